@@ -3,6 +3,7 @@ package nodev1
 
 import (
 	fmt "fmt"
+	v1 "github.com/bandprotocol/chain/v3/api/band/base/oracle/v1"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -791,12 +792,16 @@ func (x *fastReflection_ChainIDResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EVMValidatorsRequest protoreflect.MessageDescriptor
+	md_EVMValidatorsRequest          protoreflect.MessageDescriptor
+	fd_EVMValidatorsRequest_height   protoreflect.FieldDescriptor
+	fd_EVMValidatorsRequest_full_set protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_base_node_v1_query_proto_init()
 	md_EVMValidatorsRequest = File_band_base_node_v1_query_proto.Messages().ByName("EVMValidatorsRequest")
+	fd_EVMValidatorsRequest_height = md_EVMValidatorsRequest.Fields().ByName("height")
+	fd_EVMValidatorsRequest_full_set = md_EVMValidatorsRequest.Fields().ByName("full_set")
 }
 
 var _ protoreflect.Message = (*fastReflection_EVMValidatorsRequest)(nil)
@@ -864,6 +869,18 @@ func (x *fastReflection_EVMValidatorsRequest) Interface() protoreflect.ProtoMess
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_EVMValidatorsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_EVMValidatorsRequest_height, value) {
+			return
+		}
+	}
+	if x.FullSet != false {
+		value := protoreflect.ValueOfBool(x.FullSet)
+		if !f(fd_EVMValidatorsRequest_full_set, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -879,6 +896,10 @@ func (x *fastReflection_EVMValidatorsRequest) Range(f func(protoreflect.FieldDes
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_EVMValidatorsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "band.base.node.v1.EVMValidatorsRequest.height":
+		return x.Height != int64(0)
+	case "band.base.node.v1.EVMValidatorsRequest.full_set":
+		return x.FullSet != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.EVMValidatorsRequest"))
@@ -895,6 +916,10 @@ func (x *fastReflection_EVMValidatorsRequest) Has(fd protoreflect.FieldDescripto
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_EVMValidatorsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "band.base.node.v1.EVMValidatorsRequest.height":
+		x.Height = int64(0)
+	case "band.base.node.v1.EVMValidatorsRequest.full_set":
+		x.FullSet = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.EVMValidatorsRequest"))
@@ -911,6 +936,12 @@ func (x *fastReflection_EVMValidatorsRequest) Clear(fd protoreflect.FieldDescrip
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_EVMValidatorsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "band.base.node.v1.EVMValidatorsRequest.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "band.base.node.v1.EVMValidatorsRequest.full_set":
+		value := x.FullSet
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.EVMValidatorsRequest"))
@@ -931,6 +962,10 @@ func (x *fastReflection_EVMValidatorsRequest) Get(descriptor protoreflect.FieldD
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_EVMValidatorsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "band.base.node.v1.EVMValidatorsRequest.height":
+		x.Height = value.Int()
+	case "band.base.node.v1.EVMValidatorsRequest.full_set":
+		x.FullSet = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.EVMValidatorsRequest"))
@@ -951,6 +986,10 @@ func (x *fastReflection_EVMValidatorsRequest) Set(fd protoreflect.FieldDescripto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_EVMValidatorsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.base.node.v1.EVMValidatorsRequest.height":
+		panic(fmt.Errorf("field height of message band.base.node.v1.EVMValidatorsRequest is not mutable"))
+	case "band.base.node.v1.EVMValidatorsRequest.full_set":
+		panic(fmt.Errorf("field full_set of message band.base.node.v1.EVMValidatorsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.EVMValidatorsRequest"))
@@ -964,6 +1003,10 @@ func (x *fastReflection_EVMValidatorsRequest) Mutable(fd protoreflect.FieldDescr
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_EVMValidatorsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.base.node.v1.EVMValidatorsRequest.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.base.node.v1.EVMValidatorsRequest.full_set":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.EVMValidatorsRequest"))
@@ -1033,6 +1076,12 @@ func (x *fastReflection_EVMValidatorsRequest) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.FullSet {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1062,6 +1111,21 @@ func (x *fastReflection_EVMValidatorsRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.FullSet {
+			i--
+			if x.FullSet {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -1111,6 +1175,45 @@ func (x *fastReflection_EVMValidatorsRequest) ProtoMethods() *protoiface.Methods
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EVMValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FullSet", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.FullSet = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1692,6 +1795,7 @@ var (
 	md_ValidatorMinimal              protoreflect.MessageDescriptor
 	fd_ValidatorMinimal_address      protoreflect.FieldDescriptor
 	fd_ValidatorMinimal_voting_power protoreflect.FieldDescriptor
+	fd_ValidatorMinimal_pub_key      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1699,6 +1803,7 @@ func init() {
 	md_ValidatorMinimal = File_band_base_node_v1_query_proto.Messages().ByName("ValidatorMinimal")
 	fd_ValidatorMinimal_address = md_ValidatorMinimal.Fields().ByName("address")
 	fd_ValidatorMinimal_voting_power = md_ValidatorMinimal.Fields().ByName("voting_power")
+	fd_ValidatorMinimal_pub_key = md_ValidatorMinimal.Fields().ByName("pub_key")
 }
 
 var _ protoreflect.Message = (*fastReflection_ValidatorMinimal)(nil)
@@ -1778,6 +1883,12 @@ func (x *fastReflection_ValidatorMinimal) Range(f func(protoreflect.FieldDescrip
 			return
 		}
 	}
+	if len(x.PubKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.PubKey)
+		if !f(fd_ValidatorMinimal_pub_key, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1797,6 +1908,8 @@ func (x *fastReflection_ValidatorMinimal) Has(fd protoreflect.FieldDescriptor) b
 		return x.Address != ""
 	case "band.base.node.v1.ValidatorMinimal.voting_power":
 		return x.VotingPower != int64(0)
+	case "band.base.node.v1.ValidatorMinimal.pub_key":
+		return len(x.PubKey) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.ValidatorMinimal"))
@@ -1817,6 +1930,8 @@ func (x *fastReflection_ValidatorMinimal) Clear(fd protoreflect.FieldDescriptor)
 		x.Address = ""
 	case "band.base.node.v1.ValidatorMinimal.voting_power":
 		x.VotingPower = int64(0)
+	case "band.base.node.v1.ValidatorMinimal.pub_key":
+		x.PubKey = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.ValidatorMinimal"))
@@ -1839,6 +1954,9 @@ func (x *fastReflection_ValidatorMinimal) Get(descriptor protoreflect.FieldDescr
 	case "band.base.node.v1.ValidatorMinimal.voting_power":
 		value := x.VotingPower
 		return protoreflect.ValueOfInt64(value)
+	case "band.base.node.v1.ValidatorMinimal.pub_key":
+		value := x.PubKey
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.ValidatorMinimal"))
@@ -1863,6 +1981,8 @@ func (x *fastReflection_ValidatorMinimal) Set(fd protoreflect.FieldDescriptor, v
 		x.Address = value.Interface().(string)
 	case "band.base.node.v1.ValidatorMinimal.voting_power":
 		x.VotingPower = value.Int()
+	case "band.base.node.v1.ValidatorMinimal.pub_key":
+		x.PubKey = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.ValidatorMinimal"))
@@ -1887,6 +2007,8 @@ func (x *fastReflection_ValidatorMinimal) Mutable(fd protoreflect.FieldDescripto
 		panic(fmt.Errorf("field address of message band.base.node.v1.ValidatorMinimal is not mutable"))
 	case "band.base.node.v1.ValidatorMinimal.voting_power":
 		panic(fmt.Errorf("field voting_power of message band.base.node.v1.ValidatorMinimal is not mutable"))
+	case "band.base.node.v1.ValidatorMinimal.pub_key":
+		panic(fmt.Errorf("field pub_key of message band.base.node.v1.ValidatorMinimal is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.ValidatorMinimal"))
@@ -1904,6 +2026,8 @@ func (x *fastReflection_ValidatorMinimal) NewField(fd protoreflect.FieldDescript
 		return protoreflect.ValueOfString("")
 	case "band.base.node.v1.ValidatorMinimal.voting_power":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "band.base.node.v1.ValidatorMinimal.pub_key":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.ValidatorMinimal"))
@@ -1980,6 +2104,10 @@ func (x *fastReflection_ValidatorMinimal) ProtoMethods() *protoiface.Methods {
 		if x.VotingPower != 0 {
 			n += 1 + runtime.Sov(uint64(x.VotingPower))
 		}
+		l = len(x.PubKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2009,6 +2137,13 @@ func (x *fastReflection_ValidatorMinimal) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.PubKey) > 0 {
+			i -= len(x.PubKey)
+			copy(dAtA[i:], x.PubKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKey)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.VotingPower != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.VotingPower))
 			i--
@@ -2121,6 +2256,40 @@ func (x *fastReflection_ValidatorMinimal) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PubKey = append(x.PubKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.PubKey == nil {
+					x.PubKey = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2156,150 +2325,2374 @@ func (x *fastReflection_ValidatorMinimal) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: band/base/node/v1/query.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_EVMValidatorSetDiffRequest             protoreflect.MessageDescriptor
+	fd_EVMValidatorSetDiffRequest_from_height protoreflect.FieldDescriptor
+	fd_EVMValidatorSetDiffRequest_to_height   protoreflect.FieldDescriptor
 )
 
-// ChainIDRequest is request type for the Service/ChainID RPC method.
-type ChainIDRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *ChainIDRequest) Reset() {
-	*x = ChainIDRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_node_v1_query_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ChainIDRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func init() {
+	file_band_base_node_v1_query_proto_init()
+	md_EVMValidatorSetDiffRequest = File_band_base_node_v1_query_proto.Messages().ByName("EVMValidatorSetDiffRequest")
+	fd_EVMValidatorSetDiffRequest_from_height = md_EVMValidatorSetDiffRequest.Fields().ByName("from_height")
+	fd_EVMValidatorSetDiffRequest_to_height = md_EVMValidatorSetDiffRequest.Fields().ByName("to_height")
 }
 
-func (*ChainIDRequest) ProtoMessage() {}
-
-// Deprecated: Use ChainIDRequest.ProtoReflect.Descriptor instead.
-func (*ChainIDRequest) Descriptor() ([]byte, []int) {
-	return file_band_base_node_v1_query_proto_rawDescGZIP(), []int{0}
-}
+var _ protoreflect.Message = (*fastReflection_EVMValidatorSetDiffRequest)(nil)
 
-// ChainIDResponse is response type for the Service/ChainID RPC method.
-type ChainIDResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type fastReflection_EVMValidatorSetDiffRequest EVMValidatorSetDiffRequest
 
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+func (x *EVMValidatorSetDiffRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EVMValidatorSetDiffRequest)(x)
 }
 
-func (x *ChainIDResponse) Reset() {
-	*x = ChainIDResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_node_v1_query_proto_msgTypes[1]
+func (x *EVMValidatorSetDiffRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_node_v1_query_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *ChainIDResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_EVMValidatorSetDiffRequest_messageType fastReflection_EVMValidatorSetDiffRequest_messageType
+var _ protoreflect.MessageType = fastReflection_EVMValidatorSetDiffRequest_messageType{}
 
-func (*ChainIDResponse) ProtoMessage() {}
+type fastReflection_EVMValidatorSetDiffRequest_messageType struct{}
 
-// Deprecated: Use ChainIDResponse.ProtoReflect.Descriptor instead.
-func (*ChainIDResponse) Descriptor() ([]byte, []int) {
-	return file_band_base_node_v1_query_proto_rawDescGZIP(), []int{1}
+func (x fastReflection_EVMValidatorSetDiffRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EVMValidatorSetDiffRequest)(nil)
 }
-
-func (x *ChainIDResponse) GetChainId() string {
-	if x != nil {
-		return x.ChainId
-	}
-	return ""
+func (x fastReflection_EVMValidatorSetDiffRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_EVMValidatorSetDiffRequest)
 }
-
-// EVMValidatorsRequest is request type for the Service/EVMValidators RPC method.
-type EVMValidatorsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x fastReflection_EVMValidatorSetDiffRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EVMValidatorSetDiffRequest
 }
 
-func (x *EVMValidatorsRequest) Reset() {
-	*x = EVMValidatorsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_node_v1_query_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EVMValidatorSetDiffRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_EVMValidatorSetDiffRequest
 }
 
-func (x *EVMValidatorsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EVMValidatorSetDiffRequest) Type() protoreflect.MessageType {
+	return _fastReflection_EVMValidatorSetDiffRequest_messageType
 }
 
-func (*EVMValidatorsRequest) ProtoMessage() {}
-
-// Deprecated: Use EVMValidatorsRequest.ProtoReflect.Descriptor instead.
-func (*EVMValidatorsRequest) Descriptor() ([]byte, []int) {
-	return file_band_base_node_v1_query_proto_rawDescGZIP(), []int{2}
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EVMValidatorSetDiffRequest) New() protoreflect.Message {
+	return new(fastReflection_EVMValidatorSetDiffRequest)
 }
 
-// EVMValidatorsResponse is response type for the Service/EVMValidators RPC method.
-type EVMValidatorsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// BlockHeight is the latest block height
-	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	// Validators is list of validator's addresss and voting power
-	Validators []*ValidatorMinimal `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EVMValidatorSetDiffRequest) Interface() protoreflect.ProtoMessage {
+	return (*EVMValidatorSetDiffRequest)(x)
 }
 
-func (x *EVMValidatorsResponse) Reset() {
-	*x = EVMValidatorsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_base_node_v1_query_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EVMValidatorSetDiffRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FromHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FromHeight)
+		if !f(fd_EVMValidatorSetDiffRequest_from_height, value) {
+			return
+		}
+	}
+	if x.ToHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ToHeight)
+		if !f(fd_EVMValidatorSetDiffRequest_to_height, value) {
+			return
+		}
 	}
 }
 
-func (x *EVMValidatorsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EVMValidatorSetDiffRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.node.v1.EVMValidatorSetDiffRequest.from_height":
+		return x.FromHeight != int64(0)
+	case "band.base.node.v1.EVMValidatorSetDiffRequest.to_height":
+		return x.ToHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.EVMValidatorSetDiffRequest"))
+		}
+		panic(fmt.Errorf("message band.base.node.v1.EVMValidatorSetDiffRequest does not contain field %s", fd.FullName()))
+	}
 }
 
-func (*EVMValidatorsResponse) ProtoMessage() {}
-
-// Deprecated: Use EVMValidatorsResponse.ProtoReflect.Descriptor instead.
-func (*EVMValidatorsResponse) Descriptor() ([]byte, []int) {
-	return file_band_base_node_v1_query_proto_rawDescGZIP(), []int{3}
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EVMValidatorSetDiffRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.node.v1.EVMValidatorSetDiffRequest.from_height":
+		x.FromHeight = int64(0)
+	case "band.base.node.v1.EVMValidatorSetDiffRequest.to_height":
+		x.ToHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.EVMValidatorSetDiffRequest"))
+		}
+		panic(fmt.Errorf("message band.base.node.v1.EVMValidatorSetDiffRequest does not contain field %s", fd.FullName()))
+	}
 }
 
-func (x *EVMValidatorsResponse) GetBlockHeight() int64 {
-	if x != nil {
-		return x.BlockHeight
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EVMValidatorSetDiffRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.node.v1.EVMValidatorSetDiffRequest.from_height":
+		value := x.FromHeight
+		return protoreflect.ValueOfInt64(value)
+	case "band.base.node.v1.EVMValidatorSetDiffRequest.to_height":
+		value := x.ToHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.EVMValidatorSetDiffRequest"))
+		}
+		panic(fmt.Errorf("message band.base.node.v1.EVMValidatorSetDiffRequest does not contain field %s", descriptor.FullName()))
 	}
-	return 0
 }
 
-func (x *EVMValidatorsResponse) GetValidators() []*ValidatorMinimal {
-	if x != nil {
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EVMValidatorSetDiffRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.node.v1.EVMValidatorSetDiffRequest.from_height":
+		x.FromHeight = value.Int()
+	case "band.base.node.v1.EVMValidatorSetDiffRequest.to_height":
+		x.ToHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.EVMValidatorSetDiffRequest"))
+		}
+		panic(fmt.Errorf("message band.base.node.v1.EVMValidatorSetDiffRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EVMValidatorSetDiffRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.node.v1.EVMValidatorSetDiffRequest.from_height":
+		panic(fmt.Errorf("field from_height of message band.base.node.v1.EVMValidatorSetDiffRequest is not mutable"))
+	case "band.base.node.v1.EVMValidatorSetDiffRequest.to_height":
+		panic(fmt.Errorf("field to_height of message band.base.node.v1.EVMValidatorSetDiffRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.EVMValidatorSetDiffRequest"))
+		}
+		panic(fmt.Errorf("message band.base.node.v1.EVMValidatorSetDiffRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EVMValidatorSetDiffRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.node.v1.EVMValidatorSetDiffRequest.from_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.base.node.v1.EVMValidatorSetDiffRequest.to_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.EVMValidatorSetDiffRequest"))
+		}
+		panic(fmt.Errorf("message band.base.node.v1.EVMValidatorSetDiffRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EVMValidatorSetDiffRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.node.v1.EVMValidatorSetDiffRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EVMValidatorSetDiffRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EVMValidatorSetDiffRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EVMValidatorSetDiffRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EVMValidatorSetDiffRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EVMValidatorSetDiffRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.FromHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.FromHeight))
+		}
+		if x.ToHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ToHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EVMValidatorSetDiffRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ToHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ToHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.FromHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FromHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EVMValidatorSetDiffRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EVMValidatorSetDiffRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EVMValidatorSetDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+				}
+				x.FromHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FromHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+				}
+				x.ToHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ToHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EVMValidatorSetDiffResponse_3_list)(nil)
+
+type _EVMValidatorSetDiffResponse_3_list struct {
+	list *[]*ValidatorMinimal
+}
+
+func (x *_EVMValidatorSetDiffResponse_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EVMValidatorSetDiffResponse_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EVMValidatorSetDiffResponse_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorMinimal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EVMValidatorSetDiffResponse_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorMinimal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EVMValidatorSetDiffResponse_3_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorMinimal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EVMValidatorSetDiffResponse_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EVMValidatorSetDiffResponse_3_list) NewElement() protoreflect.Value {
+	v := new(ValidatorMinimal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EVMValidatorSetDiffResponse_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EVMValidatorSetDiffResponse_4_list)(nil)
+
+type _EVMValidatorSetDiffResponse_4_list struct {
+	list *[]*ValidatorMinimal
+}
+
+func (x *_EVMValidatorSetDiffResponse_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EVMValidatorSetDiffResponse_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EVMValidatorSetDiffResponse_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorMinimal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EVMValidatorSetDiffResponse_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorMinimal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EVMValidatorSetDiffResponse_4_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorMinimal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EVMValidatorSetDiffResponse_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EVMValidatorSetDiffResponse_4_list) NewElement() protoreflect.Value {
+	v := new(ValidatorMinimal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EVMValidatorSetDiffResponse_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EVMValidatorSetDiffResponse                   protoreflect.MessageDescriptor
+	fd_EVMValidatorSetDiffResponse_from_block_height protoreflect.FieldDescriptor
+	fd_EVMValidatorSetDiffResponse_to_block_height   protoreflect.FieldDescriptor
+	fd_EVMValidatorSetDiffResponse_changes           protoreflect.FieldDescriptor
+	fd_EVMValidatorSetDiffResponse_validators        protoreflect.FieldDescriptor
+	fd_EVMValidatorSetDiffResponse_proof             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_node_v1_query_proto_init()
+	md_EVMValidatorSetDiffResponse = File_band_base_node_v1_query_proto.Messages().ByName("EVMValidatorSetDiffResponse")
+	fd_EVMValidatorSetDiffResponse_from_block_height = md_EVMValidatorSetDiffResponse.Fields().ByName("from_block_height")
+	fd_EVMValidatorSetDiffResponse_to_block_height = md_EVMValidatorSetDiffResponse.Fields().ByName("to_block_height")
+	fd_EVMValidatorSetDiffResponse_changes = md_EVMValidatorSetDiffResponse.Fields().ByName("changes")
+	fd_EVMValidatorSetDiffResponse_validators = md_EVMValidatorSetDiffResponse.Fields().ByName("validators")
+	fd_EVMValidatorSetDiffResponse_proof = md_EVMValidatorSetDiffResponse.Fields().ByName("proof")
+}
+
+var _ protoreflect.Message = (*fastReflection_EVMValidatorSetDiffResponse)(nil)
+
+type fastReflection_EVMValidatorSetDiffResponse EVMValidatorSetDiffResponse
+
+func (x *EVMValidatorSetDiffResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EVMValidatorSetDiffResponse)(x)
+}
+
+func (x *EVMValidatorSetDiffResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_node_v1_query_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EVMValidatorSetDiffResponse_messageType fastReflection_EVMValidatorSetDiffResponse_messageType
+var _ protoreflect.MessageType = fastReflection_EVMValidatorSetDiffResponse_messageType{}
+
+type fastReflection_EVMValidatorSetDiffResponse_messageType struct{}
+
+func (x fastReflection_EVMValidatorSetDiffResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EVMValidatorSetDiffResponse)(nil)
+}
+func (x fastReflection_EVMValidatorSetDiffResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_EVMValidatorSetDiffResponse)
+}
+func (x fastReflection_EVMValidatorSetDiffResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EVMValidatorSetDiffResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EVMValidatorSetDiffResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_EVMValidatorSetDiffResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EVMValidatorSetDiffResponse) Type() protoreflect.MessageType {
+	return _fastReflection_EVMValidatorSetDiffResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EVMValidatorSetDiffResponse) New() protoreflect.Message {
+	return new(fastReflection_EVMValidatorSetDiffResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EVMValidatorSetDiffResponse) Interface() protoreflect.ProtoMessage {
+	return (*EVMValidatorSetDiffResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EVMValidatorSetDiffResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FromBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FromBlockHeight)
+		if !f(fd_EVMValidatorSetDiffResponse_from_block_height, value) {
+			return
+		}
+	}
+	if x.ToBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ToBlockHeight)
+		if !f(fd_EVMValidatorSetDiffResponse_to_block_height, value) {
+			return
+		}
+	}
+	if len(x.Changes) != 0 {
+		value := protoreflect.ValueOfList(&_EVMValidatorSetDiffResponse_3_list{list: &x.Changes})
+		if !f(fd_EVMValidatorSetDiffResponse_changes, value) {
+			return
+		}
+	}
+	if len(x.Validators) != 0 {
+		value := protoreflect.ValueOfList(&_EVMValidatorSetDiffResponse_4_list{list: &x.Validators})
+		if !f(fd_EVMValidatorSetDiffResponse_validators, value) {
+			return
+		}
+	}
+	if x.Proof != nil {
+		value := protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+		if !f(fd_EVMValidatorSetDiffResponse_proof, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EVMValidatorSetDiffResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.from_block_height":
+		return x.FromBlockHeight != int64(0)
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.to_block_height":
+		return x.ToBlockHeight != int64(0)
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.changes":
+		return len(x.Changes) != 0
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.validators":
+		return len(x.Validators) != 0
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.proof":
+		return x.Proof != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.EVMValidatorSetDiffResponse"))
+		}
+		panic(fmt.Errorf("message band.base.node.v1.EVMValidatorSetDiffResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EVMValidatorSetDiffResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.from_block_height":
+		x.FromBlockHeight = int64(0)
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.to_block_height":
+		x.ToBlockHeight = int64(0)
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.changes":
+		x.Changes = nil
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.validators":
+		x.Validators = nil
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.proof":
+		x.Proof = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.EVMValidatorSetDiffResponse"))
+		}
+		panic(fmt.Errorf("message band.base.node.v1.EVMValidatorSetDiffResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EVMValidatorSetDiffResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.from_block_height":
+		value := x.FromBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.to_block_height":
+		value := x.ToBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.changes":
+		if len(x.Changes) == 0 {
+			return protoreflect.ValueOfList(&_EVMValidatorSetDiffResponse_3_list{})
+		}
+		listValue := &_EVMValidatorSetDiffResponse_3_list{list: &x.Changes}
+		return protoreflect.ValueOfList(listValue)
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.validators":
+		if len(x.Validators) == 0 {
+			return protoreflect.ValueOfList(&_EVMValidatorSetDiffResponse_4_list{})
+		}
+		listValue := &_EVMValidatorSetDiffResponse_4_list{list: &x.Validators}
+		return protoreflect.ValueOfList(listValue)
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.proof":
+		value := x.Proof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.EVMValidatorSetDiffResponse"))
+		}
+		panic(fmt.Errorf("message band.base.node.v1.EVMValidatorSetDiffResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EVMValidatorSetDiffResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.from_block_height":
+		x.FromBlockHeight = value.Int()
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.to_block_height":
+		x.ToBlockHeight = value.Int()
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.changes":
+		lv := value.List()
+		clv := lv.(*_EVMValidatorSetDiffResponse_3_list)
+		x.Changes = *clv.list
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.validators":
+		lv := value.List()
+		clv := lv.(*_EVMValidatorSetDiffResponse_4_list)
+		x.Validators = *clv.list
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.proof":
+		x.Proof = value.Message().Interface().(*ValidatorSetTransitionProof)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.EVMValidatorSetDiffResponse"))
+		}
+		panic(fmt.Errorf("message band.base.node.v1.EVMValidatorSetDiffResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EVMValidatorSetDiffResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.changes":
+		if x.Changes == nil {
+			x.Changes = []*ValidatorMinimal{}
+		}
+		value := &_EVMValidatorSetDiffResponse_3_list{list: &x.Changes}
+		return protoreflect.ValueOfList(value)
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.validators":
+		if x.Validators == nil {
+			x.Validators = []*ValidatorMinimal{}
+		}
+		value := &_EVMValidatorSetDiffResponse_4_list{list: &x.Validators}
+		return protoreflect.ValueOfList(value)
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.proof":
+		if x.Proof == nil {
+			x.Proof = new(ValidatorSetTransitionProof)
+		}
+		return protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.from_block_height":
+		panic(fmt.Errorf("field from_block_height of message band.base.node.v1.EVMValidatorSetDiffResponse is not mutable"))
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.to_block_height":
+		panic(fmt.Errorf("field to_block_height of message band.base.node.v1.EVMValidatorSetDiffResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.EVMValidatorSetDiffResponse"))
+		}
+		panic(fmt.Errorf("message band.base.node.v1.EVMValidatorSetDiffResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EVMValidatorSetDiffResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.from_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.to_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.changes":
+		list := []*ValidatorMinimal{}
+		return protoreflect.ValueOfList(&_EVMValidatorSetDiffResponse_3_list{list: &list})
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.validators":
+		list := []*ValidatorMinimal{}
+		return protoreflect.ValueOfList(&_EVMValidatorSetDiffResponse_4_list{list: &list})
+	case "band.base.node.v1.EVMValidatorSetDiffResponse.proof":
+		m := new(ValidatorSetTransitionProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.EVMValidatorSetDiffResponse"))
+		}
+		panic(fmt.Errorf("message band.base.node.v1.EVMValidatorSetDiffResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EVMValidatorSetDiffResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.node.v1.EVMValidatorSetDiffResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EVMValidatorSetDiffResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EVMValidatorSetDiffResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EVMValidatorSetDiffResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EVMValidatorSetDiffResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EVMValidatorSetDiffResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.FromBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.FromBlockHeight))
+		}
+		if x.ToBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ToBlockHeight))
+		}
+		if len(x.Changes) > 0 {
+			for _, e := range x.Changes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Validators) > 0 {
+			for _, e := range x.Validators {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Proof != nil {
+			l = options.Size(x.Proof)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EVMValidatorSetDiffResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Proof != nil {
+			encoded, err := options.Marshal(x.Proof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Validators) > 0 {
+			for iNdEx := len(x.Validators) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Validators[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Changes) > 0 {
+			for iNdEx := len(x.Changes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Changes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.ToBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ToBlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.FromBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FromBlockHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EVMValidatorSetDiffResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EVMValidatorSetDiffResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EVMValidatorSetDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromBlockHeight", wireType)
+				}
+				x.FromBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FromBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToBlockHeight", wireType)
+				}
+				x.ToBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ToBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Changes = append(x.Changes, &ValidatorMinimal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Changes[len(x.Changes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validators = append(x.Validators, &ValidatorMinimal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Validators[len(x.Validators)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Proof == nil {
+					x.Proof = &ValidatorSetTransitionProof{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proof); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ValidatorSetTransitionProof_6_list)(nil)
+
+type _ValidatorSetTransitionProof_6_list struct {
+	list *[]*v1.TMSignature
+}
+
+func (x *_ValidatorSetTransitionProof_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValidatorSetTransitionProof_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ValidatorSetTransitionProof_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.TMSignature)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValidatorSetTransitionProof_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.TMSignature)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValidatorSetTransitionProof_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1.TMSignature)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorSetTransitionProof_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValidatorSetTransitionProof_6_list) NewElement() protoreflect.Value {
+	v := new(v1.TMSignature)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorSetTransitionProof_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ValidatorSetTransitionProof                                    protoreflect.MessageDescriptor
+	fd_ValidatorSetTransitionProof_block_header_merkle_parts          protoreflect.FieldDescriptor
+	fd_ValidatorSetTransitionProof_app_hash                           protoreflect.FieldDescriptor
+	fd_ValidatorSetTransitionProof_last_block_id_and_last_commit_hash protoreflect.FieldDescriptor
+	fd_ValidatorSetTransitionProof_data_hash                          protoreflect.FieldDescriptor
+	fd_ValidatorSetTransitionProof_common_encoded_vote_part           protoreflect.FieldDescriptor
+	fd_ValidatorSetTransitionProof_signatures                         protoreflect.FieldDescriptor
+	fd_ValidatorSetTransitionProof_signed_voting_power                protoreflect.FieldDescriptor
+	fd_ValidatorSetTransitionProof_total_voting_power                 protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_base_node_v1_query_proto_init()
+	md_ValidatorSetTransitionProof = File_band_base_node_v1_query_proto.Messages().ByName("ValidatorSetTransitionProof")
+	fd_ValidatorSetTransitionProof_block_header_merkle_parts = md_ValidatorSetTransitionProof.Fields().ByName("block_header_merkle_parts")
+	fd_ValidatorSetTransitionProof_app_hash = md_ValidatorSetTransitionProof.Fields().ByName("app_hash")
+	fd_ValidatorSetTransitionProof_last_block_id_and_last_commit_hash = md_ValidatorSetTransitionProof.Fields().ByName("last_block_id_and_last_commit_hash")
+	fd_ValidatorSetTransitionProof_data_hash = md_ValidatorSetTransitionProof.Fields().ByName("data_hash")
+	fd_ValidatorSetTransitionProof_common_encoded_vote_part = md_ValidatorSetTransitionProof.Fields().ByName("common_encoded_vote_part")
+	fd_ValidatorSetTransitionProof_signatures = md_ValidatorSetTransitionProof.Fields().ByName("signatures")
+	fd_ValidatorSetTransitionProof_signed_voting_power = md_ValidatorSetTransitionProof.Fields().ByName("signed_voting_power")
+	fd_ValidatorSetTransitionProof_total_voting_power = md_ValidatorSetTransitionProof.Fields().ByName("total_voting_power")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorSetTransitionProof)(nil)
+
+type fastReflection_ValidatorSetTransitionProof ValidatorSetTransitionProof
+
+func (x *ValidatorSetTransitionProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorSetTransitionProof)(x)
+}
+
+func (x *ValidatorSetTransitionProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_base_node_v1_query_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorSetTransitionProof_messageType fastReflection_ValidatorSetTransitionProof_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorSetTransitionProof_messageType{}
+
+type fastReflection_ValidatorSetTransitionProof_messageType struct{}
+
+func (x fastReflection_ValidatorSetTransitionProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorSetTransitionProof)(nil)
+}
+func (x fastReflection_ValidatorSetTransitionProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorSetTransitionProof)
+}
+func (x fastReflection_ValidatorSetTransitionProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorSetTransitionProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorSetTransitionProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorSetTransitionProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorSetTransitionProof) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorSetTransitionProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorSetTransitionProof) New() protoreflect.Message {
+	return new(fastReflection_ValidatorSetTransitionProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorSetTransitionProof) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorSetTransitionProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorSetTransitionProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockHeaderMerkleParts != nil {
+		value := protoreflect.ValueOfMessage(x.BlockHeaderMerkleParts.ProtoReflect())
+		if !f(fd_ValidatorSetTransitionProof_block_header_merkle_parts, value) {
+			return
+		}
+	}
+	if len(x.AppHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.AppHash)
+		if !f(fd_ValidatorSetTransitionProof_app_hash, value) {
+			return
+		}
+	}
+	if len(x.LastBlockIdAndLastCommitHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.LastBlockIdAndLastCommitHash)
+		if !f(fd_ValidatorSetTransitionProof_last_block_id_and_last_commit_hash, value) {
+			return
+		}
+	}
+	if len(x.DataHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.DataHash)
+		if !f(fd_ValidatorSetTransitionProof_data_hash, value) {
+			return
+		}
+	}
+	if x.CommonEncodedVotePart != nil {
+		value := protoreflect.ValueOfMessage(x.CommonEncodedVotePart.ProtoReflect())
+		if !f(fd_ValidatorSetTransitionProof_common_encoded_vote_part, value) {
+			return
+		}
+	}
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorSetTransitionProof_6_list{list: &x.Signatures})
+		if !f(fd_ValidatorSetTransitionProof_signatures, value) {
+			return
+		}
+	}
+	if x.SignedVotingPower != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SignedVotingPower)
+		if !f(fd_ValidatorSetTransitionProof_signed_voting_power, value) {
+			return
+		}
+	}
+	if x.TotalVotingPower != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TotalVotingPower)
+		if !f(fd_ValidatorSetTransitionProof_total_voting_power, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorSetTransitionProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.base.node.v1.ValidatorSetTransitionProof.block_header_merkle_parts":
+		return x.BlockHeaderMerkleParts != nil
+	case "band.base.node.v1.ValidatorSetTransitionProof.app_hash":
+		return len(x.AppHash) != 0
+	case "band.base.node.v1.ValidatorSetTransitionProof.last_block_id_and_last_commit_hash":
+		return len(x.LastBlockIdAndLastCommitHash) != 0
+	case "band.base.node.v1.ValidatorSetTransitionProof.data_hash":
+		return len(x.DataHash) != 0
+	case "band.base.node.v1.ValidatorSetTransitionProof.common_encoded_vote_part":
+		return x.CommonEncodedVotePart != nil
+	case "band.base.node.v1.ValidatorSetTransitionProof.signatures":
+		return len(x.Signatures) != 0
+	case "band.base.node.v1.ValidatorSetTransitionProof.signed_voting_power":
+		return x.SignedVotingPower != int64(0)
+	case "band.base.node.v1.ValidatorSetTransitionProof.total_voting_power":
+		return x.TotalVotingPower != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.ValidatorSetTransitionProof"))
+		}
+		panic(fmt.Errorf("message band.base.node.v1.ValidatorSetTransitionProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorSetTransitionProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.base.node.v1.ValidatorSetTransitionProof.block_header_merkle_parts":
+		x.BlockHeaderMerkleParts = nil
+	case "band.base.node.v1.ValidatorSetTransitionProof.app_hash":
+		x.AppHash = nil
+	case "band.base.node.v1.ValidatorSetTransitionProof.last_block_id_and_last_commit_hash":
+		x.LastBlockIdAndLastCommitHash = nil
+	case "band.base.node.v1.ValidatorSetTransitionProof.data_hash":
+		x.DataHash = nil
+	case "band.base.node.v1.ValidatorSetTransitionProof.common_encoded_vote_part":
+		x.CommonEncodedVotePart = nil
+	case "band.base.node.v1.ValidatorSetTransitionProof.signatures":
+		x.Signatures = nil
+	case "band.base.node.v1.ValidatorSetTransitionProof.signed_voting_power":
+		x.SignedVotingPower = int64(0)
+	case "band.base.node.v1.ValidatorSetTransitionProof.total_voting_power":
+		x.TotalVotingPower = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.ValidatorSetTransitionProof"))
+		}
+		panic(fmt.Errorf("message band.base.node.v1.ValidatorSetTransitionProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorSetTransitionProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.base.node.v1.ValidatorSetTransitionProof.block_header_merkle_parts":
+		value := x.BlockHeaderMerkleParts
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.node.v1.ValidatorSetTransitionProof.app_hash":
+		value := x.AppHash
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.node.v1.ValidatorSetTransitionProof.last_block_id_and_last_commit_hash":
+		value := x.LastBlockIdAndLastCommitHash
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.node.v1.ValidatorSetTransitionProof.data_hash":
+		value := x.DataHash
+		return protoreflect.ValueOfBytes(value)
+	case "band.base.node.v1.ValidatorSetTransitionProof.common_encoded_vote_part":
+		value := x.CommonEncodedVotePart
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.base.node.v1.ValidatorSetTransitionProof.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorSetTransitionProof_6_list{})
+		}
+		listValue := &_ValidatorSetTransitionProof_6_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
+	case "band.base.node.v1.ValidatorSetTransitionProof.signed_voting_power":
+		value := x.SignedVotingPower
+		return protoreflect.ValueOfInt64(value)
+	case "band.base.node.v1.ValidatorSetTransitionProof.total_voting_power":
+		value := x.TotalVotingPower
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.ValidatorSetTransitionProof"))
+		}
+		panic(fmt.Errorf("message band.base.node.v1.ValidatorSetTransitionProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorSetTransitionProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.base.node.v1.ValidatorSetTransitionProof.block_header_merkle_parts":
+		x.BlockHeaderMerkleParts = value.Message().Interface().(*v1.BlockHeaderMerkleParts)
+	case "band.base.node.v1.ValidatorSetTransitionProof.app_hash":
+		x.AppHash = value.Bytes()
+	case "band.base.node.v1.ValidatorSetTransitionProof.last_block_id_and_last_commit_hash":
+		x.LastBlockIdAndLastCommitHash = value.Bytes()
+	case "band.base.node.v1.ValidatorSetTransitionProof.data_hash":
+		x.DataHash = value.Bytes()
+	case "band.base.node.v1.ValidatorSetTransitionProof.common_encoded_vote_part":
+		x.CommonEncodedVotePart = value.Message().Interface().(*v1.CommonEncodedVotePart)
+	case "band.base.node.v1.ValidatorSetTransitionProof.signatures":
+		lv := value.List()
+		clv := lv.(*_ValidatorSetTransitionProof_6_list)
+		x.Signatures = *clv.list
+	case "band.base.node.v1.ValidatorSetTransitionProof.signed_voting_power":
+		x.SignedVotingPower = value.Int()
+	case "band.base.node.v1.ValidatorSetTransitionProof.total_voting_power":
+		x.TotalVotingPower = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.ValidatorSetTransitionProof"))
+		}
+		panic(fmt.Errorf("message band.base.node.v1.ValidatorSetTransitionProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorSetTransitionProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.node.v1.ValidatorSetTransitionProof.block_header_merkle_parts":
+		if x.BlockHeaderMerkleParts == nil {
+			x.BlockHeaderMerkleParts = new(v1.BlockHeaderMerkleParts)
+		}
+		return protoreflect.ValueOfMessage(x.BlockHeaderMerkleParts.ProtoReflect())
+	case "band.base.node.v1.ValidatorSetTransitionProof.common_encoded_vote_part":
+		if x.CommonEncodedVotePart == nil {
+			x.CommonEncodedVotePart = new(v1.CommonEncodedVotePart)
+		}
+		return protoreflect.ValueOfMessage(x.CommonEncodedVotePart.ProtoReflect())
+	case "band.base.node.v1.ValidatorSetTransitionProof.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []*v1.TMSignature{}
+		}
+		value := &_ValidatorSetTransitionProof_6_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	case "band.base.node.v1.ValidatorSetTransitionProof.app_hash":
+		panic(fmt.Errorf("field app_hash of message band.base.node.v1.ValidatorSetTransitionProof is not mutable"))
+	case "band.base.node.v1.ValidatorSetTransitionProof.last_block_id_and_last_commit_hash":
+		panic(fmt.Errorf("field last_block_id_and_last_commit_hash of message band.base.node.v1.ValidatorSetTransitionProof is not mutable"))
+	case "band.base.node.v1.ValidatorSetTransitionProof.data_hash":
+		panic(fmt.Errorf("field data_hash of message band.base.node.v1.ValidatorSetTransitionProof is not mutable"))
+	case "band.base.node.v1.ValidatorSetTransitionProof.signed_voting_power":
+		panic(fmt.Errorf("field signed_voting_power of message band.base.node.v1.ValidatorSetTransitionProof is not mutable"))
+	case "band.base.node.v1.ValidatorSetTransitionProof.total_voting_power":
+		panic(fmt.Errorf("field total_voting_power of message band.base.node.v1.ValidatorSetTransitionProof is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.ValidatorSetTransitionProof"))
+		}
+		panic(fmt.Errorf("message band.base.node.v1.ValidatorSetTransitionProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorSetTransitionProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.base.node.v1.ValidatorSetTransitionProof.block_header_merkle_parts":
+		m := new(v1.BlockHeaderMerkleParts)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.node.v1.ValidatorSetTransitionProof.app_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.node.v1.ValidatorSetTransitionProof.last_block_id_and_last_commit_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.node.v1.ValidatorSetTransitionProof.data_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.base.node.v1.ValidatorSetTransitionProof.common_encoded_vote_part":
+		m := new(v1.CommonEncodedVotePart)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.base.node.v1.ValidatorSetTransitionProof.signatures":
+		list := []*v1.TMSignature{}
+		return protoreflect.ValueOfList(&_ValidatorSetTransitionProof_6_list{list: &list})
+	case "band.base.node.v1.ValidatorSetTransitionProof.signed_voting_power":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.base.node.v1.ValidatorSetTransitionProof.total_voting_power":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.base.node.v1.ValidatorSetTransitionProof"))
+		}
+		panic(fmt.Errorf("message band.base.node.v1.ValidatorSetTransitionProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorSetTransitionProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.base.node.v1.ValidatorSetTransitionProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorSetTransitionProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorSetTransitionProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorSetTransitionProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorSetTransitionProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorSetTransitionProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BlockHeaderMerkleParts != nil {
+			l = options.Size(x.BlockHeaderMerkleParts)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AppHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LastBlockIdAndLastCommitHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DataHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CommonEncodedVotePart != nil {
+			l = options.Size(x.CommonEncodedVotePart)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signatures) > 0 {
+			for _, e := range x.Signatures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.SignedVotingPower != 0 {
+			n += 1 + runtime.Sov(uint64(x.SignedVotingPower))
+		}
+		if x.TotalVotingPower != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalVotingPower))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorSetTransitionProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TotalVotingPower != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalVotingPower))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.SignedVotingPower != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SignedVotingPower))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signatures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.CommonEncodedVotePart != nil {
+			encoded, err := options.Marshal(x.CommonEncodedVotePart)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.DataHash) > 0 {
+			i -= len(x.DataHash)
+			copy(dAtA[i:], x.DataHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DataHash)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.LastBlockIdAndLastCommitHash) > 0 {
+			i -= len(x.LastBlockIdAndLastCommitHash)
+			copy(dAtA[i:], x.LastBlockIdAndLastCommitHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LastBlockIdAndLastCommitHash)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.AppHash) > 0 {
+			i -= len(x.AppHash)
+			copy(dAtA[i:], x.AppHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AppHash)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.BlockHeaderMerkleParts != nil {
+			encoded, err := options.Marshal(x.BlockHeaderMerkleParts)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorSetTransitionProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSetTransitionProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSetTransitionProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderMerkleParts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BlockHeaderMerkleParts == nil {
+					x.BlockHeaderMerkleParts = &v1.BlockHeaderMerkleParts{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockHeaderMerkleParts); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AppHash = append(x.AppHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.AppHash == nil {
+					x.AppHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastBlockIdAndLastCommitHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LastBlockIdAndLastCommitHash = append(x.LastBlockIdAndLastCommitHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.LastBlockIdAndLastCommitHash == nil {
+					x.LastBlockIdAndLastCommitHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DataHash = append(x.DataHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.DataHash == nil {
+					x.DataHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommonEncodedVotePart", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CommonEncodedVotePart == nil {
+					x.CommonEncodedVotePart = &v1.CommonEncodedVotePart{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CommonEncodedVotePart); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, &v1.TMSignature{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignedVotingPower", wireType)
+				}
+				x.SignedVotingPower = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SignedVotingPower |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+				}
+				x.TotalVotingPower = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalVotingPower |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: band/base/node/v1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ChainIDRequest is request type for the Service/ChainID RPC method.
+type ChainIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ChainIDRequest) Reset() {
+	*x = ChainIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_node_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainIDRequest) ProtoMessage() {}
+
+// Deprecated: Use ChainIDRequest.ProtoReflect.Descriptor instead.
+func (*ChainIDRequest) Descriptor() ([]byte, []int) {
+	return file_band_base_node_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// ChainIDResponse is response type for the Service/ChainID RPC method.
+type ChainIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (x *ChainIDResponse) Reset() {
+	*x = ChainIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_node_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainIDResponse) ProtoMessage() {}
+
+// Deprecated: Use ChainIDResponse.ProtoReflect.Descriptor instead.
+func (*ChainIDResponse) Descriptor() ([]byte, []int) {
+	return file_band_base_node_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChainIDResponse) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+// EVMValidatorsRequest is request type for the Service/EVMValidators RPC method.
+type EVMValidatorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// height is the block height of the validator set, the latest one if it's zero
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// full_set returns the full validator set instead of the top 100 validators by voting power
+	FullSet bool `protobuf:"varint,2,opt,name=full_set,json=fullSet,proto3" json:"full_set,omitempty"`
+}
+
+func (x *EVMValidatorsRequest) Reset() {
+	*x = EVMValidatorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_node_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EVMValidatorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EVMValidatorsRequest) ProtoMessage() {}
+
+// Deprecated: Use EVMValidatorsRequest.ProtoReflect.Descriptor instead.
+func (*EVMValidatorsRequest) Descriptor() ([]byte, []int) {
+	return file_band_base_node_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EVMValidatorsRequest) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *EVMValidatorsRequest) GetFullSet() bool {
+	if x != nil {
+		return x.FullSet
+	}
+	return false
+}
+
+// EVMValidatorsResponse is response type for the Service/EVMValidators RPC method.
+type EVMValidatorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// BlockHeight is the block height of the validator set
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Validators is list of validator's addresss and voting power
+	Validators []*ValidatorMinimal `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (x *EVMValidatorsResponse) Reset() {
+	*x = EVMValidatorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_node_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EVMValidatorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EVMValidatorsResponse) ProtoMessage() {}
+
+// Deprecated: Use EVMValidatorsResponse.ProtoReflect.Descriptor instead.
+func (*EVMValidatorsResponse) Descriptor() ([]byte, []int) {
+	return file_band_base_node_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EVMValidatorsResponse) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *EVMValidatorsResponse) GetValidators() []*ValidatorMinimal {
+	if x != nil {
 		return x.Validators
 	}
 	return nil
@@ -2313,6 +4706,8 @@ type ValidatorMinimal struct {
 
 	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	VotingPower int64  `protobuf:"varint,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// pub_key is the compressed secp256k1 public key of the validator
+	PubKey []byte `protobuf:"bytes,3,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
 }
 
 func (x *ValidatorMinimal) Reset() {
@@ -2349,6 +4744,232 @@ func (x *ValidatorMinimal) GetVotingPower() int64 {
 	return 0
 }
 
+func (x *ValidatorMinimal) GetPubKey() []byte {
+	if x != nil {
+		return x.PubKey
+	}
+	return nil
+}
+
+// EVMValidatorSetDiffRequest is request type for the Service/EVMValidatorSetDiff RPC method.
+type EVMValidatorSetDiffRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from_height is the block height of the validator set that is already trusted
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the block height of the new validator set, the latest one if it's zero
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (x *EVMValidatorSetDiffRequest) Reset() {
+	*x = EVMValidatorSetDiffRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_node_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EVMValidatorSetDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EVMValidatorSetDiffRequest) ProtoMessage() {}
+
+// Deprecated: Use EVMValidatorSetDiffRequest.ProtoReflect.Descriptor instead.
+func (*EVMValidatorSetDiffRequest) Descriptor() ([]byte, []int) {
+	return file_band_base_node_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EVMValidatorSetDiffRequest) GetFromHeight() int64 {
+	if x != nil {
+		return x.FromHeight
+	}
+	return 0
+}
+
+func (x *EVMValidatorSetDiffRequest) GetToHeight() int64 {
+	if x != nil {
+		return x.ToHeight
+	}
+	return 0
+}
+
+// EVMValidatorSetDiffResponse is response type for the Service/EVMValidatorSetDiff RPC method.
+type EVMValidatorSetDiffResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from_block_height is the block height of the trusted validator set
+	FromBlockHeight int64 `protobuf:"varint,1,opt,name=from_block_height,json=fromBlockHeight,proto3" json:"from_block_height,omitempty"`
+	// to_block_height is the block height of the new validator set
+	ToBlockHeight int64 `protobuf:"varint,2,opt,name=to_block_height,json=toBlockHeight,proto3" json:"to_block_height,omitempty"`
+	// changes is the list of validators whose voting power is changed ordered by address. A validator
+	// with zero voting power is removed from the validator set.
+	Changes []*ValidatorMinimal `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	// validators is the new validator set in the order of the validator set hash of the block
+	Validators []*ValidatorMinimal `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators,omitempty"`
+	// proof is the proof that the new validator set is in the block signed by the trusted validator set
+	Proof *ValidatorSetTransitionProof `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *EVMValidatorSetDiffResponse) Reset() {
+	*x = EVMValidatorSetDiffResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_node_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EVMValidatorSetDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EVMValidatorSetDiffResponse) ProtoMessage() {}
+
+// Deprecated: Use EVMValidatorSetDiffResponse.ProtoReflect.Descriptor instead.
+func (*EVMValidatorSetDiffResponse) Descriptor() ([]byte, []int) {
+	return file_band_base_node_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EVMValidatorSetDiffResponse) GetFromBlockHeight() int64 {
+	if x != nil {
+		return x.FromBlockHeight
+	}
+	return 0
+}
+
+func (x *EVMValidatorSetDiffResponse) GetToBlockHeight() int64 {
+	if x != nil {
+		return x.ToBlockHeight
+	}
+	return 0
+}
+
+func (x *EVMValidatorSetDiffResponse) GetChanges() []*ValidatorMinimal {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *EVMValidatorSetDiffResponse) GetValidators() []*ValidatorMinimal {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *EVMValidatorSetDiffResponse) GetProof() *ValidatorSetTransitionProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+// ValidatorSetTransitionProof contains the block header of the new validator set and the signatures of
+// the block. The transition is authorized if the validators of the trusted validator set that signed the
+// block have more than 2/3 of its voting power.
+//
+// The validator set hash [7] of the block header is computed from the new validator set and combined with
+// [1C] and [6] to get [2B] of the block header merkle parts, see BlockHeaderMerkleParts.
+type ValidatorSetTransitionProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeaderMerkleParts       *v1.BlockHeaderMerkleParts `protobuf:"bytes,1,opt,name=block_header_merkle_parts,json=blockHeaderMerkleParts,proto3" json:"block_header_merkle_parts,omitempty"`
+	AppHash                      []byte                     `protobuf:"bytes,2,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
+	LastBlockIdAndLastCommitHash []byte                     `protobuf:"bytes,3,opt,name=last_block_id_and_last_commit_hash,json=lastBlockIdAndLastCommitHash,proto3" json:"last_block_id_and_last_commit_hash,omitempty"`
+	DataHash                     []byte                     `protobuf:"bytes,4,opt,name=data_hash,json=dataHash,proto3" json:"data_hash,omitempty"`
+	CommonEncodedVotePart        *v1.CommonEncodedVotePart  `protobuf:"bytes,5,opt,name=common_encoded_vote_part,json=commonEncodedVotePart,proto3" json:"common_encoded_vote_part,omitempty"`
+	Signatures                   []*v1.TMSignature          `protobuf:"bytes,6,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// signed_voting_power is the voting power of the trusted validator set that signed the block
+	SignedVotingPower int64 `protobuf:"varint,7,opt,name=signed_voting_power,json=signedVotingPower,proto3" json:"signed_voting_power,omitempty"`
+	// total_voting_power is the total voting power of the trusted validator set
+	TotalVotingPower int64 `protobuf:"varint,8,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+}
+
+func (x *ValidatorSetTransitionProof) Reset() {
+	*x = ValidatorSetTransitionProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_base_node_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorSetTransitionProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorSetTransitionProof) ProtoMessage() {}
+
+// Deprecated: Use ValidatorSetTransitionProof.ProtoReflect.Descriptor instead.
+func (*ValidatorSetTransitionProof) Descriptor() ([]byte, []int) {
+	return file_band_base_node_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ValidatorSetTransitionProof) GetBlockHeaderMerkleParts() *v1.BlockHeaderMerkleParts {
+	if x != nil {
+		return x.BlockHeaderMerkleParts
+	}
+	return nil
+}
+
+func (x *ValidatorSetTransitionProof) GetAppHash() []byte {
+	if x != nil {
+		return x.AppHash
+	}
+	return nil
+}
+
+func (x *ValidatorSetTransitionProof) GetLastBlockIdAndLastCommitHash() []byte {
+	if x != nil {
+		return x.LastBlockIdAndLastCommitHash
+	}
+	return nil
+}
+
+func (x *ValidatorSetTransitionProof) GetDataHash() []byte {
+	if x != nil {
+		return x.DataHash
+	}
+	return nil
+}
+
+func (x *ValidatorSetTransitionProof) GetCommonEncodedVotePart() *v1.CommonEncodedVotePart {
+	if x != nil {
+		return x.CommonEncodedVotePart
+	}
+	return nil
+}
+
+func (x *ValidatorSetTransitionProof) GetSignatures() []*v1.TMSignature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+func (x *ValidatorSetTransitionProof) GetSignedVotingPower() int64 {
+	if x != nil {
+		return x.SignedVotingPower
+	}
+	return 0
+}
+
+func (x *ValidatorSetTransitionProof) GetTotalVotingPower() int64 {
+	if x != nil {
+		return x.TotalVotingPower
+	}
+	return 0
+}
+
 var File_band_base_node_v1_query_proto protoreflect.FileDescriptor
 
 var file_band_base_node_v1_query_proto_rawDesc = []byte{
@@ -2358,57 +4979,148 @@ var file_band_base_node_v1_query_proto_rawDesc = []byte{
 	0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
 	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x10, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
-	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x39, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x08, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xe2,
-	0xde, 0x1f, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x45, 0x56, 0x4d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x15,
-	0x45, 0x56, 0x4d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x49, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62,
-	0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x61,
-	0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x73, 0x22, 0x4f, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50,
-	0x6f, 0x77, 0x65, 0x72, 0x32, 0x86, 0x02, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x70, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x21, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x62, 0x61, 0x73,
+	0x65, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x10, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x39, 0x0a, 0x0f, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x08,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b,
+	0xe2, 0xde, 0x1f, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x14, 0x45, 0x56, 0x4d, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x73, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x75, 0x6c, 0x6c, 0x53, 0x65, 0x74, 0x22,
+	0x85, 0x01, 0x0a, 0x15, 0x45, 0x56, 0x4d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x49, 0x0a, 0x0a,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f, 0x64,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x69,
+	0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x76, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x07, 0x70, 0x75, 0x62,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74,
+	0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62,
+	0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0x5a, 0x0a, 0x1a, 0x45, 0x56, 0x4d, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x72, 0x6f,
+	0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x6f, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0xcd, 0x02, 0x0a, 0x1b, 0x45, 0x56, 0x4d, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x6f, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x43, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x49, 0x0a,
+	0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f,
+	0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4d,
+	0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x4a, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x22, 0xc0, 0x05, 0x0a, 0x1b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x53, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x6c, 0x0a, 0x19, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50,
+	0x61, 0x72, 0x74, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x72,
+	0x74, 0x73, 0x12, 0x4f, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f,
+	0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x07, 0x61, 0x70, 0x70, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x7e, 0x0a, 0x22, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x69, 0x64, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66,
+	0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x1c, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x49, 0x64, 0x41, 0x6e, 0x64, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x51, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x34, 0xfa, 0xde, 0x1f, 0x30, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f,
+	0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x73, 0x2f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x69, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x61,
+	0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x74, 0x12, 0x46, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x4d, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x5f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x32, 0xa8, 0x03, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x70, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x21,
 	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x62, 0x61, 0x6e,
-	0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
-	0x69, 0x64, 0x12, 0x88, 0x01, 0x0a, 0x0d, 0x45, 0x56, 0x4d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x56, 0x4d, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x56, 0x4d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12,
-	0x1c, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x65,
-	0x76, 0x6d, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x42, 0xc9, 0x01,
-	0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64,
-	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6e, 0x6f,
-	0x64, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x42, 0x4e, 0xaa, 0x02, 0x11, 0x42, 0x61, 0x6e,
-	0x64, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x11, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x4e, 0x6f, 0x64, 0x65, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x1d, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x4e,
-	0x6f, 0x64, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x14, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x42, 0x61, 0x73, 0x65, 0x3a,
-	0x3a, 0x4e, 0x6f, 0x64, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f,
+	0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f,
+	0x62, 0x61, 0x6e, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x88, 0x01, 0x0a, 0x0d, 0x45, 0x56, 0x4d, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x56, 0x4d, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f, 0x64,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x56, 0x4d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76,
+	0x31, 0x2f, 0x65, 0x76, 0x6d, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x9f, 0x01, 0x0a, 0x13, 0x45, 0x56, 0x4d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x53, 0x65, 0x74, 0x44, 0x69, 0x66, 0x66, 0x12, 0x2d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x56, 0x4d,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x44, 0x69, 0x66, 0x66,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x56, 0x4d, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12,
+	0x21, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x65,
+	0x76, 0x6d, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x64, 0x69,
+	0x66, 0x66, 0x42, 0xc9, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
+	0x76, 0x31, 0x3b, 0x6e, 0x6f, 0x64, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x42, 0x4e, 0xaa,
+	0x02, 0x11, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c,
+	0x4e, 0x6f, 0x64, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x42,
+	0x61, 0x73, 0x65, 0x5c, 0x4e, 0x6f, 0x64, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a,
+	0x42, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x4e, 0x6f, 0x64, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2423,25 +5135,39 @@ func file_band_base_node_v1_query_proto_rawDescGZIP() []byte {
 	return file_band_base_node_v1_query_proto_rawDescData
 }
 
-var file_band_base_node_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_band_base_node_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_band_base_node_v1_query_proto_goTypes = []interface{}{
-	(*ChainIDRequest)(nil),        // 0: band.base.node.v1.ChainIDRequest
-	(*ChainIDResponse)(nil),       // 1: band.base.node.v1.ChainIDResponse
-	(*EVMValidatorsRequest)(nil),  // 2: band.base.node.v1.EVMValidatorsRequest
-	(*EVMValidatorsResponse)(nil), // 3: band.base.node.v1.EVMValidatorsResponse
-	(*ValidatorMinimal)(nil),      // 4: band.base.node.v1.ValidatorMinimal
+	(*ChainIDRequest)(nil),              // 0: band.base.node.v1.ChainIDRequest
+	(*ChainIDResponse)(nil),             // 1: band.base.node.v1.ChainIDResponse
+	(*EVMValidatorsRequest)(nil),        // 2: band.base.node.v1.EVMValidatorsRequest
+	(*EVMValidatorsResponse)(nil),       // 3: band.base.node.v1.EVMValidatorsResponse
+	(*ValidatorMinimal)(nil),            // 4: band.base.node.v1.ValidatorMinimal
+	(*EVMValidatorSetDiffRequest)(nil),  // 5: band.base.node.v1.EVMValidatorSetDiffRequest
+	(*EVMValidatorSetDiffResponse)(nil), // 6: band.base.node.v1.EVMValidatorSetDiffResponse
+	(*ValidatorSetTransitionProof)(nil), // 7: band.base.node.v1.ValidatorSetTransitionProof
+	(*v1.BlockHeaderMerkleParts)(nil),   // 8: band.base.oracle.v1.BlockHeaderMerkleParts
+	(*v1.CommonEncodedVotePart)(nil),    // 9: band.base.oracle.v1.CommonEncodedVotePart
+	(*v1.TMSignature)(nil),              // 10: band.base.oracle.v1.TMSignature
 }
 var file_band_base_node_v1_query_proto_depIdxs = []int32{
-	4, // 0: band.base.node.v1.EVMValidatorsResponse.validators:type_name -> band.base.node.v1.ValidatorMinimal
-	0, // 1: band.base.node.v1.Service.ChainID:input_type -> band.base.node.v1.ChainIDRequest
-	2, // 2: band.base.node.v1.Service.EVMValidators:input_type -> band.base.node.v1.EVMValidatorsRequest
-	1, // 3: band.base.node.v1.Service.ChainID:output_type -> band.base.node.v1.ChainIDResponse
-	3, // 4: band.base.node.v1.Service.EVMValidators:output_type -> band.base.node.v1.EVMValidatorsResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	4,  // 0: band.base.node.v1.EVMValidatorsResponse.validators:type_name -> band.base.node.v1.ValidatorMinimal
+	4,  // 1: band.base.node.v1.EVMValidatorSetDiffResponse.changes:type_name -> band.base.node.v1.ValidatorMinimal
+	4,  // 2: band.base.node.v1.EVMValidatorSetDiffResponse.validators:type_name -> band.base.node.v1.ValidatorMinimal
+	7,  // 3: band.base.node.v1.EVMValidatorSetDiffResponse.proof:type_name -> band.base.node.v1.ValidatorSetTransitionProof
+	8,  // 4: band.base.node.v1.ValidatorSetTransitionProof.block_header_merkle_parts:type_name -> band.base.oracle.v1.BlockHeaderMerkleParts
+	9,  // 5: band.base.node.v1.ValidatorSetTransitionProof.common_encoded_vote_part:type_name -> band.base.oracle.v1.CommonEncodedVotePart
+	10, // 6: band.base.node.v1.ValidatorSetTransitionProof.signatures:type_name -> band.base.oracle.v1.TMSignature
+	0,  // 7: band.base.node.v1.Service.ChainID:input_type -> band.base.node.v1.ChainIDRequest
+	2,  // 8: band.base.node.v1.Service.EVMValidators:input_type -> band.base.node.v1.EVMValidatorsRequest
+	5,  // 9: band.base.node.v1.Service.EVMValidatorSetDiff:input_type -> band.base.node.v1.EVMValidatorSetDiffRequest
+	1,  // 10: band.base.node.v1.Service.ChainID:output_type -> band.base.node.v1.ChainIDResponse
+	3,  // 11: band.base.node.v1.Service.EVMValidators:output_type -> band.base.node.v1.EVMValidatorsResponse
+	6,  // 12: band.base.node.v1.Service.EVMValidatorSetDiff:output_type -> band.base.node.v1.EVMValidatorSetDiffResponse
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_band_base_node_v1_query_proto_init() }
@@ -2510,6 +5236,42 @@ func file_band_base_node_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_band_base_node_v1_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EVMValidatorSetDiffRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_node_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EVMValidatorSetDiffResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_base_node_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorSetTransitionProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2517,7 +5279,7 @@ func file_band_base_node_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_base_node_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Service_ChainID_FullMethodName       = "/band.base.node.v1.Service/ChainID"
-	Service_EVMValidators_FullMethodName = "/band.base.node.v1.Service/EVMValidators"
+	Service_ChainID_FullMethodName             = "/band.base.node.v1.Service/ChainID"
+	Service_EVMValidators_FullMethodName       = "/band.base.node.v1.Service/EVMValidators"
+	Service_EVMValidatorSetDiff_FullMethodName = "/band.base.node.v1.Service/EVMValidatorSetDiff"
 )
 
 // ServiceClient is the client API for Service service.
@@ -29,8 +30,11 @@ const (
 type ServiceClient interface {
 	// ChainID queries the chain ID of this node
 	ChainID(ctx context.Context, in *ChainIDRequest, opts ...grpc.CallOption) (*ChainIDResponse, error)
-	// EVMValidators queries current list of validator's address and power
+	// EVMValidators queries list of validator's address and power at the given height
 	EVMValidators(ctx context.Context, in *EVMValidatorsRequest, opts ...grpc.CallOption) (*EVMValidatorsResponse, error)
+	// EVMValidatorSetDiff queries the changes of the validator set between two heights along with the
+	// signatures of the block at the later height to authorize the transition
+	EVMValidatorSetDiff(ctx context.Context, in *EVMValidatorSetDiffRequest, opts ...grpc.CallOption) (*EVMValidatorSetDiffResponse, error)
 }
 
 type serviceClient struct {
@@ -59,14 +63,26 @@ func (c *serviceClient) EVMValidators(ctx context.Context, in *EVMValidatorsRequ
 	return out, nil
 }
 
+func (c *serviceClient) EVMValidatorSetDiff(ctx context.Context, in *EVMValidatorSetDiffRequest, opts ...grpc.CallOption) (*EVMValidatorSetDiffResponse, error) {
+	out := new(EVMValidatorSetDiffResponse)
+	err := c.cc.Invoke(ctx, Service_EVMValidatorSetDiff_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
 type ServiceServer interface {
 	// ChainID queries the chain ID of this node
 	ChainID(context.Context, *ChainIDRequest) (*ChainIDResponse, error)
-	// EVMValidators queries current list of validator's address and power
+	// EVMValidators queries list of validator's address and power at the given height
 	EVMValidators(context.Context, *EVMValidatorsRequest) (*EVMValidatorsResponse, error)
+	// EVMValidatorSetDiff queries the changes of the validator set between two heights along with the
+	// signatures of the block at the later height to authorize the transition
+	EVMValidatorSetDiff(context.Context, *EVMValidatorSetDiffRequest) (*EVMValidatorSetDiffResponse, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -80,6 +96,9 @@ func (UnimplementedServiceServer) ChainID(context.Context, *ChainIDRequest) (*Ch
 func (UnimplementedServiceServer) EVMValidators(context.Context, *EVMValidatorsRequest) (*EVMValidatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EVMValidators not implemented")
 }
+func (UnimplementedServiceServer) EVMValidatorSetDiff(context.Context, *EVMValidatorSetDiffRequest) (*EVMValidatorSetDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EVMValidatorSetDiff not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +148,24 @@ func _Service_EVMValidators_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_EVMValidatorSetDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EVMValidatorSetDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).EVMValidatorSetDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_EVMValidatorSetDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).EVMValidatorSetDiff(ctx, req.(*EVMValidatorSetDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +181,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EVMValidators",
 			Handler:    _Service_EVMValidators_Handler,
 		},
+		{
+			MethodName: "EVMValidatorSetDiff",
+			Handler:    _Service_EVMValidatorSetDiff_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/base/node/v1/query.proto",
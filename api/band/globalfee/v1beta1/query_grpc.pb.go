@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName = "/band.globalfee.v1beta1.Query/Params"
+	Query_Params_FullMethodName              = "/band.globalfee.v1beta1.Query/Params"
+	Query_BaseGasPrice_FullMethodName        = "/band.globalfee.v1beta1.Query/BaseGasPrice"
+	Query_BaseGasPriceHistory_FullMethodName = "/band.globalfee.v1beta1.Query/BaseGasPriceHistory"
 )
 
 // QueryClient is the client API for Query service.
@@ -28,6 +30,10 @@ const (
 type QueryClient interface {
 	// Params queries parameters of globalfee module
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BaseGasPrice queries the base gas price of the next block
+	BaseGasPrice(ctx context.Context, in *QueryBaseGasPriceRequest, opts ...grpc.CallOption) (*QueryBaseGasPriceResponse, error)
+	// BaseGasPriceHistory queries the base gas prices and the gas used of the recent blocks
+	BaseGasPriceHistory(ctx context.Context, in *QueryBaseGasPriceHistoryRequest, opts ...grpc.CallOption) (*QueryBaseGasPriceHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -47,12 +53,34 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) BaseGasPrice(ctx context.Context, in *QueryBaseGasPriceRequest, opts ...grpc.CallOption) (*QueryBaseGasPriceResponse, error) {
+	out := new(QueryBaseGasPriceResponse)
+	err := c.cc.Invoke(ctx, Query_BaseGasPrice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseGasPriceHistory(ctx context.Context, in *QueryBaseGasPriceHistoryRequest, opts ...grpc.CallOption) (*QueryBaseGasPriceHistoryResponse, error) {
+	out := new(QueryBaseGasPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_BaseGasPriceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Params queries parameters of globalfee module
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BaseGasPrice queries the base gas price of the next block
+	BaseGasPrice(context.Context, *QueryBaseGasPriceRequest) (*QueryBaseGasPriceResponse, error)
+	// BaseGasPriceHistory queries the base gas prices and the gas used of the recent blocks
+	BaseGasPriceHistory(context.Context, *QueryBaseGasPriceHistoryRequest) (*QueryBaseGasPriceHistoryResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -63,6 +91,12 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) BaseGasPrice(context.Context, *QueryBaseGasPriceRequest) (*QueryBaseGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseGasPrice not implemented")
+}
+func (UnimplementedQueryServer) BaseGasPriceHistory(context.Context, *QueryBaseGasPriceHistoryRequest) (*QueryBaseGasPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseGasPriceHistory not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +128,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BaseGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BaseGasPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseGasPrice(ctx, req.(*QueryBaseGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseGasPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseGasPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseGasPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BaseGasPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseGasPriceHistory(ctx, req.(*QueryBaseGasPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +175,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "BaseGasPrice",
+			Handler:    _Query_BaseGasPrice_Handler,
+		},
+		{
+			MethodName: "BaseGasPriceHistory",
+			Handler:    _Query_BaseGasPriceHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/globalfee/v1beta1/query.proto",
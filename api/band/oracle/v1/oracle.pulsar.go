@@ -4,6 +4,7 @@ package oraclev1
 import (
 	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -2768,6 +2769,7 @@ var (
 	fd_Request_tss_encoder          protoreflect.FieldDescriptor
 	fd_Request_requester            protoreflect.FieldDescriptor
 	fd_Request_fee_limit            protoreflect.FieldDescriptor
+	fd_Request_callback             protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2786,6 +2788,7 @@ func init() {
 	fd_Request_tss_encoder = md_Request.Fields().ByName("tss_encoder")
 	fd_Request_requester = md_Request.Fields().ByName("requester")
 	fd_Request_fee_limit = md_Request.Fields().ByName("fee_limit")
+	fd_Request_callback = md_Request.Fields().ByName("callback")
 }
 
 var _ protoreflect.Message = (*fastReflection_Request)(nil)
@@ -2931,6 +2934,12 @@ func (x *fastReflection_Request) Range(f func(protoreflect.FieldDescriptor, prot
 			return
 		}
 	}
+	if x.Callback != "" {
+		value := protoreflect.ValueOfString(x.Callback)
+		if !f(fd_Request_callback, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2972,6 +2981,8 @@ func (x *fastReflection_Request) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Requester != ""
 	case "band.oracle.v1.Request.fee_limit":
 		return len(x.FeeLimit) != 0
+	case "band.oracle.v1.Request.callback":
+		return x.Callback != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
@@ -3014,6 +3025,8 @@ func (x *fastReflection_Request) Clear(fd protoreflect.FieldDescriptor) {
 		x.Requester = ""
 	case "band.oracle.v1.Request.fee_limit":
 		x.FeeLimit = nil
+	case "band.oracle.v1.Request.callback":
+		x.Callback = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
@@ -3078,6 +3091,9 @@ func (x *fastReflection_Request) Get(descriptor protoreflect.FieldDescriptor) pr
 		}
 		listValue := &_Request_13_list{list: &x.FeeLimit}
 		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.Request.callback":
+		value := x.Callback
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
@@ -3130,6 +3146,8 @@ func (x *fastReflection_Request) Set(fd protoreflect.FieldDescriptor, value prot
 		lv := value.List()
 		clv := lv.(*_Request_13_list)
 		x.FeeLimit = *clv.list
+	case "band.oracle.v1.Request.callback":
+		x.Callback = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
@@ -3191,6 +3209,8 @@ func (x *fastReflection_Request) Mutable(fd protoreflect.FieldDescriptor) protor
 		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.Request is not mutable"))
 	case "band.oracle.v1.Request.requester":
 		panic(fmt.Errorf("field requester of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.callback":
+		panic(fmt.Errorf("field callback of message band.oracle.v1.Request is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
@@ -3234,6 +3254,8 @@ func (x *fastReflection_Request) NewField(fd protoreflect.FieldDescriptor) proto
 	case "band.oracle.v1.Request.fee_limit":
 		list := []*v1beta1.Coin{}
 		return protoreflect.ValueOfList(&_Request_13_list{list: &list})
+	case "band.oracle.v1.Request.callback":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
@@ -3355,6 +3377,10 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.Callback)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3384,6 +3410,13 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Callback) > 0 {
+			i -= len(x.Callback)
+			copy(dAtA[i:], x.Callback)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Callback)))
+			i--
+			dAtA[i] = 0x72
+		}
 		if len(x.FeeLimit) > 0 {
 			for iNdEx := len(x.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.FeeLimit[iNdEx])
@@ -3887,6 +3920,38 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Callback = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3922,81 +3987,83 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Report_3_list)(nil)
+var _ protoreflect.List = (*_RawRequestFee_4_list)(nil)
 
-type _Report_3_list struct {
-	list *[]*RawReport
+type _RawRequestFee_4_list struct {
+	list *[]*v1beta1.Coin
 }
 
-func (x *_Report_3_list) Len() int {
+func (x *_RawRequestFee_4_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Report_3_list) Get(i int) protoreflect.Value {
+func (x *_RawRequestFee_4_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Report_3_list) Set(i int, value protoreflect.Value) {
+func (x *_RawRequestFee_4_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*RawReport)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Report_3_list) Append(value protoreflect.Value) {
+func (x *_RawRequestFee_4_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*RawReport)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Report_3_list) AppendMutable() protoreflect.Value {
-	v := new(RawReport)
+func (x *_RawRequestFee_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Report_3_list) Truncate(n int) {
+func (x *_RawRequestFee_4_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Report_3_list) NewElement() protoreflect.Value {
-	v := new(RawReport)
+func (x *_RawRequestFee_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Report_3_list) IsValid() bool {
+func (x *_RawRequestFee_4_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Report                   protoreflect.MessageDescriptor
-	fd_Report_validator         protoreflect.FieldDescriptor
-	fd_Report_in_before_resolve protoreflect.FieldDescriptor
-	fd_Report_raw_reports       protoreflect.FieldDescriptor
+	md_RawRequestFee                protoreflect.MessageDescriptor
+	fd_RawRequestFee_external_id    protoreflect.FieldDescriptor
+	fd_RawRequestFee_data_source_id protoreflect.FieldDescriptor
+	fd_RawRequestFee_treasury       protoreflect.FieldDescriptor
+	fd_RawRequestFee_fee            protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_Report = File_band_oracle_v1_oracle_proto.Messages().ByName("Report")
-	fd_Report_validator = md_Report.Fields().ByName("validator")
-	fd_Report_in_before_resolve = md_Report.Fields().ByName("in_before_resolve")
-	fd_Report_raw_reports = md_Report.Fields().ByName("raw_reports")
+	md_RawRequestFee = File_band_oracle_v1_oracle_proto.Messages().ByName("RawRequestFee")
+	fd_RawRequestFee_external_id = md_RawRequestFee.Fields().ByName("external_id")
+	fd_RawRequestFee_data_source_id = md_RawRequestFee.Fields().ByName("data_source_id")
+	fd_RawRequestFee_treasury = md_RawRequestFee.Fields().ByName("treasury")
+	fd_RawRequestFee_fee = md_RawRequestFee.Fields().ByName("fee")
 }
 
-var _ protoreflect.Message = (*fastReflection_Report)(nil)
+var _ protoreflect.Message = (*fastReflection_RawRequestFee)(nil)
 
-type fastReflection_Report Report
+type fastReflection_RawRequestFee RawRequestFee
 
-func (x *Report) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Report)(x)
+func (x *RawRequestFee) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RawRequestFee)(x)
 }
 
-func (x *Report) slowProtoReflect() protoreflect.Message {
+func (x *RawRequestFee) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4008,43 +4075,43 @@ func (x *Report) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Report_messageType fastReflection_Report_messageType
-var _ protoreflect.MessageType = fastReflection_Report_messageType{}
+var _fastReflection_RawRequestFee_messageType fastReflection_RawRequestFee_messageType
+var _ protoreflect.MessageType = fastReflection_RawRequestFee_messageType{}
 
-type fastReflection_Report_messageType struct{}
+type fastReflection_RawRequestFee_messageType struct{}
 
-func (x fastReflection_Report_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Report)(nil)
+func (x fastReflection_RawRequestFee_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RawRequestFee)(nil)
 }
-func (x fastReflection_Report_messageType) New() protoreflect.Message {
-	return new(fastReflection_Report)
+func (x fastReflection_RawRequestFee_messageType) New() protoreflect.Message {
+	return new(fastReflection_RawRequestFee)
 }
-func (x fastReflection_Report_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Report
+func (x fastReflection_RawRequestFee_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RawRequestFee
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Report) Descriptor() protoreflect.MessageDescriptor {
-	return md_Report
+func (x *fastReflection_RawRequestFee) Descriptor() protoreflect.MessageDescriptor {
+	return md_RawRequestFee
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Report) Type() protoreflect.MessageType {
-	return _fastReflection_Report_messageType
+func (x *fastReflection_RawRequestFee) Type() protoreflect.MessageType {
+	return _fastReflection_RawRequestFee_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Report) New() protoreflect.Message {
-	return new(fastReflection_Report)
+func (x *fastReflection_RawRequestFee) New() protoreflect.Message {
+	return new(fastReflection_RawRequestFee)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Report) Interface() protoreflect.ProtoMessage {
-	return (*Report)(x)
+func (x *fastReflection_RawRequestFee) Interface() protoreflect.ProtoMessage {
+	return (*RawRequestFee)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4052,22 +4119,28 @@ func (x *fastReflection_Report) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Report) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Validator != "" {
-		value := protoreflect.ValueOfString(x.Validator)
-		if !f(fd_Report_validator, value) {
+func (x *fastReflection_RawRequestFee) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExternalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExternalId)
+		if !f(fd_RawRequestFee_external_id, value) {
 			return
 		}
 	}
-	if x.InBeforeResolve != false {
-		value := protoreflect.ValueOfBool(x.InBeforeResolve)
-		if !f(fd_Report_in_before_resolve, value) {
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_RawRequestFee_data_source_id, value) {
 			return
 		}
 	}
-	if len(x.RawReports) != 0 {
-		value := protoreflect.ValueOfList(&_Report_3_list{list: &x.RawReports})
-		if !f(fd_Report_raw_reports, value) {
+	if x.Treasury != "" {
+		value := protoreflect.ValueOfString(x.Treasury)
+		if !f(fd_RawRequestFee_treasury, value) {
+			return
+		}
+	}
+	if len(x.Fee) != 0 {
+		value := protoreflect.ValueOfList(&_RawRequestFee_4_list{list: &x.Fee})
+		if !f(fd_RawRequestFee_fee, value) {
 			return
 		}
 	}
@@ -4084,19 +4157,21 @@ func (x *fastReflection_Report) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Report) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RawRequestFee) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.Report.validator":
-		return x.Validator != ""
-	case "band.oracle.v1.Report.in_before_resolve":
-		return x.InBeforeResolve != false
-	case "band.oracle.v1.Report.raw_reports":
-		return len(x.RawReports) != 0
+	case "band.oracle.v1.RawRequestFee.external_id":
+		return x.ExternalId != uint64(0)
+	case "band.oracle.v1.RawRequestFee.data_source_id":
+		return x.DataSourceId != uint64(0)
+	case "band.oracle.v1.RawRequestFee.treasury":
+		return x.Treasury != ""
+	case "band.oracle.v1.RawRequestFee.fee":
+		return len(x.Fee) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequestFee"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequestFee does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4106,19 +4181,21 @@ func (x *fastReflection_Report) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Report) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RawRequestFee) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Report.validator":
-		x.Validator = ""
-	case "band.oracle.v1.Report.in_before_resolve":
-		x.InBeforeResolve = false
-	case "band.oracle.v1.Report.raw_reports":
-		x.RawReports = nil
+	case "band.oracle.v1.RawRequestFee.external_id":
+		x.ExternalId = uint64(0)
+	case "band.oracle.v1.RawRequestFee.data_source_id":
+		x.DataSourceId = uint64(0)
+	case "band.oracle.v1.RawRequestFee.treasury":
+		x.Treasury = ""
+	case "band.oracle.v1.RawRequestFee.fee":
+		x.Fee = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequestFee"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequestFee does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4128,25 +4205,28 @@ func (x *fastReflection_Report) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Report) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RawRequestFee) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.Report.validator":
-		value := x.Validator
+	case "band.oracle.v1.RawRequestFee.external_id":
+		value := x.ExternalId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RawRequestFee.data_source_id":
+		value := x.DataSourceId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RawRequestFee.treasury":
+		value := x.Treasury
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.Report.in_before_resolve":
-		value := x.InBeforeResolve
-		return protoreflect.ValueOfBool(value)
-	case "band.oracle.v1.Report.raw_reports":
-		if len(x.RawReports) == 0 {
-			return protoreflect.ValueOfList(&_Report_3_list{})
+	case "band.oracle.v1.RawRequestFee.fee":
+		if len(x.Fee) == 0 {
+			return protoreflect.ValueOfList(&_RawRequestFee_4_list{})
 		}
-		listValue := &_Report_3_list{list: &x.RawReports}
+		listValue := &_RawRequestFee_4_list{list: &x.Fee}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequestFee"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequestFee does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4160,21 +4240,23 @@ func (x *fastReflection_Report) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Report) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RawRequestFee) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Report.validator":
-		x.Validator = value.Interface().(string)
-	case "band.oracle.v1.Report.in_before_resolve":
-		x.InBeforeResolve = value.Bool()
-	case "band.oracle.v1.Report.raw_reports":
+	case "band.oracle.v1.RawRequestFee.external_id":
+		x.ExternalId = value.Uint()
+	case "band.oracle.v1.RawRequestFee.data_source_id":
+		x.DataSourceId = value.Uint()
+	case "band.oracle.v1.RawRequestFee.treasury":
+		x.Treasury = value.Interface().(string)
+	case "band.oracle.v1.RawRequestFee.fee":
 		lv := value.List()
-		clv := lv.(*_Report_3_list)
-		x.RawReports = *clv.list
+		clv := lv.(*_RawRequestFee_4_list)
+		x.Fee = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequestFee"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequestFee does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4188,53 +4270,57 @@ func (x *fastReflection_Report) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Report) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RawRequestFee) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Report.raw_reports":
-		if x.RawReports == nil {
-			x.RawReports = []*RawReport{}
+	case "band.oracle.v1.RawRequestFee.fee":
+		if x.Fee == nil {
+			x.Fee = []*v1beta1.Coin{}
 		}
-		value := &_Report_3_list{list: &x.RawReports}
+		value := &_RawRequestFee_4_list{list: &x.Fee}
 		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.Report.validator":
-		panic(fmt.Errorf("field validator of message band.oracle.v1.Report is not mutable"))
-	case "band.oracle.v1.Report.in_before_resolve":
-		panic(fmt.Errorf("field in_before_resolve of message band.oracle.v1.Report is not mutable"))
+	case "band.oracle.v1.RawRequestFee.external_id":
+		panic(fmt.Errorf("field external_id of message band.oracle.v1.RawRequestFee is not mutable"))
+	case "band.oracle.v1.RawRequestFee.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.RawRequestFee is not mutable"))
+	case "band.oracle.v1.RawRequestFee.treasury":
+		panic(fmt.Errorf("field treasury of message band.oracle.v1.RawRequestFee is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequestFee"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequestFee does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Report) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RawRequestFee) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Report.validator":
+	case "band.oracle.v1.RawRequestFee.external_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RawRequestFee.data_source_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RawRequestFee.treasury":
 		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.Report.in_before_resolve":
-		return protoreflect.ValueOfBool(false)
-	case "band.oracle.v1.Report.raw_reports":
-		list := []*RawReport{}
-		return protoreflect.ValueOfList(&_Report_3_list{list: &list})
+	case "band.oracle.v1.RawRequestFee.fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_RawRequestFee_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequestFee"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequestFee does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Report) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RawRequestFee) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Report", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RawRequestFee", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4242,7 +4328,7 @@ func (x *fastReflection_Report) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Report) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RawRequestFee) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4253,7 +4339,7 @@ func (x *fastReflection_Report) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Report) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RawRequestFee) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4265,7 +4351,7 @@ func (x *fastReflection_Report) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Report) IsValid() bool {
+func (x *fastReflection_RawRequestFee) IsValid() bool {
 	return x != nil
 }
 
@@ -4275,9 +4361,9 @@ func (x *fastReflection_Report) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RawRequestFee) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Report)
+		x := input.Message.Interface().(*RawRequestFee)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4289,15 +4375,18 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Validator)
+		if x.ExternalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExternalId))
+		}
+		if x.DataSourceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceId))
+		}
+		l = len(x.Treasury)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.InBeforeResolve {
-			n += 2
-		}
-		if len(x.RawReports) > 0 {
-			for _, e := range x.RawReports {
+		if len(x.Fee) > 0 {
+			for _, e := range x.Fee {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -4312,7 +4401,7 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Report)
+		x := input.Message.Interface().(*RawRequestFee)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4331,9 +4420,9 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.RawReports) > 0 {
-			for iNdEx := len(x.RawReports) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.RawReports[iNdEx])
+		if len(x.Fee) > 0 {
+			for iNdEx := len(x.Fee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Fee[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4344,25 +4433,25 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x1a
+				dAtA[i] = 0x22
 			}
 		}
-		if x.InBeforeResolve {
+		if len(x.Treasury) > 0 {
+			i -= len(x.Treasury)
+			copy(dAtA[i:], x.Treasury)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Treasury)))
 			i--
-			if x.InBeforeResolve {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+			dAtA[i] = 0x1a
+		}
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.Validator) > 0 {
-			i -= len(x.Validator)
-			copy(dAtA[i:], x.Validator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+		if x.ExternalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4375,7 +4464,7 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Report)
+		x := input.Message.Interface().(*RawRequestFee)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4407,17 +4496,17 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Report: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawRequestFee: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawRequestFee: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 				}
-				var stringLen uint64
+				x.ExternalId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4427,29 +4516,16 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.ExternalId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Validator = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InBeforeResolve", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
 				}
-				var v int
+				x.DataSourceId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4459,15 +4535,46 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					x.DataSourceId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.InBeforeResolve = bool(v != 0)
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Treasury = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4494,8 +4601,8 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RawReports = append(x.RawReports, &RawReport{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RawReports[len(x.RawReports)-1]); err != nil {
+				x.Fee = append(x.Fee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee[len(x.Fee)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4534,93 +4641,134 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_OracleRequestPacketData_6_list)(nil)
+var _ protoreflect.List = (*_FeeEscrow_3_list)(nil)
 
-type _OracleRequestPacketData_6_list struct {
+type _FeeEscrow_3_list struct {
+	list *[]*RawRequestFee
+}
+
+func (x *_FeeEscrow_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeEscrow_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeEscrow_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawRequestFee)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeEscrow_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawRequestFee)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeEscrow_3_list) AppendMutable() protoreflect.Value {
+	v := new(RawRequestFee)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeEscrow_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeEscrow_3_list) NewElement() protoreflect.Value {
+	v := new(RawRequestFee)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeEscrow_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FeeEscrow_4_list)(nil)
+
+type _FeeEscrow_4_list struct {
 	list *[]*v1beta1.Coin
 }
 
-func (x *_OracleRequestPacketData_6_list) Len() int {
+func (x *_FeeEscrow_4_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_OracleRequestPacketData_6_list) Get(i int) protoreflect.Value {
+func (x *_FeeEscrow_4_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_OracleRequestPacketData_6_list) Set(i int, value protoreflect.Value) {
+func (x *_FeeEscrow_4_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_OracleRequestPacketData_6_list) Append(value protoreflect.Value) {
+func (x *_FeeEscrow_4_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_OracleRequestPacketData_6_list) AppendMutable() protoreflect.Value {
+func (x *_FeeEscrow_4_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_OracleRequestPacketData_6_list) Truncate(n int) {
+func (x *_FeeEscrow_4_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_OracleRequestPacketData_6_list) NewElement() protoreflect.Value {
+func (x *_FeeEscrow_4_list) NewElement() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_OracleRequestPacketData_6_list) IsValid() bool {
+func (x *_FeeEscrow_4_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_OracleRequestPacketData                  protoreflect.MessageDescriptor
-	fd_OracleRequestPacketData_client_id        protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_oracle_script_id protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_calldata         protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_ask_count        protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_min_count        protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_fee_limit        protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_prepare_gas      protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_execute_gas      protoreflect.FieldDescriptor
-	fd_OracleRequestPacketData_tss_encoder      protoreflect.FieldDescriptor
+	md_FeeEscrow                  protoreflect.MessageDescriptor
+	fd_FeeEscrow_request_id       protoreflect.FieldDescriptor
+	fd_FeeEscrow_payer            protoreflect.FieldDescriptor
+	fd_FeeEscrow_raw_request_fees protoreflect.FieldDescriptor
+	fd_FeeEscrow_remaining        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_OracleRequestPacketData = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleRequestPacketData")
-	fd_OracleRequestPacketData_client_id = md_OracleRequestPacketData.Fields().ByName("client_id")
-	fd_OracleRequestPacketData_oracle_script_id = md_OracleRequestPacketData.Fields().ByName("oracle_script_id")
-	fd_OracleRequestPacketData_calldata = md_OracleRequestPacketData.Fields().ByName("calldata")
-	fd_OracleRequestPacketData_ask_count = md_OracleRequestPacketData.Fields().ByName("ask_count")
-	fd_OracleRequestPacketData_min_count = md_OracleRequestPacketData.Fields().ByName("min_count")
-	fd_OracleRequestPacketData_fee_limit = md_OracleRequestPacketData.Fields().ByName("fee_limit")
-	fd_OracleRequestPacketData_prepare_gas = md_OracleRequestPacketData.Fields().ByName("prepare_gas")
-	fd_OracleRequestPacketData_execute_gas = md_OracleRequestPacketData.Fields().ByName("execute_gas")
-	fd_OracleRequestPacketData_tss_encoder = md_OracleRequestPacketData.Fields().ByName("tss_encoder")
+	md_FeeEscrow = File_band_oracle_v1_oracle_proto.Messages().ByName("FeeEscrow")
+	fd_FeeEscrow_request_id = md_FeeEscrow.Fields().ByName("request_id")
+	fd_FeeEscrow_payer = md_FeeEscrow.Fields().ByName("payer")
+	fd_FeeEscrow_raw_request_fees = md_FeeEscrow.Fields().ByName("raw_request_fees")
+	fd_FeeEscrow_remaining = md_FeeEscrow.Fields().ByName("remaining")
 }
 
-var _ protoreflect.Message = (*fastReflection_OracleRequestPacketData)(nil)
+var _ protoreflect.Message = (*fastReflection_FeeEscrow)(nil)
 
-type fastReflection_OracleRequestPacketData OracleRequestPacketData
+type fastReflection_FeeEscrow FeeEscrow
 
-func (x *OracleRequestPacketData) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_OracleRequestPacketData)(x)
+func (x *FeeEscrow) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeEscrow)(x)
 }
 
-func (x *OracleRequestPacketData) slowProtoReflect() protoreflect.Message {
+func (x *FeeEscrow) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4632,43 +4780,43 @@ func (x *OracleRequestPacketData) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_OracleRequestPacketData_messageType fastReflection_OracleRequestPacketData_messageType
-var _ protoreflect.MessageType = fastReflection_OracleRequestPacketData_messageType{}
+var _fastReflection_FeeEscrow_messageType fastReflection_FeeEscrow_messageType
+var _ protoreflect.MessageType = fastReflection_FeeEscrow_messageType{}
 
-type fastReflection_OracleRequestPacketData_messageType struct{}
+type fastReflection_FeeEscrow_messageType struct{}
 
-func (x fastReflection_OracleRequestPacketData_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_OracleRequestPacketData)(nil)
+func (x fastReflection_FeeEscrow_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeEscrow)(nil)
 }
-func (x fastReflection_OracleRequestPacketData_messageType) New() protoreflect.Message {
-	return new(fastReflection_OracleRequestPacketData)
+func (x fastReflection_FeeEscrow_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeEscrow)
 }
-func (x fastReflection_OracleRequestPacketData_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleRequestPacketData
+func (x fastReflection_FeeEscrow_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeEscrow
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_OracleRequestPacketData) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleRequestPacketData
+func (x *fastReflection_FeeEscrow) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeEscrow
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_OracleRequestPacketData) Type() protoreflect.MessageType {
-	return _fastReflection_OracleRequestPacketData_messageType
+func (x *fastReflection_FeeEscrow) Type() protoreflect.MessageType {
+	return _fastReflection_FeeEscrow_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_OracleRequestPacketData) New() protoreflect.Message {
-	return new(fastReflection_OracleRequestPacketData)
+func (x *fastReflection_FeeEscrow) New() protoreflect.Message {
+	return new(fastReflection_FeeEscrow)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_OracleRequestPacketData) Interface() protoreflect.ProtoMessage {
-	return (*OracleRequestPacketData)(x)
+func (x *fastReflection_FeeEscrow) Interface() protoreflect.ProtoMessage {
+	return (*FeeEscrow)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4676,58 +4824,28 @@ func (x *fastReflection_OracleRequestPacketData) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_OracleRequestPacketData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ClientId != "" {
-		value := protoreflect.ValueOfString(x.ClientId)
-		if !f(fd_OracleRequestPacketData_client_id, value) {
-			return
-		}
-	}
-	if x.OracleScriptId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OracleScriptId)
-		if !f(fd_OracleRequestPacketData_oracle_script_id, value) {
-			return
-		}
-	}
-	if len(x.Calldata) != 0 {
-		value := protoreflect.ValueOfBytes(x.Calldata)
-		if !f(fd_OracleRequestPacketData_calldata, value) {
-			return
-		}
-	}
-	if x.AskCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.AskCount)
-		if !f(fd_OracleRequestPacketData_ask_count, value) {
-			return
-		}
-	}
-	if x.MinCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MinCount)
-		if !f(fd_OracleRequestPacketData_min_count, value) {
-			return
-		}
-	}
-	if len(x.FeeLimit) != 0 {
-		value := protoreflect.ValueOfList(&_OracleRequestPacketData_6_list{list: &x.FeeLimit})
-		if !f(fd_OracleRequestPacketData_fee_limit, value) {
+func (x *fastReflection_FeeEscrow) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_FeeEscrow_request_id, value) {
 			return
 		}
 	}
-	if x.PrepareGas != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.PrepareGas)
-		if !f(fd_OracleRequestPacketData_prepare_gas, value) {
+	if x.Payer != "" {
+		value := protoreflect.ValueOfString(x.Payer)
+		if !f(fd_FeeEscrow_payer, value) {
 			return
 		}
 	}
-	if x.ExecuteGas != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExecuteGas)
-		if !f(fd_OracleRequestPacketData_execute_gas, value) {
+	if len(x.RawRequestFees) != 0 {
+		value := protoreflect.ValueOfList(&_FeeEscrow_3_list{list: &x.RawRequestFees})
+		if !f(fd_FeeEscrow_raw_request_fees, value) {
 			return
 		}
 	}
-	if x.TssEncoder != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TssEncoder))
-		if !f(fd_OracleRequestPacketData_tss_encoder, value) {
+	if len(x.Remaining) != 0 {
+		value := protoreflect.ValueOfList(&_FeeEscrow_4_list{list: &x.Remaining})
+		if !f(fd_FeeEscrow_remaining, value) {
 			return
 		}
 	}
@@ -4744,31 +4862,21 @@ func (x *fastReflection_OracleRequestPacketData) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_OracleRequestPacketData) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_FeeEscrow) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketData.client_id":
-		return x.ClientId != ""
-	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
-		return x.OracleScriptId != uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.calldata":
-		return len(x.Calldata) != 0
-	case "band.oracle.v1.OracleRequestPacketData.ask_count":
-		return x.AskCount != uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.min_count":
-		return x.MinCount != uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
-		return len(x.FeeLimit) != 0
-	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
-		return x.PrepareGas != uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
-		return x.ExecuteGas != uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
-		return x.TssEncoder != 0
+	case "band.oracle.v1.FeeEscrow.request_id":
+		return x.RequestId != uint64(0)
+	case "band.oracle.v1.FeeEscrow.payer":
+		return x.Payer != ""
+	case "band.oracle.v1.FeeEscrow.raw_request_fees":
+		return len(x.RawRequestFees) != 0
+	case "band.oracle.v1.FeeEscrow.remaining":
+		return len(x.Remaining) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeEscrow"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.FeeEscrow does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4778,31 +4886,21 @@ func (x *fastReflection_OracleRequestPacketData) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketData) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_FeeEscrow) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketData.client_id":
-		x.ClientId = ""
-	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
-		x.OracleScriptId = uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.calldata":
-		x.Calldata = nil
-	case "band.oracle.v1.OracleRequestPacketData.ask_count":
-		x.AskCount = uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.min_count":
-		x.MinCount = uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
-		x.FeeLimit = nil
-	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
-		x.PrepareGas = uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
-		x.ExecuteGas = uint64(0)
-	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
-		x.TssEncoder = 0
+	case "band.oracle.v1.FeeEscrow.request_id":
+		x.RequestId = uint64(0)
+	case "band.oracle.v1.FeeEscrow.payer":
+		x.Payer = ""
+	case "band.oracle.v1.FeeEscrow.raw_request_fees":
+		x.RawRequestFees = nil
+	case "band.oracle.v1.FeeEscrow.remaining":
+		x.Remaining = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeEscrow"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.FeeEscrow does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4812,43 +4910,31 @@ func (x *fastReflection_OracleRequestPacketData) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_OracleRequestPacketData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeEscrow) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.OracleRequestPacketData.client_id":
-		value := x.ClientId
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
-		value := x.OracleScriptId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleRequestPacketData.calldata":
-		value := x.Calldata
-		return protoreflect.ValueOfBytes(value)
-	case "band.oracle.v1.OracleRequestPacketData.ask_count":
-		value := x.AskCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleRequestPacketData.min_count":
-		value := x.MinCount
+	case "band.oracle.v1.FeeEscrow.request_id":
+		value := x.RequestId
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
-		if len(x.FeeLimit) == 0 {
-			return protoreflect.ValueOfList(&_OracleRequestPacketData_6_list{})
+	case "band.oracle.v1.FeeEscrow.payer":
+		value := x.Payer
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.FeeEscrow.raw_request_fees":
+		if len(x.RawRequestFees) == 0 {
+			return protoreflect.ValueOfList(&_FeeEscrow_3_list{})
 		}
-		listValue := &_OracleRequestPacketData_6_list{list: &x.FeeLimit}
+		listValue := &_FeeEscrow_3_list{list: &x.RawRequestFees}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.FeeEscrow.remaining":
+		if len(x.Remaining) == 0 {
+			return protoreflect.ValueOfList(&_FeeEscrow_4_list{})
+		}
+		listValue := &_FeeEscrow_4_list{list: &x.Remaining}
 		return protoreflect.ValueOfList(listValue)
-	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
-		value := x.PrepareGas
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
-		value := x.ExecuteGas
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
-		value := x.TssEncoder
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeEscrow"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.FeeEscrow does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4862,33 +4948,25 @@ func (x *fastReflection_OracleRequestPacketData) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_FeeEscrow) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketData.client_id":
-		x.ClientId = value.Interface().(string)
-	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
-		x.OracleScriptId = value.Uint()
-	case "band.oracle.v1.OracleRequestPacketData.calldata":
-		x.Calldata = value.Bytes()
-	case "band.oracle.v1.OracleRequestPacketData.ask_count":
-		x.AskCount = value.Uint()
-	case "band.oracle.v1.OracleRequestPacketData.min_count":
-		x.MinCount = value.Uint()
-	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
+	case "band.oracle.v1.FeeEscrow.request_id":
+		x.RequestId = value.Uint()
+	case "band.oracle.v1.FeeEscrow.payer":
+		x.Payer = value.Interface().(string)
+	case "band.oracle.v1.FeeEscrow.raw_request_fees":
 		lv := value.List()
-		clv := lv.(*_OracleRequestPacketData_6_list)
-		x.FeeLimit = *clv.list
-	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
-		x.PrepareGas = value.Uint()
-	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
-		x.ExecuteGas = value.Uint()
-	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
-		x.TssEncoder = (Encoder)(value.Enum())
+		clv := lv.(*_FeeEscrow_3_list)
+		x.RawRequestFees = *clv.list
+	case "band.oracle.v1.FeeEscrow.remaining":
+		lv := value.List()
+		clv := lv.(*_FeeEscrow_4_list)
+		x.Remaining = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeEscrow"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.FeeEscrow does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4902,77 +4980,62 @@ func (x *fastReflection_OracleRequestPacketData) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeEscrow) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
-		if x.FeeLimit == nil {
-			x.FeeLimit = []*v1beta1.Coin{}
+	case "band.oracle.v1.FeeEscrow.raw_request_fees":
+		if x.RawRequestFees == nil {
+			x.RawRequestFees = []*RawRequestFee{}
 		}
-		value := &_OracleRequestPacketData_6_list{list: &x.FeeLimit}
+		value := &_FeeEscrow_3_list{list: &x.RawRequestFees}
 		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.OracleRequestPacketData.client_id":
-		panic(fmt.Errorf("field client_id of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
-		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.calldata":
-		panic(fmt.Errorf("field calldata of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.ask_count":
-		panic(fmt.Errorf("field ask_count of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.min_count":
-		panic(fmt.Errorf("field min_count of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
-		panic(fmt.Errorf("field prepare_gas of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
-		panic(fmt.Errorf("field execute_gas of message band.oracle.v1.OracleRequestPacketData is not mutable"))
-	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
-		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.FeeEscrow.remaining":
+		if x.Remaining == nil {
+			x.Remaining = []*v1beta1.Coin{}
+		}
+		value := &_FeeEscrow_4_list{list: &x.Remaining}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.FeeEscrow.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.FeeEscrow is not mutable"))
+	case "band.oracle.v1.FeeEscrow.payer":
+		panic(fmt.Errorf("field payer of message band.oracle.v1.FeeEscrow is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeEscrow"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.FeeEscrow does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_OracleRequestPacketData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeEscrow) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketData.client_id":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleRequestPacketData.calldata":
-		return protoreflect.ValueOfBytes(nil)
-	case "band.oracle.v1.OracleRequestPacketData.ask_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleRequestPacketData.min_count":
+	case "band.oracle.v1.FeeEscrow.request_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
+	case "band.oracle.v1.FeeEscrow.payer":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.FeeEscrow.raw_request_fees":
+		list := []*RawRequestFee{}
+		return protoreflect.ValueOfList(&_FeeEscrow_3_list{list: &list})
+	case "band.oracle.v1.FeeEscrow.remaining":
 		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_OracleRequestPacketData_6_list{list: &list})
-	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
-		return protoreflect.ValueOfEnum(0)
+		return protoreflect.ValueOfList(&_FeeEscrow_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.FeeEscrow"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.FeeEscrow does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_OracleRequestPacketData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_FeeEscrow) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleRequestPacketData", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.FeeEscrow", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4980,7 +5043,7 @@ func (x *fastReflection_OracleRequestPacketData) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_OracleRequestPacketData) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_FeeEscrow) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4991,7 +5054,7 @@ func (x *fastReflection_OracleRequestPacketData) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketData) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_FeeEscrow) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5003,7 +5066,7 @@ func (x *fastReflection_OracleRequestPacketData) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_OracleRequestPacketData) IsValid() bool {
+func (x *fastReflection_FeeEscrow) IsValid() bool {
 	return x != nil
 }
 
@@ -5013,9 +5076,9 @@ func (x *fastReflection_OracleRequestPacketData) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_FeeEscrow) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*OracleRequestPacketData)
+		x := input.Message.Interface().(*FeeEscrow)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5027,37 +5090,24 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		l = len(x.ClientId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.OracleScriptId != 0 {
-			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
 		}
-		l = len(x.Calldata)
+		l = len(x.Payer)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.AskCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.AskCount))
-		}
-		if x.MinCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.MinCount))
-		}
-		if len(x.FeeLimit) > 0 {
-			for _, e := range x.FeeLimit {
+		if len(x.RawRequestFees) > 0 {
+			for _, e := range x.RawRequestFees {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.PrepareGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.PrepareGas))
-		}
-		if x.ExecuteGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExecuteGas))
-		}
-		if x.TssEncoder != 0 {
-			n += 1 + runtime.Sov(uint64(x.TssEncoder))
+		if len(x.Remaining) > 0 {
+			for _, e := range x.Remaining {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5069,7 +5119,7 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*OracleRequestPacketData)
+		x := input.Message.Interface().(*FeeEscrow)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5088,24 +5138,9 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.TssEncoder != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TssEncoder))
-			i--
-			dAtA[i] = 0x48
-		}
-		if x.ExecuteGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGas))
-			i--
-			dAtA[i] = 0x40
-		}
-		if x.PrepareGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrepareGas))
-			i--
-			dAtA[i] = 0x38
-		}
-		if len(x.FeeLimit) > 0 {
-			for iNdEx := len(x.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.FeeLimit[iNdEx])
+		if len(x.Remaining) > 0 {
+			for iNdEx := len(x.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Remaining[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5116,37 +5151,36 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x32
+				dAtA[i] = 0x22
 			}
 		}
-		if x.MinCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.AskCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
-			i--
-			dAtA[i] = 0x20
-		}
-		if len(x.Calldata) > 0 {
-			i -= len(x.Calldata)
-			copy(dAtA[i:], x.Calldata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
-			i--
-			dAtA[i] = 0x1a
+		if len(x.RawRequestFees) > 0 {
+			for iNdEx := len(x.RawRequestFees) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RawRequestFees[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
 		}
-		if x.OracleScriptId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+		if len(x.Payer) > 0 {
+			i -= len(x.Payer)
+			copy(dAtA[i:], x.Payer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payer)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if len(x.ClientId) > 0 {
-			i -= len(x.ClientId)
-			copy(dAtA[i:], x.ClientId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5159,7 +5193,7 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*OracleRequestPacketData)
+		x := input.Message.Interface().(*FeeEscrow)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5191,17 +5225,17 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketData: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeEscrow: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 				}
-				var stringLen uint64
+				x.RequestId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5211,29 +5245,16 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.RequestId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ClientId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
 				}
-				x.OracleScriptId = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5243,16 +5264,29 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.OracleScriptId |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Payer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawRequestFees", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5262,31 +5296,31 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
-				if x.Calldata == nil {
-					x.Calldata = []byte{}
+				x.RawRequestFees = append(x.RawRequestFees, &RawRequestFee{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RawRequestFees[len(x.RawRequestFees)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 				}
-				x.AskCount = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5296,51 +5330,13 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.AskCount |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
-				}
-				x.MinCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MinCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				postIndex := iNdEx + msglen
 				if postIndex < 0 {
@@ -5349,68 +5345,11 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.FeeLimit = append(x.FeeLimit, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeLimit[len(x.FeeLimit)-1]); err != nil {
+				x.Remaining = append(x.Remaining, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Remaining[len(x.Remaining)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 7:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
-				}
-				x.PrepareGas = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.PrepareGas |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
-				}
-				x.ExecuteGas = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ExecuteGas |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 9:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssEncoder", wireType)
-				}
-				x.TssEncoder = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.TssEncoder |= Encoder(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5446,26 +5385,107 @@ func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Meth
 	}
 }
 
-var (
-	md_OracleRequestPacketAcknowledgement            protoreflect.MessageDescriptor
-	fd_OracleRequestPacketAcknowledgement_request_id protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_RecurringRequest_7_list)(nil)
 
-func init() {
-	file_band_oracle_v1_oracle_proto_init()
-	md_OracleRequestPacketAcknowledgement = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleRequestPacketAcknowledgement")
-	fd_OracleRequestPacketAcknowledgement_request_id = md_OracleRequestPacketAcknowledgement.Fields().ByName("request_id")
+type _RecurringRequest_7_list struct {
+	list *[]*v1beta1.Coin
 }
 
-var _ protoreflect.Message = (*fastReflection_OracleRequestPacketAcknowledgement)(nil)
+func (x *_RecurringRequest_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_OracleRequestPacketAcknowledgement OracleRequestPacketAcknowledgement
+func (x *_RecurringRequest_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *OracleRequestPacketAcknowledgement) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_OracleRequestPacketAcknowledgement)(x)
+func (x *_RecurringRequest_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *OracleRequestPacketAcknowledgement) slowProtoReflect() protoreflect.Message {
+func (x *_RecurringRequest_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RecurringRequest_7_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RecurringRequest_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RecurringRequest_7_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RecurringRequest_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_RecurringRequest                  protoreflect.MessageDescriptor
+	fd_RecurringRequest_id               protoreflect.FieldDescriptor
+	fd_RecurringRequest_oracle_script_id protoreflect.FieldDescriptor
+	fd_RecurringRequest_calldata         protoreflect.FieldDescriptor
+	fd_RecurringRequest_ask_count        protoreflect.FieldDescriptor
+	fd_RecurringRequest_min_count        protoreflect.FieldDescriptor
+	fd_RecurringRequest_client_id        protoreflect.FieldDescriptor
+	fd_RecurringRequest_fee_limit        protoreflect.FieldDescriptor
+	fd_RecurringRequest_prepare_gas      protoreflect.FieldDescriptor
+	fd_RecurringRequest_execute_gas      protoreflect.FieldDescriptor
+	fd_RecurringRequest_tss_encoder      protoreflect.FieldDescriptor
+	fd_RecurringRequest_callback         protoreflect.FieldDescriptor
+	fd_RecurringRequest_interval         protoreflect.FieldDescriptor
+	fd_RecurringRequest_next_height      protoreflect.FieldDescriptor
+	fd_RecurringRequest_fee_payer        protoreflect.FieldDescriptor
+	fd_RecurringRequest_owner            protoreflect.FieldDescriptor
+	fd_RecurringRequest_is_active        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_RecurringRequest = File_band_oracle_v1_oracle_proto.Messages().ByName("RecurringRequest")
+	fd_RecurringRequest_id = md_RecurringRequest.Fields().ByName("id")
+	fd_RecurringRequest_oracle_script_id = md_RecurringRequest.Fields().ByName("oracle_script_id")
+	fd_RecurringRequest_calldata = md_RecurringRequest.Fields().ByName("calldata")
+	fd_RecurringRequest_ask_count = md_RecurringRequest.Fields().ByName("ask_count")
+	fd_RecurringRequest_min_count = md_RecurringRequest.Fields().ByName("min_count")
+	fd_RecurringRequest_client_id = md_RecurringRequest.Fields().ByName("client_id")
+	fd_RecurringRequest_fee_limit = md_RecurringRequest.Fields().ByName("fee_limit")
+	fd_RecurringRequest_prepare_gas = md_RecurringRequest.Fields().ByName("prepare_gas")
+	fd_RecurringRequest_execute_gas = md_RecurringRequest.Fields().ByName("execute_gas")
+	fd_RecurringRequest_tss_encoder = md_RecurringRequest.Fields().ByName("tss_encoder")
+	fd_RecurringRequest_callback = md_RecurringRequest.Fields().ByName("callback")
+	fd_RecurringRequest_interval = md_RecurringRequest.Fields().ByName("interval")
+	fd_RecurringRequest_next_height = md_RecurringRequest.Fields().ByName("next_height")
+	fd_RecurringRequest_fee_payer = md_RecurringRequest.Fields().ByName("fee_payer")
+	fd_RecurringRequest_owner = md_RecurringRequest.Fields().ByName("owner")
+	fd_RecurringRequest_is_active = md_RecurringRequest.Fields().ByName("is_active")
+}
+
+var _ protoreflect.Message = (*fastReflection_RecurringRequest)(nil)
+
+type fastReflection_RecurringRequest RecurringRequest
+
+func (x *RecurringRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RecurringRequest)(x)
+}
+
+func (x *RecurringRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5477,43 +5497,43 @@ func (x *OracleRequestPacketAcknowledgement) slowProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_OracleRequestPacketAcknowledgement_messageType fastReflection_OracleRequestPacketAcknowledgement_messageType
-var _ protoreflect.MessageType = fastReflection_OracleRequestPacketAcknowledgement_messageType{}
+var _fastReflection_RecurringRequest_messageType fastReflection_RecurringRequest_messageType
+var _ protoreflect.MessageType = fastReflection_RecurringRequest_messageType{}
 
-type fastReflection_OracleRequestPacketAcknowledgement_messageType struct{}
+type fastReflection_RecurringRequest_messageType struct{}
 
-func (x fastReflection_OracleRequestPacketAcknowledgement_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_OracleRequestPacketAcknowledgement)(nil)
+func (x fastReflection_RecurringRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RecurringRequest)(nil)
 }
-func (x fastReflection_OracleRequestPacketAcknowledgement_messageType) New() protoreflect.Message {
-	return new(fastReflection_OracleRequestPacketAcknowledgement)
+func (x fastReflection_RecurringRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_RecurringRequest)
 }
-func (x fastReflection_OracleRequestPacketAcknowledgement_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleRequestPacketAcknowledgement
+func (x fastReflection_RecurringRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RecurringRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleRequestPacketAcknowledgement
+func (x *fastReflection_RecurringRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_RecurringRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Type() protoreflect.MessageType {
-	return _fastReflection_OracleRequestPacketAcknowledgement_messageType
+func (x *fastReflection_RecurringRequest) Type() protoreflect.MessageType {
+	return _fastReflection_RecurringRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) New() protoreflect.Message {
-	return new(fastReflection_OracleRequestPacketAcknowledgement)
+func (x *fastReflection_RecurringRequest) New() protoreflect.Message {
+	return new(fastReflection_RecurringRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Interface() protoreflect.ProtoMessage {
-	return (*OracleRequestPacketAcknowledgement)(x)
+func (x *fastReflection_RecurringRequest) Interface() protoreflect.ProtoMessage {
+	return (*RecurringRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5521,10 +5541,100 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.RequestId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_OracleRequestPacketAcknowledgement_request_id, value) {
+func (x *fastReflection_RecurringRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_RecurringRequest_id, value) {
+			return
+		}
+	}
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_RecurringRequest_oracle_script_id, value) {
+			return
+		}
+	}
+	if len(x.Calldata) != 0 {
+		value := protoreflect.ValueOfBytes(x.Calldata)
+		if !f(fd_RecurringRequest_calldata, value) {
+			return
+		}
+	}
+	if x.AskCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AskCount)
+		if !f(fd_RecurringRequest_ask_count, value) {
+			return
+		}
+	}
+	if x.MinCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinCount)
+		if !f(fd_RecurringRequest_min_count, value) {
+			return
+		}
+	}
+	if x.ClientId != "" {
+		value := protoreflect.ValueOfString(x.ClientId)
+		if !f(fd_RecurringRequest_client_id, value) {
+			return
+		}
+	}
+	if len(x.FeeLimit) != 0 {
+		value := protoreflect.ValueOfList(&_RecurringRequest_7_list{list: &x.FeeLimit})
+		if !f(fd_RecurringRequest_fee_limit, value) {
+			return
+		}
+	}
+	if x.PrepareGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PrepareGas)
+		if !f(fd_RecurringRequest_prepare_gas, value) {
+			return
+		}
+	}
+	if x.ExecuteGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecuteGas)
+		if !f(fd_RecurringRequest_execute_gas, value) {
+			return
+		}
+	}
+	if x.TssEncoder != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TssEncoder))
+		if !f(fd_RecurringRequest_tss_encoder, value) {
+			return
+		}
+	}
+	if x.Callback != "" {
+		value := protoreflect.ValueOfString(x.Callback)
+		if !f(fd_RecurringRequest_callback, value) {
+			return
+		}
+	}
+	if x.Interval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Interval)
+		if !f(fd_RecurringRequest_interval, value) {
+			return
+		}
+	}
+	if x.NextHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NextHeight)
+		if !f(fd_RecurringRequest_next_height, value) {
+			return
+		}
+	}
+	if x.FeePayer != "" {
+		value := protoreflect.ValueOfString(x.FeePayer)
+		if !f(fd_RecurringRequest_fee_payer, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_RecurringRequest_owner, value) {
+			return
+		}
+	}
+	if x.IsActive != false {
+		value := protoreflect.ValueOfBool(x.IsActive)
+		if !f(fd_RecurringRequest_is_active, value) {
 			return
 		}
 	}
@@ -5541,15 +5651,45 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RecurringRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
-		return x.RequestId != uint64(0)
+	case "band.oracle.v1.RecurringRequest.id":
+		return x.Id != uint64(0)
+	case "band.oracle.v1.RecurringRequest.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.RecurringRequest.calldata":
+		return len(x.Calldata) != 0
+	case "band.oracle.v1.RecurringRequest.ask_count":
+		return x.AskCount != uint64(0)
+	case "band.oracle.v1.RecurringRequest.min_count":
+		return x.MinCount != uint64(0)
+	case "band.oracle.v1.RecurringRequest.client_id":
+		return x.ClientId != ""
+	case "band.oracle.v1.RecurringRequest.fee_limit":
+		return len(x.FeeLimit) != 0
+	case "band.oracle.v1.RecurringRequest.prepare_gas":
+		return x.PrepareGas != uint64(0)
+	case "band.oracle.v1.RecurringRequest.execute_gas":
+		return x.ExecuteGas != uint64(0)
+	case "band.oracle.v1.RecurringRequest.tss_encoder":
+		return x.TssEncoder != 0
+	case "band.oracle.v1.RecurringRequest.callback":
+		return x.Callback != ""
+	case "band.oracle.v1.RecurringRequest.interval":
+		return x.Interval != uint64(0)
+	case "band.oracle.v1.RecurringRequest.next_height":
+		return x.NextHeight != int64(0)
+	case "band.oracle.v1.RecurringRequest.fee_payer":
+		return x.FeePayer != ""
+	case "band.oracle.v1.RecurringRequest.owner":
+		return x.Owner != ""
+	case "band.oracle.v1.RecurringRequest.is_active":
+		return x.IsActive != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RecurringRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RecurringRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5559,15 +5699,45 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RecurringRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
-		x.RequestId = uint64(0)
+	case "band.oracle.v1.RecurringRequest.id":
+		x.Id = uint64(0)
+	case "band.oracle.v1.RecurringRequest.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.RecurringRequest.calldata":
+		x.Calldata = nil
+	case "band.oracle.v1.RecurringRequest.ask_count":
+		x.AskCount = uint64(0)
+	case "band.oracle.v1.RecurringRequest.min_count":
+		x.MinCount = uint64(0)
+	case "band.oracle.v1.RecurringRequest.client_id":
+		x.ClientId = ""
+	case "band.oracle.v1.RecurringRequest.fee_limit":
+		x.FeeLimit = nil
+	case "band.oracle.v1.RecurringRequest.prepare_gas":
+		x.PrepareGas = uint64(0)
+	case "band.oracle.v1.RecurringRequest.execute_gas":
+		x.ExecuteGas = uint64(0)
+	case "band.oracle.v1.RecurringRequest.tss_encoder":
+		x.TssEncoder = 0
+	case "band.oracle.v1.RecurringRequest.callback":
+		x.Callback = ""
+	case "band.oracle.v1.RecurringRequest.interval":
+		x.Interval = uint64(0)
+	case "band.oracle.v1.RecurringRequest.next_height":
+		x.NextHeight = int64(0)
+	case "band.oracle.v1.RecurringRequest.fee_payer":
+		x.FeePayer = ""
+	case "band.oracle.v1.RecurringRequest.owner":
+		x.Owner = ""
+	case "band.oracle.v1.RecurringRequest.is_active":
+		x.IsActive = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RecurringRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RecurringRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5577,16 +5747,64 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RecurringRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
-		value := x.RequestId
+	case "band.oracle.v1.RecurringRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RecurringRequest.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RecurringRequest.calldata":
+		value := x.Calldata
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.RecurringRequest.ask_count":
+		value := x.AskCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RecurringRequest.min_count":
+		value := x.MinCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RecurringRequest.client_id":
+		value := x.ClientId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.RecurringRequest.fee_limit":
+		if len(x.FeeLimit) == 0 {
+			return protoreflect.ValueOfList(&_RecurringRequest_7_list{})
+		}
+		listValue := &_RecurringRequest_7_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.RecurringRequest.prepare_gas":
+		value := x.PrepareGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RecurringRequest.execute_gas":
+		value := x.ExecuteGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RecurringRequest.tss_encoder":
+		value := x.TssEncoder
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.RecurringRequest.callback":
+		value := x.Callback
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.RecurringRequest.interval":
+		value := x.Interval
 		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RecurringRequest.next_height":
+		value := x.NextHeight
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.RecurringRequest.fee_payer":
+		value := x.FeePayer
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.RecurringRequest.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.RecurringRequest.is_active":
+		value := x.IsActive
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RecurringRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RecurringRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5600,15 +5818,47 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RecurringRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
-		x.RequestId = value.Uint()
+	case "band.oracle.v1.RecurringRequest.id":
+		x.Id = value.Uint()
+	case "band.oracle.v1.RecurringRequest.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.RecurringRequest.calldata":
+		x.Calldata = value.Bytes()
+	case "band.oracle.v1.RecurringRequest.ask_count":
+		x.AskCount = value.Uint()
+	case "band.oracle.v1.RecurringRequest.min_count":
+		x.MinCount = value.Uint()
+	case "band.oracle.v1.RecurringRequest.client_id":
+		x.ClientId = value.Interface().(string)
+	case "band.oracle.v1.RecurringRequest.fee_limit":
+		lv := value.List()
+		clv := lv.(*_RecurringRequest_7_list)
+		x.FeeLimit = *clv.list
+	case "band.oracle.v1.RecurringRequest.prepare_gas":
+		x.PrepareGas = value.Uint()
+	case "band.oracle.v1.RecurringRequest.execute_gas":
+		x.ExecuteGas = value.Uint()
+	case "band.oracle.v1.RecurringRequest.tss_encoder":
+		x.TssEncoder = (Encoder)(value.Enum())
+	case "band.oracle.v1.RecurringRequest.callback":
+		x.Callback = value.Interface().(string)
+	case "band.oracle.v1.RecurringRequest.interval":
+		x.Interval = value.Uint()
+	case "band.oracle.v1.RecurringRequest.next_height":
+		x.NextHeight = value.Int()
+	case "band.oracle.v1.RecurringRequest.fee_payer":
+		x.FeePayer = value.Interface().(string)
+	case "band.oracle.v1.RecurringRequest.owner":
+		x.Owner = value.Interface().(string)
+	case "band.oracle.v1.RecurringRequest.is_active":
+		x.IsActive = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RecurringRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RecurringRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5622,40 +5872,105 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RecurringRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
-		panic(fmt.Errorf("field request_id of message band.oracle.v1.OracleRequestPacketAcknowledgement is not mutable"))
+	case "band.oracle.v1.RecurringRequest.fee_limit":
+		if x.FeeLimit == nil {
+			x.FeeLimit = []*v1beta1.Coin{}
+		}
+		value := &_RecurringRequest_7_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.RecurringRequest.id":
+		panic(fmt.Errorf("field id of message band.oracle.v1.RecurringRequest is not mutable"))
+	case "band.oracle.v1.RecurringRequest.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.RecurringRequest is not mutable"))
+	case "band.oracle.v1.RecurringRequest.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.RecurringRequest is not mutable"))
+	case "band.oracle.v1.RecurringRequest.ask_count":
+		panic(fmt.Errorf("field ask_count of message band.oracle.v1.RecurringRequest is not mutable"))
+	case "band.oracle.v1.RecurringRequest.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.RecurringRequest is not mutable"))
+	case "band.oracle.v1.RecurringRequest.client_id":
+		panic(fmt.Errorf("field client_id of message band.oracle.v1.RecurringRequest is not mutable"))
+	case "band.oracle.v1.RecurringRequest.prepare_gas":
+		panic(fmt.Errorf("field prepare_gas of message band.oracle.v1.RecurringRequest is not mutable"))
+	case "band.oracle.v1.RecurringRequest.execute_gas":
+		panic(fmt.Errorf("field execute_gas of message band.oracle.v1.RecurringRequest is not mutable"))
+	case "band.oracle.v1.RecurringRequest.tss_encoder":
+		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.RecurringRequest is not mutable"))
+	case "band.oracle.v1.RecurringRequest.callback":
+		panic(fmt.Errorf("field callback of message band.oracle.v1.RecurringRequest is not mutable"))
+	case "band.oracle.v1.RecurringRequest.interval":
+		panic(fmt.Errorf("field interval of message band.oracle.v1.RecurringRequest is not mutable"))
+	case "band.oracle.v1.RecurringRequest.next_height":
+		panic(fmt.Errorf("field next_height of message band.oracle.v1.RecurringRequest is not mutable"))
+	case "band.oracle.v1.RecurringRequest.fee_payer":
+		panic(fmt.Errorf("field fee_payer of message band.oracle.v1.RecurringRequest is not mutable"))
+	case "band.oracle.v1.RecurringRequest.owner":
+		panic(fmt.Errorf("field owner of message band.oracle.v1.RecurringRequest is not mutable"))
+	case "band.oracle.v1.RecurringRequest.is_active":
+		panic(fmt.Errorf("field is_active of message band.oracle.v1.RecurringRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RecurringRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RecurringRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RecurringRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+	case "band.oracle.v1.RecurringRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RecurringRequest.oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RecurringRequest.calldata":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.RecurringRequest.ask_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RecurringRequest.min_count":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RecurringRequest.client_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.RecurringRequest.fee_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_RecurringRequest_7_list{list: &list})
+	case "band.oracle.v1.RecurringRequest.prepare_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RecurringRequest.execute_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RecurringRequest.tss_encoder":
+		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.RecurringRequest.callback":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.RecurringRequest.interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RecurringRequest.next_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.RecurringRequest.fee_payer":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.RecurringRequest.owner":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.RecurringRequest.is_active":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RecurringRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RecurringRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RecurringRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleRequestPacketAcknowledgement", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RecurringRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5663,7 +5978,7 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RecurringRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5674,7 +5989,7 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RecurringRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5686,7 +6001,7 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) IsValid() bool {
+func (x *fastReflection_RecurringRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5696,9 +6011,9 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RecurringRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*OracleRequestPacketAcknowledgement)
+		x := input.Message.Interface().(*RecurringRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5710,8 +6025,61 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *prot
 		var n int
 		var l int
 		_ = l
-		if x.RequestId != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestId))
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		}
+		l = len(x.Calldata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AskCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AskCount))
+		}
+		if x.MinCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinCount))
+		}
+		l = len(x.ClientId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FeeLimit) > 0 {
+			for _, e := range x.FeeLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PrepareGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrepareGas))
+		}
+		if x.ExecuteGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecuteGas))
+		}
+		if x.TssEncoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.TssEncoder))
+		}
+		l = len(x.Callback)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Interval != 0 {
+			n += 1 + runtime.Sov(uint64(x.Interval))
+		}
+		if x.NextHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextHeight))
+		}
+		l = len(x.FeePayer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IsActive {
+			n += 3
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5723,7 +6091,7 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*OracleRequestPacketAcknowledgement)
+		x := input.Message.Interface().(*RecurringRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5742,8 +6110,111 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *prot
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.RequestId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+		if x.IsActive {
+			i--
+			if x.IsActive {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.FeePayer) > 0 {
+			i -= len(x.FeePayer)
+			copy(dAtA[i:], x.FeePayer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeePayer)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.NextHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextHeight))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.Interval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.Callback) > 0 {
+			i -= len(x.Callback)
+			copy(dAtA[i:], x.Callback)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Callback)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.TssEncoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TssEncoder))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ExecuteGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGas))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.PrepareGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrepareGas))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.FeeLimit) > 0 {
+			for iNdEx := len(x.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.ClientId) > 0 {
+			i -= len(x.ClientId)
+			copy(dAtA[i:], x.ClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.MinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.AskCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Calldata) > 0 {
+			i -= len(x.Calldata)
+			copy(dAtA[i:], x.Calldata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -5758,7 +6229,7 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*OracleRequestPacketAcknowledgement)
+		x := input.Message.Interface().(*RecurringRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5790,17 +6261,17 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *prot
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketAcknowledgement: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecurringRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecurringRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				x.RequestId = 0
+				x.Id = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5810,277 +6281,4627 @@ func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *prot
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
+					x.Id |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.OracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_OracleResponsePacketData                protoreflect.MessageDescriptor
-	fd_OracleResponsePacketData_client_id      protoreflect.FieldDescriptor
-	fd_OracleResponsePacketData_request_id     protoreflect.FieldDescriptor
-	fd_OracleResponsePacketData_ans_count      protoreflect.FieldDescriptor
-	fd_OracleResponsePacketData_request_time   protoreflect.FieldDescriptor
-	fd_OracleResponsePacketData_resolve_time   protoreflect.FieldDescriptor
-	fd_OracleResponsePacketData_resolve_status protoreflect.FieldDescriptor
-	fd_OracleResponsePacketData_result         protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_band_oracle_v1_oracle_proto_init()
-	md_OracleResponsePacketData = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleResponsePacketData")
-	fd_OracleResponsePacketData_client_id = md_OracleResponsePacketData.Fields().ByName("client_id")
-	fd_OracleResponsePacketData_request_id = md_OracleResponsePacketData.Fields().ByName("request_id")
-	fd_OracleResponsePacketData_ans_count = md_OracleResponsePacketData.Fields().ByName("ans_count")
-	fd_OracleResponsePacketData_request_time = md_OracleResponsePacketData.Fields().ByName("request_time")
-	fd_OracleResponsePacketData_resolve_time = md_OracleResponsePacketData.Fields().ByName("resolve_time")
-	fd_OracleResponsePacketData_resolve_status = md_OracleResponsePacketData.Fields().ByName("resolve_status")
-	fd_OracleResponsePacketData_result = md_OracleResponsePacketData.Fields().ByName("result")
-}
-
-var _ protoreflect.Message = (*fastReflection_OracleResponsePacketData)(nil)
-
-type fastReflection_OracleResponsePacketData OracleResponsePacketData
-
-func (x *OracleResponsePacketData) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_OracleResponsePacketData)(x)
-}
-
-func (x *OracleResponsePacketData) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_OracleResponsePacketData_messageType fastReflection_OracleResponsePacketData_messageType
-var _ protoreflect.MessageType = fastReflection_OracleResponsePacketData_messageType{}
-
-type fastReflection_OracleResponsePacketData_messageType struct{}
-
-func (x fastReflection_OracleResponsePacketData_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_OracleResponsePacketData)(nil)
-}
-func (x fastReflection_OracleResponsePacketData_messageType) New() protoreflect.Message {
-	return new(fastReflection_OracleResponsePacketData)
-}
-func (x fastReflection_OracleResponsePacketData_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleResponsePacketData
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_OracleResponsePacketData) Descriptor() protoreflect.MessageDescriptor {
-	return md_OracleResponsePacketData
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_OracleResponsePacketData) Type() protoreflect.MessageType {
-	return _fastReflection_OracleResponsePacketData_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_OracleResponsePacketData) New() protoreflect.Message {
-	return new(fastReflection_OracleResponsePacketData)
-}
-
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_OracleResponsePacketData) Interface() protoreflect.ProtoMessage {
-	return (*OracleResponsePacketData)(x)
-}
-
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_OracleResponsePacketData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ClientId != "" {
-		value := protoreflect.ValueOfString(x.ClientId)
-		if !f(fd_OracleResponsePacketData_client_id, value) {
-			return
-		}
-	}
-	if x.RequestId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_OracleResponsePacketData_request_id, value) {
-			return
-		}
-	}
-	if x.AnsCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.AnsCount)
-		if !f(fd_OracleResponsePacketData_ans_count, value) {
-			return
-		}
-	}
-	if x.RequestTime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.RequestTime)
-		if !f(fd_OracleResponsePacketData_request_time, value) {
-			return
-		}
-	}
-	if x.ResolveTime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ResolveTime)
-		if !f(fd_OracleResponsePacketData_resolve_time, value) {
-			return
-		}
-	}
-	if x.ResolveStatus != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ResolveStatus))
-		if !f(fd_OracleResponsePacketData_resolve_status, value) {
-			return
-		}
-	}
-	if len(x.Result) != 0 {
-		value := protoreflect.ValueOfBytes(x.Result)
-		if !f(fd_OracleResponsePacketData_result, value) {
-			return
-		}
-	}
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_OracleResponsePacketData) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "band.oracle.v1.OracleResponsePacketData.client_id":
-		return x.ClientId != ""
-	case "band.oracle.v1.OracleResponsePacketData.request_id":
-		return x.RequestId != uint64(0)
-	case "band.oracle.v1.OracleResponsePacketData.ans_count":
-		return x.AnsCount != uint64(0)
-	case "band.oracle.v1.OracleResponsePacketData.request_time":
-		return x.RequestTime != int64(0)
-	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
-		return x.ResolveTime != int64(0)
-	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
-		return x.ResolveStatus != 0
-	case "band.oracle.v1.OracleResponsePacketData.result":
-		return len(x.Result) != 0
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
-		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
-	}
-}
-
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleResponsePacketData) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "band.oracle.v1.OracleResponsePacketData.client_id":
-		x.ClientId = ""
-	case "band.oracle.v1.OracleResponsePacketData.request_id":
-		x.RequestId = uint64(0)
-	case "band.oracle.v1.OracleResponsePacketData.ans_count":
-		x.AnsCount = uint64(0)
-	case "band.oracle.v1.OracleResponsePacketData.request_time":
-		x.RequestTime = int64(0)
-	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
-		x.ResolveTime = int64(0)
-	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
-		x.ResolveStatus = 0
-	case "band.oracle.v1.OracleResponsePacketData.result":
-		x.Result = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
-		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
-	}
-}
-
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_OracleResponsePacketData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "band.oracle.v1.OracleResponsePacketData.client_id":
-		value := x.ClientId
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.OracleResponsePacketData.request_id":
-		value := x.RequestId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleResponsePacketData.ans_count":
-		value := x.AnsCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.OracleResponsePacketData.request_time":
-		value := x.RequestTime
-		return protoreflect.ValueOfInt64(value)
-	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
-		value := x.ResolveTime
-		return protoreflect.ValueOfInt64(value)
-	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
-		value := x.ResolveStatus
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Calldata == nil {
+					x.Calldata = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				}
+				x.AskCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AskCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				}
+				x.MinCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClientId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeLimit = append(x.FeeLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeLimit[len(x.FeeLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+				}
+				x.PrepareGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrepareGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+				}
+				x.ExecuteGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecuteGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssEncoder", wireType)
+				}
+				x.TssEncoder = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TssEncoder |= Encoder(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Callback = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				}
+				x.Interval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Interval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+				}
+				x.NextHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeePayer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsActive = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Report_3_list)(nil)
+
+type _Report_3_list struct {
+	list *[]*RawReport
+}
+
+func (x *_Report_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Report_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Report_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawReport)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Report_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawReport)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Report_3_list) AppendMutable() protoreflect.Value {
+	v := new(RawReport)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Report_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Report_3_list) NewElement() protoreflect.Value {
+	v := new(RawReport)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Report_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Report                   protoreflect.MessageDescriptor
+	fd_Report_validator         protoreflect.FieldDescriptor
+	fd_Report_in_before_resolve protoreflect.FieldDescriptor
+	fd_Report_raw_reports       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_Report = File_band_oracle_v1_oracle_proto.Messages().ByName("Report")
+	fd_Report_validator = md_Report.Fields().ByName("validator")
+	fd_Report_in_before_resolve = md_Report.Fields().ByName("in_before_resolve")
+	fd_Report_raw_reports = md_Report.Fields().ByName("raw_reports")
+}
+
+var _ protoreflect.Message = (*fastReflection_Report)(nil)
+
+type fastReflection_Report Report
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Report)(x)
+}
+
+func (x *Report) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Report_messageType fastReflection_Report_messageType
+var _ protoreflect.MessageType = fastReflection_Report_messageType{}
+
+type fastReflection_Report_messageType struct{}
+
+func (x fastReflection_Report_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Report)(nil)
+}
+func (x fastReflection_Report_messageType) New() protoreflect.Message {
+	return new(fastReflection_Report)
+}
+func (x fastReflection_Report_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Report
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Report) Descriptor() protoreflect.MessageDescriptor {
+	return md_Report
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Report) Type() protoreflect.MessageType {
+	return _fastReflection_Report_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Report) New() protoreflect.Message {
+	return new(fastReflection_Report)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Report) Interface() protoreflect.ProtoMessage {
+	return (*Report)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Report) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_Report_validator, value) {
+			return
+		}
+	}
+	if x.InBeforeResolve != false {
+		value := protoreflect.ValueOfBool(x.InBeforeResolve)
+		if !f(fd_Report_in_before_resolve, value) {
+			return
+		}
+	}
+	if len(x.RawReports) != 0 {
+		value := protoreflect.ValueOfList(&_Report_3_list{list: &x.RawReports})
+		if !f(fd_Report_raw_reports, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Report) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.Report.validator":
+		return x.Validator != ""
+	case "band.oracle.v1.Report.in_before_resolve":
+		return x.InBeforeResolve != false
+	case "band.oracle.v1.Report.raw_reports":
+		return len(x.RawReports) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Report) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.Report.validator":
+		x.Validator = ""
+	case "band.oracle.v1.Report.in_before_resolve":
+		x.InBeforeResolve = false
+	case "band.oracle.v1.Report.raw_reports":
+		x.RawReports = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Report) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.Report.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.Report.in_before_resolve":
+		value := x.InBeforeResolve
+		return protoreflect.ValueOfBool(value)
+	case "band.oracle.v1.Report.raw_reports":
+		if len(x.RawReports) == 0 {
+			return protoreflect.ValueOfList(&_Report_3_list{})
+		}
+		listValue := &_Report_3_list{list: &x.RawReports}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Report) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.Report.validator":
+		x.Validator = value.Interface().(string)
+	case "band.oracle.v1.Report.in_before_resolve":
+		x.InBeforeResolve = value.Bool()
+	case "band.oracle.v1.Report.raw_reports":
+		lv := value.List()
+		clv := lv.(*_Report_3_list)
+		x.RawReports = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Report) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.Report.raw_reports":
+		if x.RawReports == nil {
+			x.RawReports = []*RawReport{}
+		}
+		value := &_Report_3_list{list: &x.RawReports}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.Report.validator":
+		panic(fmt.Errorf("field validator of message band.oracle.v1.Report is not mutable"))
+	case "band.oracle.v1.Report.in_before_resolve":
+		panic(fmt.Errorf("field in_before_resolve of message band.oracle.v1.Report is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Report) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.Report.validator":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.Report.in_before_resolve":
+		return protoreflect.ValueOfBool(false)
+	case "band.oracle.v1.Report.raw_reports":
+		list := []*RawReport{}
+		return protoreflect.ValueOfList(&_Report_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Report) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Report", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Report) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Report) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Report) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Report)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.InBeforeResolve {
+			n += 2
+		}
+		if len(x.RawReports) > 0 {
+			for _, e := range x.RawReports {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Report)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RawReports) > 0 {
+			for iNdEx := len(x.RawReports) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RawReports[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.InBeforeResolve {
+			i--
+			if x.InBeforeResolve {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Report)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Report: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InBeforeResolve", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.InBeforeResolve = bool(v != 0)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RawReports = append(x.RawReports, &RawReport{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RawReports[len(x.RawReports)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_OracleRequestPacketData_6_list)(nil)
+
+type _OracleRequestPacketData_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_OracleRequestPacketData_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OracleRequestPacketData_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_OracleRequestPacketData_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OracleRequestPacketData_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OracleRequestPacketData_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OracleRequestPacketData_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OracleRequestPacketData_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OracleRequestPacketData_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_OracleRequestPacketData                  protoreflect.MessageDescriptor
+	fd_OracleRequestPacketData_client_id        protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_oracle_script_id protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_calldata         protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_ask_count        protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_min_count        protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_fee_limit        protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_prepare_gas      protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_execute_gas      protoreflect.FieldDescriptor
+	fd_OracleRequestPacketData_tss_encoder      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_OracleRequestPacketData = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleRequestPacketData")
+	fd_OracleRequestPacketData_client_id = md_OracleRequestPacketData.Fields().ByName("client_id")
+	fd_OracleRequestPacketData_oracle_script_id = md_OracleRequestPacketData.Fields().ByName("oracle_script_id")
+	fd_OracleRequestPacketData_calldata = md_OracleRequestPacketData.Fields().ByName("calldata")
+	fd_OracleRequestPacketData_ask_count = md_OracleRequestPacketData.Fields().ByName("ask_count")
+	fd_OracleRequestPacketData_min_count = md_OracleRequestPacketData.Fields().ByName("min_count")
+	fd_OracleRequestPacketData_fee_limit = md_OracleRequestPacketData.Fields().ByName("fee_limit")
+	fd_OracleRequestPacketData_prepare_gas = md_OracleRequestPacketData.Fields().ByName("prepare_gas")
+	fd_OracleRequestPacketData_execute_gas = md_OracleRequestPacketData.Fields().ByName("execute_gas")
+	fd_OracleRequestPacketData_tss_encoder = md_OracleRequestPacketData.Fields().ByName("tss_encoder")
+}
+
+var _ protoreflect.Message = (*fastReflection_OracleRequestPacketData)(nil)
+
+type fastReflection_OracleRequestPacketData OracleRequestPacketData
+
+func (x *OracleRequestPacketData) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OracleRequestPacketData)(x)
+}
+
+func (x *OracleRequestPacketData) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OracleRequestPacketData_messageType fastReflection_OracleRequestPacketData_messageType
+var _ protoreflect.MessageType = fastReflection_OracleRequestPacketData_messageType{}
+
+type fastReflection_OracleRequestPacketData_messageType struct{}
+
+func (x fastReflection_OracleRequestPacketData_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OracleRequestPacketData)(nil)
+}
+func (x fastReflection_OracleRequestPacketData_messageType) New() protoreflect.Message {
+	return new(fastReflection_OracleRequestPacketData)
+}
+func (x fastReflection_OracleRequestPacketData_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleRequestPacketData
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OracleRequestPacketData) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleRequestPacketData
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OracleRequestPacketData) Type() protoreflect.MessageType {
+	return _fastReflection_OracleRequestPacketData_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OracleRequestPacketData) New() protoreflect.Message {
+	return new(fastReflection_OracleRequestPacketData)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OracleRequestPacketData) Interface() protoreflect.ProtoMessage {
+	return (*OracleRequestPacketData)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OracleRequestPacketData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClientId != "" {
+		value := protoreflect.ValueOfString(x.ClientId)
+		if !f(fd_OracleRequestPacketData_client_id, value) {
+			return
+		}
+	}
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_OracleRequestPacketData_oracle_script_id, value) {
+			return
+		}
+	}
+	if len(x.Calldata) != 0 {
+		value := protoreflect.ValueOfBytes(x.Calldata)
+		if !f(fd_OracleRequestPacketData_calldata, value) {
+			return
+		}
+	}
+	if x.AskCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AskCount)
+		if !f(fd_OracleRequestPacketData_ask_count, value) {
+			return
+		}
+	}
+	if x.MinCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinCount)
+		if !f(fd_OracleRequestPacketData_min_count, value) {
+			return
+		}
+	}
+	if len(x.FeeLimit) != 0 {
+		value := protoreflect.ValueOfList(&_OracleRequestPacketData_6_list{list: &x.FeeLimit})
+		if !f(fd_OracleRequestPacketData_fee_limit, value) {
+			return
+		}
+	}
+	if x.PrepareGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PrepareGas)
+		if !f(fd_OracleRequestPacketData_prepare_gas, value) {
+			return
+		}
+	}
+	if x.ExecuteGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecuteGas)
+		if !f(fd_OracleRequestPacketData_execute_gas, value) {
+			return
+		}
+	}
+	if x.TssEncoder != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TssEncoder))
+		if !f(fd_OracleRequestPacketData_tss_encoder, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OracleRequestPacketData) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleRequestPacketData.client_id":
+		return x.ClientId != ""
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.OracleRequestPacketData.calldata":
+		return len(x.Calldata) != 0
+	case "band.oracle.v1.OracleRequestPacketData.ask_count":
+		return x.AskCount != uint64(0)
+	case "band.oracle.v1.OracleRequestPacketData.min_count":
+		return x.MinCount != uint64(0)
+	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
+		return len(x.FeeLimit) != 0
+	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
+		return x.PrepareGas != uint64(0)
+	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
+		return x.ExecuteGas != uint64(0)
+	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
+		return x.TssEncoder != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleRequestPacketData) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleRequestPacketData.client_id":
+		x.ClientId = ""
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.OracleRequestPacketData.calldata":
+		x.Calldata = nil
+	case "band.oracle.v1.OracleRequestPacketData.ask_count":
+		x.AskCount = uint64(0)
+	case "band.oracle.v1.OracleRequestPacketData.min_count":
+		x.MinCount = uint64(0)
+	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
+		x.FeeLimit = nil
+	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
+		x.PrepareGas = uint64(0)
+	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
+		x.ExecuteGas = uint64(0)
+	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
+		x.TssEncoder = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OracleRequestPacketData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.OracleRequestPacketData.client_id":
+		value := x.ClientId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleRequestPacketData.calldata":
+		value := x.Calldata
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.OracleRequestPacketData.ask_count":
+		value := x.AskCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleRequestPacketData.min_count":
+		value := x.MinCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
+		if len(x.FeeLimit) == 0 {
+			return protoreflect.ValueOfList(&_OracleRequestPacketData_6_list{})
+		}
+		listValue := &_OracleRequestPacketData_6_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
+		value := x.PrepareGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
+		value := x.ExecuteGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
+		value := x.TssEncoder
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleRequestPacketData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleRequestPacketData.client_id":
+		x.ClientId = value.Interface().(string)
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.OracleRequestPacketData.calldata":
+		x.Calldata = value.Bytes()
+	case "band.oracle.v1.OracleRequestPacketData.ask_count":
+		x.AskCount = value.Uint()
+	case "band.oracle.v1.OracleRequestPacketData.min_count":
+		x.MinCount = value.Uint()
+	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
+		lv := value.List()
+		clv := lv.(*_OracleRequestPacketData_6_list)
+		x.FeeLimit = *clv.list
+	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
+		x.PrepareGas = value.Uint()
+	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
+		x.ExecuteGas = value.Uint()
+	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
+		x.TssEncoder = (Encoder)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleRequestPacketData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
+		if x.FeeLimit == nil {
+			x.FeeLimit = []*v1beta1.Coin{}
+		}
+		value := &_OracleRequestPacketData_6_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.OracleRequestPacketData.client_id":
+		panic(fmt.Errorf("field client_id of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.ask_count":
+		panic(fmt.Errorf("field ask_count of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
+		panic(fmt.Errorf("field prepare_gas of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
+		panic(fmt.Errorf("field execute_gas of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
+		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.OracleRequestPacketData is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OracleRequestPacketData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleRequestPacketData.client_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.OracleRequestPacketData.oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.OracleRequestPacketData.calldata":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.OracleRequestPacketData.ask_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.OracleRequestPacketData.min_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.OracleRequestPacketData.fee_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_OracleRequestPacketData_6_list{list: &list})
+	case "band.oracle.v1.OracleRequestPacketData.prepare_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.OracleRequestPacketData.execute_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.OracleRequestPacketData.tss_encoder":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketData"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OracleRequestPacketData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleRequestPacketData", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OracleRequestPacketData) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleRequestPacketData) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OracleRequestPacketData) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OracleRequestPacketData) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OracleRequestPacketData)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ClientId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		}
+		l = len(x.Calldata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AskCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AskCount))
+		}
+		if x.MinCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinCount))
+		}
+		if len(x.FeeLimit) > 0 {
+			for _, e := range x.FeeLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PrepareGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrepareGas))
+		}
+		if x.ExecuteGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecuteGas))
+		}
+		if x.TssEncoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.TssEncoder))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OracleRequestPacketData)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TssEncoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TssEncoder))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.ExecuteGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGas))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.PrepareGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrepareGas))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.FeeLimit) > 0 {
+			for iNdEx := len(x.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.MinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.AskCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Calldata) > 0 {
+			i -= len(x.Calldata)
+			copy(dAtA[i:], x.Calldata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ClientId) > 0 {
+			i -= len(x.ClientId)
+			copy(dAtA[i:], x.ClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OracleRequestPacketData)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketData: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClientId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				}
+				x.OracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Calldata == nil {
+					x.Calldata = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				}
+				x.AskCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AskCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				}
+				x.MinCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeLimit = append(x.FeeLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeLimit[len(x.FeeLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+				}
+				x.PrepareGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrepareGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+				}
+				x.ExecuteGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecuteGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssEncoder", wireType)
+				}
+				x.TssEncoder = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TssEncoder |= Encoder(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_OracleRequestPacketAcknowledgement            protoreflect.MessageDescriptor
+	fd_OracleRequestPacketAcknowledgement_request_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_OracleRequestPacketAcknowledgement = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleRequestPacketAcknowledgement")
+	fd_OracleRequestPacketAcknowledgement_request_id = md_OracleRequestPacketAcknowledgement.Fields().ByName("request_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_OracleRequestPacketAcknowledgement)(nil)
+
+type fastReflection_OracleRequestPacketAcknowledgement OracleRequestPacketAcknowledgement
+
+func (x *OracleRequestPacketAcknowledgement) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OracleRequestPacketAcknowledgement)(x)
+}
+
+func (x *OracleRequestPacketAcknowledgement) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OracleRequestPacketAcknowledgement_messageType fastReflection_OracleRequestPacketAcknowledgement_messageType
+var _ protoreflect.MessageType = fastReflection_OracleRequestPacketAcknowledgement_messageType{}
+
+type fastReflection_OracleRequestPacketAcknowledgement_messageType struct{}
+
+func (x fastReflection_OracleRequestPacketAcknowledgement_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OracleRequestPacketAcknowledgement)(nil)
+}
+func (x fastReflection_OracleRequestPacketAcknowledgement_messageType) New() protoreflect.Message {
+	return new(fastReflection_OracleRequestPacketAcknowledgement)
+}
+func (x fastReflection_OracleRequestPacketAcknowledgement_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleRequestPacketAcknowledgement
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleRequestPacketAcknowledgement
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Type() protoreflect.MessageType {
+	return _fastReflection_OracleRequestPacketAcknowledgement_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OracleRequestPacketAcknowledgement) New() protoreflect.Message {
+	return new(fastReflection_OracleRequestPacketAcknowledgement)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Interface() protoreflect.ProtoMessage {
+	return (*OracleRequestPacketAcknowledgement)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_OracleRequestPacketAcknowledgement_request_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+		return x.RequestId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+		x.RequestId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+		x.RequestId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleRequestPacketAcknowledgement) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.OracleRequestPacketAcknowledgement is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OracleRequestPacketAcknowledgement) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleRequestPacketAcknowledgement.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgement"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgement does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OracleRequestPacketAcknowledgement) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleRequestPacketAcknowledgement", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OracleRequestPacketAcknowledgement) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleRequestPacketAcknowledgement) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OracleRequestPacketAcknowledgement) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OracleRequestPacketAcknowledgement) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OracleRequestPacketAcknowledgement)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OracleRequestPacketAcknowledgement)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OracleRequestPacketAcknowledgement)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketAcknowledgement: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_OracleRequestPacketAcknowledgementV2                   protoreflect.MessageDescriptor
+	fd_OracleRequestPacketAcknowledgementV2_request_id        protoreflect.FieldDescriptor
+	fd_OracleRequestPacketAcknowledgementV2_expiration_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_OracleRequestPacketAcknowledgementV2 = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleRequestPacketAcknowledgementV2")
+	fd_OracleRequestPacketAcknowledgementV2_request_id = md_OracleRequestPacketAcknowledgementV2.Fields().ByName("request_id")
+	fd_OracleRequestPacketAcknowledgementV2_expiration_height = md_OracleRequestPacketAcknowledgementV2.Fields().ByName("expiration_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_OracleRequestPacketAcknowledgementV2)(nil)
+
+type fastReflection_OracleRequestPacketAcknowledgementV2 OracleRequestPacketAcknowledgementV2
+
+func (x *OracleRequestPacketAcknowledgementV2) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OracleRequestPacketAcknowledgementV2)(x)
+}
+
+func (x *OracleRequestPacketAcknowledgementV2) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OracleRequestPacketAcknowledgementV2_messageType fastReflection_OracleRequestPacketAcknowledgementV2_messageType
+var _ protoreflect.MessageType = fastReflection_OracleRequestPacketAcknowledgementV2_messageType{}
+
+type fastReflection_OracleRequestPacketAcknowledgementV2_messageType struct{}
+
+func (x fastReflection_OracleRequestPacketAcknowledgementV2_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OracleRequestPacketAcknowledgementV2)(nil)
+}
+func (x fastReflection_OracleRequestPacketAcknowledgementV2_messageType) New() protoreflect.Message {
+	return new(fastReflection_OracleRequestPacketAcknowledgementV2)
+}
+func (x fastReflection_OracleRequestPacketAcknowledgementV2_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleRequestPacketAcknowledgementV2
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OracleRequestPacketAcknowledgementV2) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleRequestPacketAcknowledgementV2
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OracleRequestPacketAcknowledgementV2) Type() protoreflect.MessageType {
+	return _fastReflection_OracleRequestPacketAcknowledgementV2_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OracleRequestPacketAcknowledgementV2) New() protoreflect.Message {
+	return new(fastReflection_OracleRequestPacketAcknowledgementV2)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OracleRequestPacketAcknowledgementV2) Interface() protoreflect.ProtoMessage {
+	return (*OracleRequestPacketAcknowledgementV2)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OracleRequestPacketAcknowledgementV2) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_OracleRequestPacketAcknowledgementV2_request_id, value) {
+			return
+		}
+	}
+	if x.ExpirationHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpirationHeight)
+		if !f(fd_OracleRequestPacketAcknowledgementV2_expiration_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OracleRequestPacketAcknowledgementV2) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleRequestPacketAcknowledgementV2.request_id":
+		return x.RequestId != uint64(0)
+	case "band.oracle.v1.OracleRequestPacketAcknowledgementV2.expiration_height":
+		return x.ExpirationHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgementV2"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgementV2 does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleRequestPacketAcknowledgementV2) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleRequestPacketAcknowledgementV2.request_id":
+		x.RequestId = uint64(0)
+	case "band.oracle.v1.OracleRequestPacketAcknowledgementV2.expiration_height":
+		x.ExpirationHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgementV2"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgementV2 does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OracleRequestPacketAcknowledgementV2) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.OracleRequestPacketAcknowledgementV2.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleRequestPacketAcknowledgementV2.expiration_height":
+		value := x.ExpirationHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgementV2"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgementV2 does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleRequestPacketAcknowledgementV2) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleRequestPacketAcknowledgementV2.request_id":
+		x.RequestId = value.Uint()
+	case "band.oracle.v1.OracleRequestPacketAcknowledgementV2.expiration_height":
+		x.ExpirationHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgementV2"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgementV2 does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleRequestPacketAcknowledgementV2) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleRequestPacketAcknowledgementV2.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.OracleRequestPacketAcknowledgementV2 is not mutable"))
+	case "band.oracle.v1.OracleRequestPacketAcknowledgementV2.expiration_height":
+		panic(fmt.Errorf("field expiration_height of message band.oracle.v1.OracleRequestPacketAcknowledgementV2 is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgementV2"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgementV2 does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OracleRequestPacketAcknowledgementV2) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleRequestPacketAcknowledgementV2.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.OracleRequestPacketAcknowledgementV2.expiration_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleRequestPacketAcknowledgementV2"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleRequestPacketAcknowledgementV2 does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OracleRequestPacketAcknowledgementV2) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleRequestPacketAcknowledgementV2", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OracleRequestPacketAcknowledgementV2) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleRequestPacketAcknowledgementV2) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OracleRequestPacketAcknowledgementV2) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OracleRequestPacketAcknowledgementV2) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OracleRequestPacketAcknowledgementV2)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.ExpirationHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpirationHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OracleRequestPacketAcknowledgementV2)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExpirationHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpirationHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OracleRequestPacketAcknowledgementV2)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketAcknowledgementV2: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleRequestPacketAcknowledgementV2: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+				}
+				x.ExpirationHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpirationHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_OracleResponsePacketData                protoreflect.MessageDescriptor
+	fd_OracleResponsePacketData_client_id      protoreflect.FieldDescriptor
+	fd_OracleResponsePacketData_request_id     protoreflect.FieldDescriptor
+	fd_OracleResponsePacketData_ans_count      protoreflect.FieldDescriptor
+	fd_OracleResponsePacketData_request_time   protoreflect.FieldDescriptor
+	fd_OracleResponsePacketData_resolve_time   protoreflect.FieldDescriptor
+	fd_OracleResponsePacketData_resolve_status protoreflect.FieldDescriptor
+	fd_OracleResponsePacketData_result         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_OracleResponsePacketData = File_band_oracle_v1_oracle_proto.Messages().ByName("OracleResponsePacketData")
+	fd_OracleResponsePacketData_client_id = md_OracleResponsePacketData.Fields().ByName("client_id")
+	fd_OracleResponsePacketData_request_id = md_OracleResponsePacketData.Fields().ByName("request_id")
+	fd_OracleResponsePacketData_ans_count = md_OracleResponsePacketData.Fields().ByName("ans_count")
+	fd_OracleResponsePacketData_request_time = md_OracleResponsePacketData.Fields().ByName("request_time")
+	fd_OracleResponsePacketData_resolve_time = md_OracleResponsePacketData.Fields().ByName("resolve_time")
+	fd_OracleResponsePacketData_resolve_status = md_OracleResponsePacketData.Fields().ByName("resolve_status")
+	fd_OracleResponsePacketData_result = md_OracleResponsePacketData.Fields().ByName("result")
+}
+
+var _ protoreflect.Message = (*fastReflection_OracleResponsePacketData)(nil)
+
+type fastReflection_OracleResponsePacketData OracleResponsePacketData
+
+func (x *OracleResponsePacketData) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OracleResponsePacketData)(x)
+}
+
+func (x *OracleResponsePacketData) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OracleResponsePacketData_messageType fastReflection_OracleResponsePacketData_messageType
+var _ protoreflect.MessageType = fastReflection_OracleResponsePacketData_messageType{}
+
+type fastReflection_OracleResponsePacketData_messageType struct{}
+
+func (x fastReflection_OracleResponsePacketData_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OracleResponsePacketData)(nil)
+}
+func (x fastReflection_OracleResponsePacketData_messageType) New() protoreflect.Message {
+	return new(fastReflection_OracleResponsePacketData)
+}
+func (x fastReflection_OracleResponsePacketData_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleResponsePacketData
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OracleResponsePacketData) Descriptor() protoreflect.MessageDescriptor {
+	return md_OracleResponsePacketData
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OracleResponsePacketData) Type() protoreflect.MessageType {
+	return _fastReflection_OracleResponsePacketData_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OracleResponsePacketData) New() protoreflect.Message {
+	return new(fastReflection_OracleResponsePacketData)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OracleResponsePacketData) Interface() protoreflect.ProtoMessage {
+	return (*OracleResponsePacketData)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OracleResponsePacketData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClientId != "" {
+		value := protoreflect.ValueOfString(x.ClientId)
+		if !f(fd_OracleResponsePacketData_client_id, value) {
+			return
+		}
+	}
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_OracleResponsePacketData_request_id, value) {
+			return
+		}
+	}
+	if x.AnsCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AnsCount)
+		if !f(fd_OracleResponsePacketData_ans_count, value) {
+			return
+		}
+	}
+	if x.RequestTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RequestTime)
+		if !f(fd_OracleResponsePacketData_request_time, value) {
+			return
+		}
+	}
+	if x.ResolveTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ResolveTime)
+		if !f(fd_OracleResponsePacketData_resolve_time, value) {
+			return
+		}
+	}
+	if x.ResolveStatus != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ResolveStatus))
+		if !f(fd_OracleResponsePacketData_resolve_status, value) {
+			return
+		}
+	}
+	if len(x.Result) != 0 {
+		value := protoreflect.ValueOfBytes(x.Result)
+		if !f(fd_OracleResponsePacketData_result, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OracleResponsePacketData) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleResponsePacketData.client_id":
+		return x.ClientId != ""
+	case "band.oracle.v1.OracleResponsePacketData.request_id":
+		return x.RequestId != uint64(0)
+	case "band.oracle.v1.OracleResponsePacketData.ans_count":
+		return x.AnsCount != uint64(0)
+	case "band.oracle.v1.OracleResponsePacketData.request_time":
+		return x.RequestTime != int64(0)
+	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
+		return x.ResolveTime != int64(0)
+	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
+		return x.ResolveStatus != 0
+	case "band.oracle.v1.OracleResponsePacketData.result":
+		return len(x.Result) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleResponsePacketData) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleResponsePacketData.client_id":
+		x.ClientId = ""
+	case "band.oracle.v1.OracleResponsePacketData.request_id":
+		x.RequestId = uint64(0)
+	case "band.oracle.v1.OracleResponsePacketData.ans_count":
+		x.AnsCount = uint64(0)
+	case "band.oracle.v1.OracleResponsePacketData.request_time":
+		x.RequestTime = int64(0)
+	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
+		x.ResolveTime = int64(0)
+	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
+		x.ResolveStatus = 0
+	case "band.oracle.v1.OracleResponsePacketData.result":
+		x.Result = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OracleResponsePacketData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.OracleResponsePacketData.client_id":
+		value := x.ClientId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.OracleResponsePacketData.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleResponsePacketData.ans_count":
+		value := x.AnsCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.OracleResponsePacketData.request_time":
+		value := x.RequestTime
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
+		value := x.ResolveTime
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
+		value := x.ResolveStatus
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.OracleResponsePacketData.result":
+		value := x.Result
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleResponsePacketData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleResponsePacketData.client_id":
+		x.ClientId = value.Interface().(string)
+	case "band.oracle.v1.OracleResponsePacketData.request_id":
+		x.RequestId = value.Uint()
+	case "band.oracle.v1.OracleResponsePacketData.ans_count":
+		x.AnsCount = value.Uint()
+	case "band.oracle.v1.OracleResponsePacketData.request_time":
+		x.RequestTime = value.Int()
+	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
+		x.ResolveTime = value.Int()
+	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
+		x.ResolveStatus = (ResolveStatus)(value.Enum())
+	case "band.oracle.v1.OracleResponsePacketData.result":
+		x.Result = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleResponsePacketData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleResponsePacketData.client_id":
+		panic(fmt.Errorf("field client_id of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.OracleResponsePacketData.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.OracleResponsePacketData.ans_count":
+		panic(fmt.Errorf("field ans_count of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.OracleResponsePacketData.request_time":
+		panic(fmt.Errorf("field request_time of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
+		panic(fmt.Errorf("field resolve_time of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
+		panic(fmt.Errorf("field resolve_status of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.OracleResponsePacketData.result":
+		panic(fmt.Errorf("field result of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OracleResponsePacketData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.OracleResponsePacketData.client_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.OracleResponsePacketData.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.OracleResponsePacketData.ans_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.OracleResponsePacketData.request_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
+		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.OracleResponsePacketData.result":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OracleResponsePacketData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleResponsePacketData", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OracleResponsePacketData) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OracleResponsePacketData) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OracleResponsePacketData) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OracleResponsePacketData)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ClientId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.AnsCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AnsCount))
+		}
+		if x.RequestTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestTime))
+		}
+		if x.ResolveTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResolveTime))
+		}
+		if x.ResolveStatus != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResolveStatus))
+		}
+		l = len(x.Result)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OracleResponsePacketData)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Result) > 0 {
+			i -= len(x.Result)
+			copy(dAtA[i:], x.Result)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.ResolveStatus != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveStatus))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ResolveTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveTime))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.RequestTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestTime))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.AnsCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AnsCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ClientId) > 0 {
+			i -= len(x.ClientId)
+			copy(dAtA[i:], x.ClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OracleResponsePacketData)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleResponsePacketData: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleResponsePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClientId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
+				}
+				x.AnsCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AnsCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+				}
+				x.RequestTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+				}
+				x.ResolveTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResolveTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
+				}
+				x.ResolveStatus = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResolveStatus |= ResolveStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Result = append(x.Result[:0], dAtA[iNdEx:postIndex]...)
+				if x.Result == nil {
+					x.Result = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Result                  protoreflect.MessageDescriptor
+	fd_Result_client_id        protoreflect.FieldDescriptor
+	fd_Result_oracle_script_id protoreflect.FieldDescriptor
+	fd_Result_calldata         protoreflect.FieldDescriptor
+	fd_Result_ask_count        protoreflect.FieldDescriptor
+	fd_Result_min_count        protoreflect.FieldDescriptor
+	fd_Result_request_id       protoreflect.FieldDescriptor
+	fd_Result_ans_count        protoreflect.FieldDescriptor
+	fd_Result_request_time     protoreflect.FieldDescriptor
+	fd_Result_resolve_time     protoreflect.FieldDescriptor
+	fd_Result_resolve_status   protoreflect.FieldDescriptor
+	fd_Result_result           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_Result = File_band_oracle_v1_oracle_proto.Messages().ByName("Result")
+	fd_Result_client_id = md_Result.Fields().ByName("client_id")
+	fd_Result_oracle_script_id = md_Result.Fields().ByName("oracle_script_id")
+	fd_Result_calldata = md_Result.Fields().ByName("calldata")
+	fd_Result_ask_count = md_Result.Fields().ByName("ask_count")
+	fd_Result_min_count = md_Result.Fields().ByName("min_count")
+	fd_Result_request_id = md_Result.Fields().ByName("request_id")
+	fd_Result_ans_count = md_Result.Fields().ByName("ans_count")
+	fd_Result_request_time = md_Result.Fields().ByName("request_time")
+	fd_Result_resolve_time = md_Result.Fields().ByName("resolve_time")
+	fd_Result_resolve_status = md_Result.Fields().ByName("resolve_status")
+	fd_Result_result = md_Result.Fields().ByName("result")
+}
+
+var _ protoreflect.Message = (*fastReflection_Result)(nil)
+
+type fastReflection_Result Result
+
+func (x *Result) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Result)(x)
+}
+
+func (x *Result) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Result_messageType fastReflection_Result_messageType
+var _ protoreflect.MessageType = fastReflection_Result_messageType{}
+
+type fastReflection_Result_messageType struct{}
+
+func (x fastReflection_Result_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Result)(nil)
+}
+func (x fastReflection_Result_messageType) New() protoreflect.Message {
+	return new(fastReflection_Result)
+}
+func (x fastReflection_Result_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Result
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Result) Descriptor() protoreflect.MessageDescriptor {
+	return md_Result
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Result) Type() protoreflect.MessageType {
+	return _fastReflection_Result_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Result) New() protoreflect.Message {
+	return new(fastReflection_Result)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Result) Interface() protoreflect.ProtoMessage {
+	return (*Result)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Result) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClientId != "" {
+		value := protoreflect.ValueOfString(x.ClientId)
+		if !f(fd_Result_client_id, value) {
+			return
+		}
+	}
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_Result_oracle_script_id, value) {
+			return
+		}
+	}
+	if len(x.Calldata) != 0 {
+		value := protoreflect.ValueOfBytes(x.Calldata)
+		if !f(fd_Result_calldata, value) {
+			return
+		}
+	}
+	if x.AskCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AskCount)
+		if !f(fd_Result_ask_count, value) {
+			return
+		}
+	}
+	if x.MinCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinCount)
+		if !f(fd_Result_min_count, value) {
+			return
+		}
+	}
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_Result_request_id, value) {
+			return
+		}
+	}
+	if x.AnsCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AnsCount)
+		if !f(fd_Result_ans_count, value) {
+			return
+		}
+	}
+	if x.RequestTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RequestTime)
+		if !f(fd_Result_request_time, value) {
+			return
+		}
+	}
+	if x.ResolveTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ResolveTime)
+		if !f(fd_Result_resolve_time, value) {
+			return
+		}
+	}
+	if x.ResolveStatus != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ResolveStatus))
+		if !f(fd_Result_resolve_status, value) {
+			return
+		}
+	}
+	if len(x.Result) != 0 {
+		value := protoreflect.ValueOfBytes(x.Result)
+		if !f(fd_Result_result, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Result) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.Result.client_id":
+		return x.ClientId != ""
+	case "band.oracle.v1.Result.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.Result.calldata":
+		return len(x.Calldata) != 0
+	case "band.oracle.v1.Result.ask_count":
+		return x.AskCount != uint64(0)
+	case "band.oracle.v1.Result.min_count":
+		return x.MinCount != uint64(0)
+	case "band.oracle.v1.Result.request_id":
+		return x.RequestId != uint64(0)
+	case "band.oracle.v1.Result.ans_count":
+		return x.AnsCount != uint64(0)
+	case "band.oracle.v1.Result.request_time":
+		return x.RequestTime != int64(0)
+	case "band.oracle.v1.Result.resolve_time":
+		return x.ResolveTime != int64(0)
+	case "band.oracle.v1.Result.resolve_status":
+		return x.ResolveStatus != 0
+	case "band.oracle.v1.Result.result":
+		return len(x.Result) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Result) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.Result.client_id":
+		x.ClientId = ""
+	case "band.oracle.v1.Result.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.Result.calldata":
+		x.Calldata = nil
+	case "band.oracle.v1.Result.ask_count":
+		x.AskCount = uint64(0)
+	case "band.oracle.v1.Result.min_count":
+		x.MinCount = uint64(0)
+	case "band.oracle.v1.Result.request_id":
+		x.RequestId = uint64(0)
+	case "band.oracle.v1.Result.ans_count":
+		x.AnsCount = uint64(0)
+	case "band.oracle.v1.Result.request_time":
+		x.RequestTime = int64(0)
+	case "band.oracle.v1.Result.resolve_time":
+		x.ResolveTime = int64(0)
+	case "band.oracle.v1.Result.resolve_status":
+		x.ResolveStatus = 0
+	case "band.oracle.v1.Result.result":
+		x.Result = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Result) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.Result.client_id":
+		value := x.ClientId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.Result.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Result.calldata":
+		value := x.Calldata
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.Result.ask_count":
+		value := x.AskCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Result.min_count":
+		value := x.MinCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Result.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Result.ans_count":
+		value := x.AnsCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Result.request_time":
+		value := x.RequestTime
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.Result.resolve_time":
+		value := x.ResolveTime
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.Result.resolve_status":
+		value := x.ResolveStatus
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "band.oracle.v1.OracleResponsePacketData.result":
+	case "band.oracle.v1.Result.result":
 		value := x.Result
 		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Result) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.Result.client_id":
+		x.ClientId = value.Interface().(string)
+	case "band.oracle.v1.Result.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.Result.calldata":
+		x.Calldata = value.Bytes()
+	case "band.oracle.v1.Result.ask_count":
+		x.AskCount = value.Uint()
+	case "band.oracle.v1.Result.min_count":
+		x.MinCount = value.Uint()
+	case "band.oracle.v1.Result.request_id":
+		x.RequestId = value.Uint()
+	case "band.oracle.v1.Result.ans_count":
+		x.AnsCount = value.Uint()
+	case "band.oracle.v1.Result.request_time":
+		x.RequestTime = value.Int()
+	case "band.oracle.v1.Result.resolve_time":
+		x.ResolveTime = value.Int()
+	case "band.oracle.v1.Result.resolve_status":
+		x.ResolveStatus = (ResolveStatus)(value.Enum())
+	case "band.oracle.v1.Result.result":
+		x.Result = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Result) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.Result.client_id":
+		panic(fmt.Errorf("field client_id of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.ask_count":
+		panic(fmt.Errorf("field ask_count of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.ans_count":
+		panic(fmt.Errorf("field ans_count of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.request_time":
+		panic(fmt.Errorf("field request_time of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.resolve_time":
+		panic(fmt.Errorf("field resolve_time of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.resolve_status":
+		panic(fmt.Errorf("field resolve_status of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.Result.result":
+		panic(fmt.Errorf("field result of message band.oracle.v1.Result is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Result) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.Result.client_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.Result.oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Result.calldata":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.Result.ask_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Result.min_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Result.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Result.ans_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Result.request_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.Result.resolve_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.Result.resolve_status":
+		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.Result.result":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Result) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Result", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Result) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Result) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Result) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Result)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ClientId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		}
+		l = len(x.Calldata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AskCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AskCount))
+		}
+		if x.MinCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinCount))
+		}
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.AnsCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AnsCount))
+		}
+		if x.RequestTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestTime))
+		}
+		if x.ResolveTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResolveTime))
+		}
+		if x.ResolveStatus != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResolveStatus))
+		}
+		l = len(x.Result)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Result)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Result) > 0 {
+			i -= len(x.Result)
+			copy(dAtA[i:], x.Result)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.ResolveStatus != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveStatus))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ResolveTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveTime))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.RequestTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestTime))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.AnsCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AnsCount))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.MinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.AskCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Calldata) > 0 {
+			i -= len(x.Calldata)
+			copy(dAtA[i:], x.Calldata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ClientId) > 0 {
+			i -= len(x.ClientId)
+			copy(dAtA[i:], x.ClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Result)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Result: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClientId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				}
+				x.OracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Calldata == nil {
+					x.Calldata = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				}
+				x.AskCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AskCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				}
+				x.MinCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
+				}
+				x.AnsCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AnsCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+				}
+				x.RequestTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+				}
+				x.ResolveTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResolveTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
+				}
+				x.ResolveStatus = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResolveStatus |= ResolveStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Result = append(x.Result[:0], dAtA[iNdEx:postIndex]...)
+				if x.Result == nil {
+					x.Result = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SigningResult                 protoreflect.MessageDescriptor
+	fd_SigningResult_signing_id      protoreflect.FieldDescriptor
+	fd_SigningResult_error_codespace protoreflect.FieldDescriptor
+	fd_SigningResult_error_code      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_SigningResult = File_band_oracle_v1_oracle_proto.Messages().ByName("SigningResult")
+	fd_SigningResult_signing_id = md_SigningResult.Fields().ByName("signing_id")
+	fd_SigningResult_error_codespace = md_SigningResult.Fields().ByName("error_codespace")
+	fd_SigningResult_error_code = md_SigningResult.Fields().ByName("error_code")
+}
+
+var _ protoreflect.Message = (*fastReflection_SigningResult)(nil)
+
+type fastReflection_SigningResult SigningResult
+
+func (x *SigningResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SigningResult)(x)
+}
+
+func (x *SigningResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SigningResult_messageType fastReflection_SigningResult_messageType
+var _ protoreflect.MessageType = fastReflection_SigningResult_messageType{}
+
+type fastReflection_SigningResult_messageType struct{}
+
+func (x fastReflection_SigningResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SigningResult)(nil)
+}
+func (x fastReflection_SigningResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_SigningResult)
+}
+func (x fastReflection_SigningResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningResult
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SigningResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningResult
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SigningResult) Type() protoreflect.MessageType {
+	return _fastReflection_SigningResult_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SigningResult) New() protoreflect.Message {
+	return new(fastReflection_SigningResult)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SigningResult) Interface() protoreflect.ProtoMessage {
+	return (*SigningResult)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SigningResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SigningId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SigningId)
+		if !f(fd_SigningResult_signing_id, value) {
+			return
+		}
+	}
+	if x.ErrorCodespace != "" {
+		value := protoreflect.ValueOfString(x.ErrorCodespace)
+		if !f(fd_SigningResult_error_codespace, value) {
+			return
+		}
+	}
+	if x.ErrorCode != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ErrorCode)
+		if !f(fd_SigningResult_error_code, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SigningResult) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.SigningResult.signing_id":
+		return x.SigningId != uint64(0)
+	case "band.oracle.v1.SigningResult.error_codespace":
+		return x.ErrorCodespace != ""
+	case "band.oracle.v1.SigningResult.error_code":
+		return x.ErrorCode != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningResult) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.SigningResult.signing_id":
+		x.SigningId = uint64(0)
+	case "band.oracle.v1.SigningResult.error_codespace":
+		x.ErrorCodespace = ""
+	case "band.oracle.v1.SigningResult.error_code":
+		x.ErrorCode = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SigningResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.SigningResult.signing_id":
+		value := x.SigningId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.SigningResult.error_codespace":
+		value := x.ErrorCodespace
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.SigningResult.error_code":
+		value := x.ErrorCode
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6094,27 +10915,19 @@ func (x *fastReflection_OracleResponsePacketData) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleResponsePacketData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SigningResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleResponsePacketData.client_id":
-		x.ClientId = value.Interface().(string)
-	case "band.oracle.v1.OracleResponsePacketData.request_id":
-		x.RequestId = value.Uint()
-	case "band.oracle.v1.OracleResponsePacketData.ans_count":
-		x.AnsCount = value.Uint()
-	case "band.oracle.v1.OracleResponsePacketData.request_time":
-		x.RequestTime = value.Int()
-	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
-		x.ResolveTime = value.Int()
-	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
-		x.ResolveStatus = (ResolveStatus)(value.Enum())
-	case "band.oracle.v1.OracleResponsePacketData.result":
-		x.Result = value.Bytes()
+	case "band.oracle.v1.SigningResult.signing_id":
+		x.SigningId = value.Uint()
+	case "band.oracle.v1.SigningResult.error_codespace":
+		x.ErrorCodespace = value.Interface().(string)
+	case "band.oracle.v1.SigningResult.error_code":
+		x.ErrorCode = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6128,64 +10941,48 @@ func (x *fastReflection_OracleResponsePacketData) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleResponsePacketData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SigningResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleResponsePacketData.client_id":
-		panic(fmt.Errorf("field client_id of message band.oracle.v1.OracleResponsePacketData is not mutable"))
-	case "band.oracle.v1.OracleResponsePacketData.request_id":
-		panic(fmt.Errorf("field request_id of message band.oracle.v1.OracleResponsePacketData is not mutable"))
-	case "band.oracle.v1.OracleResponsePacketData.ans_count":
-		panic(fmt.Errorf("field ans_count of message band.oracle.v1.OracleResponsePacketData is not mutable"))
-	case "band.oracle.v1.OracleResponsePacketData.request_time":
-		panic(fmt.Errorf("field request_time of message band.oracle.v1.OracleResponsePacketData is not mutable"))
-	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
-		panic(fmt.Errorf("field resolve_time of message band.oracle.v1.OracleResponsePacketData is not mutable"))
-	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
-		panic(fmt.Errorf("field resolve_status of message band.oracle.v1.OracleResponsePacketData is not mutable"))
-	case "band.oracle.v1.OracleResponsePacketData.result":
-		panic(fmt.Errorf("field result of message band.oracle.v1.OracleResponsePacketData is not mutable"))
+	case "band.oracle.v1.SigningResult.signing_id":
+		panic(fmt.Errorf("field signing_id of message band.oracle.v1.SigningResult is not mutable"))
+	case "band.oracle.v1.SigningResult.error_codespace":
+		panic(fmt.Errorf("field error_codespace of message band.oracle.v1.SigningResult is not mutable"))
+	case "band.oracle.v1.SigningResult.error_code":
+		panic(fmt.Errorf("field error_code of message band.oracle.v1.SigningResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_OracleResponsePacketData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SigningResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.OracleResponsePacketData.client_id":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.OracleResponsePacketData.request_id":
+	case "band.oracle.v1.SigningResult.signing_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleResponsePacketData.ans_count":
+	case "band.oracle.v1.SigningResult.error_codespace":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.SigningResult.error_code":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.OracleResponsePacketData.request_time":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.oracle.v1.OracleResponsePacketData.resolve_time":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.oracle.v1.OracleResponsePacketData.resolve_status":
-		return protoreflect.ValueOfEnum(0)
-	case "band.oracle.v1.OracleResponsePacketData.result":
-		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleResponsePacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.OracleResponsePacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_OracleResponsePacketData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SigningResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.OracleResponsePacketData", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.SigningResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6193,7 +10990,7 @@ func (x *fastReflection_OracleResponsePacketData) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_OracleResponsePacketData) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SigningResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6204,7 +11001,7 @@ func (x *fastReflection_OracleResponsePacketData) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OracleResponsePacketData) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SigningResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6216,7 +11013,7 @@ func (x *fastReflection_OracleResponsePacketData) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_OracleResponsePacketData) IsValid() bool {
+func (x *fastReflection_SigningResult) IsValid() bool {
 	return x != nil
 }
 
@@ -6226,9 +11023,9 @@ func (x *fastReflection_OracleResponsePacketData) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SigningResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*OracleResponsePacketData)
+		x := input.Message.Interface().(*SigningResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6240,29 +11037,16 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		l = len(x.ClientId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.RequestId != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestId))
-		}
-		if x.AnsCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.AnsCount))
-		}
-		if x.RequestTime != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestTime))
-		}
-		if x.ResolveTime != 0 {
-			n += 1 + runtime.Sov(uint64(x.ResolveTime))
-		}
-		if x.ResolveStatus != 0 {
-			n += 1 + runtime.Sov(uint64(x.ResolveStatus))
+		if x.SigningId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SigningId))
 		}
-		l = len(x.Result)
+		l = len(x.ErrorCodespace)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ErrorCode != 0 {
+			n += 1 + runtime.Sov(uint64(x.ErrorCode))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6273,7 +11057,7 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*OracleResponsePacketData)
+		x := input.Message.Interface().(*SigningResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6292,44 +11076,22 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Result) > 0 {
-			i -= len(x.Result)
-			copy(dAtA[i:], x.Result)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if x.ResolveStatus != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveStatus))
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.ResolveTime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveTime))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.RequestTime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestTime))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.AnsCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AnsCount))
+		if x.ErrorCode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ErrorCode))
 			i--
 			dAtA[i] = 0x18
 		}
-		if x.RequestId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+		if len(x.ErrorCodespace) > 0 {
+			i -= len(x.ErrorCodespace)
+			copy(dAtA[i:], x.ErrorCodespace)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ErrorCodespace)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if len(x.ClientId) > 0 {
-			i -= len(x.ClientId)
-			copy(dAtA[i:], x.ClientId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+		if x.SigningId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigningId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6342,7 +11104,7 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*OracleResponsePacketData)
+		x := input.Message.Interface().(*SigningResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6374,106 +11136,17 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleResponsePacketData: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OracleResponsePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ClientId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-				}
-				x.RequestId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
-				}
-				x.AnsCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.AnsCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
-				}
-				x.RequestTime = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestTime |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningId", wireType)
 				}
-				x.ResolveTime = 0
+				x.SigningId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6483,16 +11156,16 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ResolveTime |= int64(b&0x7F) << shift
+					x.SigningId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ErrorCodespace", wireType)
 				}
-				x.ResolveStatus = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6502,16 +11175,29 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ResolveStatus |= ResolveStatus(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				var byteLen int
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ErrorCodespace = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+				}
+				x.ErrorCode = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6521,26 +11207,11 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					x.ErrorCode |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Result = append(x.Result[:0], dAtA[iNdEx:postIndex]...)
-				if x.Result == nil {
-					x.Result = []byte{}
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6577,46 +11248,28 @@ func (x *fastReflection_OracleResponsePacketData) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_Result                  protoreflect.MessageDescriptor
-	fd_Result_client_id        protoreflect.FieldDescriptor
-	fd_Result_oracle_script_id protoreflect.FieldDescriptor
-	fd_Result_calldata         protoreflect.FieldDescriptor
-	fd_Result_ask_count        protoreflect.FieldDescriptor
-	fd_Result_min_count        protoreflect.FieldDescriptor
-	fd_Result_request_id       protoreflect.FieldDescriptor
-	fd_Result_ans_count        protoreflect.FieldDescriptor
-	fd_Result_request_time     protoreflect.FieldDescriptor
-	fd_Result_resolve_time     protoreflect.FieldDescriptor
-	fd_Result_resolve_status   protoreflect.FieldDescriptor
-	fd_Result_result           protoreflect.FieldDescriptor
+	md_ValidatorStatus           protoreflect.MessageDescriptor
+	fd_ValidatorStatus_is_active protoreflect.FieldDescriptor
+	fd_ValidatorStatus_since     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_Result = File_band_oracle_v1_oracle_proto.Messages().ByName("Result")
-	fd_Result_client_id = md_Result.Fields().ByName("client_id")
-	fd_Result_oracle_script_id = md_Result.Fields().ByName("oracle_script_id")
-	fd_Result_calldata = md_Result.Fields().ByName("calldata")
-	fd_Result_ask_count = md_Result.Fields().ByName("ask_count")
-	fd_Result_min_count = md_Result.Fields().ByName("min_count")
-	fd_Result_request_id = md_Result.Fields().ByName("request_id")
-	fd_Result_ans_count = md_Result.Fields().ByName("ans_count")
-	fd_Result_request_time = md_Result.Fields().ByName("request_time")
-	fd_Result_resolve_time = md_Result.Fields().ByName("resolve_time")
-	fd_Result_resolve_status = md_Result.Fields().ByName("resolve_status")
-	fd_Result_result = md_Result.Fields().ByName("result")
+	md_ValidatorStatus = File_band_oracle_v1_oracle_proto.Messages().ByName("ValidatorStatus")
+	fd_ValidatorStatus_is_active = md_ValidatorStatus.Fields().ByName("is_active")
+	fd_ValidatorStatus_since = md_ValidatorStatus.Fields().ByName("since")
 }
 
-var _ protoreflect.Message = (*fastReflection_Result)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorStatus)(nil)
 
-type fastReflection_Result Result
+type fastReflection_ValidatorStatus ValidatorStatus
 
-func (x *Result) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Result)(x)
+func (x *ValidatorStatus) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorStatus)(x)
 }
 
-func (x *Result) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
+func (x *ValidatorStatus) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6627,43 +11280,43 @@ func (x *Result) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Result_messageType fastReflection_Result_messageType
-var _ protoreflect.MessageType = fastReflection_Result_messageType{}
+var _fastReflection_ValidatorStatus_messageType fastReflection_ValidatorStatus_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorStatus_messageType{}
 
-type fastReflection_Result_messageType struct{}
+type fastReflection_ValidatorStatus_messageType struct{}
 
-func (x fastReflection_Result_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Result)(nil)
+func (x fastReflection_ValidatorStatus_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorStatus)(nil)
 }
-func (x fastReflection_Result_messageType) New() protoreflect.Message {
-	return new(fastReflection_Result)
+func (x fastReflection_ValidatorStatus_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorStatus)
 }
-func (x fastReflection_Result_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Result
+func (x fastReflection_ValidatorStatus_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorStatus
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Result) Descriptor() protoreflect.MessageDescriptor {
-	return md_Result
+func (x *fastReflection_ValidatorStatus) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorStatus
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Result) Type() protoreflect.MessageType {
-	return _fastReflection_Result_messageType
+func (x *fastReflection_ValidatorStatus) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorStatus_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Result) New() protoreflect.Message {
-	return new(fastReflection_Result)
+func (x *fastReflection_ValidatorStatus) New() protoreflect.Message {
+	return new(fastReflection_ValidatorStatus)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Result) Interface() protoreflect.ProtoMessage {
-	return (*Result)(x)
+func (x *fastReflection_ValidatorStatus) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorStatus)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6671,70 +11324,16 @@ func (x *fastReflection_Result) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Result) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ClientId != "" {
-		value := protoreflect.ValueOfString(x.ClientId)
-		if !f(fd_Result_client_id, value) {
-			return
-		}
-	}
-	if x.OracleScriptId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OracleScriptId)
-		if !f(fd_Result_oracle_script_id, value) {
-			return
-		}
-	}
-	if len(x.Calldata) != 0 {
-		value := protoreflect.ValueOfBytes(x.Calldata)
-		if !f(fd_Result_calldata, value) {
-			return
-		}
-	}
-	if x.AskCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.AskCount)
-		if !f(fd_Result_ask_count, value) {
-			return
-		}
-	}
-	if x.MinCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MinCount)
-		if !f(fd_Result_min_count, value) {
-			return
-		}
-	}
-	if x.RequestId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.RequestId)
-		if !f(fd_Result_request_id, value) {
-			return
-		}
-	}
-	if x.AnsCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.AnsCount)
-		if !f(fd_Result_ans_count, value) {
-			return
-		}
-	}
-	if x.RequestTime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.RequestTime)
-		if !f(fd_Result_request_time, value) {
-			return
-		}
-	}
-	if x.ResolveTime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ResolveTime)
-		if !f(fd_Result_resolve_time, value) {
-			return
-		}
-	}
-	if x.ResolveStatus != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ResolveStatus))
-		if !f(fd_Result_resolve_status, value) {
+func (x *fastReflection_ValidatorStatus) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.IsActive != false {
+		value := protoreflect.ValueOfBool(x.IsActive)
+		if !f(fd_ValidatorStatus_is_active, value) {
 			return
 		}
 	}
-	if len(x.Result) != 0 {
-		value := protoreflect.ValueOfBytes(x.Result)
-		if !f(fd_Result_result, value) {
+	if x.Since != nil {
+		value := protoreflect.ValueOfMessage(x.Since.ProtoReflect())
+		if !f(fd_ValidatorStatus_since, value) {
 			return
 		}
 	}
@@ -6751,35 +11350,17 @@ func (x *fastReflection_Result) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Result) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorStatus) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.Result.client_id":
-		return x.ClientId != ""
-	case "band.oracle.v1.Result.oracle_script_id":
-		return x.OracleScriptId != uint64(0)
-	case "band.oracle.v1.Result.calldata":
-		return len(x.Calldata) != 0
-	case "band.oracle.v1.Result.ask_count":
-		return x.AskCount != uint64(0)
-	case "band.oracle.v1.Result.min_count":
-		return x.MinCount != uint64(0)
-	case "band.oracle.v1.Result.request_id":
-		return x.RequestId != uint64(0)
-	case "band.oracle.v1.Result.ans_count":
-		return x.AnsCount != uint64(0)
-	case "band.oracle.v1.Result.request_time":
-		return x.RequestTime != int64(0)
-	case "band.oracle.v1.Result.resolve_time":
-		return x.ResolveTime != int64(0)
-	case "band.oracle.v1.Result.resolve_status":
-		return x.ResolveStatus != 0
-	case "band.oracle.v1.Result.result":
-		return len(x.Result) != 0
+	case "band.oracle.v1.ValidatorStatus.is_active":
+		return x.IsActive != false
+	case "band.oracle.v1.ValidatorStatus.since":
+		return x.Since != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6789,35 +11370,17 @@ func (x *fastReflection_Result) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Result) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorStatus) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Result.client_id":
-		x.ClientId = ""
-	case "band.oracle.v1.Result.oracle_script_id":
-		x.OracleScriptId = uint64(0)
-	case "band.oracle.v1.Result.calldata":
-		x.Calldata = nil
-	case "band.oracle.v1.Result.ask_count":
-		x.AskCount = uint64(0)
-	case "band.oracle.v1.Result.min_count":
-		x.MinCount = uint64(0)
-	case "band.oracle.v1.Result.request_id":
-		x.RequestId = uint64(0)
-	case "band.oracle.v1.Result.ans_count":
-		x.AnsCount = uint64(0)
-	case "band.oracle.v1.Result.request_time":
-		x.RequestTime = int64(0)
-	case "band.oracle.v1.Result.resolve_time":
-		x.ResolveTime = int64(0)
-	case "band.oracle.v1.Result.resolve_status":
-		x.ResolveStatus = 0
-	case "band.oracle.v1.Result.result":
-		x.Result = nil
+	case "band.oracle.v1.ValidatorStatus.is_active":
+		x.IsActive = false
+	case "band.oracle.v1.ValidatorStatus.since":
+		x.Since = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6827,46 +11390,19 @@ func (x *fastReflection_Result) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Result) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorStatus) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.Result.client_id":
-		value := x.ClientId
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.Result.oracle_script_id":
-		value := x.OracleScriptId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Result.calldata":
-		value := x.Calldata
-		return protoreflect.ValueOfBytes(value)
-	case "band.oracle.v1.Result.ask_count":
-		value := x.AskCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Result.min_count":
-		value := x.MinCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Result.request_id":
-		value := x.RequestId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Result.ans_count":
-		value := x.AnsCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Result.request_time":
-		value := x.RequestTime
-		return protoreflect.ValueOfInt64(value)
-	case "band.oracle.v1.Result.resolve_time":
-		value := x.ResolveTime
-		return protoreflect.ValueOfInt64(value)
-	case "band.oracle.v1.Result.resolve_status":
-		value := x.ResolveStatus
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "band.oracle.v1.Result.result":
-		value := x.Result
-		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.ValidatorStatus.is_active":
+		value := x.IsActive
+		return protoreflect.ValueOfBool(value)
+	case "band.oracle.v1.ValidatorStatus.since":
+		value := x.Since
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6880,35 +11416,17 @@ func (x *fastReflection_Result) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Result) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorStatus) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Result.client_id":
-		x.ClientId = value.Interface().(string)
-	case "band.oracle.v1.Result.oracle_script_id":
-		x.OracleScriptId = value.Uint()
-	case "band.oracle.v1.Result.calldata":
-		x.Calldata = value.Bytes()
-	case "band.oracle.v1.Result.ask_count":
-		x.AskCount = value.Uint()
-	case "band.oracle.v1.Result.min_count":
-		x.MinCount = value.Uint()
-	case "band.oracle.v1.Result.request_id":
-		x.RequestId = value.Uint()
-	case "band.oracle.v1.Result.ans_count":
-		x.AnsCount = value.Uint()
-	case "band.oracle.v1.Result.request_time":
-		x.RequestTime = value.Int()
-	case "band.oracle.v1.Result.resolve_time":
-		x.ResolveTime = value.Int()
-	case "band.oracle.v1.Result.resolve_status":
-		x.ResolveStatus = (ResolveStatus)(value.Enum())
-	case "band.oracle.v1.Result.result":
-		x.Result = value.Bytes()
+	case "band.oracle.v1.ValidatorStatus.is_active":
+		x.IsActive = value.Bool()
+	case "band.oracle.v1.ValidatorStatus.since":
+		x.Since = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6922,80 +11440,48 @@ func (x *fastReflection_Result) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Result) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorStatus) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Result.client_id":
-		panic(fmt.Errorf("field client_id of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.oracle_script_id":
-		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.calldata":
-		panic(fmt.Errorf("field calldata of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.ask_count":
-		panic(fmt.Errorf("field ask_count of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.min_count":
-		panic(fmt.Errorf("field min_count of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.request_id":
-		panic(fmt.Errorf("field request_id of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.ans_count":
-		panic(fmt.Errorf("field ans_count of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.request_time":
-		panic(fmt.Errorf("field request_time of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.resolve_time":
-		panic(fmt.Errorf("field resolve_time of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.resolve_status":
-		panic(fmt.Errorf("field resolve_status of message band.oracle.v1.Result is not mutable"))
-	case "band.oracle.v1.Result.result":
-		panic(fmt.Errorf("field result of message band.oracle.v1.Result is not mutable"))
+	case "band.oracle.v1.ValidatorStatus.since":
+		if x.Since == nil {
+			x.Since = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Since.ProtoReflect())
+	case "band.oracle.v1.ValidatorStatus.is_active":
+		panic(fmt.Errorf("field is_active of message band.oracle.v1.ValidatorStatus is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Result) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorStatus) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Result.client_id":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.Result.oracle_script_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Result.calldata":
-		return protoreflect.ValueOfBytes(nil)
-	case "band.oracle.v1.Result.ask_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Result.min_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Result.request_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Result.ans_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Result.request_time":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.oracle.v1.Result.resolve_time":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.oracle.v1.Result.resolve_status":
-		return protoreflect.ValueOfEnum(0)
-	case "band.oracle.v1.Result.result":
-		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.ValidatorStatus.is_active":
+		return protoreflect.ValueOfBool(false)
+	case "band.oracle.v1.ValidatorStatus.since":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Result"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Result does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Result) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorStatus) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Result", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.ValidatorStatus", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7003,7 +11489,7 @@ func (x *fastReflection_Result) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Result) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorStatus) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7014,7 +11500,7 @@ func (x *fastReflection_Result) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Result) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorStatus) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7026,7 +11512,7 @@ func (x *fastReflection_Result) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Result) IsValid() bool {
+func (x *fastReflection_ValidatorStatus) IsValid() bool {
 	return x != nil
 }
 
@@ -7036,9 +11522,9 @@ func (x *fastReflection_Result) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Result)
+		x := input.Message.Interface().(*ValidatorStatus)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7050,40 +11536,11 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.ClientId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.OracleScriptId != 0 {
-			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
-		}
-		l = len(x.Calldata)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.AskCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.AskCount))
-		}
-		if x.MinCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.MinCount))
-		}
-		if x.RequestId != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestId))
-		}
-		if x.AnsCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.AnsCount))
-		}
-		if x.RequestTime != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestTime))
-		}
-		if x.ResolveTime != 0 {
-			n += 1 + runtime.Sov(uint64(x.ResolveTime))
-		}
-		if x.ResolveStatus != 0 {
-			n += 1 + runtime.Sov(uint64(x.ResolveStatus))
+		if x.IsActive {
+			n += 2
 		}
-		l = len(x.Result)
-		if l > 0 {
+		if x.Since != nil {
+			l = options.Size(x.Since)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -7096,85 +11553,48 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Result)
+		x := input.Message.Interface().(*ValidatorStatus)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.Result) > 0 {
-			i -= len(x.Result)
-			copy(dAtA[i:], x.Result)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
-			i--
-			dAtA[i] = 0x5a
-		}
-		if x.ResolveStatus != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveStatus))
-			i--
-			dAtA[i] = 0x50
-		}
-		if x.ResolveTime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolveTime))
-			i--
-			dAtA[i] = 0x48
-		}
-		if x.RequestTime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestTime))
-			i--
-			dAtA[i] = 0x40
-		}
-		if x.AnsCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AnsCount))
-			i--
-			dAtA[i] = 0x38
-		}
-		if x.RequestId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.MinCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.AskCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
-			i--
-			dAtA[i] = 0x20
+				Buf:               input.Buf,
+			}, nil
 		}
-		if len(x.Calldata) > 0 {
-			i -= len(x.Calldata)
-			copy(dAtA[i:], x.Calldata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
-			i--
-			dAtA[i] = 0x1a
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.OracleScriptId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+		if x.Since != nil {
+			encoded, err := options.Marshal(x.Since)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if len(x.ClientId) > 0 {
-			i -= len(x.ClientId)
-			copy(dAtA[i:], x.ClientId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+		if x.IsActive {
 			i--
-			dAtA[i] = 0xa
+			if x.IsActive {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7187,7 +11607,7 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Result)
+		x := input.Message.Interface().(*ValidatorStatus)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7218,217 +11638,18 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 			}
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Result: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ClientId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
-				}
-				x.OracleScriptId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OracleScriptId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
-				if x.Calldata == nil {
-					x.Calldata = []byte{}
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
-				}
-				x.AskCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.AskCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
-				}
-				x.MinCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MinCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-				}
-				x.RequestId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 7:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
-				}
-				x.AnsCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.AnsCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
-				}
-				x.RequestTime = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestTime |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 9:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
-				}
-				x.ResolveTime = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ResolveTime |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 10:
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorStatus: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
 				}
-				x.ResolveStatus = 0
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7438,16 +11659,17 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ResolveStatus |= ResolveStatus(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 11:
+				x.IsActive = bool(v != 0)
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7457,24 +11679,26 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Result = append(x.Result[:0], dAtA[iNdEx:postIndex]...)
-				if x.Result == nil {
-					x.Result = []byte{}
+				if x.Since == nil {
+					x.Since = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Since); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -7513,30 +11737,28 @@ func (x *fastReflection_Result) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SigningResult                 protoreflect.MessageDescriptor
-	fd_SigningResult_signing_id      protoreflect.FieldDescriptor
-	fd_SigningResult_error_codespace protoreflect.FieldDescriptor
-	fd_SigningResult_error_code      protoreflect.FieldDescriptor
+	md_ActiveValidator         protoreflect.MessageDescriptor
+	fd_ActiveValidator_address protoreflect.FieldDescriptor
+	fd_ActiveValidator_power   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_SigningResult = File_band_oracle_v1_oracle_proto.Messages().ByName("SigningResult")
-	fd_SigningResult_signing_id = md_SigningResult.Fields().ByName("signing_id")
-	fd_SigningResult_error_codespace = md_SigningResult.Fields().ByName("error_codespace")
-	fd_SigningResult_error_code = md_SigningResult.Fields().ByName("error_code")
+	md_ActiveValidator = File_band_oracle_v1_oracle_proto.Messages().ByName("ActiveValidator")
+	fd_ActiveValidator_address = md_ActiveValidator.Fields().ByName("address")
+	fd_ActiveValidator_power = md_ActiveValidator.Fields().ByName("power")
 }
 
-var _ protoreflect.Message = (*fastReflection_SigningResult)(nil)
+var _ protoreflect.Message = (*fastReflection_ActiveValidator)(nil)
 
-type fastReflection_SigningResult SigningResult
+type fastReflection_ActiveValidator ActiveValidator
 
-func (x *SigningResult) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SigningResult)(x)
+func (x *ActiveValidator) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ActiveValidator)(x)
 }
 
-func (x *SigningResult) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
+func (x *ActiveValidator) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7547,43 +11769,43 @@ func (x *SigningResult) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SigningResult_messageType fastReflection_SigningResult_messageType
-var _ protoreflect.MessageType = fastReflection_SigningResult_messageType{}
+var _fastReflection_ActiveValidator_messageType fastReflection_ActiveValidator_messageType
+var _ protoreflect.MessageType = fastReflection_ActiveValidator_messageType{}
 
-type fastReflection_SigningResult_messageType struct{}
+type fastReflection_ActiveValidator_messageType struct{}
 
-func (x fastReflection_SigningResult_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SigningResult)(nil)
+func (x fastReflection_ActiveValidator_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ActiveValidator)(nil)
 }
-func (x fastReflection_SigningResult_messageType) New() protoreflect.Message {
-	return new(fastReflection_SigningResult)
+func (x fastReflection_ActiveValidator_messageType) New() protoreflect.Message {
+	return new(fastReflection_ActiveValidator)
 }
-func (x fastReflection_SigningResult_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SigningResult
+func (x fastReflection_ActiveValidator_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ActiveValidator
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SigningResult) Descriptor() protoreflect.MessageDescriptor {
-	return md_SigningResult
+func (x *fastReflection_ActiveValidator) Descriptor() protoreflect.MessageDescriptor {
+	return md_ActiveValidator
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SigningResult) Type() protoreflect.MessageType {
-	return _fastReflection_SigningResult_messageType
+func (x *fastReflection_ActiveValidator) Type() protoreflect.MessageType {
+	return _fastReflection_ActiveValidator_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SigningResult) New() protoreflect.Message {
-	return new(fastReflection_SigningResult)
+func (x *fastReflection_ActiveValidator) New() protoreflect.Message {
+	return new(fastReflection_ActiveValidator)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SigningResult) Interface() protoreflect.ProtoMessage {
-	return (*SigningResult)(x)
+func (x *fastReflection_ActiveValidator) Interface() protoreflect.ProtoMessage {
+	return (*ActiveValidator)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7591,22 +11813,16 @@ func (x *fastReflection_SigningResult) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SigningResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.SigningId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.SigningId)
-		if !f(fd_SigningResult_signing_id, value) {
-			return
-		}
-	}
-	if x.ErrorCodespace != "" {
-		value := protoreflect.ValueOfString(x.ErrorCodespace)
-		if !f(fd_SigningResult_error_codespace, value) {
+func (x *fastReflection_ActiveValidator) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ActiveValidator_address, value) {
 			return
 		}
 	}
-	if x.ErrorCode != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ErrorCode)
-		if !f(fd_SigningResult_error_code, value) {
+	if x.Power != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Power)
+		if !f(fd_ActiveValidator_power, value) {
 			return
 		}
 	}
@@ -7623,19 +11839,17 @@ func (x *fastReflection_SigningResult) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SigningResult) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ActiveValidator) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.SigningResult.signing_id":
-		return x.SigningId != uint64(0)
-	case "band.oracle.v1.SigningResult.error_codespace":
-		return x.ErrorCodespace != ""
-	case "band.oracle.v1.SigningResult.error_code":
-		return x.ErrorCode != uint64(0)
+	case "band.oracle.v1.ActiveValidator.address":
+		return x.Address != ""
+	case "band.oracle.v1.ActiveValidator.power":
+		return x.Power != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7645,19 +11859,17 @@ func (x *fastReflection_SigningResult) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SigningResult) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ActiveValidator) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.SigningResult.signing_id":
-		x.SigningId = uint64(0)
-	case "band.oracle.v1.SigningResult.error_codespace":
-		x.ErrorCodespace = ""
-	case "band.oracle.v1.SigningResult.error_code":
-		x.ErrorCode = uint64(0)
+	case "band.oracle.v1.ActiveValidator.address":
+		x.Address = ""
+	case "band.oracle.v1.ActiveValidator.power":
+		x.Power = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7667,22 +11879,19 @@ func (x *fastReflection_SigningResult) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SigningResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ActiveValidator) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.SigningResult.signing_id":
-		value := x.SigningId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.SigningResult.error_codespace":
-		value := x.ErrorCodespace
+	case "band.oracle.v1.ActiveValidator.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.SigningResult.error_code":
-		value := x.ErrorCode
+	case "band.oracle.v1.ActiveValidator.power":
+		value := x.Power
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7696,19 +11905,17 @@ func (x *fastReflection_SigningResult) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SigningResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ActiveValidator) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.SigningResult.signing_id":
-		x.SigningId = value.Uint()
-	case "band.oracle.v1.SigningResult.error_codespace":
-		x.ErrorCodespace = value.Interface().(string)
-	case "band.oracle.v1.SigningResult.error_code":
-		x.ErrorCode = value.Uint()
+	case "band.oracle.v1.ActiveValidator.address":
+		x.Address = value.Interface().(string)
+	case "band.oracle.v1.ActiveValidator.power":
+		x.Power = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7722,48 +11929,44 @@ func (x *fastReflection_SigningResult) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SigningResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ActiveValidator) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.SigningResult.signing_id":
-		panic(fmt.Errorf("field signing_id of message band.oracle.v1.SigningResult is not mutable"))
-	case "band.oracle.v1.SigningResult.error_codespace":
-		panic(fmt.Errorf("field error_codespace of message band.oracle.v1.SigningResult is not mutable"))
-	case "band.oracle.v1.SigningResult.error_code":
-		panic(fmt.Errorf("field error_code of message band.oracle.v1.SigningResult is not mutable"))
+	case "band.oracle.v1.ActiveValidator.address":
+		panic(fmt.Errorf("field address of message band.oracle.v1.ActiveValidator is not mutable"))
+	case "band.oracle.v1.ActiveValidator.power":
+		panic(fmt.Errorf("field power of message band.oracle.v1.ActiveValidator is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SigningResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ActiveValidator) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.SigningResult.signing_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.SigningResult.error_codespace":
+	case "band.oracle.v1.ActiveValidator.address":
 		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.SigningResult.error_code":
+	case "band.oracle.v1.ActiveValidator.power":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.SigningResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.SigningResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SigningResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ActiveValidator) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.SigningResult", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.ActiveValidator", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7771,7 +11974,7 @@ func (x *fastReflection_SigningResult) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SigningResult) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ActiveValidator) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7782,7 +11985,7 @@ func (x *fastReflection_SigningResult) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SigningResult) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ActiveValidator) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7794,7 +11997,7 @@ func (x *fastReflection_SigningResult) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SigningResult) IsValid() bool {
+func (x *fastReflection_ActiveValidator) IsValid() bool {
 	return x != nil
 }
 
@@ -7804,9 +12007,9 @@ func (x *fastReflection_SigningResult) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SigningResult) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SigningResult)
+		x := input.Message.Interface().(*ActiveValidator)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7818,15 +12021,12 @@ func (x *fastReflection_SigningResult) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.SigningId != 0 {
-			n += 1 + runtime.Sov(uint64(x.SigningId))
-		}
-		l = len(x.ErrorCodespace)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ErrorCode != 0 {
-			n += 1 + runtime.Sov(uint64(x.ErrorCode))
+		if x.Power != 0 {
+			n += 1 + runtime.Sov(uint64(x.Power))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7838,7 +12038,7 @@ func (x *fastReflection_SigningResult) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SigningResult)
+		x := input.Message.Interface().(*ActiveValidator)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7857,22 +12057,17 @@ func (x *fastReflection_SigningResult) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ErrorCode != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ErrorCode))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.ErrorCodespace) > 0 {
-			i -= len(x.ErrorCodespace)
-			copy(dAtA[i:], x.ErrorCodespace)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ErrorCodespace)))
+		if x.Power != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if x.SigningId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigningId))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7885,7 +12080,7 @@ func (x *fastReflection_SigningResult) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SigningResult)
+		x := input.Message.Interface().(*ActiveValidator)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7917,34 +12112,15 @@ func (x *fastReflection_SigningResult) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningResult: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActiveValidator: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningResult: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActiveValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningId", wireType)
-				}
-				x.SigningId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.SigningId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ErrorCodespace", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7972,13 +12148,13 @@ func (x *fastReflection_SigningResult) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ErrorCodespace = string(dAtA[iNdEx:postIndex])
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 				}
-				x.ErrorCode = 0
+				x.Power = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7988,7 +12164,7 @@ func (x *fastReflection_SigningResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ErrorCode |= uint64(b&0x7F) << shift
+					x.Power |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -8015,106 +12191,317 @@ func (x *fastReflection_SigningResult) ProtoMethods() *protoiface.Methods {
 		if iNdEx > l {
 			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Params_21_list)(nil)
+
+type _Params_21_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_21_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_21_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_21_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_21_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_21_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_21_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_21_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_21_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                                   protoreflect.MessageDescriptor
+	fd_Params_max_raw_request_count             protoreflect.FieldDescriptor
+	fd_Params_max_ask_count                     protoreflect.FieldDescriptor
+	fd_Params_max_calldata_size                 protoreflect.FieldDescriptor
+	fd_Params_max_report_data_size              protoreflect.FieldDescriptor
+	fd_Params_expiration_block_count            protoreflect.FieldDescriptor
+	fd_Params_base_owasm_gas                    protoreflect.FieldDescriptor
+	fd_Params_per_validator_request_gas         protoreflect.FieldDescriptor
+	fd_Params_sampling_try_count                protoreflect.FieldDescriptor
+	fd_Params_oracle_reward_percentage          protoreflect.FieldDescriptor
+	fd_Params_inactive_penalty_duration         protoreflect.FieldDescriptor
+	fd_Params_ibc_request_enabled               protoreflect.FieldDescriptor
+	fd_Params_reliability_sampling_enabled      protoreflect.FieldDescriptor
+	fd_Params_reliability_weight_percentage     protoreflect.FieldDescriptor
+	fd_Params_result_retention_period           protoreflect.FieldDescriptor
+	fd_Params_result_retention_count            protoreflect.FieldDescriptor
+	fd_Params_max_pruned_results_per_block      protoreflect.FieldDescriptor
+	fd_Params_ibc_request_rate_limit_window     protoreflect.FieldDescriptor
+	fd_Params_max_ibc_requests_per_channel      protoreflect.FieldDescriptor
+	fd_Params_max_ibc_requests_per_light_client protoreflect.FieldDescriptor
+	fd_Params_min_recurring_request_interval    protoreflect.FieldDescriptor
+	fd_Params_recurring_request_spawn_fee       protoreflect.FieldDescriptor
+	fd_Params_reliability_window_size           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_Params = File_band_oracle_v1_oracle_proto.Messages().ByName("Params")
+	fd_Params_max_raw_request_count = md_Params.Fields().ByName("max_raw_request_count")
+	fd_Params_max_ask_count = md_Params.Fields().ByName("max_ask_count")
+	fd_Params_max_calldata_size = md_Params.Fields().ByName("max_calldata_size")
+	fd_Params_max_report_data_size = md_Params.Fields().ByName("max_report_data_size")
+	fd_Params_expiration_block_count = md_Params.Fields().ByName("expiration_block_count")
+	fd_Params_base_owasm_gas = md_Params.Fields().ByName("base_owasm_gas")
+	fd_Params_per_validator_request_gas = md_Params.Fields().ByName("per_validator_request_gas")
+	fd_Params_sampling_try_count = md_Params.Fields().ByName("sampling_try_count")
+	fd_Params_oracle_reward_percentage = md_Params.Fields().ByName("oracle_reward_percentage")
+	fd_Params_inactive_penalty_duration = md_Params.Fields().ByName("inactive_penalty_duration")
+	fd_Params_ibc_request_enabled = md_Params.Fields().ByName("ibc_request_enabled")
+	fd_Params_reliability_sampling_enabled = md_Params.Fields().ByName("reliability_sampling_enabled")
+	fd_Params_reliability_weight_percentage = md_Params.Fields().ByName("reliability_weight_percentage")
+	fd_Params_result_retention_period = md_Params.Fields().ByName("result_retention_period")
+	fd_Params_result_retention_count = md_Params.Fields().ByName("result_retention_count")
+	fd_Params_max_pruned_results_per_block = md_Params.Fields().ByName("max_pruned_results_per_block")
+	fd_Params_ibc_request_rate_limit_window = md_Params.Fields().ByName("ibc_request_rate_limit_window")
+	fd_Params_max_ibc_requests_per_channel = md_Params.Fields().ByName("max_ibc_requests_per_channel")
+	fd_Params_max_ibc_requests_per_light_client = md_Params.Fields().ByName("max_ibc_requests_per_light_client")
+	fd_Params_min_recurring_request_interval = md_Params.Fields().ByName("min_recurring_request_interval")
+	fd_Params_recurring_request_spawn_fee = md_Params.Fields().ByName("recurring_request_spawn_fee")
+	fd_Params_reliability_window_size = md_Params.Fields().ByName("reliability_window_size")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxRawRequestCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRawRequestCount)
+		if !f(fd_Params_max_raw_request_count, value) {
+			return
+		}
+	}
+	if x.MaxAskCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxAskCount)
+		if !f(fd_Params_max_ask_count, value) {
+			return
+		}
+	}
+	if x.MaxCalldataSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxCalldataSize)
+		if !f(fd_Params_max_calldata_size, value) {
+			return
+		}
+	}
+	if x.MaxReportDataSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxReportDataSize)
+		if !f(fd_Params_max_report_data_size, value) {
+			return
+		}
+	}
+	if x.ExpirationBlockCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExpirationBlockCount)
+		if !f(fd_Params_expiration_block_count, value) {
+			return
+		}
+	}
+	if x.BaseOwasmGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BaseOwasmGas)
+		if !f(fd_Params_base_owasm_gas, value) {
+			return
+		}
+	}
+	if x.PerValidatorRequestGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PerValidatorRequestGas)
+		if !f(fd_Params_per_validator_request_gas, value) {
+			return
+		}
+	}
+	if x.SamplingTryCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SamplingTryCount)
+		if !f(fd_Params_sampling_try_count, value) {
+			return
+		}
+	}
+	if x.OracleRewardPercentage != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleRewardPercentage)
+		if !f(fd_Params_oracle_reward_percentage, value) {
+			return
+		}
+	}
+	if x.InactivePenaltyDuration != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InactivePenaltyDuration)
+		if !f(fd_Params_inactive_penalty_duration, value) {
+			return
+		}
+	}
+	if x.IbcRequestEnabled != false {
+		value := protoreflect.ValueOfBool(x.IbcRequestEnabled)
+		if !f(fd_Params_ibc_request_enabled, value) {
+			return
+		}
+	}
+	if x.ReliabilitySamplingEnabled != false {
+		value := protoreflect.ValueOfBool(x.ReliabilitySamplingEnabled)
+		if !f(fd_Params_reliability_sampling_enabled, value) {
+			return
+		}
+	}
+	if x.ReliabilityWeightPercentage != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReliabilityWeightPercentage)
+		if !f(fd_Params_reliability_weight_percentage, value) {
+			return
+		}
+	}
+	if x.ResultRetentionPeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ResultRetentionPeriod)
+		if !f(fd_Params_result_retention_period, value) {
+			return
+		}
+	}
+	if x.ResultRetentionCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ResultRetentionCount)
+		if !f(fd_Params_result_retention_count, value) {
+			return
+		}
+	}
+	if x.MaxPrunedResultsPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPrunedResultsPerBlock)
+		if !f(fd_Params_max_pruned_results_per_block, value) {
+			return
+		}
+	}
+	if x.IbcRequestRateLimitWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IbcRequestRateLimitWindow)
+		if !f(fd_Params_ibc_request_rate_limit_window, value) {
+			return
+		}
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	if x.MaxIbcRequestsPerChannel != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxIbcRequestsPerChannel)
+		if !f(fd_Params_max_ibc_requests_per_channel, value) {
+			return
+		}
 	}
-}
-
-var (
-	md_ValidatorStatus           protoreflect.MessageDescriptor
-	fd_ValidatorStatus_is_active protoreflect.FieldDescriptor
-	fd_ValidatorStatus_since     protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_band_oracle_v1_oracle_proto_init()
-	md_ValidatorStatus = File_band_oracle_v1_oracle_proto.Messages().ByName("ValidatorStatus")
-	fd_ValidatorStatus_is_active = md_ValidatorStatus.Fields().ByName("is_active")
-	fd_ValidatorStatus_since = md_ValidatorStatus.Fields().ByName("since")
-}
-
-var _ protoreflect.Message = (*fastReflection_ValidatorStatus)(nil)
-
-type fastReflection_ValidatorStatus ValidatorStatus
-
-func (x *ValidatorStatus) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorStatus)(x)
-}
-
-func (x *ValidatorStatus) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+	if x.MaxIbcRequestsPerLightClient != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxIbcRequestsPerLightClient)
+		if !f(fd_Params_max_ibc_requests_per_light_client, value) {
+			return
 		}
-		return ms
 	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_ValidatorStatus_messageType fastReflection_ValidatorStatus_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorStatus_messageType{}
-
-type fastReflection_ValidatorStatus_messageType struct{}
-
-func (x fastReflection_ValidatorStatus_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorStatus)(nil)
-}
-func (x fastReflection_ValidatorStatus_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorStatus)
-}
-func (x fastReflection_ValidatorStatus_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorStatus
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_ValidatorStatus) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorStatus
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorStatus) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorStatus_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorStatus) New() protoreflect.Message {
-	return new(fastReflection_ValidatorStatus)
-}
-
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorStatus) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorStatus)(x)
-}
-
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_ValidatorStatus) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.IsActive != false {
-		value := protoreflect.ValueOfBool(x.IsActive)
-		if !f(fd_ValidatorStatus_is_active, value) {
+	if x.MinRecurringRequestInterval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinRecurringRequestInterval)
+		if !f(fd_Params_min_recurring_request_interval, value) {
 			return
 		}
 	}
-	if x.Since != nil {
-		value := protoreflect.ValueOfMessage(x.Since.ProtoReflect())
-		if !f(fd_ValidatorStatus_since, value) {
+	if len(x.RecurringRequestSpawnFee) != 0 {
+		value := protoreflect.ValueOfList(&_Params_21_list{list: &x.RecurringRequestSpawnFee})
+		if !f(fd_Params_recurring_request_spawn_fee, value) {
+			return
+		}
+	}
+	if x.ReliabilityWindowSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReliabilityWindowSize)
+		if !f(fd_Params_reliability_window_size, value) {
 			return
 		}
 	}
@@ -8131,17 +12518,57 @@ func (x *fastReflection_ValidatorStatus) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorStatus) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.ValidatorStatus.is_active":
-		return x.IsActive != false
-	case "band.oracle.v1.ValidatorStatus.since":
-		return x.Since != nil
+	case "band.oracle.v1.Params.max_raw_request_count":
+		return x.MaxRawRequestCount != uint64(0)
+	case "band.oracle.v1.Params.max_ask_count":
+		return x.MaxAskCount != uint64(0)
+	case "band.oracle.v1.Params.max_calldata_size":
+		return x.MaxCalldataSize != uint64(0)
+	case "band.oracle.v1.Params.max_report_data_size":
+		return x.MaxReportDataSize != uint64(0)
+	case "band.oracle.v1.Params.expiration_block_count":
+		return x.ExpirationBlockCount != uint64(0)
+	case "band.oracle.v1.Params.base_owasm_gas":
+		return x.BaseOwasmGas != uint64(0)
+	case "band.oracle.v1.Params.per_validator_request_gas":
+		return x.PerValidatorRequestGas != uint64(0)
+	case "band.oracle.v1.Params.sampling_try_count":
+		return x.SamplingTryCount != uint64(0)
+	case "band.oracle.v1.Params.oracle_reward_percentage":
+		return x.OracleRewardPercentage != uint64(0)
+	case "band.oracle.v1.Params.inactive_penalty_duration":
+		return x.InactivePenaltyDuration != uint64(0)
+	case "band.oracle.v1.Params.ibc_request_enabled":
+		return x.IbcRequestEnabled != false
+	case "band.oracle.v1.Params.reliability_sampling_enabled":
+		return x.ReliabilitySamplingEnabled != false
+	case "band.oracle.v1.Params.reliability_weight_percentage":
+		return x.ReliabilityWeightPercentage != uint64(0)
+	case "band.oracle.v1.Params.result_retention_period":
+		return x.ResultRetentionPeriod != uint64(0)
+	case "band.oracle.v1.Params.result_retention_count":
+		return x.ResultRetentionCount != uint64(0)
+	case "band.oracle.v1.Params.max_pruned_results_per_block":
+		return x.MaxPrunedResultsPerBlock != uint64(0)
+	case "band.oracle.v1.Params.ibc_request_rate_limit_window":
+		return x.IbcRequestRateLimitWindow != uint64(0)
+	case "band.oracle.v1.Params.max_ibc_requests_per_channel":
+		return x.MaxIbcRequestsPerChannel != uint64(0)
+	case "band.oracle.v1.Params.max_ibc_requests_per_light_client":
+		return x.MaxIbcRequestsPerLightClient != uint64(0)
+	case "band.oracle.v1.Params.min_recurring_request_interval":
+		return x.MinRecurringRequestInterval != uint64(0)
+	case "band.oracle.v1.Params.recurring_request_spawn_fee":
+		return len(x.RecurringRequestSpawnFee) != 0
+	case "band.oracle.v1.Params.reliability_window_size":
+		return x.ReliabilityWindowSize != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8151,17 +12578,57 @@ func (x *fastReflection_ValidatorStatus) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorStatus) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.ValidatorStatus.is_active":
-		x.IsActive = false
-	case "band.oracle.v1.ValidatorStatus.since":
-		x.Since = nil
+	case "band.oracle.v1.Params.max_raw_request_count":
+		x.MaxRawRequestCount = uint64(0)
+	case "band.oracle.v1.Params.max_ask_count":
+		x.MaxAskCount = uint64(0)
+	case "band.oracle.v1.Params.max_calldata_size":
+		x.MaxCalldataSize = uint64(0)
+	case "band.oracle.v1.Params.max_report_data_size":
+		x.MaxReportDataSize = uint64(0)
+	case "band.oracle.v1.Params.expiration_block_count":
+		x.ExpirationBlockCount = uint64(0)
+	case "band.oracle.v1.Params.base_owasm_gas":
+		x.BaseOwasmGas = uint64(0)
+	case "band.oracle.v1.Params.per_validator_request_gas":
+		x.PerValidatorRequestGas = uint64(0)
+	case "band.oracle.v1.Params.sampling_try_count":
+		x.SamplingTryCount = uint64(0)
+	case "band.oracle.v1.Params.oracle_reward_percentage":
+		x.OracleRewardPercentage = uint64(0)
+	case "band.oracle.v1.Params.inactive_penalty_duration":
+		x.InactivePenaltyDuration = uint64(0)
+	case "band.oracle.v1.Params.ibc_request_enabled":
+		x.IbcRequestEnabled = false
+	case "band.oracle.v1.Params.reliability_sampling_enabled":
+		x.ReliabilitySamplingEnabled = false
+	case "band.oracle.v1.Params.reliability_weight_percentage":
+		x.ReliabilityWeightPercentage = uint64(0)
+	case "band.oracle.v1.Params.result_retention_period":
+		x.ResultRetentionPeriod = uint64(0)
+	case "band.oracle.v1.Params.result_retention_count":
+		x.ResultRetentionCount = uint64(0)
+	case "band.oracle.v1.Params.max_pruned_results_per_block":
+		x.MaxPrunedResultsPerBlock = uint64(0)
+	case "band.oracle.v1.Params.ibc_request_rate_limit_window":
+		x.IbcRequestRateLimitWindow = uint64(0)
+	case "band.oracle.v1.Params.max_ibc_requests_per_channel":
+		x.MaxIbcRequestsPerChannel = uint64(0)
+	case "band.oracle.v1.Params.max_ibc_requests_per_light_client":
+		x.MaxIbcRequestsPerLightClient = uint64(0)
+	case "band.oracle.v1.Params.min_recurring_request_interval":
+		x.MinRecurringRequestInterval = uint64(0)
+	case "band.oracle.v1.Params.recurring_request_spawn_fee":
+		x.RecurringRequestSpawnFee = nil
+	case "band.oracle.v1.Params.reliability_window_size":
+		x.ReliabilityWindowSize = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8171,19 +12638,82 @@ func (x *fastReflection_ValidatorStatus) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorStatus) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.ValidatorStatus.is_active":
-		value := x.IsActive
+	case "band.oracle.v1.Params.max_raw_request_count":
+		value := x.MaxRawRequestCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.max_ask_count":
+		value := x.MaxAskCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.max_calldata_size":
+		value := x.MaxCalldataSize
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.max_report_data_size":
+		value := x.MaxReportDataSize
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.expiration_block_count":
+		value := x.ExpirationBlockCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.base_owasm_gas":
+		value := x.BaseOwasmGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.per_validator_request_gas":
+		value := x.PerValidatorRequestGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.sampling_try_count":
+		value := x.SamplingTryCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.oracle_reward_percentage":
+		value := x.OracleRewardPercentage
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.inactive_penalty_duration":
+		value := x.InactivePenaltyDuration
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.ibc_request_enabled":
+		value := x.IbcRequestEnabled
 		return protoreflect.ValueOfBool(value)
-	case "band.oracle.v1.ValidatorStatus.since":
-		value := x.Since
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.oracle.v1.Params.reliability_sampling_enabled":
+		value := x.ReliabilitySamplingEnabled
+		return protoreflect.ValueOfBool(value)
+	case "band.oracle.v1.Params.reliability_weight_percentage":
+		value := x.ReliabilityWeightPercentage
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.result_retention_period":
+		value := x.ResultRetentionPeriod
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.result_retention_count":
+		value := x.ResultRetentionCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.max_pruned_results_per_block":
+		value := x.MaxPrunedResultsPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.ibc_request_rate_limit_window":
+		value := x.IbcRequestRateLimitWindow
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.max_ibc_requests_per_channel":
+		value := x.MaxIbcRequestsPerChannel
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.max_ibc_requests_per_light_client":
+		value := x.MaxIbcRequestsPerLightClient
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.min_recurring_request_interval":
+		value := x.MinRecurringRequestInterval
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Params.recurring_request_spawn_fee":
+		if len(x.RecurringRequestSpawnFee) == 0 {
+			return protoreflect.ValueOfList(&_Params_21_list{})
+		}
+		listValue := &_Params_21_list{list: &x.RecurringRequestSpawnFee}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.Params.reliability_window_size":
+		value := x.ReliabilityWindowSize
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8197,17 +12727,59 @@ func (x *fastReflection_ValidatorStatus) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorStatus) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.ValidatorStatus.is_active":
-		x.IsActive = value.Bool()
-	case "band.oracle.v1.ValidatorStatus.since":
-		x.Since = value.Message().Interface().(*timestamppb.Timestamp)
+	case "band.oracle.v1.Params.max_raw_request_count":
+		x.MaxRawRequestCount = value.Uint()
+	case "band.oracle.v1.Params.max_ask_count":
+		x.MaxAskCount = value.Uint()
+	case "band.oracle.v1.Params.max_calldata_size":
+		x.MaxCalldataSize = value.Uint()
+	case "band.oracle.v1.Params.max_report_data_size":
+		x.MaxReportDataSize = value.Uint()
+	case "band.oracle.v1.Params.expiration_block_count":
+		x.ExpirationBlockCount = value.Uint()
+	case "band.oracle.v1.Params.base_owasm_gas":
+		x.BaseOwasmGas = value.Uint()
+	case "band.oracle.v1.Params.per_validator_request_gas":
+		x.PerValidatorRequestGas = value.Uint()
+	case "band.oracle.v1.Params.sampling_try_count":
+		x.SamplingTryCount = value.Uint()
+	case "band.oracle.v1.Params.oracle_reward_percentage":
+		x.OracleRewardPercentage = value.Uint()
+	case "band.oracle.v1.Params.inactive_penalty_duration":
+		x.InactivePenaltyDuration = value.Uint()
+	case "band.oracle.v1.Params.ibc_request_enabled":
+		x.IbcRequestEnabled = value.Bool()
+	case "band.oracle.v1.Params.reliability_sampling_enabled":
+		x.ReliabilitySamplingEnabled = value.Bool()
+	case "band.oracle.v1.Params.reliability_weight_percentage":
+		x.ReliabilityWeightPercentage = value.Uint()
+	case "band.oracle.v1.Params.result_retention_period":
+		x.ResultRetentionPeriod = value.Uint()
+	case "band.oracle.v1.Params.result_retention_count":
+		x.ResultRetentionCount = value.Uint()
+	case "band.oracle.v1.Params.max_pruned_results_per_block":
+		x.MaxPrunedResultsPerBlock = value.Uint()
+	case "band.oracle.v1.Params.ibc_request_rate_limit_window":
+		x.IbcRequestRateLimitWindow = value.Uint()
+	case "band.oracle.v1.Params.max_ibc_requests_per_channel":
+		x.MaxIbcRequestsPerChannel = value.Uint()
+	case "band.oracle.v1.Params.max_ibc_requests_per_light_client":
+		x.MaxIbcRequestsPerLightClient = value.Uint()
+	case "band.oracle.v1.Params.min_recurring_request_interval":
+		x.MinRecurringRequestInterval = value.Uint()
+	case "band.oracle.v1.Params.recurring_request_spawn_fee":
+		lv := value.List()
+		clv := lv.(*_Params_21_list)
+		x.RecurringRequestSpawnFee = *clv.list
+	case "band.oracle.v1.Params.reliability_window_size":
+		x.ReliabilityWindowSize = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8221,48 +12793,129 @@ func (x *fastReflection_ValidatorStatus) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorStatus) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "band.oracle.v1.ValidatorStatus.since":
-		if x.Since == nil {
-			x.Since = new(timestamppb.Timestamp)
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.Params.recurring_request_spawn_fee":
+		if x.RecurringRequestSpawnFee == nil {
+			x.RecurringRequestSpawnFee = []*v1beta1.Coin{}
 		}
-		return protoreflect.ValueOfMessage(x.Since.ProtoReflect())
-	case "band.oracle.v1.ValidatorStatus.is_active":
-		panic(fmt.Errorf("field is_active of message band.oracle.v1.ValidatorStatus is not mutable"))
+		value := &_Params_21_list{list: &x.RecurringRequestSpawnFee}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.Params.max_raw_request_count":
+		panic(fmt.Errorf("field max_raw_request_count of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_ask_count":
+		panic(fmt.Errorf("field max_ask_count of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_calldata_size":
+		panic(fmt.Errorf("field max_calldata_size of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_report_data_size":
+		panic(fmt.Errorf("field max_report_data_size of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.expiration_block_count":
+		panic(fmt.Errorf("field expiration_block_count of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.base_owasm_gas":
+		panic(fmt.Errorf("field base_owasm_gas of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.per_validator_request_gas":
+		panic(fmt.Errorf("field per_validator_request_gas of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.sampling_try_count":
+		panic(fmt.Errorf("field sampling_try_count of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.oracle_reward_percentage":
+		panic(fmt.Errorf("field oracle_reward_percentage of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.inactive_penalty_duration":
+		panic(fmt.Errorf("field inactive_penalty_duration of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.ibc_request_enabled":
+		panic(fmt.Errorf("field ibc_request_enabled of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.reliability_sampling_enabled":
+		panic(fmt.Errorf("field reliability_sampling_enabled of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.reliability_weight_percentage":
+		panic(fmt.Errorf("field reliability_weight_percentage of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.result_retention_period":
+		panic(fmt.Errorf("field result_retention_period of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.result_retention_count":
+		panic(fmt.Errorf("field result_retention_count of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_pruned_results_per_block":
+		panic(fmt.Errorf("field max_pruned_results_per_block of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.ibc_request_rate_limit_window":
+		panic(fmt.Errorf("field ibc_request_rate_limit_window of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_ibc_requests_per_channel":
+		panic(fmt.Errorf("field max_ibc_requests_per_channel of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.max_ibc_requests_per_light_client":
+		panic(fmt.Errorf("field max_ibc_requests_per_light_client of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.min_recurring_request_interval":
+		panic(fmt.Errorf("field min_recurring_request_interval of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.reliability_window_size":
+		panic(fmt.Errorf("field reliability_window_size of message band.oracle.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorStatus) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.ValidatorStatus.is_active":
+	case "band.oracle.v1.Params.max_raw_request_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.max_ask_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.max_calldata_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.max_report_data_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.expiration_block_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.base_owasm_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.per_validator_request_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.sampling_try_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.oracle_reward_percentage":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.inactive_penalty_duration":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.ibc_request_enabled":
 		return protoreflect.ValueOfBool(false)
-	case "band.oracle.v1.ValidatorStatus.since":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.oracle.v1.Params.reliability_sampling_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "band.oracle.v1.Params.reliability_weight_percentage":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.result_retention_period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.result_retention_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.max_pruned_results_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.ibc_request_rate_limit_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.max_ibc_requests_per_channel":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.max_ibc_requests_per_light_client":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.min_recurring_request_interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Params.recurring_request_spawn_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_21_list{list: &list})
+	case "band.oracle.v1.Params.reliability_window_size":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorStatus"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ValidatorStatus does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorStatus) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.ValidatorStatus", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Params", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8270,7 +12923,7 @@ func (x *fastReflection_ValidatorStatus) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorStatus) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8281,7 +12934,7 @@ func (x *fastReflection_ValidatorStatus) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorStatus) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8293,7 +12946,7 @@ func (x *fastReflection_ValidatorStatus) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorStatus) IsValid() bool {
+func (x *fastReflection_Params) IsValid() bool {
 	return x != nil
 }
 
@@ -8303,9 +12956,9 @@ func (x *fastReflection_ValidatorStatus) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorStatus)
+		x := input.Message.Interface().(*Params)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8317,12 +12970,74 @@ func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.IsActive {
+		if x.MaxRawRequestCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRawRequestCount))
+		}
+		if x.MaxAskCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxAskCount))
+		}
+		if x.MaxCalldataSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxCalldataSize))
+		}
+		if x.MaxReportDataSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxReportDataSize))
+		}
+		if x.ExpirationBlockCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpirationBlockCount))
+		}
+		if x.BaseOwasmGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseOwasmGas))
+		}
+		if x.PerValidatorRequestGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.PerValidatorRequestGas))
+		}
+		if x.SamplingTryCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.SamplingTryCount))
+		}
+		if x.OracleRewardPercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleRewardPercentage))
+		}
+		if x.InactivePenaltyDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.InactivePenaltyDuration))
+		}
+		if x.IbcRequestEnabled {
 			n += 2
 		}
-		if x.Since != nil {
-			l = options.Size(x.Since)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.ReliabilitySamplingEnabled {
+			n += 2
+		}
+		if x.ReliabilityWeightPercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReliabilityWeightPercentage))
+		}
+		if x.ResultRetentionPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResultRetentionPeriod))
+		}
+		if x.ResultRetentionCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResultRetentionCount))
+		}
+		if x.MaxPrunedResultsPerBlock != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxPrunedResultsPerBlock))
+		}
+		if x.IbcRequestRateLimitWindow != 0 {
+			n += 2 + runtime.Sov(uint64(x.IbcRequestRateLimitWindow))
+		}
+		if x.MaxIbcRequestsPerChannel != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxIbcRequestsPerChannel))
+		}
+		if x.MaxIbcRequestsPerLightClient != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxIbcRequestsPerLightClient))
+		}
+		if x.MinRecurringRequestInterval != 0 {
+			n += 2 + runtime.Sov(uint64(x.MinRecurringRequestInterval))
+		}
+		if len(x.RecurringRequestSpawnFee) > 0 {
+			for _, e := range x.RecurringRequestSpawnFee {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ReliabilityWindowSize != 0 {
+			n += 2 + runtime.Sov(uint64(x.ReliabilityWindowSize))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8334,7 +13049,7 @@ func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorStatus)
+		x := input.Message.Interface().(*Params)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8353,28 +13068,149 @@ func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Since != nil {
-			encoded, err := options.Marshal(x.Since)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if x.ReliabilityWindowSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReliabilityWindowSize))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb0
+		}
+		if len(x.RecurringRequestSpawnFee) > 0 {
+			for iNdEx := len(x.RecurringRequestSpawnFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RecurringRequestSpawnFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xaa
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		}
+		if x.MinRecurringRequestInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinRecurringRequestInterval))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
 		}
-		if x.IsActive {
+		if x.MaxIbcRequestsPerLightClient != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxIbcRequestsPerLightClient))
 			i--
-			if x.IsActive {
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if x.MaxIbcRequestsPerChannel != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxIbcRequestsPerChannel))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.IbcRequestRateLimitWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IbcRequestRateLimitWindow))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.MaxPrunedResultsPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPrunedResultsPerBlock))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.ResultRetentionCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResultRetentionCount))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.ResultRetentionPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResultRetentionPeriod))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.ReliabilityWeightPercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReliabilityWeightPercentage))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.ReliabilitySamplingEnabled {
+			i--
+			if x.ReliabilitySamplingEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.IbcRequestEnabled {
+			i--
+			if x.IbcRequestEnabled {
 				dAtA[i] = 1
 			} else {
 				dAtA[i] = 0
 			}
 			i--
+			dAtA[i] = 0x58
+		}
+		if x.InactivePenaltyDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InactivePenaltyDuration))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.OracleRewardPercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleRewardPercentage))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.SamplingTryCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SamplingTryCount))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.PerValidatorRequestGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PerValidatorRequestGas))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.BaseOwasmGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseOwasmGas))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ExpirationBlockCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpirationBlockCount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MaxReportDataSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxReportDataSize))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxCalldataSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxCalldataSize))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxAskCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAskCount))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.MaxRawRequestCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRawRequestCount))
+			i--
 			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
@@ -8388,7 +13224,7 @@ func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorStatus)
+		x := input.Message.Interface().(*Params)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8407,30 +13243,317 @@ func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRawRequestCount", wireType)
+				}
+				x.MaxRawRequestCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRawRequestCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAskCount", wireType)
+				}
+				x.MaxAskCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAskCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxCalldataSize", wireType)
+				}
+				x.MaxCalldataSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxCalldataSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxReportDataSize", wireType)
+				}
+				x.MaxReportDataSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxReportDataSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlockCount", wireType)
+				}
+				x.ExpirationBlockCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpirationBlockCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseOwasmGas", wireType)
+				}
+				x.BaseOwasmGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseOwasmGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PerValidatorRequestGas", wireType)
+				}
+				x.PerValidatorRequestGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PerValidatorRequestGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SamplingTryCount", wireType)
+				}
+				x.SamplingTryCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SamplingTryCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleRewardPercentage", wireType)
+				}
+				x.OracleRewardPercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleRewardPercentage |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InactivePenaltyDuration", wireType)
+				}
+				x.InactivePenaltyDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InactivePenaltyDuration |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcRequestEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IbcRequestEnabled = bool(v != 0)
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReliabilitySamplingEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ReliabilitySamplingEnabled = bool(v != 0)
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReliabilityWeightPercentage", wireType)
+				}
+				x.ReliabilityWeightPercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReliabilityWeightPercentage |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResultRetentionPeriod", wireType)
+				}
+				x.ResultRetentionPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResultRetentionPeriod |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResultRetentionCount", wireType)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.ResultRetentionCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResultRetentionCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorStatus: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+			case 16:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedResultsPerBlock", wireType)
 				}
-				var v int
+				x.MaxPrunedResultsPerBlock = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8440,15 +13563,90 @@ func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					x.MaxPrunedResultsPerBlock |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.IsActive = bool(v != 0)
-			case 2:
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcRequestRateLimitWindow", wireType)
+				}
+				x.IbcRequestRateLimitWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IbcRequestRateLimitWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxIbcRequestsPerChannel", wireType)
+				}
+				x.MaxIbcRequestsPerChannel = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxIbcRequestsPerChannel |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxIbcRequestsPerLightClient", wireType)
+				}
+				x.MaxIbcRequestsPerLightClient = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxIbcRequestsPerLightClient |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinRecurringRequestInterval", wireType)
+				}
+				x.MinRecurringRequestInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinRecurringRequestInterval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 21:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecurringRequestSpawnFee", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -8475,13 +13673,30 @@ func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Since == nil {
-					x.Since = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Since); err != nil {
+				x.RecurringRequestSpawnFee = append(x.RecurringRequestSpawnFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RecurringRequestSpawnFee[len(x.RecurringRequestSpawnFee)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 22:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReliabilityWindowSize", wireType)
+				}
+				x.ReliabilityWindowSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReliabilityWindowSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8518,28 +13733,28 @@ func (x *fastReflection_ValidatorStatus) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_ActiveValidator         protoreflect.MessageDescriptor
-	fd_ActiveValidator_address protoreflect.FieldDescriptor
-	fd_ActiveValidator_power   protoreflect.FieldDescriptor
+	md_IBCRequestCounter        protoreflect.MessageDescriptor
+	fd_IBCRequestCounter_window protoreflect.FieldDescriptor
+	fd_IBCRequestCounter_count  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_ActiveValidator = File_band_oracle_v1_oracle_proto.Messages().ByName("ActiveValidator")
-	fd_ActiveValidator_address = md_ActiveValidator.Fields().ByName("address")
-	fd_ActiveValidator_power = md_ActiveValidator.Fields().ByName("power")
+	md_IBCRequestCounter = File_band_oracle_v1_oracle_proto.Messages().ByName("IBCRequestCounter")
+	fd_IBCRequestCounter_window = md_IBCRequestCounter.Fields().ByName("window")
+	fd_IBCRequestCounter_count = md_IBCRequestCounter.Fields().ByName("count")
 }
 
-var _ protoreflect.Message = (*fastReflection_ActiveValidator)(nil)
+var _ protoreflect.Message = (*fastReflection_IBCRequestCounter)(nil)
 
-type fastReflection_ActiveValidator ActiveValidator
+type fastReflection_IBCRequestCounter IBCRequestCounter
 
-func (x *ActiveValidator) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ActiveValidator)(x)
+func (x *IBCRequestCounter) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IBCRequestCounter)(x)
 }
 
-func (x *ActiveValidator) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
+func (x *IBCRequestCounter) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8550,43 +13765,43 @@ func (x *ActiveValidator) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ActiveValidator_messageType fastReflection_ActiveValidator_messageType
-var _ protoreflect.MessageType = fastReflection_ActiveValidator_messageType{}
+var _fastReflection_IBCRequestCounter_messageType fastReflection_IBCRequestCounter_messageType
+var _ protoreflect.MessageType = fastReflection_IBCRequestCounter_messageType{}
 
-type fastReflection_ActiveValidator_messageType struct{}
+type fastReflection_IBCRequestCounter_messageType struct{}
 
-func (x fastReflection_ActiveValidator_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ActiveValidator)(nil)
+func (x fastReflection_IBCRequestCounter_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IBCRequestCounter)(nil)
 }
-func (x fastReflection_ActiveValidator_messageType) New() protoreflect.Message {
-	return new(fastReflection_ActiveValidator)
+func (x fastReflection_IBCRequestCounter_messageType) New() protoreflect.Message {
+	return new(fastReflection_IBCRequestCounter)
 }
-func (x fastReflection_ActiveValidator_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ActiveValidator
+func (x fastReflection_IBCRequestCounter_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCRequestCounter
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ActiveValidator) Descriptor() protoreflect.MessageDescriptor {
-	return md_ActiveValidator
+func (x *fastReflection_IBCRequestCounter) Descriptor() protoreflect.MessageDescriptor {
+	return md_IBCRequestCounter
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ActiveValidator) Type() protoreflect.MessageType {
-	return _fastReflection_ActiveValidator_messageType
+func (x *fastReflection_IBCRequestCounter) Type() protoreflect.MessageType {
+	return _fastReflection_IBCRequestCounter_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ActiveValidator) New() protoreflect.Message {
-	return new(fastReflection_ActiveValidator)
+func (x *fastReflection_IBCRequestCounter) New() protoreflect.Message {
+	return new(fastReflection_IBCRequestCounter)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ActiveValidator) Interface() protoreflect.ProtoMessage {
-	return (*ActiveValidator)(x)
+func (x *fastReflection_IBCRequestCounter) Interface() protoreflect.ProtoMessage {
+	return (*IBCRequestCounter)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8594,16 +13809,16 @@ func (x *fastReflection_ActiveValidator) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ActiveValidator) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_ActiveValidator_address, value) {
+func (x *fastReflection_IBCRequestCounter) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Window != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Window)
+		if !f(fd_IBCRequestCounter_window, value) {
 			return
 		}
 	}
-	if x.Power != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Power)
-		if !f(fd_ActiveValidator_power, value) {
+	if x.Count != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Count)
+		if !f(fd_IBCRequestCounter_count, value) {
 			return
 		}
 	}
@@ -8620,17 +13835,17 @@ func (x *fastReflection_ActiveValidator) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ActiveValidator) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_IBCRequestCounter) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.ActiveValidator.address":
-		return x.Address != ""
-	case "band.oracle.v1.ActiveValidator.power":
-		return x.Power != uint64(0)
+	case "band.oracle.v1.IBCRequestCounter.window":
+		return x.Window != uint64(0)
+	case "band.oracle.v1.IBCRequestCounter.count":
+		return x.Count != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCRequestCounter"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.IBCRequestCounter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8640,17 +13855,17 @@ func (x *fastReflection_ActiveValidator) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ActiveValidator) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_IBCRequestCounter) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.ActiveValidator.address":
-		x.Address = ""
-	case "band.oracle.v1.ActiveValidator.power":
-		x.Power = uint64(0)
+	case "band.oracle.v1.IBCRequestCounter.window":
+		x.Window = uint64(0)
+	case "band.oracle.v1.IBCRequestCounter.count":
+		x.Count = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCRequestCounter"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.IBCRequestCounter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8660,19 +13875,19 @@ func (x *fastReflection_ActiveValidator) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ActiveValidator) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_IBCRequestCounter) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.ActiveValidator.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.ActiveValidator.power":
-		value := x.Power
+	case "band.oracle.v1.IBCRequestCounter.window":
+		value := x.Window
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.IBCRequestCounter.count":
+		value := x.Count
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCRequestCounter"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.IBCRequestCounter does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8686,17 +13901,17 @@ func (x *fastReflection_ActiveValidator) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ActiveValidator) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_IBCRequestCounter) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.ActiveValidator.address":
-		x.Address = value.Interface().(string)
-	case "band.oracle.v1.ActiveValidator.power":
-		x.Power = value.Uint()
+	case "band.oracle.v1.IBCRequestCounter.window":
+		x.Window = value.Uint()
+	case "band.oracle.v1.IBCRequestCounter.count":
+		x.Count = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCRequestCounter"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.IBCRequestCounter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8710,44 +13925,44 @@ func (x *fastReflection_ActiveValidator) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ActiveValidator) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_IBCRequestCounter) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.ActiveValidator.address":
-		panic(fmt.Errorf("field address of message band.oracle.v1.ActiveValidator is not mutable"))
-	case "band.oracle.v1.ActiveValidator.power":
-		panic(fmt.Errorf("field power of message band.oracle.v1.ActiveValidator is not mutable"))
+	case "band.oracle.v1.IBCRequestCounter.window":
+		panic(fmt.Errorf("field window of message band.oracle.v1.IBCRequestCounter is not mutable"))
+	case "band.oracle.v1.IBCRequestCounter.count":
+		panic(fmt.Errorf("field count of message band.oracle.v1.IBCRequestCounter is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCRequestCounter"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.IBCRequestCounter does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ActiveValidator) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_IBCRequestCounter) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.ActiveValidator.address":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.ActiveValidator.power":
+	case "band.oracle.v1.IBCRequestCounter.window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.IBCRequestCounter.count":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ActiveValidator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.IBCRequestCounter"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.ActiveValidator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.IBCRequestCounter does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ActiveValidator) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_IBCRequestCounter) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.ActiveValidator", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.IBCRequestCounter", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8755,7 +13970,7 @@ func (x *fastReflection_ActiveValidator) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ActiveValidator) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_IBCRequestCounter) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8766,7 +13981,7 @@ func (x *fastReflection_ActiveValidator) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ActiveValidator) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_IBCRequestCounter) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8778,7 +13993,7 @@ func (x *fastReflection_ActiveValidator) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ActiveValidator) IsValid() bool {
+func (x *fastReflection_IBCRequestCounter) IsValid() bool {
 	return x != nil
 }
 
@@ -8788,9 +14003,9 @@ func (x *fastReflection_ActiveValidator) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_IBCRequestCounter) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ActiveValidator)
+		x := input.Message.Interface().(*IBCRequestCounter)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8802,12 +14017,11 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Window != 0 {
+			n += 1 + runtime.Sov(uint64(x.Window))
 		}
-		if x.Power != 0 {
-			n += 1 + runtime.Sov(uint64(x.Power))
+		if x.Count != 0 {
+			n += 1 + runtime.Sov(uint64(x.Count))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8819,7 +14033,7 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ActiveValidator)
+		x := input.Message.Interface().(*IBCRequestCounter)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8838,17 +14052,15 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Power != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
+		if x.Count != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if x.Window != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Window))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8861,7 +14073,7 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ActiveValidator)
+		x := input.Message.Interface().(*IBCRequestCounter)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8893,49 +14105,36 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActiveValidator: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCRequestCounter: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActiveValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IBCRequestCounter: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 				}
-				var stringLen uint64
+				x.Window = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
 					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Window |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 				}
-				x.Power = 0
+				x.Count = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8945,7 +14144,7 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Power |= uint64(b&0x7F) << shift
+					x.Count |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -8986,46 +14185,32 @@ func (x *fastReflection_ActiveValidator) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Params                           protoreflect.MessageDescriptor
-	fd_Params_max_raw_request_count     protoreflect.FieldDescriptor
-	fd_Params_max_ask_count             protoreflect.FieldDescriptor
-	fd_Params_max_calldata_size         protoreflect.FieldDescriptor
-	fd_Params_max_report_data_size      protoreflect.FieldDescriptor
-	fd_Params_expiration_block_count    protoreflect.FieldDescriptor
-	fd_Params_base_owasm_gas            protoreflect.FieldDescriptor
-	fd_Params_per_validator_request_gas protoreflect.FieldDescriptor
-	fd_Params_sampling_try_count        protoreflect.FieldDescriptor
-	fd_Params_oracle_reward_percentage  protoreflect.FieldDescriptor
-	fd_Params_inactive_penalty_duration protoreflect.FieldDescriptor
-	fd_Params_ibc_request_enabled       protoreflect.FieldDescriptor
+	md_ValidatorReliability                      protoreflect.MessageDescriptor
+	fd_ValidatorReliability_validator            protoreflect.FieldDescriptor
+	fd_ValidatorReliability_assigned_count       protoreflect.FieldDescriptor
+	fd_ValidatorReliability_reported_count       protoreflect.FieldDescriptor
+	fd_ValidatorReliability_total_report_latency protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_Params = File_band_oracle_v1_oracle_proto.Messages().ByName("Params")
-	fd_Params_max_raw_request_count = md_Params.Fields().ByName("max_raw_request_count")
-	fd_Params_max_ask_count = md_Params.Fields().ByName("max_ask_count")
-	fd_Params_max_calldata_size = md_Params.Fields().ByName("max_calldata_size")
-	fd_Params_max_report_data_size = md_Params.Fields().ByName("max_report_data_size")
-	fd_Params_expiration_block_count = md_Params.Fields().ByName("expiration_block_count")
-	fd_Params_base_owasm_gas = md_Params.Fields().ByName("base_owasm_gas")
-	fd_Params_per_validator_request_gas = md_Params.Fields().ByName("per_validator_request_gas")
-	fd_Params_sampling_try_count = md_Params.Fields().ByName("sampling_try_count")
-	fd_Params_oracle_reward_percentage = md_Params.Fields().ByName("oracle_reward_percentage")
-	fd_Params_inactive_penalty_duration = md_Params.Fields().ByName("inactive_penalty_duration")
-	fd_Params_ibc_request_enabled = md_Params.Fields().ByName("ibc_request_enabled")
+	md_ValidatorReliability = File_band_oracle_v1_oracle_proto.Messages().ByName("ValidatorReliability")
+	fd_ValidatorReliability_validator = md_ValidatorReliability.Fields().ByName("validator")
+	fd_ValidatorReliability_assigned_count = md_ValidatorReliability.Fields().ByName("assigned_count")
+	fd_ValidatorReliability_reported_count = md_ValidatorReliability.Fields().ByName("reported_count")
+	fd_ValidatorReliability_total_report_latency = md_ValidatorReliability.Fields().ByName("total_report_latency")
 }
 
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorReliability)(nil)
 
-type fastReflection_Params Params
+type fastReflection_ValidatorReliability ValidatorReliability
 
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
+func (x *ValidatorReliability) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorReliability)(x)
 }
 
-func (x *Params) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
+func (x *ValidatorReliability) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9036,43 +14221,43 @@ func (x *Params) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+var _fastReflection_ValidatorReliability_messageType fastReflection_ValidatorReliability_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorReliability_messageType{}
 
-type fastReflection_Params_messageType struct{}
+type fastReflection_ValidatorReliability_messageType struct{}
 
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
+func (x fastReflection_ValidatorReliability_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorReliability)(nil)
 }
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x fastReflection_ValidatorReliability_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorReliability)
 }
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x fastReflection_ValidatorReliability_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorReliability
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x *fastReflection_ValidatorReliability) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorReliability
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
+func (x *fastReflection_ValidatorReliability) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorReliability_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x *fastReflection_ValidatorReliability) New() protoreflect.Message {
+	return new(fastReflection_ValidatorReliability)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
+func (x *fastReflection_ValidatorReliability) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorReliability)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9080,70 +14265,28 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.MaxRawRequestCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MaxRawRequestCount)
-		if !f(fd_Params_max_raw_request_count, value) {
-			return
-		}
-	}
-	if x.MaxAskCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MaxAskCount)
-		if !f(fd_Params_max_ask_count, value) {
-			return
-		}
-	}
-	if x.MaxCalldataSize != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MaxCalldataSize)
-		if !f(fd_Params_max_calldata_size, value) {
-			return
-		}
-	}
-	if x.MaxReportDataSize != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MaxReportDataSize)
-		if !f(fd_Params_max_report_data_size, value) {
-			return
-		}
-	}
-	if x.ExpirationBlockCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExpirationBlockCount)
-		if !f(fd_Params_expiration_block_count, value) {
-			return
-		}
-	}
-	if x.BaseOwasmGas != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BaseOwasmGas)
-		if !f(fd_Params_base_owasm_gas, value) {
-			return
-		}
-	}
-	if x.PerValidatorRequestGas != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.PerValidatorRequestGas)
-		if !f(fd_Params_per_validator_request_gas, value) {
-			return
-		}
-	}
-	if x.SamplingTryCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.SamplingTryCount)
-		if !f(fd_Params_sampling_try_count, value) {
+func (x *fastReflection_ValidatorReliability) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_ValidatorReliability_validator, value) {
 			return
 		}
 	}
-	if x.OracleRewardPercentage != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OracleRewardPercentage)
-		if !f(fd_Params_oracle_reward_percentage, value) {
+	if x.AssignedCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AssignedCount)
+		if !f(fd_ValidatorReliability_assigned_count, value) {
 			return
 		}
 	}
-	if x.InactivePenaltyDuration != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.InactivePenaltyDuration)
-		if !f(fd_Params_inactive_penalty_duration, value) {
+	if x.ReportedCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReportedCount)
+		if !f(fd_ValidatorReliability_reported_count, value) {
 			return
 		}
 	}
-	if x.IbcRequestEnabled != false {
-		value := protoreflect.ValueOfBool(x.IbcRequestEnabled)
-		if !f(fd_Params_ibc_request_enabled, value) {
+	if x.TotalReportLatency != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalReportLatency)
+		if !f(fd_ValidatorReliability_total_report_latency, value) {
 			return
 		}
 	}
@@ -9160,35 +14303,21 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorReliability) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.Params.max_raw_request_count":
-		return x.MaxRawRequestCount != uint64(0)
-	case "band.oracle.v1.Params.max_ask_count":
-		return x.MaxAskCount != uint64(0)
-	case "band.oracle.v1.Params.max_calldata_size":
-		return x.MaxCalldataSize != uint64(0)
-	case "band.oracle.v1.Params.max_report_data_size":
-		return x.MaxReportDataSize != uint64(0)
-	case "band.oracle.v1.Params.expiration_block_count":
-		return x.ExpirationBlockCount != uint64(0)
-	case "band.oracle.v1.Params.base_owasm_gas":
-		return x.BaseOwasmGas != uint64(0)
-	case "band.oracle.v1.Params.per_validator_request_gas":
-		return x.PerValidatorRequestGas != uint64(0)
-	case "band.oracle.v1.Params.sampling_try_count":
-		return x.SamplingTryCount != uint64(0)
-	case "band.oracle.v1.Params.oracle_reward_percentage":
-		return x.OracleRewardPercentage != uint64(0)
-	case "band.oracle.v1.Params.inactive_penalty_duration":
-		return x.InactivePenaltyDuration != uint64(0)
-	case "band.oracle.v1.Params.ibc_request_enabled":
-		return x.IbcRequestEnabled != false
+	case "band.oracle.v1.ValidatorReliability.validator":
+		return x.Validator != ""
+	case "band.oracle.v1.ValidatorReliability.assigned_count":
+		return x.AssignedCount != uint64(0)
+	case "band.oracle.v1.ValidatorReliability.reported_count":
+		return x.ReportedCount != uint64(0)
+	case "band.oracle.v1.ValidatorReliability.total_report_latency":
+		return x.TotalReportLatency != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorReliability"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorReliability does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9198,35 +14327,21 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorReliability) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Params.max_raw_request_count":
-		x.MaxRawRequestCount = uint64(0)
-	case "band.oracle.v1.Params.max_ask_count":
-		x.MaxAskCount = uint64(0)
-	case "band.oracle.v1.Params.max_calldata_size":
-		x.MaxCalldataSize = uint64(0)
-	case "band.oracle.v1.Params.max_report_data_size":
-		x.MaxReportDataSize = uint64(0)
-	case "band.oracle.v1.Params.expiration_block_count":
-		x.ExpirationBlockCount = uint64(0)
-	case "band.oracle.v1.Params.base_owasm_gas":
-		x.BaseOwasmGas = uint64(0)
-	case "band.oracle.v1.Params.per_validator_request_gas":
-		x.PerValidatorRequestGas = uint64(0)
-	case "band.oracle.v1.Params.sampling_try_count":
-		x.SamplingTryCount = uint64(0)
-	case "band.oracle.v1.Params.oracle_reward_percentage":
-		x.OracleRewardPercentage = uint64(0)
-	case "band.oracle.v1.Params.inactive_penalty_duration":
-		x.InactivePenaltyDuration = uint64(0)
-	case "band.oracle.v1.Params.ibc_request_enabled":
-		x.IbcRequestEnabled = false
+	case "band.oracle.v1.ValidatorReliability.validator":
+		x.Validator = ""
+	case "band.oracle.v1.ValidatorReliability.assigned_count":
+		x.AssignedCount = uint64(0)
+	case "band.oracle.v1.ValidatorReliability.reported_count":
+		x.ReportedCount = uint64(0)
+	case "band.oracle.v1.ValidatorReliability.total_report_latency":
+		x.TotalReportLatency = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorReliability"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorReliability does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9236,46 +14351,25 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorReliability) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.Params.max_raw_request_count":
-		value := x.MaxRawRequestCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.max_ask_count":
-		value := x.MaxAskCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.max_calldata_size":
-		value := x.MaxCalldataSize
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.max_report_data_size":
-		value := x.MaxReportDataSize
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.expiration_block_count":
-		value := x.ExpirationBlockCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.base_owasm_gas":
-		value := x.BaseOwasmGas
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.per_validator_request_gas":
-		value := x.PerValidatorRequestGas
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.sampling_try_count":
-		value := x.SamplingTryCount
+	case "band.oracle.v1.ValidatorReliability.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.ValidatorReliability.assigned_count":
+		value := x.AssignedCount
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.oracle_reward_percentage":
-		value := x.OracleRewardPercentage
+	case "band.oracle.v1.ValidatorReliability.reported_count":
+		value := x.ReportedCount
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.inactive_penalty_duration":
-		value := x.InactivePenaltyDuration
+	case "band.oracle.v1.ValidatorReliability.total_report_latency":
+		value := x.TotalReportLatency
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Params.ibc_request_enabled":
-		value := x.IbcRequestEnabled
-		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorReliability"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorReliability does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9289,122 +14383,80 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorReliability) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Params.max_raw_request_count":
-		x.MaxRawRequestCount = value.Uint()
-	case "band.oracle.v1.Params.max_ask_count":
-		x.MaxAskCount = value.Uint()
-	case "band.oracle.v1.Params.max_calldata_size":
-		x.MaxCalldataSize = value.Uint()
-	case "band.oracle.v1.Params.max_report_data_size":
-		x.MaxReportDataSize = value.Uint()
-	case "band.oracle.v1.Params.expiration_block_count":
-		x.ExpirationBlockCount = value.Uint()
-	case "band.oracle.v1.Params.base_owasm_gas":
-		x.BaseOwasmGas = value.Uint()
-	case "band.oracle.v1.Params.per_validator_request_gas":
-		x.PerValidatorRequestGas = value.Uint()
-	case "band.oracle.v1.Params.sampling_try_count":
-		x.SamplingTryCount = value.Uint()
-	case "band.oracle.v1.Params.oracle_reward_percentage":
-		x.OracleRewardPercentage = value.Uint()
-	case "band.oracle.v1.Params.inactive_penalty_duration":
-		x.InactivePenaltyDuration = value.Uint()
-	case "band.oracle.v1.Params.ibc_request_enabled":
-		x.IbcRequestEnabled = value.Bool()
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
-		}
-		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
-	}
-}
-
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "band.oracle.v1.Params.max_raw_request_count":
-		panic(fmt.Errorf("field max_raw_request_count of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.max_ask_count":
-		panic(fmt.Errorf("field max_ask_count of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.max_calldata_size":
-		panic(fmt.Errorf("field max_calldata_size of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.max_report_data_size":
-		panic(fmt.Errorf("field max_report_data_size of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.expiration_block_count":
-		panic(fmt.Errorf("field expiration_block_count of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.base_owasm_gas":
-		panic(fmt.Errorf("field base_owasm_gas of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.per_validator_request_gas":
-		panic(fmt.Errorf("field per_validator_request_gas of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.sampling_try_count":
-		panic(fmt.Errorf("field sampling_try_count of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.oracle_reward_percentage":
-		panic(fmt.Errorf("field oracle_reward_percentage of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.inactive_penalty_duration":
-		panic(fmt.Errorf("field inactive_penalty_duration of message band.oracle.v1.Params is not mutable"))
-	case "band.oracle.v1.Params.ibc_request_enabled":
-		panic(fmt.Errorf("field ibc_request_enabled of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.ValidatorReliability.validator":
+		x.Validator = value.Interface().(string)
+	case "band.oracle.v1.ValidatorReliability.assigned_count":
+		x.AssignedCount = value.Uint()
+	case "band.oracle.v1.ValidatorReliability.reported_count":
+		x.ReportedCount = value.Uint()
+	case "band.oracle.v1.ValidatorReliability.total_report_latency":
+		x.TotalReportLatency = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorReliability"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorReliability does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorReliability) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.ValidatorReliability.validator":
+		panic(fmt.Errorf("field validator of message band.oracle.v1.ValidatorReliability is not mutable"))
+	case "band.oracle.v1.ValidatorReliability.assigned_count":
+		panic(fmt.Errorf("field assigned_count of message band.oracle.v1.ValidatorReliability is not mutable"))
+	case "band.oracle.v1.ValidatorReliability.reported_count":
+		panic(fmt.Errorf("field reported_count of message band.oracle.v1.ValidatorReliability is not mutable"))
+	case "band.oracle.v1.ValidatorReliability.total_report_latency":
+		panic(fmt.Errorf("field total_report_latency of message band.oracle.v1.ValidatorReliability is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorReliability"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorReliability does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorReliability) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Params.max_raw_request_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.max_ask_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.max_calldata_size":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.max_report_data_size":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.expiration_block_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.base_owasm_gas":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.per_validator_request_gas":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.sampling_try_count":
+	case "band.oracle.v1.ValidatorReliability.validator":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.ValidatorReliability.assigned_count":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.oracle_reward_percentage":
+	case "band.oracle.v1.ValidatorReliability.reported_count":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.inactive_penalty_duration":
+	case "band.oracle.v1.ValidatorReliability.total_report_latency":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Params.ibc_request_enabled":
-		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ValidatorReliability"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ValidatorReliability does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorReliability) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.ValidatorReliability", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9412,7 +14464,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorReliability) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9423,7 +14475,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorReliability) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9435,7 +14487,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_ValidatorReliability) IsValid() bool {
 	return x != nil
 }
 
@@ -9445,9 +14497,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorReliability) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*ValidatorReliability)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9459,38 +14511,18 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.MaxRawRequestCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxRawRequestCount))
-		}
-		if x.MaxAskCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxAskCount))
-		}
-		if x.MaxCalldataSize != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxCalldataSize))
-		}
-		if x.MaxReportDataSize != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxReportDataSize))
-		}
-		if x.ExpirationBlockCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExpirationBlockCount))
-		}
-		if x.BaseOwasmGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.BaseOwasmGas))
-		}
-		if x.PerValidatorRequestGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.PerValidatorRequestGas))
-		}
-		if x.SamplingTryCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.SamplingTryCount))
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.OracleRewardPercentage != 0 {
-			n += 1 + runtime.Sov(uint64(x.OracleRewardPercentage))
+		if x.AssignedCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AssignedCount))
 		}
-		if x.InactivePenaltyDuration != 0 {
-			n += 1 + runtime.Sov(uint64(x.InactivePenaltyDuration))
+		if x.ReportedCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReportedCount))
 		}
-		if x.IbcRequestEnabled {
-			n += 2
+		if x.TotalReportLatency != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalReportLatency))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -9502,7 +14534,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*ValidatorReliability)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9521,65 +14553,27 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.IbcRequestEnabled {
-			i--
-			if x.IbcRequestEnabled {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x58
-		}
-		if x.InactivePenaltyDuration != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.InactivePenaltyDuration))
-			i--
-			dAtA[i] = 0x50
-		}
-		if x.OracleRewardPercentage != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleRewardPercentage))
-			i--
-			dAtA[i] = 0x48
-		}
-		if x.SamplingTryCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SamplingTryCount))
-			i--
-			dAtA[i] = 0x40
-		}
-		if x.PerValidatorRequestGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PerValidatorRequestGas))
-			i--
-			dAtA[i] = 0x38
-		}
-		if x.BaseOwasmGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseOwasmGas))
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.ExpirationBlockCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpirationBlockCount))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.MaxReportDataSize != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxReportDataSize))
+		if x.TotalReportLatency != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalReportLatency))
 			i--
 			dAtA[i] = 0x20
 		}
-		if x.MaxCalldataSize != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxCalldataSize))
+		if x.ReportedCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReportedCount))
 			i--
 			dAtA[i] = 0x18
 		}
-		if x.MaxAskCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAskCount))
+		if x.AssignedCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AssignedCount))
 			i--
 			dAtA[i] = 0x10
 		}
-		if x.MaxRawRequestCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRawRequestCount))
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -9592,7 +14586,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*ValidatorReliability)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9606,149 +14600,35 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		iNdEx := 0
 		for iNdEx < l {
 			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRawRequestCount", wireType)
-				}
-				x.MaxRawRequestCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MaxRawRequestCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAskCount", wireType)
-				}
-				x.MaxAskCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MaxAskCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxCalldataSize", wireType)
-				}
-				x.MaxCalldataSize = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MaxCalldataSize |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxReportDataSize", wireType)
-				}
-				x.MaxReportDataSize = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MaxReportDataSize |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlockCount", wireType)
-				}
-				x.ExpirationBlockCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ExpirationBlockCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseOwasmGas", wireType)
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BaseOwasmGas = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BaseOwasmGas |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			case 7:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PerValidatorRequestGas", wireType)
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorReliability: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorReliability: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 				}
-				x.PerValidatorRequestGas = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9758,35 +14638,29 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.PerValidatorRequestGas |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SamplingTryCount", wireType)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.SamplingTryCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.SamplingTryCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			case 9:
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleRewardPercentage", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AssignedCount", wireType)
 				}
-				x.OracleRewardPercentage = 0
+				x.AssignedCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9796,16 +14670,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.OracleRewardPercentage |= uint64(b&0x7F) << shift
+					x.AssignedCount |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 10:
+			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InactivePenaltyDuration", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportedCount", wireType)
 				}
-				x.InactivePenaltyDuration = 0
+				x.ReportedCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9815,16 +14689,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.InactivePenaltyDuration |= uint64(b&0x7F) << shift
+					x.ReportedCount |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 11:
+			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcRequestEnabled", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalReportLatency", wireType)
 				}
-				var v int
+				x.TotalReportLatency = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9834,12 +14708,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					x.TotalReportLatency |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.IbcRequestEnabled = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9941,7 +14814,7 @@ func (x *PendingResolveList) ProtoReflect() protoreflect.Message {
 }
 
 func (x *PendingResolveList) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10433,7 +15306,7 @@ func (x *IBCChannel) ProtoReflect() protoreflect.Message {
 }
 
 func (x *IBCChannel) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10923,7 +15796,7 @@ func (x *RequestVerification) ProtoReflect() protoreflect.Message {
 }
 
 func (x *RequestVerification) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11551,7 +16424,7 @@ func (x *PriceResult) ProtoReflect() protoreflect.Message {
 }
 
 func (x *PriceResult) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12157,7 +17030,7 @@ func (x *OracleResultSignatureOrder) ProtoReflect() protoreflect.Message {
 }
 
 func (x *OracleResultSignatureOrder) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12702,192 +17575,469 @@ func (Encoder) Descriptor() protoreflect.EnumDescriptor {
 	return file_band_oracle_v1_oracle_proto_enumTypes[1].Descriptor()
 }
 
-func (Encoder) Type() protoreflect.EnumType {
-	return &file_band_oracle_v1_oracle_proto_enumTypes[1]
+func (Encoder) Type() protoreflect.EnumType {
+	return &file_band_oracle_v1_oracle_proto_enumTypes[1]
+}
+
+func (x Encoder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Encoder.Descriptor instead.
+func (Encoder) EnumDescriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
+}
+
+// DataSource is the data structure for storing data sources in the storage.
+type DataSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Owner is an address of the account who own the data source
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Name is data source name used for display
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Description is data source description used for display
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Filename is string of file name used as reference for locating
+	// data source file stored in bandchain nodes
+	Filename string `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Treasury is the account address who receive data source fee from requester.
+	Treasury string `protobuf:"bytes,5,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	// Fee is the data source fee per ask_count that data provider will receive
+	// from requester.
+	Fee []*v1beta1.Coin `protobuf:"bytes,6,rep,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (x *DataSource) Reset() {
+	*x = DataSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataSource) ProtoMessage() {}
+
+// Deprecated: Use DataSource.ProtoReflect.Descriptor instead.
+func (*DataSource) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DataSource) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *DataSource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DataSource) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DataSource) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *DataSource) GetTreasury() string {
+	if x != nil {
+		return x.Treasury
+	}
+	return ""
+}
+
+func (x *DataSource) GetFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+// OracleScript is the data structure for storing oracle scripts in the storage.
+type OracleScript struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Owner is an address of the account who own the oracle script
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Name is oracle script name used for display
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Description is oracle script description used for display
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Filename is string of file name used as reference for locating
+	// compiled oracle script WASM file stored in bandchain nodes
+	Filename string `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Schema is the schema of the oracle script input/output
+	// which is formatted in OBI format e.g.
+	// "{symbol:string,multiplier:u64}/{px:u64}"
+	Schema string `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
+	// SourceCodeURL is the URL of oracle script's source code.
+	// It is recommendded to store source code on IPFS and get its URL to preserve
+	// decentralization.
+	SourceCodeUrl string `protobuf:"bytes,6,opt,name=source_code_url,json=sourceCodeUrl,proto3" json:"source_code_url,omitempty"`
+}
+
+func (x *OracleScript) Reset() {
+	*x = OracleScript{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OracleScript) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OracleScript) ProtoMessage() {}
+
+// Deprecated: Use OracleScript.ProtoReflect.Descriptor instead.
+func (*OracleScript) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OracleScript) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *OracleScript) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OracleScript) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OracleScript) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
 }
 
-func (x Encoder) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *OracleScript) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
 }
 
-// Deprecated: Use Encoder.Descriptor instead.
-func (Encoder) EnumDescriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
+func (x *OracleScript) GetSourceCodeUrl() string {
+	if x != nil {
+		return x.SourceCodeUrl
+	}
+	return ""
 }
 
-// DataSource is the data structure for storing data sources in the storage.
-type DataSource struct {
+// RawRequest is the data structure for storing raw requests in the storage.
+type RawRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Owner is an address of the account who own the data source
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	// Name is data source name used for display
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Description is data source description used for display
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// Filename is string of file name used as reference for locating
-	// data source file stored in bandchain nodes
-	Filename string `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
-	// Treasury is the account address who receive data source fee from requester.
-	Treasury string `protobuf:"bytes,5,opt,name=treasury,proto3" json:"treasury,omitempty"`
-	// Fee is the data source fee per ask_count that data provider will receive
-	// from requester.
-	Fee []*v1beta1.Coin `protobuf:"bytes,6,rep,name=fee,proto3" json:"fee,omitempty"`
+	// ExternalID is an ID of the raw request
+	ExternalId uint64 `protobuf:"varint,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// DataSourceID is an ID of data source script that relates to the raw request
+	DataSourceId uint64 `protobuf:"varint,2,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// Calldata is the data used as argument params for executing data source
+	// script
+	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
 }
 
-func (x *DataSource) Reset() {
-	*x = DataSource{}
+func (x *RawRequest) Reset() {
+	*x = RawRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[0]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DataSource) String() string {
+func (x *RawRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataSource) ProtoMessage() {}
+func (*RawRequest) ProtoMessage() {}
 
-// Deprecated: Use DataSource.ProtoReflect.Descriptor instead.
-func (*DataSource) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use RawRequest.ProtoReflect.Descriptor instead.
+func (*RawRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *DataSource) GetOwner() string {
+func (x *RawRequest) GetExternalId() uint64 {
 	if x != nil {
-		return x.Owner
+		return x.ExternalId
 	}
-	return ""
+	return 0
 }
 
-func (x *DataSource) GetName() string {
+func (x *RawRequest) GetDataSourceId() uint64 {
 	if x != nil {
-		return x.Name
+		return x.DataSourceId
 	}
-	return ""
+	return 0
 }
 
-func (x *DataSource) GetDescription() string {
+func (x *RawRequest) GetCalldata() []byte {
 	if x != nil {
-		return x.Description
+		return x.Calldata
 	}
-	return ""
+	return nil
 }
 
-func (x *DataSource) GetFilename() string {
+// RawRequest is the data structure for storing raw reporter in the storage.
+type RawReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ExternalID is an ID of the raw request
+	ExternalId uint64 `protobuf:"varint,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// ExitCode is status code provided by validators to specify error, if any.
+	// Exit code is usually filled by the exit code returned from execution of
+	// specified data source script. With code 0 means there is no error.
+	ExitCode uint32 `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	// Data is raw result provided by validators.
+	// It is usually filled by the result from execution of specified data source
+	// script.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *RawReport) Reset() {
+	*x = RawReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RawReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RawReport) ProtoMessage() {}
+
+// Deprecated: Use RawReport.ProtoReflect.Descriptor instead.
+func (*RawReport) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RawReport) GetExternalId() uint64 {
 	if x != nil {
-		return x.Filename
+		return x.ExternalId
 	}
-	return ""
+	return 0
 }
 
-func (x *DataSource) GetTreasury() string {
+func (x *RawReport) GetExitCode() uint32 {
 	if x != nil {
-		return x.Treasury
+		return x.ExitCode
 	}
-	return ""
+	return 0
 }
 
-func (x *DataSource) GetFee() []*v1beta1.Coin {
+func (x *RawReport) GetData() []byte {
 	if x != nil {
-		return x.Fee
+		return x.Data
 	}
 	return nil
 }
 
-// OracleScript is the data structure for storing oracle scripts in the storage.
-type OracleScript struct {
+// Request is the data structure for storing requests in the storage.
+type Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Owner is an address of the account who own the oracle script
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	// Name is oracle script name used for display
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Description is oracle script description used for display
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// Filename is string of file name used as reference for locating
-	// compiled oracle script WASM file stored in bandchain nodes
-	Filename string `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
-	// Schema is the schema of the oracle script input/output
-	// which is formatted in OBI format e.g.
-	// "{symbol:string,multiplier:u64}/{px:u64}"
-	Schema string `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
-	// SourceCodeURL is the URL of oracle script's source code.
-	// It is recommendded to store source code on IPFS and get its URL to preserve
-	// decentralization.
-	SourceCodeUrl string `protobuf:"bytes,6,opt,name=source_code_url,json=sourceCodeUrl,proto3" json:"source_code_url,omitempty"`
+	// OracleScriptID is ID of an oracle script
+	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// Calldata is the data used as argument params for the oracle script
+	Calldata []byte `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// RequestedValidators is a list of validator addresses that are assigned for
+	// fulfilling the request
+	RequestedValidators []string `protobuf:"bytes,3,rep,name=requested_validators,json=requestedValidators,proto3" json:"requested_validators,omitempty"`
+	// MinCount is minimum number of validators required for fulfilling the
+	// request
+	MinCount uint64 `protobuf:"varint,4,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// RequestHeight is block height that the request has been created
+	RequestHeight int64 `protobuf:"varint,5,opt,name=request_height,json=requestHeight,proto3" json:"request_height,omitempty"`
+	// RequestTime is timestamp of the chain's block which contains the request
+	RequestTime int64 `protobuf:"varint,6,opt,name=request_time,json=requestTime,proto3" json:"request_time,omitempty"`
+	// ClientID is arbitrary id provided by requester.
+	// It is used by client-side for referencing the request
+	ClientId string `protobuf:"bytes,7,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// RawRequests is a list of raw requests specified by execution of oracle
+	// script
+	RawRequests []*RawRequest `protobuf:"bytes,8,rep,name=raw_requests,json=rawRequests,proto3" json:"raw_requests,omitempty"`
+	// IBCChannel is an IBC channel info of the other chain, which contains a
+	// channel and a port to allow bandchain connect to that chain. This field
+	// allows other chain be able to request data from bandchain via IBC.
+	IbcChannel *IBCChannel `protobuf:"bytes,9,opt,name=ibc_channel,json=ibcChannel,proto3" json:"ibc_channel,omitempty"`
+	// ExecuteGas is amount of gas to reserve for executing
+	ExecuteGas uint64 `protobuf:"varint,10,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	// TSSEncoder is the mode of encoding oracle result signature order.
+	TssEncoder Encoder `protobuf:"varint,11,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// Requester is the address of person who requests the data.
+	Requester string `protobuf:"bytes,12,opt,name=requester,proto3" json:"requester,omitempty"`
+	// FeeLimit is the maximum tokens that will be paid for this request.
+	FeeLimit []*v1beta1.Coin `protobuf:"bytes,13,rep,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	// Callback is the name of the module to be notified through the oracle hooks
+	// when the request is resolved. Empty if no module is subscribed.
+	Callback string `protobuf:"bytes,14,opt,name=callback,proto3" json:"callback,omitempty"`
 }
 
-func (x *OracleScript) Reset() {
-	*x = OracleScript{}
+func (x *Request) Reset() {
+	*x = Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[1]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OracleScript) String() string {
+func (x *Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OracleScript) ProtoMessage() {}
+func (*Request) ProtoMessage() {}
 
-// Deprecated: Use OracleScript.ProtoReflect.Descriptor instead.
-func (*OracleScript) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Request.ProtoReflect.Descriptor instead.
+func (*Request) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Request) GetOracleScriptId() uint64 {
+	if x != nil {
+		return x.OracleScriptId
+	}
+	return 0
+}
+
+func (x *Request) GetCalldata() []byte {
+	if x != nil {
+		return x.Calldata
+	}
+	return nil
+}
+
+func (x *Request) GetRequestedValidators() []string {
+	if x != nil {
+		return x.RequestedValidators
+	}
+	return nil
+}
+
+func (x *Request) GetMinCount() uint64 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
+}
+
+func (x *Request) GetRequestHeight() int64 {
+	if x != nil {
+		return x.RequestHeight
+	}
+	return 0
+}
+
+func (x *Request) GetRequestTime() int64 {
+	if x != nil {
+		return x.RequestTime
+	}
+	return 0
+}
+
+func (x *Request) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *Request) GetRawRequests() []*RawRequest {
+	if x != nil {
+		return x.RawRequests
+	}
+	return nil
 }
 
-func (x *OracleScript) GetOwner() string {
+func (x *Request) GetIbcChannel() *IBCChannel {
 	if x != nil {
-		return x.Owner
+		return x.IbcChannel
 	}
-	return ""
+	return nil
 }
 
-func (x *OracleScript) GetName() string {
+func (x *Request) GetExecuteGas() uint64 {
 	if x != nil {
-		return x.Name
+		return x.ExecuteGas
 	}
-	return ""
+	return 0
 }
 
-func (x *OracleScript) GetDescription() string {
+func (x *Request) GetTssEncoder() Encoder {
 	if x != nil {
-		return x.Description
+		return x.TssEncoder
 	}
-	return ""
+	return Encoder_ENCODER_UNSPECIFIED
 }
 
-func (x *OracleScript) GetFilename() string {
+func (x *Request) GetRequester() string {
 	if x != nil {
-		return x.Filename
+		return x.Requester
 	}
 	return ""
 }
 
-func (x *OracleScript) GetSchema() string {
+func (x *Request) GetFeeLimit() []*v1beta1.Coin {
 	if x != nil {
-		return x.Schema
+		return x.FeeLimit
 	}
-	return ""
+	return nil
 }
 
-func (x *OracleScript) GetSourceCodeUrl() string {
+func (x *Request) GetCallback() string {
 	if x != nil {
-		return x.SourceCodeUrl
+		return x.Callback
 	}
 	return ""
 }
 
-// RawRequest is the data structure for storing raw requests in the storage.
-type RawRequest struct {
+// RawRequestFee is the data source fee escrowed for one raw request, paid to
+// the treasury of the data source for each validator reporting the raw request.
+type RawRequestFee struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -12896,260 +18046,305 @@ type RawRequest struct {
 	ExternalId uint64 `protobuf:"varint,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 	// DataSourceID is an ID of data source script that relates to the raw request
 	DataSourceId uint64 `protobuf:"varint,2,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
-	// Calldata is the data used as argument params for executing data source
-	// script
-	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// Treasury is the account address of the data source treasury at the time
+	// of the request
+	Treasury string `protobuf:"bytes,3,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	// Fee is the amount paid to the treasury for each report of the raw request
+	Fee []*v1beta1.Coin `protobuf:"bytes,4,rep,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func (x *RawRequest) Reset() {
-	*x = RawRequest{}
+func (x *RawRequestFee) Reset() {
+	*x = RawRequestFee{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[2]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RawRequest) String() string {
+func (x *RawRequestFee) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RawRequest) ProtoMessage() {}
+func (*RawRequestFee) ProtoMessage() {}
 
-// Deprecated: Use RawRequest.ProtoReflect.Descriptor instead.
-func (*RawRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use RawRequestFee.ProtoReflect.Descriptor instead.
+func (*RawRequestFee) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *RawRequest) GetExternalId() uint64 {
+func (x *RawRequestFee) GetExternalId() uint64 {
 	if x != nil {
 		return x.ExternalId
 	}
 	return 0
 }
 
-func (x *RawRequest) GetDataSourceId() uint64 {
+func (x *RawRequestFee) GetDataSourceId() uint64 {
 	if x != nil {
 		return x.DataSourceId
 	}
 	return 0
 }
 
-func (x *RawRequest) GetCalldata() []byte {
+func (x *RawRequestFee) GetTreasury() string {
 	if x != nil {
-		return x.Calldata
+		return x.Treasury
+	}
+	return ""
+}
+
+func (x *RawRequestFee) GetFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.Fee
 	}
 	return nil
 }
 
-// RawRequest is the data structure for storing raw reporter in the storage.
-type RawReport struct {
+// FeeEscrow is the data structure for storing data source fees held by the
+// oracle module until the raw requests of the request are reported. Every
+// unresolved request has one, and the remaining fees are refunded to the payer
+// on resolution, so reports that arrive after resolution pay nothing.
+type FeeEscrow struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ExternalID is an ID of the raw request
-	ExternalId uint64 `protobuf:"varint,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
-	// ExitCode is status code provided by validators to specify error, if any.
-	// Exit code is usually filled by the exit code returned from execution of
-	// specified data source script. With code 0 means there is no error.
-	ExitCode uint32 `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
-	// Data is raw result provided by validators.
-	// It is usually filled by the result from execution of specified data source
-	// script.
-	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// RequestID is ID of the request that the fees are escrowed for
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Payer is the address of the account that paid the fees and receives the
+	// refund of the remaining fees
+	Payer string `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	// RawRequestFees is the list of fees for each raw request of the request
+	RawRequestFees []*RawRequestFee `protobuf:"bytes,3,rep,name=raw_request_fees,json=rawRequestFees,proto3" json:"raw_request_fees,omitempty"`
+	// Remaining is the amount of fees still held by the escrow
+	Remaining []*v1beta1.Coin `protobuf:"bytes,4,rep,name=remaining,proto3" json:"remaining,omitempty"`
 }
 
-func (x *RawReport) Reset() {
-	*x = RawReport{}
+func (x *FeeEscrow) Reset() {
+	*x = FeeEscrow{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[3]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RawReport) String() string {
+func (x *FeeEscrow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RawReport) ProtoMessage() {}
+func (*FeeEscrow) ProtoMessage() {}
 
-// Deprecated: Use RawReport.ProtoReflect.Descriptor instead.
-func (*RawReport) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use FeeEscrow.ProtoReflect.Descriptor instead.
+func (*FeeEscrow) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *RawReport) GetExternalId() uint64 {
+func (x *FeeEscrow) GetRequestId() uint64 {
 	if x != nil {
-		return x.ExternalId
+		return x.RequestId
 	}
 	return 0
 }
 
-func (x *RawReport) GetExitCode() uint32 {
+func (x *FeeEscrow) GetPayer() string {
 	if x != nil {
-		return x.ExitCode
+		return x.Payer
 	}
-	return 0
+	return ""
 }
 
-func (x *RawReport) GetData() []byte {
+func (x *FeeEscrow) GetRawRequestFees() []*RawRequestFee {
 	if x != nil {
-		return x.Data
+		return x.RawRequestFees
 	}
 	return nil
 }
 
-// Request is the data structure for storing requests in the storage.
-type Request struct {
+func (x *FeeEscrow) GetRemaining() []*v1beta1.Coin {
+	if x != nil {
+		return x.Remaining
+	}
+	return nil
+}
+
+// RecurringRequest is the data structure for storing a request schedule that
+// periodically spawns oracle requests on behalf of its owner.
+type RecurringRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// OracleScriptID is ID of an oracle script
-	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
-	// Calldata is the data used as argument params for the oracle script
-	Calldata []byte `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
-	// RequestedValidators is a list of validator addresses that are assigned for
-	// fulfilling the request
-	RequestedValidators []string `protobuf:"bytes,3,rep,name=requested_validators,json=requestedValidators,proto3" json:"requested_validators,omitempty"`
-	// MinCount is minimum number of validators required for fulfilling the
-	// request
-	MinCount uint64 `protobuf:"varint,4,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
-	// RequestHeight is block height that the request has been created
-	RequestHeight int64 `protobuf:"varint,5,opt,name=request_height,json=requestHeight,proto3" json:"request_height,omitempty"`
-	// RequestTime is timestamp of the chain's block which contains the request
-	RequestTime int64 `protobuf:"varint,6,opt,name=request_time,json=requestTime,proto3" json:"request_time,omitempty"`
-	// ClientID is arbitrary id provided by requester.
-	// It is used by client-side for referencing the request
-	ClientId string `protobuf:"bytes,7,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	// RawRequests is a list of raw requests specified by execution of oracle
-	// script
-	RawRequests []*RawRequest `protobuf:"bytes,8,rep,name=raw_requests,json=rawRequests,proto3" json:"raw_requests,omitempty"`
-	// IBCChannel is an IBC channel info of the other chain, which contains a
-	// channel and a port to allow bandchain connect to that chain. This field
-	// allows other chain be able to request data from bandchain via IBC.
-	IbcChannel *IBCChannel `protobuf:"bytes,9,opt,name=ibc_channel,json=ibcChannel,proto3" json:"ibc_channel,omitempty"`
+	// ID is the unique identifier of the recurring request.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// OracleScriptID is the identifier of the oracle script to be called.
+	OracleScriptId uint64 `protobuf:"varint,2,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// Calldata is the OBI-encoded call parameters for the oracle script.
+	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is the number of validators to perform the oracle task.
+	AskCount uint64 `protobuf:"varint,4,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is the minimum number of validators sufficient to resolve the
+	// oracle tasks.
+	MinCount uint64 `protobuf:"varint,5,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// ClientID is the client-provided unique identifier to track the spawned
+	// requests.
+	ClientId string `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// FeeLimit is the maximum tokens that will be paid for each spawned request.
+	FeeLimit []*v1beta1.Coin `protobuf:"bytes,7,rep,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	// PrepareGas is amount of gas to pay to prepare raw requests
+	PrepareGas uint64 `protobuf:"varint,8,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
 	// ExecuteGas is amount of gas to reserve for executing
-	ExecuteGas uint64 `protobuf:"varint,10,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	ExecuteGas uint64 `protobuf:"varint,9,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
 	// TSSEncoder is the mode of encoding oracle result signature order.
-	TssEncoder Encoder `protobuf:"varint,11,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
-	// Requester is the address of person who requests the data.
-	Requester string `protobuf:"bytes,12,opt,name=requester,proto3" json:"requester,omitempty"`
-	// FeeLimit is the maximum tokens that will be paid for this request.
-	FeeLimit []*v1beta1.Coin `protobuf:"bytes,13,rep,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
-}
-
-func (x *Request) Reset() {
-	*x = Request{}
+	TssEncoder Encoder `protobuf:"varint,10,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// Callback is the name of the module to be notified through the oracle hooks
+	// when a spawned request is resolved (optional).
+	Callback string `protobuf:"bytes,11,opt,name=callback,proto3" json:"callback,omitempty"`
+	// Interval is the number of blocks between two spawned requests.
+	Interval uint64 `protobuf:"varint,12,opt,name=interval,proto3" json:"interval,omitempty"`
+	// NextHeight is the block height at which the next request will be spawned.
+	NextHeight int64 `protobuf:"varint,13,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// FeePayer is the account holding the prepaid deposit that pays for the
+	// spawned requests.
+	FeePayer string `protobuf:"bytes,14,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// Owner is the address of the account who created the recurring request.
+	Owner string `protobuf:"bytes,15,opt,name=owner,proto3" json:"owner,omitempty"`
+	// IsActive is a flag indicating whether the recurring request still spawns
+	// requests. It is turned off when the deposit cannot cover the fee limit.
+	IsActive bool `protobuf:"varint,16,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+}
+
+func (x *RecurringRequest) Reset() {
+	*x = RecurringRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[4]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Request) String() string {
+func (x *RecurringRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Request) ProtoMessage() {}
+func (*RecurringRequest) ProtoMessage() {}
 
-// Deprecated: Use Request.ProtoReflect.Descriptor instead.
-func (*Request) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use RecurringRequest.ProtoReflect.Descriptor instead.
+func (*RecurringRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Request) GetOracleScriptId() uint64 {
+func (x *RecurringRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RecurringRequest) GetOracleScriptId() uint64 {
 	if x != nil {
 		return x.OracleScriptId
 	}
 	return 0
 }
 
-func (x *Request) GetCalldata() []byte {
+func (x *RecurringRequest) GetCalldata() []byte {
 	if x != nil {
 		return x.Calldata
 	}
 	return nil
 }
 
-func (x *Request) GetRequestedValidators() []string {
+func (x *RecurringRequest) GetAskCount() uint64 {
 	if x != nil {
-		return x.RequestedValidators
+		return x.AskCount
 	}
-	return nil
+	return 0
 }
 
-func (x *Request) GetMinCount() uint64 {
+func (x *RecurringRequest) GetMinCount() uint64 {
 	if x != nil {
 		return x.MinCount
 	}
 	return 0
 }
 
-func (x *Request) GetRequestHeight() int64 {
+func (x *RecurringRequest) GetClientId() string {
 	if x != nil {
-		return x.RequestHeight
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *RecurringRequest) GetFeeLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.FeeLimit
+	}
+	return nil
+}
+
+func (x *RecurringRequest) GetPrepareGas() uint64 {
+	if x != nil {
+		return x.PrepareGas
 	}
 	return 0
 }
 
-func (x *Request) GetRequestTime() int64 {
+func (x *RecurringRequest) GetExecuteGas() uint64 {
 	if x != nil {
-		return x.RequestTime
+		return x.ExecuteGas
 	}
 	return 0
 }
 
-func (x *Request) GetClientId() string {
+func (x *RecurringRequest) GetTssEncoder() Encoder {
 	if x != nil {
-		return x.ClientId
+		return x.TssEncoder
 	}
-	return ""
+	return Encoder_ENCODER_UNSPECIFIED
 }
 
-func (x *Request) GetRawRequests() []*RawRequest {
+func (x *RecurringRequest) GetCallback() string {
 	if x != nil {
-		return x.RawRequests
+		return x.Callback
 	}
-	return nil
+	return ""
 }
 
-func (x *Request) GetIbcChannel() *IBCChannel {
+func (x *RecurringRequest) GetInterval() uint64 {
 	if x != nil {
-		return x.IbcChannel
+		return x.Interval
 	}
-	return nil
+	return 0
 }
 
-func (x *Request) GetExecuteGas() uint64 {
+func (x *RecurringRequest) GetNextHeight() int64 {
 	if x != nil {
-		return x.ExecuteGas
+		return x.NextHeight
 	}
 	return 0
 }
 
-func (x *Request) GetTssEncoder() Encoder {
+func (x *RecurringRequest) GetFeePayer() string {
 	if x != nil {
-		return x.TssEncoder
+		return x.FeePayer
 	}
-	return Encoder_ENCODER_UNSPECIFIED
+	return ""
 }
 
-func (x *Request) GetRequester() string {
+func (x *RecurringRequest) GetOwner() string {
 	if x != nil {
-		return x.Requester
+		return x.Owner
 	}
 	return ""
 }
 
-func (x *Request) GetFeeLimit() []*v1beta1.Coin {
+func (x *RecurringRequest) GetIsActive() bool {
 	if x != nil {
-		return x.FeeLimit
+		return x.IsActive
 	}
-	return nil
+	return false
 }
 
 // Report is the data structure for storing reports in the storage.
@@ -13171,7 +18366,7 @@ type Report struct {
 func (x *Report) Reset() {
 	*x = Report{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13185,7 +18380,7 @@ func (*Report) ProtoMessage() {}
 
 // Deprecated: Use Report.ProtoReflect.Descriptor instead.
 func (*Report) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{5}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Report) GetValidator() string {
@@ -13247,7 +18442,7 @@ type OracleRequestPacketData struct {
 func (x *OracleRequestPacketData) Reset() {
 	*x = OracleRequestPacketData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[6]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13261,7 +18456,7 @@ func (*OracleRequestPacketData) ProtoMessage() {}
 
 // Deprecated: Use OracleRequestPacketData.ProtoReflect.Descriptor instead.
 func (*OracleRequestPacketData) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{6}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *OracleRequestPacketData) GetClientId() string {
@@ -13341,7 +18536,7 @@ type OracleRequestPacketAcknowledgement struct {
 func (x *OracleRequestPacketAcknowledgement) Reset() {
 	*x = OracleRequestPacketAcknowledgement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[7]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13355,7 +18550,7 @@ func (*OracleRequestPacketAcknowledgement) ProtoMessage() {}
 
 // Deprecated: Use OracleRequestPacketAcknowledgement.ProtoReflect.Descriptor instead.
 func (*OracleRequestPacketAcknowledgement) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{7}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *OracleRequestPacketAcknowledgement) GetRequestId() uint64 {
@@ -13365,6 +18560,55 @@ func (x *OracleRequestPacketAcknowledgement) GetRequestId() uint64 {
 	return 0
 }
 
+// OracleRequestPacketAcknowledgementV2 encodes an oracle request
+// acknowledgement send back to requester chain on a channel of version
+// bandchain-2.
+type OracleRequestPacketAcknowledgementV2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RequestID is BandChain's unique identifier for this oracle request.
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// ExpirationHeight is the BandChain height at which the request expires if it
+	// is not resolved yet, so the requester chain can time out sanely.
+	ExpirationHeight int64 `protobuf:"varint,2,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (x *OracleRequestPacketAcknowledgementV2) Reset() {
+	*x = OracleRequestPacketAcknowledgementV2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OracleRequestPacketAcknowledgementV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OracleRequestPacketAcknowledgementV2) ProtoMessage() {}
+
+// Deprecated: Use OracleRequestPacketAcknowledgementV2.ProtoReflect.Descriptor instead.
+func (*OracleRequestPacketAcknowledgementV2) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *OracleRequestPacketAcknowledgementV2) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *OracleRequestPacketAcknowledgementV2) GetExpirationHeight() int64 {
+	if x != nil {
+		return x.ExpirationHeight
+	}
+	return 0
+}
+
 // OracleResponsePacketData encodes an oracle response from BandChain to the
 // requester.
 type OracleResponsePacketData struct {
@@ -13398,7 +18642,7 @@ type OracleResponsePacketData struct {
 func (x *OracleResponsePacketData) Reset() {
 	*x = OracleResponsePacketData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13412,7 +18656,7 @@ func (*OracleResponsePacketData) ProtoMessage() {}
 
 // Deprecated: Use OracleResponsePacketData.ProtoReflect.Descriptor instead.
 func (*OracleResponsePacketData) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{8}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *OracleResponsePacketData) GetClientId() string {
@@ -13508,7 +18752,7 @@ type Result struct {
 func (x *Result) Reset() {
 	*x = Result{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13522,7 +18766,7 @@ func (*Result) ProtoMessage() {}
 
 // Deprecated: Use Result.ProtoReflect.Descriptor instead.
 func (*Result) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{9}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Result) GetClientId() string {
@@ -13619,7 +18863,7 @@ type SigningResult struct {
 func (x *SigningResult) Reset() {
 	*x = SigningResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13633,7 +18877,7 @@ func (*SigningResult) ProtoMessage() {}
 
 // Deprecated: Use SigningResult.ProtoReflect.Descriptor instead.
 func (*SigningResult) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{10}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SigningResult) GetSigningId() uint64 {
@@ -13674,7 +18918,7 @@ type ValidatorStatus struct {
 func (x *ValidatorStatus) Reset() {
 	*x = ValidatorStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13688,7 +18932,7 @@ func (*ValidatorStatus) ProtoMessage() {}
 
 // Deprecated: Use ValidatorStatus.ProtoReflect.Descriptor instead.
 func (*ValidatorStatus) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{11}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ValidatorStatus) GetIsActive() bool {
@@ -13720,7 +18964,7 @@ type ActiveValidator struct {
 func (x *ActiveValidator) Reset() {
 	*x = ActiveValidator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13734,7 +18978,7 @@ func (*ActiveValidator) ProtoMessage() {}
 
 // Deprecated: Use ActiveValidator.ProtoReflect.Descriptor instead.
 func (*ActiveValidator) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{12}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ActiveValidator) GetAddress() string {
@@ -13788,12 +19032,50 @@ type Params struct {
 	// IBCRequestEnabled is a flag indicating whether sending oracle request via
 	// IBC is allowed
 	IbcRequestEnabled bool `protobuf:"varint,11,opt,name=ibc_request_enabled,json=ibcRequestEnabled,proto3" json:"ibc_request_enabled,omitempty"`
+	// ReliabilitySamplingEnabled is a flag indicating whether validator
+	// sampling takes the oracle reliability of validators into account
+	ReliabilitySamplingEnabled bool `protobuf:"varint,12,opt,name=reliability_sampling_enabled,json=reliabilitySamplingEnabled,proto3" json:"reliability_sampling_enabled,omitempty"`
+	// ReliabilityWeightPercentage is the percentage of the sampling weight of a
+	// validator that depends on its oracle reliability instead of its power. It
+	// must not exceed 90, so that every validator keeps some sampling weight.
+	ReliabilityWeightPercentage uint64 `protobuf:"varint,13,opt,name=reliability_weight_percentage,json=reliabilityWeightPercentage,proto3" json:"reliability_weight_percentage,omitempty"`
+	// ResultRetentionPeriod is the duration (in seconds) after resolution that a
+	// request result is kept in state before it can be pruned. Zero disables
+	// pruning by age.
+	ResultRetentionPeriod uint64 `protobuf:"varint,14,opt,name=result_retention_period,json=resultRetentionPeriod,proto3" json:"result_retention_period,omitempty"`
+	// ResultRetentionCount is the number of the most recent expired request
+	// results that are always kept in state. Zero disables pruning by count.
+	ResultRetentionCount uint64 `protobuf:"varint,15,opt,name=result_retention_count,json=resultRetentionCount,proto3" json:"result_retention_count,omitempty"`
+	// MaxPrunedResultsPerBlock is the maximum number of request results pruned
+	// in a single block. Zero pauses pruning.
+	MaxPrunedResultsPerBlock uint64 `protobuf:"varint,16,opt,name=max_pruned_results_per_block,json=maxPrunedResultsPerBlock,proto3" json:"max_pruned_results_per_block,omitempty"`
+	// IBCRequestRateLimitWindow is the number of blocks of a window in which
+	// the number of IBC requests is rate limited. Zero disables rate limiting.
+	IbcRequestRateLimitWindow uint64 `protobuf:"varint,17,opt,name=ibc_request_rate_limit_window,json=ibcRequestRateLimitWindow,proto3" json:"ibc_request_rate_limit_window,omitempty"`
+	// MaxIBCRequestsPerChannel is the maximum number of IBC requests from a
+	// channel in a window. Zero means unlimited.
+	MaxIbcRequestsPerChannel uint64 `protobuf:"varint,18,opt,name=max_ibc_requests_per_channel,json=maxIbcRequestsPerChannel,proto3" json:"max_ibc_requests_per_channel,omitempty"`
+	// MaxIBCRequestsPerLightClient is the maximum number of IBC requests from
+	// all channels of a light client, i.e. of a counterparty chain, in a window.
+	// Zero means unlimited.
+	MaxIbcRequestsPerLightClient uint64 `protobuf:"varint,19,opt,name=max_ibc_requests_per_light_client,json=maxIbcRequestsPerLightClient,proto3" json:"max_ibc_requests_per_light_client,omitempty"`
+	// MinRecurringRequestInterval is the minimum number of blocks between two
+	// requests spawned by a recurring request.
+	MinRecurringRequestInterval uint64 `protobuf:"varint,20,opt,name=min_recurring_request_interval,json=minRecurringRequestInterval,proto3" json:"min_recurring_request_interval,omitempty"`
+	// RecurringRequestSpawnFee is the fee charged from the deposit of a
+	// recurring request for every attempt to spawn a request, which pays for
+	// preparing the request in the begin blocker.
+	RecurringRequestSpawnFee []*v1beta1.Coin `protobuf:"bytes,21,rep,name=recurring_request_spawn_fee,json=recurringRequestSpawnFee,proto3" json:"recurring_request_spawn_fee,omitempty"`
+	// ReliabilityWindowSize is the number of assigned requests after which the
+	// reliability counts of a validator are halved, so that recent reports weigh
+	// more than old ones. Zero disables the decay.
+	ReliabilityWindowSize uint64 `protobuf:"varint,22,opt,name=reliability_window_size,json=reliabilityWindowSize,proto3" json:"reliability_window_size,omitempty"`
 }
 
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13807,7 +19089,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{13}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Params) GetMaxRawRequestCount() uint64 {
@@ -13887,6 +19169,196 @@ func (x *Params) GetIbcRequestEnabled() bool {
 	return false
 }
 
+func (x *Params) GetReliabilitySamplingEnabled() bool {
+	if x != nil {
+		return x.ReliabilitySamplingEnabled
+	}
+	return false
+}
+
+func (x *Params) GetReliabilityWeightPercentage() uint64 {
+	if x != nil {
+		return x.ReliabilityWeightPercentage
+	}
+	return 0
+}
+
+func (x *Params) GetResultRetentionPeriod() uint64 {
+	if x != nil {
+		return x.ResultRetentionPeriod
+	}
+	return 0
+}
+
+func (x *Params) GetResultRetentionCount() uint64 {
+	if x != nil {
+		return x.ResultRetentionCount
+	}
+	return 0
+}
+
+func (x *Params) GetMaxPrunedResultsPerBlock() uint64 {
+	if x != nil {
+		return x.MaxPrunedResultsPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetIbcRequestRateLimitWindow() uint64 {
+	if x != nil {
+		return x.IbcRequestRateLimitWindow
+	}
+	return 0
+}
+
+func (x *Params) GetMaxIbcRequestsPerChannel() uint64 {
+	if x != nil {
+		return x.MaxIbcRequestsPerChannel
+	}
+	return 0
+}
+
+func (x *Params) GetMaxIbcRequestsPerLightClient() uint64 {
+	if x != nil {
+		return x.MaxIbcRequestsPerLightClient
+	}
+	return 0
+}
+
+func (x *Params) GetMinRecurringRequestInterval() uint64 {
+	if x != nil {
+		return x.MinRecurringRequestInterval
+	}
+	return 0
+}
+
+func (x *Params) GetRecurringRequestSpawnFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.RecurringRequestSpawnFee
+	}
+	return nil
+}
+
+func (x *Params) GetReliabilityWindowSize() uint64 {
+	if x != nil {
+		return x.ReliabilityWindowSize
+	}
+	return 0
+}
+
+// IBCRequestCounter is the number of IBC requests received in the current
+// rate limit window.
+type IBCRequestCounter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Window is the index of the rate limit window the count belongs to
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// Count is the number of requests received in the window
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *IBCRequestCounter) Reset() {
+	*x = IBCRequestCounter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IBCRequestCounter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IBCRequestCounter) ProtoMessage() {}
+
+// Deprecated: Use IBCRequestCounter.ProtoReflect.Descriptor instead.
+func (*IBCRequestCounter) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *IBCRequestCounter) GetWindow() uint64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *IBCRequestCounter) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// ValidatorReliability is the oracle reporting record of a validator that is
+// used to weight the validator in request sampling.
+type ValidatorReliability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Validator is the validator address
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// AssignedCount is the number of requests the validator was chosen for
+	AssignedCount uint64 `protobuf:"varint,2,opt,name=assigned_count,json=assignedCount,proto3" json:"assigned_count,omitempty"`
+	// ReportedCount is the number of requests the validator reported to
+	ReportedCount uint64 `protobuf:"varint,3,opt,name=reported_count,json=reportedCount,proto3" json:"reported_count,omitempty"`
+	// TotalReportLatency is the sum of blocks between request and report of all
+	// reports of the validator
+	TotalReportLatency uint64 `protobuf:"varint,4,opt,name=total_report_latency,json=totalReportLatency,proto3" json:"total_report_latency,omitempty"`
+}
+
+func (x *ValidatorReliability) Reset() {
+	*x = ValidatorReliability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorReliability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorReliability) ProtoMessage() {}
+
+// Deprecated: Use ValidatorReliability.ProtoReflect.Descriptor instead.
+func (*ValidatorReliability) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ValidatorReliability) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *ValidatorReliability) GetAssignedCount() uint64 {
+	if x != nil {
+		return x.AssignedCount
+	}
+	return 0
+}
+
+func (x *ValidatorReliability) GetReportedCount() uint64 {
+	if x != nil {
+		return x.ReportedCount
+	}
+	return 0
+}
+
+func (x *ValidatorReliability) GetTotalReportLatency() uint64 {
+	if x != nil {
+		return x.TotalReportLatency
+	}
+	return 0
+}
+
 // PendingResolveList is a list of requests that are waiting to be resolved
 type PendingResolveList struct {
 	state         protoimpl.MessageState
@@ -13900,7 +19372,7 @@ type PendingResolveList struct {
 func (x *PendingResolveList) Reset() {
 	*x = PendingResolveList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13914,7 +19386,7 @@ func (*PendingResolveList) ProtoMessage() {}
 
 // Deprecated: Use PendingResolveList.ProtoReflect.Descriptor instead.
 func (*PendingResolveList) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{14}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *PendingResolveList) GetRequestIds() []uint64 {
@@ -13942,7 +19414,7 @@ type IBCChannel struct {
 func (x *IBCChannel) Reset() {
 	*x = IBCChannel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13956,7 +19428,7 @@ func (*IBCChannel) ProtoMessage() {}
 
 // Deprecated: Use IBCChannel.ProtoReflect.Descriptor instead.
 func (*IBCChannel) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{15}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *IBCChannel) GetPortId() string {
@@ -13995,7 +19467,7 @@ type RequestVerification struct {
 func (x *RequestVerification) Reset() {
 	*x = RequestVerification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14009,7 +19481,7 @@ func (*RequestVerification) ProtoMessage() {}
 
 // Deprecated: Use RequestVerification.ProtoReflect.Descriptor instead.
 func (*RequestVerification) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{16}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *RequestVerification) GetChainId() string {
@@ -14071,7 +19543,7 @@ type PriceResult struct {
 func (x *PriceResult) Reset() {
 	*x = PriceResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14085,7 +19557,7 @@ func (*PriceResult) ProtoMessage() {}
 
 // Deprecated: Use PriceResult.ProtoReflect.Descriptor instead.
 func (*PriceResult) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{17}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *PriceResult) GetSymbol() string {
@@ -14138,7 +19610,7 @@ type OracleResultSignatureOrder struct {
 func (x *OracleResultSignatureOrder) Reset() {
 	*x = OracleResultSignatureOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14152,7 +19624,7 @@ func (*OracleResultSignatureOrder) ProtoMessage() {}
 
 // Deprecated: Use OracleResultSignatureOrder.ProtoReflect.Descriptor instead.
 func (*OracleResultSignatureOrder) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{18}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *OracleResultSignatureOrder) GetRequestId() uint64 {
@@ -14180,340 +19652,511 @@ var file_band_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
 	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf5, 0x01, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74,
-	0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
-	0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x12, 0x5d, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
-	0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x73, 0x52, 0x03, 0x66, 0x65, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xcf, 0x01, 0x0a,
-	0x0c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x39, 0x0a,
-	0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75, 0x72, 0x6c,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb5,
-	0x01, 0x0a, 0x0a, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a,
-	0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44,
-	0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0e,
-	0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61,
-	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x81, 0x01, 0x0a, 0x09, 0x52, 0x61, 0x77, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc5, 0x05, 0x0a, 0x07, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x09,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x61, 0x77, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x0b, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x0b,
-	0x69, 0x62, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x0e, 0xe2,
-	0xde, 0x1f, 0x0a, 0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x0a, 0x69,
-	0x62, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73,
-	0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53,
-	0x53, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
-	0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x65, 0x72, 0x12, 0x68, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x73, 0x52, 0x08, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x04, 0xe8, 0xa0,
-	0x1f, 0x01, 0x22, 0x9a, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x69,
-	0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
-	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x61, 0x77, 0x5f, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62,
-	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61,
-	0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72,
-	0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0xe6, 0x03, 0x0a, 0x17, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x09, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x68, 0x0a, 0x09,
-	0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xf5, 0x01, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x79, 0x12, 0x5d, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00,
 	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x66, 0x65,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72,
-	0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x65, 0x47, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73, 0x73, 0x5f,
-	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53, 0x53, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x65, 0x0a, 0x22, 0x4f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x41,
-	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39,
-	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0xc7, 0x02, 0x0a, 0x18, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x09,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x03, 0x66, 0x65,
+	0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xcf, 0x01, 0x0a, 0x0c, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x39, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x55, 0x52, 0x4c, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x55, 0x72, 0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb5, 0x01, 0x0a, 0x0a, 0x52, 0x61,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2,
+	0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f,
+	0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
+	0x01, 0x22, 0x81, 0x01, 0x0a, 0x09, 0x52, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a,
+	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xe1, 0x05, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f,
+	0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa,
+	0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49,
+	0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x31, 0x0a,
+	0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x0b, 0x69, 0x62, 0x63, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x42,
+	0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x49, 0x42,
+	0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x0a, 0x69, 0x62, 0x63, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f,
+	0x67, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73, 0x73, 0x5f, 0x65, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53, 0x53, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x68, 0x0a,
+	0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x66,
+	0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb1, 0x02, 0x0a, 0x0d, 0x52, 0x61,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x65, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44,
+	0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08,
+	0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x12, 0x5d, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0x52, 0x03, 0x66, 0x65, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb6, 0x02,
+	0x0a, 0x09, 0x46, 0x65, 0x65, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x12, 0x39, 0x0a, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde,
+	0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x70, 0x61, 0x79, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x05, 0x70, 0x61, 0x79, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x10, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x65, 0x65, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x46, 0x65, 0x65, 0x73, 0x12, 0x69, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xea, 0x05, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x75, 0x72,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x02, 0x49, 0x44, 0xfa,
+	0xde, 0x1f, 0x12, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c,
+	0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c,
+	0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x68, 0x0a, 0x09, 0x66, 0x65,
+	0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x66, 0x65, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x5f,
+	0x67, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x70, 0x61,
+	0x72, 0x65, 0x47, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x5f, 0x67, 0x61, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73, 0x73, 0x5f, 0x65, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53, 0x53, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x66, 0x65, 0x65,
+	0x5f, 0x70, 0x61, 0x79, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x66, 0x65, 0x65, 0x50, 0x61, 0x79, 0x65, 0x72,
+	0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x3a, 0x04, 0xe8,
+	0xa0, 0x1f, 0x01, 0x22, 0x9a, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11,
+	0x69, 0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x61, 0x77, 0x5f,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a,
+	0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
+	0x22, 0xe6, 0x03, 0x0a, 0x17, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x09,
 	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f,
-	0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65,
-	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xdb, 0x03, 0x0a, 0x06, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x68, 0x0a,
+	0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x66,
+	0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61,
+	0x72, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x65, 0x47, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73, 0x73,
+	0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53, 0x53,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x65, 0x0a, 0x22, 0x4f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
+	0x22, 0x94, 0x01, 0x0a, 0x24, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2,
+	0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc7, 0x02, 0x0a, 0x18, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69,
 	0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f,
-	0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52,
-	0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61,
-	0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f,
-	0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc9, 0x01, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x6a, 0x0a, 0x0a, 0x73, 0x69, 0x67,
-	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x4b, 0xe2,
-	0xde, 0x1f, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x3a,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33,
-	0x2f, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x3a, 0x04, 0xe8,
-	0xa0, 0x1f, 0x01, 0x22, 0x70, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
-	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x3a,
-	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x41, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x22, 0xc4, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x73,
-	0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d,
-	0x61, 0x78, 0x41, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61,
-	0x78, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a,
-	0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x77, 0x61, 0x73, 0x6d, 0x5f, 0x67, 0x61, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x4f, 0x77, 0x61, 0x73, 0x6d,
-	0x47, 0x61, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x67, 0x61, 0x73,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x70, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x61, 0x73, 0x12, 0x2c,
-	0x0a, 0x12, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x61, 0x6d, 0x70,
-	0x6c, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x18,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x69, 0x6e, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x13, 0x69, 0x62, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42,
-	0x15, 0xe2, 0xde, 0x1f, 0x11, 0x49, 0x42, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x11, 0x69, 0x62, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0x3b, 0x0a, 0x12, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x64, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x4a, 0x0a, 0x0a,
-	0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
-	0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72,
-	0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa3, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x26, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0b, 0xe2, 0xde, 0x1f, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52,
-	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64,
-	0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb3,
-	0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
-	0x6c, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x78, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x70, 0x78, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x1a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31,
-	0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x72, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x2a, 0xfb, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f,
-	0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x1f, 0x52, 0x45, 0x53,
+	0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e,
+	0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61,
+	0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a,
+	0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
+	0x01, 0x22, 0xdb, 0x03, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x09,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
+	0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
+	0xc9, 0x01, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x6a, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x4b, 0xe2, 0xde, 0x1f, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x69,
+	0x6e, 0x67, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x74,
+	0x73, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x49, 0x44, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x27, 0x0a,
+	0x0f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x70, 0x0a, 0x0f, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x73,
+	0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01,
+	0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x41, 0x0a,
+	0x0f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x22, 0xac, 0x0b, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x6d,
+	0x61, 0x78, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x52,
+	0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22,
+	0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x73, 0x6b, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d,
+	0x61, 0x78, 0x43, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2f,
+	0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61,
+	0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x34, 0x0a, 0x16, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x14, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x77,
+	0x61, 0x73, 0x6d, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62,
+	0x61, 0x73, 0x65, 0x4f, 0x77, 0x61, 0x73, 0x6d, 0x47, 0x61, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x70,
+	0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16,
+	0x70, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x47, 0x61, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x79, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x3a,
+	0x0a, 0x19, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x17, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x13, 0x69, 0x62,
+	0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x15, 0xe2, 0xde, 0x1f, 0x11, 0x49, 0x42, 0x43,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x11,
+	0x69, 0x62, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x40, 0x0a, 0x1c, 0x72, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x72, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x1d, 0x72, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1b, 0x72, 0x65, 0x6c, 0x69,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x34, 0x0a, 0x16, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x14, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x1c, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x75,
+	0x6e, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x6d, 0x61, 0x78,
+	0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x50, 0x65, 0x72,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x5f, 0x0a, 0x1d, 0x69, 0x62, 0x63, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1d, 0xe2, 0xde,
+	0x1f, 0x19, 0x49, 0x42, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x19, 0x69, 0x62, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x5c, 0x0a, 0x1c, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x62,
+	0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde,
+	0x1f, 0x18, 0x4d, 0x61, 0x78, 0x49, 0x42, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x50, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x49,
+	0x62, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50, 0x65, 0x72, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x69, 0x0a, 0x21, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x62, 0x63, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x20, 0xe2, 0xde, 0x1f, 0x1c, 0x4d, 0x61, 0x78, 0x49, 0x42, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x50, 0x65, 0x72, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x1c, 0x6d, 0x61, 0x78, 0x49, 0x62, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x50, 0x65, 0x72, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x43, 0x0a, 0x1e, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1b, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x75,
+	0x72, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x8a, 0x01, 0x0a, 0x1b, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x5f, 0x66, 0x65, 0x65, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x18, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x46, 0x65,
+	0x65, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x15, 0x72, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x69, 0x7a, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
+	0x47, 0x0a, 0x11, 0x49, 0x42, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xdd, 0x01, 0x0a, 0x14, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x30, 0x0a, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x3b, 0x0a, 0x12, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x73, 0x3a,
+	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x4a, 0x0a, 0x0a, 0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
+	0x01, 0x22, 0xa3, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x08, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xe2, 0xde, 0x1f,
+	0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa,
+	0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb3, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x70, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x70, 0x78, 0x12,
+	0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x90, 0x01,
+	0x0a, 0x1a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa,
+	0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00,
+	0x2a, 0xfb, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x3c, 0x0a, 0x1f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x17, 0x8a, 0x9d, 0x20, 0x13, 0x52, 0x45, 0x53,
 	0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x17,
-	0x8a, 0x9d, 0x20, 0x13, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c,
-	0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
-	0x53, 0x10, 0x01, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x12,
-	0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20,
-	0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c,
-	0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
-	0x44, 0x10, 0x03, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x1a,
-	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x6a, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
-	0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x43,
-	0x4f, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10,
-	0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x41, 0x42, 0x49,
-	0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41,
-	0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x41, 0x42, 0x49, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e,
-	0x00, 0x42, 0xb9, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61,
-	0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x61,
-	0x6e, 0x64, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x42,
-	0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a,
-	0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61, 0x6e,
-	0x64, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x1a, 0x1a, 0x8a, 0x9d,
+	0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f,
+	0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55,
+	0x52, 0x45, 0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45,
+	0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x1a, 0x8a, 0x9d,
+	0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x6a,
+	0x0a, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43,
+	0x4f, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x52,
+	0x4f, 0x54, 0x4f, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52,
+	0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x41, 0x42, 0x49, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x45,
+	0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x41,
+	0x42, 0x49, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xb9, 0x01, 0x0a, 0x12, 0x63,
+	0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e,
+	0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -14529,50 +20172,62 @@ func file_band_oracle_v1_oracle_proto_rawDescGZIP() []byte {
 }
 
 var file_band_oracle_v1_oracle_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_band_oracle_v1_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_band_oracle_v1_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_band_oracle_v1_oracle_proto_goTypes = []interface{}{
-	(ResolveStatus)(0),                         // 0: band.oracle.v1.ResolveStatus
-	(Encoder)(0),                               // 1: band.oracle.v1.Encoder
-	(*DataSource)(nil),                         // 2: band.oracle.v1.DataSource
-	(*OracleScript)(nil),                       // 3: band.oracle.v1.OracleScript
-	(*RawRequest)(nil),                         // 4: band.oracle.v1.RawRequest
-	(*RawReport)(nil),                          // 5: band.oracle.v1.RawReport
-	(*Request)(nil),                            // 6: band.oracle.v1.Request
-	(*Report)(nil),                             // 7: band.oracle.v1.Report
-	(*OracleRequestPacketData)(nil),            // 8: band.oracle.v1.OracleRequestPacketData
-	(*OracleRequestPacketAcknowledgement)(nil), // 9: band.oracle.v1.OracleRequestPacketAcknowledgement
-	(*OracleResponsePacketData)(nil),           // 10: band.oracle.v1.OracleResponsePacketData
-	(*Result)(nil),                             // 11: band.oracle.v1.Result
-	(*SigningResult)(nil),                      // 12: band.oracle.v1.SigningResult
-	(*ValidatorStatus)(nil),                    // 13: band.oracle.v1.ValidatorStatus
-	(*ActiveValidator)(nil),                    // 14: band.oracle.v1.ActiveValidator
-	(*Params)(nil),                             // 15: band.oracle.v1.Params
-	(*PendingResolveList)(nil),                 // 16: band.oracle.v1.PendingResolveList
-	(*IBCChannel)(nil),                         // 17: band.oracle.v1.IBCChannel
-	(*RequestVerification)(nil),                // 18: band.oracle.v1.RequestVerification
-	(*PriceResult)(nil),                        // 19: band.oracle.v1.PriceResult
-	(*OracleResultSignatureOrder)(nil),         // 20: band.oracle.v1.OracleResultSignatureOrder
-	(*v1beta1.Coin)(nil),                       // 21: cosmos.base.v1beta1.Coin
-	(*timestamppb.Timestamp)(nil),              // 22: google.protobuf.Timestamp
+	(ResolveStatus)(0),                           // 0: band.oracle.v1.ResolveStatus
+	(Encoder)(0),                                 // 1: band.oracle.v1.Encoder
+	(*DataSource)(nil),                           // 2: band.oracle.v1.DataSource
+	(*OracleScript)(nil),                         // 3: band.oracle.v1.OracleScript
+	(*RawRequest)(nil),                           // 4: band.oracle.v1.RawRequest
+	(*RawReport)(nil),                            // 5: band.oracle.v1.RawReport
+	(*Request)(nil),                              // 6: band.oracle.v1.Request
+	(*RawRequestFee)(nil),                        // 7: band.oracle.v1.RawRequestFee
+	(*FeeEscrow)(nil),                            // 8: band.oracle.v1.FeeEscrow
+	(*RecurringRequest)(nil),                     // 9: band.oracle.v1.RecurringRequest
+	(*Report)(nil),                               // 10: band.oracle.v1.Report
+	(*OracleRequestPacketData)(nil),              // 11: band.oracle.v1.OracleRequestPacketData
+	(*OracleRequestPacketAcknowledgement)(nil),   // 12: band.oracle.v1.OracleRequestPacketAcknowledgement
+	(*OracleRequestPacketAcknowledgementV2)(nil), // 13: band.oracle.v1.OracleRequestPacketAcknowledgementV2
+	(*OracleResponsePacketData)(nil),             // 14: band.oracle.v1.OracleResponsePacketData
+	(*Result)(nil),                               // 15: band.oracle.v1.Result
+	(*SigningResult)(nil),                        // 16: band.oracle.v1.SigningResult
+	(*ValidatorStatus)(nil),                      // 17: band.oracle.v1.ValidatorStatus
+	(*ActiveValidator)(nil),                      // 18: band.oracle.v1.ActiveValidator
+	(*Params)(nil),                               // 19: band.oracle.v1.Params
+	(*IBCRequestCounter)(nil),                    // 20: band.oracle.v1.IBCRequestCounter
+	(*ValidatorReliability)(nil),                 // 21: band.oracle.v1.ValidatorReliability
+	(*PendingResolveList)(nil),                   // 22: band.oracle.v1.PendingResolveList
+	(*IBCChannel)(nil),                           // 23: band.oracle.v1.IBCChannel
+	(*RequestVerification)(nil),                  // 24: band.oracle.v1.RequestVerification
+	(*PriceResult)(nil),                          // 25: band.oracle.v1.PriceResult
+	(*OracleResultSignatureOrder)(nil),           // 26: band.oracle.v1.OracleResultSignatureOrder
+	(*v1beta1.Coin)(nil),                         // 27: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil),                // 28: google.protobuf.Timestamp
 }
 var file_band_oracle_v1_oracle_proto_depIdxs = []int32{
-	21, // 0: band.oracle.v1.DataSource.fee:type_name -> cosmos.base.v1beta1.Coin
+	27, // 0: band.oracle.v1.DataSource.fee:type_name -> cosmos.base.v1beta1.Coin
 	4,  // 1: band.oracle.v1.Request.raw_requests:type_name -> band.oracle.v1.RawRequest
-	17, // 2: band.oracle.v1.Request.ibc_channel:type_name -> band.oracle.v1.IBCChannel
+	23, // 2: band.oracle.v1.Request.ibc_channel:type_name -> band.oracle.v1.IBCChannel
 	1,  // 3: band.oracle.v1.Request.tss_encoder:type_name -> band.oracle.v1.Encoder
-	21, // 4: band.oracle.v1.Request.fee_limit:type_name -> cosmos.base.v1beta1.Coin
-	5,  // 5: band.oracle.v1.Report.raw_reports:type_name -> band.oracle.v1.RawReport
-	21, // 6: band.oracle.v1.OracleRequestPacketData.fee_limit:type_name -> cosmos.base.v1beta1.Coin
-	1,  // 7: band.oracle.v1.OracleRequestPacketData.tss_encoder:type_name -> band.oracle.v1.Encoder
-	0,  // 8: band.oracle.v1.OracleResponsePacketData.resolve_status:type_name -> band.oracle.v1.ResolveStatus
-	0,  // 9: band.oracle.v1.Result.resolve_status:type_name -> band.oracle.v1.ResolveStatus
-	22, // 10: band.oracle.v1.ValidatorStatus.since:type_name -> google.protobuf.Timestamp
-	1,  // 11: band.oracle.v1.OracleResultSignatureOrder.encoder:type_name -> band.oracle.v1.Encoder
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	27, // 4: band.oracle.v1.Request.fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	27, // 5: band.oracle.v1.RawRequestFee.fee:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 6: band.oracle.v1.FeeEscrow.raw_request_fees:type_name -> band.oracle.v1.RawRequestFee
+	27, // 7: band.oracle.v1.FeeEscrow.remaining:type_name -> cosmos.base.v1beta1.Coin
+	27, // 8: band.oracle.v1.RecurringRequest.fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 9: band.oracle.v1.RecurringRequest.tss_encoder:type_name -> band.oracle.v1.Encoder
+	5,  // 10: band.oracle.v1.Report.raw_reports:type_name -> band.oracle.v1.RawReport
+	27, // 11: band.oracle.v1.OracleRequestPacketData.fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 12: band.oracle.v1.OracleRequestPacketData.tss_encoder:type_name -> band.oracle.v1.Encoder
+	0,  // 13: band.oracle.v1.OracleResponsePacketData.resolve_status:type_name -> band.oracle.v1.ResolveStatus
+	0,  // 14: band.oracle.v1.Result.resolve_status:type_name -> band.oracle.v1.ResolveStatus
+	28, // 15: band.oracle.v1.ValidatorStatus.since:type_name -> google.protobuf.Timestamp
+	27, // 16: band.oracle.v1.Params.recurring_request_spawn_fee:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 17: band.oracle.v1.OracleResultSignatureOrder.encoder:type_name -> band.oracle.v1.Encoder
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_band_oracle_v1_oracle_proto_init() }
@@ -14642,7 +20297,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Report); i {
+			switch v := v.(*RawRequestFee); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14654,7 +20309,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OracleRequestPacketData); i {
+			switch v := v.(*FeeEscrow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14666,7 +20321,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OracleRequestPacketAcknowledgement); i {
+			switch v := v.(*RecurringRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14678,7 +20333,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OracleResponsePacketData); i {
+			switch v := v.(*Report); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14690,7 +20345,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Result); i {
+			switch v := v.(*OracleRequestPacketData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14702,7 +20357,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SigningResult); i {
+			switch v := v.(*OracleRequestPacketAcknowledgement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14714,7 +20369,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorStatus); i {
+			switch v := v.(*OracleRequestPacketAcknowledgementV2); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14726,7 +20381,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActiveValidator); i {
+			switch v := v.(*OracleResponsePacketData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14738,7 +20393,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Params); i {
+			switch v := v.(*Result); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14750,7 +20405,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PendingResolveList); i {
+			switch v := v.(*SigningResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14762,7 +20417,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IBCChannel); i {
+			switch v := v.(*ValidatorStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14774,7 +20429,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestVerification); i {
+			switch v := v.(*ActiveValidator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14786,7 +20441,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PriceResult); i {
+			switch v := v.(*Params); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14798,6 +20453,78 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IBCRequestCounter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorReliability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingResolveList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IBCChannel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestVerification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OracleResultSignatureOrder); i {
 			case 0:
 				return &v.state
@@ -14816,7 +20543,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_oracle_v1_oracle_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   19,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,20 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Counts_FullMethodName              = "/band.oracle.v1.Query/Counts"
-	Query_Data_FullMethodName                = "/band.oracle.v1.Query/Data"
-	Query_DataSource_FullMethodName          = "/band.oracle.v1.Query/DataSource"
-	Query_OracleScript_FullMethodName        = "/band.oracle.v1.Query/OracleScript"
-	Query_Request_FullMethodName             = "/band.oracle.v1.Query/Request"
-	Query_PendingRequests_FullMethodName     = "/band.oracle.v1.Query/PendingRequests"
-	Query_Validator_FullMethodName           = "/band.oracle.v1.Query/Validator"
-	Query_IsReporter_FullMethodName          = "/band.oracle.v1.Query/IsReporter"
-	Query_Reporters_FullMethodName           = "/band.oracle.v1.Query/Reporters"
-	Query_ActiveValidators_FullMethodName    = "/band.oracle.v1.Query/ActiveValidators"
-	Query_Params_FullMethodName              = "/band.oracle.v1.Query/Params"
-	Query_RequestSearch_FullMethodName       = "/band.oracle.v1.Query/RequestSearch"
-	Query_RequestPrice_FullMethodName        = "/band.oracle.v1.Query/RequestPrice"
-	Query_RequestVerification_FullMethodName = "/band.oracle.v1.Query/RequestVerification"
+	Query_Counts_FullMethodName                 = "/band.oracle.v1.Query/Counts"
+	Query_Data_FullMethodName                   = "/band.oracle.v1.Query/Data"
+	Query_DataSource_FullMethodName             = "/band.oracle.v1.Query/DataSource"
+	Query_OracleScript_FullMethodName           = "/band.oracle.v1.Query/OracleScript"
+	Query_Request_FullMethodName                = "/band.oracle.v1.Query/Request"
+	Query_PendingRequests_FullMethodName        = "/band.oracle.v1.Query/PendingRequests"
+	Query_Validator_FullMethodName              = "/band.oracle.v1.Query/Validator"
+	Query_IsReporter_FullMethodName             = "/band.oracle.v1.Query/IsReporter"
+	Query_Reporters_FullMethodName              = "/band.oracle.v1.Query/Reporters"
+	Query_ActiveValidators_FullMethodName       = "/band.oracle.v1.Query/ActiveValidators"
+	Query_Params_FullMethodName                 = "/band.oracle.v1.Query/Params"
+	Query_RequestSearch_FullMethodName          = "/band.oracle.v1.Query/RequestSearch"
+	Query_RequestPrice_FullMethodName           = "/band.oracle.v1.Query/RequestPrice"
+	Query_RequestVerification_FullMethodName    = "/band.oracle.v1.Query/RequestVerification"
+	Query_RecurringRequest_FullMethodName       = "/band.oracle.v1.Query/RecurringRequest"
+	Query_RecurringRequests_FullMethodName      = "/band.oracle.v1.Query/RecurringRequests"
+	Query_RecurringRequestSpawns_FullMethodName = "/band.oracle.v1.Query/RecurringRequestSpawns"
+	Query_ValidatorReliability_FullMethodName   = "/band.oracle.v1.Query/ValidatorReliability"
+	Query_FeeEscrow_FullMethodName              = "/band.oracle.v1.Query/FeeEscrow"
+	Query_PrunedResults_FullMethodName          = "/band.oracle.v1.Query/PrunedResults"
+	Query_ChannelPrepaidBalance_FullMethodName  = "/band.oracle.v1.Query/ChannelPrepaidBalance"
 )
 
 // QueryClient is the client API for Query service.
@@ -72,6 +79,25 @@ type QueryClient interface {
 	// RequestVerification verifies a request to make sure that
 	// all information that will be used to report the data is valid
 	RequestVerification(ctx context.Context, in *QueryRequestVerificationRequest, opts ...grpc.CallOption) (*QueryRequestVerificationResponse, error)
+	// RecurringRequest queries the recurring request for given recurring request
+	// id along with its remaining deposit.
+	RecurringRequest(ctx context.Context, in *QueryRecurringRequestRequest, opts ...grpc.CallOption) (*QueryRecurringRequestResponse, error)
+	// RecurringRequests queries all recurring requests.
+	RecurringRequests(ctx context.Context, in *QueryRecurringRequestsRequest, opts ...grpc.CallOption) (*QueryRecurringRequestsResponse, error)
+	// RecurringRequestSpawns queries the IDs of unexpired oracle requests spawned
+	// by the given recurring request.
+	RecurringRequestSpawns(ctx context.Context, in *QueryRecurringRequestSpawnsRequest, opts ...grpc.CallOption) (*QueryRecurringRequestSpawnsResponse, error)
+	// ValidatorReliability queries the oracle reliability of a validator and its
+	// resulting sampling weight.
+	ValidatorReliability(ctx context.Context, in *QueryValidatorReliabilityRequest, opts ...grpc.CallOption) (*QueryValidatorReliabilityResponse, error)
+	// FeeEscrow queries the data source fees escrowed for given request id.
+	FeeEscrow(ctx context.Context, in *QueryFeeEscrowRequest, opts ...grpc.CallOption) (*QueryFeeEscrowResponse, error)
+	// PrunedResults queries the range of request results that have been pruned
+	// from state by the result retention policy.
+	PrunedResults(ctx context.Context, in *QueryPrunedResultsRequest, opts ...grpc.CallOption) (*QueryPrunedResultsResponse, error)
+	// ChannelPrepaidBalance queries the prepaid fee account of an oracle IBC
+	// channel and its balance.
+	ChannelPrepaidBalance(ctx context.Context, in *QueryChannelPrepaidBalanceRequest, opts ...grpc.CallOption) (*QueryChannelPrepaidBalanceResponse, error)
 }
 
 type queryClient struct {
@@ -208,6 +234,69 @@ func (c *queryClient) RequestVerification(ctx context.Context, in *QueryRequestV
 	return out, nil
 }
 
+func (c *queryClient) RecurringRequest(ctx context.Context, in *QueryRecurringRequestRequest, opts ...grpc.CallOption) (*QueryRecurringRequestResponse, error) {
+	out := new(QueryRecurringRequestResponse)
+	err := c.cc.Invoke(ctx, Query_RecurringRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecurringRequests(ctx context.Context, in *QueryRecurringRequestsRequest, opts ...grpc.CallOption) (*QueryRecurringRequestsResponse, error) {
+	out := new(QueryRecurringRequestsResponse)
+	err := c.cc.Invoke(ctx, Query_RecurringRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecurringRequestSpawns(ctx context.Context, in *QueryRecurringRequestSpawnsRequest, opts ...grpc.CallOption) (*QueryRecurringRequestSpawnsResponse, error) {
+	out := new(QueryRecurringRequestSpawnsResponse)
+	err := c.cc.Invoke(ctx, Query_RecurringRequestSpawns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorReliability(ctx context.Context, in *QueryValidatorReliabilityRequest, opts ...grpc.CallOption) (*QueryValidatorReliabilityResponse, error) {
+	out := new(QueryValidatorReliabilityResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorReliability_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeEscrow(ctx context.Context, in *QueryFeeEscrowRequest, opts ...grpc.CallOption) (*QueryFeeEscrowResponse, error) {
+	out := new(QueryFeeEscrowResponse)
+	err := c.cc.Invoke(ctx, Query_FeeEscrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PrunedResults(ctx context.Context, in *QueryPrunedResultsRequest, opts ...grpc.CallOption) (*QueryPrunedResultsResponse, error) {
+	out := new(QueryPrunedResultsResponse)
+	err := c.cc.Invoke(ctx, Query_PrunedResults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelPrepaidBalance(ctx context.Context, in *QueryChannelPrepaidBalanceRequest, opts ...grpc.CallOption) (*QueryChannelPrepaidBalanceResponse, error) {
+	out := new(QueryChannelPrepaidBalanceResponse)
+	err := c.cc.Invoke(ctx, Query_ChannelPrepaidBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -245,6 +334,25 @@ type QueryServer interface {
 	// RequestVerification verifies a request to make sure that
 	// all information that will be used to report the data is valid
 	RequestVerification(context.Context, *QueryRequestVerificationRequest) (*QueryRequestVerificationResponse, error)
+	// RecurringRequest queries the recurring request for given recurring request
+	// id along with its remaining deposit.
+	RecurringRequest(context.Context, *QueryRecurringRequestRequest) (*QueryRecurringRequestResponse, error)
+	// RecurringRequests queries all recurring requests.
+	RecurringRequests(context.Context, *QueryRecurringRequestsRequest) (*QueryRecurringRequestsResponse, error)
+	// RecurringRequestSpawns queries the IDs of unexpired oracle requests spawned
+	// by the given recurring request.
+	RecurringRequestSpawns(context.Context, *QueryRecurringRequestSpawnsRequest) (*QueryRecurringRequestSpawnsResponse, error)
+	// ValidatorReliability queries the oracle reliability of a validator and its
+	// resulting sampling weight.
+	ValidatorReliability(context.Context, *QueryValidatorReliabilityRequest) (*QueryValidatorReliabilityResponse, error)
+	// FeeEscrow queries the data source fees escrowed for given request id.
+	FeeEscrow(context.Context, *QueryFeeEscrowRequest) (*QueryFeeEscrowResponse, error)
+	// PrunedResults queries the range of request results that have been pruned
+	// from state by the result retention policy.
+	PrunedResults(context.Context, *QueryPrunedResultsRequest) (*QueryPrunedResultsResponse, error)
+	// ChannelPrepaidBalance queries the prepaid fee account of an oracle IBC
+	// channel and its balance.
+	ChannelPrepaidBalance(context.Context, *QueryChannelPrepaidBalanceRequest) (*QueryChannelPrepaidBalanceResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -294,6 +402,27 @@ func (UnimplementedQueryServer) RequestPrice(context.Context, *QueryRequestPrice
 func (UnimplementedQueryServer) RequestVerification(context.Context, *QueryRequestVerificationRequest) (*QueryRequestVerificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestVerification not implemented")
 }
+func (UnimplementedQueryServer) RecurringRequest(context.Context, *QueryRecurringRequestRequest) (*QueryRecurringRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecurringRequest not implemented")
+}
+func (UnimplementedQueryServer) RecurringRequests(context.Context, *QueryRecurringRequestsRequest) (*QueryRecurringRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecurringRequests not implemented")
+}
+func (UnimplementedQueryServer) RecurringRequestSpawns(context.Context, *QueryRecurringRequestSpawnsRequest) (*QueryRecurringRequestSpawnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecurringRequestSpawns not implemented")
+}
+func (UnimplementedQueryServer) ValidatorReliability(context.Context, *QueryValidatorReliabilityRequest) (*QueryValidatorReliabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorReliability not implemented")
+}
+func (UnimplementedQueryServer) FeeEscrow(context.Context, *QueryFeeEscrowRequest) (*QueryFeeEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeEscrow not implemented")
+}
+func (UnimplementedQueryServer) PrunedResults(context.Context, *QueryPrunedResultsRequest) (*QueryPrunedResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrunedResults not implemented")
+}
+func (UnimplementedQueryServer) ChannelPrepaidBalance(context.Context, *QueryChannelPrepaidBalanceRequest) (*QueryChannelPrepaidBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelPrepaidBalance not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -559,6 +688,132 @@ func _Query_RequestVerification_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RecurringRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecurringRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecurringRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RecurringRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecurringRequest(ctx, req.(*QueryRecurringRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecurringRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecurringRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecurringRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RecurringRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecurringRequests(ctx, req.(*QueryRecurringRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecurringRequestSpawns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecurringRequestSpawnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecurringRequestSpawns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RecurringRequestSpawns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecurringRequestSpawns(ctx, req.(*QueryRecurringRequestSpawnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorReliability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorReliabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorReliability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorReliability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorReliability(ctx, req.(*QueryValidatorReliabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeEscrow(ctx, req.(*QueryFeeEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PrunedResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPrunedResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PrunedResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PrunedResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PrunedResults(ctx, req.(*QueryPrunedResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelPrepaidBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelPrepaidBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelPrepaidBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ChannelPrepaidBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelPrepaidBalance(ctx, req.(*QueryChannelPrepaidBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -622,6 +877,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestVerification",
 			Handler:    _Query_RequestVerification_Handler,
 		},
+		{
+			MethodName: "RecurringRequest",
+			Handler:    _Query_RecurringRequest_Handler,
+		},
+		{
+			MethodName: "RecurringRequests",
+			Handler:    _Query_RecurringRequests_Handler,
+		},
+		{
+			MethodName: "RecurringRequestSpawns",
+			Handler:    _Query_RecurringRequestSpawns_Handler,
+		},
+		{
+			MethodName: "ValidatorReliability",
+			Handler:    _Query_ValidatorReliability_Handler,
+		},
+		{
+			MethodName: "FeeEscrow",
+			Handler:    _Query_FeeEscrow_Handler,
+		},
+		{
+			MethodName: "PrunedResults",
+			Handler:    _Query_PrunedResults_Handler,
+		},
+		{
+			MethodName: "ChannelPrepaidBalance",
+			Handler:    _Query_ChannelPrepaidBalance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/oracle/v1/query.proto",
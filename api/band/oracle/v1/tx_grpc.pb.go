@@ -19,14 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_RequestData_FullMethodName        = "/band.oracle.v1.Msg/RequestData"
-	Msg_ReportData_FullMethodName         = "/band.oracle.v1.Msg/ReportData"
-	Msg_CreateDataSource_FullMethodName   = "/band.oracle.v1.Msg/CreateDataSource"
-	Msg_EditDataSource_FullMethodName     = "/band.oracle.v1.Msg/EditDataSource"
-	Msg_CreateOracleScript_FullMethodName = "/band.oracle.v1.Msg/CreateOracleScript"
-	Msg_EditOracleScript_FullMethodName   = "/band.oracle.v1.Msg/EditOracleScript"
-	Msg_Activate_FullMethodName           = "/band.oracle.v1.Msg/Activate"
-	Msg_UpdateParams_FullMethodName       = "/band.oracle.v1.Msg/UpdateParams"
+	Msg_RequestData_FullMethodName               = "/band.oracle.v1.Msg/RequestData"
+	Msg_ReportData_FullMethodName                = "/band.oracle.v1.Msg/ReportData"
+	Msg_CreateDataSource_FullMethodName          = "/band.oracle.v1.Msg/CreateDataSource"
+	Msg_EditDataSource_FullMethodName            = "/band.oracle.v1.Msg/EditDataSource"
+	Msg_CreateOracleScript_FullMethodName        = "/band.oracle.v1.Msg/CreateOracleScript"
+	Msg_EditOracleScript_FullMethodName          = "/band.oracle.v1.Msg/EditOracleScript"
+	Msg_Activate_FullMethodName                  = "/band.oracle.v1.Msg/Activate"
+	Msg_CreateRecurringRequest_FullMethodName    = "/band.oracle.v1.Msg/CreateRecurringRequest"
+	Msg_UpdateRecurringRequest_FullMethodName    = "/band.oracle.v1.Msg/UpdateRecurringRequest"
+	Msg_CancelRecurringRequest_FullMethodName    = "/band.oracle.v1.Msg/CancelRecurringRequest"
+	Msg_UpdateParams_FullMethodName              = "/band.oracle.v1.Msg/UpdateParams"
+	Msg_WithdrawChannelPrepaidFee_FullMethodName = "/band.oracle.v1.Msg/WithdrawChannelPrepaidFee"
 )
 
 // MsgClient is the client API for Msg service.
@@ -47,11 +51,23 @@ type MsgClient interface {
 	EditOracleScript(ctx context.Context, in *MsgEditOracleScript, opts ...grpc.CallOption) (*MsgEditOracleScriptResponse, error)
 	// Activate defines a method for applying to be an oracle validator.
 	Activate(ctx context.Context, in *MsgActivate, opts ...grpc.CallOption) (*MsgActivateResponse, error)
+	// CreateRecurringRequest defines a method for creating a request schedule
+	// that spawns an oracle request every given number of blocks.
+	CreateRecurringRequest(ctx context.Context, in *MsgCreateRecurringRequest, opts ...grpc.CallOption) (*MsgCreateRecurringRequestResponse, error)
+	// UpdateRecurringRequest defines a method for updating and topping up an
+	// existing recurring request.
+	UpdateRecurringRequest(ctx context.Context, in *MsgUpdateRecurringRequest, opts ...grpc.CallOption) (*MsgUpdateRecurringRequestResponse, error)
+	// CancelRecurringRequest defines a method for cancelling a recurring request
+	// and refunding its remaining deposit.
+	CancelRecurringRequest(ctx context.Context, in *MsgCancelRecurringRequest, opts ...grpc.CallOption) (*MsgCancelRecurringRequestResponse, error)
 	// UpdateParams defines a governance operation for updating the x/oracle module
 	// parameters.
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// WithdrawChannelPrepaidFee defines a governance operation for withdrawing
+	// from the prepaid fee account of an oracle IBC channel.
+	WithdrawChannelPrepaidFee(ctx context.Context, in *MsgWithdrawChannelPrepaidFee, opts ...grpc.CallOption) (*MsgWithdrawChannelPrepaidFeeResponse, error)
 }
 
 type msgClient struct {
@@ -125,6 +141,33 @@ func (c *msgClient) Activate(ctx context.Context, in *MsgActivate, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) CreateRecurringRequest(ctx context.Context, in *MsgCreateRecurringRequest, opts ...grpc.CallOption) (*MsgCreateRecurringRequestResponse, error) {
+	out := new(MsgCreateRecurringRequestResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateRecurringRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateRecurringRequest(ctx context.Context, in *MsgUpdateRecurringRequest, opts ...grpc.CallOption) (*MsgUpdateRecurringRequestResponse, error) {
+	out := new(MsgUpdateRecurringRequestResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateRecurringRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRecurringRequest(ctx context.Context, in *MsgCancelRecurringRequest, opts ...grpc.CallOption) (*MsgCancelRecurringRequestResponse, error) {
+	out := new(MsgCancelRecurringRequestResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelRecurringRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -134,6 +177,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) WithdrawChannelPrepaidFee(ctx context.Context, in *MsgWithdrawChannelPrepaidFee, opts ...grpc.CallOption) (*MsgWithdrawChannelPrepaidFeeResponse, error) {
+	out := new(MsgWithdrawChannelPrepaidFeeResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawChannelPrepaidFee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -152,11 +204,23 @@ type MsgServer interface {
 	EditOracleScript(context.Context, *MsgEditOracleScript) (*MsgEditOracleScriptResponse, error)
 	// Activate defines a method for applying to be an oracle validator.
 	Activate(context.Context, *MsgActivate) (*MsgActivateResponse, error)
+	// CreateRecurringRequest defines a method for creating a request schedule
+	// that spawns an oracle request every given number of blocks.
+	CreateRecurringRequest(context.Context, *MsgCreateRecurringRequest) (*MsgCreateRecurringRequestResponse, error)
+	// UpdateRecurringRequest defines a method for updating and topping up an
+	// existing recurring request.
+	UpdateRecurringRequest(context.Context, *MsgUpdateRecurringRequest) (*MsgUpdateRecurringRequestResponse, error)
+	// CancelRecurringRequest defines a method for cancelling a recurring request
+	// and refunding its remaining deposit.
+	CancelRecurringRequest(context.Context, *MsgCancelRecurringRequest) (*MsgCancelRecurringRequestResponse, error)
 	// UpdateParams defines a governance operation for updating the x/oracle module
 	// parameters.
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// WithdrawChannelPrepaidFee defines a governance operation for withdrawing
+	// from the prepaid fee account of an oracle IBC channel.
+	WithdrawChannelPrepaidFee(context.Context, *MsgWithdrawChannelPrepaidFee) (*MsgWithdrawChannelPrepaidFeeResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -185,9 +249,21 @@ func (UnimplementedMsgServer) EditOracleScript(context.Context, *MsgEditOracleSc
 func (UnimplementedMsgServer) Activate(context.Context, *MsgActivate) (*MsgActivateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Activate not implemented")
 }
+func (UnimplementedMsgServer) CreateRecurringRequest(context.Context, *MsgCreateRecurringRequest) (*MsgCreateRecurringRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringRequest not implemented")
+}
+func (UnimplementedMsgServer) UpdateRecurringRequest(context.Context, *MsgUpdateRecurringRequest) (*MsgUpdateRecurringRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecurringRequest not implemented")
+}
+func (UnimplementedMsgServer) CancelRecurringRequest(context.Context, *MsgCancelRecurringRequest) (*MsgCancelRecurringRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRecurringRequest not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) WithdrawChannelPrepaidFee(context.Context, *MsgWithdrawChannelPrepaidFee) (*MsgWithdrawChannelPrepaidFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawChannelPrepaidFee not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -327,6 +403,60 @@ func _Msg_Activate_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateRecurringRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRecurringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRecurringRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateRecurringRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRecurringRequest(ctx, req.(*MsgCreateRecurringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateRecurringRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRecurringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRecurringRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateRecurringRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRecurringRequest(ctx, req.(*MsgUpdateRecurringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRecurringRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRecurringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRecurringRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelRecurringRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRecurringRequest(ctx, req.(*MsgCancelRecurringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -345,6 +475,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawChannelPrepaidFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawChannelPrepaidFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawChannelPrepaidFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawChannelPrepaidFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawChannelPrepaidFee(ctx, req.(*MsgWithdrawChannelPrepaidFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -380,10 +528,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Activate",
 			Handler:    _Msg_Activate_Handler,
 		},
+		{
+			MethodName: "CreateRecurringRequest",
+			Handler:    _Msg_CreateRecurringRequest_Handler,
+		},
+		{
+			MethodName: "UpdateRecurringRequest",
+			Handler:    _Msg_UpdateRecurringRequest_Handler,
+		},
+		{
+			MethodName: "CancelRecurringRequest",
+			Handler:    _Msg_CancelRecurringRequest_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "WithdrawChannelPrepaidFee",
+			Handler:    _Msg_WithdrawChannelPrepaidFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/oracle/v1/tx.proto",
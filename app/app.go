@@ -1,6 +1,7 @@
 package band
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -21,6 +22,7 @@ import (
 	"cosmossdk.io/client/v2/autocli"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/tx/signing"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
@@ -55,8 +57,12 @@ import (
 	"github.com/bandprotocol/chain/v3/app/keepers"
 	"github.com/bandprotocol/chain/v3/app/upgrades"
 	v3 "github.com/bandprotocol/chain/v3/app/upgrades/v3"
+	"github.com/bandprotocol/chain/v3/app/upgrades/v3_1"
 	nodeservice "github.com/bandprotocol/chain/v3/client/grpc/node"
 	proofservice "github.com/bandprotocol/chain/v3/client/grpc/oracle/proof"
+	"github.com/bandprotocol/chain/v3/x/globalfee/feechecker"
+	globalfeetypes "github.com/bandprotocol/chain/v3/x/globalfee/types"
+	oracleindex "github.com/bandprotocol/chain/v3/x/oracle/index"
 	oraclekeeper "github.com/bandprotocol/chain/v3/x/oracle/keeper"
 )
 
@@ -64,7 +70,7 @@ var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
 
-	Upgrades = []upgrades.Upgrade{v3.Upgrade}
+	Upgrades = []upgrades.Upgrade{v3.Upgrade, v3_1.Upgrade}
 )
 
 var (
@@ -92,6 +98,9 @@ type BandApp struct {
 	// simulation manager
 	sm           *module.SimulationManager
 	configurator module.Configurator
+
+	// node-local index of resolved oracle requests, nil if it isn't enabled
+	oracleIndex *oracleindex.Index
 }
 
 func init() {
@@ -179,6 +188,20 @@ func NewBandApp(
 		owasmCacheSize,
 	)
 
+	// Set up the node-local oracle request index if it is enabled in the app config.
+	// NOTE: the index must be set before the oracle keeper is copied into the oracle module.
+	if oracleIndexCfg := oracleindex.ConfigFromAppOptions(appOpts); oracleIndexCfg.Enable {
+		app.oracleIndex, err = oracleindex.Open(homePath, server.GetAppDBBackend(appOpts), oracleIndexCfg)
+		if err != nil {
+			panic(err)
+		}
+
+		app.OracleKeeper.SetRequestIndex(app.oracleIndex)
+		app.SetStreamingManager(storetypes.StreamingManager{
+			ABCIListeners: []storetypes.ABCIListener{oracleindex.NewListener(app.oracleIndex)},
+		})
+	}
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(appModules(app, appCodec, txConfig, skipGenesisInvariants)...)
@@ -258,6 +281,19 @@ func NewBandApp(
 	app.MountTransientStores(app.GetTransientStoreKey())
 	app.MountMemoryStores(app.GetMemoryStoreKey())
 
+	// the fee checker is shared by the ante handler, which checks the fee of txs, and the post handler, which
+	// refunds the fee of their unused gas.
+	feeChecker := feechecker.NewFeeChecker(
+		app.appCodec,
+		&app.AuthzKeeper,
+		&app.OracleKeeper,
+		&app.GlobalFeeKeeper,
+		app.StakingKeeper,
+		app.TSSKeeper,
+		&app.BandtssKeeper,
+		&app.FeedsKeeper,
+	)
+
 	anteHandler, err := NewAnteHandler(
 		HandlerOptions{
 			HandlerOptions: ante.HandlerOptions{
@@ -266,6 +302,7 @@ func NewBandApp(
 				SignModeHandler: txConfig.SignModeHandler(),
 				FeegrantKeeper:  app.FeeGrantKeeper,
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+				TxFeeChecker:    feeChecker.CheckTxFee,
 			},
 			Cdc:             app.appCodec,
 			AuthzKeeper:     &app.AuthzKeeper,
@@ -283,7 +320,11 @@ func NewBandApp(
 	}
 
 	postHandler, err := NewPostHandler(
-		PostHandlerOptions{},
+		PostHandlerOptions{
+			BankKeeper:             app.BankKeeper,
+			GlobalfeeKeeper:        &app.GlobalFeeKeeper,
+			GetTxRequiredGasPrices: feeChecker.GetTxRequiredGasPrices,
+		},
 	)
 	if err != nil {
 		panic(fmt.Errorf("failed to create post handler: %s", err))
@@ -333,6 +374,16 @@ func NewBandApp(
 	return app
 }
 
+// Close closes the application and the oracle request index.
+func (app *BandApp) Close() error {
+	err := app.BaseApp.Close()
+	if app.oracleIndex != nil {
+		return errors.Join(err, app.oracleIndex.Close())
+	}
+
+	return err
+}
+
 // Name returns the name of the App.
 func (app *BandApp) Name() string { return app.BaseApp.Name() }
 
@@ -388,6 +439,8 @@ func (app *BandApp) ModuleAccountAddrs() map[string]bool {
 func (app *BandApp) BlockedModuleAccountAddrs(modAccAddrs map[string]bool) map[string]bool {
 	// remove module accounts that are ALLOWED to received funds
 	delete(modAccAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	// the refund pool is funded by transfers, e.g. community pool spends
+	delete(modAccAddrs, authtypes.NewModuleAddress(globalfeetypes.RefundPoolName).String())
 
 	return modAccAddrs
 }
@@ -4,6 +4,8 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/spf13/cast"
+
 	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	ica "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts"
@@ -66,6 +68,7 @@ import (
 
 	owasm "github.com/bandprotocol/go-owasm/api"
 
+	"github.com/bandprotocol/chain/v3/pkg/filecache"
 	"github.com/bandprotocol/chain/v3/x/bandtss"
 	bandtsskeeper "github.com/bandprotocol/chain/v3/x/bandtss/keeper"
 	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
@@ -453,10 +456,16 @@ func NewAppKeeper(
 		authtypes.FeeCollectorName,
 	)
 
+	fileCacheSize := filecache.DefaultCacheSizeMax
+	if appOpts.Get(oracle.FlagWithFileCacheSize) != nil {
+		fileCacheSize = cast.ToUint64(appOpts.Get(oracle.FlagWithFileCacheSize))
+	}
+
 	appKeepers.OracleKeeper = oraclekeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[oracletypes.StoreKey],
 		filepath.Join(homePath, "files"),
+		fileCacheSize,
 		authtypes.FeeCollectorName,
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
@@ -465,6 +474,7 @@ func NewAppKeeper(
 		appKeepers.AuthzKeeper,
 		appKeepers.IBCFeeKeeper,
 		appKeepers.IBCKeeper.PortKeeper,
+		appKeepers.IBCKeeper.ChannelKeeper,
 		appKeepers.RollingseedKeeper,
 		appKeepers.BandtssKeeper,
 		appKeepers.ScopedOracleKeeper,
@@ -472,6 +482,15 @@ func NewAppKeeper(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// register the oracle hooks
+	// NOTE: hooks must be set before the oracle keeper is copied by value into other keepers, the oracle
+	// module and the IBC stack
+	appKeepers.OracleKeeper.SetHooks(
+		oracletypes.NewMultiOracleHooks(
+		// insert oracle hooks receivers here
+		),
+	)
+
 	appKeepers.FeedsKeeper = feedskeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[feedstypes.StoreKey],
@@ -520,6 +539,7 @@ func NewAppKeeper(
 	// Create Transfer Stack
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(appKeepers.TransferKeeper)
+	transferStack = oracle.NewPrepayMiddleware(transferStack, appKeepers.OracleKeeper)
 	transferStack = ibcfee.NewIBCMiddleware(transferStack, appKeepers.IBCFeeKeeper)
 
 	// Create ICAHost Stack
@@ -84,6 +84,8 @@ var maccPerms = map[string][]string{
 	bandtsstypes.ModuleName:        nil,
 	restaketypes.ModuleName:        nil,
 	tunneltypes.ModuleName:         nil,
+	oracletypes.ModuleName:         nil,
+	globalfeetypes.RefundPoolName:  nil,
 }
 
 func appModules(
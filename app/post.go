@@ -2,13 +2,38 @@ package band
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	globalfeekeeper "github.com/bandprotocol/chain/v3/x/globalfee/keeper"
+	"github.com/bandprotocol/chain/v3/x/globalfee/posthandler"
+	globalfeetypes "github.com/bandprotocol/chain/v3/x/globalfee/types"
 )
 
 // PostHandlerOptions are the options required for constructing PostHandlers.
-type PostHandlerOptions struct{}
+type PostHandlerOptions struct {
+	BankKeeper             globalfeetypes.BankKeeper
+	GlobalfeeKeeper        *globalfeekeeper.Keeper
+	GetTxRequiredGasPrices posthandler.TxRequiredGasPricesGetter
+}
 
 // NewPostHandler returns a PostHandler chain with decorators.
 func NewPostHandler(options PostHandlerOptions) (sdk.PostHandler, error) {
-	postDecorators := []sdk.PostDecorator{}
+	if options.BankKeeper == nil {
+		return nil, sdkerrors.ErrLogic.Wrap("bank keeper is required for PostHandler")
+	}
+	if options.GlobalfeeKeeper == nil {
+		return nil, sdkerrors.ErrLogic.Wrap("Globalfee keeper is required for PostHandler")
+	}
+	if options.GetTxRequiredGasPrices == nil {
+		return nil, sdkerrors.ErrLogic.Wrap("tx required gas prices getter is required for PostHandler")
+	}
+
+	postDecorators := []sdk.PostDecorator{
+		posthandler.NewRefundDecorator(
+			options.GlobalfeeKeeper,
+			options.BankKeeper,
+			options.GetTxRequiredGasPrices,
+		),
+	}
 	return sdk.ChainPostDecorators(postDecorators...), nil
 }
@@ -123,12 +123,19 @@ func CreateUpgradeHandler(
 		}
 
 		err = keepers.GlobalFeeKeeper.SetParams(ctx, globalfeetypes.Params{
-			MinimumGasPrices: sdk.DecCoins{sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(25, 4))},
+			MinimumGasPrices:      sdk.DecCoins{sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(25, 4))},
+			MsgFeeRules:           globalfeetypes.DefaultMsgFeeRules(),
+			FeeMarket:             globalfeetypes.DefaultFeeMarketParams(),
+			RefundableMsgTypeURLs: globalfeetypes.DefaultRefundableMsgTypeURLs(),
+			MaxRefundableGas:      globalfeetypes.DefaultMaxRefundableGas,
 		})
 		if err != nil {
 			return nil, err
 		}
 
+		// create the refund pool account
+		keepers.AccountKeeper.GetModuleAccount(ctx, globalfeetypes.RefundPoolName)
+
 		return vm, nil
 	}
 }
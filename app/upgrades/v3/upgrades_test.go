@@ -14,10 +14,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	band "github.com/bandprotocol/chain/v3/app"
 	v3 "github.com/bandprotocol/chain/v3/app/upgrades/v3"
 	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	globalfeetypes "github.com/bandprotocol/chain/v3/x/globalfee/types"
 )
 
 type UpgradeTestSuite struct {
@@ -92,6 +94,14 @@ func postUpgradeChecks(s *UpgradeTestSuite) {
 	// check global fee params
 	s.Require().
 		Equal(sdk.DecCoins{sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(25, 4))}, s.app.GlobalFeeKeeper.GetParams(s.ctx).MinimumGasPrices)
+	s.Require().Equal(globalfeetypes.DefaultMsgFeeRules(), s.app.GlobalFeeKeeper.GetParams(s.ctx).MsgFeeRules)
+	s.Require().Equal(globalfeetypes.DefaultFeeMarketParams(), s.app.GlobalFeeKeeper.GetParams(s.ctx).FeeMarket)
+	s.Require().Equal(
+		globalfeetypes.DefaultRefundableMsgTypeURLs(),
+		s.app.GlobalFeeKeeper.GetParams(s.ctx).RefundableMsgTypeURLs,
+	)
+	s.Require().Equal(globalfeetypes.DefaultMaxRefundableGas, s.app.GlobalFeeKeeper.GetParams(s.ctx).MaxRefundableGas)
+	s.Require().NotNil(s.app.AccountKeeper.GetAccount(s.ctx, authtypes.NewModuleAddress(globalfeetypes.RefundPoolName)))
 }
 
 func (s *UpgradeTestSuite) ConfirmUpgradeSucceeded(upgradeName string, upgradeHeight int64) {
@@ -0,0 +1,117 @@
+package v3_1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	band "github.com/bandprotocol/chain/v3/app"
+	"github.com/bandprotocol/chain/v3/app/upgrades/v3_1"
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	globalfeetypes "github.com/bandprotocol/chain/v3/x/globalfee/types"
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+type UpgradeTestSuite struct {
+	suite.Suite
+
+	app *band.BandApp
+	ctx sdk.Context
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+func (s *UpgradeTestSuite) SetupTest() {
+	dir := testutil.GetTempDir(s.T())
+	s.app = bandtesting.SetupWithCustomHome(false, dir)
+	s.ctx = s.app.BaseApp.NewUncachedContext(false, cmtproto.Header{})
+
+	_, err := s.app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: s.app.LastBlockHeight() + 1})
+	s.Require().NoError(err)
+	_, err = s.app.Commit()
+	s.Require().NoError(err)
+}
+
+func (s *UpgradeTestSuite) TestUpgrade() {
+	preUpgradeChecks(s)
+
+	upgradeHeight := int64(2)
+	s.ConfirmUpgradeSucceeded(v3_1.UpgradeName, upgradeHeight)
+
+	postUpgradeChecks(s)
+}
+
+// preUpgradeChecks sets up the state of a chain running v3, whose x/oracle and x/globalfee modules are of the
+// consensus versions 2 and 1.
+func preUpgradeChecks(s *UpgradeTestSuite) {
+	vm, err := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().NoError(err)
+	vm[oracletypes.ModuleName] = 2
+	vm[globalfeetypes.ModuleName] = 1
+	s.Require().NoError(s.app.UpgradeKeeper.SetModuleVersionMap(s.ctx, vm))
+
+	// the oracle params added after v3 are zero
+	oracleParams := oracletypes.DefaultParams()
+	oracleParams.ReliabilityWeightPercentage = 0
+	oracleParams.ReliabilityWindowSize = 0
+	oracleParams.MaxPrunedResultsPerBlock = 0
+	oracleParams.MinRecurringRequestInterval = 0
+	oracleParams.RecurringRequestSpawnFee = nil
+	s.Require().NoError(s.app.OracleKeeper.SetParams(s.ctx, oracleParams))
+
+	// the global fee params of v3 only have the minimum gas prices
+	minGasPrices := s.app.GlobalFeeKeeper.GetParams(s.ctx).MinimumGasPrices
+	s.Require().NoError(s.app.GlobalFeeKeeper.SetParams(s.ctx, globalfeetypes.Params{MinimumGasPrices: minGasPrices}))
+
+	// a pending request of v3 has no fee escrow
+	requester := bandtesting.Alice.Address.String()
+	s.app.OracleKeeper.AddRequest(s.ctx, oracletypes.Request{Requester: requester, RequestHeight: 1})
+	s.Require().False(s.app.OracleKeeper.HasFeeEscrow(s.ctx, 1))
+}
+
+func postUpgradeChecks(s *UpgradeTestSuite) {
+	vm, err := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), vm[oracletypes.ModuleName])
+	s.Require().Equal(uint64(2), vm[globalfeetypes.ModuleName])
+
+	// check oracle params that are added after v3
+	s.Require().Equal(oracletypes.DefaultParams(), s.app.OracleKeeper.GetParams(s.ctx))
+
+	// check the fee escrow of the pending request
+	escrow, err := s.app.OracleKeeper.GetFeeEscrow(s.ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(bandtesting.Alice.Address.String(), escrow.Payer)
+	s.Require().True(escrow.Remaining.IsZero())
+
+	// check global fee params that are added after v3
+	globalfeeParams := s.app.GlobalFeeKeeper.GetParams(s.ctx)
+	s.Require().Equal(globalfeetypes.DefaultMsgFeeRules(), globalfeeParams.MsgFeeRules)
+	s.Require().Equal(globalfeetypes.DefaultFeeMarketParams(), globalfeeParams.FeeMarket)
+	s.Require().Equal(globalfeetypes.DefaultRefundableMsgTypeURLs(), globalfeeParams.RefundableMsgTypeURLs)
+	s.Require().Equal(globalfeetypes.DefaultMaxRefundableGas, globalfeeParams.MaxRefundableGas)
+	s.Require().NotNil(s.app.AccountKeeper.GetAccount(s.ctx, authtypes.NewModuleAddress(globalfeetypes.RefundPoolName)))
+}
+
+func (s *UpgradeTestSuite) ConfirmUpgradeSucceeded(upgradeName string, upgradeHeight int64) {
+	plan := upgradetypes.Plan{Name: upgradeName, Height: upgradeHeight}
+	err := s.app.AppKeepers.UpgradeKeeper.ScheduleUpgrade(s.ctx, plan)
+	s.Require().NoError(err)
+	_, err = s.app.AppKeepers.UpgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().NoError(err)
+
+	s.ctx = s.ctx.WithBlockHeight(upgradeHeight)
+	_, err = s.app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: s.ctx.BlockHeight()})
+	s.Require().NoError(err)
+}
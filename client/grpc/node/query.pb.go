@@ -6,6 +6,8 @@ package node
 import (
 	context "context"
 	fmt "fmt"
+	proof "github.com/bandprotocol/chain/v3/client/grpc/oracle/proof"
+	github_com_cometbft_cometbft_libs_bytes "github.com/cometbft/cometbft/libs/bytes"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -113,6 +115,10 @@ func (m *ChainIDResponse) GetChainID() string {
 
 // EVMValidatorsRequest is request type for the Service/EVMValidators RPC method.
 type EVMValidatorsRequest struct {
+	// height is the block height of the validator set, the latest one if it's zero
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// full_set returns the full validator set instead of the top 100 validators by voting power
+	FullSet bool `protobuf:"varint,2,opt,name=full_set,json=fullSet,proto3" json:"full_set,omitempty"`
 }
 
 func (m *EVMValidatorsRequest) Reset()         { *m = EVMValidatorsRequest{} }
@@ -148,9 +154,23 @@ func (m *EVMValidatorsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EVMValidatorsRequest proto.InternalMessageInfo
 
+func (m *EVMValidatorsRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EVMValidatorsRequest) GetFullSet() bool {
+	if m != nil {
+		return m.FullSet
+	}
+	return false
+}
+
 // EVMValidatorsResponse is response type for the Service/EVMValidators RPC method.
 type EVMValidatorsResponse struct {
-	// BlockHeight is the latest block height
+	// BlockHeight is the block height of the validator set
 	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	// Validators is list of validator's addresss and voting power
 	Validators []ValidatorMinimal `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators"`
@@ -207,6 +227,8 @@ func (m *EVMValidatorsResponse) GetValidators() []ValidatorMinimal {
 type ValidatorMinimal struct {
 	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	VotingPower int64  `protobuf:"varint,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// pub_key is the compressed secp256k1 public key of the validator
+	PubKey github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=pub_key,json=pubKey,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"pub_key,omitempty"`
 }
 
 func (m *ValidatorMinimal) Reset()         { *m = ValidatorMinimal{} }
@@ -256,46 +278,333 @@ func (m *ValidatorMinimal) GetVotingPower() int64 {
 	return 0
 }
 
+func (m *ValidatorMinimal) GetPubKey() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+// EVMValidatorSetDiffRequest is request type for the Service/EVMValidatorSetDiff RPC method.
+type EVMValidatorSetDiffRequest struct {
+	// from_height is the block height of the validator set that is already trusted
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the block height of the new validator set, the latest one if it's zero
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *EVMValidatorSetDiffRequest) Reset()         { *m = EVMValidatorSetDiffRequest{} }
+func (m *EVMValidatorSetDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*EVMValidatorSetDiffRequest) ProtoMessage()    {}
+func (*EVMValidatorSetDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b9808b0dc6d368a, []int{5}
+}
+func (m *EVMValidatorSetDiffRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EVMValidatorSetDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EVMValidatorSetDiffRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EVMValidatorSetDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMValidatorSetDiffRequest.Merge(m, src)
+}
+func (m *EVMValidatorSetDiffRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EVMValidatorSetDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMValidatorSetDiffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMValidatorSetDiffRequest proto.InternalMessageInfo
+
+func (m *EVMValidatorSetDiffRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *EVMValidatorSetDiffRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// EVMValidatorSetDiffResponse is response type for the Service/EVMValidatorSetDiff RPC method.
+type EVMValidatorSetDiffResponse struct {
+	// from_block_height is the block height of the trusted validator set
+	FromBlockHeight int64 `protobuf:"varint,1,opt,name=from_block_height,json=fromBlockHeight,proto3" json:"from_block_height,omitempty"`
+	// to_block_height is the block height of the new validator set
+	ToBlockHeight int64 `protobuf:"varint,2,opt,name=to_block_height,json=toBlockHeight,proto3" json:"to_block_height,omitempty"`
+	// changes is the list of validators whose voting power is changed ordered by address. A validator
+	// with zero voting power is removed from the validator set.
+	Changes []ValidatorMinimal `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes"`
+	// validators is the new validator set in the order of the validator set hash of the block
+	Validators []ValidatorMinimal `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators"`
+	// proof is the proof that the new validator set is in the block signed by the trusted validator set
+	Proof ValidatorSetTransitionProof `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof"`
+}
+
+func (m *EVMValidatorSetDiffResponse) Reset()         { *m = EVMValidatorSetDiffResponse{} }
+func (m *EVMValidatorSetDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*EVMValidatorSetDiffResponse) ProtoMessage()    {}
+func (*EVMValidatorSetDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b9808b0dc6d368a, []int{6}
+}
+func (m *EVMValidatorSetDiffResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EVMValidatorSetDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EVMValidatorSetDiffResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EVMValidatorSetDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMValidatorSetDiffResponse.Merge(m, src)
+}
+func (m *EVMValidatorSetDiffResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EVMValidatorSetDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMValidatorSetDiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMValidatorSetDiffResponse proto.InternalMessageInfo
+
+func (m *EVMValidatorSetDiffResponse) GetFromBlockHeight() int64 {
+	if m != nil {
+		return m.FromBlockHeight
+	}
+	return 0
+}
+
+func (m *EVMValidatorSetDiffResponse) GetToBlockHeight() int64 {
+	if m != nil {
+		return m.ToBlockHeight
+	}
+	return 0
+}
+
+func (m *EVMValidatorSetDiffResponse) GetChanges() []ValidatorMinimal {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *EVMValidatorSetDiffResponse) GetValidators() []ValidatorMinimal {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *EVMValidatorSetDiffResponse) GetProof() ValidatorSetTransitionProof {
+	if m != nil {
+		return m.Proof
+	}
+	return ValidatorSetTransitionProof{}
+}
+
+// ValidatorSetTransitionProof contains the block header of the new validator set and the signatures of
+// the block. The transition is authorized if the validators of the trusted validator set that signed the
+// block have more than 2/3 of its voting power.
+//
+// The validator set hash [7] of the block header is computed from the new validator set and combined with
+// [1C] and [6] to get [2B] of the block header merkle parts, see BlockHeaderMerkleParts.
+type ValidatorSetTransitionProof struct {
+	BlockHeaderMerkleParts       proof.BlockHeaderMerkleParts                     `protobuf:"bytes,1,opt,name=block_header_merkle_parts,json=blockHeaderMerkleParts,proto3" json:"block_header_merkle_parts"`
+	AppHash                      github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=app_hash,json=appHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"app_hash,omitempty"`
+	LastBlockIdAndLastCommitHash github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=last_block_id_and_last_commit_hash,json=lastBlockIdAndLastCommitHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"last_block_id_and_last_commit_hash,omitempty"`
+	DataHash                     github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,4,opt,name=data_hash,json=dataHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"data_hash,omitempty"`
+	CommonEncodedVotePart        proof.CommonEncodedVotePart                      `protobuf:"bytes,5,opt,name=common_encoded_vote_part,json=commonEncodedVotePart,proto3" json:"common_encoded_vote_part"`
+	Signatures                   []proof.TMSignature                              `protobuf:"bytes,6,rep,name=signatures,proto3" json:"signatures"`
+	// signed_voting_power is the voting power of the trusted validator set that signed the block
+	SignedVotingPower int64 `protobuf:"varint,7,opt,name=signed_voting_power,json=signedVotingPower,proto3" json:"signed_voting_power,omitempty"`
+	// total_voting_power is the total voting power of the trusted validator set
+	TotalVotingPower int64 `protobuf:"varint,8,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+}
+
+func (m *ValidatorSetTransitionProof) Reset()         { *m = ValidatorSetTransitionProof{} }
+func (m *ValidatorSetTransitionProof) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetTransitionProof) ProtoMessage()    {}
+func (*ValidatorSetTransitionProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b9808b0dc6d368a, []int{7}
+}
+func (m *ValidatorSetTransitionProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSetTransitionProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetTransitionProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSetTransitionProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetTransitionProof.Merge(m, src)
+}
+func (m *ValidatorSetTransitionProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetTransitionProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetTransitionProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetTransitionProof proto.InternalMessageInfo
+
+func (m *ValidatorSetTransitionProof) GetBlockHeaderMerkleParts() proof.BlockHeaderMerkleParts {
+	if m != nil {
+		return m.BlockHeaderMerkleParts
+	}
+	return proof.BlockHeaderMerkleParts{}
+}
+
+func (m *ValidatorSetTransitionProof) GetAppHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.AppHash
+	}
+	return nil
+}
+
+func (m *ValidatorSetTransitionProof) GetLastBlockIdAndLastCommitHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.LastBlockIdAndLastCommitHash
+	}
+	return nil
+}
+
+func (m *ValidatorSetTransitionProof) GetDataHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.DataHash
+	}
+	return nil
+}
+
+func (m *ValidatorSetTransitionProof) GetCommonEncodedVotePart() proof.CommonEncodedVotePart {
+	if m != nil {
+		return m.CommonEncodedVotePart
+	}
+	return proof.CommonEncodedVotePart{}
+}
+
+func (m *ValidatorSetTransitionProof) GetSignatures() []proof.TMSignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+func (m *ValidatorSetTransitionProof) GetSignedVotingPower() int64 {
+	if m != nil {
+		return m.SignedVotingPower
+	}
+	return 0
+}
+
+func (m *ValidatorSetTransitionProof) GetTotalVotingPower() int64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ChainIDRequest)(nil), "band.base.node.v1.ChainIDRequest")
 	proto.RegisterType((*ChainIDResponse)(nil), "band.base.node.v1.ChainIDResponse")
 	proto.RegisterType((*EVMValidatorsRequest)(nil), "band.base.node.v1.EVMValidatorsRequest")
 	proto.RegisterType((*EVMValidatorsResponse)(nil), "band.base.node.v1.EVMValidatorsResponse")
 	proto.RegisterType((*ValidatorMinimal)(nil), "band.base.node.v1.ValidatorMinimal")
+	proto.RegisterType((*EVMValidatorSetDiffRequest)(nil), "band.base.node.v1.EVMValidatorSetDiffRequest")
+	proto.RegisterType((*EVMValidatorSetDiffResponse)(nil), "band.base.node.v1.EVMValidatorSetDiffResponse")
+	proto.RegisterType((*ValidatorSetTransitionProof)(nil), "band.base.node.v1.ValidatorSetTransitionProof")
 }
 
 func init() { proto.RegisterFile("band/base/node/v1/query.proto", fileDescriptor_0b9808b0dc6d368a) }
 
 var fileDescriptor_0b9808b0dc6d368a = []byte{
-	// 445 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xcf, 0x6f, 0xd3, 0x30,
-	0x18, 0x6d, 0x3a, 0x44, 0xc1, 0xe5, 0xc7, 0xb0, 0xc6, 0x14, 0x55, 0xc3, 0xdb, 0x02, 0x82, 0x5e,
-	0x88, 0xd5, 0xed, 0xc4, 0xb5, 0x80, 0x44, 0x85, 0x26, 0x50, 0x91, 0x76, 0xe0, 0x52, 0x39, 0x89,
-	0xe5, 0x58, 0xa4, 0xfe, 0xb2, 0xd8, 0x0d, 0xe2, 0x8a, 0x04, 0xe2, 0x88, 0xc4, 0x3f, 0xb5, 0xe3,
-	0x24, 0x2e, 0x9c, 0x26, 0x94, 0xf2, 0x87, 0x20, 0x3b, 0x4d, 0xa1, 0xa3, 0x62, 0x37, 0xfb, 0xbd,
-	0xf7, 0x3d, 0x3d, 0x3f, 0x7f, 0xe8, 0x5e, 0xc4, 0x54, 0x42, 0x23, 0xa6, 0x39, 0x55, 0x90, 0x70,
-	0x5a, 0x0e, 0xe8, 0xc9, 0x8c, 0x17, 0x1f, 0xc2, 0xbc, 0x00, 0x03, 0xf8, 0x8e, 0xa5, 0x43, 0x4b,
-	0x87, 0x96, 0x0e, 0xcb, 0x41, 0x6f, 0x47, 0x00, 0x88, 0x8c, 0x53, 0x96, 0x4b, 0xca, 0x94, 0x02,
-	0xc3, 0x8c, 0x04, 0xa5, 0xeb, 0x81, 0xde, 0x96, 0x00, 0x01, 0xee, 0x48, 0xed, 0xa9, 0x46, 0x83,
-	0x4d, 0x74, 0xeb, 0x69, 0xca, 0xa4, 0x1a, 0x3d, 0x1b, 0xf3, 0x93, 0x19, 0xd7, 0x26, 0x78, 0x82,
-	0x6e, 0x2f, 0x11, 0x9d, 0x83, 0xd2, 0x1c, 0x3f, 0x44, 0xd7, 0x62, 0x0b, 0x4d, 0x64, 0xe2, 0x7b,
-	0x7b, 0x5e, 0xff, 0xfa, 0xb0, 0x5b, 0x9d, 0xef, 0x76, 0x1a, 0x59, 0xc7, 0x91, 0xa3, 0x24, 0xd8,
-	0x46, 0x5b, 0xcf, 0x8f, 0x8f, 0x8e, 0x59, 0x26, 0x13, 0x66, 0xa0, 0xd0, 0x8d, 0xe5, 0x27, 0x0f,
-	0xdd, 0xbd, 0x40, 0x2c, 0x9c, 0xf7, 0xd1, 0x8d, 0x28, 0x83, 0xf8, 0xdd, 0x24, 0xe5, 0x52, 0xa4,
-	0xc6, 0xb9, 0x6f, 0x8c, 0xbb, 0x0e, 0x7b, 0xe1, 0x20, 0x3c, 0x42, 0xa8, 0x5c, 0x0e, 0xfa, 0xed,
-	0xbd, 0x8d, 0x7e, 0xf7, 0xe0, 0x7e, 0xf8, 0xcf, 0xeb, 0xc3, 0xa5, 0xfb, 0x91, 0x54, 0x72, 0xca,
-	0xb2, 0xe1, 0x95, 0xd3, 0xf3, 0xdd, 0xd6, 0xf8, 0xaf, 0xe1, 0xe0, 0x15, 0xda, 0xbc, 0xa8, 0xc2,
-	0x3e, 0xea, 0xb0, 0x24, 0x29, 0xb8, 0xd6, 0xf5, 0xd3, 0xc6, 0xcd, 0xd5, 0x66, 0x2b, 0xc1, 0x48,
-	0x25, 0x26, 0x39, 0xbc, 0xe7, 0x85, 0xdf, 0xae, 0xb3, 0xd5, 0xd8, 0x6b, 0x0b, 0x1d, 0x7c, 0x6e,
-	0xa3, 0xce, 0x1b, 0x5e, 0x94, 0x32, 0xe6, 0x38, 0x47, 0x4d, 0x21, 0x78, 0x7f, 0x4d, 0xbc, 0xd5,
-	0x96, 0x7b, 0xc1, 0xff, 0x24, 0x75, 0x39, 0x01, 0xf9, 0xf8, 0xfd, 0xd7, 0xb7, 0xb6, 0x8f, 0xb7,
-	0xa9, 0xd5, 0xba, 0x92, 0xed, 0x16, 0x34, 0x5f, 0x81, 0xbf, 0x78, 0xe8, 0xe6, 0x4a, 0xad, 0xf8,
-	0xd1, 0x1a, 0xd7, 0x75, 0x3f, 0xd2, 0xeb, 0x5f, 0x2e, 0x5c, 0x84, 0x78, 0xe0, 0x42, 0x10, 0xbc,
-	0xb3, 0x1a, 0x82, 0x97, 0xd3, 0xc7, 0x7f, 0x9a, 0x1d, 0xbe, 0x3c, 0xad, 0x88, 0x77, 0x56, 0x11,
-	0xef, 0x67, 0x45, 0xbc, 0xaf, 0x73, 0xd2, 0x3a, 0x9b, 0x93, 0xd6, 0x8f, 0x39, 0x69, 0xbd, 0x1d,
-	0x08, 0x69, 0xd2, 0x59, 0x14, 0xc6, 0x30, 0x75, 0x0e, 0x6e, 0xed, 0x62, 0xc8, 0xe8, 0xc2, 0xea,
-	0x90, 0xc6, 0x99, 0xe4, 0xca, 0x50, 0x51, 0xe4, 0xb1, 0xdb, 0xf4, 0xe8, 0xaa, 0xd3, 0x1c, 0xfe,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0x92, 0x7c, 0xf9, 0x69, 0x02, 0x03, 0x00, 0x00,
+	// 939 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0xb3, 0x49, 0x76, 0xf3, 0xb6, 0x25, 0xc9, 0xb4, 0x8d, 0xdc, 0x4d, 0xd8, 0x6c, 0x5c,
+	0x54, 0x96, 0x42, 0x6d, 0x92, 0x72, 0xe1, 0xc8, 0xa6, 0x45, 0x59, 0xca, 0x8a, 0xb0, 0x5b, 0xe5,
+	0xd0, 0x8b, 0x35, 0xb6, 0x67, 0xbd, 0xa3, 0x78, 0x3d, 0xae, 0x67, 0x76, 0x21, 0x17, 0x0e, 0x48,
+	0x48, 0x1c, 0x91, 0xb8, 0xc3, 0x95, 0x9f, 0xc1, 0xb1, 0x17, 0xa4, 0x4a, 0x5c, 0x38, 0x45, 0x28,
+	0xe1, 0x57, 0x70, 0x42, 0x33, 0x63, 0x27, 0xde, 0x60, 0xa5, 0xd0, 0xdc, 0x66, 0xde, 0xfb, 0xde,
+	0xf7, 0x9e, 0xdf, 0x7b, 0xf3, 0x9e, 0xe1, 0x6d, 0x0f, 0xc7, 0x81, 0xe3, 0x61, 0x4e, 0x9c, 0x98,
+	0x05, 0xc4, 0x99, 0xee, 0x38, 0x2f, 0x26, 0x24, 0x3d, 0xb6, 0x93, 0x94, 0x09, 0x86, 0xd6, 0xa4,
+	0xda, 0x96, 0x6a, 0x5b, 0xaa, 0xed, 0xe9, 0x4e, 0x63, 0x33, 0x64, 0x2c, 0x8c, 0x88, 0x83, 0x13,
+	0xea, 0xe0, 0x38, 0x66, 0x02, 0x0b, 0xca, 0x62, 0xae, 0x0d, 0x1a, 0xb7, 0x43, 0x16, 0x32, 0x75,
+	0x74, 0xe4, 0x29, 0x93, 0x6e, 0x5d, 0x78, 0x61, 0x29, 0xf6, 0x23, 0xe5, 0x27, 0x49, 0x19, 0x1b,
+	0x6a, 0x80, 0xb5, 0x0a, 0x6f, 0xed, 0x8d, 0x30, 0x8d, 0xbb, 0x8f, 0xfb, 0xe4, 0xc5, 0x84, 0x70,
+	0x61, 0x7d, 0x0c, 0x2b, 0xe7, 0x12, 0x9e, 0xb0, 0x98, 0x13, 0x74, 0x1f, 0x6a, 0xbe, 0x14, 0xb9,
+	0x34, 0x30, 0x8d, 0x96, 0xd1, 0x5e, 0xee, 0xd4, 0x4f, 0x4f, 0xb6, 0xaa, 0x39, 0xac, 0xaa, 0x94,
+	0xdd, 0xc0, 0xea, 0xc2, 0xed, 0x27, 0x87, 0xbd, 0x43, 0x1c, 0xd1, 0x00, 0x0b, 0x96, 0xf2, 0x8c,
+	0x12, 0xad, 0xc3, 0xd2, 0x88, 0xd0, 0x70, 0x24, 0x94, 0x75, 0xa5, 0x9f, 0xdd, 0xd0, 0x5d, 0xa8,
+	0x0d, 0x27, 0x51, 0xe4, 0x72, 0x22, 0xcc, 0xf9, 0x96, 0xd1, 0xae, 0xf5, 0xab, 0xf2, 0x3e, 0x20,
+	0xc2, 0xfa, 0xce, 0x80, 0x3b, 0x97, 0xb8, 0xb2, 0x60, 0xb6, 0xe1, 0x86, 0x17, 0x31, 0xff, 0xc8,
+	0x9d, 0xa1, 0xac, 0x2b, 0xd9, 0xbe, 0xe6, 0xed, 0x02, 0x4c, 0xcf, 0x0d, 0xcd, 0xf9, 0x56, 0xa5,
+	0x5d, 0xdf, 0xbd, 0x67, 0xff, 0x2b, 0xa3, 0xf6, 0x39, 0x7b, 0x8f, 0xc6, 0x74, 0x8c, 0xa3, 0xce,
+	0xc2, 0xcb, 0x93, 0xad, 0xb9, 0x7e, 0xc1, 0xd8, 0xfa, 0xc9, 0x80, 0xd5, 0xcb, 0x30, 0x64, 0x42,
+	0x15, 0x07, 0x41, 0x4a, 0x38, 0xd7, 0xe9, 0xe8, 0xe7, 0x57, 0x19, 0xdc, 0x94, 0x09, 0x1a, 0x87,
+	0x6e, 0xc2, 0xbe, 0x22, 0xa9, 0xfa, 0xaa, 0x4a, 0xbf, 0xae, 0x65, 0x07, 0x52, 0x84, 0x7a, 0x50,
+	0x4d, 0x26, 0x9e, 0x7b, 0x44, 0x8e, 0xcd, 0x4a, 0xcb, 0x68, 0xdf, 0xe8, 0x7c, 0xf4, 0xf7, 0xc9,
+	0xd6, 0x87, 0x21, 0x15, 0xa3, 0x89, 0x67, 0xfb, 0x6c, 0xec, 0xf8, 0x6c, 0x4c, 0x84, 0x37, 0x14,
+	0x17, 0x87, 0x88, 0x7a, 0xdc, 0xf1, 0x8e, 0x05, 0xe1, 0xf6, 0x3e, 0xf9, 0xba, 0x23, 0x0f, 0xfd,
+	0xa5, 0x64, 0xe2, 0x3d, 0x25, 0xc7, 0xd6, 0x73, 0x68, 0x14, 0xf3, 0x34, 0x20, 0xe2, 0x31, 0x1d,
+	0x0e, 0xf3, 0xcc, 0x6f, 0x41, 0x7d, 0x98, 0xb2, 0xf1, 0x6c, 0xae, 0x40, 0x8a, 0xb2, 0x54, 0x6d,
+	0xc0, 0xb2, 0x60, 0xb9, 0x5a, 0x47, 0x5b, 0x13, 0x4c, 0x2b, 0xad, 0xdf, 0xe6, 0x61, 0xa3, 0x94,
+	0x3c, 0x2b, 0xc5, 0x03, 0x58, 0x53, 0xec, 0x25, 0xf5, 0x58, 0x91, 0x8a, 0x4e, 0xa1, 0x26, 0xf7,
+	0x61, 0x45, 0xb0, 0x59, 0xa4, 0x76, 0x77, 0x53, 0xb0, 0x22, 0x6e, 0x0f, 0x64, 0x3b, 0xc5, 0x21,
+	0xe1, 0x66, 0xe5, 0xff, 0x16, 0x2e, 0xb7, 0xbc, 0xd4, 0x00, 0x0b, 0xd7, 0x68, 0x00, 0xf4, 0x19,
+	0x2c, 0xaa, 0xf7, 0x62, 0x2e, 0xb6, 0x8c, 0x76, 0x7d, 0xd7, 0xbe, 0x8a, 0x65, 0x40, 0xc4, 0xb3,
+	0x14, 0xc7, 0x9c, 0xca, 0x87, 0x79, 0x20, 0xad, 0x32, 0x42, 0x4d, 0x61, 0xfd, 0xba, 0x08, 0x1b,
+	0x57, 0x80, 0x51, 0x04, 0x77, 0xf3, 0x04, 0xe1, 0x80, 0xa4, 0xee, 0x98, 0xa4, 0x47, 0x11, 0x71,
+	0x13, 0x9c, 0x0a, 0xdd, 0x69, 0xf5, 0xdd, 0xf7, 0x0b, 0xfe, 0xf5, 0x8b, 0x96, 0x11, 0x64, 0x09,
+	0x94, 0x46, 0x3d, 0x65, 0x73, 0x20, 0x4d, 0x32, 0xe7, 0xeb, 0x5e, 0xa9, 0x16, 0x7d, 0x01, 0x35,
+	0x9c, 0x24, 0xee, 0x08, 0xf3, 0x91, 0x2a, 0xc5, 0x9b, 0x76, 0x62, 0x15, 0x27, 0xc9, 0x3e, 0xe6,
+	0x23, 0xf4, 0x0d, 0x58, 0x11, 0xe6, 0x22, 0x2b, 0x32, 0x0d, 0x5c, 0x1c, 0x07, 0xae, 0x92, 0xf8,
+	0x6c, 0x3c, 0xa6, 0x42, 0xbb, 0xba, 0x4e, 0xd3, 0x6f, 0x4a, 0x36, 0xf5, 0xb1, 0xdd, 0xe0, 0x93,
+	0x38, 0xf8, 0x1c, 0x73, 0xb1, 0xa7, 0xa8, 0x95, 0xff, 0x2f, 0x61, 0x39, 0xc0, 0x02, 0x6b, 0x37,
+	0x0b, 0xd7, 0x70, 0x53, 0x93, 0x34, 0x8a, 0x92, 0x82, 0x29, 0x63, 0x67, 0xb1, 0x4b, 0x62, 0x9f,
+	0x05, 0x24, 0x70, 0xa7, 0x4c, 0xe8, 0x8a, 0x64, 0x0d, 0xf1, 0xa0, 0xb4, 0x20, 0x7b, 0xca, 0xe8,
+	0x89, 0xb6, 0x39, 0x64, 0x42, 0xa5, 0x3c, 0xab, 0xc7, 0x1d, 0xbf, 0x4c, 0x89, 0x3e, 0x05, 0xe0,
+	0x34, 0x8c, 0xb1, 0x98, 0xa4, 0x84, 0x9b, 0x4b, 0xaa, 0x67, 0x5b, 0xa5, 0xe4, 0xcf, 0x7a, 0x83,
+	0x1c, 0x98, 0x37, 0xec, 0x85, 0x25, 0xb2, 0xe1, 0x96, 0xbc, 0xe9, 0x50, 0x2f, 0x26, 0x51, 0x55,
+	0x3d, 0xb6, 0x35, 0xad, 0x3a, 0x2c, 0xcc, 0xa3, 0x0f, 0x00, 0x09, 0x26, 0x70, 0x34, 0x0b, 0xaf,
+	0x29, 0xf8, 0xaa, 0xd2, 0x14, 0xd0, 0xbb, 0xbf, 0x54, 0xa0, 0x3a, 0x20, 0xe9, 0x94, 0xfa, 0x04,
+	0x25, 0x90, 0xaf, 0x00, 0xb4, 0x5d, 0xf2, 0x2c, 0x66, 0xf7, 0x4a, 0xc3, 0xba, 0x0a, 0xa2, 0x07,
+	0x8a, 0xd5, 0xfc, 0xf6, 0xf7, 0xbf, 0x7e, 0x9c, 0x37, 0xd1, 0xba, 0x23, 0xb1, 0x6a, 0xad, 0xc8,
+	0x85, 0x95, 0x2f, 0x1f, 0xf4, 0xbd, 0x01, 0x37, 0x67, 0xb6, 0x02, 0x7a, 0xb7, 0x84, 0xb5, 0x6c,
+	0x07, 0x35, 0xda, 0xaf, 0x07, 0x66, 0x41, 0xbc, 0xa3, 0x82, 0x68, 0xa2, 0xcd, 0xd9, 0x20, 0xc8,
+	0x74, 0xfc, 0xb0, 0x30, 0x17, 0x7e, 0x36, 0xe0, 0x56, 0xc9, 0x6c, 0x44, 0x0f, 0x5f, 0xe3, 0x67,
+	0x76, 0x40, 0x37, 0xec, 0xff, 0x0a, 0xcf, 0x82, 0x7b, 0x4f, 0x05, 0x77, 0x0f, 0x6d, 0x5f, 0x15,
+	0x9c, 0x13, 0xd0, 0xe1, 0xb0, 0xf3, 0xf4, 0xe5, 0x69, 0xd3, 0x78, 0x75, 0xda, 0x34, 0xfe, 0x3c,
+	0x6d, 0x1a, 0x3f, 0x9c, 0x35, 0xe7, 0x5e, 0x9d, 0x35, 0xe7, 0xfe, 0x38, 0x6b, 0xce, 0x3d, 0xdf,
+	0x29, 0xbc, 0x08, 0x49, 0xa3, 0x7e, 0x05, 0x7c, 0x16, 0x39, 0x19, 0xdf, 0x23, 0xc7, 0x8f, 0x28,
+	0x89, 0x85, 0x13, 0xa6, 0x89, 0xaf, 0x7e, 0x4f, 0xbc, 0x25, 0x85, 0x79, 0xf4, 0x4f, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0x68, 0xef, 0xd9, 0x31, 0xb7, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -312,8 +621,11 @@ const _ = grpc.SupportPackageIsVersion4
 type ServiceClient interface {
 	// ChainID queries the chain ID of this node
 	ChainID(ctx context.Context, in *ChainIDRequest, opts ...grpc.CallOption) (*ChainIDResponse, error)
-	// EVMValidators queries current list of validator's address and power
+	// EVMValidators queries list of validator's address and power at the given height
 	EVMValidators(ctx context.Context, in *EVMValidatorsRequest, opts ...grpc.CallOption) (*EVMValidatorsResponse, error)
+	// EVMValidatorSetDiff queries the changes of the validator set between two heights along with the
+	// signatures of the block at the later height to authorize the transition
+	EVMValidatorSetDiff(ctx context.Context, in *EVMValidatorSetDiffRequest, opts ...grpc.CallOption) (*EVMValidatorSetDiffResponse, error)
 }
 
 type serviceClient struct {
@@ -342,12 +654,24 @@ func (c *serviceClient) EVMValidators(ctx context.Context, in *EVMValidatorsRequ
 	return out, nil
 }
 
+func (c *serviceClient) EVMValidatorSetDiff(ctx context.Context, in *EVMValidatorSetDiffRequest, opts ...grpc.CallOption) (*EVMValidatorSetDiffResponse, error) {
+	out := new(EVMValidatorSetDiffResponse)
+	err := c.cc.Invoke(ctx, "/band.base.node.v1.Service/EVMValidatorSetDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// ChainID queries the chain ID of this node
 	ChainID(context.Context, *ChainIDRequest) (*ChainIDResponse, error)
-	// EVMValidators queries current list of validator's address and power
+	// EVMValidators queries list of validator's address and power at the given height
 	EVMValidators(context.Context, *EVMValidatorsRequest) (*EVMValidatorsResponse, error)
+	// EVMValidatorSetDiff queries the changes of the validator set between two heights along with the
+	// signatures of the block at the later height to authorize the transition
+	EVMValidatorSetDiff(context.Context, *EVMValidatorSetDiffRequest) (*EVMValidatorSetDiffResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -360,6 +684,9 @@ func (*UnimplementedServiceServer) ChainID(ctx context.Context, req *ChainIDRequ
 func (*UnimplementedServiceServer) EVMValidators(ctx context.Context, req *EVMValidatorsRequest) (*EVMValidatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EVMValidators not implemented")
 }
+func (*UnimplementedServiceServer) EVMValidatorSetDiff(ctx context.Context, req *EVMValidatorSetDiffRequest) (*EVMValidatorSetDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EVMValidatorSetDiff not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -401,6 +728,24 @@ func _Service_EVMValidators_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_EVMValidatorSetDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EVMValidatorSetDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).EVMValidatorSetDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.base.node.v1.Service/EVMValidatorSetDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).EVMValidatorSetDiff(ctx, req.(*EVMValidatorSetDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "band.base.node.v1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -413,6 +758,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EVMValidators",
 			Handler:    _Service_EVMValidators_Handler,
 		},
+		{
+			MethodName: "EVMValidatorSetDiff",
+			Handler:    _Service_EVMValidatorSetDiff_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/base/node/v1/query.proto",
@@ -491,6 +840,21 @@ func (m *EVMValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FullSet {
+		i--
+		if m.FullSet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -556,6 +920,13 @@ func (m *ValidatorMinimal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.VotingPower != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
 		i--
@@ -571,79 +942,362 @@ func (m *ValidatorMinimal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EVMValidatorSetDiffRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ChainIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *EVMValidatorSetDiffRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChainIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EVMValidatorSetDiffRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EVMValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EVMValidatorSetDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EVMValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
-	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *EVMValidatorSetDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorMinimal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EVMValidatorSetDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ToBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromBlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSetTransitionProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetTransitionProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetTransitionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SignedVotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignedVotingPower))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.CommonEncodedVotePart.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DataHash) > 0 {
+		i -= len(m.DataHash)
+		copy(dAtA[i:], m.DataHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LastBlockIdAndLastCommitHash) > 0 {
+		i -= len(m.LastBlockIdAndLastCommitHash)
+		copy(dAtA[i:], m.LastBlockIdAndLastCommitHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LastBlockIdAndLastCommitHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AppHash) > 0 {
+		i -= len(m.AppHash)
+		copy(dAtA[i:], m.AppHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AppHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.BlockHeaderMerkleParts.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChainIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ChainIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EVMValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.FullSet {
+		n += 2
+	}
+	return n
+}
+
+func (m *EVMValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorMinimal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	if m.VotingPower != 0 {
 		n += 1 + sovQuery(uint64(m.VotingPower))
 	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EVMValidatorSetDiffRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *EVMValidatorSetDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromBlockHeight))
+	}
+	if m.ToBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToBlockHeight))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Proof.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ValidatorSetTransitionProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlockHeaderMerkleParts.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LastBlockIdAndLastCommitHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DataHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.CommonEncodedVotePart.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.SignedVotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.SignedVotingPower))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalVotingPower))
+	}
 	return n
 }
 
@@ -814,6 +1468,45 @@ func (m *EVMValidatorsRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: EVMValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullSet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FullSet = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1018,6 +1711,607 @@ func (m *ValidatorMinimal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EVMValidatorSetDiffRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EVMValidatorSetDiffRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EVMValidatorSetDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EVMValidatorSetDiffResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EVMValidatorSetDiffResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EVMValidatorSetDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBlockHeight", wireType)
+			}
+			m.FromBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBlockHeight", wireType)
+			}
+			m.ToBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, ValidatorMinimal{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ValidatorMinimal{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSetTransitionProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetTransitionProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetTransitionProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderMerkleParts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockHeaderMerkleParts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockIdAndLastCommitHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastBlockIdAndLastCommitHash = append(m.LastBlockIdAndLastCommitHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LastBlockIdAndLastCommitHash == nil {
+				m.LastBlockIdAndLastCommitHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataHash = append(m.DataHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataHash == nil {
+				m.DataHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonEncodedVotePart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommonEncodedVotePart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, proof.TMSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedVotingPower", wireType)
+			}
+			m.SignedVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1,19 +1,28 @@
 package node
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"sort"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
+	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	gogotypes "github.com/cosmos/gogoproto/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/client/grpc/oracle/proof"
 )
 
 // RegisterNodeService registers the node gRPC service on the provided gRPC router.
@@ -27,6 +36,10 @@ func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.Serv
 	_ = RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
 }
 
+// maxEVMValidators is the number of validators returned by the EVMValidators query unless the full set is
+// requested.
+const maxEVMValidators = 100
+
 // to check queryServer implements ServiceServer
 var _ ServiceServer = queryServer{}
 
@@ -53,47 +66,220 @@ func (s queryServer) ChainID(ctx context.Context, _ *ChainIDRequest) (*ChainIDRe
 	}, nil
 }
 
-// EVMValidators returns top 100 validators' address and voting power order by voting power
+// EVMValidators returns top 100 validators' address and voting power at the given height order by voting power,
+// or all validators if the full set is requested
 func (s queryServer) EVMValidators(
 	ctx context.Context,
-	_ *EVMValidatorsRequest,
+	req *EVMValidatorsRequest,
 ) (*EVMValidatorsResponse, error) {
 	node, err := s.clientCtx.GetNode()
 	if err != nil {
 		return nil, err
 	}
 
-	// Get top 100 validators for now
-	page := 1
-	perPage := 100
-	validators, err := node.Validators(context.Background(), nil, &page, &perPage)
+	limit := maxEVMValidators
+	if req.FullSet {
+		limit = 0
+	}
+	blockHeight, validators, err := getEVMValidators(node, req.Height, limit)
 	if err != nil {
 		return nil, err
 	}
 
-	evmValidatorsResponse := EVMValidatorsResponse{}
-	evmValidatorsResponse.BlockHeight = validators.BlockHeight
-	evmValidatorsResponse.Validators = []ValidatorMinimal{}
+	return &EVMValidatorsResponse{
+		BlockHeight: blockHeight,
+		Validators:  validators,
+	}, nil
+}
+
+// EVMValidatorSetDiff returns the changes of the validator set between two heights and the signatures of
+// the block at the later height to authorize the transition of the validator set
+func (s queryServer) EVMValidatorSetDiff(
+	ctx context.Context,
+	req *EVMValidatorSetDiffRequest,
+) (*EVMValidatorSetDiffResponse, error) {
+	if req.FromHeight <= 0 {
+		return nil, fmt.Errorf("from height must be positive")
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
 
-	// put each validator's address and voting power to the response
-	for _, validator := range validators.Validators {
-		pubKeyBytes, ok := validator.PubKey.(secp256k1.PubKey)
-		if !ok {
-			return nil, fmt.Errorf("can't get validator public key")
+	// Get the commit of the block of the new validator set
+	var toHeight *int64
+	if req.ToHeight != 0 {
+		toHeight = &req.ToHeight
+	}
+	commit, err := node.Commit(context.Background(), toHeight)
+	if err != nil {
+		return nil, err
+	}
+	if commit.Height < req.FromHeight {
+		return nil, fmt.Errorf("to height %d is lower than from height %d", commit.Height, req.FromHeight)
+	}
+
+	// The full validator sets are needed to compute the validator set hash of the block
+	fromHeight, fromValidators, err := getEVMValidators(node, req.FromHeight, 0)
+	if err != nil {
+		return nil, err
+	}
+	_, toValidators, err := getEVMValidators(node, commit.Height, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	signatures, commonVote, err := proof.GetSignaturesAndPrefix(&commit.SignedHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sum the voting power of the trusted validators that signed the block
+	fromPowers := make(map[string]int64, len(fromValidators))
+	totalPower := int64(0)
+	for _, val := range fromValidators {
+		pubKey := secp256k1.PubKey(val.PubKey)
+		fromPowers[string(pubKey.Address())] = val.VotingPower
+		totalPower += val.VotingPower
+	}
+	signedPower := int64(0)
+	for _, sig := range commit.Commit.Signatures {
+		if sig.BlockIDFlag == cmttypes.BlockIDFlagCommit {
+			signedPower += fromPowers[string(sig.ValidatorAddress)]
 		}
+	}
 
-		if pubkey, err := crypto.DecompressPubkey(pubKeyBytes[:]); err != nil {
-			return nil, err
-		} else {
-			evmValidatorsResponse.Validators = append(
-				evmValidatorsResponse.Validators,
+	lastBlockIDAndLastCommitHash, err := getLastBlockIDAndLastCommitHash(commit.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EVMValidatorSetDiffResponse{
+		FromBlockHeight: fromHeight,
+		ToBlockHeight:   commit.Height,
+		Changes:         diffEVMValidators(fromValidators, toValidators),
+		Validators:      toValidators,
+		Proof: ValidatorSetTransitionProof{
+			BlockHeaderMerkleParts:       proof.GetBlockHeaderMerkleParts(commit.Header),
+			AppHash:                      commit.Header.AppHash,
+			LastBlockIdAndLastCommitHash: lastBlockIDAndLastCommitHash,
+			DataHash:                     commit.Header.DataHash,
+			CommonEncodedVotePart:        commonVote,
+			Signatures:                   signatures,
+			SignedVotingPower:            signedPower,
+			TotalVotingPower:             totalPower,
+		},
+	}, nil
+}
+
+// getEVMValidators returns the first validators up to the given limit, or all validators if the limit is zero,
+// at the given height, or the latest height if it's zero, in the order of the validator set
+func getEVMValidators(node client.CometRPC, height int64, limit int) (int64, []ValidatorMinimal, error) {
+	var queryHeight *int64
+	if height != 0 {
+		queryHeight = &height
+	}
+
+	blockHeight := int64(0)
+	evmValidators := []ValidatorMinimal{}
+	perPage := maxEVMValidators
+	if limit != 0 && limit < perPage {
+		perPage = limit
+	}
+	for page := 1; ; page++ {
+		validators, err := node.Validators(context.Background(), queryHeight, &page, &perPage)
+		if err != nil {
+			return 0, nil, err
+		}
+		// Query the following pages at the same height in case a new block is committed meanwhile
+		blockHeight = validators.BlockHeight
+		queryHeight = &blockHeight
+
+		// put each validator's address and voting power to the response
+		for _, validator := range validators.Validators {
+			pubKeyBytes, ok := validator.PubKey.(secp256k1.PubKey)
+			if !ok {
+				return 0, nil, fmt.Errorf("can't get validator public key")
+			}
+
+			pubkey, err := crypto.DecompressPubkey(pubKeyBytes[:])
+			if err != nil {
+				return 0, nil, err
+			}
+
+			evmValidators = append(
+				evmValidators,
 				ValidatorMinimal{
 					Address:     crypto.PubkeyToAddress(*pubkey).String(),
 					VotingPower: validator.VotingPower,
+					PubKey:      tmbytes.HexBytes(pubKeyBytes),
 				},
 			)
 		}
+
+		if limit != 0 && len(evmValidators) >= limit {
+			return blockHeight, evmValidators[:limit], nil
+		}
+		if len(evmValidators) >= validators.Total || len(validators.Validators) == 0 {
+			return blockHeight, evmValidators, nil
+		}
+	}
+}
+
+// diffEVMValidators returns the validators whose voting power is changed from the old validator set to the new
+// one ordered by address. The removed validators are returned with zero voting power.
+func diffEVMValidators(from, to []ValidatorMinimal) []ValidatorMinimal {
+	fromPowers := make(map[common.Address]int64, len(from))
+	for _, val := range from {
+		fromPowers[common.HexToAddress(val.Address)] = val.VotingPower
+	}
+
+	changes := []ValidatorMinimal{}
+	for _, val := range to {
+		address := common.HexToAddress(val.Address)
+		if power, ok := fromPowers[address]; !ok || power != val.VotingPower {
+			changes = append(changes, val)
+		}
+		delete(fromPowers, address)
+	}
+	for _, val := range from {
+		if _, ok := fromPowers[common.HexToAddress(val.Address)]; ok {
+			changes = append(changes, ValidatorMinimal{
+				Address:     val.Address,
+				VotingPower: 0,
+				PubKey:      val.PubKey,
+			})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return bytes.Compare(
+			common.HexToAddress(changes[i].Address).Bytes(),
+			common.HexToAddress(changes[j].Address).Bytes(),
+		) < 0
+	})
+
+	return changes
+}
+
+// getLastBlockIDAndLastCommitHash returns the merkle hash of the last block ID and the last commit hash of
+// the block header, which is [1C] of BlockHeaderMerkleParts.
+func getLastBlockIDAndLastCommitHash(header *cmttypes.Header) ([]byte, error) {
+	pbbi := header.LastBlockID.ToProto()
+	bzbi, err := pbbi.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	lastCommitHash := []byte{}
+	if len(header.LastCommitHash) > 0 {
+		// cdcEncode of the header hash, see https://github.com/cometbft/cometbft/blob/v0.38.12/types/block.go
+		lastCommitHash, err = (&gogotypes.BytesValue{Value: header.LastCommitHash}).Marshal()
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	return &evmValidatorsResponse, nil
+	return merkle.HashFromByteSlices([][]byte{bzbi, lastCommitHash}), nil
 }
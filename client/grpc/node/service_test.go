@@ -0,0 +1,121 @@
+package node
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/bandprotocol/chain/v3/client/grpc/oracle/proof"
+)
+
+func TestDiffEVMValidators(t *testing.T) {
+	from := []ValidatorMinimal{
+		{Address: "0xB956589b6fC5523eeD0d9eEcfF06262Ce84ff260", VotingPower: 100},
+		{Address: "0x88e1cd00710495EEB93D4f522d16bC8B87Cb00FE", VotingPower: 100},
+		{Address: "0x652D89a66Eb4eA55366c45b1f9ACfc8e2179E1c5", VotingPower: 100},
+	}
+	to := []ValidatorMinimal{
+		{Address: "0x88e1cd00710495EEB93D4f522d16bC8B87Cb00FE", VotingPower: 150},
+		{Address: "0x652D89a66Eb4eA55366c45b1f9ACfc8e2179E1c5", VotingPower: 100},
+		{Address: "0xaAA22E077492CbaD414098EBD98AA8dc1C7AE8D9", VotingPower: 50},
+	}
+
+	require.Equal(t, []ValidatorMinimal{
+		{Address: "0x88e1cd00710495EEB93D4f522d16bC8B87Cb00FE", VotingPower: 150},
+		{Address: "0xaAA22E077492CbaD414098EBD98AA8dc1C7AE8D9", VotingPower: 50},
+		{Address: "0xB956589b6fC5523eeD0d9eEcfF06262Ce84ff260", VotingPower: 0},
+	}, diffEVMValidators(from, to))
+
+	require.Empty(t, diffEVMValidators(to, to))
+}
+
+// mockValidatorsNode is a node that only serves the paginated validator set at a fixed height.
+type mockValidatorsNode struct {
+	client.CometRPC
+
+	validators []*cmttypes.Validator
+}
+
+func (m mockValidatorsNode) Validators(
+	_ context.Context,
+	_ *int64,
+	page, perPage *int,
+) (*coretypes.ResultValidators, error) {
+	start := min((*page-1)**perPage, len(m.validators))
+	end := min(start+*perPage, len(m.validators))
+
+	return &coretypes.ResultValidators{
+		BlockHeight: 25000,
+		Validators:  m.validators[start:end],
+		Count:       end - start,
+		Total:       len(m.validators),
+	}, nil
+}
+
+func TestGetEVMValidators(t *testing.T) {
+	node := mockValidatorsNode{}
+	for i := 0; i < 150; i++ {
+		pubKey := secp256k1.GenPrivKey().PubKey()
+		node.validators = append(node.validators, cmttypes.NewValidator(pubKey, int64(1000-i)))
+	}
+
+	// The validators are capped to the top validators by default
+	height, validators, err := getEVMValidators(node, 0, maxEVMValidators)
+	require.NoError(t, err)
+	require.Equal(t, int64(25000), height)
+	require.Len(t, validators, maxEVMValidators)
+	require.Equal(t, int64(1000), validators[0].VotingPower)
+
+	_, validators, err = getEVMValidators(node, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, validators, 10)
+
+	// All pages are queried for the full validator set
+	_, validators, err = getEVMValidators(node, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, validators, 150)
+	require.Equal(t, int64(851), validators[149].VotingPower)
+}
+
+func TestGetLastBlockIDAndLastCommitHash(t *testing.T) {
+	header := cmttypes.Header{
+		ChainID: "bandchain",
+		Height:  25000,
+		LastBlockID: cmttypes.BlockID{
+			Hash:          tmhash.Sum([]byte("last block")),
+			PartSetHeader: cmttypes.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("last parts"))},
+		},
+		LastCommitHash: tmhash.Sum([]byte("last commit")),
+		DataHash:       tmhash.Sum(nil),
+		ValidatorsHash: tmhash.Sum([]byte("validators")),
+	}
+
+	lastBlockIDAndLastCommitHash, err := getLastBlockIDAndLastCommitHash(&header)
+	require.NoError(t, err)
+
+	// [2B] of the block header merkle parts is [1C] combined with the data hash and the validators hash
+	dataHash, err := (&gogotypes.BytesValue{Value: header.DataHash}).Marshal()
+	require.NoError(t, err)
+	validatorsHash, err := (&gogotypes.BytesValue{Value: header.ValidatorsHash}).Marshal()
+	require.NoError(t, err)
+
+	dataAndValidatorsHash := merkle.HashFromByteSlices([][]byte{dataHash, validatorsHash})
+
+	parts := proof.GetBlockHeaderMerkleParts(&header)
+	require.Equal(
+		t,
+		[]byte(parts.LastBlockIdAndOther),
+		tmhash.Sum(append(append([]byte{1}, lastBlockIDAndLastCommitHash...), dataAndValidatorsHash...)),
+	)
+}
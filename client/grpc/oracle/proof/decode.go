@@ -0,0 +1,182 @@
+package proof
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// EncodeSingleProof packs the block relay proof and the oracle data proof of the given single proof into
+// the EVM proof bytes that are relayed to the bridge contract.
+func EncodeSingleProof(p SingleProof) ([]byte, error) {
+	blockRelayBytes, err := p.BlockRelayProof.encodeToEthData()
+	if err != nil {
+		return nil, err
+	}
+	oracleDataBytes, err := p.OracleDataProof.encodeToEthData(p.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return relayAndVerifyArguments.Pack(blockRelayBytes, oracleDataBytes)
+}
+
+// DecodeSingleProof unpacks the EVM proof bytes of a single proof back to SingleProof. It only decodes
+// the bytes, the returned proof has to be verified separately.
+func DecodeSingleProof(evmProofBytes []byte) (proof SingleProof, err error) {
+	// abi.ConvertType panics if the unpacked values cannot be converted, which must not happen for
+	// the values unpacked with the same arguments.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to decode proof: %v", r)
+		}
+	}()
+
+	values, err := relayAndVerifyArguments.Unpack(evmProofBytes)
+	if err != nil {
+		return SingleProof{}, err
+	}
+
+	blockRelay, err := decodeBlockRelayProof(values[0].([]byte))
+	if err != nil {
+		return SingleProof{}, err
+	}
+
+	blockHeight, oracleData, err := decodeOracleDataProof(values[1].([]byte))
+	if err != nil {
+		return SingleProof{}, err
+	}
+
+	return SingleProof{
+		BlockHeight:     blockHeight,
+		OracleDataProof: oracleData,
+		BlockRelayProof: blockRelay,
+	}, nil
+}
+
+func decodeBlockRelayProof(bz []byte) (BlockRelayProof, error) {
+	values, err := relayArguments.Unpack(bz)
+	if err != nil {
+		return BlockRelayProof{}, err
+	}
+
+	multiStore := *abi.ConvertType(values[0], new(MultiStoreProofEthereum)).(*MultiStoreProofEthereum)
+	merkleParts := *abi.ConvertType(values[1], new(BlockHeaderMerklePartsEthereum)).(*BlockHeaderMerklePartsEthereum)
+	commonVote := *abi.ConvertType(values[2], new(CommonEncodedVotePartEthereum)).(*CommonEncodedVotePartEthereum)
+	signatures := *abi.ConvertType(values[3], new([]TMSignatureEthereum)).(*[]TMSignatureEthereum)
+
+	blockRelay := BlockRelayProof{
+		MultiStoreProof: MultiStoreProof{
+			OracleIAVLStateHash:                   multiStore.OracleIAVLStateHash.Bytes(),
+			MintStoreMerkleHash:                   multiStore.MintStoreMerkleHash.Bytes(),
+			ParamsToRestakeStoresMerkleHash:       multiStore.ParamsToRestakeStoresMerkleHash.Bytes(),
+			RollingseedToTransferStoresMerkleHash: multiStore.RollingseedToTransferStoresMerkleHash.Bytes(),
+			TssToUpgradeStoresMerkleHash:          multiStore.TssToUpgradeStoresMerkleHash.Bytes(),
+			AuthToIcahostStoresMerkleHash:         multiStore.AuthToIcahostStoresMerkleHash.Bytes(),
+		},
+		BlockHeaderMerkleParts: BlockHeaderMerkleParts{
+			VersionAndChainIdHash:             merkleParts.VersionAndChainIdHash.Bytes(),
+			Height:                            merkleParts.Height,
+			TimeSecond:                        merkleParts.TimeSecond,
+			TimeNanoSecond:                    merkleParts.TimeNanoSecond,
+			LastBlockIdAndOther:               merkleParts.LastBlockIdAndOther.Bytes(),
+			NextValidatorHashAndConsensusHash: merkleParts.NextValidatorHashAndConsensusHash.Bytes(),
+			LastResultsHash:                   merkleParts.LastResultsHash.Bytes(),
+			EvidenceAndProposerHash:           merkleParts.EvidenceAndProposerHash.Bytes(),
+		},
+		CommonEncodedVotePart: CommonEncodedVotePart{
+			SignedDataPrefix: commonVote.SignedDataPrefix,
+			SignedDataSuffix: commonVote.SignedDataSuffix,
+		},
+		Signatures: make([]TMSignature, len(signatures)),
+	}
+	for i, sig := range signatures {
+		blockRelay.Signatures[i] = TMSignature{
+			R:                sig.R.Bytes(),
+			S:                sig.S.Bytes(),
+			V:                uint32(sig.V),
+			EncodedTimestamp: sig.EncodedTimestamp,
+		}
+	}
+
+	return blockRelay, nil
+}
+
+func decodeOracleDataProof(bz []byte) (uint64, OracleDataProof, error) {
+	values, err := verifyArguments.Unpack(bz)
+	if err != nil {
+		return 0, OracleDataProof{}, err
+	}
+
+	blockHeight, err := decodeUint64(values[0])
+	if err != nil {
+		return 0, OracleDataProof{}, err
+	}
+	result := *abi.ConvertType(values[1], new(ResultEthereum)).(*ResultEthereum)
+	version, err := decodeUint64(values[2])
+	if err != nil {
+		return 0, OracleDataProof{}, err
+	}
+	paths, err := decodeIAVLMerklePaths(values[3])
+	if err != nil {
+		return 0, OracleDataProof{}, err
+	}
+
+	return blockHeight, OracleDataProof{
+		Result: oracletypes.Result{
+			ClientID:       result.ClientID,
+			OracleScriptID: oracletypes.OracleScriptID(result.OracleScriptID),
+			Calldata:       result.Params,
+			AskCount:       result.AskCount,
+			MinCount:       result.MinCount,
+			RequestID:      oracletypes.RequestID(result.RequestID),
+			AnsCount:       result.AnsCount,
+			RequestTime:    int64(result.RequestTime),
+			ResolveTime:    int64(result.ResolveTime),
+			ResolveStatus:  oracletypes.ResolveStatus(result.ResolveStatus),
+			Result:         result.Result,
+		},
+		Version:     version,
+		MerklePaths: paths,
+	}, nil
+}
+
+func decodeIAVLMerklePaths(value interface{}) ([]IAVLMerklePath, error) {
+	ethPaths := *abi.ConvertType(value, new([]IAVLMerklePathEthereum)).(*[]IAVLMerklePathEthereum)
+
+	paths := make([]IAVLMerklePath, len(ethPaths))
+	for i, path := range ethPaths {
+		size, err := decodeUint64(path.SubtreeSize)
+		if err != nil {
+			return nil, err
+		}
+		version, err := decodeUint64(path.SubtreeVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		paths[i] = IAVLMerklePath{
+			IsDataOnRight:  path.IsDataOnRight,
+			SubtreeHeight:  uint32(path.SubtreeHeight),
+			SubtreeSize:    size,
+			SubtreeVersion: version,
+			SiblingHash:    tmbytes.HexBytes(path.SiblingHash.Bytes()),
+		}
+	}
+
+	return paths, nil
+}
+
+func decodeUint64(value interface{}) (uint64, error) {
+	v, ok := value.(*big.Int)
+	if !ok || v.Sign() < 0 || !v.IsUint64() {
+		return 0, fmt.Errorf("invalid uint64 value: %v", value)
+	}
+
+	return v.Uint64(), nil
+}
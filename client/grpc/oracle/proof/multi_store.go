@@ -40,3 +40,58 @@ func GetMultiStoreProof(multiStoreEp *ics23.ExistenceProof) MultiStoreProof {
 		AuthToIcahostStoresMerkleHash:         tmbytes.HexBytes(multiStoreEp.Path[4].Prefix[1:]),
 	}
 }
+
+// MultiStoreMerklePathEthereum is an Ethereum version of MultiStoreMerklePath for solidity ABI-encoding.
+type MultiStoreMerklePathEthereum struct {
+	IsDataOnRight bool
+	SiblingHash   common.Hash
+}
+
+// ModuleStoreProofEthereum is an Ethereum version of ModuleStoreProof for solidity ABI-encoding.
+type ModuleStoreProofEthereum struct {
+	StoreKey      string
+	IavlStateHash common.Hash
+	MerklePaths   []MultiStoreMerklePathEthereum
+}
+
+func (m *ModuleStoreProof) encodeToEthFormat() ModuleStoreProofEthereum {
+	paths := make([]MultiStoreMerklePathEthereum, len(m.MerklePaths))
+	for i, path := range m.MerklePaths {
+		paths[i] = MultiStoreMerklePathEthereum{
+			IsDataOnRight: path.IsDataOnRight,
+			SiblingHash:   common.BytesToHash(path.SiblingHash),
+		}
+	}
+
+	return ModuleStoreProofEthereum{
+		StoreKey:      m.StoreKey,
+		IavlStateHash: common.BytesToHash(m.IAVLStateHash),
+		MerklePaths:   paths,
+	}
+}
+
+// GetModuleStoreProof compacts Multi store proof from Tendermint of the given store to ModuleStoreProof version.
+func GetModuleStoreProof(storeKey string, multiStoreEp *ics23.ExistenceProof) ModuleStoreProof {
+	paths := make([]MultiStoreMerklePath, 0, len(multiStoreEp.Path))
+	for _, step := range multiStoreEp.Path {
+		// An inner node of the multistore is hashed from 0x01 | left | right, so the sibling is in the
+		// prefix if it's on the left and is the suffix if it's on the right.
+		if len(step.Prefix) > 1 {
+			paths = append(paths, MultiStoreMerklePath{
+				IsDataOnRight: true,
+				SiblingHash:   tmbytes.HexBytes(step.Prefix[1:]),
+			})
+		} else {
+			paths = append(paths, MultiStoreMerklePath{
+				IsDataOnRight: false,
+				SiblingHash:   tmbytes.HexBytes(step.Suffix),
+			})
+		}
+	}
+
+	return ModuleStoreProof{
+		StoreKey:      storeKey,
+		IAVLStateHash: tmbytes.HexBytes(multiStoreEp.Value),
+		MerklePaths:   paths,
+	}
+}
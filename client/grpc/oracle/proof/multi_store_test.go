@@ -92,3 +92,47 @@ func TestGetMultiStoreProof(t *testing.T) {
 
 	require.Equal(t, expectAppHash, apphash)
 }
+
+func TestGetModuleStoreProof(t *testing.T) {
+	key := []byte("oracle")
+	data := base64ToBytes(
+		"Cv4BCgZvcmFjbGUSILWuAqi7QA1Y943STZKRzdhqI/oM/3sihXiy0WvbYyjtGgkIARgBIAEqAQAiJQgBEiEBXVOth1oJa51+x41GKsXabapY7uL7OJssOImGEiPT298iJwgBEgEBGiDAh+1I3Z5vtDdvCoqbjqdXtDqMS2OQUuNb9RLtMehqtiInCAESAQEaIDqK5Rpdb442lPE4nvtBIBBC+gc6duYo7pQ4EWrTGTZjIicIARIBARogxinvyHFSBjSggcRdbDRvGaaNqaNLpwZ36rA6wxPCgW8iJQgBEiEBPO/QdDCqwo6da1w04OySSpstZJxfeGYlKuwfwmlBFOI=",
+	)
+
+	proof := &ics23.CommitmentProof{}
+	err := proof.Unmarshal(data)
+	require.Nil(t, err)
+
+	multistoreEp := storetypes.NewSimpleMerkleCommitmentOp(key, proof).Proof.GetExist()
+	require.NotNil(t, multistoreEp)
+
+	var expectAppHash []byte
+	expectAppHash, err = multistoreEp.Calculate()
+	require.Nil(t, err)
+
+	m := GetModuleStoreProof("oracle", multistoreEp)
+	require.Equal(t, "oracle", m.StoreKey)
+	require.Len(t, m.MerklePaths, 5)
+
+	// The module store proof must give the same app hash as the multi store proof
+	multiStoreProof := GetMultiStoreProof(multistoreEp)
+	require.Equal(t, multiStoreProof.OracleIAVLStateHash, m.IAVLStateHash)
+	require.Equal(t, multiStoreProof.MintStoreMerkleHash, m.MerklePaths[0].SiblingHash)
+	require.Equal(t, multiStoreProof.AuthToIcahostStoresMerkleHash, m.MerklePaths[4].SiblingHash)
+
+	prefix := []byte{}
+	prefix = append(prefix, byte(len(m.StoreKey))) // key length
+	prefix = append(prefix, m.StoreKey...)         // store key
+	prefix = append(prefix, 32)                    // size of store hash must be 32
+
+	apphash := leafHash(append(prefix, tmhash.Sum(m.IAVLStateHash)...))
+	for _, path := range m.MerklePaths {
+		if path.IsDataOnRight {
+			apphash = innerHash(path.SiblingHash, apphash)
+		} else {
+			apphash = innerHash(apphash, path.SiblingHash)
+		}
+	}
+
+	require.Equal(t, expectAppHash, apphash)
+}
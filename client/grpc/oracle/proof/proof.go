@@ -2,6 +2,7 @@ package proof
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -15,12 +16,18 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
+
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
 )
 
 var (
-	relayArguments       abi.Arguments
-	verifyArguments      abi.Arguments
-	verifyCountArguments abi.Arguments
+	relayAndVerifyArguments     abi.Arguments
+	relayArguments              abi.Arguments
+	verifyArguments             abi.Arguments
+	verifyCountArguments        abi.Arguments
+	moduleRelayArguments        abi.Arguments
+	verifyPriceArguments        abi.Arguments
+	verifyTunnelPacketArguments abi.Arguments
 )
 
 const (
@@ -28,7 +35,11 @@ const (
 )
 
 func init() {
-	err := json.Unmarshal(relayFormat, &relayArguments)
+	err := json.Unmarshal(relayAndVerifyFormat, &relayAndVerifyArguments)
+	if err != nil {
+		panic(err)
+	}
+	err = json.Unmarshal(relayFormat, &relayArguments)
 	if err != nil {
 		panic(err)
 	}
@@ -40,6 +51,18 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	err = json.Unmarshal(moduleRelayFormat, &moduleRelayArguments)
+	if err != nil {
+		panic(err)
+	}
+	err = json.Unmarshal(verifyPriceFormat, &verifyPriceArguments)
+	if err != nil {
+		panic(err)
+	}
+	err = json.Unmarshal(verifyTunnelPacketFormat, &verifyTunnelPacketArguments)
+	if err != nil {
+		panic(err)
+	}
 }
 
 func (blockRelay *BlockRelayProof) encodeToEthData() ([]byte, error) {
@@ -81,6 +104,47 @@ func (o *RequestsCountProof) encodeToEthData(blockHeight uint64) ([]byte, error)
 	)
 }
 
+func (blockRelay *ModuleBlockRelayProof) encodeToEthData() ([]byte, error) {
+	parseSignatures := make([]TMSignatureEthereum, len(blockRelay.Signatures))
+	for i, sig := range blockRelay.Signatures {
+		parseSignatures[i] = sig.encodeToEthFormat()
+	}
+	return moduleRelayArguments.Pack(
+		blockRelay.ModuleStoreProof.encodeToEthFormat(),
+		blockRelay.BlockHeaderMerkleParts.encodeToEthFormat(),
+		blockRelay.CommonEncodedVotePart.encodeToEthFormat(),
+		parseSignatures,
+	)
+}
+
+func (o *PriceDataProof) encodeToEthData(blockHeight uint64) ([]byte, error) {
+	parsePaths := make([]IAVLMerklePathEthereum, len(o.MerklePaths))
+	for i, path := range o.MerklePaths {
+		parsePaths[i] = path.encodeToEthFormat()
+	}
+	return verifyPriceArguments.Pack(
+		big.NewInt(int64(blockHeight)),
+		transformPrice(o.Price),
+		big.NewInt(int64(o.Version)),
+		parsePaths,
+	)
+}
+
+func (o *TunnelPacketDataProof) encodeToEthData(blockHeight uint64) ([]byte, error) {
+	parsePaths := make([]IAVLMerklePathEthereum, len(o.MerklePaths))
+	for i, path := range o.MerklePaths {
+		parsePaths[i] = path.encodeToEthFormat()
+	}
+	return verifyTunnelPacketArguments.Pack(
+		big.NewInt(int64(blockHeight)),
+		o.Packet.TunnelID,
+		o.Packet.Sequence,
+		[]byte(o.EncodedPacket),
+		big.NewInt(int64(o.Version)),
+		parsePaths,
+	)
+}
+
 type CommonEncodedVotePartEthereum struct {
 	SignedDataPrefix []byte
 	SignedDataSuffix []byte
@@ -95,13 +159,14 @@ func (commonVote *CommonEncodedVotePart) encodeToEthFormat() CommonEncodedVotePa
 
 func getProofsByKey(
 	ctx client.Context,
+	storeKey string,
 	key []byte,
 	queryOptions rpcclient.ABCIQueryOptions,
 	getMultiStoreEp bool,
 ) ([]byte, *ics23.ExistenceProof, *ics23.ExistenceProof, error) {
 	resp, err := ctx.Client.ABCIQueryWithOptions(
 		context.Background(),
-		"/store/oracle/key",
+		fmt.Sprintf("/store/%s/key", storeKey),
 		key,
 		queryOptions,
 	)
@@ -159,3 +224,45 @@ func getProofsByKey(
 
 	return resp.Response.GetValue(), iavlEp, multiStoreEp, nil
 }
+
+// getResultProofsByKey returns the value and proofs of the result of the given request ID like getProofsByKey,
+// but returns a clear error if the result has been pruned by the result retention policy at the queried height.
+func getResultProofsByKey(
+	ctx client.Context,
+	requestID oracletypes.RequestID,
+	queryOptions rpcclient.ABCIQueryOptions,
+	getMultiStoreEp bool,
+) ([]byte, *ics23.ExistenceProof, *ics23.ExistenceProof, error) {
+	value, iavlEp, multiStoreEp, err := getProofsByKey(
+		ctx,
+		oracletypes.StoreKey,
+		oracletypes.ResultStoreKey(requestID),
+		queryOptions,
+		getMultiStoreEp,
+	)
+	if err == nil {
+		return value, iavlEp, multiStoreEp, nil
+	}
+
+	resp, queryErr := ctx.Client.ABCIQueryWithOptions(
+		context.Background(),
+		fmt.Sprintf("/store/%s/key", oracletypes.StoreKey),
+		oracletypes.ResultLastPrunedStoreKey,
+		rpcclient.ABCIQueryOptions{Height: queryOptions.Height},
+	)
+	if queryErr != nil || len(resp.Response.GetValue()) != 8 {
+		return nil, &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, err
+	}
+
+	lastPruned := oracletypes.RequestID(binary.BigEndian.Uint64(resp.Response.GetValue()))
+	if requestID <= lastPruned {
+		return nil, &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, oracletypes.ErrResultPruned.Wrapf(
+			"result of request %d has been pruned; results up to request %d are pruned at height %d",
+			requestID,
+			lastPruned,
+			queryOptions.Height,
+		)
+	}
+
+	return nil, &ics23.ExistenceProof{}, &ics23.ExistenceProof{}, err
+}
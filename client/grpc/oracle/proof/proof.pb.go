@@ -6,7 +6,9 @@ package proof
 import (
 	context "context"
 	fmt "fmt"
+	types1 "github.com/bandprotocol/chain/v3/x/feeds/types"
 	types "github.com/bandprotocol/chain/v3/x/oracle/types"
+	types2 "github.com/bandprotocol/chain/v3/x/tunnel/types"
 	github_com_cometbft_cometbft_libs_bytes "github.com/cometbft/cometbft/libs/bytes"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -330,6 +332,235 @@ func (m *RequestCountProofResponse) GetResult() CountProofResult {
 	return CountProofResult{}
 }
 
+// PriceProofRequest is request type for the Service/PriceProof RPC method.
+type PriceProofRequest struct {
+	// signal_id is the signal ID of the price
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// height is block height
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *PriceProofRequest) Reset()         { *m = PriceProofRequest{} }
+func (m *PriceProofRequest) String() string { return proto.CompactTextString(m) }
+func (*PriceProofRequest) ProtoMessage()    {}
+func (*PriceProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{6}
+}
+func (m *PriceProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceProofRequest.Merge(m, src)
+}
+func (m *PriceProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceProofRequest proto.InternalMessageInfo
+
+func (m *PriceProofRequest) GetSignalId() string {
+	if m != nil {
+		return m.SignalId
+	}
+	return ""
+}
+
+func (m *PriceProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// PriceProofResponse is response type for the Service/PriceProof RPC method.
+type PriceProofResponse struct {
+	// height is block height
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// result is the proof
+	Result PriceProofResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result"`
+}
+
+func (m *PriceProofResponse) Reset()         { *m = PriceProofResponse{} }
+func (m *PriceProofResponse) String() string { return proto.CompactTextString(m) }
+func (*PriceProofResponse) ProtoMessage()    {}
+func (*PriceProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{7}
+}
+func (m *PriceProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceProofResponse.Merge(m, src)
+}
+func (m *PriceProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceProofResponse proto.InternalMessageInfo
+
+func (m *PriceProofResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PriceProofResponse) GetResult() PriceProofResult {
+	if m != nil {
+		return m.Result
+	}
+	return PriceProofResult{}
+}
+
+// TunnelPacketProofRequest is request type for the Service/TunnelPacketProof RPC method.
+type TunnelPacketProofRequest struct {
+	// tunnel_id is the ID of the tunnel
+	TunnelId uint64 `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	// sequence is the sequence of the packet
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// height is block height
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *TunnelPacketProofRequest) Reset()         { *m = TunnelPacketProofRequest{} }
+func (m *TunnelPacketProofRequest) String() string { return proto.CompactTextString(m) }
+func (*TunnelPacketProofRequest) ProtoMessage()    {}
+func (*TunnelPacketProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{8}
+}
+func (m *TunnelPacketProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TunnelPacketProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TunnelPacketProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TunnelPacketProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TunnelPacketProofRequest.Merge(m, src)
+}
+func (m *TunnelPacketProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TunnelPacketProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TunnelPacketProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TunnelPacketProofRequest proto.InternalMessageInfo
+
+func (m *TunnelPacketProofRequest) GetTunnelId() uint64 {
+	if m != nil {
+		return m.TunnelId
+	}
+	return 0
+}
+
+func (m *TunnelPacketProofRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *TunnelPacketProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// TunnelPacketProofResponse is response type for the Service/TunnelPacketProof RPC method.
+type TunnelPacketProofResponse struct {
+	// height is block height
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// result is the proof
+	Result TunnelPacketProofResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result"`
+}
+
+func (m *TunnelPacketProofResponse) Reset()         { *m = TunnelPacketProofResponse{} }
+func (m *TunnelPacketProofResponse) String() string { return proto.CompactTextString(m) }
+func (*TunnelPacketProofResponse) ProtoMessage()    {}
+func (*TunnelPacketProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{9}
+}
+func (m *TunnelPacketProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TunnelPacketProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TunnelPacketProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TunnelPacketProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TunnelPacketProofResponse.Merge(m, src)
+}
+func (m *TunnelPacketProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TunnelPacketProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TunnelPacketProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TunnelPacketProofResponse proto.InternalMessageInfo
+
+func (m *TunnelPacketProofResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TunnelPacketProofResponse) GetResult() TunnelPacketProofResult {
+	if m != nil {
+		return m.Result
+	}
+	return TunnelPacketProofResult{}
+}
+
 // SingleProofResponse is the data structure for response of single proof
 type SingleProofResult struct {
 	Proof         SingleProof                                      `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
@@ -340,7 +571,7 @@ func (m *SingleProofResult) Reset()         { *m = SingleProofResult{} }
 func (m *SingleProofResult) String() string { return proto.CompactTextString(m) }
 func (*SingleProofResult) ProtoMessage()    {}
 func (*SingleProofResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{6}
+	return fileDescriptor_9deb752797273b09, []int{10}
 }
 func (m *SingleProofResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,7 +624,7 @@ func (m *MultiProofResult) Reset()         { *m = MultiProofResult{} }
 func (m *MultiProofResult) String() string { return proto.CompactTextString(m) }
 func (*MultiProofResult) ProtoMessage()    {}
 func (*MultiProofResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{7}
+	return fileDescriptor_9deb752797273b09, []int{11}
 }
 func (m *MultiProofResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,7 +677,7 @@ func (m *CountProofResult) Reset()         { *m = CountProofResult{} }
 func (m *CountProofResult) String() string { return proto.CompactTextString(m) }
 func (*CountProofResult) ProtoMessage()    {}
 func (*CountProofResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{8}
+	return fileDescriptor_9deb752797273b09, []int{12}
 }
 func (m *CountProofResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -489,6 +720,112 @@ func (m *CountProofResult) GetEvmProofBytes() github_com_cometbft_cometbft_libs_
 	return nil
 }
 
+// PriceProofResult is the data structure for response of price proof
+type PriceProofResult struct {
+	Proof         PriceProof                                       `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
+	EvmProofBytes github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"evm_proof_bytes,omitempty"`
+}
+
+func (m *PriceProofResult) Reset()         { *m = PriceProofResult{} }
+func (m *PriceProofResult) String() string { return proto.CompactTextString(m) }
+func (*PriceProofResult) ProtoMessage()    {}
+func (*PriceProofResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{13}
+}
+func (m *PriceProofResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceProofResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceProofResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceProofResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceProofResult.Merge(m, src)
+}
+func (m *PriceProofResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceProofResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceProofResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceProofResult proto.InternalMessageInfo
+
+func (m *PriceProofResult) GetProof() PriceProof {
+	if m != nil {
+		return m.Proof
+	}
+	return PriceProof{}
+}
+
+func (m *PriceProofResult) GetEvmProofBytes() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EvmProofBytes
+	}
+	return nil
+}
+
+// TunnelPacketProofResult is the data structure for response of tunnel packet proof
+type TunnelPacketProofResult struct {
+	Proof         TunnelPacketProof                                `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
+	EvmProofBytes github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=evm_proof_bytes,json=evmProofBytes,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"evm_proof_bytes,omitempty"`
+}
+
+func (m *TunnelPacketProofResult) Reset()         { *m = TunnelPacketProofResult{} }
+func (m *TunnelPacketProofResult) String() string { return proto.CompactTextString(m) }
+func (*TunnelPacketProofResult) ProtoMessage()    {}
+func (*TunnelPacketProofResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{14}
+}
+func (m *TunnelPacketProofResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TunnelPacketProofResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TunnelPacketProofResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TunnelPacketProofResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TunnelPacketProofResult.Merge(m, src)
+}
+func (m *TunnelPacketProofResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TunnelPacketProofResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TunnelPacketProofResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TunnelPacketProofResult proto.InternalMessageInfo
+
+func (m *TunnelPacketProofResult) GetProof() TunnelPacketProof {
+	if m != nil {
+		return m.Proof
+	}
+	return TunnelPacketProof{}
+}
+
+func (m *TunnelPacketProofResult) GetEvmProofBytes() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EvmProofBytes
+	}
+	return nil
+}
+
 // SingleProof contains block height, oracle data proof and block relay proof
 type SingleProof struct {
 	BlockHeight     uint64          `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
@@ -500,7 +837,7 @@ func (m *SingleProof) Reset()         { *m = SingleProof{} }
 func (m *SingleProof) String() string { return proto.CompactTextString(m) }
 func (*SingleProof) ProtoMessage()    {}
 func (*SingleProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{9}
+	return fileDescriptor_9deb752797273b09, []int{15}
 }
 func (m *SingleProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,7 +898,7 @@ func (m *MultiProof) Reset()         { *m = MultiProof{} }
 func (m *MultiProof) String() string { return proto.CompactTextString(m) }
 func (*MultiProof) ProtoMessage()    {}
 func (*MultiProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{10}
+	return fileDescriptor_9deb752797273b09, []int{16}
 }
 func (m *MultiProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -622,7 +959,7 @@ func (m *CountProof) Reset()         { *m = CountProof{} }
 func (m *CountProof) String() string { return proto.CompactTextString(m) }
 func (*CountProof) ProtoMessage()    {}
 func (*CountProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{11}
+	return fileDescriptor_9deb752797273b09, []int{17}
 }
 func (m *CountProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -672,25 +1009,25 @@ func (m *CountProof) GetBlockRelayProof() BlockRelayProof {
 	return BlockRelayProof{}
 }
 
-// OracleDataProof contains result, version and merkle paths
-type OracleDataProof struct {
-	Result      types.Result     `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
-	Version     uint64           `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	MerklePaths []IAVLMerklePath `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths"`
+// PriceProof contains block height, price data proof and module block relay proof
+type PriceProof struct {
+	BlockHeight     uint64                `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	PriceDataProof  PriceDataProof        `protobuf:"bytes,2,opt,name=price_data_proof,json=priceDataProof,proto3" json:"price_data_proof"`
+	BlockRelayProof ModuleBlockRelayProof `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof"`
 }
 
-func (m *OracleDataProof) Reset()         { *m = OracleDataProof{} }
-func (m *OracleDataProof) String() string { return proto.CompactTextString(m) }
-func (*OracleDataProof) ProtoMessage()    {}
-func (*OracleDataProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{12}
+func (m *PriceProof) Reset()         { *m = PriceProof{} }
+func (m *PriceProof) String() string { return proto.CompactTextString(m) }
+func (*PriceProof) ProtoMessage()    {}
+func (*PriceProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{18}
 }
-func (m *OracleDataProof) XXX_Unmarshal(b []byte) error {
+func (m *PriceProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OracleDataProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PriceProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OracleDataProof.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PriceProof.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -700,35 +1037,288 @@ func (m *OracleDataProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *OracleDataProof) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OracleDataProof.Merge(m, src)
+func (m *PriceProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceProof.Merge(m, src)
 }
-func (m *OracleDataProof) XXX_Size() int {
+func (m *PriceProof) XXX_Size() int {
 	return m.Size()
 }
-func (m *OracleDataProof) XXX_DiscardUnknown() {
-	xxx_messageInfo_OracleDataProof.DiscardUnknown(m)
+func (m *PriceProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceProof.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OracleDataProof proto.InternalMessageInfo
+var xxx_messageInfo_PriceProof proto.InternalMessageInfo
 
-func (m *OracleDataProof) GetResult() types.Result {
+func (m *PriceProof) GetBlockHeight() uint64 {
 	if m != nil {
-		return m.Result
+		return m.BlockHeight
 	}
-	return types.Result{}
+	return 0
 }
 
-func (m *OracleDataProof) GetVersion() uint64 {
+func (m *PriceProof) GetPriceDataProof() PriceDataProof {
 	if m != nil {
-		return m.Version
+		return m.PriceDataProof
 	}
-	return 0
+	return PriceDataProof{}
 }
 
-func (m *OracleDataProof) GetMerklePaths() []IAVLMerklePath {
+func (m *PriceProof) GetBlockRelayProof() ModuleBlockRelayProof {
 	if m != nil {
-		return m.MerklePaths
+		return m.BlockRelayProof
+	}
+	return ModuleBlockRelayProof{}
+}
+
+// TunnelPacketProof contains block height, tunnel packet data proof and module block relay proof
+type TunnelPacketProof struct {
+	BlockHeight     uint64                `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	PacketDataProof TunnelPacketDataProof `protobuf:"bytes,2,opt,name=packet_data_proof,json=packetDataProof,proto3" json:"packet_data_proof"`
+	BlockRelayProof ModuleBlockRelayProof `protobuf:"bytes,3,opt,name=block_relay_proof,json=blockRelayProof,proto3" json:"block_relay_proof"`
+}
+
+func (m *TunnelPacketProof) Reset()         { *m = TunnelPacketProof{} }
+func (m *TunnelPacketProof) String() string { return proto.CompactTextString(m) }
+func (*TunnelPacketProof) ProtoMessage()    {}
+func (*TunnelPacketProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{19}
+}
+func (m *TunnelPacketProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TunnelPacketProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TunnelPacketProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TunnelPacketProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TunnelPacketProof.Merge(m, src)
+}
+func (m *TunnelPacketProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *TunnelPacketProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_TunnelPacketProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TunnelPacketProof proto.InternalMessageInfo
+
+func (m *TunnelPacketProof) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *TunnelPacketProof) GetPacketDataProof() TunnelPacketDataProof {
+	if m != nil {
+		return m.PacketDataProof
+	}
+	return TunnelPacketDataProof{}
+}
+
+func (m *TunnelPacketProof) GetBlockRelayProof() ModuleBlockRelayProof {
+	if m != nil {
+		return m.BlockRelayProof
+	}
+	return ModuleBlockRelayProof{}
+}
+
+// OracleDataProof contains result, version and merkle paths
+type OracleDataProof struct {
+	Result      types.Result     `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
+	Version     uint64           `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	MerklePaths []IAVLMerklePath `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths"`
+}
+
+func (m *OracleDataProof) Reset()         { *m = OracleDataProof{} }
+func (m *OracleDataProof) String() string { return proto.CompactTextString(m) }
+func (*OracleDataProof) ProtoMessage()    {}
+func (*OracleDataProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{20}
+}
+func (m *OracleDataProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleDataProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleDataProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleDataProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleDataProof.Merge(m, src)
+}
+func (m *OracleDataProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleDataProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleDataProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleDataProof proto.InternalMessageInfo
+
+func (m *OracleDataProof) GetResult() types.Result {
+	if m != nil {
+		return m.Result
+	}
+	return types.Result{}
+}
+
+func (m *OracleDataProof) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *OracleDataProof) GetMerklePaths() []IAVLMerklePath {
+	if m != nil {
+		return m.MerklePaths
+	}
+	return nil
+}
+
+// PriceDataProof contains price, version and merkle paths
+type PriceDataProof struct {
+	Price       types1.Price     `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+	Version     uint64           `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	MerklePaths []IAVLMerklePath `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths"`
+}
+
+func (m *PriceDataProof) Reset()         { *m = PriceDataProof{} }
+func (m *PriceDataProof) String() string { return proto.CompactTextString(m) }
+func (*PriceDataProof) ProtoMessage()    {}
+func (*PriceDataProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{21}
+}
+func (m *PriceDataProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceDataProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceDataProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceDataProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceDataProof.Merge(m, src)
+}
+func (m *PriceDataProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceDataProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceDataProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceDataProof proto.InternalMessageInfo
+
+func (m *PriceDataProof) GetPrice() types1.Price {
+	if m != nil {
+		return m.Price
+	}
+	return types1.Price{}
+}
+
+func (m *PriceDataProof) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *PriceDataProof) GetMerklePaths() []IAVLMerklePath {
+	if m != nil {
+		return m.MerklePaths
+	}
+	return nil
+}
+
+// TunnelPacketDataProof contains packet, its encoded bytes in the store, version and merkle paths. The
+// encoded packet is used in the EVM proof since the receipt of the packet cannot be ABI-encoded.
+type TunnelPacketDataProof struct {
+	Packet        types2.Packet                                    `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+	EncodedPacket github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=encoded_packet,json=encodedPacket,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"encoded_packet,omitempty"`
+	Version       uint64                                           `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	MerklePaths   []IAVLMerklePath                                 `protobuf:"bytes,4,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths"`
+}
+
+func (m *TunnelPacketDataProof) Reset()         { *m = TunnelPacketDataProof{} }
+func (m *TunnelPacketDataProof) String() string { return proto.CompactTextString(m) }
+func (*TunnelPacketDataProof) ProtoMessage()    {}
+func (*TunnelPacketDataProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{22}
+}
+func (m *TunnelPacketDataProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TunnelPacketDataProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TunnelPacketDataProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TunnelPacketDataProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TunnelPacketDataProof.Merge(m, src)
+}
+func (m *TunnelPacketDataProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *TunnelPacketDataProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_TunnelPacketDataProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TunnelPacketDataProof proto.InternalMessageInfo
+
+func (m *TunnelPacketDataProof) GetPacket() types2.Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return types2.Packet{}
+}
+
+func (m *TunnelPacketDataProof) GetEncodedPacket() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EncodedPacket
+	}
+	return nil
+}
+
+func (m *TunnelPacketDataProof) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *TunnelPacketDataProof) GetMerklePaths() []IAVLMerklePath {
+	if m != nil {
+		return m.MerklePaths
 	}
 	return nil
 }
@@ -746,7 +1336,7 @@ func (m *IAVLMerklePath) Reset()         { *m = IAVLMerklePath{} }
 func (m *IAVLMerklePath) String() string { return proto.CompactTextString(m) }
 func (*IAVLMerklePath) ProtoMessage()    {}
 func (*IAVLMerklePath) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{13}
+	return fileDescriptor_9deb752797273b09, []int{23}
 }
 func (m *IAVLMerklePath) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -822,7 +1412,7 @@ func (m *BlockRelayProof) Reset()         { *m = BlockRelayProof{} }
 func (m *BlockRelayProof) String() string { return proto.CompactTextString(m) }
 func (*BlockRelayProof) ProtoMessage()    {}
 func (*BlockRelayProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{14}
+	return fileDescriptor_9deb752797273b09, []int{24}
 }
 func (m *BlockRelayProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -916,7 +1506,7 @@ func (m *MultiStoreProof) Reset()         { *m = MultiStoreProof{} }
 func (m *MultiStoreProof) String() string { return proto.CompactTextString(m) }
 func (*MultiStoreProof) ProtoMessage()    {}
 func (*MultiStoreProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{15}
+	return fileDescriptor_9deb752797273b09, []int{25}
 }
 func (m *MultiStoreProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -987,55 +1577,28 @@ func (m *MultiStoreProof) GetAuthToIcahostStoresMerkleHash() github_com_cometbft
 	return nil
 }
 
-// BlockHeaderMerkleParts stores a group of hashes using for computing Tendermint's block
-// header hash from app hash, and height.
-//
-// In Tendermint, a block header hash is the Merkle hash of a binary tree with 14 leaf nodes.
-// Each node encodes a data piece of the blockchain. The notable data leaves are: [A] app_hash,
-// [2] height. All data pieces are combined into one 32-byte hash to be signed
-// by block validators. The structure of the Merkle tree is shown below.
-//
-//	                                 [BlockHeader]
-//	                              /                \
-//	                 [3A]                                    [3B]
-//	               /      \                                /      \
-//	       [2A]                [2B]                [2C]                [2D]
-//	      /    \              /    \              /    \              /    \
-//	  [1A]      [1B]      [1C]      [1D]      [1E]      [1F]        [C]    [D]
-//	  /  \      /  \      /  \      /  \      /  \      /  \
-//	[0]  [1]  [2]  [3]  [4]  [5]  [6]  [7]  [8]  [9]  [A]  [B]
-//
-//	[0] - version               [1] - chain_id            [2] - height        [3] - time
-//	[4] - last_block_id         [5] - last_commit_hash    [6] - data_hash     [7] - validators_hash
-//	[8] - next_validators_hash  [9] - consensus_hash      [A] - app_hash      [B] - last_results_hash
-//	[C] - evidence_hash         [D] - proposer_address
-//
-// Notice that NOT all leaves of the Merkle tree are needed in order to compute the Merkle
-// root hash, since we only want to validate the correctness of [2], [3], and [A]. In fact, only
-// [1A], [2B], [1E], [B], and [2D] are needed in order to compute [BlockHeader].
-type BlockHeaderMerkleParts struct {
-	VersionAndChainIdHash             github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=version_and_chain_id_hash,json=versionAndChainIdHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"version_and_chain_id_hash,omitempty"`
-	Height                            uint64                                           `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	TimeSecond                        uint64                                           `protobuf:"varint,3,opt,name=time_second,json=timeSecond,proto3" json:"time_second,omitempty"`
-	TimeNanoSecond                    uint32                                           `protobuf:"varint,4,opt,name=time_nano_second,json=timeNanoSecond,proto3" json:"time_nano_second,omitempty"`
-	LastBlockIdAndOther               github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,5,opt,name=last_block_id_and_other,json=lastBlockIdAndOther,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"last_block_id_and_other,omitempty"`
-	NextValidatorHashAndConsensusHash github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,6,opt,name=next_validator_hash_and_consensus_hash,json=nextValidatorHashAndConsensusHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"next_validator_hash_and_consensus_hash,omitempty"`
-	LastResultsHash                   github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,7,opt,name=last_results_hash,json=lastResultsHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"last_results_hash,omitempty"`
-	EvidenceAndProposerHash           github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,8,opt,name=evidence_and_proposer_hash,json=evidenceAndProposerHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"evidence_and_proposer_hash,omitempty"`
+// ModuleBlockRelayProof is a BlockRelayProof of a module store whose position in the multistore differs
+// from the oracle store. It contains module store proof, block header merkle parts, common encoded part
+// and signatures
+type ModuleBlockRelayProof struct {
+	ModuleStoreProof       ModuleStoreProof       `protobuf:"bytes,1,opt,name=module_store_proof,json=moduleStoreProof,proto3" json:"module_store_proof"`
+	BlockHeaderMerkleParts BlockHeaderMerkleParts `protobuf:"bytes,2,opt,name=block_header_merkle_parts,json=blockHeaderMerkleParts,proto3" json:"block_header_merkle_parts"`
+	CommonEncodedVotePart  CommonEncodedVotePart  `protobuf:"bytes,3,opt,name=common_encoded_vote_part,json=commonEncodedVotePart,proto3" json:"common_encoded_vote_part"`
+	Signatures             []TMSignature          `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures"`
 }
 
-func (m *BlockHeaderMerkleParts) Reset()         { *m = BlockHeaderMerkleParts{} }
-func (m *BlockHeaderMerkleParts) String() string { return proto.CompactTextString(m) }
-func (*BlockHeaderMerkleParts) ProtoMessage()    {}
-func (*BlockHeaderMerkleParts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{16}
+func (m *ModuleBlockRelayProof) Reset()         { *m = ModuleBlockRelayProof{} }
+func (m *ModuleBlockRelayProof) String() string { return proto.CompactTextString(m) }
+func (*ModuleBlockRelayProof) ProtoMessage()    {}
+func (*ModuleBlockRelayProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{26}
 }
-func (m *BlockHeaderMerkleParts) XXX_Unmarshal(b []byte) error {
+func (m *ModuleBlockRelayProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BlockHeaderMerkleParts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ModuleBlockRelayProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BlockHeaderMerkleParts.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ModuleBlockRelayProof.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1045,54 +1608,268 @@ func (m *BlockHeaderMerkleParts) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *BlockHeaderMerkleParts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockHeaderMerkleParts.Merge(m, src)
+func (m *ModuleBlockRelayProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleBlockRelayProof.Merge(m, src)
 }
-func (m *BlockHeaderMerkleParts) XXX_Size() int {
+func (m *ModuleBlockRelayProof) XXX_Size() int {
 	return m.Size()
 }
-func (m *BlockHeaderMerkleParts) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockHeaderMerkleParts.DiscardUnknown(m)
+func (m *ModuleBlockRelayProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleBlockRelayProof.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockHeaderMerkleParts proto.InternalMessageInfo
+var xxx_messageInfo_ModuleBlockRelayProof proto.InternalMessageInfo
 
-func (m *BlockHeaderMerkleParts) GetVersionAndChainIdHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+func (m *ModuleBlockRelayProof) GetModuleStoreProof() ModuleStoreProof {
 	if m != nil {
-		return m.VersionAndChainIdHash
+		return m.ModuleStoreProof
 	}
-	return nil
+	return ModuleStoreProof{}
 }
 
-func (m *BlockHeaderMerkleParts) GetHeight() uint64 {
+func (m *ModuleBlockRelayProof) GetBlockHeaderMerkleParts() BlockHeaderMerkleParts {
 	if m != nil {
-		return m.Height
+		return m.BlockHeaderMerkleParts
 	}
-	return 0
+	return BlockHeaderMerkleParts{}
 }
 
-func (m *BlockHeaderMerkleParts) GetTimeSecond() uint64 {
+func (m *ModuleBlockRelayProof) GetCommonEncodedVotePart() CommonEncodedVotePart {
 	if m != nil {
-		return m.TimeSecond
+		return m.CommonEncodedVotePart
 	}
-	return 0
+	return CommonEncodedVotePart{}
 }
 
-func (m *BlockHeaderMerkleParts) GetTimeNanoSecond() uint32 {
+func (m *ModuleBlockRelayProof) GetSignatures() []TMSignature {
 	if m != nil {
-		return m.TimeNanoSecond
+		return m.Signatures
 	}
-	return 0
+	return nil
 }
 
-func (m *BlockHeaderMerkleParts) GetLastBlockIdAndOther() github_com_cometbft_cometbft_libs_bytes.HexBytes {
-	if m != nil {
-		return m.LastBlockIdAndOther
-	}
-	return nil
+// ModuleStoreProof stores the hash of a module store and the Merkle path from the leaf of the store in
+// the multistore to compute Tendermint's application state hash at a given block. Unlike MultiStoreProof,
+// it doesn't depend on the position of the store in the multistore tree shown above.
+type ModuleStoreProof struct {
+	StoreKey      string                                           `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	IAVLStateHash github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=iavl_state_hash,json=iavlStateHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"iavl_state_hash,omitempty"`
+	MerklePaths   []MultiStoreMerklePath                           `protobuf:"bytes,3,rep,name=merkle_paths,json=merklePaths,proto3" json:"merkle_paths"`
 }
 
-func (m *BlockHeaderMerkleParts) GetNextValidatorHashAndConsensusHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+func (m *ModuleStoreProof) Reset()         { *m = ModuleStoreProof{} }
+func (m *ModuleStoreProof) String() string { return proto.CompactTextString(m) }
+func (*ModuleStoreProof) ProtoMessage()    {}
+func (*ModuleStoreProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{27}
+}
+func (m *ModuleStoreProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleStoreProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleStoreProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleStoreProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleStoreProof.Merge(m, src)
+}
+func (m *ModuleStoreProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleStoreProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleStoreProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleStoreProof proto.InternalMessageInfo
+
+func (m *ModuleStoreProof) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *ModuleStoreProof) GetIAVLStateHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.IAVLStateHash
+	}
+	return nil
+}
+
+func (m *ModuleStoreProof) GetMerklePaths() []MultiStoreMerklePath {
+	if m != nil {
+		return m.MerklePaths
+	}
+	return nil
+}
+
+// MultiStoreMerklePath represents a Merkle step from a store leaf to the root of the multistore tree.
+type MultiStoreMerklePath struct {
+	IsDataOnRight bool                                             `protobuf:"varint,1,opt,name=is_data_on_right,json=isDataOnRight,proto3" json:"is_data_on_right,omitempty"`
+	SiblingHash   github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=sibling_hash,json=siblingHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"sibling_hash,omitempty"`
+}
+
+func (m *MultiStoreMerklePath) Reset()         { *m = MultiStoreMerklePath{} }
+func (m *MultiStoreMerklePath) String() string { return proto.CompactTextString(m) }
+func (*MultiStoreMerklePath) ProtoMessage()    {}
+func (*MultiStoreMerklePath) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{28}
+}
+func (m *MultiStoreMerklePath) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiStoreMerklePath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiStoreMerklePath.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiStoreMerklePath) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiStoreMerklePath.Merge(m, src)
+}
+func (m *MultiStoreMerklePath) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiStoreMerklePath) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiStoreMerklePath.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiStoreMerklePath proto.InternalMessageInfo
+
+func (m *MultiStoreMerklePath) GetIsDataOnRight() bool {
+	if m != nil {
+		return m.IsDataOnRight
+	}
+	return false
+}
+
+func (m *MultiStoreMerklePath) GetSiblingHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.SiblingHash
+	}
+	return nil
+}
+
+// BlockHeaderMerkleParts stores a group of hashes using for computing Tendermint's block
+// header hash from app hash, and height.
+//
+// In Tendermint, a block header hash is the Merkle hash of a binary tree with 14 leaf nodes.
+// Each node encodes a data piece of the blockchain. The notable data leaves are: [A] app_hash,
+// [2] height. All data pieces are combined into one 32-byte hash to be signed
+// by block validators. The structure of the Merkle tree is shown below.
+//
+//	                                 [BlockHeader]
+//	                              /                \
+//	                 [3A]                                    [3B]
+//	               /      \                                /      \
+//	       [2A]                [2B]                [2C]                [2D]
+//	      /    \              /    \              /    \              /    \
+//	  [1A]      [1B]      [1C]      [1D]      [1E]      [1F]        [C]    [D]
+//	  /  \      /  \      /  \      /  \      /  \      /  \
+//	[0]  [1]  [2]  [3]  [4]  [5]  [6]  [7]  [8]  [9]  [A]  [B]
+//
+//	[0] - version               [1] - chain_id            [2] - height        [3] - time
+//	[4] - last_block_id         [5] - last_commit_hash    [6] - data_hash     [7] - validators_hash
+//	[8] - next_validators_hash  [9] - consensus_hash      [A] - app_hash      [B] - last_results_hash
+//	[C] - evidence_hash         [D] - proposer_address
+//
+// Notice that NOT all leaves of the Merkle tree are needed in order to compute the Merkle
+// root hash, since we only want to validate the correctness of [2], [3], and [A]. In fact, only
+// [1A], [2B], [1E], [B], and [2D] are needed in order to compute [BlockHeader].
+type BlockHeaderMerkleParts struct {
+	VersionAndChainIdHash             github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=version_and_chain_id_hash,json=versionAndChainIdHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"version_and_chain_id_hash,omitempty"`
+	Height                            uint64                                           `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	TimeSecond                        uint64                                           `protobuf:"varint,3,opt,name=time_second,json=timeSecond,proto3" json:"time_second,omitempty"`
+	TimeNanoSecond                    uint32                                           `protobuf:"varint,4,opt,name=time_nano_second,json=timeNanoSecond,proto3" json:"time_nano_second,omitempty"`
+	LastBlockIdAndOther               github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,5,opt,name=last_block_id_and_other,json=lastBlockIdAndOther,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"last_block_id_and_other,omitempty"`
+	NextValidatorHashAndConsensusHash github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,6,opt,name=next_validator_hash_and_consensus_hash,json=nextValidatorHashAndConsensusHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"next_validator_hash_and_consensus_hash,omitempty"`
+	LastResultsHash                   github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,7,opt,name=last_results_hash,json=lastResultsHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"last_results_hash,omitempty"`
+	EvidenceAndProposerHash           github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,8,opt,name=evidence_and_proposer_hash,json=evidenceAndProposerHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"evidence_and_proposer_hash,omitempty"`
+}
+
+func (m *BlockHeaderMerkleParts) Reset()         { *m = BlockHeaderMerkleParts{} }
+func (m *BlockHeaderMerkleParts) String() string { return proto.CompactTextString(m) }
+func (*BlockHeaderMerkleParts) ProtoMessage()    {}
+func (*BlockHeaderMerkleParts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9deb752797273b09, []int{29}
+}
+func (m *BlockHeaderMerkleParts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockHeaderMerkleParts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockHeaderMerkleParts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockHeaderMerkleParts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHeaderMerkleParts.Merge(m, src)
+}
+func (m *BlockHeaderMerkleParts) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockHeaderMerkleParts) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHeaderMerkleParts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockHeaderMerkleParts proto.InternalMessageInfo
+
+func (m *BlockHeaderMerkleParts) GetVersionAndChainIdHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.VersionAndChainIdHash
+	}
+	return nil
+}
+
+func (m *BlockHeaderMerkleParts) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockHeaderMerkleParts) GetTimeSecond() uint64 {
+	if m != nil {
+		return m.TimeSecond
+	}
+	return 0
+}
+
+func (m *BlockHeaderMerkleParts) GetTimeNanoSecond() uint32 {
+	if m != nil {
+		return m.TimeNanoSecond
+	}
+	return 0
+}
+
+func (m *BlockHeaderMerkleParts) GetLastBlockIdAndOther() github_com_cometbft_cometbft_libs_bytes.HexBytes {
+	if m != nil {
+		return m.LastBlockIdAndOther
+	}
+	return nil
+}
+
+func (m *BlockHeaderMerkleParts) GetNextValidatorHashAndConsensusHash() github_com_cometbft_cometbft_libs_bytes.HexBytes {
 	if m != nil {
 		return m.NextValidatorHashAndConsensusHash
 	}
@@ -1123,7 +1900,7 @@ func (m *CommonEncodedVotePart) Reset()         { *m = CommonEncodedVotePart{} }
 func (m *CommonEncodedVotePart) String() string { return proto.CompactTextString(m) }
 func (*CommonEncodedVotePart) ProtoMessage()    {}
 func (*CommonEncodedVotePart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{17}
+	return fileDescriptor_9deb752797273b09, []int{30}
 }
 func (m *CommonEncodedVotePart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1182,7 +1959,7 @@ func (m *TMSignature) Reset()         { *m = TMSignature{} }
 func (m *TMSignature) String() string { return proto.CompactTextString(m) }
 func (*TMSignature) ProtoMessage()    {}
 func (*TMSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{18}
+	return fileDescriptor_9deb752797273b09, []int{31}
 }
 func (m *TMSignature) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1250,7 +2027,7 @@ func (m *RequestsCountProof) Reset()         { *m = RequestsCountProof{} }
 func (m *RequestsCountProof) String() string { return proto.CompactTextString(m) }
 func (*RequestsCountProof) ProtoMessage()    {}
 func (*RequestsCountProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9deb752797273b09, []int{19}
+	return fileDescriptor_9deb752797273b09, []int{32}
 }
 func (m *RequestsCountProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1307,16 +2084,29 @@ func init() {
 	proto.RegisterType((*MultiProofResponse)(nil), "band.base.oracle.v1.MultiProofResponse")
 	proto.RegisterType((*RequestCountProofRequest)(nil), "band.base.oracle.v1.RequestCountProofRequest")
 	proto.RegisterType((*RequestCountProofResponse)(nil), "band.base.oracle.v1.RequestCountProofResponse")
+	proto.RegisterType((*PriceProofRequest)(nil), "band.base.oracle.v1.PriceProofRequest")
+	proto.RegisterType((*PriceProofResponse)(nil), "band.base.oracle.v1.PriceProofResponse")
+	proto.RegisterType((*TunnelPacketProofRequest)(nil), "band.base.oracle.v1.TunnelPacketProofRequest")
+	proto.RegisterType((*TunnelPacketProofResponse)(nil), "band.base.oracle.v1.TunnelPacketProofResponse")
 	proto.RegisterType((*SingleProofResult)(nil), "band.base.oracle.v1.SingleProofResult")
 	proto.RegisterType((*MultiProofResult)(nil), "band.base.oracle.v1.MultiProofResult")
 	proto.RegisterType((*CountProofResult)(nil), "band.base.oracle.v1.CountProofResult")
+	proto.RegisterType((*PriceProofResult)(nil), "band.base.oracle.v1.PriceProofResult")
+	proto.RegisterType((*TunnelPacketProofResult)(nil), "band.base.oracle.v1.TunnelPacketProofResult")
 	proto.RegisterType((*SingleProof)(nil), "band.base.oracle.v1.SingleProof")
 	proto.RegisterType((*MultiProof)(nil), "band.base.oracle.v1.MultiProof")
 	proto.RegisterType((*CountProof)(nil), "band.base.oracle.v1.CountProof")
+	proto.RegisterType((*PriceProof)(nil), "band.base.oracle.v1.PriceProof")
+	proto.RegisterType((*TunnelPacketProof)(nil), "band.base.oracle.v1.TunnelPacketProof")
 	proto.RegisterType((*OracleDataProof)(nil), "band.base.oracle.v1.OracleDataProof")
+	proto.RegisterType((*PriceDataProof)(nil), "band.base.oracle.v1.PriceDataProof")
+	proto.RegisterType((*TunnelPacketDataProof)(nil), "band.base.oracle.v1.TunnelPacketDataProof")
 	proto.RegisterType((*IAVLMerklePath)(nil), "band.base.oracle.v1.IAVLMerklePath")
 	proto.RegisterType((*BlockRelayProof)(nil), "band.base.oracle.v1.BlockRelayProof")
 	proto.RegisterType((*MultiStoreProof)(nil), "band.base.oracle.v1.MultiStoreProof")
+	proto.RegisterType((*ModuleBlockRelayProof)(nil), "band.base.oracle.v1.ModuleBlockRelayProof")
+	proto.RegisterType((*ModuleStoreProof)(nil), "band.base.oracle.v1.ModuleStoreProof")
+	proto.RegisterType((*MultiStoreMerklePath)(nil), "band.base.oracle.v1.MultiStoreMerklePath")
 	proto.RegisterType((*BlockHeaderMerkleParts)(nil), "band.base.oracle.v1.BlockHeaderMerkleParts")
 	proto.RegisterType((*CommonEncodedVotePart)(nil), "band.base.oracle.v1.CommonEncodedVotePart")
 	proto.RegisterType((*TMSignature)(nil), "band.base.oracle.v1.TMSignature")
@@ -1326,105 +2116,135 @@ func init() {
 func init() { proto.RegisterFile("band/base/oracle/v1/proof.proto", fileDescriptor_9deb752797273b09) }
 
 var fileDescriptor_9deb752797273b09 = []byte{
-	// 1562 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x5d, 0x6f, 0x13, 0xc7,
-	0x1a, 0xce, 0xc6, 0x4e, 0xe0, 0xbc, 0x4e, 0x48, 0x32, 0x81, 0x60, 0x72, 0x20, 0x4e, 0xf6, 0x00,
-	0x09, 0xa0, 0x63, 0x1f, 0x3e, 0x6e, 0x8e, 0xce, 0xb9, 0x49, 0x80, 0x2a, 0x51, 0xf9, 0x92, 0x9d,
-	0xa6, 0x52, 0x55, 0x69, 0x3b, 0xde, 0x9d, 0xd8, 0x53, 0xd6, 0x33, 0x66, 0x67, 0x6c, 0x05, 0x2a,
-	0x44, 0xd5, 0xaa, 0xad, 0xd4, 0x9b, 0x56, 0xed, 0x45, 0x7f, 0x41, 0x2f, 0x2b, 0xee, 0x7a, 0xd5,
-	0x1f, 0xc0, 0x25, 0x52, 0x2f, 0xda, 0x2b, 0x8a, 0x42, 0xaf, 0xfa, 0x13, 0x7a, 0x55, 0xcd, 0xc7,
-	0xda, 0x6b, 0x7b, 0x21, 0x29, 0x06, 0xee, 0x76, 0x67, 0xdf, 0x79, 0x9e, 0xe7, 0xfd, 0x98, 0x77,
-	0x67, 0x06, 0x0a, 0x55, 0xcc, 0x82, 0x52, 0x15, 0x0b, 0x52, 0xe2, 0x11, 0xf6, 0x43, 0x52, 0x6a,
-	0x9f, 0x2f, 0x35, 0x23, 0xce, 0xb7, 0x8b, 0xcd, 0x88, 0x4b, 0x8e, 0x66, 0x95, 0x41, 0x51, 0x19,
-	0x14, 0x8d, 0x41, 0xb1, 0x7d, 0x7e, 0xfe, 0x78, 0x8d, 0xf3, 0x5a, 0x48, 0x4a, 0xb8, 0x49, 0x4b,
-	0x98, 0x31, 0x2e, 0xb1, 0xa4, 0x9c, 0x09, 0x33, 0x65, 0xfe, 0x70, 0x8d, 0xd7, 0xb8, 0x7e, 0x2c,
-	0xa9, 0x27, 0x3b, 0xfa, 0x4f, 0xcd, 0xd4, 0x25, 0xb1, 0x68, 0xfa, 0xa3, 0x7b, 0x15, 0x26, 0x6e,
-	0x29, 0xd2, 0x32, 0xb9, 0xd3, 0x22, 0x42, 0xa2, 0x13, 0x00, 0x91, 0x79, 0xf4, 0x68, 0x90, 0x77,
-	0x16, 0x9d, 0x95, 0x6c, 0xf9, 0x1f, 0x76, 0x64, 0x23, 0x40, 0x73, 0x30, 0x5e, 0x27, 0xb4, 0x56,
-	0x97, 0xf9, 0xd1, 0x45, 0x67, 0x25, 0x53, 0xb6, 0x6f, 0x6e, 0x03, 0x26, 0x2d, 0x8c, 0x68, 0x72,
-	0x26, 0x48, 0xc2, 0xd0, 0x49, 0x1a, 0xa2, 0x2b, 0x30, 0x1e, 0x11, 0xd1, 0x0a, 0x0d, 0x40, 0xee,
-	0xc2, 0xe9, 0x62, 0x8a, 0x9b, 0xc5, 0x0a, 0x65, 0xb5, 0x90, 0xc4, 0x88, 0xad, 0x50, 0xae, 0x65,
-	0x1f, 0x3d, 0x29, 0x8c, 0x94, 0xed, 0x5c, 0xf7, 0x12, 0xcc, 0x5c, 0x6f, 0x85, 0x92, 0xf6, 0x48,
-	0x2f, 0x40, 0xae, 0x2b, 0x5d, 0xe4, 0x9d, 0xc5, 0xcc, 0x4a, 0xb6, 0x0c, 0x1d, 0xed, 0xc2, 0xbd,
-	0x03, 0x28, 0x39, 0x6b, 0x0f, 0xa5, 0x97, 0xfb, 0x94, 0x9e, 0x4a, 0x55, 0xda, 0x03, 0x38, 0x28,
-	0x74, 0x1e, 0xf2, 0x56, 0xde, 0x65, 0xde, 0x62, 0x32, 0xa9, 0xd7, 0xdd, 0x81, 0x63, 0x29, 0xdf,
-	0x5e, 0x89, 0xaa, 0x1e, 0xc0, 0x41, 0x55, 0x0f, 0x1d, 0x98, 0x19, 0x08, 0x31, 0xfa, 0x3f, 0x8c,
-	0xe9, 0xfa, 0xd3, 0x8c, 0xb9, 0x0b, 0x8b, 0x7b, 0x65, 0xc6, 0x82, 0x9a, 0x49, 0xe8, 0x7d, 0x98,
-	0x22, 0xed, 0x86, 0xa7, 0x5f, 0xbc, 0xea, 0x5d, 0x49, 0x84, 0x56, 0x38, 0xb1, 0x76, 0xe9, 0xcf,
-	0x27, 0x85, 0xff, 0xd4, 0xa8, 0xac, 0xb7, 0xaa, 0x45, 0x9f, 0x37, 0x4a, 0x3e, 0x6f, 0x10, 0x59,
-	0xdd, 0x96, 0xdd, 0x87, 0x90, 0x56, 0x45, 0x49, 0x4f, 0x29, 0xae, 0x93, 0x9d, 0x35, 0xf5, 0x50,
-	0x9e, 0x24, 0xed, 0x86, 0x61, 0x51, 0xaf, 0xee, 0x0f, 0x0e, 0x4c, 0xf7, 0x87, 0x1a, 0xfd, 0xaf,
-	0x57, 0x70, 0x61, 0x8f, 0x04, 0xbd, 0x69, 0xbd, 0xfd, 0x49, 0xd8, 0x9f, 0xde, 0xee, 0xac, 0x37,
-	0xa9, 0xf7, 0xa9, 0x03, 0xb9, 0x44, 0x6a, 0xd1, 0x12, 0x4c, 0x54, 0x43, 0xee, 0xdf, 0xf6, 0x12,
-	0x45, 0x98, 0x2d, 0xe7, 0xf4, 0xd8, 0xba, 0xa9, 0xc4, 0x2d, 0x98, 0x31, 0xaa, 0xbd, 0x00, 0x4b,
-	0x6c, 0x84, 0xd9, 0xa2, 0x3c, 0x99, 0xea, 0xd9, 0x4d, 0xfd, 0x74, 0x05, 0x4b, 0x9c, 0x74, 0x6f,
-	0x8a, 0xf7, 0x0e, 0x2b, 0x5c, 0x43, 0x1d, 0x91, 0x10, 0xdf, 0xb5, 0xb8, 0x99, 0x17, 0xe0, 0xae,
-	0x29, 0xeb, 0xb2, 0x32, 0xee, 0xc1, 0xad, 0xf6, 0x0e, 0xbb, 0x7f, 0x38, 0x00, 0xdd, 0x62, 0xd8,
-	0x8f, 0x87, 0x18, 0x8e, 0x26, 0x3d, 0x6c, 0xa8, 0xc9, 0x1d, 0x3f, 0x33, 0x7f, 0xd3, 0xcf, 0xc3,
-	0x5d, 0x3f, 0x13, 0x2a, 0x5e, 0x97, 0xb3, 0xbf, 0x38, 0x00, 0xdd, 0x4a, 0xda, 0x8f, 0xb3, 0x37,
-	0x20, 0xe7, 0xab, 0x09, 0x3d, 0x89, 0x5c, 0x4e, 0xd5, 0x60, 0xbb, 0x96, 0x18, 0x28, 0x55, 0xf0,
-	0xbb, 0x94, 0xaf, 0xcb, 0xb3, 0x87, 0x0e, 0x4c, 0xf5, 0x45, 0x18, 0x5d, 0xea, 0x34, 0x45, 0xb3,
-	0xb2, 0xe6, 0x0c, 0x41, 0x52, 0xf1, 0x60, 0x17, 0x44, 0x79, 0x38, 0xd0, 0x26, 0x91, 0xa0, 0x9c,
-	0x69, 0x6f, 0xb3, 0xe5, 0xf8, 0x15, 0x5d, 0x83, 0x89, 0x06, 0x89, 0x6e, 0x87, 0xc4, 0x6b, 0x62,
-	0x59, 0x17, 0xf9, 0x8c, 0xce, 0xf6, 0xbf, 0x52, 0x65, 0x6f, 0xac, 0x6e, 0x5d, 0xbb, 0xae, 0x8d,
-	0x6f, 0x61, 0x59, 0xb7, 0x14, 0xb9, 0x46, 0x67, 0x44, 0xb8, 0x9f, 0x8d, 0xc2, 0xa1, 0x5e, 0x2b,
-	0xb4, 0x0c, 0xd3, 0x54, 0x98, 0xaa, 0xe2, 0xcc, 0x8b, 0x3a, 0x39, 0x39, 0x58, 0x9e, 0xa4, 0x42,
-	0xf9, 0x75, 0x93, 0x95, 0x75, 0x56, 0x4e, 0xc1, 0x21, 0xd1, 0xaa, 0xca, 0x88, 0x10, 0x2f, 0xf1,
-	0xdf, 0x9d, 0x2c, 0x4f, 0xda, 0x51, 0x9b, 0xbc, 0x25, 0x98, 0x88, 0xcd, 0x04, 0xbd, 0x47, 0x74,
-	0x9c, 0xb3, 0xe5, 0x9c, 0x1d, 0xab, 0xd0, 0x7b, 0x04, 0x2d, 0xc3, 0x54, 0x6c, 0x12, 0x7b, 0x9d,
-	0xd5, 0x56, 0x31, 0xc1, 0x96, 0x75, 0xfe, 0x5d, 0x98, 0x10, 0xb4, 0x1a, 0x52, 0x56, 0xf3, 0xea,
-	0x58, 0xd4, 0xf3, 0x63, 0x43, 0x74, 0x99, 0x9c, 0x45, 0x5a, 0xc7, 0xa2, 0xee, 0x7e, 0x99, 0x81,
-	0xa9, 0xbe, 0x24, 0xab, 0x2a, 0x31, 0xcb, 0x4a, 0x48, 0x1e, 0x11, 0x2f, 0xd9, 0x1e, 0x4f, 0x3e,
-	0xbf, 0x9d, 0x57, 0x94, 0x71, 0x4f, 0x95, 0x34, 0x7a, 0x87, 0x51, 0x08, 0xc7, 0xe2, 0x82, 0xc7,
-	0x01, 0x89, 0xbc, 0x4e, 0x3a, 0x23, 0x29, 0x6c, 0x6d, 0x9f, 0x7b, 0x7e, 0x15, 0xae, 0xeb, 0x49,
-	0x71, 0xbe, 0x22, 0x29, 0x2c, 0xcd, 0x5c, 0x35, 0xf5, 0x2b, 0xa2, 0x90, 0xf7, 0x79, 0xa3, 0xc1,
-	0x99, 0x47, 0x98, 0xcf, 0x03, 0x12, 0x78, 0x6d, 0x2e, 0x0d, 0x9b, 0x2d, 0xf9, 0xb3, 0xcf, 0xe9,
-	0xf5, 0x6a, 0xd2, 0x55, 0x33, 0x67, 0x8b, 0x4b, 0x0d, 0x67, 0xb9, 0x8e, 0xf8, 0x69, 0x1f, 0xd1,
-	0x5b, 0x00, 0x82, 0xd6, 0x18, 0x96, 0xad, 0x88, 0x88, 0x7c, 0x56, 0x17, 0x66, 0xfa, 0x9f, 0x7a,
-	0xf3, 0x7a, 0x25, 0x36, 0x8c, 0x97, 0x67, 0x77, 0xa6, 0xfb, 0xdd, 0x38, 0x4c, 0xf5, 0xc5, 0x12,
-	0x7d, 0xec, 0xc0, 0x9c, 0x6d, 0x78, 0x14, 0xb7, 0x43, 0x4f, 0x48, 0x2c, 0x89, 0x29, 0x02, 0x47,
-	0x17, 0xc1, 0xdb, 0xbb, 0x4f, 0x0a, 0xb3, 0x66, 0xf1, 0xa9, 0x82, 0xae, 0xa8, 0xef, 0x2a, 0xb5,
-	0x2f, 0x55, 0x1b, 0xb3, 0x86, 0x6a, 0x03, 0xb7, 0xc3, 0x0e, 0x10, 0xa2, 0x30, 0xd7, 0xa0, 0x4c,
-	0xda, 0x72, 0xb0, 0x59, 0xd3, 0x0a, 0x86, 0xf9, 0xd9, 0xcd, 0x2a, 0x4c, 0xed, 0xa8, 0xc9, 0x9a,
-	0xa6, 0xfa, 0xd4, 0x81, 0x93, 0x4d, 0x1c, 0xe1, 0x86, 0xf0, 0x24, 0xf7, 0x22, 0x22, 0x24, 0xbe,
-	0x4d, 0x0c, 0xb1, 0xe8, 0x61, 0xce, 0x0c, 0xc1, 0x5c, 0x30, 0x0c, 0x9b, 0xbc, 0x6c, 0xf0, 0xb5,
-	0x08, 0x91, 0x50, 0xf1, 0x95, 0x03, 0x67, 0x23, 0x1e, 0xaa, 0x45, 0x22, 0x08, 0x09, 0x94, 0x14,
-	0x19, 0x61, 0x26, 0xb6, 0x49, 0x94, 0xa6, 0x25, 0x3b, 0x84, 0x96, 0x53, 0x09, 0x9e, 0x4d, 0xbe,
-	0x69, 0x59, 0x06, 0x14, 0xdd, 0x87, 0x25, 0x29, 0x74, 0x4c, 0x5a, 0xcd, 0x5a, 0x84, 0x83, 0xd4,
-	0x98, 0x0c, 0xd3, 0x14, 0x8e, 0x4b, 0x21, 0x36, 0xf9, 0x3b, 0x06, 0x7c, 0x80, 0xfe, 0x01, 0xb8,
-	0xb8, 0x25, 0xeb, 0x8a, 0x9f, 0xfa, 0xb8, 0xce, 0x85, 0x4c, 0xe3, 0x1f, 0x1f, 0x82, 0xff, 0x84,
-	0xc2, 0xdf, 0xe4, 0x1b, 0x06, 0xbd, 0x5f, 0x80, 0xfb, 0xe3, 0x18, 0xcc, 0xa5, 0x77, 0x01, 0xc4,
-	0xe0, 0x98, 0xed, 0x9d, 0x1e, 0x66, 0x81, 0xe7, 0xd7, 0x31, 0x65, 0x1e, 0x0d, 0x92, 0x4b, 0xe4,
-	0xe5, 0x24, 0x1d, 0xb1, 0xb0, 0xab, 0x2c, 0xb8, 0xac, 0x40, 0x37, 0x02, 0x1d, 0x8b, 0xde, 0xd3,
-	0x56, 0xb6, 0x73, 0x08, 0x28, 0x40, 0x4e, 0xd2, 0x06, 0xf1, 0x04, 0xf1, 0x39, 0x0b, 0x6c, 0xb7,
-	0x07, 0x35, 0x54, 0xd1, 0x23, 0x68, 0x05, 0xa6, 0xb5, 0x01, 0xc3, 0x8c, 0xc7, 0x56, 0x59, 0xfd,
-	0xe3, 0x38, 0xa4, 0xc6, 0x6f, 0x60, 0xc6, 0xad, 0xe5, 0x87, 0x70, 0x34, 0xc4, 0x42, 0x7a, 0xa6,
-	0x5b, 0xd2, 0x40, 0x3b, 0xc6, 0x65, 0x9d, 0x44, 0x43, 0xe5, 0x78, 0x56, 0x81, 0xea, 0x28, 0x6e,
-	0x04, 0xab, 0x2c, 0xb8, 0xa9, 0x00, 0xd1, 0x17, 0x0e, 0x9c, 0x66, 0x64, 0x47, 0x7a, 0x6d, 0x1c,
-	0xd2, 0x00, 0x4b, 0x1e, 0xe9, 0xc8, 0x99, 0x58, 0xaa, 0x53, 0x0f, 0x13, 0x2d, 0x31, 0x7c, 0x7e,
-	0x97, 0x14, 0xc7, 0x56, 0x4c, 0xa1, 0x02, 0xa9, 0xc2, 0x1a, 0xe3, 0xeb, 0xc0, 0x7e, 0x00, 0x33,
-	0xda, 0x6b, 0xb3, 0x13, 0xb0, 0x9c, 0x07, 0x86, 0xe0, 0x9c, 0x52, 0x70, 0x66, 0x97, 0x61, 0x18,
-	0xee, 0xc0, 0x3c, 0x69, 0xd3, 0x80, 0x30, 0x9f, 0x68, 0xff, 0x9a, 0x11, 0x6f, 0x72, 0x41, 0x8c,
-	0xc7, 0xf9, 0x83, 0x43, 0x50, 0x1d, 0x8d, 0x71, 0x57, 0x59, 0x70, 0xcb, 0xa2, 0xea, 0xc2, 0xfd,
-	0xcd, 0x81, 0x23, 0xa9, 0x7f, 0x14, 0x54, 0x05, 0xa4, 0x5a, 0x3f, 0x09, 0xe2, 0xad, 0x3a, 0xd9,
-	0xa6, 0x3b, 0x43, 0x15, 0xec, 0xb4, 0xc1, 0x33, 0x5b, 0x30, 0x85, 0xd6, 0xcf, 0x21, 0x5a, 0xdb,
-	0x8a, 0x63, 0xf4, 0xd5, 0x70, 0x54, 0x34, 0x9a, 0xfb, 0xf9, 0x28, 0xe4, 0x12, 0xbf, 0x35, 0xb4,
-	0x06, 0x4e, 0x34, 0x94, 0x1b, 0x4e, 0xa4, 0x30, 0x86, 0x3b, 0x49, 0x39, 0x02, 0x4d, 0x80, 0xd3,
-	0xd6, 0xab, 0x70, 0xb2, 0xec, 0xb4, 0x11, 0x86, 0x99, 0x78, 0x1b, 0xa0, 0x16, 0x9b, 0x90, 0xb8,
-	0xd1, 0x1c, 0xaa, 0x71, 0x4f, 0x5b, 0xb8, 0xcd, 0x18, 0xcd, 0xfd, 0xc6, 0x01, 0x34, 0xb8, 0x0b,
-	0x47, 0x87, 0x61, 0x4c, 0xef, 0xc0, 0xed, 0xfe, 0xde, 0xbc, 0xbc, 0xa9, 0x7d, 0xee, 0x85, 0x9f,
-	0x32, 0x70, 0xa0, 0x42, 0xa2, 0x36, 0xf5, 0x09, 0x7a, 0x00, 0x63, 0xf6, 0xe4, 0x91, 0x0a, 0x96,
-	0xbc, 0x07, 0x99, 0x77, 0x5f, 0x64, 0x62, 0xae, 0x43, 0xdc, 0xd2, 0x27, 0x3f, 0xff, 0xfe, 0xed,
-	0xe8, 0x19, 0xb4, 0x5c, 0x52, 0xb6, 0xba, 0xf1, 0x76, 0xaf, 0xb2, 0xcc, 0xb5, 0x59, 0xe9, 0xa3,
-	0xee, 0xed, 0xcf, 0x7d, 0xd5, 0x6b, 0x92, 0xa7, 0xbd, 0xd3, 0x7b, 0x5e, 0xde, 0x18, 0x2d, 0xcb,
-	0x7b, 0x5f, 0xf2, 0x18, 0x41, 0x2b, 0x5a, 0x90, 0x8b, 0x16, 0x53, 0x05, 0x25, 0x8e, 0x8a, 0xe8,
-	0x7b, 0x07, 0x66, 0x06, 0xee, 0x79, 0xd0, 0xbf, 0x5f, 0x74, 0xb2, 0x1a, 0xb8, 0x2b, 0x9a, 0x2f,
-	0xee, 0xd7, 0xdc, 0xca, 0x3b, 0xaf, 0xe5, 0x9d, 0x43, 0x67, 0x52, 0xe5, 0xd9, 0x40, 0x09, 0x2f,
-	0x71, 0xe2, 0x5b, 0xab, 0x3c, 0xda, 0x5d, 0x70, 0x1e, 0xef, 0x2e, 0x38, 0x4f, 0x77, 0x17, 0x9c,
-	0xaf, 0x9f, 0x2d, 0x8c, 0x3c, 0x7e, 0xb6, 0x30, 0xf2, 0xeb, 0xb3, 0x85, 0x91, 0xf7, 0xfe, 0x9b,
-	0xa8, 0x58, 0x05, 0xa7, 0x6f, 0x0e, 0x7d, 0x1e, 0x96, 0x2c, 0xee, 0xc5, 0x92, 0x1f, 0x52, 0xc2,
-	0x64, 0xa9, 0x16, 0x35, 0xfd, 0x9e, 0x9c, 0x54, 0xc7, 0xb5, 0xed, 0xc5, 0xbf, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x00, 0xff, 0x2e, 0xa3, 0xee, 0x14, 0x00, 0x00,
+	// 2038 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcf, 0x6f, 0x1c, 0x49,
+	0xf5, 0x4f, 0x7b, 0xc6, 0x8e, 0xf3, 0xc6, 0x3f, 0xcb, 0xb1, 0x33, 0x76, 0x76, 0x6d, 0xa7, 0xbf,
+	0xf1, 0x8f, 0x64, 0xbf, 0xf1, 0xe0, 0x24, 0x1c, 0x22, 0xb8, 0xc4, 0xd9, 0x20, 0x9b, 0xdd, 0x6c,
+	0xac, 0x19, 0x63, 0x04, 0x44, 0x6a, 0x6a, 0xba, 0xcb, 0x33, 0x8d, 0xbb, 0xbb, 0x26, 0x5d, 0x35,
+	0xa3, 0x64, 0x57, 0x56, 0x10, 0x08, 0x90, 0x10, 0x12, 0x88, 0x3d, 0x2c, 0xff, 0x00, 0xc7, 0xd5,
+	0xde, 0xb8, 0xf0, 0x0f, 0xec, 0x71, 0x25, 0x0e, 0x70, 0x0a, 0x2b, 0x87, 0x13, 0xc7, 0x3d, 0xc2,
+	0x05, 0xd5, 0x8f, 0x9e, 0xe9, 0x9e, 0x6e, 0xcf, 0x0c, 0x3b, 0x89, 0x4f, 0xdc, 0xba, 0xab, 0x5f,
+	0x7d, 0xde, 0xe7, 0xbd, 0x7a, 0xef, 0x75, 0x55, 0x3d, 0x58, 0xa9, 0xe2, 0xc0, 0x29, 0x55, 0x31,
+	0x23, 0x25, 0x1a, 0x62, 0xdb, 0x23, 0xa5, 0xd6, 0x76, 0xa9, 0x11, 0x52, 0x7a, 0xb4, 0xd5, 0x08,
+	0x29, 0xa7, 0x68, 0x4e, 0x08, 0x6c, 0x09, 0x81, 0x2d, 0x25, 0xb0, 0xd5, 0xda, 0x5e, 0x7a, 0xab,
+	0x46, 0x69, 0xcd, 0x23, 0x25, 0xdc, 0x70, 0x4b, 0x38, 0x08, 0x28, 0xc7, 0xdc, 0xa5, 0x01, 0x53,
+	0x53, 0x96, 0x2e, 0xd7, 0x68, 0x8d, 0xca, 0xc7, 0x92, 0x78, 0xd2, 0xa3, 0x57, 0xa5, 0xa6, 0x8e,
+	0x12, 0x8d, 0xa6, 0x3e, 0x2e, 0xcb, 0x8f, 0x47, 0x84, 0x38, 0xac, 0xd4, 0xda, 0xae, 0x12, 0x8e,
+	0xb7, 0xd5, 0x9b, 0xfe, 0xbe, 0x2a, 0xbf, 0xf3, 0x66, 0x10, 0x10, 0xaf, 0x2d, 0xa0, 0x5e, 0x95,
+	0x84, 0xf9, 0x10, 0x26, 0xf6, 0x05, 0xed, 0x32, 0x79, 0xda, 0x24, 0x8c, 0xa3, 0xb7, 0x01, 0x42,
+	0xf5, 0x68, 0xb9, 0x4e, 0xd1, 0x58, 0x35, 0x36, 0xf3, 0xe5, 0x4b, 0x7a, 0x64, 0xcf, 0x41, 0x0b,
+	0x30, 0x56, 0x27, 0x6e, 0xad, 0xce, 0x8b, 0x23, 0xab, 0xc6, 0x66, 0xae, 0xac, 0xdf, 0x4c, 0x1f,
+	0x26, 0x35, 0x0c, 0x6b, 0xd0, 0x80, 0x91, 0x98, 0xa0, 0x11, 0x17, 0x44, 0xef, 0xc2, 0x58, 0x48,
+	0x58, 0xd3, 0x53, 0x00, 0x85, 0xdb, 0xeb, 0x5b, 0x19, 0x8e, 0xda, 0xaa, 0xb8, 0x41, 0xcd, 0x23,
+	0x11, 0x62, 0xd3, 0xe3, 0x3b, 0xf9, 0xcf, 0x5f, 0xae, 0x5c, 0x28, 0xeb, 0xb9, 0xe6, 0x5d, 0x98,
+	0x7d, 0xd4, 0xf4, 0xb8, 0x9b, 0xa0, 0xbe, 0x02, 0x85, 0x0e, 0x75, 0x56, 0x34, 0x56, 0x73, 0x9b,
+	0xf9, 0x32, 0xb4, 0xb9, 0x33, 0xf3, 0x29, 0xa0, 0xf8, 0xac, 0x3e, 0x4c, 0x1f, 0x74, 0x31, 0x5d,
+	0xcb, 0x64, 0x9a, 0x00, 0x4c, 0x13, 0x5d, 0x82, 0xa2, 0xa6, 0xf7, 0x80, 0x36, 0x03, 0x1e, 0xe7,
+	0x6b, 0x3e, 0x83, 0xc5, 0x8c, 0x6f, 0xaf, 0x85, 0x55, 0x02, 0x30, 0xcd, 0x6a, 0x17, 0x66, 0xf7,
+	0x43, 0xd7, 0x26, 0x09, 0xf7, 0x5d, 0x85, 0x4b, 0xcc, 0xad, 0x05, 0xd8, 0x8b, 0x16, 0xfe, 0x52,
+	0x79, 0x5c, 0x0d, 0xf4, 0x58, 0xf7, 0xa7, 0x80, 0xe2, 0x48, 0xaf, 0x85, 0x7c, 0x02, 0x30, 0x4d,
+	0xfe, 0x18, 0x8a, 0x07, 0x32, 0x82, 0xf7, 0xb1, 0x7d, 0x4c, 0x78, 0xb7, 0x0d, 0x2a, 0xba, 0x3b,
+	0xc1, 0x3b, 0xae, 0x06, 0xf6, 0x1c, 0xb4, 0x04, 0xe3, 0x4c, 0xc8, 0x05, 0x36, 0x91, 0xfa, 0xf3,
+	0xe5, 0xf6, 0x7b, 0x8c, 0x71, 0x2e, 0x61, 0xdf, 0x0b, 0x58, 0xcc, 0x50, 0xd6, 0xc7, 0xcc, 0xef,
+	0x76, 0x99, 0xf9, 0xff, 0x99, 0x66, 0x66, 0xe1, 0xa6, 0xad, 0xfd, 0xcc, 0x80, 0xd9, 0x54, 0x36,
+	0xa0, 0x6f, 0xc3, 0xa8, 0x2c, 0x36, 0x52, 0x71, 0xe1, 0xf6, 0x6a, 0xbf, 0x24, 0xd2, 0xa0, 0x6a,
+	0x12, 0x7a, 0x02, 0xd3, 0xa4, 0xe5, 0x5b, 0xf2, 0xc5, 0xaa, 0x3e, 0xe7, 0x84, 0x49, 0xa2, 0x13,
+	0x3b, 0x77, 0xff, 0xf5, 0x72, 0xe5, 0x1b, 0x35, 0x97, 0xd7, 0x9b, 0xd5, 0x2d, 0x9b, 0xfa, 0x25,
+	0x9b, 0xfa, 0x84, 0x57, 0x8f, 0x78, 0xe7, 0xc1, 0x73, 0xab, 0xac, 0x24, 0xa7, 0x6c, 0xed, 0x92,
+	0x67, 0x3b, 0xe2, 0xa1, 0x3c, 0x49, 0x5a, 0xbe, 0xd2, 0x22, 0x5e, 0xcd, 0x4f, 0x0d, 0x98, 0xe9,
+	0xce, 0x0a, 0xf4, 0xad, 0x24, 0xe1, 0x95, 0x3e, 0xb9, 0x74, 0xde, 0x7c, 0xbb, 0xf3, 0x65, 0x30,
+	0xbe, 0x9d, 0x59, 0xe7, 0xcd, 0xb7, 0x3b, 0x45, 0x06, 0xe3, 0xdb, 0x99, 0x75, 0x9e, 0x7c, 0xff,
+	0x6c, 0xc0, 0x95, 0x33, 0x62, 0x1d, 0xed, 0x24, 0x69, 0xaf, 0x0f, 0x96, 0x28, 0xe7, 0xc9, 0xfe,
+	0x4b, 0x03, 0x0a, 0xb1, 0x44, 0x42, 0xd7, 0x60, 0xa2, 0xea, 0x51, 0xfb, 0xd8, 0x8a, 0x65, 0x7e,
+	0xbe, 0x5c, 0x90, 0x63, 0xbb, 0x2a, 0xfd, 0x0f, 0x61, 0x56, 0x91, 0xb7, 0x1c, 0xcc, 0xb1, 0x22,
+	0xa6, 0x2b, 0xc1, 0xf5, 0x4c, 0x03, 0x1f, 0xcb, 0xa7, 0x77, 0x31, 0xc7, 0x71, 0xf3, 0xa6, 0x69,
+	0x72, 0x58, 0xe0, 0x2a, 0xd5, 0x21, 0xf1, 0xf0, 0x73, 0x8d, 0x9b, 0xeb, 0x81, 0xbb, 0x23, 0xa4,
+	0xcb, 0x42, 0x38, 0x81, 0x5b, 0x4d, 0x0e, 0x9b, 0xff, 0x34, 0x00, 0x3a, 0xa9, 0x37, 0x88, 0x85,
+	0x18, 0xae, 0xc4, 0x2d, 0xf4, 0xc5, 0xe4, 0xb6, 0x9d, 0xb9, 0xff, 0xd2, 0xce, 0xcb, 0x1d, 0x3b,
+	0x63, 0x2c, 0xde, 0x94, 0xb1, 0x7f, 0x35, 0x00, 0x3a, 0x79, 0x3b, 0x88, 0xb1, 0x1f, 0x40, 0xc1,
+	0x16, 0x13, 0x12, 0x0b, 0xb9, 0x91, 0xc9, 0x41, 0xff, 0x86, 0x58, 0xaa, 0x30, 0x80, 0xdd, 0x51,
+	0xf9, 0xa6, 0x2c, 0x3b, 0x35, 0x00, 0x3a, 0x19, 0x3e, 0x88, 0x65, 0x15, 0x98, 0x69, 0x88, 0x09,
+	0xe9, 0x38, 0xfd, 0xbf, 0xb3, 0xeb, 0x47, 0xf7, 0xf2, 0x4d, 0x35, 0x12, 0xa3, 0xe8, 0xc9, 0xd9,
+	0xe6, 0xdd, 0xcc, 0xae, 0xfa, 0xd4, 0x69, 0x7a, 0x64, 0x40, 0x23, 0xbf, 0x32, 0x60, 0x36, 0x55,
+	0x0f, 0x06, 0xb1, 0xf5, 0x09, 0xcc, 0x36, 0xe4, 0x8c, 0xb4, 0xb1, 0x37, 0xfb, 0x56, 0x9d, 0x54,
+	0x6a, 0x36, 0x92, 0xc3, 0x6f, 0xd8, 0xe8, 0xcf, 0x0c, 0x98, 0xee, 0xca, 0x1d, 0x74, 0xb7, 0xbd,
+	0xc7, 0x50, 0xa5, 0x73, 0x41, 0xa9, 0x89, 0xc7, 0x62, 0x7a, 0x37, 0x81, 0x8a, 0x70, 0xb1, 0x45,
+	0x42, 0xe6, 0xd2, 0x40, 0xef, 0x80, 0xa2, 0x57, 0xf4, 0x3e, 0x4c, 0xf8, 0x24, 0x3c, 0xf6, 0x88,
+	0xd5, 0xc0, 0xbc, 0xce, 0x8a, 0x39, 0x99, 0xc7, 0xd9, 0x71, 0xb0, 0x77, 0xff, 0xf0, 0xfd, 0x47,
+	0x52, 0x78, 0x1f, 0xf3, 0xba, 0x56, 0x51, 0xf0, 0xdb, 0x23, 0x4c, 0x30, 0x9e, 0x4a, 0x46, 0x0b,
+	0xfa, 0xa6, 0x28, 0xf5, 0xae, 0x4d, 0x34, 0xdf, 0x45, 0x85, 0xac, 0x0e, 0x2b, 0xfa, 0x64, 0xa2,
+	0x02, 0xac, 0x53, 0xdd, 0x5d, 0x9b, 0x9c, 0x1b, 0xe3, 0x4f, 0x46, 0x60, 0x3e, 0x73, 0xc9, 0xd1,
+	0x3d, 0x18, 0x53, 0xcb, 0xad, 0x99, 0x5f, 0x55, 0x1a, 0xf4, 0x29, 0xaa, 0x4d, 0x5d, 0x8a, 0x44,
+	0xee, 0x56, 0x13, 0xd0, 0x8f, 0x60, 0x8a, 0x04, 0x36, 0x75, 0x88, 0x63, 0x69, 0x88, 0xe1, 0xfe,
+	0x4c, 0x0a, 0x4b, 0xa9, 0x8a, 0x7b, 0x26, 0xd7, 0xdb, 0x33, 0xf9, 0xa1, 0x3c, 0xf3, 0x8b, 0x11,
+	0x98, 0x4a, 0x4a, 0xa1, 0x0d, 0x98, 0x71, 0x99, 0x4a, 0x24, 0x1a, 0x58, 0x61, 0x3b, 0xe7, 0xc6,
+	0xcb, 0x93, 0x2e, 0x13, 0x9e, 0x7b, 0x1c, 0x94, 0x65, 0xd6, 0xad, 0xc1, 0x14, 0x6b, 0x56, 0x79,
+	0x48, 0x88, 0x15, 0x3b, 0x3e, 0x4c, 0x96, 0x27, 0xf5, 0xa8, 0x4e, 0xce, 0x6b, 0x30, 0x11, 0x89,
+	0x31, 0xf7, 0x43, 0xa2, 0xed, 0x29, 0xe8, 0xb1, 0x8a, 0xfb, 0x21, 0x41, 0x1b, 0x30, 0x1d, 0x89,
+	0x44, 0x56, 0xe7, 0xa5, 0x54, 0xa4, 0xe0, 0x50, 0x1b, 0xff, 0x7d, 0x98, 0x60, 0x6e, 0xd5, 0x73,
+	0x83, 0x9a, 0x55, 0xc7, 0xac, 0x5e, 0x1c, 0x1d, 0xc2, 0xe3, 0x05, 0x8d, 0xb4, 0x8b, 0x59, 0xdd,
+	0xfc, 0x75, 0x0e, 0xa6, 0xbb, 0x12, 0x56, 0xd4, 0x72, 0xf5, 0xf3, 0x63, 0x9c, 0x86, 0xc4, 0x8a,
+	0xef, 0x65, 0xae, 0x9f, 0xbd, 0xc5, 0xad, 0x08, 0xe1, 0x44, 0xc6, 0xfb, 0xc9, 0x61, 0xe4, 0xc1,
+	0x62, 0x54, 0xd0, 0xb0, 0x43, 0x42, 0xab, 0xbd, 0x9c, 0x21, 0x67, 0xba, 0x6a, 0xbd, 0x73, 0xf6,
+	0xbf, 0x62, 0x57, 0x4e, 0x8a, 0xd6, 0x2b, 0xe4, 0x4c, 0xab, 0x59, 0xa8, 0x66, 0x7e, 0x45, 0x2e,
+	0x14, 0x6d, 0xea, 0xfb, 0x34, 0xb0, 0xa2, 0x68, 0x6d, 0x51, 0xae, 0xb4, 0xf5, 0x2c, 0x62, 0x0f,
+	0xe4, 0xa4, 0x87, 0x6a, 0xce, 0x21, 0xe5, 0x12, 0x4e, 0xeb, 0x9a, 0xb7, 0xb3, 0x3e, 0xa2, 0xef,
+	0x00, 0xc8, 0x33, 0x25, 0x6f, 0x86, 0x24, 0x0a, 0xcc, 0xec, 0xd3, 0xcb, 0xc1, 0xa3, 0x4a, 0x24,
+	0x18, 0xfd, 0x44, 0x3b, 0x33, 0xcd, 0x4f, 0xc6, 0x60, 0xba, 0xcb, 0x97, 0xe8, 0xa7, 0x06, 0x2c,
+	0xe8, 0x6d, 0x89, 0x8b, 0x5b, 0x9e, 0xc5, 0x38, 0xe6, 0x44, 0x05, 0x81, 0x21, 0x83, 0xe0, 0xbd,
+	0xd3, 0x97, 0x2b, 0x73, 0xaa, 0x90, 0x8a, 0x80, 0xae, 0x88, 0xef, 0x62, 0x69, 0xbf, 0x56, 0x6c,
+	0xcc, 0x29, 0x55, 0x7b, 0xb8, 0xe5, 0xb5, 0x81, 0x90, 0x0b, 0x0b, 0xbe, 0x1b, 0x70, 0x1d, 0x0e,
+	0x7a, 0xd5, 0x24, 0x83, 0x61, 0x12, 0x7f, 0x4e, 0x60, 0x4a, 0x43, 0xd5, 0xaa, 0x49, 0x55, 0x3f,
+	0x37, 0xe0, 0x7a, 0x03, 0x87, 0xd8, 0x67, 0x16, 0xa7, 0x56, 0x48, 0x18, 0xc7, 0xc7, 0x44, 0x29,
+	0x66, 0x09, 0xcd, 0xb9, 0x21, 0x34, 0xaf, 0x28, 0x0d, 0x07, 0xb4, 0xac, 0xf0, 0x25, 0x09, 0x16,
+	0x63, 0xf1, 0x5b, 0x03, 0x6e, 0x86, 0xd4, 0x13, 0x49, 0xc2, 0x08, 0x71, 0x04, 0x15, 0x1e, 0xe2,
+	0x80, 0x1d, 0x91, 0x30, 0x8b, 0x4b, 0x7e, 0x08, 0x2e, 0x6b, 0x31, 0x3d, 0x07, 0xf4, 0x40, 0x6b,
+	0x49, 0x31, 0x3a, 0x81, 0x6b, 0x9c, 0x49, 0x9f, 0x34, 0x1b, 0xb5, 0x10, 0x3b, 0x99, 0x3e, 0x19,
+	0xa6, 0x28, 0xbc, 0xc5, 0x19, 0x3b, 0xa0, 0xdf, 0x53, 0xe0, 0x29, 0xf5, 0x2f, 0xc0, 0xc4, 0x4d,
+	0x5e, 0x17, 0xfa, 0x5d, 0x1b, 0xd7, 0x29, 0xe3, 0x59, 0xfa, 0xc7, 0x86, 0xd0, 0xff, 0xb6, 0xc0,
+	0x3f, 0xa0, 0x7b, 0x0a, 0xbd, 0x9b, 0x80, 0xf9, 0x71, 0x0e, 0xe6, 0x33, 0x77, 0x17, 0xe8, 0x07,
+	0x80, 0x7c, 0xf9, 0x21, 0xa3, 0x5a, 0xad, 0xf5, 0xd8, 0xa5, 0xa4, 0xca, 0xd5, 0x8c, 0xdf, 0x35,
+	0xfe, 0xbf, 0x7a, 0xd5, 0xaf, 0x5e, 0x7d, 0x65, 0xc0, 0x4c, 0xb7, 0x37, 0xe5, 0x8d, 0x9b, 0x5c,
+	0x89, 0x63, 0xf2, 0xbc, 0x7d, 0xe3, 0x26, 0x06, 0xde, 0x23, 0xcf, 0x91, 0x0f, 0xd3, 0xdd, 0x55,
+	0x4c, 0xd5, 0x90, 0x87, 0xa7, 0x2f, 0x57, 0x26, 0x87, 0xaf, 0x5f, 0x93, 0x6e, 0xa2, 0x72, 0x95,
+	0x33, 0x77, 0x53, 0x37, 0xfa, 0xfc, 0xc4, 0x7a, 0xef, 0x1c, 0xfe, 0x60, 0xc0, 0xe5, 0x2c, 0xd9,
+	0xc1, 0xf7, 0x0f, 0xdd, 0x3f, 0xf3, 0x91, 0xd7, 0xf5, 0x33, 0xff, 0xd3, 0x28, 0x2c, 0x64, 0xc7,
+	0x1e, 0x0a, 0x60, 0x51, 0xef, 0x30, 0x2c, 0x1c, 0x38, 0x96, 0x5d, 0xc7, 0x6e, 0x60, 0xb9, 0x4e,
+	0xfc, 0x47, 0xf2, 0xf5, 0x08, 0xcc, 0x6b, 0xd8, 0xfb, 0x81, 0xf3, 0x40, 0x80, 0xee, 0x39, 0xd2,
+	0xf3, 0xc9, 0xab, 0xd5, 0x7c, 0xfb, 0x16, 0x71, 0x05, 0x0a, 0xdc, 0xf5, 0x89, 0xc5, 0x88, 0x4d,
+	0x03, 0x47, 0xef, 0x89, 0x40, 0x0c, 0x55, 0xe4, 0x08, 0xda, 0x84, 0x19, 0x29, 0x10, 0xe0, 0x80,
+	0x46, 0x52, 0x79, 0xb9, 0xbd, 0x9a, 0x12, 0xe3, 0x1f, 0xe0, 0x80, 0x6a, 0xc9, 0x9f, 0xc0, 0x15,
+	0x0f, 0x33, 0x6e, 0xa9, 0x1c, 0x75, 0x1d, 0x69, 0x18, 0xe5, 0x75, 0x12, 0x0e, 0x55, 0x09, 0xe7,
+	0x04, 0xa8, 0xf4, 0xe2, 0x9e, 0x73, 0x3f, 0x70, 0x1e, 0x0b, 0x40, 0xf4, 0x2b, 0x03, 0xd6, 0x03,
+	0xf2, 0x8c, 0x5b, 0x2d, 0xec, 0xb9, 0x0e, 0xe6, 0x34, 0x94, 0x9e, 0x53, 0xbe, 0xa4, 0x01, 0x23,
+	0x01, 0x6b, 0xb2, 0xe1, 0xab, 0xe0, 0x35, 0xa1, 0xe3, 0x30, 0x52, 0x21, 0x1c, 0x29, 0xdc, 0x1a,
+	0xe1, 0x4b, 0xc7, 0xfe, 0x18, 0x66, 0xa5, 0xd5, 0xea, 0xec, 0xa3, 0x75, 0x5e, 0x1c, 0x42, 0xe7,
+	0xb4, 0x80, 0x53, 0xe7, 0x2a, 0xa5, 0xe1, 0x29, 0x2c, 0x91, 0x96, 0xeb, 0x90, 0xc0, 0x26, 0xd2,
+	0xbe, 0x46, 0x48, 0x1b, 0x94, 0x11, 0x65, 0x71, 0x71, 0x7c, 0x08, 0x55, 0x57, 0x22, 0xdc, 0xfb,
+	0x81, 0xb3, 0xaf, 0x51, 0x65, 0xe0, 0xfe, 0xdd, 0x80, 0xf9, 0xcc, 0x3a, 0x86, 0xaa, 0x80, 0x44,
+	0xc1, 0x21, 0x4e, 0x74, 0xc2, 0x25, 0x47, 0xee, 0xb3, 0xa1, 0x02, 0x76, 0x46, 0xe1, 0xa9, 0xa3,
+	0x90, 0x40, 0xeb, 0xd6, 0xc1, 0x9a, 0x47, 0x42, 0xc7, 0xc8, 0xeb, 0xd1, 0x51, 0x91, 0x68, 0xe6,
+	0x2f, 0x47, 0xa0, 0x10, 0x2b, 0xa6, 0x68, 0x07, 0x8c, 0x70, 0x28, 0x33, 0x8c, 0x50, 0x60, 0x0c,
+	0x77, 0x2b, 0x68, 0x30, 0x34, 0x01, 0x46, 0x4b, 0x66, 0xe1, 0x64, 0xd9, 0x68, 0x21, 0x0c, 0xb3,
+	0xd1, 0xcf, 0x47, 0x24, 0x1b, 0xe3, 0xd8, 0x6f, 0x0c, 0xb5, 0xbd, 0x99, 0xd1, 0x70, 0x07, 0x11,
+	0x9a, 0xf9, 0x7b, 0x03, 0x50, 0xfa, 0x46, 0x09, 0x5d, 0x86, 0x51, 0x79, 0x9b, 0xa4, 0x6f, 0x39,
+	0xd4, 0xcb, 0x79, 0x9d, 0x93, 0x6f, 0xff, 0x7b, 0x14, 0x2e, 0x56, 0x48, 0xd8, 0x12, 0x67, 0xf3,
+	0x17, 0x30, 0xaa, 0xef, 0x5f, 0xce, 0xb8, 0x2e, 0xea, 0x74, 0x66, 0x96, 0xcc, 0x5e, 0x22, 0xaa,
+	0x9f, 0x62, 0x96, 0x7e, 0xf6, 0x97, 0x7f, 0x7c, 0x3c, 0x72, 0x03, 0x6d, 0x94, 0x84, 0xac, 0x2c,
+	0xbc, 0x9d, 0x8e, 0xa7, 0xea, 0xae, 0x96, 0x3e, 0xea, 0xb4, 0xf8, 0x4e, 0x44, 0xad, 0x89, 0xdf,
+	0x5c, 0xae, 0xf7, 0xed, 0xd0, 0x29, 0x2e, 0x1b, 0xfd, 0x3b, 0x79, 0x8a, 0xd0, 0xa6, 0x24, 0x64,
+	0xa2, 0xd5, 0x4c, 0x42, 0xb1, 0x6b, 0x4f, 0xf4, 0x47, 0x03, 0x66, 0x53, 0xcd, 0x3c, 0x74, 0xab,
+	0xd7, 0x2d, 0x61, 0xaa, 0x21, 0xb8, 0xb4, 0x35, 0xa8, 0xb8, 0xa6, 0xb7, 0x2d, 0xe9, 0xbd, 0x83,
+	0x6e, 0x64, 0xd2, 0xd3, 0x8e, 0x62, 0x56, 0xec, 0xf6, 0x12, 0xfd, 0x26, 0x79, 0x49, 0xb8, 0xde,
+	0xb7, 0x01, 0xd7, 0xcb, 0x63, 0xe9, 0xce, 0x9f, 0x79, 0x4b, 0x52, 0xda, 0x40, 0x6b, 0x49, 0x4a,
+	0xaa, 0x43, 0xad, 0x57, 0xb0, 0xdd, 0x65, 0x3c, 0x41, 0x9f, 0x66, 0x5e, 0xe7, 0xdd, 0x1a, 0xb4,
+	0x5f, 0xd6, 0xcb, 0x6d, 0x67, 0xb6, 0xed, 0xcc, 0x7b, 0x92, 0xe3, 0x1d, 0xb4, 0x9d, 0xe4, 0xa8,
+	0xbb, 0xe4, 0x9a, 0x64, 0xbb, 0x8d, 0x78, 0x52, 0xfa, 0x28, 0xea, 0x12, 0x9e, 0xec, 0x54, 0x3e,
+	0x3f, 0x5d, 0x36, 0xbe, 0x38, 0x5d, 0x36, 0xbe, 0x3c, 0x5d, 0x36, 0x7e, 0xf7, 0x6a, 0xf9, 0xc2,
+	0x17, 0xaf, 0x96, 0x2f, 0xfc, 0xed, 0xd5, 0xf2, 0x85, 0x1f, 0xde, 0x8b, 0x25, 0xbc, 0x80, 0x95,
+	0xdd, 0x75, 0x9b, 0x7a, 0x25, 0x8d, 0x7f, 0xa7, 0x64, 0x7b, 0x2e, 0x09, 0x78, 0xa9, 0x16, 0x36,
+	0xec, 0x44, 0x48, 0x57, 0xc7, 0xa4, 0xec, 0x9d, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x12, 0x56,
+	0x2b, 0xff, 0x54, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1445,6 +2265,10 @@ type ServiceClient interface {
 	MultiProof(ctx context.Context, in *MultiProofRequest, opts ...grpc.CallOption) (*MultiProofResponse, error)
 	// RequestCountProof queries the count proof
 	RequestCountProof(ctx context.Context, in *RequestCountProofRequest, opts ...grpc.CallOption) (*RequestCountProofResponse, error)
+	// PriceProof queries the proof of the feeds price for given signal ID
+	PriceProof(ctx context.Context, in *PriceProofRequest, opts ...grpc.CallOption) (*PriceProofResponse, error)
+	// TunnelPacketProof queries the proof of the tunnel packet for given tunnel ID and sequence
+	TunnelPacketProof(ctx context.Context, in *TunnelPacketProofRequest, opts ...grpc.CallOption) (*TunnelPacketProofResponse, error)
 }
 
 type serviceClient struct {
@@ -1482,6 +2306,24 @@ func (c *serviceClient) RequestCountProof(ctx context.Context, in *RequestCountP
 	return out, nil
 }
 
+func (c *serviceClient) PriceProof(ctx context.Context, in *PriceProofRequest, opts ...grpc.CallOption) (*PriceProofResponse, error) {
+	out := new(PriceProofResponse)
+	err := c.cc.Invoke(ctx, "/band.base.oracle.v1.Service/PriceProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) TunnelPacketProof(ctx context.Context, in *TunnelPacketProofRequest, opts ...grpc.CallOption) (*TunnelPacketProofResponse, error) {
+	out := new(TunnelPacketProofResponse)
+	err := c.cc.Invoke(ctx, "/band.base.oracle.v1.Service/TunnelPacketProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// Proof queries the proof for given request ID
@@ -1490,6 +2332,10 @@ type ServiceServer interface {
 	MultiProof(context.Context, *MultiProofRequest) (*MultiProofResponse, error)
 	// RequestCountProof queries the count proof
 	RequestCountProof(context.Context, *RequestCountProofRequest) (*RequestCountProofResponse, error)
+	// PriceProof queries the proof of the feeds price for given signal ID
+	PriceProof(context.Context, *PriceProofRequest) (*PriceProofResponse, error)
+	// TunnelPacketProof queries the proof of the tunnel packet for given tunnel ID and sequence
+	TunnelPacketProof(context.Context, *TunnelPacketProofRequest) (*TunnelPacketProofResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -1505,6 +2351,12 @@ func (*UnimplementedServiceServer) MultiProof(ctx context.Context, req *MultiPro
 func (*UnimplementedServiceServer) RequestCountProof(ctx context.Context, req *RequestCountProofRequest) (*RequestCountProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestCountProof not implemented")
 }
+func (*UnimplementedServiceServer) PriceProof(ctx context.Context, req *PriceProofRequest) (*PriceProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceProof not implemented")
+}
+func (*UnimplementedServiceServer) TunnelPacketProof(ctx context.Context, req *TunnelPacketProofRequest) (*TunnelPacketProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TunnelPacketProof not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -1564,6 +2416,42 @@ func _Service_RequestCountProof_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_PriceProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).PriceProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.base.oracle.v1.Service/PriceProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).PriceProof(ctx, req.(*PriceProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_TunnelPacketProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TunnelPacketProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).TunnelPacketProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.base.oracle.v1.Service/TunnelPacketProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).TunnelPacketProof(ctx, req.(*TunnelPacketProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "band.base.oracle.v1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -1580,6 +2468,14 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RequestCountProof",
 			Handler:    _Service_RequestCountProof_Handler,
 		},
+		{
+			MethodName: "PriceProof",
+			Handler:    _Service_PriceProof_Handler,
+		},
+		{
+			MethodName: "TunnelPacketProof",
+			Handler:    _Service_TunnelPacketProof_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/base/oracle/v1/proof.proto",
@@ -1796,7 +2692,7 @@ func (m *RequestCountProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *SingleProofResult) Marshal() (dAtA []byte, err error) {
+func (m *PriceProofRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1806,37 +2702,32 @@ func (m *SingleProofResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SingleProofResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceProofRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SingleProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EvmProofBytes) > 0 {
-		i -= len(m.EvmProofBytes)
-		copy(dAtA[i:], m.EvmProofBytes)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	{
-		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
+	if len(m.SignalId) > 0 {
+		i -= len(m.SignalId)
+		copy(dAtA[i:], m.SignalId)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.SignalId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiProofResult) Marshal() (dAtA []byte, err error) {
+func (m *PriceProofResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1846,25 +2737,18 @@ func (m *MultiProofResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiProofResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceProofResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EvmProofBytes) > 0 {
-		i -= len(m.EvmProofBytes)
-		copy(dAtA[i:], m.EvmProofBytes)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
-		i--
-		dAtA[i] = 0x12
-	}
 	{
-		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1872,11 +2756,16 @@ func (m *MultiProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
 
-func (m *CountProofResult) Marshal() (dAtA []byte, err error) {
+func (m *TunnelPacketProofRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1886,37 +2775,35 @@ func (m *CountProofResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CountProofResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *TunnelPacketProofRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CountProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TunnelPacketProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EvmProofBytes) > 0 {
-		i -= len(m.EvmProofBytes)
-		copy(dAtA[i:], m.EvmProofBytes)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	{
-		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
+	if m.Sequence != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TunnelId != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.TunnelId))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *SingleProof) Marshal() (dAtA []byte, err error) {
+func (m *TunnelPacketProofResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1926,28 +2813,18 @@ func (m *SingleProof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SingleProof) MarshalTo(dAtA []byte) (int, error) {
+func (m *TunnelPacketProofResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SingleProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TunnelPacketProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.OracleDataProof.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1956,15 +2833,15 @@ func (m *SingleProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	if m.BlockHeight != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiProof) Marshal() (dAtA []byte, err error) {
+func (m *SingleProofResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1974,18 +2851,25 @@ func (m *MultiProof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiProof) MarshalTo(dAtA []byte) (int, error) {
+func (m *SingleProofResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SingleProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmProofBytes) > 0 {
+		i -= len(m.EvmProofBytes)
+		copy(dAtA[i:], m.EvmProofBytes)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
-		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1993,30 +2877,11 @@ func (m *MultiProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.OracleDataMultiProof) > 0 {
-		for iNdEx := len(m.OracleDataMultiProof) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OracleDataMultiProof[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProof(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *CountProof) Marshal() (dAtA []byte, err error) {
+func (m *MultiProofResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2026,18 +2891,25 @@ func (m *CountProof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CountProof) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiProofResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmProofBytes) > 0 {
+		i -= len(m.EvmProofBytes)
+		copy(dAtA[i:], m.EvmProofBytes)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
-		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2045,9 +2917,39 @@ func (m *CountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CountProofResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CountProofResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CountProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmProofBytes) > 0 {
+		i -= len(m.EvmProofBytes)
+		copy(dAtA[i:], m.EvmProofBytes)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
-		size, err := m.CountProof.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2055,16 +2957,11 @@ func (m *CountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if m.BlockHeight != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *OracleDataProof) Marshal() (dAtA []byte, err error) {
+func (m *PriceProofResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2074,37 +2971,25 @@ func (m *OracleDataProof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OracleDataProof) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceProofResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OracleDataProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MerklePaths) > 0 {
-		for iNdEx := len(m.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MerklePaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProof(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.Version != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.Version))
+	if len(m.EvmProofBytes) > 0 {
+		i -= len(m.EvmProofBytes)
+		copy(dAtA[i:], m.EvmProofBytes)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	{
-		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2116,7 +3001,7 @@ func (m *OracleDataProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *IAVLMerklePath) Marshal() (dAtA []byte, err error) {
+func (m *TunnelPacketProofResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2126,52 +3011,37 @@ func (m *IAVLMerklePath) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IAVLMerklePath) MarshalTo(dAtA []byte) (int, error) {
+func (m *TunnelPacketProofResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IAVLMerklePath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TunnelPacketProofResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SiblingHash) > 0 {
-		i -= len(m.SiblingHash)
-		copy(dAtA[i:], m.SiblingHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.SiblingHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.SubtreeVersion != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.SubtreeVersion))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.SubtreeSize != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.SubtreeSize))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.SubtreeHeight != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.SubtreeHeight))
+	if len(m.EvmProofBytes) > 0 {
+		i -= len(m.EvmProofBytes)
+		copy(dAtA[i:], m.EvmProofBytes)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvmProofBytes)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.IsDataOnRight {
-		i--
-		if m.IsDataOnRight {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x8
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockRelayProof) Marshal() (dAtA []byte, err error) {
+func (m *SingleProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2181,32 +3051,18 @@ func (m *BlockRelayProof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockRelayProof) MarshalTo(dAtA []byte) (int, error) {
+func (m *SingleProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockRelayProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SingleProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProof(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
 	{
-		size, err := m.CommonEncodedVotePart.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2216,7 +3072,7 @@ func (m *BlockRelayProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x1a
 	{
-		size, err := m.BlockHeaderMerkleParts.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.OracleDataProof.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2225,20 +3081,15 @@ func (m *BlockRelayProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	{
-		size, err := m.MultiStoreProof.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProof(dAtA, i, uint64(size))
+	if m.BlockHeight != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiStoreProof) Marshal() (dAtA []byte, err error) {
+func (m *MultiProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2248,62 +3099,49 @@ func (m *MultiStoreProof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiStoreProof) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiStoreProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AuthToIcahostStoresMerkleHash) > 0 {
-		i -= len(m.AuthToIcahostStoresMerkleHash)
-		copy(dAtA[i:], m.AuthToIcahostStoresMerkleHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.AuthToIcahostStoresMerkleHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.TssToUpgradeStoresMerkleHash) > 0 {
-		i -= len(m.TssToUpgradeStoresMerkleHash)
-		copy(dAtA[i:], m.TssToUpgradeStoresMerkleHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.TssToUpgradeStoresMerkleHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.RollingseedToTransferStoresMerkleHash) > 0 {
-		i -= len(m.RollingseedToTransferStoresMerkleHash)
-		copy(dAtA[i:], m.RollingseedToTransferStoresMerkleHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.RollingseedToTransferStoresMerkleHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ParamsToRestakeStoresMerkleHash) > 0 {
-		i -= len(m.ParamsToRestakeStoresMerkleHash)
-		copy(dAtA[i:], m.ParamsToRestakeStoresMerkleHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.ParamsToRestakeStoresMerkleHash)))
-		i--
-		dAtA[i] = 0x1a
+	{
+		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
-	if len(m.MintStoreMerkleHash) > 0 {
-		i -= len(m.MintStoreMerkleHash)
-		copy(dAtA[i:], m.MintStoreMerkleHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.MintStoreMerkleHash)))
-		i--
-		dAtA[i] = 0x12
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OracleDataMultiProof) > 0 {
+		for iNdEx := len(m.OracleDataMultiProof) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleDataMultiProof[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.OracleIAVLStateHash) > 0 {
-		i -= len(m.OracleIAVLStateHash)
-		copy(dAtA[i:], m.OracleIAVLStateHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.OracleIAVLStateHash)))
+	if m.BlockHeight != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockHeaderMerkleParts) Marshal() (dAtA []byte, err error) {
+func (m *CountProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2313,70 +3151,45 @@ func (m *BlockHeaderMerkleParts) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockHeaderMerkleParts) MarshalTo(dAtA []byte) (int, error) {
+func (m *CountProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockHeaderMerkleParts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EvidenceAndProposerHash) > 0 {
-		i -= len(m.EvidenceAndProposerHash)
-		copy(dAtA[i:], m.EvidenceAndProposerHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.EvidenceAndProposerHash)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.LastResultsHash) > 0 {
-		i -= len(m.LastResultsHash)
-		copy(dAtA[i:], m.LastResultsHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.LastResultsHash)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.NextValidatorHashAndConsensusHash) > 0 {
-		i -= len(m.NextValidatorHashAndConsensusHash)
-		copy(dAtA[i:], m.NextValidatorHashAndConsensusHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.NextValidatorHashAndConsensusHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.LastBlockIdAndOther) > 0 {
-		i -= len(m.LastBlockIdAndOther)
-		copy(dAtA[i:], m.LastBlockIdAndOther)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.LastBlockIdAndOther)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.TimeNanoSecond != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.TimeNanoSecond))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TimeSecond != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.TimeSecond))
-		i--
-		dAtA[i] = 0x18
+	{
+		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
-	if m.Height != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CountProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
-	if len(m.VersionAndChainIdHash) > 0 {
-		i -= len(m.VersionAndChainIdHash)
-		copy(dAtA[i:], m.VersionAndChainIdHash)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.VersionAndChainIdHash)))
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CommonEncodedVotePart) Marshal() (dAtA []byte, err error) {
+func (m *PriceProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2386,34 +3199,45 @@ func (m *CommonEncodedVotePart) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CommonEncodedVotePart) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommonEncodedVotePart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SignedDataSuffix) > 0 {
-		i -= len(m.SignedDataSuffix)
-		copy(dAtA[i:], m.SignedDataSuffix)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.SignedDataSuffix)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
-	if len(m.SignedDataPrefix) > 0 {
-		i -= len(m.SignedDataPrefix)
-		copy(dAtA[i:], m.SignedDataPrefix)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.SignedDataPrefix)))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.PriceDataProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TMSignature) Marshal() (dAtA []byte, err error) {
+func (m *TunnelPacketProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2423,46 +3247,45 @@ func (m *TMSignature) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TMSignature) MarshalTo(dAtA []byte) (int, error) {
+func (m *TunnelPacketProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TMSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TunnelPacketProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EncodedTimestamp) > 0 {
-		i -= len(m.EncodedTimestamp)
-		copy(dAtA[i:], m.EncodedTimestamp)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.EncodedTimestamp)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.V != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.V))
-		i--
-		dAtA[i] = 0x18
+	{
+		size, err := m.BlockRelayProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
-	if len(m.S) > 0 {
-		i -= len(m.S)
-		copy(dAtA[i:], m.S)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.S)))
-		i--
-		dAtA[i] = 0x12
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.PacketDataProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
-	if len(m.R) > 0 {
-		i -= len(m.R)
-		copy(dAtA[i:], m.R)
-		i = encodeVarintProof(dAtA, i, uint64(len(m.R)))
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RequestsCountProof) Marshal() (dAtA []byte, err error) {
+func (m *OracleDataProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2472,12 +3295,12 @@ func (m *RequestsCountProof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RequestsCountProof) MarshalTo(dAtA []byte) (int, error) {
+func (m *OracleDataProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RequestsCountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OracleDataProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2501,410 +3324,2638 @@ func (m *RequestsCountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Count != 0 {
-		i = encodeVarintProof(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x8
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProof(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProof(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PriceDataProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ProofRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RequestId != 0 {
-		n += 1 + sovProof(uint64(m.RequestId))
-	}
-	if m.Height != 0 {
-		n += 1 + sovProof(uint64(m.Height))
-	}
-	return n
+
+func (m *PriceDataProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProofResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PriceDataProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovProof(uint64(m.Height))
+	if len(m.MerklePaths) > 0 {
+		for iNdEx := len(m.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerklePaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = m.Result.Size()
-	n += 1 + l + sovProof(uint64(l))
-	return n
-}
-
-func (m *MultiProofRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Version != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if len(m.RequestIds) > 0 {
-		l = 0
-		for _, e := range m.RequestIds {
-			l += sovProof(uint64(e))
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovProof(uint64(l)) + l
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MultiProofResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovProof(uint64(m.Height))
+func (m *TunnelPacketDataProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Result.Size()
-	n += 1 + l + sovProof(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RequestCountProofRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *TunnelPacketDataProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RequestCountProofResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TunnelPacketDataProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovProof(uint64(m.Height))
+	if len(m.MerklePaths) > 0 {
+		for iNdEx := len(m.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerklePaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = m.Result.Size()
-	n += 1 + l + sovProof(uint64(l))
-	return n
-}
-
-func (m *SingleProofResult) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Version != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	l = m.Proof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = len(m.EvmProofBytes)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if len(m.EncodedPacket) > 0 {
+		i -= len(m.EncodedPacket)
+		copy(dAtA[i:], m.EncodedPacket)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EncodedPacket)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MultiProofResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Proof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = len(m.EvmProofBytes)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+func (m *IAVLMerklePath) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CountProofResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Proof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = len(m.EvmProofBytes)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
-	}
-	return n
+func (m *IAVLMerklePath) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SingleProof) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *IAVLMerklePath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovProof(uint64(m.BlockHeight))
+	if len(m.SiblingHash) > 0 {
+		i -= len(m.SiblingHash)
+		copy(dAtA[i:], m.SiblingHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.SiblingHash)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = m.OracleDataProof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = m.BlockRelayProof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	return n
-}
-
-func (m *MultiProof) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SubtreeVersion != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.SubtreeVersion))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovProof(uint64(m.BlockHeight))
+	if m.SubtreeSize != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.SubtreeSize))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.OracleDataMultiProof) > 0 {
-		for _, e := range m.OracleDataMultiProof {
-			l = e.Size()
-			n += 1 + l + sovProof(uint64(l))
+	if m.SubtreeHeight != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.SubtreeHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IsDataOnRight {
+		i--
+		if m.IsDataOnRight {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.BlockRelayProof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CountProof) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovProof(uint64(m.BlockHeight))
+func (m *BlockRelayProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.CountProof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = m.BlockRelayProof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OracleDataProof) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BlockRelayProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockRelayProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Result.Size()
-	n += 1 + l + sovProof(uint64(l))
-	if m.Version != 0 {
-		n += 1 + sovProof(uint64(m.Version))
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if len(m.MerklePaths) > 0 {
-		for _, e := range m.MerklePaths {
-			l = e.Size()
-			n += 1 + l + sovProof(uint64(l))
+	{
+		size, err := m.CommonEncodedVotePart.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.BlockHeaderMerkleParts.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MultiStoreProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *IAVLMerklePath) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MultiStoreProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiStoreProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStoreProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.IsDataOnRight {
-		n += 2
+	if len(m.AuthToIcahostStoresMerkleHash) > 0 {
+		i -= len(m.AuthToIcahostStoresMerkleHash)
+		copy(dAtA[i:], m.AuthToIcahostStoresMerkleHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.AuthToIcahostStoresMerkleHash)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.SubtreeHeight != 0 {
-		n += 1 + sovProof(uint64(m.SubtreeHeight))
+	if len(m.TssToUpgradeStoresMerkleHash) > 0 {
+		i -= len(m.TssToUpgradeStoresMerkleHash)
+		copy(dAtA[i:], m.TssToUpgradeStoresMerkleHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.TssToUpgradeStoresMerkleHash)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.SubtreeSize != 0 {
-		n += 1 + sovProof(uint64(m.SubtreeSize))
+	if len(m.RollingseedToTransferStoresMerkleHash) > 0 {
+		i -= len(m.RollingseedToTransferStoresMerkleHash)
+		copy(dAtA[i:], m.RollingseedToTransferStoresMerkleHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.RollingseedToTransferStoresMerkleHash)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.SubtreeVersion != 0 {
-		n += 1 + sovProof(uint64(m.SubtreeVersion))
+	if len(m.ParamsToRestakeStoresMerkleHash) > 0 {
+		i -= len(m.ParamsToRestakeStoresMerkleHash)
+		copy(dAtA[i:], m.ParamsToRestakeStoresMerkleHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.ParamsToRestakeStoresMerkleHash)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.SiblingHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if len(m.MintStoreMerkleHash) > 0 {
+		i -= len(m.MintStoreMerkleHash)
+		copy(dAtA[i:], m.MintStoreMerkleHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.MintStoreMerkleHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.OracleIAVLStateHash) > 0 {
+		i -= len(m.OracleIAVLStateHash)
+		copy(dAtA[i:], m.OracleIAVLStateHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.OracleIAVLStateHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *BlockRelayProof) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ModuleBlockRelayProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleBlockRelayProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleBlockRelayProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.MultiStoreProof.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = m.BlockHeaderMerkleParts.Size()
-	n += 1 + l + sovProof(uint64(l))
-	l = m.CommonEncodedVotePart.Size()
-	n += 1 + l + sovProof(uint64(l))
 	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovProof(uint64(l))
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	return n
+	{
+		size, err := m.CommonEncodedVotePart.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.BlockHeaderMerkleParts.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ModuleStoreProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MultiStoreProof) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ModuleStoreProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleStoreProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleStoreProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OracleIAVLStateHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
-	}
-	l = len(m.MintStoreMerkleHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
-	}
-	l = len(m.ParamsToRestakeStoresMerkleHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
-	}
-	l = len(m.RollingseedToTransferStoresMerkleHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if len(m.MerklePaths) > 0 {
+		for iNdEx := len(m.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerklePaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.TssToUpgradeStoresMerkleHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if len(m.IAVLStateHash) > 0 {
+		i -= len(m.IAVLStateHash)
+		copy(dAtA[i:], m.IAVLStateHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.IAVLStateHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.AuthToIcahostStoresMerkleHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BlockHeaderMerkleParts) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MultiStoreMerklePath) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiStoreMerklePath) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStoreMerklePath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.VersionAndChainIdHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if len(m.SiblingHash) > 0 {
+		i -= len(m.SiblingHash)
+		copy(dAtA[i:], m.SiblingHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.SiblingHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Height != 0 {
-		n += 1 + sovProof(uint64(m.Height))
+	if m.IsDataOnRight {
+		i--
+		if m.IsDataOnRight {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.TimeSecond != 0 {
-		n += 1 + sovProof(uint64(m.TimeSecond))
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockHeaderMerkleParts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockHeaderMerkleParts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockHeaderMerkleParts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvidenceAndProposerHash) > 0 {
+		i -= len(m.EvidenceAndProposerHash)
+		copy(dAtA[i:], m.EvidenceAndProposerHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EvidenceAndProposerHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.LastResultsHash) > 0 {
+		i -= len(m.LastResultsHash)
+		copy(dAtA[i:], m.LastResultsHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.LastResultsHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.NextValidatorHashAndConsensusHash) > 0 {
+		i -= len(m.NextValidatorHashAndConsensusHash)
+		copy(dAtA[i:], m.NextValidatorHashAndConsensusHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.NextValidatorHashAndConsensusHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LastBlockIdAndOther) > 0 {
+		i -= len(m.LastBlockIdAndOther)
+		copy(dAtA[i:], m.LastBlockIdAndOther)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.LastBlockIdAndOther)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.TimeNanoSecond != 0 {
-		n += 1 + sovProof(uint64(m.TimeNanoSecond))
+		i = encodeVarintProof(dAtA, i, uint64(m.TimeNanoSecond))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.LastBlockIdAndOther)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if m.TimeSecond != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.TimeSecond))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.NextValidatorHashAndConsensusHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.LastResultsHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if len(m.VersionAndChainIdHash) > 0 {
+		i -= len(m.VersionAndChainIdHash)
+		copy(dAtA[i:], m.VersionAndChainIdHash)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.VersionAndChainIdHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.EvidenceAndProposerHash)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *CommonEncodedVotePart) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CommonEncodedVotePart) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommonEncodedVotePart) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommonEncodedVotePart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignedDataSuffix) > 0 {
+		i -= len(m.SignedDataSuffix)
+		copy(dAtA[i:], m.SignedDataSuffix)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.SignedDataSuffix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignedDataPrefix) > 0 {
+		i -= len(m.SignedDataPrefix)
+		copy(dAtA[i:], m.SignedDataPrefix)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.SignedDataPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TMSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TMSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TMSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SignedDataPrefix)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if len(m.EncodedTimestamp) > 0 {
+		i -= len(m.EncodedTimestamp)
+		copy(dAtA[i:], m.EncodedTimestamp)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.EncodedTimestamp)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.SignedDataSuffix)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if m.V != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.V))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.S)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.R)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestsCountProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestsCountProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestsCountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MerklePaths) > 0 {
+		for iNdEx := len(m.MerklePaths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerklePaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Count != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProof(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProof(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovProof(uint64(m.RequestId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *MultiProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RequestIds) > 0 {
+		l = 0
+		for _, e := range m.RequestIds {
+			l += sovProof(uint64(e))
+		}
+		n += 1 + sovProof(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MultiProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *RequestCountProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RequestCountProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *PriceProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalId)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *PriceProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *TunnelPacketProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TunnelId != 0 {
+		n += 1 + sovProof(uint64(m.TunnelId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovProof(uint64(m.Sequence))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *TunnelPacketProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *SingleProofResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = len(m.EvmProofBytes)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *MultiProofResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = len(m.EvmProofBytes)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *CountProofResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = len(m.EvmProofBytes)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceProofResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = len(m.EvmProofBytes)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *TunnelPacketProofResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = len(m.EvmProofBytes)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *SingleProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovProof(uint64(m.BlockHeight))
+	}
+	l = m.OracleDataProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.BlockRelayProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *MultiProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovProof(uint64(m.BlockHeight))
+	}
+	if len(m.OracleDataMultiProof) > 0 {
+		for _, e := range m.OracleDataMultiProof {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	l = m.BlockRelayProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *CountProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovProof(uint64(m.BlockHeight))
+	}
+	l = m.CountProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.BlockRelayProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *PriceProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovProof(uint64(m.BlockHeight))
+	}
+	l = m.PriceDataProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.BlockRelayProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *TunnelPacketProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovProof(uint64(m.BlockHeight))
+	}
+	l = m.PacketDataProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.BlockRelayProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	return n
+}
+
+func (m *OracleDataProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Result.Size()
+	n += 1 + l + sovProof(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovProof(uint64(m.Version))
+	}
+	if len(m.MerklePaths) > 0 {
+		for _, e := range m.MerklePaths {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceDataProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovProof(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovProof(uint64(m.Version))
+	}
+	if len(m.MerklePaths) > 0 {
+		for _, e := range m.MerklePaths {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TunnelPacketDataProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = len(m.EncodedPacket)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovProof(uint64(m.Version))
+	}
+	if len(m.MerklePaths) > 0 {
+		for _, e := range m.MerklePaths {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IAVLMerklePath) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsDataOnRight {
+		n += 2
+	}
+	if m.SubtreeHeight != 0 {
+		n += 1 + sovProof(uint64(m.SubtreeHeight))
+	}
+	if m.SubtreeSize != 0 {
+		n += 1 + sovProof(uint64(m.SubtreeSize))
+	}
+	if m.SubtreeVersion != 0 {
+		n += 1 + sovProof(uint64(m.SubtreeVersion))
+	}
+	l = len(m.SiblingHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockRelayProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MultiStoreProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.BlockHeaderMerkleParts.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.CommonEncodedVotePart.Size()
+	n += 1 + l + sovProof(uint64(l))
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiStoreProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OracleIAVLStateHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.MintStoreMerkleHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.ParamsToRestakeStoresMerkleHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.RollingseedToTransferStoresMerkleHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.TssToUpgradeStoresMerkleHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.AuthToIcahostStoresMerkleHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *ModuleBlockRelayProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ModuleStoreProof.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.BlockHeaderMerkleParts.Size()
+	n += 1 + l + sovProof(uint64(l))
+	l = m.CommonEncodedVotePart.Size()
+	n += 1 + l + sovProof(uint64(l))
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleStoreProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.IAVLStateHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if len(m.MerklePaths) > 0 {
+		for _, e := range m.MerklePaths {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiStoreMerklePath) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsDataOnRight {
+		n += 2
+	}
+	l = len(m.SiblingHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockHeaderMerkleParts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VersionAndChainIdHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProof(uint64(m.Height))
+	}
+	if m.TimeSecond != 0 {
+		n += 1 + sovProof(uint64(m.TimeSecond))
+	}
+	if m.TimeNanoSecond != 0 {
+		n += 1 + sovProof(uint64(m.TimeNanoSecond))
+	}
+	l = len(m.LastBlockIdAndOther)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.NextValidatorHashAndConsensusHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.LastResultsHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.EvidenceAndProposerHash)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *CommonEncodedVotePart) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignedDataPrefix)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.SignedDataSuffix)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *TMSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.R)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.V != 0 {
+		n += 1 + sovProof(uint64(m.V))
+	}
+	l = len(m.EncodedTimestamp)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *RequestsCountProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovProof(uint64(m.Count))
+	}
+	if m.Version != 0 {
+		n += 1 + sovProof(uint64(m.Version))
+	}
+	if len(m.MerklePaths) > 0 {
+		for _, e := range m.MerklePaths {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProof(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProof(x uint64) (n int) {
+	return sovProof(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProof
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RequestIds = append(m.RequestIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProof
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProof
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProof
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RequestIds) == 0 {
+					m.RequestIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProof
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RequestIds = append(m.RequestIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestCountProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestCountProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestCountProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestCountProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestCountProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestCountProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TunnelPacketProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TunnelPacketProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TunnelPacketProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelId", wireType)
+			}
+			m.TunnelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TunnelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TunnelPacketProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TunnelPacketProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TunnelPacketProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SingleProofResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SingleProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmProofBytes == nil {
+				m.EvmProofBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiProofResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmProofBytes == nil {
+				m.EvmProofBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *TMSignature) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.R)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
-	}
-	l = len(m.S)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
-	}
-	if m.V != 0 {
-		n += 1 + sovProof(uint64(m.V))
-	}
-	l = len(m.EncodedTimestamp)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *RequestsCountProof) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Count != 0 {
-		n += 1 + sovProof(uint64(m.Count))
-	}
-	if m.Version != 0 {
-		n += 1 + sovProof(uint64(m.Version))
-	}
-	if len(m.MerklePaths) > 0 {
-		for _, e := range m.MerklePaths {
-			l = e.Size()
-			n += 1 + l + sovProof(uint64(l))
+func (m *CountProofResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CountProofResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CountProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmProofBytes == nil {
+				m.EvmProofBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovProof(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozProof(x uint64) (n int) {
-	return sovProof(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ProofRequest) Unmarshal(dAtA []byte) error {
+func (m *PriceProofResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2927,17 +5978,17 @@ func (m *ProofRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProofRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceProofResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			m.RequestId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -2947,16 +5998,30 @@ func (m *ProofRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
 			}
-			m.Height = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -2966,11 +6031,26 @@ func (m *ProofRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmProofBytes == nil {
+				m.EvmProofBytes = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProof(dAtA[iNdEx:])
@@ -2992,7 +6072,7 @@ func (m *ProofRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProofResponse) Unmarshal(dAtA []byte) error {
+func (m *TunnelPacketProofResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3015,17 +6095,17 @@ func (m *ProofResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProofResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TunnelPacketProofResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TunnelPacketProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3035,16 +6115,30 @@ func (m *ProofResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3054,23 +6148,24 @@ func (m *ProofResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmProofBytes == nil {
+				m.EvmProofBytes = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3094,7 +6189,7 @@ func (m *ProofResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiProofRequest) Unmarshal(dAtA []byte) error {
+func (m *SingleProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3117,88 +6212,97 @@ func (m *MultiProofRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiProofRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SingleProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SingleProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProof
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
 				}
-				m.RequestIds = append(m.RequestIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProof
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthProof
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthProof
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDataProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.RequestIds) == 0 {
-					m.RequestIds = make([]uint64, 0, elementCount)
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleDataProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowProof
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RequestIds = append(m.RequestIds, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestIds", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProof(dAtA[iNdEx:])
@@ -3220,7 +6324,7 @@ func (m *MultiProofRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiProofResponse) Unmarshal(dAtA []byte) error {
+func (m *MultiProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3243,17 +6347,17 @@ func (m *MultiProofResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiProofResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.Height = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3263,14 +6367,14 @@ func (m *MultiProofResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDataMultiProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3297,60 +6401,44 @@ func (m *MultiProofResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OracleDataMultiProof = append(m.OracleDataMultiProof, OracleDataProof{})
+			if err := m.OracleDataMultiProof[len(m.OracleDataMultiProof)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProof(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProof
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthProof
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RequestCountProofRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProof
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCountProofRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCountProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProof(dAtA[iNdEx:])
@@ -3372,7 +6460,7 @@ func (m *RequestCountProofRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestCountProofResponse) Unmarshal(dAtA []byte) error {
+func (m *CountProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3395,17 +6483,17 @@ func (m *RequestCountProofResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCountProofResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CountProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCountProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CountProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.Height = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3415,14 +6503,14 @@ func (m *RequestCountProofResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CountProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3449,7 +6537,40 @@ func (m *RequestCountProofResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CountProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3474,7 +6595,7 @@ func (m *RequestCountProofResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
+func (m *PriceProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3497,15 +6618,34 @@ func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SingleProofResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SingleProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDataProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3532,15 +6672,15 @@ func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceDataProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3550,24 +6690,23 @@ func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.EvmProofBytes == nil {
-				m.EvmProofBytes = []byte{}
+			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3591,7 +6730,7 @@ func (m *SingleProofResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
+func (m *TunnelPacketProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3614,15 +6753,34 @@ func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiProofResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: TunnelPacketProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TunnelPacketProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketDataProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3649,15 +6807,15 @@ func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PacketDataProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3667,24 +6825,23 @@ func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.EvmProofBytes == nil {
-				m.EvmProofBytes = []byte{}
+			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3708,7 +6865,7 @@ func (m *MultiProofResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CountProofResult) Unmarshal(dAtA []byte) error {
+func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3731,15 +6888,15 @@ func (m *CountProofResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CountProofResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleDataProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CountProofResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleDataProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3766,15 +6923,34 @@ func (m *CountProofResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmProofBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -3784,24 +6960,24 @@ func (m *CountProofResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmProofBytes = append(m.EvmProofBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.EvmProofBytes == nil {
-				m.EvmProofBytes = []byte{}
+			m.MerklePaths = append(m.MerklePaths, IAVLMerklePath{})
+			if err := m.MerklePaths[len(m.MerklePaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3825,7 +7001,7 @@ func (m *CountProofResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SingleProof) Unmarshal(dAtA []byte) error {
+func (m *PriceDataProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3848,34 +7024,15 @@ func (m *SingleProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SingleProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceDataProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SingleProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceDataProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProof
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleDataProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3902,13 +7059,32 @@ func (m *SingleProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OracleDataProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3935,7 +7111,8 @@ func (m *SingleProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MerklePaths = append(m.MerklePaths, IAVLMerklePath{})
+			if err := m.MerklePaths[len(m.MerklePaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3960,7 +7137,7 @@ func (m *SingleProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiProof) Unmarshal(dAtA []byte) error {
+func (m *TunnelPacketDataProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3983,17 +7160,17 @@ func (m *MultiProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: TunnelPacketDataProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TunnelPacketDataProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
-			m.BlockHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4003,16 +7180,30 @@ func (m *MultiProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleDataMultiProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedPacket", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4022,29 +7213,48 @@ func (m *MultiProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleDataMultiProof = append(m.OracleDataMultiProof, OracleDataProof{})
-			if err := m.OracleDataMultiProof[len(m.OracleDataMultiProof)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EncodedPacket = append(m.EncodedPacket[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncodedPacket == nil {
+				m.EncodedPacket = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4071,7 +7281,8 @@ func (m *MultiProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MerklePaths = append(m.MerklePaths, IAVLMerklePath{})
+			if err := m.MerklePaths[len(m.MerklePaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4096,7 +7307,7 @@ func (m *MultiProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CountProof) Unmarshal(dAtA []byte) error {
+func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4119,17 +7330,17 @@ func (m *CountProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CountProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: IAVLMerklePath: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CountProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IAVLMerklePath: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDataOnRight", wireType)
 			}
-			m.BlockHeight = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4139,16 +7350,17 @@ func (m *CountProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsDataOnRight = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CountProof", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeHeight", wireType)
 			}
-			var msglen int
+			m.SubtreeHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4158,30 +7370,54 @@ func (m *CountProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SubtreeHeight |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProof
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeSize", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProof
+			m.SubtreeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubtreeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeVersion", wireType)
 			}
-			if err := m.CountProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SubtreeVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubtreeVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRelayProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SiblingHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4191,23 +7427,24 @@ func (m *CountProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockRelayProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SiblingHash = append(m.SiblingHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SiblingHash == nil {
+				m.SiblingHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4231,7 +7468,7 @@ func (m *CountProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
+func (m *BlockRelayProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4254,15 +7491,15 @@ func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleDataProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockRelayProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleDataProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockRelayProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiStoreProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4289,15 +7526,15 @@ func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MultiStoreProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderMerkleParts", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4307,14 +7544,28 @@ func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockHeaderMerkleParts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonEncodedVotePart", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4341,8 +7592,41 @@ func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MerklePaths = append(m.MerklePaths, IAVLMerklePath{})
-			if err := m.MerklePaths[len(m.MerklePaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CommonEncodedVotePart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, TMSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4367,7 +7651,7 @@ func (m *OracleDataProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
+func (m *MultiStoreProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4390,17 +7674,51 @@ func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IAVLMerklePath: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiStoreProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IAVLMerklePath: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiStoreProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsDataOnRight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleIAVLStateHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
 			}
-			var v int
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleIAVLStateHash = append(m.OracleIAVLStateHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.OracleIAVLStateHash == nil {
+				m.OracleIAVLStateHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintStoreMerkleHash", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4410,17 +7728,31 @@ func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsDataOnRight = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeHeight", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
 			}
-			m.SubtreeHeight = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintStoreMerkleHash = append(m.MintStoreMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MintStoreMerkleHash == nil {
+				m.MintStoreMerkleHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsToRestakeStoresMerkleHash", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4430,16 +7762,31 @@ func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubtreeHeight |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeSize", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
 			}
-			m.SubtreeSize = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsToRestakeStoresMerkleHash = append(m.ParamsToRestakeStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParamsToRestakeStoresMerkleHash == nil {
+				m.ParamsToRestakeStoresMerkleHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollingseedToTransferStoresMerkleHash", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4449,16 +7796,31 @@ func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubtreeSize |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeVersion", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
 			}
-			m.SubtreeVersion = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollingseedToTransferStoresMerkleHash = append(m.RollingseedToTransferStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RollingseedToTransferStoresMerkleHash == nil {
+				m.RollingseedToTransferStoresMerkleHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssToUpgradeStoresMerkleHash", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4468,14 +7830,29 @@ func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubtreeVersion |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TssToUpgradeStoresMerkleHash = append(m.TssToUpgradeStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TssToUpgradeStoresMerkleHash == nil {
+				m.TssToUpgradeStoresMerkleHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SiblingHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthToIcahostStoresMerkleHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4502,9 +7879,9 @@ func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SiblingHash = append(m.SiblingHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.SiblingHash == nil {
-				m.SiblingHash = []byte{}
+			m.AuthToIcahostStoresMerkleHash = append(m.AuthToIcahostStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AuthToIcahostStoresMerkleHash == nil {
+				m.AuthToIcahostStoresMerkleHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4528,7 +7905,7 @@ func (m *IAVLMerklePath) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockRelayProof) Unmarshal(dAtA []byte) error {
+func (m *ModuleBlockRelayProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4551,15 +7928,15 @@ func (m *BlockRelayProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockRelayProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModuleBlockRelayProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockRelayProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModuleBlockRelayProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultiStoreProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleStoreProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4586,7 +7963,7 @@ func (m *BlockRelayProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MultiStoreProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ModuleStoreProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4711,7 +8088,7 @@ func (m *BlockRelayProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiStoreProof) Unmarshal(dAtA []byte) error {
+func (m *ModuleStoreProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4734,17 +8111,17 @@ func (m *MultiStoreProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiStoreProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModuleStoreProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiStoreProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModuleStoreProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleIAVLStateHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4754,29 +8131,27 @@ func (m *MultiStoreProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleIAVLStateHash = append(m.OracleIAVLStateHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.OracleIAVLStateHash == nil {
-				m.OracleIAVLStateHash = []byte{}
-			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintStoreMerkleHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IAVLStateHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4803,16 +8178,16 @@ func (m *MultiStoreProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintStoreMerkleHash = append(m.MintStoreMerkleHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.MintStoreMerkleHash == nil {
-				m.MintStoreMerkleHash = []byte{}
+			m.IAVLStateHash = append(m.IAVLStateHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.IAVLStateHash == nil {
+				m.IAVLStateHash = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParamsToRestakeStoresMerkleHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePaths", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4822,65 +8197,81 @@ func (m *MultiStoreProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParamsToRestakeStoresMerkleHash = append(m.ParamsToRestakeStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.ParamsToRestakeStoresMerkleHash == nil {
-				m.ParamsToRestakeStoresMerkleHash = []byte{}
+			m.MerklePaths = append(m.MerklePaths, MultiStoreMerklePath{})
+			if err := m.MerklePaths[len(m.MerklePaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollingseedToTransferStoresMerkleHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProof
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProof
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiStoreMerklePath) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollingseedToTransferStoresMerkleHash = append(m.RollingseedToTransferStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.RollingseedToTransferStoresMerkleHash == nil {
-				m.RollingseedToTransferStoresMerkleHash = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TssToUpgradeStoresMerkleHash", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiStoreMerklePath: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiStoreMerklePath: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDataOnRight", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -4890,29 +8281,15 @@ func (m *MultiStoreProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProof
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProof
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TssToUpgradeStoresMerkleHash = append(m.TssToUpgradeStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.TssToUpgradeStoresMerkleHash == nil {
-				m.TssToUpgradeStoresMerkleHash = []byte{}
-			}
-			iNdEx = postIndex
-		case 6:
+			m.IsDataOnRight = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthToIcahostStoresMerkleHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SiblingHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4939,9 +8316,9 @@ func (m *MultiStoreProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthToIcahostStoresMerkleHash = append(m.AuthToIcahostStoresMerkleHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.AuthToIcahostStoresMerkleHash == nil {
-				m.AuthToIcahostStoresMerkleHash = []byte{}
+			m.SiblingHash = append(m.SiblingHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SiblingHash == nil {
+				m.SiblingHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
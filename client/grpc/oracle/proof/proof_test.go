@@ -2,13 +2,17 @@ package proof
 
 import (
 	"encoding/hex"
+	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
 
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+	tunneltypes "github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
 func hexToBytes(hexstr string) []byte {
@@ -211,3 +215,89 @@ func TestEncodeVerifyCount(t *testing.T) {
 	)
 	require.Equal(t, expect, result)
 }
+
+func TestEncodeVerifyPrice(t *testing.T) {
+	data := PriceDataProof{
+		Version: 217,
+		Price: feedstypes.Price{
+			Status:    feedstypes.PRICE_STATUS_AVAILABLE,
+			SignalID:  "CS:BTC-USD",
+			Price:     6500000000000,
+			Timestamp: 1629803671,
+		},
+		MerklePaths: []IAVLMerklePath{
+			{
+				IsDataOnRight:  true,
+				SubtreeHeight:  1,
+				SubtreeSize:    2,
+				SubtreeVersion: 217,
+				SiblingHash:    hexToBytes("EB739BB22F48B7F3053A90BA2BA4FE07FAB262CADF8664489565C50FF505B8BD"),
+			},
+		},
+	}
+
+	result, err := data.encodeToEthData(25000)
+	require.Nil(t, err)
+
+	values, err := verifyPriceArguments.Unpack(result)
+	require.Nil(t, err)
+	require.Len(t, values, 4)
+	require.Equal(t, big.NewInt(25000), values[0])
+	require.Equal(t, big.NewInt(217), values[2])
+
+	price := abi.ConvertType(values[1], new(PriceEthereum)).(*PriceEthereum)
+	require.Equal(t, transformPrice(data.Price), *price)
+}
+
+func TestEncodeVerifyTunnelPacket(t *testing.T) {
+	data := TunnelPacketDataProof{
+		Version:       217,
+		Packet:        tunneltypes.Packet{TunnelID: 1, Sequence: 2},
+		EncodedPacket: hexToBytes("08011002"),
+		MerklePaths: []IAVLMerklePath{
+			{
+				IsDataOnRight:  false,
+				SubtreeHeight:  1,
+				SubtreeSize:    2,
+				SubtreeVersion: 217,
+				SiblingHash:    hexToBytes("EB739BB22F48B7F3053A90BA2BA4FE07FAB262CADF8664489565C50FF505B8BD"),
+			},
+		},
+	}
+
+	result, err := data.encodeToEthData(25000)
+	require.Nil(t, err)
+
+	values, err := verifyTunnelPacketArguments.Unpack(result)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(25000), values[0])
+	require.Equal(t, uint64(1), values[1])
+	require.Equal(t, uint64(2), values[2])
+	require.Equal(t, hexToBytes("08011002"), values[3])
+	require.Equal(t, big.NewInt(217), values[4])
+}
+
+func TestEncodeModuleRelay(t *testing.T) {
+	block := ModuleBlockRelayProof{
+		ModuleStoreProof: ModuleStoreProof{
+			StoreKey:      "feeds",
+			IAVLStateHash: hexToBytes("B5AE02A8BB400D58F78DD24D9291CDD86A23FA0CFF7B228578B2D16BDB6328ED"),
+			MerklePaths: []MultiStoreMerklePath{
+				{
+					IsDataOnRight: true,
+					SiblingHash:   hexToBytes("5D53AD875A096B9D7EC78D462AC5DA6DAA58EEE2FB389B2C38898612233D3DBD"),
+				},
+			},
+		},
+	}
+
+	result, err := block.encodeToEthData()
+	require.Nil(t, err)
+
+	values, err := moduleRelayArguments.Unpack(result)
+	require.Nil(t, err)
+	require.Len(t, values, 4)
+
+	moduleStore := abi.ConvertType(values[0], new(ModuleStoreProofEthereum)).(*ModuleStoreProofEthereum)
+	require.Equal(t, block.ModuleStoreProof.encodeToEthFormat(), *moduleStore)
+}
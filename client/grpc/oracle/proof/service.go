@@ -12,11 +12,14 @@ import (
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	ics23 "github.com/cosmos/ics23/go"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
 
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	tunneltypes "github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
 // RegisterProofService registers the node gRPC service on the provided gRPC router.
@@ -68,9 +71,9 @@ func (s proofServer) Proof(ctx context.Context, req *ProofRequest) (*ProofRespon
 	}
 
 	// Get the proofs for the requested id and height
-	value, iavlEp, multiStoreEp, err := getProofsByKey(
+	value, iavlEp, multiStoreEp, err := getResultProofsByKey(
 		cliCtx,
-		types.ResultStoreKey(requestID),
+		requestID,
 		rpcclient.ABCIQueryOptions{Height: commit.Height - 1, Prove: true},
 		true,
 	)
@@ -103,25 +106,14 @@ func (s proofServer) Proof(ctx context.Context, req *ProofRequest) (*ProofRespon
 		MerklePaths: GetMerklePaths(iavlEp),
 	}
 
-	// Calculate byte for proofbytes
-	var relayAndVerifyArguments abi.Arguments
-	format := `[{"type":"bytes"},{"type":"bytes"}]`
-	err = json.Unmarshal([]byte(format), &relayAndVerifyArguments)
-	if err != nil {
-		panic(err)
-	}
-
-	blockRelayBytes, err := blockRelay.encodeToEthData()
-	if err != nil {
-		return nil, err
-	}
-	oracleDataBytes, err := oracleData.encodeToEthData(uint64(commit.Height))
-	if err != nil {
-		return nil, err
+	singleProof := SingleProof{
+		BlockHeight:     uint64(commit.Height),
+		OracleDataProof: oracleData,
+		BlockRelayProof: blockRelay,
 	}
 
 	// Pack the encoded block relay bytes and oracle data bytes into a evm proof bytes
-	evmProofBytes, err := relayAndVerifyArguments.Pack(blockRelayBytes, oracleDataBytes)
+	evmProofBytes, err := EncodeSingleProof(singleProof)
 	if err != nil {
 		return nil, err
 	}
@@ -135,11 +127,7 @@ func (s proofServer) Proof(ctx context.Context, req *ProofRequest) (*ProofRespon
 	return &ProofResponse{
 		Height: cliCtx.Height,
 		Result: SingleProofResult{
-			Proof: SingleProof{
-				BlockHeight:     uint64(commit.Height),
-				OracleDataProof: oracleData,
-				BlockRelayProof: blockRelay,
-			},
+			Proof:         singleProof,
 			EvmProofBytes: evmProofBytes,
 		},
 	}, nil
@@ -188,9 +176,9 @@ func (s proofServer) MultiProof(ctx context.Context, req *MultiProofRequest) (*M
 	for idx, intRequestID := range requestIDs {
 		requestID := types.RequestID(intRequestID)
 
-		value, iavlEp, multiStoreEp, err := getProofsByKey(
+		value, iavlEp, multiStoreEp, err := getResultProofsByKey(
 			cliCtx,
-			types.ResultStoreKey(requestID),
+			requestID,
 			rpcclient.ABCIQueryOptions{Height: commit.Height - 1, Prove: true},
 			idx == 0,
 		)
@@ -284,6 +272,7 @@ func (s proofServer) RequestCountProof(
 	// Get the proofs for the count from the IAVL tree
 	value, iavlEp, multiStoreEp, err := getProofsByKey(
 		cliCtx,
+		types.StoreKey,
 		types.RequestCountStoreKey,
 		rpcclient.ABCIQueryOptions{Height: commit.Height - 1, Prove: true},
 		true,
@@ -357,3 +346,186 @@ func (s proofServer) RequestCountProof(
 		},
 	}, nil
 }
+
+// PriceProof returns a proof of the feeds price from provided signal ID and block height
+func (s proofServer) PriceProof(ctx context.Context, req *PriceProofRequest) (*PriceProofResponse, error) {
+	// Get the proofs of the price in the feeds store
+	blockHeight, value, iavlEp, blockRelay, err := s.getModuleProofs(
+		feedstypes.StoreKey,
+		feedstypes.PriceStoreKey(req.SignalId),
+		req.Height,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal the value into a Price object
+	var price feedstypes.Price
+	if err := s.clientCtx.Codec.Unmarshal(value, &price); err != nil {
+		return nil, err
+	}
+
+	// Create a PriceDataProof object with the relevant information
+	priceData := PriceDataProof{
+		Price:       price,
+		Version:     decodeIAVLLeafPrefix(iavlEp.Leaf.Prefix),
+		MerklePaths: GetMerklePaths(iavlEp),
+	}
+
+	// Encode the block relay proof and the price data proof into Ethereum-compatible format
+	blockRelayBytes, err := blockRelay.encodeToEthData()
+	if err != nil {
+		return nil, err
+	}
+	priceDataBytes, err := priceData.encodeToEthData(uint64(blockHeight))
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate byte for proofbytes
+	var relayAndVerifyArguments abi.Arguments
+	format := `[{"type":"bytes"},{"type":"bytes"}]`
+	err = json.Unmarshal([]byte(format), &relayAndVerifyArguments)
+	if err != nil {
+		panic(err)
+	}
+
+	// Pack the encoded block relay bytes and price data bytes into a evm proof bytes
+	evmProofBytes, err := relayAndVerifyArguments.Pack(blockRelayBytes, priceDataBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return a PriceProofResponse object with the relevant information
+	return &PriceProofResponse{
+		Height: req.Height,
+		Result: PriceProofResult{
+			Proof: PriceProof{
+				BlockHeight:     uint64(blockHeight),
+				PriceDataProof:  priceData,
+				BlockRelayProof: blockRelay,
+			},
+			EvmProofBytes: evmProofBytes,
+		},
+	}, nil
+}
+
+// TunnelPacketProof returns a proof of the tunnel packet from provided tunnel ID, sequence and block height
+func (s proofServer) TunnelPacketProof(
+	ctx context.Context,
+	req *TunnelPacketProofRequest,
+) (*TunnelPacketProofResponse, error) {
+	// Get the proofs of the packet in the tunnel store
+	blockHeight, value, iavlEp, blockRelay, err := s.getModuleProofs(
+		tunneltypes.StoreKey,
+		tunneltypes.TunnelPacketStoreKey(req.TunnelId, req.Sequence),
+		req.Height,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal the value into a Packet object
+	var packet tunneltypes.Packet
+	if err := s.clientCtx.Codec.Unmarshal(value, &packet); err != nil {
+		return nil, err
+	}
+
+	// Create a TunnelPacketDataProof object with the relevant information
+	packetData := TunnelPacketDataProof{
+		Packet:        packet,
+		EncodedPacket: value,
+		Version:       decodeIAVLLeafPrefix(iavlEp.Leaf.Prefix),
+		MerklePaths:   GetMerklePaths(iavlEp),
+	}
+
+	// Encode the block relay proof and the packet data proof into Ethereum-compatible format
+	blockRelayBytes, err := blockRelay.encodeToEthData()
+	if err != nil {
+		return nil, err
+	}
+	packetDataBytes, err := packetData.encodeToEthData(uint64(blockHeight))
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate byte for proofbytes
+	var relayAndVerifyArguments abi.Arguments
+	format := `[{"type":"bytes"},{"type":"bytes"}]`
+	err = json.Unmarshal([]byte(format), &relayAndVerifyArguments)
+	if err != nil {
+		panic(err)
+	}
+
+	// Pack the encoded block relay bytes and packet data bytes into a evm proof bytes
+	evmProofBytes, err := relayAndVerifyArguments.Pack(blockRelayBytes, packetDataBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return a TunnelPacketProofResponse object with the relevant information
+	return &TunnelPacketProofResponse{
+		Height: req.Height,
+		Result: TunnelPacketProofResult{
+			Proof: TunnelPacketProof{
+				BlockHeight:     uint64(blockHeight),
+				PacketDataProof: packetData,
+				BlockRelayProof: blockRelay,
+			},
+			EvmProofBytes: evmProofBytes,
+		},
+	}, nil
+}
+
+// getModuleProofs returns the block height of the commit, the value of the key in the module store, its
+// IAVL proof and the block relay proof of the module store at the given height
+func (s proofServer) getModuleProofs(
+	storeKey string,
+	key []byte,
+	reqHeight int64,
+) (int64, []byte, *ics23.ExistenceProof, ModuleBlockRelayProof, error) {
+	// If the height is negative, return an error
+	if reqHeight < 0 {
+		return 0, nil, nil, ModuleBlockRelayProof{}, fmt.Errorf("negative height in request")
+	}
+
+	// If the height is 0, get the latest commit
+	var height *int64
+	if reqHeight != 0 {
+		height = &reqHeight
+	}
+
+	// Get the commit at the specified height from the client
+	commit, err := s.clientCtx.Client.Commit(context.Background(), height)
+	if err != nil {
+		return 0, nil, nil, ModuleBlockRelayProof{}, err
+	}
+
+	// Get the proofs for the key at the state of the commit
+	value, iavlEp, multiStoreEp, err := getProofsByKey(
+		s.clientCtx,
+		storeKey,
+		key,
+		rpcclient.ABCIQueryOptions{Height: commit.Height - 1, Prove: true},
+		true,
+	)
+	if err != nil {
+		return 0, nil, nil, ModuleBlockRelayProof{}, err
+	}
+
+	// Get the signatures and common vote prefix from the commit header
+	signatures, commonVote, err := GetSignaturesAndPrefix(&commit.SignedHeader)
+	if err != nil {
+		return 0, nil, nil, ModuleBlockRelayProof{}, err
+	}
+
+	// Create a ModuleBlockRelayProof object with the relevant information
+	blockRelay := ModuleBlockRelayProof{
+		ModuleStoreProof:       GetModuleStoreProof(storeKey, multiStoreEp),
+		BlockHeaderMerkleParts: GetBlockHeaderMerkleParts(commit.Header),
+		CommonEncodedVotePart:  commonVote,
+		Signatures:             signatures,
+	}
+
+	return commit.Height, value, iavlEp, blockRelay, nil
+}
@@ -0,0 +1,440 @@
+// Package verify verifies the oracle proofs produced by the proof service and the validator set transitions
+// produced by the node service offline, in the same way as the bridge contract does on EVM chains, so that
+// relayers can check a proof before spending gas on it.
+package verify
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"maps"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/bandprotocol/chain/v3/client/grpc/node"
+	"github.com/bandprotocol/chain/v3/client/grpc/oracle/proof"
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// Validators is a trusted validator set that maps the EVM address of each validator to its voting power.
+type Validators map[common.Address]uint64
+
+// NewValidators returns the trusted validator set from the validators returned by the EVMValidators query.
+func NewValidators(validators []node.ValidatorMinimal) (Validators, error) {
+	vals := make(Validators, len(validators))
+	for _, val := range validators {
+		if !common.IsHexAddress(val.Address) {
+			return nil, fmt.Errorf("invalid validator address: %s", val.Address)
+		}
+		if val.VotingPower <= 0 {
+			return nil, fmt.Errorf("invalid voting power of validator %s: %d", val.Address, val.VotingPower)
+		}
+
+		address := common.HexToAddress(val.Address)
+		if _, ok := vals[address]; ok {
+			return nil, fmt.Errorf("duplicate validator address: %s", val.Address)
+		}
+		vals[address] = uint64(val.VotingPower)
+	}
+
+	return vals, nil
+}
+
+// TotalPower returns the total voting power of the validator set.
+func (vals Validators) TotalPower() uint64 {
+	total := uint64(0)
+	for _, power := range vals {
+		total += power
+	}
+
+	return total
+}
+
+// VerifyProof decodes the EVM proof bytes of a single proof, verifies it against the trusted validator set
+// of the given chain and returns the proven oracle result.
+func VerifyProof(evmProofBytes []byte, chainID string, vals Validators) (oracletypes.Result, error) {
+	p, err := proof.DecodeSingleProof(evmProofBytes)
+	if err != nil {
+		return oracletypes.Result{}, err
+	}
+
+	if err := VerifySingleProof(p, chainID, vals); err != nil {
+		return oracletypes.Result{}, err
+	}
+
+	return p.OracleDataProof.Result, nil
+}
+
+// VerifySingleProof verifies that the oracle result of the single proof is in the oracle store of a block
+// committed by more than 2/3 of the voting power of the trusted validator set.
+func VerifySingleProof(p proof.SingleProof, chainID string, vals Validators) error {
+	if p.BlockHeight != p.BlockRelayProof.BlockHeaderMerkleParts.Height {
+		return fmt.Errorf(
+			"block height of oracle data proof %d does not match block header height %d",
+			p.BlockHeight,
+			p.BlockRelayProof.BlockHeaderMerkleParts.Height,
+		)
+	}
+
+	if err := VerifyBlockRelayProof(p.BlockRelayProof, chainID, vals); err != nil {
+		return err
+	}
+
+	return VerifyOracleDataProof(p.OracleDataProof, p.BlockRelayProof.MultiStoreProof.OracleIAVLStateHash)
+}
+
+// VerifyBlockRelayProof verifies that the block of the relay proof is signed by more than 2/3 of the voting
+// power of the trusted validator set.
+func VerifyBlockRelayProof(relay proof.BlockRelayProof, chainID string, vals Validators) error {
+	appHash := GetAppHash(relay.MultiStoreProof)
+	blockHash := GetBlockHash(relay.BlockHeaderMerkleParts, appHash)
+
+	return verifySignatures(blockHash, chainID, relay.CommonEncodedVotePart, relay.Signatures, vals)
+}
+
+// VerifyOracleDataProof verifies that the oracle result of the data proof is in the oracle store with the
+// given IAVL state hash.
+func VerifyOracleDataProof(data proof.OracleDataProof, oracleIAVLStateHash []byte) error {
+	value, err := data.Result.Marshal()
+	if err != nil {
+		return err
+	}
+
+	root, err := GetIAVLRootHash(
+		oracletypes.ResultStoreKey(data.Result.RequestID),
+		value,
+		data.Version,
+		data.MerklePaths,
+	)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(root, oracleIAVLStateHash) {
+		return fmt.Errorf("oracle IAVL state hash mismatch: expected %X, got %X", oracleIAVLStateHash, root)
+	}
+
+	return nil
+}
+
+// VerifyValidatorSetTransition verifies that the new validator set of the diff is in the block signed by
+// more than 2/3 of the voting power of the trusted validator set and returns the new validator set.
+func VerifyValidatorSetTransition(
+	diff node.EVMValidatorSetDiffResponse,
+	chainID string,
+	vals Validators,
+) (Validators, error) {
+	p := diff.Proof
+	if uint64(diff.ToBlockHeight) != p.BlockHeaderMerkleParts.Height {
+		return nil, fmt.Errorf(
+			"block height of validator set %d does not match block header height %d",
+			diff.ToBlockHeight,
+			p.BlockHeaderMerkleParts.Height,
+		)
+	}
+
+	newVals, err := NewValidators(diff.Validators)
+	if err != nil {
+		return nil, err
+	}
+
+	// The changes applied to the trusted validator set must give the new validator set.
+	updatedVals := make(Validators, len(vals))
+	for address, power := range vals {
+		updatedVals[address] = power
+	}
+	for _, change := range diff.Changes {
+		if !common.IsHexAddress(change.Address) || change.VotingPower < 0 {
+			return nil, fmt.Errorf("invalid validator change: %s", change.Address)
+		}
+
+		address := common.HexToAddress(change.Address)
+		if change.VotingPower == 0 {
+			delete(updatedVals, address)
+		} else {
+			updatedVals[address] = uint64(change.VotingPower)
+		}
+	}
+	if !maps.Equal(updatedVals, newVals) {
+		return nil, fmt.Errorf("changes do not match the new validator set")
+	}
+
+	validatorsHash, err := GetValidatorsHash(diff.Validators)
+	if err != nil {
+		return nil, err
+	}
+
+	lastBlockIDAndOther := innerHash( // [2B]
+		p.LastBlockIdAndLastCommitHash, // [1C]
+		innerHash( // [1D]
+			leafHash(encodeBytesField(1, p.DataHash)),     // [6]
+			leafHash(encodeBytesField(1, validatorsHash)), // [7]
+		),
+	)
+	if !bytes.Equal(lastBlockIDAndOther, p.BlockHeaderMerkleParts.LastBlockIdAndOther) {
+		return nil, fmt.Errorf("validator set hash does not match the block header")
+	}
+
+	blockHash := GetBlockHash(p.BlockHeaderMerkleParts, p.AppHash)
+	if err := verifySignatures(blockHash, chainID, p.CommonEncodedVotePart, p.Signatures, vals); err != nil {
+		return nil, err
+	}
+
+	return newVals, nil
+}
+
+// GetValidatorsHash returns the hash of the validator set in the block header computed from the validators
+// in the order of the validator set.
+func GetValidatorsHash(validators []node.ValidatorMinimal) ([]byte, error) {
+	// ref: https://github.com/cometbft/cometbft/blob/v0.38.12/types/validator_set.go
+	bzs := make([][]byte, len(validators))
+	for i, val := range validators {
+		pubKey, err := crypto.DecompressPubkey(val.PubKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key of validator %s: %w", val.Address, err)
+		}
+		if crypto.PubkeyToAddress(*pubKey) != common.HexToAddress(val.Address) {
+			return nil, fmt.Errorf("public key does not match address of validator %s", val.Address)
+		}
+
+		bzs[i], err = (&cmtproto.SimpleValidator{
+			PubKey: &cmtprotocrypto.PublicKey{
+				Sum: &cmtprotocrypto.PublicKey_Secp256K1{Secp256K1: val.PubKey},
+			},
+			VotingPower: val.VotingPower,
+		}).Marshal()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return merkle.HashFromByteSlices(bzs), nil
+}
+
+// GetIAVLRootHash returns the root hash of the IAVL tree computed from the leaf of the key and value at the
+// given version and the merkle paths from the leaf to the root.
+func GetIAVLRootHash(key, value []byte, version uint64, paths []proof.IAVLMerklePath) ([]byte, error) {
+	// ref: https://github.com/cosmos/iavl/blob/master/proof_ics23.go
+	leaf := binary.AppendVarint(nil, 0) // height
+	leaf = binary.AppendVarint(leaf, 1) // size
+	leaf = binary.AppendVarint(leaf, int64(version))
+	leaf = append(leaf, byte(len(key)))
+	leaf = append(leaf, key...)
+	leaf = append(leaf, 32) // size of value hash
+	leaf = append(leaf, tmhash.Sum(value)...)
+	hash := tmhash.Sum(leaf)
+
+	for _, path := range paths {
+		if path.SubtreeVersion < version {
+			return nil, fmt.Errorf("subtree version %d is lower than leaf version %d", path.SubtreeVersion, version)
+		}
+
+		node := binary.AppendVarint(nil, int64(path.SubtreeHeight))
+		node = binary.AppendVarint(node, int64(path.SubtreeSize))
+		node = binary.AppendVarint(node, int64(path.SubtreeVersion))
+		if path.IsDataOnRight {
+			node = append(append(node, 32), path.SiblingHash...)
+			node = append(append(node, 32), hash...)
+		} else {
+			node = append(append(node, 32), hash...)
+			node = append(append(node, 32), path.SiblingHash...)
+		}
+		hash = tmhash.Sum(node)
+	}
+
+	return hash, nil
+}
+
+// GetAppHash returns the application state hash computed from the multi store proof. See MultiStoreProof
+// for the layout of the multistore tree.
+func GetAppHash(m proof.MultiStoreProof) []byte {
+	oracleLeaf := []byte{byte(len(oracletypes.StoreKey))}
+	oracleLeaf = append(oracleLeaf, oracletypes.StoreKey...)
+	oracleLeaf = append(oracleLeaf, 32) // size of store hash
+	oracleLeaf = append(oracleLeaf, tmhash.Sum(m.OracleIAVLStateHash)...)
+
+	return innerHash( // [AppHash]
+		m.AuthToIcahostStoresMerkleHash, // [N24]
+		innerHash( // [N25]
+			innerHash( // [N22]
+				innerHash( // [N16]
+					innerHash( // [N8]
+						m.MintStoreMerkleHash, // [G]
+						leafHash(oracleLeaf),  // [H]
+					),
+					m.ParamsToRestakeStoresMerkleHash, // [N9]
+				),
+				m.RollingseedToTransferStoresMerkleHash, // [N17]
+			),
+			m.TssToUpgradeStoresMerkleHash, // [N23]
+		),
+	)
+}
+
+// GetBlockHash returns the block hash computed from the block header merkle parts and the app hash.
+func GetBlockHash(parts proof.BlockHeaderMerkleParts, appHash []byte) []byte {
+	// ref: https://github.com/cometbft/cometbft/blob/v0.38.12/types/block.go
+	return innerHash( // [BlockHeader]
+		innerHash( // [3A]
+			innerHash( // [2A]
+				parts.VersionAndChainIdHash, // [1A]
+				innerHash( // [1B]
+					leafHash(encodeUvarintField(1, parts.Height)),                // [2]
+					leafHash(encodeTime(parts.TimeSecond, parts.TimeNanoSecond)), // [3]
+				),
+			),
+			parts.LastBlockIdAndOther, // [2B]
+		),
+		innerHash( // [3B]
+			innerHash( // [2C]
+				parts.NextValidatorHashAndConsensusHash, // [1E]
+				innerHash( // [1F]
+					leafHash(encodeBytesField(1, appHash)), // [A]
+					parts.LastResultsHash,                  // [B]
+				),
+			),
+			parts.EvidenceAndProposerHash, // [2D]
+		),
+	)
+}
+
+// ValidateCommonEncodedVotePart checks that the common part is of a canonical precommit vote, in the same way
+// as the bridge contract does, so that signatures of other votes cannot be counted as precommits. The prefix
+// is the type, height, round (omitted if zero) and the start of the block ID, and the suffix is the part set
+// header of the block ID. See GetSignaturesAndPrefix for the encoding.
+func ValidateCommonEncodedVotePart(commonVote proof.CommonEncodedVotePart) error {
+	prefix := commonVote.SignedDataPrefix
+	if len(prefix) != 15 && len(prefix) != 24 {
+		return fmt.Errorf("invalid signed data prefix size: %d", len(prefix))
+	}
+	// 8 is a key for the type ( 8 == (1 << 3) | 0 )
+	if prefix[0] != 8 || prefix[1] != byte(cmtproto.PrecommitType) {
+		return fmt.Errorf("invalid vote type of signed data prefix: %X", prefix[:2])
+	}
+	if !bytes.HasSuffix(prefix, []byte{34, 72, 10, 32}) {
+		return fmt.Errorf("invalid block ID of signed data prefix: %X", prefix)
+	}
+
+	suffix := commonVote.SignedDataSuffix
+	if len(suffix) != 38 {
+		return fmt.Errorf("invalid signed data suffix size: %d", len(suffix))
+	}
+	// 18 is a key for the CanonicalPartSetHeader and 36 is its length
+	if suffix[0] != 18 || suffix[1] != 36 {
+		return fmt.Errorf("invalid part set header of signed data suffix: %X", suffix)
+	}
+
+	return nil
+}
+
+// RecoverSigners returns the EVM addresses of the validators that signed the precommit votes of the block
+// with the given hash.
+func RecoverSigners(
+	blockHash []byte,
+	chainID string,
+	commonVote proof.CommonEncodedVotePart,
+	signatures []proof.TMSignature,
+) ([]common.Address, error) {
+	if err := ValidateCommonEncodedVotePart(commonVote); err != nil {
+		return nil, err
+	}
+
+	commonPart := append([]byte{}, commonVote.SignedDataPrefix...)
+	commonPart = append(commonPart, blockHash...)
+	commonPart = append(commonPart, commonVote.SignedDataSuffix...)
+
+	encodedChainID := append([]byte{50, byte(len(chainID))}, chainID...)
+
+	signers := make([]common.Address, len(signatures))
+	for i, sig := range signatures {
+		if len(sig.R) != 32 || len(sig.S) != 32 || (sig.V != 27 && sig.V != 28) {
+			return nil, fmt.Errorf("invalid signature at index %d", i)
+		}
+
+		msg := append([]byte{}, commonPart...)
+		msg = append(msg, 42, byte(len(sig.EncodedTimestamp)))
+		msg = append(msg, sig.EncodedTimestamp...)
+		msg = append(msg, encodedChainID...)
+		msg = append([]byte{byte(len(msg))}, msg...)
+
+		rsv := append(append([]byte{}, sig.R...), sig.S...)
+		rsv = append(rsv, byte(sig.V-27))
+		pub, err := crypto.SigToPub(tmhash.Sum(msg), rsv)
+		if err != nil {
+			return nil, fmt.Errorf("failed to recover signer at index %d: %w", i, err)
+		}
+		signers[i] = crypto.PubkeyToAddress(*pub)
+	}
+
+	return signers, nil
+}
+
+// verifySignatures verifies that the block with the given hash is signed by more than 2/3 of the voting power
+// of the trusted validator set.
+func verifySignatures(
+	blockHash []byte,
+	chainID string,
+	commonVote proof.CommonEncodedVotePart,
+	signatures []proof.TMSignature,
+	vals Validators,
+) error {
+	signers, err := RecoverSigners(blockHash, chainID, commonVote, signatures)
+	if err != nil {
+		return err
+	}
+
+	// Signers must be sorted in ascending order so that a signature cannot be counted twice.
+	signedPower := uint64(0)
+	for i, signer := range signers {
+		if i > 0 && bytes.Compare(signers[i-1].Bytes(), signer.Bytes()) >= 0 {
+			return fmt.Errorf("signers are not in ascending order")
+		}
+		signedPower += vals[signer]
+	}
+
+	totalPower := vals.TotalPower()
+	if signedPower*3 <= totalPower*2 {
+		return fmt.Errorf("insufficient voting power: signed %d of total %d", signedPower, totalPower)
+	}
+
+	return nil
+}
+
+func leafHash(item []byte) []byte {
+	// leaf prefix is 0
+	return tmhash.Sum(append([]byte{0}, item...))
+}
+
+func innerHash(left, right []byte) []byte {
+	// branch prefix is 1
+	return tmhash.Sum(append([]byte{1}, append(append([]byte{}, left...), right...)...))
+}
+
+// encodeUvarintField returns the protobuf encoding of a varint field, which is empty for the zero value.
+func encodeUvarintField(num uint64, value uint64) []byte {
+	if value == 0 {
+		return nil
+	}
+
+	return binary.AppendUvarint([]byte{byte(num << 3)}, value)
+}
+
+// encodeBytesField returns the protobuf encoding of a bytes field, which is empty for the zero value.
+func encodeBytesField(num uint64, value []byte) []byte {
+	if len(value) == 0 {
+		return nil
+	}
+
+	return append(binary.AppendUvarint([]byte{byte(num<<3 | 2)}, uint64(len(value))), value...)
+}
+
+// encodeTime returns the protobuf encoding of google.protobuf.Timestamp.
+func encodeTime(second uint64, nanoSecond uint32) []byte {
+	return append(encodeUvarintField(1, second), encodeUvarintField(2, uint64(nanoSecond))...)
+}
@@ -0,0 +1,530 @@
+package verify
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	ics23 "github.com/cosmos/ics23/go"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/bandprotocol/chain/v3/client/grpc/node"
+	"github.com/bandprotocol/chain/v3/client/grpc/oracle/proof"
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func hexToBytes(hexstr string) []byte {
+	b, err := hex.DecodeString(hexstr)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func base64ToBytes(s string) []byte {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// block 25000 of bandchain, see the tests of the proof package
+var (
+	blockMerkleParts = proof.BlockHeaderMerkleParts{
+		VersionAndChainIdHash: hexToBytes("3F02642D9E70D5C1C493A4F732BFE9C9B95A4A42651703B816EDCFC8FADA5312"),
+		Height:                25000,
+		TimeSecond:            1629849931,
+		TimeNanoSecond:        290650376,
+		LastBlockIdAndOther:   hexToBytes("9B4825C99C3E739E1DC171FFB0E2BF34E99EEE41B34E407E40CF594834427B09"),
+		NextValidatorHashAndConsensusHash: hexToBytes(
+			"BF23413F237906B07202B3355E7311651ACE6BD2A34FD6FC3BD98EFE4FB78755",
+		),
+		LastResultsHash:         hexToBytes("9FB9C7533CAF1D218DA3AF6D277F6B101C42E3C3B75D784242DA663604DD53C2"),
+		EvidenceAndProposerHash: hexToBytes("7D11A74E40884411901BD7A70631734990B1FDBF5DE9E4C92C63B7650A6A6659"),
+	}
+	blockAppHash = hexToBytes("37D2CA95F226A7AFE3C41DE288F8158B737E78C4B733B1CCB0061D3236E926BE")
+	blockHash    = hexToBytes("3489F21785ACE1CE4214CB2B57F3A98DC0B7377D1BA1E1180B6E199E33B0FC5A")
+	blockVote    = proof.CommonEncodedVotePart{
+		SignedDataPrefix: hexToBytes("080211A86100000000000022480A20"),
+		SignedDataSuffix: hexToBytes("1224080112206BF91EFBA26A4CD86EBBD0E54DCFC9BD2C790859CFA96215661A47E4921A6301"),
+	}
+	blockSignatures = []proof.TMSignature{
+		{
+			R:                hexToBytes("84B8585B71240FEE0E674952B79ED25D793F1B31B42DD37B80F75B98510B5754"),
+			S:                hexToBytes("1EC44DD7C5389474DF8E5C25CC6ED8B573CCA2E009AA824EE825BDC693935927"),
+			V:                28,
+			EncodedTimestamp: hexToBytes("08CD9296890610EAE9963D"),
+		},
+		{
+			R:                hexToBytes("394365193F819CF539381366D31B6C5849AAA31AE8BA6F95C62C5C80656BFB5C"),
+			S:                hexToBytes("6A07E4A3C0ABCEAE5F854D492DF699438FB84762F152F739DDEAC48DDCFCB5CC"),
+			V:                28,
+			EncodedTimestamp: hexToBytes("08CD9296890610EA928633"),
+		},
+		{
+			R:                hexToBytes("5D7B4BE7B21B00D08AD7DBE48CF2761CECCB599E64AAB10B2901A0DD58F00325"),
+			S:                hexToBytes("7160EF689A533C1E983707507FC8466DAEA1D0DC7A889E3A27D1BB1D09CEC030"),
+			V:                28,
+			EncodedTimestamp: hexToBytes("08CD929689061086FAB239"),
+		},
+		{
+			R:                hexToBytes("5654A44FB89330C34CF2D862F940763194A145B72ED3BB0ADD5759E1E68FD145"),
+			S:                hexToBytes("2AC795D02A9C574CF12343FDFC67FDCED8A24F88EC8138C7F8230F6EB442B726"),
+			V:                28,
+			EncodedTimestamp: hexToBytes("08CD9296890610F0E1F733"),
+		},
+	}
+)
+
+func TestGetBlockHash(t *testing.T) {
+	require.Equal(t, blockHash, GetBlockHash(blockMerkleParts, blockAppHash))
+}
+
+func TestRecoverSigners(t *testing.T) {
+	signers, err := RecoverSigners(blockHash, "bandchain", blockVote, blockSignatures)
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{
+		common.HexToAddress("0x652D89a66Eb4eA55366c45b1f9ACfc8e2179E1c5"),
+		common.HexToAddress("0x88e1cd00710495EEB93D4f522d16bC8B87Cb00FE"),
+		common.HexToAddress("0xaAA22E077492CbaD414098EBD98AA8dc1C7AE8D9"),
+		common.HexToAddress("0xB956589b6fC5523eeD0d9eEcfF06262Ce84ff260"),
+	}, signers)
+
+	// The signers of another chain ID are different addresses.
+	signers, err = RecoverSigners(blockHash, "otherchain", blockVote, blockSignatures)
+	require.NoError(t, err)
+	require.NotContains(t, signers, common.HexToAddress("0x652D89a66Eb4eA55366c45b1f9ACfc8e2179E1c5"))
+
+	// The common part of the votes must be of a canonical precommit vote.
+	vote := blockVote
+	vote.SignedDataPrefix = hexToBytes("080111A86100000000000022480A20")
+	_, err = RecoverSigners(blockHash, "bandchain", vote, blockSignatures)
+	require.ErrorContains(t, err, "invalid vote type")
+
+	vote = blockVote
+	vote.SignedDataPrefix = hexToBytes("080211A8610000000000002A480A20")
+	_, err = RecoverSigners(blockHash, "bandchain", vote, blockSignatures)
+	require.ErrorContains(t, err, "invalid block ID")
+
+	vote = blockVote
+	vote.SignedDataPrefix = hexToBytes("080211A86100000000000022480A2000")
+	_, err = RecoverSigners(blockHash, "bandchain", vote, blockSignatures)
+	require.ErrorContains(t, err, "invalid signed data prefix size")
+
+	vote = blockVote
+	vote.SignedDataSuffix = append(hexToBytes("1225"), blockVote.SignedDataSuffix[2:]...)
+	_, err = RecoverSigners(blockHash, "bandchain", vote, blockSignatures)
+	require.ErrorContains(t, err, "invalid part set header")
+
+	vote = blockVote
+	vote.SignedDataSuffix = blockVote.SignedDataSuffix[:37]
+	_, err = RecoverSigners(blockHash, "bandchain", vote, blockSignatures)
+	require.ErrorContains(t, err, "invalid signed data suffix size")
+}
+
+func TestVerifyOracleDataProof(t *testing.T) {
+	// query at height 24999 of /store/oracle/key with data 0xff0000000000000001
+	key := base64ToBytes("/wAAAAAAAAAB")
+	data := base64ToBytes(
+		"CocCCgn/AAAAAAAAAAESOxABGhMAAAABAAAAA0JUQwAAAAAAAYagIAEoATABOAFAk6mTiQZIl6mTiQZQAVoMAAAAAQAAAAEk7AeMGgwIARgBIAEqBAACsgMiKggBEiYCBLIDIOtzm7IvSLfzBTqQuiuk/gf6smLK34ZkSJVlxQ/1Bbi9ICIqCAESJgQIsgMgGEcQdQfV57TNmUHrb/4WlCZK80xoXBncR4vq3aJlpXggIioIARImBgyyAyDoCq5YHsAEI5hUxNkNgUjoXx+Q0HBKc2aP0tpE3AzqUyAiKwgBEicKIM6GAyB0G3lGJNvpTWfNae6o1Xqqdo0upy8fylYaS++2eDbp4SA=",
+	)
+
+	commitmentProof := &ics23.CommitmentProof{}
+	require.NoError(t, commitmentProof.Unmarshal(data))
+	iavlEp := storetypes.NewIavlCommitmentOp(key, commitmentProof).Proof.GetExist()
+	require.NotNil(t, iavlEp)
+
+	oracleIAVLStateHash, err := iavlEp.Calculate()
+	require.NoError(t, err)
+
+	var result oracletypes.Result
+	require.NoError(t, result.Unmarshal(iavlEp.Value))
+
+	// the leaf prefix of IAVL is varint encoded height, size and version
+	_, n1 := binary.Varint(iavlEp.Leaf.Prefix)
+	_, n2 := binary.Varint(iavlEp.Leaf.Prefix[n1:])
+	version, _ := binary.Varint(iavlEp.Leaf.Prefix[n1+n2:])
+
+	dataProof := proof.OracleDataProof{
+		Result:      result,
+		Version:     uint64(version),
+		MerklePaths: proof.GetMerklePaths(iavlEp),
+	}
+	require.NoError(t, VerifyOracleDataProof(dataProof, oracleIAVLStateHash))
+
+	dataProof.Result.Result = []byte("tampered")
+	require.ErrorContains(t, VerifyOracleDataProof(dataProof, oracleIAVLStateHash), "state hash mismatch")
+}
+
+func TestGetAppHash(t *testing.T) {
+	// query at height 139 of /store/oracle/key with data 0xc000000000000000
+	data := base64ToBytes(
+		"Cv4BCgZvcmFjbGUSILWuAqi7QA1Y943STZKRzdhqI/oM/3sihXiy0WvbYyjtGgkIARgBIAEqAQAiJQgBEiEBXVOth1oJa51+x41GKsXabapY7uL7OJssOImGEiPT298iJwgBEgEBGiDAh+1I3Z5vtDdvCoqbjqdXtDqMS2OQUuNb9RLtMehqtiInCAESAQEaIDqK5Rpdb442lPE4nvtBIBBC+gc6duYo7pQ4EWrTGTZjIicIARIBARogxinvyHFSBjSggcRdbDRvGaaNqaNLpwZ36rA6wxPCgW8iJQgBEiEBPO/QdDCqwo6da1w04OySSpstZJxfeGYlKuwfwmlBFOI=",
+	)
+
+	commitmentProof := &ics23.CommitmentProof{}
+	require.NoError(t, commitmentProof.Unmarshal(data))
+	multiStoreEp := storetypes.NewSimpleMerkleCommitmentOp([]byte("oracle"), commitmentProof).Proof.GetExist()
+	require.NotNil(t, multiStoreEp)
+
+	expectAppHash, err := multiStoreEp.Calculate()
+	require.NoError(t, err)
+	require.Equal(t, []byte(expectAppHash), GetAppHash(proof.GetMultiStoreProof(multiStoreEp)))
+}
+
+type testValidator struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+// signBlock signs the precommit votes of the block with the given hash by the validators.
+func signBlock(
+	t *testing.T,
+	validators []testValidator,
+	chainID string,
+	blockHash []byte,
+	commonVote proof.CommonEncodedVotePart,
+) []proof.TMSignature {
+	encodedTimestamp := hexToBytes("08CD9296890610EAE9963D")
+
+	signatures := make([]proof.TMSignature, len(validators))
+	for i, val := range validators {
+		msg := append([]byte{}, commonVote.SignedDataPrefix...)
+		msg = append(msg, blockHash...)
+		msg = append(msg, commonVote.SignedDataSuffix...)
+		msg = append(msg, 42, byte(len(encodedTimestamp)))
+		msg = append(msg, encodedTimestamp...)
+		msg = append(msg, 50, byte(len(chainID)))
+		msg = append(msg, chainID...)
+		msg = append([]byte{byte(len(msg))}, msg...)
+
+		sig, err := crypto.Sign(tmhash.Sum(msg), val.key)
+		require.NoError(t, err)
+
+		signatures[i] = proof.TMSignature{
+			R:                sig[:32],
+			S:                sig[32:64],
+			V:                uint32(sig[64]) + 27,
+			EncodedTimestamp: encodedTimestamp,
+		}
+	}
+
+	return signatures
+}
+
+func TestVerifyProof(t *testing.T) {
+	chainID := "band-test"
+
+	validators := make([]testValidator, 4)
+	minimals := make([]node.ValidatorMinimal, 4)
+	for i := range validators {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		validators[i] = testValidator{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}
+		minimals[i] = node.ValidatorMinimal{Address: validators[i].address.Hex(), VotingPower: 100}
+	}
+	// signatures of the block relay proof are sorted by the signer address
+	sort.Slice(validators, func(i, j int) bool {
+		return bytes.Compare(validators[i].address.Bytes(), validators[j].address.Bytes()) < 0
+	})
+
+	vals, err := NewValidators(minimals)
+	require.NoError(t, err)
+	require.Equal(t, uint64(400), vals.TotalPower())
+
+	result := oracletypes.Result{
+		ClientID:       "client",
+		OracleScriptID: 1,
+		Calldata:       []byte("calldata"),
+		AskCount:       4,
+		MinCount:       3,
+		RequestID:      7,
+		AnsCount:       4,
+		RequestTime:    1629849900,
+		ResolveTime:    1629849910,
+		ResolveStatus:  oracletypes.RESOLVE_STATUS_SUCCESS,
+		Result:         []byte("result"),
+	}
+	dataProof := proof.OracleDataProof{
+		Result:  result,
+		Version: 100,
+		MerklePaths: []proof.IAVLMerklePath{
+			{
+				IsDataOnRight:  true,
+				SubtreeHeight:  1,
+				SubtreeSize:    2,
+				SubtreeVersion: 100,
+				SiblingHash:    tmhash.Sum([]byte("sibling")),
+			},
+		},
+	}
+	value, err := result.Marshal()
+	require.NoError(t, err)
+	oracleIAVLStateHash, err := GetIAVLRootHash(
+		oracletypes.ResultStoreKey(result.RequestID),
+		value,
+		dataProof.Version,
+		dataProof.MerklePaths,
+	)
+	require.NoError(t, err)
+
+	multiStore := proof.MultiStoreProof{
+		OracleIAVLStateHash:                   oracleIAVLStateHash,
+		MintStoreMerkleHash:                   tmhash.Sum([]byte("mint")),
+		ParamsToRestakeStoresMerkleHash:       tmhash.Sum([]byte("params")),
+		RollingseedToTransferStoresMerkleHash: tmhash.Sum([]byte("rollingseed")),
+		TssToUpgradeStoresMerkleHash:          tmhash.Sum([]byte("tss")),
+		AuthToIcahostStoresMerkleHash:         tmhash.Sum([]byte("auth")),
+	}
+	merkleParts := blockMerkleParts
+	merkleParts.Height = 30000
+
+	prefix, err := proof.GetPrefix(cmtproto.PrecommitType, 30000, 0)
+	require.NoError(t, err)
+	commonVote := proof.CommonEncodedVotePart{
+		SignedDataPrefix: append(prefix, 34, 72, 10, 32),
+		SignedDataSuffix: blockVote.SignedDataSuffix,
+	}
+
+	hash := GetBlockHash(merkleParts, GetAppHash(multiStore))
+	singleProof := proof.SingleProof{
+		BlockHeight:     30000,
+		OracleDataProof: dataProof,
+		BlockRelayProof: proof.BlockRelayProof{
+			MultiStoreProof:        multiStore,
+			BlockHeaderMerkleParts: merkleParts,
+			CommonEncodedVotePart:  commonVote,
+			Signatures:             signBlock(t, validators[:3], chainID, hash, commonVote),
+		},
+	}
+
+	evmProofBytes, err := proof.EncodeSingleProof(singleProof)
+	require.NoError(t, err)
+
+	decoded, err := proof.DecodeSingleProof(evmProofBytes)
+	require.NoError(t, err)
+	require.Equal(t, singleProof, decoded)
+
+	verified, err := VerifyProof(evmProofBytes, chainID, vals)
+	require.NoError(t, err)
+	require.Equal(t, result, verified)
+
+	// signatures of another chain are recovered to unknown signers
+	_, err = VerifyProof(evmProofBytes, "other-chain", vals)
+	require.Error(t, err)
+
+	// only a half of the voting power signed the block
+	p := singleProof
+	p.BlockRelayProof.Signatures = signBlock(t, validators[:2], chainID, hash, commonVote)
+	require.ErrorContains(t, VerifySingleProof(p, chainID, vals), "insufficient voting power")
+
+	// a signature is counted twice
+	p = singleProof
+	p.BlockRelayProof.Signatures = signBlock(t, validators[:2], chainID, hash, commonVote)
+	p.BlockRelayProof.Signatures = append(p.BlockRelayProof.Signatures, p.BlockRelayProof.Signatures[1])
+	require.ErrorContains(t, VerifySingleProof(p, chainID, vals), "not in ascending order")
+
+	// the result is not the one in the oracle store
+	p = singleProof
+	p.OracleDataProof.Result.Result = []byte("tampered")
+	require.ErrorContains(t, VerifySingleProof(p, chainID, vals), "state hash mismatch")
+
+	// the oracle store is not the one in the signed block
+	p = singleProof
+	p.BlockRelayProof.MultiStoreProof.MintStoreMerkleHash = tmhash.Sum([]byte("other"))
+	require.Error(t, VerifySingleProof(p, chainID, vals))
+
+	// the block is signed by prevotes, which are not a commit of the block
+	prevotePrefix, err := proof.GetPrefix(cmtproto.PrevoteType, 30000, 0)
+	require.NoError(t, err)
+	prevote := proof.CommonEncodedVotePart{
+		SignedDataPrefix: append(prevotePrefix, 34, 72, 10, 32),
+		SignedDataSuffix: blockVote.SignedDataSuffix,
+	}
+	p = singleProof
+	p.BlockRelayProof.CommonEncodedVotePart = prevote
+	p.BlockRelayProof.Signatures = signBlock(t, validators[:3], chainID, hash, prevote)
+	require.ErrorContains(t, VerifySingleProof(p, chainID, vals), "invalid vote type")
+
+	// the oracle data proof is for another block
+	p = singleProof
+	p.BlockHeight = 29999
+	require.ErrorContains(t, VerifySingleProof(p, chainID, vals), "does not match block header height")
+
+	_, err = VerifyProof(evmProofBytes[:100], chainID, vals)
+	require.Error(t, err)
+}
+
+func TestNewValidators(t *testing.T) {
+	_, err := NewValidators([]node.ValidatorMinimal{{Address: "band1xyz", VotingPower: 1}})
+	require.ErrorContains(t, err, "invalid validator address")
+
+	_, err = NewValidators([]node.ValidatorMinimal{
+		{Address: "0x652D89a66Eb4eA55366c45b1f9ACfc8e2179E1c5", VotingPower: 0},
+	})
+	require.ErrorContains(t, err, "invalid voting power")
+
+	_, err = NewValidators([]node.ValidatorMinimal{
+		{Address: "0x652D89a66Eb4eA55366c45b1f9ACfc8e2179E1c5", VotingPower: 1},
+		{Address: "0x652d89a66eb4ea55366c45b1f9acfc8e2179e1c5", VotingPower: 1},
+	})
+	require.ErrorContains(t, err, "duplicate validator address")
+}
+
+func TestVerifyValidatorSetTransition(t *testing.T) {
+	chainID := "band-test"
+
+	// validators 0-2 are in the trusted validator set, validator 3 joins the new validator set and
+	// validator 2 leaves it.
+	validators := make([]testValidator, 4)
+	for i := range validators {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		validators[i] = testValidator{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}
+	}
+	minimal := func(val testValidator, power int64) node.ValidatorMinimal {
+		return node.ValidatorMinimal{
+			Address:     val.address.Hex(),
+			VotingPower: power,
+			PubKey:      crypto.CompressPubkey(&val.key.PublicKey),
+		}
+	}
+
+	trusted, err := NewValidators([]node.ValidatorMinimal{
+		minimal(validators[0], 100),
+		minimal(validators[1], 100),
+		minimal(validators[2], 50),
+	})
+	require.NoError(t, err)
+
+	newValidators := []node.ValidatorMinimal{
+		minimal(validators[0], 100),
+		minimal(validators[1], 150),
+		minimal(validators[3], 50),
+	}
+	cmtValidators := make([]*cmttypes.Validator, len(newValidators))
+	for i, val := range newValidators {
+		cmtValidators[i] = cmttypes.NewValidator(secp256k1.PubKey(val.PubKey), val.VotingPower)
+	}
+	valSet := cmttypes.NewValidatorSet(cmtValidators)
+	// validators of the diff are in the order of the validator set
+	ordered := make([]node.ValidatorMinimal, 0, len(newValidators))
+	for _, val := range valSet.Validators {
+		for _, newVal := range newValidators {
+			if bytes.Equal(newVal.PubKey, val.PubKey.Bytes()) {
+				ordered = append(ordered, newVal)
+			}
+		}
+	}
+	newValidators = ordered
+
+	header := cmttypes.Header{
+		Version: cmtversion.Consensus{Block: 11},
+		ChainID: chainID,
+		Height:  30000,
+		Time:    time.Unix(1700000000, 123),
+		LastBlockID: cmttypes.BlockID{
+			Hash:          tmhash.Sum([]byte("last block")),
+			PartSetHeader: cmttypes.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("last parts"))},
+		},
+		LastCommitHash:     tmhash.Sum([]byte("last commit")),
+		DataHash:           tmhash.Sum(nil),
+		ValidatorsHash:     valSet.Hash(),
+		NextValidatorsHash: valSet.Hash(),
+		ConsensusHash:      tmhash.Sum([]byte("consensus")),
+		AppHash:            tmhash.Sum([]byte("app")),
+		LastResultsHash:    tmhash.Sum(nil),
+		EvidenceHash:       tmhash.Sum(nil),
+		ProposerAddress:    valSet.Validators[0].Address,
+	}
+	validatorsHash, err := GetValidatorsHash(newValidators)
+	require.NoError(t, err)
+	require.Equal(t, []byte(valSet.Hash()), validatorsHash)
+	require.Equal(t, []byte(header.Hash()), GetBlockHash(proof.GetBlockHeaderMerkleParts(&header), header.AppHash))
+
+	lastBlockID := header.LastBlockID.ToProto()
+	bzbi, err := lastBlockID.Marshal()
+	require.NoError(t, err)
+
+	prefix, err := proof.GetPrefix(cmtproto.PrecommitType, 30000, 0)
+	require.NoError(t, err)
+	commonVote := proof.CommonEncodedVotePart{
+		SignedDataPrefix: append(prefix, 34, 72, 10, 32),
+		SignedDataSuffix: blockVote.SignedDataSuffix,
+	}
+	// validator 2 does not sign the block, which is still more than 2/3 of the trusted voting power
+	signers := []testValidator{validators[0], validators[1], validators[3]}
+	sort.Slice(signers, func(i, j int) bool {
+		return bytes.Compare(signers[i].address.Bytes(), signers[j].address.Bytes()) < 0
+	})
+
+	diff := node.EVMValidatorSetDiffResponse{
+		FromBlockHeight: 20000,
+		ToBlockHeight:   30000,
+		Changes: []node.ValidatorMinimal{
+			minimal(validators[1], 150),
+			minimal(validators[2], 0),
+			minimal(validators[3], 50),
+		},
+		Validators: newValidators,
+		Proof: node.ValidatorSetTransitionProof{
+			BlockHeaderMerkleParts: proof.GetBlockHeaderMerkleParts(&header),
+			AppHash:                header.AppHash,
+			LastBlockIdAndLastCommitHash: merkle.HashFromByteSlices(
+				[][]byte{bzbi, encodeBytesField(1, header.LastCommitHash)},
+			),
+			DataHash:              header.DataHash,
+			CommonEncodedVotePart: commonVote,
+			Signatures:            signBlock(t, signers, chainID, header.Hash(), commonVote),
+		},
+	}
+
+	newVals, err := VerifyValidatorSetTransition(diff, chainID, trusted)
+	require.NoError(t, err)
+	require.Equal(t, Validators{
+		validators[0].address: 100,
+		validators[1].address: 150,
+		validators[3].address: 50,
+	}, newVals)
+
+	// only validator 0 of the trusted validator set signed the block
+	d := diff
+	d.Proof.Signatures = signBlock(t, []testValidator{validators[0]}, chainID, header.Hash(), commonVote)
+	_, err = VerifyValidatorSetTransition(d, chainID, trusted)
+	require.ErrorContains(t, err, "insufficient voting power")
+
+	// the changes are not applied to the trusted validator set
+	d = diff
+	d.Changes = d.Changes[:2]
+	_, err = VerifyValidatorSetTransition(d, chainID, trusted)
+	require.ErrorContains(t, err, "changes do not match")
+
+	// the validator set is not the one in the block header
+	d = diff
+	d.Validators = []node.ValidatorMinimal{newValidators[1], newValidators[0], newValidators[2]}
+	_, err = VerifyValidatorSetTransition(d, chainID, trusted)
+	require.ErrorContains(t, err, "validator set hash does not match")
+
+	d = diff
+	d.ToBlockHeight = 30001
+	_, err = VerifyValidatorSetTransition(d, chainID, trusted)
+	require.ErrorContains(t, err, "does not match block header height")
+}
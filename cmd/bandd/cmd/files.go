@@ -0,0 +1,367 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	band "github.com/bandprotocol/chain/v3/app"
+	"github.com/bandprotocol/chain/v3/pkg/filecache"
+	"github.com/bandprotocol/chain/v3/x/oracle"
+	oraclekeeper "github.com/bandprotocol/chain/v3/x/oracle/keeper"
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+const (
+	flagDryRun = "dry-run"
+	flagHeight = "height"
+)
+
+// filesAudit is the result of checking the files of the node against the on-chain references.
+type filesAudit struct {
+	// references maps the name of every file referenced on chain to its data sources and oracle scripts
+	references map[string][]string
+	// missing is the names of the referenced files that do not exist
+	missing []string
+	// corrupted is the names of the referenced files whose content does not match their hash
+	corrupted []string
+	// unreferenced is the names of the files that are not referenced on chain
+	unreferenced []string
+}
+
+// FilesCmd returns the command to manage the data source executables and compiled oracle scripts of the node.
+func FilesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "files",
+		Short: "Manage the data source executables and compiled oracle scripts stored by the node",
+	}
+
+	cmd.AddCommand(
+		AuditFilesCmd(),
+		GCFilesCmd(),
+		FetchFilesCmd(),
+	)
+
+	return cmd
+}
+
+// AuditFilesCmd returns the command to verify the files of the node against the on-chain references.
+func AuditFilesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "audit",
+		Short: "Verify the hash of every file and that every data source and oracle script has its file",
+		Long: "Verify the hash of every file stored by the node and that the file of every data source and " +
+			"oracle script on chain exists. The node has to be stopped before running this command. " +
+			"The command fails if any file is missing or corrupted, which can be repaired with the fetch command.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			fileCache := filecache.New(filepath.Join(serverCtx.Config.RootDir, "files"))
+
+			audit, err := auditFiles(serverCtx, fileCache)
+			if err != nil {
+				return err
+			}
+
+			for _, filename := range audit.missing {
+				cmd.Printf("missing: %s (%s)\n", filename, strings.Join(audit.references[filename], ", "))
+			}
+			for _, filename := range audit.corrupted {
+				cmd.Printf("corrupted: %s (%s)\n", filename, strings.Join(audit.references[filename], ", "))
+			}
+			for _, filename := range audit.unreferenced {
+				cmd.Printf("unreferenced: %s\n", filename)
+			}
+			cmd.Printf(
+				"Checked %d referenced files: %d missing, %d corrupted, %d unreferenced files\n",
+				len(audit.references),
+				len(audit.missing),
+				len(audit.corrupted),
+				len(audit.unreferenced),
+			)
+
+			if len(audit.missing) > 0 || len(audit.corrupted) > 0 {
+				return fmt.Errorf("found %d missing and %d corrupted files", len(audit.missing), len(audit.corrupted))
+			}
+			return nil
+		},
+	}
+}
+
+// GCFilesCmd returns the command to remove the files that are not referenced on chain.
+func GCFilesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: "Remove the files that are not referenced by any data source or oracle script",
+		Long: "Remove the files that are not referenced by any data source or oracle script on chain, such as " +
+			"the old versions of edited data sources and oracle scripts. The node has to be stopped before " +
+			"running this command.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			fileCache := filecache.New(filepath.Join(serverCtx.Config.RootDir, "files"))
+
+			dryRun, err := cmd.Flags().GetBool(flagDryRun)
+			if err != nil {
+				return err
+			}
+
+			audit, err := auditFiles(serverCtx, fileCache)
+			if err != nil {
+				return err
+			}
+
+			for _, filename := range audit.unreferenced {
+				if !dryRun {
+					if err := fileCache.RemoveFile(filename); err != nil {
+						return err
+					}
+				}
+				cmd.Printf("removed: %s\n", filename)
+			}
+			cmd.Printf("Removed %d unreferenced files\n", len(audit.unreferenced))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagDryRun, false, "Print the files to be removed without removing them")
+
+	return cmd
+}
+
+// FetchFilesCmd returns the command to restore the missing and corrupted files from a state sync snapshot.
+func FetchFilesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fetch",
+		Short: "Restore the missing and corrupted files from a state sync snapshot",
+		Long: "Restore the missing and corrupted files referenced on chain from a state sync snapshot in the " +
+			"snapshot store of the node. A snapshot of a peer can be added to the store with " +
+			"`bandd snapshots load`. The node has to be stopped before running this command.",
+		Example: "bandd snapshots load peer-snapshot.tar.gz && bandd files fetch",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			fileCache := filecache.New(filepath.Join(serverCtx.Config.RootDir, "files"))
+
+			height, err := cmd.Flags().GetUint64(flagHeight)
+			if err != nil {
+				return err
+			}
+
+			audit, err := auditFiles(serverCtx, fileCache)
+			if err != nil {
+				return err
+			}
+
+			wanted := make(map[string]bool)
+			for _, filename := range audit.missing {
+				wanted[filename] = true
+			}
+			for _, filename := range audit.corrupted {
+				wanted[filename] = true
+			}
+			if len(wanted) == 0 {
+				cmd.Println("No missing or corrupted files")
+				return nil
+			}
+
+			snapshotStore, err := server.GetSnapshotStore(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := getSnapshot(snapshotStore, height)
+			if err != nil {
+				return err
+			}
+
+			if err := fetchFilesFromSnapshot(snapshotStore, snapshot, fileCache, wanted, func(filename string) {
+				cmd.Printf("fetched: %s\n", filename)
+			}); err != nil {
+				return err
+			}
+
+			if len(wanted) > 0 {
+				return fmt.Errorf(
+					"%d files are not in the snapshot at height %d, try a more recent snapshot",
+					len(wanted),
+					snapshot.Height,
+				)
+			}
+
+			cmd.Printf("Fetched all missing and corrupted files from the snapshot at height %d\n", snapshot.Height)
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(flagHeight, 0, "Height of the snapshot to fetch the files from, the latest one if zero")
+
+	return cmd
+}
+
+// auditFiles checks the files of the given file cache against the data sources and oracle scripts in the
+// latest state of the node.
+func auditFiles(serverCtx *server.Context, fileCache filecache.Cache) (filesAudit, error) {
+	references, err := getFileReferences(serverCtx)
+	if err != nil {
+		return filesAudit{}, err
+	}
+
+	audit := filesAudit{references: references}
+	for filename := range references {
+		if !fileCache.HasFile(filename) {
+			audit.missing = append(audit.missing, filename)
+		} else if _, err := fileCache.GetFile(filename); err != nil {
+			audit.corrupted = append(audit.corrupted, filename)
+		}
+	}
+	for _, filename := range fileCache.GetFilenames() {
+		if _, ok := references[filename]; !ok {
+			audit.unreferenced = append(audit.unreferenced, filename)
+		}
+	}
+
+	sort.Strings(audit.missing)
+	sort.Strings(audit.corrupted)
+	sort.Strings(audit.unreferenced)
+
+	return audit, nil
+}
+
+// getFileReferences returns the names of the files of all data sources and oracle scripts in the latest
+// state of the node, mapped to the data sources and oracle scripts that reference them.
+func getFileReferences(serverCtx *server.Context) (map[string][]string, error) {
+	home := serverCtx.Config.RootDir
+	db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(home, "data"))
+	if err != nil {
+		return nil, err
+	}
+
+	bandApp := band.NewBandApp(
+		log.NewNopLogger(),
+		db,
+		nil,
+		true,
+		map[int64]bool{},
+		home,
+		serverCtx.Viper,
+		cast.ToUint32(serverCtx.Viper.Get(oracle.FlagWithOwasmCacheSize)),
+	)
+	defer bandApp.Close()
+
+	ctx := sdk.NewContext(bandApp.CommitMultiStore(), cmtproto.Header{}, false, log.NewNopLogger())
+
+	references := make(map[string][]string)
+	for id, dataSource := range bandApp.OracleKeeper.GetAllDataSources(ctx) {
+		references[dataSource.Filename] = append(
+			references[dataSource.Filename],
+			fmt.Sprintf("data source #%d", id+1),
+		)
+	}
+	for id, oracleScript := range bandApp.OracleKeeper.GetAllOracleScripts(ctx) {
+		references[oracleScript.Filename] = append(
+			references[oracleScript.Filename],
+			fmt.Sprintf("oracle script #%d", id+1),
+		)
+	}
+
+	return references, nil
+}
+
+// getSnapshot returns the snapshot at the given height of the given store, or the latest one if the height is zero.
+func getSnapshot(snapshotStore *snapshots.Store, height uint64) (*snapshottypes.Snapshot, error) {
+	var snapshot *snapshottypes.Snapshot
+	var err error
+	if height == 0 {
+		snapshot, err = snapshotStore.GetLatest()
+	} else {
+		snapshot, err = snapshotStore.Get(height, snapshottypes.CurrentFormat)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("snapshot not found, a snapshot can be added with `bandd snapshots load`")
+	}
+
+	return snapshot, nil
+}
+
+// fetchFilesFromSnapshot adds the wanted files found in the oracle extension of the given snapshot to the
+// file cache, and removes them from wanted. A corrupted file is only replaced once the snapshot is found to
+// contain it, so the files not in the snapshot are left as they are.
+func fetchFilesFromSnapshot(
+	snapshotStore *snapshots.Store,
+	snapshot *snapshottypes.Snapshot,
+	fileCache filecache.Cache,
+	wanted map[string]bool,
+	onFetched func(filename string),
+) error {
+	_, chunks, err := snapshotStore.Load(snapshot.Height, snapshot.Format)
+	if err != nil {
+		return err
+	}
+
+	reader, err := snapshots.NewStreamReader(chunks)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	var extension string
+	for len(wanted) > 0 {
+		var item snapshottypes.SnapshotItem
+		err := reader.ReadMsg(&item)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return fmt.Errorf("invalid snapshot item: %w", err)
+		}
+
+		switch item := item.Item.(type) {
+		case *snapshottypes.SnapshotItem_Extension:
+			extension = item.Extension.Name
+		case *snapshottypes.SnapshotItem_ExtensionPayload:
+			if extension != oracletypes.ModuleName {
+				continue
+			}
+
+			code, err := oraclekeeper.UncompressSnapshotPayload(item.ExtensionPayload.Payload)
+			if err != nil {
+				return err
+			}
+
+			filename := filecache.GetFilename(code)
+			if wanted[filename] {
+				// the cache does not overwrite existing files, so a corrupted one has to be removed first.
+				if fileCache.HasFile(filename) {
+					if err := fileCache.RemoveFile(filename); err != nil {
+						return err
+					}
+				}
+				fileCache.AddFile(code)
+				delete(wanted, filename)
+				onFetched(filename)
+			}
+		}
+	}
+
+	return nil
+}
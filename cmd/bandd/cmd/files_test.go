@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	band "github.com/bandprotocol/chain/v3/app"
+	"github.com/bandprotocol/chain/v3/pkg/filecache"
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+)
+
+// setupHome initializes a node home with the data sources and oracle scripts of the testing genesis and a
+// state sync snapshot of its state, and returns the server context of the home.
+func setupHome(t *testing.T) *server.Context {
+	home := t.TempDir()
+
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config.SetRoot(home)
+	serverCtx.Viper.Set(flags.FlagHome, home)
+
+	db, err := dbm.NewDB("application", dbm.GoLevelDBBackend, filepath.Join(home, "data"))
+	require.NoError(t, err)
+	snapshotStore, err := server.GetSnapshotStore(serverCtx.Viper)
+	require.NoError(t, err)
+
+	app := band.NewBandApp(
+		log.NewNopLogger(),
+		db,
+		nil,
+		true,
+		map[int64]bool{},
+		home,
+		serverCtx.Viper,
+		100,
+		baseapp.SetChainID(bandtesting.ChainID),
+		baseapp.SetSnapshot(snapshotStore, snapshottypes.SnapshotOptions{KeepRecent: 2}),
+	)
+
+	genesisState, err := json.Marshal(bandtesting.GenesisStateWithValSet(app, home))
+	require.NoError(t, err)
+	_, err = app.InitChain(&abci.RequestInitChain{
+		ConsensusParams: bandtesting.DefaultConsensusParams,
+		AppStateBytes:   genesisState,
+		ChainId:         bandtesting.ChainID,
+	})
+	require.NoError(t, err)
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	_, err = app.SnapshotManager().Create(uint64(app.LastBlockHeight()))
+	require.NoError(t, err)
+	require.NoError(t, app.Close())
+
+	return serverCtx
+}
+
+// executeFilesCmd executes the files command with the given arguments on the home of the server context and
+// returns its output.
+func executeFilesCmd(t *testing.T, serverCtx *server.Context, args ...string) (string, error) {
+	var out bytes.Buffer
+	cmd := FilesCmd()
+	cmd.SetArgs(args)
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+
+	err := cmd.ExecuteContext(context.WithValue(context.Background(), server.ServerContextKey, serverCtx))
+	return out.String(), err
+}
+
+func TestGCFilesCmd(t *testing.T) {
+	serverCtx := setupHome(t)
+	fileCache := filecache.New(filepath.Join(serverCtx.Config.RootDir, "files"))
+	referenced := fileCache.GetFilenames()
+
+	unreferenced := fileCache.AddFile([]byte("unreferenced"))
+
+	// Dry run keeps the unreferenced file
+	out, err := executeFilesCmd(t, serverCtx, "gc", "--dry-run")
+	require.NoError(t, err)
+	require.Contains(t, out, "removed: "+unreferenced)
+	require.Contains(t, out, "Removed 1 unreferenced files")
+	require.True(t, fileCache.HasFile(unreferenced))
+
+	out, err = executeFilesCmd(t, serverCtx, "gc")
+	require.NoError(t, err)
+	require.Contains(t, out, "removed: "+unreferenced)
+	require.False(t, fileCache.HasFile(unreferenced))
+	require.ElementsMatch(t, referenced, fileCache.GetFilenames())
+}
+
+func TestFetchFilesCmd(t *testing.T) {
+	serverCtx := setupHome(t)
+	fileCache := filecache.New(filepath.Join(serverCtx.Config.RootDir, "files"))
+
+	dataSource := bandtesting.DataSources[1].Filename
+	oracleScript := bandtesting.OracleScripts[1].Filename
+	original := fileCache.MustGetFile(dataSource)
+
+	out, err := executeFilesCmd(t, serverCtx, "fetch")
+	require.NoError(t, err)
+	require.Contains(t, out, "No missing or corrupted files")
+
+	// Corrupt the file of a data source and remove the file of an oracle script
+	require.NoError(t, os.WriteFile(filepath.Join(serverCtx.Config.RootDir, "files", dataSource), []byte("x"), 0o600))
+	require.NoError(t, fileCache.RemoveFile(oracleScript))
+
+	_, err = executeFilesCmd(t, serverCtx, "audit")
+	require.ErrorContains(t, err, "found 1 missing and 1 corrupted files")
+
+	out, err = executeFilesCmd(t, serverCtx, "fetch")
+	require.NoError(t, err)
+	require.Contains(t, out, "fetched: "+dataSource)
+	require.Contains(t, out, "fetched: "+oracleScript)
+	require.Equal(t, original, fileCache.MustGetFile(dataSource))
+	require.True(t, fileCache.HasFile(oracleScript))
+
+	_, err = executeFilesCmd(t, serverCtx, "audit")
+	require.NoError(t, err)
+}
+
+func TestFetchFilesCmdWithoutSnapshot(t *testing.T) {
+	serverCtx := setupHome(t)
+	fileCache := filecache.New(filepath.Join(serverCtx.Config.RootDir, "files"))
+
+	dataSource := bandtesting.DataSources[1].Filename
+	require.NoError(t, os.WriteFile(filepath.Join(serverCtx.Config.RootDir, "files", dataSource), []byte("x"), 0o600))
+
+	// The corrupted file is kept if the snapshot to replace it is not found
+	_, err := executeFilesCmd(t, serverCtx, "fetch", "--height", "999")
+	require.ErrorContains(t, err, "snapshot not found")
+	require.True(t, fileCache.HasFile(dataSource))
+}
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
+
+	"github.com/cosmos/cosmos-sdk/server"
+
+	oracleindex "github.com/bandprotocol/chain/v3/x/oracle/index"
+)
+
+// OracleIndexCmd returns the command to manage the node-local index of resolved oracle requests.
+func OracleIndexCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oracle-index",
+		Short: "Manage the node-local index of resolved oracle requests",
+	}
+
+	cmd.AddCommand(RebuildOracleIndexCmd())
+
+	return cmd
+}
+
+// RebuildOracleIndexCmd returns the command to rebuild the oracle request index from the blocks of the node.
+func RebuildOracleIndexCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rebuild",
+		Short: "Rebuild the oracle request index from the blocks stored in the node",
+		Long: "Rebuild the oracle request index from the blocks stored in the node. " +
+			"The node has to be stopped before running this command. Requests in blocks that have been pruned " +
+			"or whose results are discarded (storage.discard_abci_responses in config.toml) cannot be indexed.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: config})
+			if err != nil {
+				return err
+			}
+			blockStore := cmtstore.NewBlockStore(blockStoreDB)
+			defer blockStore.Close()
+
+			stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: config})
+			if err != nil {
+				return err
+			}
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+			defer stateStore.Close()
+
+			index, err := oracleindex.Open(
+				config.RootDir,
+				server.GetAppDBBackend(serverCtx.Viper),
+				oracleindex.ConfigFromAppOptions(serverCtx.Viper),
+			)
+			if err != nil {
+				return err
+			}
+			defer index.Close()
+
+			if err := index.Reset(); err != nil {
+				return err
+			}
+
+			base, height := blockStore.Base(), blockStore.Height()
+			for h := base; h <= height; h++ {
+				blockMeta := blockStore.LoadBlockMeta(h)
+				if blockMeta == nil {
+					return fmt.Errorf("block meta at height %d not found", h)
+				}
+
+				res, err := stateStore.LoadFinalizeBlockResponse(h)
+				if err != nil {
+					return fmt.Errorf("failed to load block results at height %d: %w", h, err)
+				}
+
+				if err := index.IndexBlock(h, blockMeta.Header.Time, oracleindex.BlockEvents(*res)); err != nil {
+					return err
+				}
+
+				if h%10000 == 0 {
+					serverCtx.Logger.Info("indexed blocks", "height", h, "latest_height", height)
+				}
+			}
+
+			cmd.Printf("Rebuilt the oracle request index from height %d to %d\n", base, height)
+			return nil
+		},
+	}
+}
@@ -51,6 +51,7 @@ import (
 
 	band "github.com/bandprotocol/chain/v3/app"
 	"github.com/bandprotocol/chain/v3/x/oracle"
+	oracleindex "github.com/bandprotocol/chain/v3/x/oracle/index"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -173,13 +174,25 @@ func initCometConfig() *cmtcfg.Config {
 	return cfg
 }
 
+// BandAppConfig extends the SDK's server config with the config of the node-local services of BandChain.
+type BandAppConfig struct {
+	serverconfig.Config `mapstructure:",squash"`
+
+	OracleIndex oracleindex.Config `mapstructure:"oracle-index"`
+}
+
 func initAppConfig() (string, interface{}) {
 	// Can optionally overwrite the SDK's default server config.
 	srvCfg := serverconfig.DefaultConfig()
 	srvCfg.StateSync.SnapshotInterval = 20000
 	srvCfg.StateSync.SnapshotKeepRecent = 10
 
-	return serverconfig.DefaultConfigTemplate, srvCfg
+	bandAppConfig := BandAppConfig{
+		Config:      *srvCfg,
+		OracleIndex: oracleindex.DefaultConfig(),
+	}
+
+	return serverconfig.DefaultConfigTemplate + oracleindex.DefaultConfigTemplate, bandAppConfig
 }
 
 func initRootCmd(
@@ -216,6 +229,9 @@ func initRootCmd(
 		queryCommand(),
 		txCommand(basicManager),
 		keys.Commands(),
+		OracleIndexCmd(),
+		VerifyProofCmd(),
+		FilesCmd(),
 	)
 
 	// add rosetta
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/bandprotocol/chain/v3/client/grpc/node"
+	"github.com/bandprotocol/chain/v3/client/grpc/oracle/proof/verify"
+)
+
+const flagValidators = "validators"
+
+// VerifyProofCmd returns the command to verify the EVM proof bytes of an oracle result offline.
+func VerifyProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-proof [evm-proof-bytes]",
+		Short: "Verify the EVM proof bytes of an oracle result against a trusted validator set",
+		Long: "Verify the EVM proof bytes of an oracle result, as returned by the proof service, against a " +
+			"trusted validator set in the same way as the bridge contract does. The validator set is a JSON file " +
+			"of the response of the EVM validators query (/bandchain/v1/evm-validators). The proven result is " +
+			"printed if the proof is valid.",
+		Example: "bandd verify-proof 0x0000... --validators evm-validators.json --chain-id laozi-mainnet",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			if clientCtx.ChainID == "" {
+				return fmt.Errorf("--%s is required", flags.FlagChainID)
+			}
+
+			evmProofBytes, err := hex.DecodeString(strings.TrimPrefix(args[0], "0x"))
+			if err != nil {
+				return fmt.Errorf("invalid evm proof bytes: %w", err)
+			}
+
+			validatorsFile, err := cmd.Flags().GetString(flagValidators)
+			if err != nil {
+				return err
+			}
+			bz, err := os.ReadFile(validatorsFile)
+			if err != nil {
+				return err
+			}
+
+			var evmValidators node.EVMValidatorsResponse
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &evmValidators); err != nil {
+				return fmt.Errorf("invalid validators file: %w", err)
+			}
+
+			vals, err := verify.NewValidators(evmValidators.Validators)
+			if err != nil {
+				return err
+			}
+
+			result, err := verify.VerifyProof(evmProofBytes, clientCtx.ChainID, vals)
+			if err != nil {
+				return fmt.Errorf("invalid proof: %w", err)
+			}
+
+			return clientCtx.PrintProto(&result)
+		},
+	}
+
+	cmd.Flags().String(flagValidators, "", "Path to the JSON file of the trusted EVM validators")
+	cmd.Flags().String(flags.FlagChainID, "", "The chain ID of the proof")
+	cmd.Flags().StringP(flags.FlagOutput, "o", "text", "Output format (text|json)")
+	_ = cmd.MarkFlagRequired(flagValidators)
+
+	return cmd
+}
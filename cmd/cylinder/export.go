@@ -2,7 +2,6 @@ package main
 
 import (
 	"encoding/hex"
-	"encoding/json"
 	"os"
 	"strconv"
 
@@ -22,9 +21,11 @@ const (
 func exportCmd(ctx *context.Context) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "export",
-		Short: "Export data in cylinder's store",
+		Short: "Export data in cylinder's store to a password-protected archive",
 	}
 
+	cmd.PersistentFlags().Int(flagArchivePassphraseFD, -1, "File descriptor to read the archive passphrase from")
+
 	cmd.AddCommand(
 		exportGroupsCmd(ctx),
 		exportDKGsCmd(ctx),
@@ -41,6 +42,10 @@ func exportGroupsCmd(ctx *context.Context) *cobra.Command {
 		Short: "Export groups data",
 		Args:  cobra.MinimumNArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := unlockStore(cmd, ctx); err != nil {
+				return err
+			}
+
 			// get 'all' flag
 			all, err := cmd.Flags().GetBool(flagAll)
 			if err != nil {
@@ -76,26 +81,8 @@ func exportGroupsCmd(ctx *context.Context) *cobra.Command {
 				}
 			}
 
-			// marshal data of groups to json
-			bytes, err := json.Marshal(groups)
-			if err != nil {
-				return err
-			}
-
-			// create file
-			f, err := os.Create(output)
-			if err != nil {
-				return err
-			}
-			defer f.Close()
-
-			// write data to the file
-			_, err = f.Write(bytes)
-			if err != nil {
-				return err
-			}
-
-			return nil
+			// write the archive of groups to the file
+			return writeArchive(cmd, output, store.Archive{Groups: groups})
 		},
 	}
 
@@ -114,6 +101,10 @@ func exportDKGsCmd(ctx *context.Context) *cobra.Command {
 		Short: "Export DKGs data",
 		Args:  cobra.MinimumNArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := unlockStore(cmd, ctx); err != nil {
+				return err
+			}
+
 			// get 'all' flag
 			all, err := cmd.Flags().GetBool(flagAll)
 			if err != nil {
@@ -149,26 +140,8 @@ func exportDKGsCmd(ctx *context.Context) *cobra.Command {
 				}
 			}
 
-			// marshal data of dkgs to json
-			bytes, err := json.Marshal(dkgs)
-			if err != nil {
-				return err
-			}
-
-			// create file
-			f, err := os.Create(output)
-			if err != nil {
-				return err
-			}
-			defer f.Close()
-
-			// write data to the file
-			_, err = f.Write(bytes)
-			if err != nil {
-				return err
-			}
-
-			return nil
+			// write the archive of dkgs to the file
+			return writeArchive(cmd, output, store.Archive{DKGs: dkgs})
 		},
 	}
 
@@ -187,6 +160,10 @@ func exportDEsCmd(ctx *context.Context) *cobra.Command {
 		Short: "Export DEs data",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := unlockStore(cmd, ctx); err != nil {
+				return err
+			}
+
 			// get 'output' flag
 			output, err := cmd.Flags().GetString(flagOutput)
 			if err != nil {
@@ -199,26 +176,8 @@ func exportDEsCmd(ctx *context.Context) *cobra.Command {
 				return err
 			}
 
-			// marshal data of DEs to json
-			bytes, err := json.Marshal(des)
-			if err != nil {
-				return err
-			}
-
-			// create file
-			f, err := os.Create(output)
-			if err != nil {
-				return err
-			}
-			defer f.Close()
-
-			// write data to the file
-			_, err = f.Write(bytes)
-			if err != nil {
-				return err
-			}
-
-			return nil
+			// write the archive of DEs to the file
+			return writeArchive(cmd, output, store.Archive{DEs: des})
 		},
 	}
 
@@ -228,3 +187,18 @@ func exportDEsCmd(ctx *context.Context) *cobra.Command {
 
 	return cmd
 }
+
+// writeArchive writes the archive protected by the archive passphrase to the output file.
+func writeArchive(cmd *cobra.Command, output string, archive store.Archive) error {
+	passphrase, err := readPassphrase(cmd, archivePassphrase, true)
+	if err != nil {
+		return err
+	}
+
+	bytes, err := store.EncryptArchive(archive, passphrase)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(output, bytes, 0o600)
+}
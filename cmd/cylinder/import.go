@@ -1,8 +1,6 @@
 package main
 
 import (
-	"encoding/json"
-	"io"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -15,9 +13,11 @@ import (
 func importCmd(ctx *context.Context) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "import",
-		Short: "Import data in cylinder's store",
+		Short: "Import data in cylinder's store from a password-protected archive",
 	}
 
+	cmd.PersistentFlags().Int(flagArchivePassphraseFD, -1, "File descriptor to read the archive passphrase from")
+
 	cmd.AddCommand(
 		importGroupsCmd(ctx),
 		importDKGsCmd(ctx),
@@ -30,38 +30,16 @@ func importCmd(ctx *context.Context) *cobra.Command {
 // importGroupsCmd returns a Cobra command for importing groups data
 func importGroupsCmd(ctx *context.Context) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "groups [path_to_json_file]",
+		Use:   "groups [path_to_archive_file]",
 		Short: "Import groups data",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// open the file
-			jsonFile, err := os.Open(args[0])
-			if err != nil {
-				return err
-			}
-			defer jsonFile.Close()
-
-			// read the file as bytes
-			bytes, err := io.ReadAll(jsonFile)
-			if err != nil {
-				return err
-			}
-
-			// unmarshal json to data
-			var groups []store.Group
-			err = json.Unmarshal(bytes, &groups)
+			archive, err := readArchive(cmd, ctx, args[0])
 			if err != nil {
 				return err
 			}
 
-			// loop to set each group to store
-			for _, group := range groups {
-				if err = ctx.Store.SetGroup(group); err != nil {
-					return err
-				}
-			}
-
-			return nil
+			return ctx.Store.Import(store.Archive{Groups: archive.Groups})
 		},
 	}
 
@@ -71,39 +49,16 @@ func importGroupsCmd(ctx *context.Context) *cobra.Command {
 // importDKGsCmd returns a Cobra command for importing dkgs data
 func importDKGsCmd(ctx *context.Context) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "dkgs [path_to_json_file]",
+		Use:   "dkgs [path_to_archive_file]",
 		Short: "Import DKGs data",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// open the file
-			jsonFile, err := os.Open(args[0])
+			archive, err := readArchive(cmd, ctx, args[0])
 			if err != nil {
 				return err
 			}
-			defer jsonFile.Close()
 
-			// read the file as bytes
-			bytes, err := io.ReadAll(jsonFile)
-			if err != nil {
-				return err
-			}
-
-			// unmarshal json to data
-			var dkgs []store.DKG
-			err = json.Unmarshal(bytes, &dkgs)
-			if err != nil {
-				return err
-			}
-
-			// loop to set each dkg to store
-			for _, dkg := range dkgs {
-				err = ctx.Store.SetDKG(dkg)
-				if err != nil {
-					return err
-				}
-			}
-
-			return nil
+			return ctx.Store.Import(store.Archive{DKGs: archive.DKGs})
 		},
 	}
 
@@ -113,40 +68,37 @@ func importDKGsCmd(ctx *context.Context) *cobra.Command {
 // importDEsCmd returns a Cobra command for importing des data
 func importDEsCmd(ctx *context.Context) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "des [path_to_json_file]",
+		Use:   "des [path_to_archive_file]",
 		Short: "Import DEs data",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// open the file
-			jsonFile, err := os.Open(args[0])
+			archive, err := readArchive(cmd, ctx, args[0])
 			if err != nil {
 				return err
 			}
-			defer jsonFile.Close()
 
-			// read the file as bytes
-			bytes, err := io.ReadAll(jsonFile)
-			if err != nil {
-				return err
-			}
+			return ctx.Store.Import(store.Archive{DEs: archive.DEs})
+		},
+	}
 
-			// unmarshal json to data
-			var des []store.DE
-			if err := json.Unmarshal(bytes, &des); err != nil {
-				panic(err)
-			}
+	return cmd
+}
 
-			// loop to set each de to store
-			for _, de := range des {
-				err = ctx.Store.SetDE(de)
-				if err != nil {
-					return err
-				}
-			}
+// readArchive unlocks the store and reads the archive protected by the archive passphrase from the file.
+func readArchive(cmd *cobra.Command, ctx *context.Context, path string) (store.Archive, error) {
+	if err := unlockStore(cmd, ctx); err != nil {
+		return store.Archive{}, err
+	}
 
-			return nil
-		},
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return store.Archive{}, err
 	}
 
-	return cmd
+	passphrase, err := readPassphrase(cmd, archivePassphrase, false)
+	if err != nil {
+		return store.Archive{}, err
+	}
+
+	return store.DecryptArchive(bytes, passphrase)
 }
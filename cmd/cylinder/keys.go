@@ -176,6 +176,11 @@ func keysListCmd(ctx *cylinderctx.Context) *cobra.Command {
 				return err
 			}
 
+			granters, err := ctx.Config.GetGranters()
+			if err != nil {
+				return err
+			}
+
 			// Check if the "--address" flag is provided
 			isShowAddr, err := cmd.Flags().GetBool(flagAddress)
 			if err != nil {
@@ -192,19 +197,22 @@ func keysListCmd(ctx *cylinderctx.Context) *cobra.Command {
 				if isShowAddr {
 					fmt.Printf("%s ", address.String())
 				} else {
-					// Query if the key is a grantee and display the result
+					// Query if the key is a grantee of every granter and display the result, as
+					// the key sends messages of all granters
 					queryClient := types.NewQueryClient(clientCtx)
-					r, err := queryClient.IsGrantee(
-						context.Background(),
-						&types.QueryIsGranteeRequest{Granter: ctx.Config.Granter, Grantee: address.String()},
-					)
-
-					s := ":question:"
-					if err == nil {
-						if r.IsGrantee {
-							s = ":white_check_mark:"
-						} else {
+					s := ":white_check_mark:"
+					for _, granter := range granters {
+						r, err := queryClient.IsGrantee(
+							context.Background(),
+							&types.QueryIsGranteeRequest{Granter: granter.Address, Grantee: address.String()},
+						)
+						if err != nil {
+							s = ":question:"
+							break
+						}
+						if !r.IsGrantee {
 							s = ":x:"
+							break
 						}
 					}
 					emoji.Printf("%s%s => %s\n", s, key.Name, address.String())
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/input"
+
+	"github.com/bandprotocol/chain/v3/cylinder/context"
+	"github.com/bandprotocol/chain/v3/cylinder/store"
+)
+
+const (
+	flagPassphraseFD        = "passphrase-fd"
+	flagArchivePassphraseFD = "archive-passphrase-fd"
+
+	envPassphrase        = "CYLINDER_PASSPHRASE"
+	envArchivePassphrase = "CYLINDER_ARCHIVE_PASSPHRASE"
+)
+
+// passphraseSource describes where a passphrase can be read from.
+type passphraseSource struct {
+	fdFlag string // Flag of the file descriptor to read the passphrase from
+	env    string // Environment variable that holds the passphrase
+	prompt string // Prompt to ask for the passphrase on the terminal
+}
+
+var (
+	storePassphrase = passphraseSource{
+		fdFlag: flagPassphraseFD,
+		env:    envPassphrase,
+		prompt: "Enter store passphrase: ",
+	}
+	archivePassphrase = passphraseSource{
+		fdFlag: flagArchivePassphraseFD,
+		env:    envArchivePassphrase,
+		prompt: "Enter archive passphrase: ",
+	}
+)
+
+// readPassphrase reads the passphrase from the environment variable, then from the file descriptor
+// if it is given, and otherwise asks for it on the terminal. A new passphrase is asked twice.
+func readPassphrase(cmd *cobra.Command, source passphraseSource, isNew bool) ([]byte, error) {
+	if passphrase, ok := os.LookupEnv(source.env); ok {
+		return []byte(passphrase), nil
+	}
+
+	fd, err := cmd.Flags().GetInt(source.fdFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	if fd >= 0 {
+		f := os.NewFile(uintptr(fd), source.fdFlag)
+		if f == nil {
+			return nil, fmt.Errorf("invalid file descriptor: %d", fd)
+		}
+		defer f.Close()
+
+		line, err := bufio.NewReader(f).ReadString('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+
+		return []byte(strings.TrimRight(line, "\r\n")), nil
+	}
+
+	buf := bufio.NewReader(cmd.InOrStdin())
+	passphrase, err := input.GetPassword(source.prompt, buf)
+	if err != nil {
+		return nil, err
+	}
+
+	if isNew {
+		confirmation, err := input.GetPassword("Repeat the passphrase: ", buf)
+		if err != nil {
+			return nil, err
+		}
+		if passphrase != confirmation {
+			return nil, fmt.Errorf("passphrases don't match")
+		}
+	}
+
+	return []byte(passphrase), nil
+}
+
+// unlockStore unlocks the store of the context with the store passphrase.
+func unlockStore(cmd *cobra.Command, ctx *context.Context) error {
+	return unlockStores(cmd, ctx.Store)
+}
+
+// unlockStores unlocks all the given stores with the same store passphrase, which is asked to be
+// confirmed if any of the stores is new.
+func unlockStores(cmd *cobra.Command, stores ...*store.Store) error {
+	isNew := false
+	for _, s := range stores {
+		encrypted, err := s.IsEncrypted()
+		if err != nil {
+			return err
+		}
+		isNew = isNew || !encrypted
+	}
+
+	passphrase, err := readPassphrase(cmd, storePassphrase, isNew)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range stores {
+		if err := s.Unlock(passphrase); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
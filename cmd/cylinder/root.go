@@ -24,6 +24,10 @@ import (
 	"github.com/bandprotocol/chain/v3/pkg/tss"
 )
 
+const (
+	flagGranterName = "granter-name"
+)
+
 func hexByteToScalarHookFunc() mapstructure.DecodeHookFunc {
 	return func(
 		from reflect.Type, // data type
@@ -74,12 +78,17 @@ func NewRootCmd(ctx *context.Context) *cobra.Command {
 		keysCmd(ctx),
 		importCmd(ctx),
 		exportCmd(ctx),
+		storeCmd(ctx),
+		signerCmd(ctx),
+		statusCmd(ctx),
 		runCmd(ctx),
 		version.NewVersionCommand(),
 	)
 
 	rootCmd.PersistentPreRunE = createPersistentPreRunE(rootCmd, ctx)
 	rootCmd.PersistentFlags().StringVar(&ctx.Home, flags.FlagHome, getDefaultHome(), "home directory")
+	rootCmd.PersistentFlags().Int(flagPassphraseFD, -1, "File descriptor to read the store passphrase from")
+	rootCmd.PersistentFlags().String(flagGranterName, "", "Name of the granter in the granters config whose store is used")
 
 	return rootCmd
 }
@@ -140,10 +149,31 @@ func createPersistentPreRunE(rootCmd *cobra.Command, ctx *context.Context) func(
 		}
 		*ctx = *newCtx
 
-		return initConfig(ctx, rootCmd)
+		if err := initConfig(ctx, rootCmd); err != nil {
+			return err
+		}
+
+		// open the store of the selected granter
+		granterName, err := rootCmd.PersistentFlags().GetString(flagGranterName)
+		if err != nil {
+			return err
+		}
+
+		ctx.Store, err = ctx.OpenStore(granterName)
+		return err
 	}
 }
 
+// selectedGranter returns the config of the granter selected by the granter-name flag.
+func selectedGranter(cmd *cobra.Command, ctx *context.Context) (context.GranterConfig, error) {
+	name, err := cmd.Flags().GetString(flagGranterName)
+	if err != nil {
+		return context.GranterConfig{}, err
+	}
+
+	return ctx.Config.GetGranter(name)
+}
+
 func getDefaultHome() string {
 	userHomeDir, err := os.UserHomeDir()
 	if err != nil {
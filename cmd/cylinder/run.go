@@ -10,6 +10,9 @@ import (
 
 	"github.com/bandprotocol/chain/v3/cylinder"
 	"github.com/bandprotocol/chain/v3/cylinder/context"
+	"github.com/bandprotocol/chain/v3/cylinder/metrics"
+	"github.com/bandprotocol/chain/v3/cylinder/signer"
+	"github.com/bandprotocol/chain/v3/cylinder/store"
 	"github.com/bandprotocol/chain/v3/cylinder/workers/de"
 	"github.com/bandprotocol/chain/v3/cylinder/workers/group"
 	"github.com/bandprotocol/chain/v3/cylinder/workers/sender"
@@ -24,10 +27,20 @@ const (
 	flagRPCPollInterval    = "rpc-poll-interval"
 	flagMaxTry             = "max-try"
 	flagMinDE              = "min-de"
+	flagMaxDE              = "max-de"
+	flagDEDemandWindow     = "de-demand-window"
 	flagGasAdjustStart     = "gas-adjust-start"
 	flagGasAdjustStep      = "gas-adjust-step"
 	flagRandomSecret       = "random-secret"
 	flagCheckingDEInterval = "checking-de-interval"
+	flagVerifierNode       = "verifier-node"
+	flagSkipVerification   = "skip-signing-verification"
+	flagAllowedContents    = "allowed-content-types"
+	flagDeniedContents     = "denied-content-types"
+	flagAllowedOriginators = "allowed-originators"
+	flagDeniedOriginators  = "denied-originators"
+	flagRemoteSigner       = "remote-signer"
+	flagMetricsListenAddr  = "metrics-listen-addr"
 )
 
 // runCmd returns a Cobra command to run the cylinder process.
@@ -38,17 +51,22 @@ func runCmd(ctx *context.Context) *cobra.Command {
 		Short:   "Run the cylinder process",
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			group, err := group.New(ctx)
+			granters, err := newGranters(cmd, ctx)
 			if err != nil {
 				return err
 			}
 
-			de, err := de.New(ctx)
+			group, err := group.New(ctx, granters)
 			if err != nil {
 				return err
 			}
 
-			signing, err := signing.New(ctx)
+			de, err := de.New(ctx, granters)
+			if err != nil {
+				return err
+			}
+
+			signing, err := signing.New(ctx, granters)
 			if err != nil {
 				return err
 			}
@@ -60,6 +78,14 @@ func runCmd(ctx *context.Context) *cobra.Command {
 
 			workers := cylinder.Workers{group, de, signing, sender}
 
+			if ctx.Config.MetricsListenAddr != "" {
+				go func() {
+					if err := metrics.Listen(ctx.Config.MetricsListenAddr); err != nil {
+						ctx.ErrCh <- err
+					}
+				}()
+			}
+
 			return cylinder.Run(ctx, workers)
 		},
 	}
@@ -74,15 +100,27 @@ func runCmd(ctx *context.Context) *cobra.Command {
 	cmd.Flags().String(flagRPCPollInterval, "1s", "The duration of rpc poll interval")
 	cmd.Flags().Uint64(flagMaxTry, 5, "The maximum number of tries to submit a transaction")
 	cmd.Flags().Uint64(flagMinDE, 5, "The minimum number of DE")
+	cmd.Flags().Uint64(flagMaxDE, 0, "The maximum number of DE on chain, only bounded by the chain if zero")
+	cmd.Flags().Duration(flagDEDemandWindow, 10*time.Minute, "The window of recent DE usage to forecast the DE demand from")
 	cmd.Flags().Float64(flagGasAdjustStart, 1.6, "The start value of gas adjustment")
 	cmd.Flags().Float64(flagGasAdjustStep, 0.2, "The increment step of gad adjustment")
 	cmd.Flags().BytesHex(flagRandomSecret, nil, "The secret value that is used for random D,E")
 	cmd.Flags().Duration(flagCheckingDEInterval, 5*time.Minute, "The interval of checking DE")
+	cmd.Flags().String(flagVerifierNode, "", "RPC url to a second BandChain node to verify signing messages with")
+	cmd.Flags().Bool(flagSkipVerification, false, "Sign messages without verifying them (not recommended)")
+	cmd.Flags().StringSlice(flagAllowedContents, nil, "Content types allowed to be signed (text, feeds, tunnel, oracle, bandtss)")
+	cmd.Flags().StringSlice(flagDeniedContents, nil, "Content types denied to be signed")
+	cmd.Flags().StringSlice(flagAllowedOriginators, nil, "Originators allowed to be signed (e.g. direct, tunnel:1)")
+	cmd.Flags().StringSlice(flagDeniedOriginators, nil, "Originators denied to be signed")
+	cmd.Flags().String(flagRemoteSigner, "", "Address of a remote signer (e.g. unix:///path/to/signer.sock)")
+	cmd.Flags().String(flagMetricsListenAddr, "", "Address to serve Prometheus metrics on (e.g. :9100)")
 
 	flagNames := []string{
 		flags.FlagChainID, flags.FlagNode, flagGranter, flags.FlagGasPrices, flagLogLevel,
-		flagMaxMessages, flagBroadcastTimeout, flagRPCPollInterval, flagMaxTry, flagMinDE,
+		flagMaxMessages, flagBroadcastTimeout, flagRPCPollInterval, flagMaxTry, flagMinDE, flagMaxDE, flagDEDemandWindow,
 		flagGasAdjustStart, flagGasAdjustStep, flagRandomSecret, flagCheckingDEInterval,
+		flagVerifierNode, flagSkipVerification, flagAllowedContents, flagDeniedContents,
+		flagAllowedOriginators, flagDeniedOriginators, flagRemoteSigner, flagMetricsListenAddr,
 	}
 
 	for _, flagName := range flagNames {
@@ -93,3 +131,43 @@ func runCmd(ctx *context.Context) *cobra.Command {
 
 	return cmd
 }
+
+// newGranters returns all granters in the config with their signers. A granter uses its remote
+// signer if it is configured, otherwise the signer that keeps the secrets in its own local store.
+// The local stores are unlocked with the same passphrase.
+func newGranters(cmd *cobra.Command, ctx *context.Context) (cylinder.Granters, error) {
+	granterConfigs, err := ctx.Config.GetGranters()
+	if err != nil {
+		return nil, err
+	}
+
+	var localStores []*store.Store
+	granters := make(cylinder.Granters, len(granterConfigs))
+	for i, cfg := range granterConfigs {
+		granters[i].GranterConfig = cfg
+
+		if cfg.RemoteSigner != "" {
+			ctx.Logger.Info(":key: Using remote signer at %s for granter %s", cfg.RemoteSigner, cfg.Address)
+			granters[i].Signer, err = signer.NewRemoteSigner(cfg.RemoteSigner)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		s, err := ctx.OpenStore(cfg.Name)
+		if err != nil {
+			return nil, err
+		}
+		localStores = append(localStores, s)
+		granters[i].Signer = signer.NewLocalSigner(s, cfg.RandomSecret)
+	}
+
+	if len(localStores) > 0 {
+		if err := unlockStores(cmd, localStores...); err != nil {
+			return nil, err
+		}
+	}
+
+	return granters, nil
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"errors"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bandprotocol/chain/v3/cylinder/client"
+	"github.com/bandprotocol/chain/v3/cylinder/context"
+	"github.com/bandprotocol/chain/v3/cylinder/signer"
+	"github.com/bandprotocol/chain/v3/cylinder/workers/signing"
+)
+
+// signerCmd returns a Cobra command for running the remote signer.
+func signerCmd(ctx *context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer",
+		Short: "Run the remote signer that keeps the TSS secrets for a cylinder process",
+	}
+
+	cmd.AddCommand(
+		signerStartCmd(ctx),
+	)
+
+	return cmd
+}
+
+// signerStartCmd returns a Cobra command for serving the secrets in the store to a cylinder process.
+func signerStartCmd(ctx *context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start [address]",
+		Short: "Serve the TSS secrets in the store on the address (e.g. unix:///path/to/signer.sock)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			network, address, err := signer.ParseAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			granter, err := selectedGranter(cmd, ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := unlockStore(cmd, ctx); err != nil {
+				return err
+			}
+
+			verifier, err := newSignerVerifier(ctx)
+			if err != nil {
+				return err
+			}
+
+			listener, err := net.Listen(network, address)
+			if err != nil {
+				return err
+			}
+
+			// Only the owner of the socket can connect to the signer
+			if err := os.Chmod(address, 0o600); err != nil {
+				listener.Close()
+				return err
+			}
+
+			// Close the listener on interrupt, which also removes the unix socket
+			sigCh := make(chan os.Signal, 1)
+			signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+			go func() {
+				<-sigCh
+				listener.Close()
+			}()
+
+			ctx.Logger.Info(":key: Remote signer is listening on %s", args[0])
+
+			localSigner := signer.NewLocalSigner(ctx.Store, granter.RandomSecret)
+			err = signer.Serve(listener, localSigner, verifier)
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+
+			return err
+		},
+	}
+
+	return cmd
+}
+
+// newSignerVerifier creates the verifier that the signer checks signing messages with, using the
+// verifier node (or the node if unset) and the signing policy in the config. It returns nil if
+// signing verification is skipped.
+func newSignerVerifier(ctx *context.Context) (signer.MessageVerifier, error) {
+	cfg := ctx.Config
+	if cfg.SkipSigningVerification {
+		ctx.Logger.Warn(":warning: Signing verification is skipped, the signer signs any message it is asked to")
+		return nil, nil
+	}
+
+	nodeURI := cfg.VerifierNodeURI
+	if nodeURI == "" {
+		nodeURI = cfg.NodeURI
+	}
+	cli, err := client.NewWithNode(ctx, nodeURI)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := signing.NewPolicy(
+		cfg.AllowedContentTypes,
+		cfg.DeniedContentTypes,
+		cfg.AllowedOriginators,
+		cfg.DeniedOriginators,
+	)
+
+	return signing.NewVerifier(cli, ctx.Cdc, policy), nil
+}
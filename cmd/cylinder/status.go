@@ -0,0 +1,181 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/input"
+
+	"github.com/bandprotocol/chain/v3/cylinder/client"
+	"github.com/bandprotocol/chain/v3/cylinder/context"
+	"github.com/bandprotocol/chain/v3/cylinder/status"
+	"github.com/bandprotocol/chain/v3/x/tss/types"
+)
+
+const (
+	flagPrune         = "prune"
+	flagYes           = "yes"
+	flagDEGracePeriod = "de-grace-period"
+)
+
+// statusCmd returns a Cobra command for reconciling the store with the chain state of the granter.
+func statusCmd(ctx *context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the groups, DKGs and DEs in the store and compare them with the chain",
+		Long: "Show the groups, DKGs and DEs in the store and compare them with the chain. " +
+			"With --prune, orphaned groups, DKGs and DEs are deleted from the store after a confirmation. " +
+			"Groups and DKGs not found on chain and DEs generated within --de-grace-period are never pruned. " +
+			"The cylinder process has to be stopped before running this command. " +
+			"It is not supported for a granter with a remote signer.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			granter, err := selectedGranter(cmd, ctx)
+			if err != nil {
+				return err
+			}
+
+			// The secrets of a granter with a remote signer are kept by the signer, not in the local store
+			if granter.RemoteSigner != "" {
+				return fmt.Errorf(
+					"status is not supported for granter %s, whose secrets are kept by the remote signer at %s",
+					granter.Address,
+					granter.RemoteSigner,
+				)
+			}
+
+			if err := unlockStore(cmd, ctx); err != nil {
+				return err
+			}
+
+			cli, err := client.New(ctx)
+			if err != nil {
+				return err
+			}
+			defer cli.Stop()
+
+			deGracePeriod, err := cmd.Flags().GetDuration(flagDEGracePeriod)
+			if err != nil {
+				return err
+			}
+
+			report, err := status.Reconcile(cli, ctx.Store, granter.Address, deGracePeriod, time.Now())
+			if err != nil {
+				return err
+			}
+
+			if err := printReport(report); err != nil {
+				return err
+			}
+
+			prune, err := cmd.Flags().GetBool(flagPrune)
+			if err != nil {
+				return err
+			}
+
+			if !prune || !report.HasOrphaned() {
+				return nil
+			}
+
+			yes, err := cmd.Flags().GetBool(flagYes)
+			if err != nil {
+				return err
+			}
+
+			if !yes {
+				// Ask for confirmation from the user, as the deleted secrets cannot be recovered
+				confirmInput, err := input.GetString(
+					fmt.Sprintf(
+						"\n%d group(s), %d DKG(s) and %d DE(s) will be deleted. Continue?[y/N]",
+						len(report.OrphanedGroups),
+						len(report.OrphanedDKGs),
+						len(report.OrphanedDEs),
+					),
+					bufio.NewReader(cmd.InOrStdin()),
+				)
+				if err != nil {
+					return err
+				}
+
+				if confirmInput != "y" {
+					fmt.Println("Cancel")
+					return nil
+				}
+			}
+
+			if err := status.Prune(ctx.Store, report); err != nil {
+				return err
+			}
+
+			fmt.Printf(
+				"\nPruned %d group(s), %d DKG(s) and %d DE(s)\n",
+				len(report.OrphanedGroups),
+				len(report.OrphanedDKGs),
+				len(report.OrphanedDEs),
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagPrune, false, "Delete orphaned groups, DKGs and DEs from the store")
+	cmd.Flags().Bool(flagYes, false, "Skip the confirmation before pruning")
+	cmd.Flags().Duration(
+		flagDEGracePeriod,
+		10*time.Minute,
+		"Period after generating a DE in which it is not orphaned even if it is not on chain",
+	)
+
+	return cmd
+}
+
+// printReport prints the report as tables of groups, DKGs and DEs.
+func printReport(report status.Report) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "GROUP ID\tMEMBER ID\tSTATUS\tPUBLIC KEY\tORPHANED")
+	orphanedGroups := make(map[string]bool)
+	for _, group := range report.OrphanedGroups {
+		orphanedGroups[group.PubKey.String()] = true
+	}
+	for _, group := range report.Groups {
+		groupStatus := group.Status.String()
+		if group.Status == types.GROUP_STATUS_UNSPECIFIED {
+			groupStatus = "not found on chain"
+		}
+		fmt.Fprintf(
+			w, "%d\t%d\t%s\t%s\t%t\n",
+			group.GroupID, group.MemberID, groupStatus, group.PubKey, orphanedGroups[group.PubKey.String()],
+		)
+	}
+	for _, gid := range report.MissingGroups {
+		fmt.Fprintf(w, "%d\t-\tmissing in store\t-\tfalse\n", gid)
+	}
+
+	fmt.Fprintln(w, "\nDKG GROUP ID\tMEMBER ID\tSTATUS\tORPHANED")
+	orphanedDKGs := make(map[uint64]bool)
+	for _, dkg := range report.OrphanedDKGs {
+		orphanedDKGs[uint64(dkg.GroupID)] = true
+	}
+	for _, dkg := range report.DKGs {
+		dkgStatus := dkg.Status.String()
+		if dkg.Status == types.GROUP_STATUS_UNSPECIFIED {
+			dkgStatus = "not found on chain"
+		}
+		fmt.Fprintf(w, "%d\t%d\t%s\t%t\n", dkg.GroupID, dkg.MemberID, dkgStatus, orphanedDKGs[uint64(dkg.GroupID)])
+	}
+
+	fmt.Fprintln(w, "\nDE\tCOUNT")
+	fmt.Fprintf(w, "on chain\t%d\n", report.OnChainDEs)
+	fmt.Fprintf(w, "in store\t%d\n", report.LocalDEs)
+	fmt.Fprintf(w, "in use by pending signings\t%d\n", report.InUseDEs)
+	fmt.Fprintf(w, "recently generated\t%d\n", report.RecentDEs)
+	fmt.Fprintf(w, "orphaned in store\t%d\n", len(report.OrphanedDEs))
+	fmt.Fprintf(w, "missing in store\t%d\n", len(report.MissingDEs))
+
+	return w.Flush()
+}
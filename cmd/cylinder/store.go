@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bandprotocol/chain/v3/cylinder/context"
+)
+
+// storeCmd returns a Cobra command for managing cylinder's store.
+func storeCmd(ctx *context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store",
+		Short: "Manage cylinder's store",
+	}
+
+	cmd.AddCommand(
+		storeMigrateCmd(ctx),
+	)
+
+	return cmd
+}
+
+// storeMigrateCmd returns a Cobra command for encrypting a store that was written without encryption.
+func storeMigrateCmd(ctx *context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Encrypt all data of a store written by an earlier version of cylinder",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			encrypted, err := ctx.Store.IsEncrypted()
+			if err != nil {
+				return err
+			}
+			if encrypted {
+				return fmt.Errorf("store is already encrypted")
+			}
+
+			passphrase, err := readPassphrase(cmd, storePassphrase, true)
+			if err != nil {
+				return err
+			}
+
+			if err := ctx.Store.Migrate(passphrase); err != nil {
+				return err
+			}
+
+			cmd.Println("Store has been encrypted")
+			return nil
+		},
+	}
+
+	return cmd
+}
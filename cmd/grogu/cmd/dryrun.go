@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	grogu "github.com/bandprotocol/chain/v3/grogu/context"
+	"github.com/bandprotocol/chain/v3/grogu/dryrun"
+)
+
+func DryRunSummaryCmd(ctx *grogu.Context) *cobra.Command {
+	return &cobra.Command{
+		Use:   "dry-run-summary [log-file]",
+		Short: "Summarize signal prices recorded in dry-run mode",
+		Long: "Summarize signal prices recorded by `grogu run --dry-run`. " +
+			"The log file defaults to <home>/dry-run.jsonl.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logPath := filepath.Join(ctx.Home, "dry-run.jsonl")
+			if len(args) == 1 {
+				logPath = args[0]
+			}
+
+			file, err := os.Open(logPath)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			summaries, err := dryrun.Summarize(file)
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(
+				w,
+				"SIGNAL ID\tSUBMISSIONS\tAVAILABLE\tUNAVAILABLE\tUNSUPPORTED\tMEAN INTERVAL\tMEAN DEV (BPS)\tMAX DEV (BPS)",
+			)
+			for _, s := range summaries {
+				meanInterval := time.Duration(s.MeanIntervalSeconds * float64(time.Second)).Round(time.Second)
+				meanDeviation, maxDeviation := "-", "-"
+				if s.Compared > 0 {
+					meanDeviation = fmt.Sprintf("%.2f", s.MeanDeviationBps)
+					maxDeviation = fmt.Sprintf("%d", s.MaxDeviationBps)
+				}
+				fmt.Fprintf(
+					w,
+					"%s\t%d\t%d\t%d\t%d\t%s\t%s\t%s\n",
+					s.SignalID,
+					s.Submissions,
+					s.Available,
+					s.Unavailable,
+					s.Unsupported,
+					meanInterval,
+					meanDeviation,
+					maxDeviation,
+				)
+			}
+
+			return w.Flush()
+		},
+	}
+}
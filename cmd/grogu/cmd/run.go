@@ -3,6 +3,7 @@ package cmd
 import (
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -18,9 +19,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	bothanclient "github.com/bandprotocol/bothan/bothan-api/client/go-client"
-
 	"github.com/bandprotocol/chain/v3/grogu/context"
+	"github.com/bandprotocol/chain/v3/grogu/dryrun"
+	"github.com/bandprotocol/chain/v3/grogu/metrics"
 	"github.com/bandprotocol/chain/v3/grogu/querier"
 	"github.com/bandprotocol/chain/v3/grogu/signaller"
 	"github.com/bandprotocol/chain/v3/grogu/submitter"
@@ -36,10 +37,19 @@ const (
 	flagMaxTry               = "max-try"
 	flagBothan               = "bothan"
 	flagBothanTimeout        = "bothan-timeout"
+	flagBothanQuorumPolicy   = "bothan-quorum-policy"
+	flagBothanMinAgreement   = "bothan-min-agreement"
+	flagBothanAgreementBps   = "bothan-agreement-bps"
+	flagPriceGuardBps        = "price-guard-bps"
+	flagPriceGuardAction     = "price-guard-action"
 	flagDistrStartPct        = "distribution-start-pct"
 	flagDistrOffsetPct       = "distribution-offset-pct"
 	flagLogLevel             = "log-level"
 	flagUpdaterQueryInterval = "updater-query-interval"
+	flagMetricsListenAddr    = "metrics-listen-addr"
+	flagDryRun               = "dry-run"
+	flagDryRunLog            = "dry-run-log"
+	flagDryRunCompareDelay   = "dry-run-compare-delay"
 )
 
 func RunCmd(ctx *context.Context) *cobra.Command {
@@ -60,10 +70,35 @@ func RunCmd(ctx *context.Context) *cobra.Command {
 	cmd.Flags().Uint64(flagMaxTry, 5, "The maximum number of attempts to submit a transaction.")
 	cmd.Flags().Uint64(flagDistrStartPct, 50, "The starting percentage for the distribution offset range.")
 	cmd.Flags().Uint64(flagDistrOffsetPct, 30, "The offset percentage range from the starting distribution.")
-	cmd.Flags().String(flagBothan, "", "The Bothan URL to connect to.")
+	cmd.Flags().String(flagBothan, "", "The comma-separated Bothan URLs to connect to.")
 	cmd.Flags().String(flagBothanTimeout, "3s", "The timeout duration for Bothan requests.")
+	cmd.Flags().String(
+		flagBothanQuorumPolicy,
+		string(signaller.QuorumPolicyMedian),
+		"The policy to combine prices from multiple Bothans (first-healthy, median or agreement).",
+	)
+	cmd.Flags().Uint64(flagBothanMinAgreement, 1, "The number of Bothans that must agree on a price.")
+	cmd.Flags().Uint64(flagBothanAgreementBps, 50, "The allowable deviation in basis points for Bothan prices to agree.")
+	cmd.Flags().Uint64(
+		flagPriceGuardBps,
+		0,
+		"The allowable deviation in basis points from the on-chain price, 0 to disable the guard.",
+	)
+	cmd.Flags().String(
+		flagPriceGuardAction,
+		string(signaller.PriceGuardActionUnavailable),
+		"The action for prices that fail the guard (unavailable or hold).",
+	)
 	cmd.Flags().String(flagLogLevel, "info", "The application's log level.")
 	cmd.Flags().String(flagUpdaterQueryInterval, "1m", "The interval for updater querying chain.")
+	cmd.Flags().String(flagMetricsListenAddr, "", "The address to serve metrics and /healthz on, empty to disable.")
+	cmd.Flags().Bool(flagDryRun, false, "Record signal prices to a log file instead of submitting them.")
+	cmd.Flags().String(flagDryRunLog, "", "The JSONL file to record dry-run prices to (default: <home>/dry-run.jsonl).")
+	cmd.Flags().String(
+		flagDryRunCompareDelay,
+		"10s",
+		"The duration to wait before comparing dry-run prices with the on-chain price.",
+	)
 
 	_ = viper.BindPFlag(flagValidator, cmd.Flags().Lookup(flagValidator))
 	_ = viper.BindPFlag(flagNodes, cmd.Flags().Lookup(flagNodes))
@@ -76,8 +111,17 @@ func RunCmd(ctx *context.Context) *cobra.Command {
 	_ = viper.BindPFlag(flagDistrOffsetPct, cmd.Flags().Lookup(flagDistrOffsetPct))
 	_ = viper.BindPFlag(flagBothan, cmd.Flags().Lookup(flagBothan))
 	_ = viper.BindPFlag(flagBothanTimeout, cmd.Flags().Lookup(flagBothanTimeout))
+	_ = viper.BindPFlag(flagBothanQuorumPolicy, cmd.Flags().Lookup(flagBothanQuorumPolicy))
+	_ = viper.BindPFlag(flagBothanMinAgreement, cmd.Flags().Lookup(flagBothanMinAgreement))
+	_ = viper.BindPFlag(flagBothanAgreementBps, cmd.Flags().Lookup(flagBothanAgreementBps))
+	_ = viper.BindPFlag(flagPriceGuardBps, cmd.Flags().Lookup(flagPriceGuardBps))
+	_ = viper.BindPFlag(flagPriceGuardAction, cmd.Flags().Lookup(flagPriceGuardAction))
 	_ = viper.BindPFlag(flagLogLevel, cmd.Flags().Lookup(flagLogLevel))
 	_ = viper.BindPFlag(flagUpdaterQueryInterval, cmd.Flags().Lookup(flagUpdaterQueryInterval))
+	_ = viper.BindPFlag(flagMetricsListenAddr, cmd.Flags().Lookup(flagMetricsListenAddr))
+	_ = viper.BindPFlag(flagDryRun, cmd.Flags().Lookup(flagDryRun))
+	_ = viper.BindPFlag(flagDryRunLog, cmd.Flags().Lookup(flagDryRunLog))
+	_ = viper.BindPFlag(flagDryRunCompareDelay, cmd.Flags().Lookup(flagDryRunCompareDelay))
 
 	return cmd
 }
@@ -124,10 +168,31 @@ func createRunE(ctx *context.Context) func(cmd *cobra.Command, args []string) er
 		if err != nil {
 			return err
 		}
-		bothanService, err := bothanclient.NewGrpcClient(ctx.Config.Bothan, timeout)
+		bothanEndpoints, err := createBothanEndpoints(strings.Split(ctx.Config.Bothan, ","), timeout)
+		if err != nil {
+			return err
+		}
+
+		// Parse Bothan quorum policy
+		quorumPolicy, err := signaller.ParseQuorumPolicy(ctx.Config.BothanQuorumPolicy)
+		if err != nil {
+			return err
+		}
+		quorumConfig := signaller.QuorumConfig{
+			Policy:              quorumPolicy,
+			MinAgreement:        int(ctx.Config.BothanMinAgreement),
+			AgreementBasisPoint: int64(ctx.Config.BothanAgreementBasisPoint),
+		}
+
+		// Parse price guard action
+		priceGuardAction, err := signaller.ParsePriceGuardAction(ctx.Config.PriceGuardAction)
 		if err != nil {
 			return err
 		}
+		priceGuardConfig := signaller.PriceGuardConfig{
+			MaxDeviationBasisPoint: int64(ctx.Config.PriceGuardBasisPoint),
+			Action:                 priceGuardAction,
+		}
 
 		// Create submit channel
 		submitSignalPriceCh := make(chan submitter.SignalPriceSubmission, 300)
@@ -159,11 +224,37 @@ func createRunE(ctx *context.Context) func(cmd *cobra.Command, args []string) er
 		// Initialize pending signal IDs map
 		pendingSignalIDs := sync.Map{}
 
+		// In dry-run mode, signal prices are recorded by the recorder instead of the submitter
+		var recorder *dryrun.Recorder
+		signallerFeedQuerier := signaller.FeedQuerier(feedQuerier)
+		if ctx.Config.DryRun {
+			compareDelay, err := time.ParseDuration(ctx.Config.DryRunCompareDelay)
+			if err != nil {
+				return err
+			}
+
+			logPath := ctx.Config.DryRunLog
+			if logPath == "" {
+				logPath = filepath.Join(ctx.Home, "dry-run.jsonl")
+			}
+			logFile, err := os.OpenFile(logPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+			if err != nil {
+				return err
+			}
+			defer logFile.Close()
+
+			recorder = dryrun.NewRecorder(feedQuerier, submitSignalPriceCh, l, &pendingSignalIDs, compareDelay, logFile)
+			signallerFeedQuerier = recorder.FeedQuerier()
+			l.Info("Dry-run mode is enabled, recording prices to %s", logPath)
+		}
+
 		// Setup Signaller
 		signallerService := signaller.New(
-			feedQuerier,
+			signallerFeedQuerier,
 			cometQuerier,
-			bothanService,
+			bothanEndpoints,
+			quorumConfig,
+			priceGuardConfig,
 			time.Second,
 			submitSignalPriceCh,
 			l,
@@ -174,23 +265,26 @@ func createRunE(ctx *context.Context) func(cmd *cobra.Command, args []string) er
 		)
 
 		// Setup Submitter
-		submitterService, err := submitter.New(
-			clientCtx,
-			clients,
-			bothanService,
-			l,
-			submitSignalPriceCh,
-			authQuerier,
-			txQuerier,
-			valAddr,
-			&pendingSignalIDs,
-			broadcastTimeout,
-			ctx.Config.MaxTry,
-			rpcPollInterval,
-			ctx.Config.GasPrices,
-		)
-		if err != nil {
-			return err
+		var submitterService *submitter.Submitter
+		if recorder == nil {
+			submitterService, err = submitter.New(
+				clientCtx,
+				clients,
+				bothanEndpoints[0].Client,
+				l,
+				submitSignalPriceCh,
+				authQuerier,
+				txQuerier,
+				valAddr,
+				&pendingSignalIDs,
+				broadcastTimeout,
+				ctx.Config.MaxTry,
+				rpcPollInterval,
+				ctx.Config.GasPrices,
+			)
+			if err != nil {
+				return err
+			}
 		}
 
 		// Setup Updater
@@ -200,22 +294,40 @@ func createRunE(ctx *context.Context) func(cmd *cobra.Command, args []string) er
 		maxUpdateRefSourceEventHeight := new(atomic.Int64)
 		maxUpdateRefSourceEventHeight.Store(0)
 
-		updaterService := updater.New(
-			feedQuerier,
-			bothanService,
-			clients,
-			l,
-			updaterQueryInterval,
-		)
+		// Every Bothan instance keeps its own registry, so each of them needs an updater
+		updaterServices := make([]*updater.Updater, 0, len(bothanEndpoints))
+		for _, endpoint := range bothanEndpoints {
+			updaterServices = append(updaterServices, updater.New(
+				feedQuerier,
+				endpoint.Client,
+				clients,
+				l,
+				updaterQueryInterval,
+			))
+		}
 
 		// Listen for termination signals for graceful shutdown
 		sigChan := make(chan os.Signal, 1)
 		signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 
 		// Start all services
-		go updaterService.Start(sigChan)
+		for _, updaterService := range updaterServices {
+			go updaterService.Start(sigChan)
+		}
 		go signallerService.Start()
-		go submitterService.Start()
+		if recorder != nil {
+			go recorder.Start()
+		} else {
+			go submitterService.Start()
+		}
+
+		if ctx.Config.MetricsListenAddr != "" {
+			go func() {
+				if err := metrics.Listen(ctx.Config.MetricsListenAddr, signallerService.CheckFeedsSubmitted); err != nil {
+					l.Error("Metrics server has stopped: %v", err)
+				}
+			}()
+		}
 
 		l.Info("Grogu has started")
 
@@ -2,9 +2,15 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cometbft/cometbft/rpc/client/http"
+
+	bothanclient "github.com/bandprotocol/bothan/bothan-api/client/go-client"
+
+	"github.com/bandprotocol/chain/v3/grogu/signaller"
 )
 
 func createClients(nodeURIs []string) ([]rpcclient.RemoteClient, func(), error) {
@@ -35,3 +41,26 @@ func createClients(nodeURIs []string) ([]rpcclient.RemoteClient, func(), error)
 
 	return clients, stopClients, nil
 }
+
+func createBothanEndpoints(urls []string, timeout time.Duration) ([]signaller.BothanEndpoint, error) {
+	endpoints := make([]signaller.BothanEndpoint, 0, len(urls))
+	for _, url := range urls {
+		url = strings.TrimSpace(url)
+		if url == "" {
+			continue
+		}
+
+		client, err := bothanclient.NewGrpcClient(url, timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create bothan client for %s: %w", url, err)
+		}
+
+		endpoints = append(endpoints, signaller.BothanEndpoint{Name: url, Client: client})
+	}
+
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("no bothan endpoints are configured")
+	}
+
+	return endpoints, nil
+}
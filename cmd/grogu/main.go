@@ -47,6 +47,7 @@ func createRootCmd(ctx *context.Context) *cobra.Command {
 		cmd.ConfigCmd(),
 		cmd.KeysCmd(ctx),
 		cmd.RunCmd(ctx),
+		cmd.DryRunSummaryCmd(ctx),
 		version.NewVersionCommand(),
 	)
 
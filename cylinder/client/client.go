@@ -24,7 +24,10 @@ import (
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 	"github.com/bandprotocol/chain/v3/pkg/tss"
 	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
 	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
+	tunneltypes "github.com/bandprotocol/chain/v3/x/tunnel/types"
 )
 
 type Client struct {
@@ -40,13 +43,19 @@ type Client struct {
 	gasAdjustStep  float64 // Step value for adjusting the gas price.
 }
 
-// New creates a new instance of the Client.
+// New creates a new instance of the Client connected to the configured node.
 // It returns the created Client instance and an error if the initialization fails.
 func New(cylinderCtx *cylinderctx.Context) (*Client, error) {
+	return NewWithNode(cylinderCtx, cylinderCtx.Config.NodeURI)
+}
+
+// NewWithNode creates a new instance of the Client connected to the given node.
+// It returns the created Client instance and an error if the initialization fails.
+func NewWithNode(cylinderCtx *cylinderctx.Context, nodeURI string) (*Client, error) {
 	cfg := cylinderCtx.Config
 
 	// Create a new HTTP client for the specified node URI
-	c, err := httpclient.New(cfg.NodeURI, "/websocket")
+	c, err := httpclient.New(nodeURI, "/websocket")
 	if err != nil {
 		return nil, err
 	}
@@ -163,6 +172,49 @@ func (c *Client) QueryDE(address string, offset uint64, limit uint64) (*DERespon
 	return NewDEResponse(der), nil
 }
 
+// QueryAllDEs queries all DEs of the given address remaining on chain.
+func (c *Client) QueryAllDEs(address string) ([]tsstypes.DE, error) {
+	const limit = 100
+
+	var des []tsstypes.DE
+	for {
+		res, err := c.QueryDE(address, uint64(len(des)), limit)
+		if err != nil {
+			return nil, err
+		}
+
+		des = append(des, res.DEs...)
+		if len(res.DEs) < limit || uint64(len(des)) >= res.GetRemaining() {
+			return des, nil
+		}
+	}
+}
+
+// QueryAllGroups queries the information of all groups on chain.
+func (c *Client) QueryAllGroups() ([]tsstypes.GroupResult, error) {
+	queryClient := tsstypes.NewQueryClient(c.context)
+
+	var groups []tsstypes.GroupResult
+	var nextKey []byte
+	for {
+		res, err := queryClient.Groups(context.Background(), &tsstypes.QueryGroupsRequest{
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range res.Groups {
+			groups = append(groups, *group)
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return groups, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
 // QueryMember queries the member information of the given address.
 // It returns the member information on current and incoming group or an error.
 func (c *Client) QueryMember(address string) (*bandtsstypes.QueryMemberResponse, error) {
@@ -208,6 +260,90 @@ func (c *Client) QueryPendingSignings(address string) (*tsstypes.QueryPendingSig
 	return res, nil
 }
 
+// QueryTSSParams queries the parameters of the tss module.
+// It returns the parameters or an error.
+func (c *Client) QueryTSSParams() (tsstypes.Params, error) {
+	queryClient := tsstypes.NewQueryClient(c.context)
+
+	res, err := queryClient.Params(context.Background(), &tsstypes.QueryParamsRequest{})
+	if err != nil {
+		return tsstypes.Params{}, err
+	}
+
+	return res.Params, nil
+}
+
+// QueryBlockResults queries the results of the block at the given height.
+// It returns the block results or an error.
+func (c *Client) QueryBlockResults(height int64) (*ctypes.ResultBlockResults, error) {
+	return c.client.BlockResults(context.Background(), &height)
+}
+
+// QueryOracleResult queries the result of the oracle request with the given request ID.
+// It returns the result or an error if the request is not resolved.
+func (c *Client) QueryOracleResult(requestID oracletypes.RequestID) (oracletypes.Result, error) {
+	queryClient := oracletypes.NewQueryClient(c.context)
+
+	res, err := queryClient.Request(context.Background(), &oracletypes.QueryRequestRequest{
+		RequestId: uint64(requestID),
+	})
+	if err != nil {
+		return oracletypes.Result{}, err
+	}
+	if res.Result == nil {
+		return oracletypes.Result{}, fmt.Errorf("request %d has no result", requestID)
+	}
+
+	return *res.Result, nil
+}
+
+// QueryPrices queries the feeds prices of the given signal IDs at the given height.
+// It returns the prices or an error.
+func (c *Client) QueryPrices(signalIDs []string, height int64) ([]feedstypes.Price, error) {
+	queryClient := feedstypes.NewQueryClient(c.context.WithHeight(height))
+
+	res, err := queryClient.Prices(context.Background(), &feedstypes.QueryPricesRequest{
+		SignalIds: signalIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Prices, nil
+}
+
+// QueryGroupTransition queries the group transition of the bandtss module at the given height.
+// It returns the group transition or an error if there is no transition at the height.
+func (c *Client) QueryGroupTransition(height int64) (*bandtsstypes.GroupTransition, error) {
+	queryClient := bandtsstypes.NewQueryClient(c.context.WithHeight(height))
+
+	res, err := queryClient.GroupTransition(context.Background(), &bandtsstypes.QueryGroupTransitionRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if res.GroupTransition == nil {
+		return nil, fmt.Errorf("no group transition at height %d", height)
+	}
+
+	return res.GroupTransition, nil
+}
+
+// QueryTunnelPacket queries the packet of the given tunnel ID and sequence.
+// It returns the packet or an error.
+func (c *Client) QueryTunnelPacket(tunnelID uint64, sequence uint64) (*tunneltypes.Packet, error) {
+	queryClient := tunneltypes.NewQueryClient(c.context)
+
+	res, err := queryClient.Packet(context.Background(), &tunneltypes.QueryPacketRequest{
+		TunnelId: tunnelID,
+		Sequence: sequence,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Packet, nil
+}
+
 // BroadcastAndConfirm broadcasts and confirms the messages by signing and submitting them using the provided key.
 // It returns the transaction response or an error. It retries broadcasting and confirming up to maxTry times.
 func (c *Client) BroadcastAndConfirm(
@@ -1,7 +1,9 @@
 package context
 
 import (
+	"fmt"
 	"path/filepath"
+	"regexp"
 	"time"
 
 	dbm "github.com/cometbft/cometbft-db"
@@ -19,6 +21,9 @@ import (
 	"github.com/bandprotocol/chain/v3/pkg/tss"
 )
 
+// granterNameRegex is the format of a granter name, which is used as a directory name.
+var granterNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
 // Config data structure for Cylinder process.
 type Config struct {
 	ChainID            string        `mapstructure:"chain-id"`             // ChainID of the target chain
@@ -31,10 +36,99 @@ type Config struct {
 	RPCPollInterval    time.Duration `mapstructure:"rpc-poll-interval"`    // The duration of rpc poll interval
 	MaxTry             uint64        `mapstructure:"max-try"`              // The maximum number of tries to submit a report transaction
 	MinDE              uint64        `mapstructure:"min-de"`               // The minimum number of DE
+	MaxDE              uint64        `mapstructure:"max-de"`               // The maximum number of DE on chain, only bounded by the chain if zero
+	DEDemandWindow     time.Duration `mapstructure:"de-demand-window"`     // The window of recent DE usage to forecast the DE demand from
 	GasAdjustStart     float64       `mapstructure:"gas-adjust-start"`     // The start value of gas adjustment
 	GasAdjustStep      float64       `mapstructure:"gas-adjust-step"`      // The increment step of gad adjustment
 	RandomSecret       tss.Scalar    `mapstructure:"random-secret"`        // The secret value that is used for random D,E
 	CheckingDEInterval time.Duration `mapstructure:"checking-de-interval"` // The interval for updating DE
+
+	VerifierNodeURI         string   `mapstructure:"verifier-node"`             // RPC URI of a node to verify signing messages with
+	SkipSigningVerification bool     `mapstructure:"skip-signing-verification"` // Sign messages without verification
+	AllowedContentTypes     []string `mapstructure:"allowed-content-types"`     // Content types allowed to be signed
+	DeniedContentTypes      []string `mapstructure:"denied-content-types"`      // Content types denied to be signed
+	AllowedOriginators      []string `mapstructure:"allowed-originators"`       // Originators allowed to be signed
+	DeniedOriginators       []string `mapstructure:"denied-originators"`        // Originators denied to be signed
+
+	RemoteSigner      string `mapstructure:"remote-signer"`       // Address of a remote signer that keeps the TSS secrets
+	MetricsListenAddr string `mapstructure:"metrics-listen-addr"` // Address to serve Prometheus metrics on
+
+	Granters []GranterConfig `mapstructure:"granters"` // Granters managed by the process, overriding granter if set
+}
+
+// GranterConfig is the configuration of a granter account managed by the Cylinder process.
+// Each granter keeps its TSS secrets in its own store.
+type GranterConfig struct {
+	Name         string     `mapstructure:"name"`          // Name of the granter, which is also the directory name of its store
+	Address      string     `mapstructure:"address"`       // The granter address
+	MinDE        uint64     `mapstructure:"min-de"`        // The minimum number of DE, min-de of the config if not set
+	MaxDE        uint64     `mapstructure:"max-de"`        // The maximum number of DE on chain, max-de of the config if not set
+	RandomSecret tss.Scalar `mapstructure:"random-secret"` // The secret value for random D,E, random-secret of the config if not set
+	RemoteSigner string     `mapstructure:"remote-signer"` // Address of a remote signer that keeps the TSS secrets of the granter
+}
+
+// GetGranters returns the configurations of all granters managed by the process. If no granters are
+// configured, the single granter of the config is returned with an empty name.
+func (cfg *Config) GetGranters() ([]GranterConfig, error) {
+	if len(cfg.Granters) == 0 {
+		return []GranterConfig{{
+			Address:      cfg.Granter,
+			MinDE:        cfg.MinDE,
+			MaxDE:        cfg.MaxDE,
+			RandomSecret: cfg.RandomSecret,
+			RemoteSigner: cfg.RemoteSigner,
+		}}, nil
+	}
+
+	names := make(map[string]bool)
+	addresses := make(map[string]bool)
+	granters := make([]GranterConfig, 0, len(cfg.Granters))
+	for _, granter := range cfg.Granters {
+		if !granterNameRegex.MatchString(granter.Name) {
+			return nil, fmt.Errorf("invalid granter name: %q", granter.Name)
+		}
+		if granter.Address == "" {
+			return nil, fmt.Errorf("address of granter %s is not set", granter.Name)
+		}
+		if names[granter.Name] {
+			return nil, fmt.Errorf("duplicate granter name: %s", granter.Name)
+		}
+		if addresses[granter.Address] {
+			return nil, fmt.Errorf("duplicate granter address: %s", granter.Address)
+		}
+		names[granter.Name] = true
+		addresses[granter.Address] = true
+
+		if granter.MinDE == 0 {
+			granter.MinDE = cfg.MinDE
+		}
+		if granter.MaxDE == 0 {
+			granter.MaxDE = cfg.MaxDE
+		}
+		if granter.RandomSecret == nil {
+			granter.RandomSecret = cfg.RandomSecret
+		}
+
+		granters = append(granters, granter)
+	}
+
+	return granters, nil
+}
+
+// GetGranter returns the configuration of the granter with the given name.
+func (cfg *Config) GetGranter(name string) (GranterConfig, error) {
+	granters, err := cfg.GetGranters()
+	if err != nil {
+		return GranterConfig{}, err
+	}
+
+	for _, granter := range granters {
+		if granter.Name == name {
+			return granter, nil
+		}
+	}
+
+	return GranterConfig{}, fmt.Errorf("granter %q is not found in the config", name)
 }
 
 // Context holds the context information for the Cylinder process.
@@ -51,7 +145,9 @@ type Context struct {
 	ErrCh chan error
 	MsgCh chan sdk.Msg
 
-	Store *store.Store
+	Store *store.Store // Store of the granter selected for the command
+
+	stores map[string]*store.Store // Opened stores by granter name
 }
 
 // NewContext creates a new instance of the Context.
@@ -63,13 +159,6 @@ func NewContext(
 	txConfig client.TxConfig,
 	interfaceRegistry types.InterfaceRegistry,
 ) (*Context, error) {
-	// Create the store
-	dataDir := filepath.Join(home, "data")
-	db, err := dbm.NewDB("cylinder", dbm.GoLevelDBBackend, dataDir)
-	if err != nil {
-		return nil, err
-	}
-
 	// Initialize the context
 	return &Context{
 		Config:            cfg,
@@ -80,10 +169,29 @@ func NewContext(
 		InterfaceRegistry: interfaceRegistry,
 		ErrCh:             make(chan error, 1),
 		MsgCh:             make(chan sdk.Msg, 1000),
-		Store:             store.NewStore(db),
+		stores:            make(map[string]*store.Store),
 	}, nil
 }
 
+// OpenStore opens the store of the granter with the given name, or returns it if it is already opened.
+// The store of the unnamed granter is kept in the data directory, and the store of a named granter
+// in a subdirectory of it.
+func (ctx *Context) OpenStore(name string) (*store.Store, error) {
+	if s, ok := ctx.stores[name]; ok {
+		return s, nil
+	}
+
+	dataDir := filepath.Join(ctx.Home, "data", name)
+	db, err := dbm.NewDB("cylinder", dbm.GoLevelDBBackend, dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	s := store.NewStore(db)
+	ctx.stores[name] = s
+	return s, nil
+}
+
 func (ctx *Context) InitLog() error {
 	allowLevel, err := log.ParseLogLevel(ctx.Config.LogLevel)
 	if err != nil {
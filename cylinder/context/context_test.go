@@ -0,0 +1,90 @@
+package context_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/cylinder/context"
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+)
+
+func TestGetGranters(t *testing.T) {
+	secret := tss.Scalar([]byte("secret"))
+
+	tests := []struct {
+		name        string
+		cfg         context.Config
+		expGranters []context.GranterConfig
+		expError    string
+	}{
+		{
+			"single granter",
+			context.Config{Granter: "band1a", MinDE: 5, RandomSecret: secret, RemoteSigner: "unix:///signer.sock"},
+			[]context.GranterConfig{
+				{Address: "band1a", MinDE: 5, RandomSecret: secret, RemoteSigner: "unix:///signer.sock"},
+			},
+			"",
+		},
+		{
+			"multiple granters with defaults",
+			context.Config{
+				Granter:      "band1ignored",
+				MinDE:        5,
+				RandomSecret: secret,
+				Granters: []context.GranterConfig{
+					{Name: "mainnet", Address: "band1a", MinDE: 20},
+					{Name: "test-1", Address: "band1b", RandomSecret: tss.Scalar([]byte("other"))},
+				},
+			},
+			[]context.GranterConfig{
+				{Name: "mainnet", Address: "band1a", MinDE: 20, RandomSecret: secret},
+				{Name: "test-1", Address: "band1b", MinDE: 5, RandomSecret: tss.Scalar([]byte("other"))},
+			},
+			"",
+		},
+		{
+			"invalid name",
+			context.Config{Granters: []context.GranterConfig{{Name: "../a", Address: "band1a"}}},
+			nil,
+			"invalid granter name",
+		},
+		{
+			"missing address",
+			context.Config{Granters: []context.GranterConfig{{Name: "a"}}},
+			nil,
+			"address of granter a is not set",
+		},
+		{
+			"duplicate name",
+			context.Config{Granters: []context.GranterConfig{
+				{Name: "a", Address: "band1a"},
+				{Name: "a", Address: "band1b"},
+			}},
+			nil,
+			"duplicate granter name",
+		},
+		{
+			"duplicate address",
+			context.Config{Granters: []context.GranterConfig{
+				{Name: "a", Address: "band1a"},
+				{Name: "b", Address: "band1a"},
+			}},
+			nil,
+			"duplicate granter address",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			granters, err := test.cfg.GetGranters()
+			if test.expError != "" {
+				require.ErrorContains(t, err, test.expError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expGranters, granters)
+		})
+	}
+}
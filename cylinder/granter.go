@@ -0,0 +1,43 @@
+package cylinder
+
+import (
+	"github.com/bandprotocol/chain/v3/cylinder/context"
+	"github.com/bandprotocol/chain/v3/cylinder/signer"
+)
+
+// Granter is a granter account managed by the Cylinder process with the signer that keeps its TSS secrets.
+type Granter struct {
+	context.GranterConfig
+
+	Signer signer.Signer
+}
+
+// Granters is the list of granters managed by the Cylinder process. Workers use it to route events
+// to the granters they are addressed to.
+type Granters []Granter
+
+// Get returns the granter of the given address.
+func (gs Granters) Get(address string) (Granter, bool) {
+	for _, g := range gs {
+		if g.Address == address {
+			return g, true
+		}
+	}
+
+	return Granter{}, false
+}
+
+// Filter returns the granters of the given addresses, ignoring addresses of other accounts.
+func (gs Granters) Filter(addresses []string) Granters {
+	var filtered Granters
+	for _, g := range gs {
+		for _, address := range addresses {
+			if g.Address == address {
+				filtered = append(filtered, g)
+				break
+			}
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,137 @@
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+)
+
+var (
+	signings = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cylinder_signing_requests_total",
+		Help: "Number of handled signing requests by granter and result",
+	}, []string{"granter", "result"})
+
+	dkgRounds = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cylinder_dkg_rounds_total",
+		Help: "Number of handled DKG rounds by granter, round and result",
+	}, []string{"granter", "round", "result"})
+	dkgInProgress = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cylinder_dkg_in_progress_round",
+		Help: "Latest round handled by the granter for each group in the DKG process",
+	}, []string{"granter", "group_id"})
+
+	onChainDEs = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cylinder_de_remaining_on_chain",
+		Help: "Number of DEs of the granter remaining on chain",
+	}, []string{"granter"})
+	deDemandRate = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cylinder_de_demand_rate_per_minute",
+		Help: "Number of DEs of the granter assigned to signings per minute recently",
+	}, []string{"granter"})
+	dePendingSignings = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cylinder_de_pending_signings",
+		Help: "Number of pending signings of the granter at the latest DE refill decision",
+	}, []string{"granter"})
+	deTarget = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cylinder_de_target",
+		Help: "Number of DEs of the granter that should be on chain at the latest DE refill decision",
+	}, []string{"granter"})
+	generatedDEs = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cylinder_de_generated_total",
+		Help: "Number of DEs generated by granter",
+	}, []string{"granter"})
+	deletedDEs = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cylinder_de_deleted_total",
+		Help: "Number of private DEs deleted by granter after they were used or removed on chain",
+	}, []string{"granter"})
+
+	txs = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cylinder_sender_txs_total",
+		Help: "Number of transactions sent by result, codespace and code",
+	}, []string{"result", "codespace", "code"})
+)
+
+// Signing request results.
+const (
+	SigningResultSigned  = "signed"
+	SigningResultRefused = "refused"
+	SigningResultFailed  = "failed"
+)
+
+// DKG round results.
+const (
+	DKGResultSubmitted  = "submitted"
+	DKGResultComplained = "complained"
+	DKGResultFailed     = "failed"
+)
+
+// Transaction results.
+const (
+	TxResultSuccess = "success"
+	TxResultError   = "error"
+	TxResultFailure = "failure"
+)
+
+// collectors returns all cylinder metrics to be registered.
+func collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		signings,
+		dkgRounds,
+		dkgInProgress,
+		onChainDEs,
+		deDemandRate,
+		dePendingSignings,
+		deTarget,
+		generatedDEs,
+		deletedDEs,
+		txs,
+	}
+}
+
+// IncSigning records a handled signing request of the granter with its result.
+func IncSigning(granter string, result string) {
+	signings.WithLabelValues(granter, result).Inc()
+}
+
+// IncDKGRound records a handled DKG round of the granter in the group with its result. The group is
+// tracked as in progress until its round 3 is submitted.
+func IncDKGRound(granter string, gid tss.GroupID, round int, result string) {
+	dkgRounds.WithLabelValues(granter, strconv.Itoa(round), result).Inc()
+
+	groupID := strconv.FormatUint(uint64(gid), 10)
+	if round == 3 && result != DKGResultFailed {
+		dkgInProgress.DeleteLabelValues(granter, groupID)
+		return
+	}
+	dkgInProgress.WithLabelValues(granter, groupID).Set(float64(round))
+}
+
+// SetOnChainDEs records the number of DEs of the granter remaining on chain.
+func SetOnChainDEs(granter string, count uint64) {
+	onChainDEs.WithLabelValues(granter).Set(float64(count))
+}
+
+// SetDERefillPlan records the inputs and the target of the latest DE refill decision of the granter.
+func SetDERefillPlan(granter string, ratePerMinute float64, pending uint64, target uint64) {
+	deDemandRate.WithLabelValues(granter).Set(ratePerMinute)
+	dePendingSignings.WithLabelValues(granter).Set(float64(pending))
+	deTarget.WithLabelValues(granter).Set(float64(target))
+}
+
+// AddGeneratedDEs records newly generated DEs of the granter.
+func AddGeneratedDEs(granter string, count int) {
+	generatedDEs.WithLabelValues(granter).Add(float64(count))
+}
+
+// IncDeletedDE records a deleted private DE of the granter.
+func IncDeletedDE(granter string) {
+	deletedDEs.WithLabelValues(granter).Inc()
+}
+
+// IncTx records a sent transaction with its result and result code.
+func IncTx(result string, codespace string, code uint32) {
+	txs.WithLabelValues(result, codespace, strconv.FormatUint(uint64(code), 10)).Inc()
+}
@@ -1,7 +1,8 @@
-package de
+package signer
 
 import (
 	"errors"
+	"time"
 
 	"github.com/bandprotocol/chain/v3/cylinder/store"
 	"github.com/bandprotocol/chain/v3/pkg/tss"
@@ -20,6 +21,7 @@ type DEGetter interface {
 func GenerateDEs(n uint64, secret tss.Scalar, db DEGetter) (privDEs []store.DE, err error) {
 	privDEs = make([]store.DE, 0, n)
 	attempt := 0
+	now := time.Now()
 
 	for len(privDEs) < int(n) && attempt < MaxDuplicateDEAttempts {
 		privD, err := tss.GenerateSigningNonce(secret)
@@ -43,9 +45,10 @@ func GenerateDEs(n uint64, secret tss.Scalar, db DEGetter) (privDEs []store.DE,
 		}
 
 		privDEs = append(privDEs, store.DE{
-			PubDE: pubDE,
-			PrivD: privD,
-			PrivE: privE,
+			PubDE:     pubDE,
+			PrivD:     privD,
+			PrivE:     privE,
+			CreatedAt: now,
 		})
 	}
 
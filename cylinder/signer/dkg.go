@@ -1,4 +1,4 @@
-package group
+package signer
 
 import (
 	"github.com/bandprotocol/chain/v3/cylinder/client"
@@ -0,0 +1,194 @@
+package signer
+
+import (
+	"fmt"
+
+	"github.com/bandprotocol/chain/v3/cylinder/client"
+	"github.com/bandprotocol/chain/v3/cylinder/store"
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/x/tss/types"
+)
+
+// LocalSigner is a signer that keeps the secrets in the store of the process.
+type LocalSigner struct {
+	store        *store.Store
+	randomSecret tss.Scalar
+}
+
+var _ Signer = &LocalSigner{}
+
+// NewLocalSigner creates a new instance of the LocalSigner. The random secret is used as an
+// additional random factor when generating DEs.
+func NewLocalSigner(store *store.Store, randomSecret tss.Scalar) *LocalSigner {
+	return &LocalSigner{
+		store:        store,
+		randomSecret: randomSecret,
+	}
+}
+
+// GenerateRound1Info generates and keeps the DKG secrets of the member and returns its round 1 information.
+func (s *LocalSigner) GenerateRound1Info(
+	gid tss.GroupID,
+	mid tss.MemberID,
+	threshold uint64,
+	dkgContext []byte,
+) (types.Round1Info, error) {
+	data, err := tss.GenerateRound1Info(mid, threshold, dkgContext)
+	if err != nil {
+		return types.Round1Info{}, fmt.Errorf("failed to generate round1 data: %w", err)
+	}
+
+	dkg := store.DKG{
+		GroupID:        gid,
+		MemberID:       mid,
+		Coefficients:   data.Coefficients,
+		OneTimePrivKey: data.OneTimePrivKey,
+	}
+	if err := s.store.SetDKG(dkg); err != nil {
+		return types.Round1Info{}, fmt.Errorf("failed to set DKG: %w", err)
+	}
+
+	return types.Round1Info{
+		MemberID:           mid,
+		CoefficientCommits: data.CoefficientCommits,
+		OneTimePubKey:      data.OneTimePubKey,
+		A0Signature:        data.A0Signature,
+		OneTimeSignature:   data.OneTimeSignature,
+	}, nil
+}
+
+// ComputeRound2Info computes the secret shares of the member encrypted for each other member of the group.
+func (s *LocalSigner) ComputeRound2Info(gid tss.GroupID, oneTimePubKeys tss.Points) (types.Round2Info, error) {
+	dkg, err := s.store.GetDKG(gid)
+	if err != nil {
+		return types.Round2Info{}, fmt.Errorf("failed to find group in store: %w", err)
+	}
+
+	encSecretShares, err := tss.ComputeEncryptedSecretShares(
+		dkg.MemberID,
+		dkg.OneTimePrivKey,
+		oneTimePubKeys,
+		dkg.Coefficients,
+		tss.DefaultNonce16Generator{},
+	)
+	if err != nil {
+		return types.Round2Info{}, fmt.Errorf("failed to generate encrypted secret shares: %w", err)
+	}
+
+	return types.Round2Info{
+		MemberID:              dkg.MemberID,
+		EncryptedSecretShares: encSecretShares,
+	}, nil
+}
+
+// ComputeRound3 computes and keeps the private key of the member in the group and signs its public key.
+// It returns complaints instead if any secret share from the other members is invalid.
+func (s *LocalSigner) ComputeRound3(groupRes *client.GroupResult) (Round3Result, error) {
+	group, err := s.store.GetGroup(groupRes.Group.PubKey)
+	if err != nil {
+		dkg, err := s.store.GetDKG(groupRes.Group.ID)
+		if err != nil {
+			return Round3Result{}, fmt.Errorf("failed to find group in store: %w", err)
+		}
+
+		// Get own private key
+		ownPrivKey, complaints, err := getOwnPrivKey(dkg, groupRes)
+		if err != nil {
+			return Round3Result{}, fmt.Errorf("failed to get own private key or complaints: %w", err)
+		}
+
+		if len(complaints) > 0 {
+			return Round3Result{MemberID: dkg.MemberID, Complaints: complaints}, nil
+		}
+
+		// Keep own private key and remove the DKG secrets
+		group = store.Group{
+			GroupPubKey: groupRes.Group.PubKey,
+			MemberID:    dkg.MemberID,
+			PrivKey:     ownPrivKey,
+		}
+
+		if err := s.store.SetGroup(group); err != nil {
+			return Round3Result{}, fmt.Errorf("failed to set group: %w", err)
+		}
+
+		if err := s.store.DeleteDKG(groupRes.Group.ID); err != nil {
+			return Round3Result{}, fmt.Errorf("failed to delete DKG: %w", err)
+		}
+	}
+
+	ownPubKeySig, err := tss.SignOwnPubKey(
+		group.MemberID,
+		groupRes.DKGContext,
+		group.PrivKey.Point(),
+		group.PrivKey,
+	)
+	if err != nil {
+		return Round3Result{}, fmt.Errorf("failed to sign own public key: %w", err)
+	}
+
+	return Round3Result{MemberID: group.MemberID, OwnPubKeySig: ownPubKeySig}, nil
+}
+
+// GenerateDEs generates and keeps n new private DEs and returns their public DEs.
+func (s *LocalSigner) GenerateDEs(n uint64) ([]types.DE, error) {
+	privDEs, err := GenerateDEs(n, s.randomSecret, s.store)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate new DE pairs: %w", err)
+	}
+
+	var pubDEs []types.DE
+	for _, privDE := range privDEs {
+		if err := s.store.SetDE(privDE); err != nil {
+			return nil, fmt.Errorf("failed to set new DE in the store: %w", err)
+		}
+
+		pubDEs = append(pubDEs, privDE.PubDE)
+	}
+
+	return pubDEs, nil
+}
+
+// DeleteDE deletes the private DE of the given public DE.
+func (s *LocalSigner) DeleteDE(pubDE types.DE) error {
+	return s.store.DeleteDE(pubDE)
+}
+
+// Sign computes the partial signature of the member for the signing. The private DE is kept until it is
+// deleted, but it is refused for any signing or message other than the one it is first used for, so it can
+// never sign two different messages.
+func (s *LocalSigner) Sign(req SignRequest) (SignResult, error) {
+	group, err := s.store.GetGroup(req.GroupPubKey)
+	if err != nil {
+		return SignResult{}, fmt.Errorf("failed to find group in store: %w", err)
+	}
+
+	privDE, err := s.store.UseDE(req.PubDE, req.SigningID, req.Message)
+	if err != nil {
+		return SignResult{}, fmt.Errorf("failed to use private DE from store: %w", err)
+	}
+
+	privNonce, err := tss.ComputeOwnPrivNonce(privDE.PrivD, privDE.PrivE, req.BindingFactor)
+	if err != nil {
+		return SignResult{}, fmt.Errorf("failed to compute own private nonce: %w", err)
+	}
+
+	lagrange, err := tss.ComputeLagrangeCoefficient(group.MemberID, req.MemberIDs)
+	if err != nil {
+		return SignResult{}, fmt.Errorf("failed to compute lagrange coefficient: %w", err)
+	}
+
+	sig, err := tss.SignSigning(
+		req.GroupPubNonce,
+		req.GroupPubKey,
+		req.Message,
+		lagrange,
+		privNonce,
+		group.PrivKey,
+	)
+	if err != nil {
+		return SignResult{}, fmt.Errorf("failed to sign signing: %w", err)
+	}
+
+	return SignResult{MemberID: group.MemberID, Signature: sig}, nil
+}
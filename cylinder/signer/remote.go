@@ -0,0 +1,286 @@
+package signer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"strings"
+	"sync"
+
+	"github.com/bandprotocol/chain/v3/cylinder/client"
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/x/tss/types"
+)
+
+// serviceName is the name of the signer service on the remote signer server.
+const serviceName = "Signer"
+
+// Round1Args are the arguments of the GenerateRound1Info call.
+type Round1Args struct {
+	GroupID    tss.GroupID  `json:"group_id"`
+	MemberID   tss.MemberID `json:"member_id"`
+	Threshold  uint64       `json:"threshold"`
+	DKGContext []byte       `json:"dkg_context"`
+}
+
+// Round2Args are the arguments of the ComputeRound2Info call.
+type Round2Args struct {
+	GroupID        tss.GroupID `json:"group_id"`
+	OneTimePubKeys tss.Points  `json:"one_time_pub_keys"`
+}
+
+// ParseAddress parses a signer address in the form of unix:///path/to/socket into the network and
+// address to listen on or dial. The signer does not authenticate its clients, so only unix sockets,
+// whose access is controlled by file permissions, are supported.
+func ParseAddress(addr string) (string, string, error) {
+	network, address, ok := strings.Cut(addr, "://")
+	if !ok || address == "" {
+		return "", "", fmt.Errorf("invalid signer address: %s", addr)
+	}
+
+	if network != "unix" {
+		return "", "", fmt.Errorf("unsupported signer network: %s", network)
+	}
+
+	return network, address, nil
+}
+
+// MessageVerifier checks the message of a signing before the signer signs it.
+type MessageVerifier interface {
+	VerifyMessage(signingID tss.SigningID, message []byte) error
+}
+
+// RemoteSigner is a signer that forwards every operation to a remote signer server, which
+// keeps the secrets in another process.
+type RemoteSigner struct {
+	network string
+	address string
+
+	mu     sync.Mutex
+	client *rpc.Client
+}
+
+var _ Signer = &RemoteSigner{}
+
+// NewRemoteSigner creates a new instance of the RemoteSigner connected to the given address.
+func NewRemoteSigner(addr string) (*RemoteSigner, error) {
+	network, address, err := ParseAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &RemoteSigner{
+		network: network,
+		address: address,
+	}
+
+	// Connect at start to fail fast if the remote signer is not reachable
+	if _, err := s.getClient(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// getClient returns the connected client, reconnecting if the previous connection was closed.
+func (s *RemoteSigner) getClient() (*rpc.Client, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client != nil {
+		return s.client, nil
+	}
+
+	conn, err := net.Dial(s.network, s.address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to remote signer: %w", err)
+	}
+
+	s.client = jsonrpc.NewClient(conn)
+	return s.client, nil
+}
+
+// call calls the method of the remote signer. The connection is dropped on a transport error,
+// so the next call reconnects.
+func (s *RemoteSigner) call(method string, args any, reply any) error {
+	c, err := s.getClient()
+	if err != nil {
+		return err
+	}
+
+	err = c.Call(serviceName+"."+method, args, reply)
+	if errors.Is(err, rpc.ErrShutdown) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		s.mu.Lock()
+		if s.client == c {
+			s.client = nil
+		}
+		s.mu.Unlock()
+		_ = c.Close()
+	}
+
+	return err
+}
+
+// GenerateRound1Info generates and keeps the DKG secrets of the member and returns its round 1 information.
+func (s *RemoteSigner) GenerateRound1Info(
+	gid tss.GroupID,
+	mid tss.MemberID,
+	threshold uint64,
+	dkgContext []byte,
+) (types.Round1Info, error) {
+	var info types.Round1Info
+	err := s.call("GenerateRound1Info", &Round1Args{
+		GroupID:    gid,
+		MemberID:   mid,
+		Threshold:  threshold,
+		DKGContext: dkgContext,
+	}, &info)
+
+	return info, err
+}
+
+// ComputeRound2Info computes the secret shares of the member encrypted for each other member of the group.
+func (s *RemoteSigner) ComputeRound2Info(gid tss.GroupID, oneTimePubKeys tss.Points) (types.Round2Info, error) {
+	var info types.Round2Info
+	err := s.call("ComputeRound2Info", &Round2Args{GroupID: gid, OneTimePubKeys: oneTimePubKeys}, &info)
+
+	return info, err
+}
+
+// ComputeRound3 computes and keeps the private key of the member in the group and signs its public key.
+// It returns complaints instead if any secret share from the other members is invalid.
+func (s *RemoteSigner) ComputeRound3(groupRes *client.GroupResult) (Round3Result, error) {
+	var result Round3Result
+	err := s.call("ComputeRound3", groupRes, &result)
+
+	return result, err
+}
+
+// GenerateDEs generates and keeps n new private DEs and returns their public DEs.
+func (s *RemoteSigner) GenerateDEs(n uint64) ([]types.DE, error) {
+	var pubDEs []types.DE
+	err := s.call("GenerateDEs", n, &pubDEs)
+
+	return pubDEs, err
+}
+
+// DeleteDE deletes the private DE of the given public DE.
+func (s *RemoteSigner) DeleteDE(pubDE types.DE) error {
+	return s.call("DeleteDE", &pubDE, &struct{}{})
+}
+
+// Sign computes the partial signature of the member for the signing.
+func (s *RemoteSigner) Sign(req SignRequest) (SignResult, error) {
+	var result SignResult
+	err := s.call("Sign", &req, &result)
+
+	return result, err
+}
+
+// Close closes the connection to the remote signer.
+func (s *RemoteSigner) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client == nil {
+		return nil
+	}
+
+	err := s.client.Close()
+	s.client = nil
+	return err
+}
+
+// Service exposes a signer as a service of a remote signer server.
+type Service struct {
+	signer   Signer
+	verifier MessageVerifier // nil if signing messages are not verified
+}
+
+// GenerateRound1Info serves Signer.GenerateRound1Info.
+func (s *Service) GenerateRound1Info(args *Round1Args, reply *types.Round1Info) error {
+	info, err := s.signer.GenerateRound1Info(args.GroupID, args.MemberID, args.Threshold, args.DKGContext)
+	if err != nil {
+		return err
+	}
+
+	*reply = info
+	return nil
+}
+
+// ComputeRound2Info serves Signer.ComputeRound2Info.
+func (s *Service) ComputeRound2Info(args *Round2Args, reply *types.Round2Info) error {
+	info, err := s.signer.ComputeRound2Info(args.GroupID, args.OneTimePubKeys)
+	if err != nil {
+		return err
+	}
+
+	*reply = info
+	return nil
+}
+
+// ComputeRound3 serves Signer.ComputeRound3.
+func (s *Service) ComputeRound3(args *client.GroupResult, reply *Round3Result) error {
+	result, err := s.signer.ComputeRound3(args)
+	if err != nil {
+		return err
+	}
+
+	*reply = result
+	return nil
+}
+
+// GenerateDEs serves Signer.GenerateDEs.
+func (s *Service) GenerateDEs(n uint64, reply *[]types.DE) error {
+	pubDEs, err := s.signer.GenerateDEs(n)
+	if err != nil {
+		return err
+	}
+
+	*reply = pubDEs
+	return nil
+}
+
+// DeleteDE serves Signer.DeleteDE.
+func (s *Service) DeleteDE(args *types.DE, _ *struct{}) error {
+	return s.signer.DeleteDE(*args)
+}
+
+// Sign serves Signer.Sign. The message is verified before signing if the service has a verifier, so a
+// compromised cylinder process cannot get arbitrary messages signed.
+func (s *Service) Sign(args *SignRequest, reply *SignResult) error {
+	if s.verifier != nil {
+		if err := s.verifier.VerifyMessage(args.SigningID, args.Message); err != nil {
+			return fmt.Errorf("refused to sign the message: %w", err)
+		}
+	}
+
+	result, err := s.signer.Sign(*args)
+	if err != nil {
+		return err
+	}
+
+	*reply = result
+	return nil
+}
+
+// Serve accepts connections on the listener and serves the signer on each of them until the
+// listener is closed. Signing messages are checked by the verifier if it is not nil.
+func Serve(listener net.Listener, signer Signer, verifier MessageVerifier) error {
+	server := rpc.NewServer()
+	if err := server.RegisterName(serviceName, &Service{signer: signer, verifier: verifier}); err != nil {
+		return err
+	}
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+
+		go server.ServeCodec(jsonrpc.NewServerCodec(conn))
+	}
+}
@@ -0,0 +1,147 @@
+package signer
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/bandprotocol/chain/v3/cylinder/store"
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/pkg/tss/testutil"
+	"github.com/bandprotocol/chain/v3/x/tss/types"
+)
+
+func TestParseAddress(t *testing.T) {
+	network, address, err := ParseAddress("unix:///tmp/signer.sock")
+	require.NoError(t, err)
+	require.Equal(t, "unix", network)
+	require.Equal(t, "/tmp/signer.sock", address)
+
+	_, _, err = ParseAddress("/tmp/signer.sock")
+	require.ErrorContains(t, err, "invalid signer address")
+
+	// The signer does not authenticate its clients, so it is not served over TCP
+	_, _, err = ParseAddress("tcp://127.0.0.1:9000")
+	require.ErrorContains(t, err, "unsupported signer network")
+}
+
+// messageVerifierFunc is a MessageVerifier that checks messages with the function.
+type messageVerifierFunc func(signingID tss.SigningID, message []byte) error
+
+func (f messageVerifierFunc) VerifyMessage(signingID tss.SigningID, message []byte) error {
+	return f(signingID, message)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	s := store.NewStore(dbm.NewMemDB())
+	require.NoError(t, s.Unlock([]byte("passphrase")))
+
+	// Serve a local signer on a unix socket
+	socket := filepath.Join(t.TempDir(), "signer.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	defer listener.Close()
+	// The signer refuses to sign any signing other than signing 1
+	verifier := messageVerifierFunc(func(signingID tss.SigningID, _ []byte) error {
+		if signingID != 1 {
+			return fmt.Errorf("signing %d is not allowed", signingID)
+		}
+		return nil
+	})
+	go func() {
+		_ = Serve(listener, NewLocalSigner(s, []byte("secret")), verifier)
+	}()
+
+	remote, err := NewRemoteSigner("unix://" + socket)
+	require.NoError(t, err)
+	defer remote.Close()
+
+	// Round 1 information is generated remotely and its secrets are kept by the server
+	tc := testutil.TestCases[0]
+	info, err := remote.GenerateRound1Info(tc.Group.ID, 1, tc.Group.Threshold, tc.Group.DKGContext)
+	require.NoError(t, err)
+	require.Equal(t, tss.MemberID(1), info.MemberID)
+	require.NoError(t, tss.VerifyA0Signature(1, tc.Group.DKGContext, info.A0Signature, info.CoefficientCommits[0]))
+	_, err = s.GetDKG(tc.Group.ID)
+	require.NoError(t, err)
+
+	// DEs are generated and deleted remotely
+	pubDEs, err := remote.GenerateDEs(3)
+	require.NoError(t, err)
+	require.Len(t, pubDEs, 3)
+	require.True(t, s.HasDE(pubDEs[0]))
+	require.NoError(t, remote.DeleteDE(pubDEs[0]))
+	require.False(t, s.HasDE(pubDEs[0]))
+
+	// Round 3 computes the private key of the member from the DKG secrets and the group result
+	signing := tc.Signings[0]
+	assignedMember := signing.AssignedMembers[0]
+	member := tc.Group.GetMember(assignedMember.ID)
+	dkg, groupRes := getTestData(tc, member)
+	groupRes.Group.ID = tc.Group.ID
+	groupRes.Group.PubKey = tc.Group.PubKey
+	groupRes.DKGContext = tc.Group.DKGContext
+	require.NoError(t, s.SetDKG(dkg))
+
+	round3, err := remote.ComputeRound3(&groupRes)
+	require.NoError(t, err)
+	require.Empty(t, round3.Complaints)
+	require.Equal(t, member.ID, round3.MemberID)
+	require.NoError(t, tss.VerifyOwnPubKeySignature(
+		member.ID,
+		tc.Group.DKGContext,
+		round3.OwnPubKeySig,
+		member.PubKey(),
+	))
+
+	group, err := s.GetGroup(tc.Group.PubKey)
+	require.NoError(t, err)
+	require.Equal(t, member.PrivKey, group.PrivKey)
+
+	// The partial signature computed remotely is the same as the expected one
+	pubDE := types.DE{PubD: assignedMember.PubD(), PubE: assignedMember.PubE()}
+	require.NoError(t, s.SetDE(store.DE{PubDE: pubDE, PrivD: assignedMember.PrivD, PrivE: assignedMember.PrivE}))
+
+	req := SignRequest{
+		SigningID:     1,
+		GroupPubKey:   tc.Group.PubKey,
+		GroupPubNonce: signing.PubNonce,
+		Message:       signing.Data,
+		PubDE:         pubDE,
+		BindingFactor: assignedMember.BindingFactor,
+		MemberIDs:     signing.GetAllIDs(),
+	}
+
+	// Signing refused by the verifier keeps the DE
+	refused := req
+	refused.SigningID = 2
+	_, err = remote.Sign(refused)
+	require.ErrorContains(t, err, "refused to sign the message: signing 2 is not allowed")
+	require.True(t, s.HasDE(pubDE))
+
+	result, err := remote.Sign(req)
+	require.NoError(t, err)
+	require.Equal(t, member.ID, result.MemberID)
+	require.Equal(t, assignedMember.Signature, result.Signature)
+
+	// The DE is kept until it is deleted, so the same signing can be retried
+	require.True(t, s.HasDE(pubDE))
+	result, err = remote.Sign(req)
+	require.NoError(t, err)
+	require.Equal(t, assignedMember.Signature, result.Signature)
+
+	// but the DE never signs another message
+	other := req
+	other.Message = []byte("another message")
+	_, err = remote.Sign(other)
+	require.ErrorContains(t, err, "is already used for signing 1")
+
+	// Errors of the signer are returned to the caller
+	_, err = remote.ComputeRound2Info(tss.GroupID(100), nil)
+	require.ErrorContains(t, err, "failed to find group in store")
+}
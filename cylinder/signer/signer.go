@@ -0,0 +1,55 @@
+package signer
+
+import (
+	"github.com/bandprotocol/chain/v3/cylinder/client"
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/x/tss/types"
+)
+
+// Signer holds the secrets of the member in TSS groups, which are the DKG secrets, the private keys
+// of the groups and the private DEs, and performs every operation that needs them.
+type Signer interface {
+	// GenerateRound1Info generates and keeps the DKG secrets of the member and returns its round 1 information.
+	GenerateRound1Info(gid tss.GroupID, mid tss.MemberID, threshold uint64, dkgContext []byte) (types.Round1Info, error)
+
+	// ComputeRound2Info computes the secret shares of the member encrypted for each other member of the group.
+	ComputeRound2Info(gid tss.GroupID, oneTimePubKeys tss.Points) (types.Round2Info, error)
+
+	// ComputeRound3 computes and keeps the private key of the member in the group and signs its public key.
+	// It returns complaints instead if any secret share from the other members is invalid.
+	ComputeRound3(groupRes *client.GroupResult) (Round3Result, error)
+
+	// GenerateDEs generates and keeps n new private DEs and returns their public DEs.
+	GenerateDEs(n uint64) ([]types.DE, error)
+
+	// DeleteDE deletes the private DE of the given public DE.
+	DeleteDE(pubDE types.DE) error
+
+	// Sign computes the partial signature of the member for the signing. The private DE is refused for
+	// any signing or message other than the one it is first used for.
+	Sign(req SignRequest) (SignResult, error)
+}
+
+// Round3Result is the result of the round 3 computation of the member.
+type Round3Result struct {
+	MemberID     tss.MemberID      `json:"member_id"`       // Member ID of the member in the group
+	OwnPubKeySig tss.Signature     `json:"own_pub_key_sig"` // Signature of the own public key, if no complaint
+	Complaints   []types.Complaint `json:"complaints"`      // Complaints against the other members
+}
+
+// SignRequest holds the information needed to compute a partial signature.
+type SignRequest struct {
+	SigningID     tss.SigningID  `json:"signing_id"`      // ID of the signing
+	GroupPubKey   tss.Point      `json:"group_pub_key"`   // Public key of the group
+	GroupPubNonce tss.Point      `json:"group_pub_nonce"` // Public nonce of the group for the signing
+	Message       []byte         `json:"message"`         // Message to be signed
+	PubDE         types.DE       `json:"pub_de"`          // Public DE assigned to the member
+	BindingFactor tss.Scalar     `json:"binding_factor"`  // Binding factor of the member
+	MemberIDs     []tss.MemberID `json:"member_ids"`      // Member IDs of all assigned members
+}
+
+// SignResult is the partial signature of the member.
+type SignResult struct {
+	MemberID  tss.MemberID  `json:"member_id"` // Member ID of the member in the group
+	Signature tss.Signature `json:"signature"` // Partial signature of the member
+}
@@ -0,0 +1,297 @@
+package status
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/bandprotocol/chain/v3/cylinder/client"
+	"github.com/bandprotocol/chain/v3/cylinder/store"
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/x/tss/types"
+)
+
+// ChainQuerier queries the TSS state of the chain needed to reconcile the store.
+type ChainQuerier interface {
+	QueryAllDEs(address string) ([]types.DE, error)
+	QueryAllGroups() ([]types.GroupResult, error)
+	QueryPendingSignings(address string) (*types.QueryPendingSigningsResponse, error)
+	QuerySigning(signingID tss.SigningID) (*client.SigningResponse, error)
+}
+
+// GroupEntry is a group kept in the store along with its status on chain.
+type GroupEntry struct {
+	GroupID  tss.GroupID       // ID of the group on chain, zero if the group is not found
+	PubKey   tss.Point         // Public key of the group
+	MemberID tss.MemberID      // Member ID of the granter in the group
+	Status   types.GroupStatus // Status of the group on chain
+}
+
+// DKGEntry is a DKG kept in the store along with the status of its group on chain.
+type DKGEntry struct {
+	GroupID  tss.GroupID       // ID of the group of the DKG
+	MemberID tss.MemberID      // Member ID of the granter in the group
+	Status   types.GroupStatus // Status of the group on chain
+}
+
+// Report is the result of reconciling the store with the chain.
+type Report struct {
+	OnChainDEs  int        // Number of DEs of the granter remaining on chain
+	LocalDEs    int        // Number of private DEs in the store
+	InUseDEs    int        // Number of private DEs assigned to pending signings
+	RecentDEs   int        // Number of private DEs not on chain but generated within the grace period
+	OrphanedDEs []types.DE // Private DEs that are neither on chain nor assigned to a pending signing
+	MissingDEs  []types.DE // DEs on chain without a private DE in the store
+
+	Groups         []GroupEntry  // Groups in the store
+	OrphanedGroups []GroupEntry  // Groups in the store that are expired or fallen on chain
+	UnknownGroups  []GroupEntry  // Groups in the store that are not found on chain
+	MissingGroups  []tss.GroupID // Active groups of the granter without a private key in the store
+
+	DKGs         []DKGEntry // DKGs in the store
+	OrphanedDKGs []DKGEntry // DKGs in the store that are no longer needed
+	UnknownDKGs  []DKGEntry // DKGs in the store of groups that are not found on chain
+}
+
+// HasIssues checks if the report found anything orphaned or missing.
+func (r Report) HasIssues() bool {
+	return len(r.OrphanedDEs) > 0 || len(r.MissingDEs) > 0 ||
+		len(r.OrphanedGroups) > 0 || len(r.MissingGroups) > 0 || len(r.UnknownGroups) > 0 ||
+		len(r.OrphanedDKGs) > 0 || len(r.UnknownDKGs) > 0
+}
+
+// HasOrphaned checks if the report found anything that can be pruned.
+func (r Report) HasOrphaned() bool {
+	return len(r.OrphanedDEs) > 0 || len(r.OrphanedGroups) > 0 || len(r.OrphanedDKGs) > 0
+}
+
+// Reconcile compares the groups, DKGs and DEs in the store with the chain state of the granter. Groups and
+// DKGs not found on chain are reported as unknown rather than orphaned, as the chain may be queried from an
+// out-of-sync node. DEs generated within the grace period are never orphaned, as they may not be submitted yet.
+func Reconcile(
+	q ChainQuerier,
+	s *store.Store,
+	granter string,
+	deGracePeriod time.Duration,
+	now time.Time,
+) (Report, error) {
+	var report Report
+
+	chainGroups, err := q.QueryAllGroups()
+	if err != nil {
+		return Report{}, fmt.Errorf("failed to query groups: %w", err)
+	}
+
+	if err := reconcileGroups(&report, s, chainGroups, granter); err != nil {
+		return Report{}, err
+	}
+
+	if err := reconcileDKGs(&report, s, chainGroups); err != nil {
+		return Report{}, err
+	}
+
+	if err := reconcileDEs(&report, q, s, granter, now.Add(-deGracePeriod)); err != nil {
+		return Report{}, err
+	}
+
+	return report, nil
+}
+
+// reconcileGroups finds local groups that are no longer active and active groups without a local private key.
+func reconcileGroups(report *Report, s *store.Store, chainGroups []types.GroupResult, granter string) error {
+	localGroups, err := s.GetAllGroups()
+	if err != nil {
+		return fmt.Errorf("failed to get groups from store: %w", err)
+	}
+
+	for _, local := range localGroups {
+		entry := GroupEntry{PubKey: local.GroupPubKey, MemberID: local.MemberID}
+		if chainGroup, ok := findGroupByPubKey(chainGroups, local.GroupPubKey); ok {
+			entry.GroupID = chainGroup.Group.ID
+			entry.Status = chainGroup.Group.Status
+		}
+
+		report.Groups = append(report.Groups, entry)
+		switch entry.Status {
+		case types.GROUP_STATUS_ACTIVE:
+		case types.GROUP_STATUS_UNSPECIFIED:
+			report.UnknownGroups = append(report.UnknownGroups, entry)
+		default:
+			report.OrphanedGroups = append(report.OrphanedGroups, entry)
+		}
+	}
+
+	for _, chainGroup := range chainGroups {
+		if chainGroup.Group.Status != types.GROUP_STATUS_ACTIVE || !isMember(chainGroup, granter) {
+			continue
+		}
+
+		if _, err := s.GetGroup(chainGroup.Group.PubKey); err != nil {
+			report.MissingGroups = append(report.MissingGroups, chainGroup.Group.ID)
+		}
+	}
+
+	return nil
+}
+
+// reconcileDKGs finds local DKGs of groups that finished or failed their DKG process.
+func reconcileDKGs(report *Report, s *store.Store, chainGroups []types.GroupResult) error {
+	dkgs, err := s.GetAllDKGs()
+	if err != nil {
+		return fmt.Errorf("failed to get DKGs from store: %w", err)
+	}
+
+	for _, dkg := range dkgs {
+		entry := DKGEntry{GroupID: dkg.GroupID, MemberID: dkg.MemberID}
+		chainGroup, found := findGroupByID(chainGroups, dkg.GroupID)
+		if found {
+			entry.Status = chainGroup.Group.Status
+		}
+		report.DKGs = append(report.DKGs, entry)
+
+		// The DKG is still needed while the group is in progress, or if the private key of an active
+		// group has not been computed from it yet.
+		switch entry.Status {
+		case types.GROUP_STATUS_UNSPECIFIED:
+			report.UnknownDKGs = append(report.UnknownDKGs, entry)
+			continue
+		case types.GROUP_STATUS_ROUND_1, types.GROUP_STATUS_ROUND_2, types.GROUP_STATUS_ROUND_3:
+			continue
+		case types.GROUP_STATUS_ACTIVE:
+			if _, err := s.GetGroup(chainGroup.Group.PubKey); err != nil {
+				continue
+			}
+		}
+
+		report.OrphanedDKGs = append(report.OrphanedDKGs, entry)
+	}
+
+	return nil
+}
+
+// reconcileDEs finds local private DEs that can no longer be used and DEs on chain without a private DE.
+// Local DEs generated after the cutoff are not orphaned.
+func reconcileDEs(report *Report, q ChainQuerier, s *store.Store, granter string, cutoff time.Time) error {
+	onChainDEs, err := q.QueryAllDEs(granter)
+	if err != nil {
+		return fmt.Errorf("failed to query DEs: %w", err)
+	}
+
+	// DEs assigned to pending signings are removed from the chain queue but are still needed
+	pendingRes, err := q.QueryPendingSignings(granter)
+	if err != nil {
+		return fmt.Errorf("failed to query pending signings: %w", err)
+	}
+
+	inUse := make(map[string]bool)
+	for _, sid := range pendingRes.PendingSignings {
+		signingRes, err := q.QuerySigning(sid)
+		if err != nil {
+			return fmt.Errorf("failed to query signing %d: %w", sid, err)
+		}
+
+		if signingRes.SigningResult.CurrentSigningAttempt == nil {
+			continue
+		}
+
+		assignedMember, err := signingRes.GetAssignedMember(granter)
+		if err != nil {
+			continue
+		}
+		inUse[deKey(types.DE{PubD: assignedMember.PubD, PubE: assignedMember.PubE})] = true
+	}
+
+	localDEs, err := s.GetAllDEs()
+	if err != nil {
+		return fmt.Errorf("failed to get DEs from store: %w", err)
+	}
+
+	onChain := make(map[string]bool, len(onChainDEs))
+	for _, de := range onChainDEs {
+		onChain[deKey(de)] = true
+	}
+
+	local := make(map[string]bool, len(localDEs))
+	for _, de := range localDEs {
+		key := deKey(de.PubDE)
+		local[key] = true
+
+		switch {
+		case inUse[key]:
+			report.InUseDEs++
+		case onChain[key]:
+		case de.CreatedAt.After(cutoff):
+			report.RecentDEs++
+		default:
+			report.OrphanedDEs = append(report.OrphanedDEs, de.PubDE)
+		}
+	}
+
+	for _, de := range onChainDEs {
+		if !local[deKey(de)] {
+			report.MissingDEs = append(report.MissingDEs, de)
+		}
+	}
+
+	report.OnChainDEs = len(onChainDEs)
+	report.LocalDEs = len(localDEs)
+
+	return nil
+}
+
+// Prune deletes the orphaned groups, DKGs and DEs of the report from the store.
+func Prune(s *store.Store, report Report) error {
+	for _, group := range report.OrphanedGroups {
+		if err := s.DeleteGroup(group.PubKey); err != nil {
+			return err
+		}
+	}
+
+	for _, dkg := range report.OrphanedDKGs {
+		if err := s.DeleteDKG(dkg.GroupID); err != nil {
+			return err
+		}
+	}
+
+	for _, de := range report.OrphanedDEs {
+		if err := s.DeleteDE(de); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func findGroupByPubKey(groups []types.GroupResult, pubKey tss.Point) (types.GroupResult, bool) {
+	for _, group := range groups {
+		if bytes.Equal(group.Group.PubKey, pubKey) {
+			return group, true
+		}
+	}
+
+	return types.GroupResult{}, false
+}
+
+func findGroupByID(groups []types.GroupResult, gid tss.GroupID) (types.GroupResult, bool) {
+	for _, group := range groups {
+		if group.Group.ID == gid {
+			return group, true
+		}
+	}
+
+	return types.GroupResult{}, false
+}
+
+func isMember(group types.GroupResult, address string) bool {
+	for _, member := range group.Members {
+		if member.Address == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+func deKey(de types.DE) string {
+	return string(de.PubD) + string(de.PubE)
+}
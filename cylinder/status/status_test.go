@@ -0,0 +1,170 @@
+package status
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/bandprotocol/chain/v3/cylinder/client"
+	"github.com/bandprotocol/chain/v3/cylinder/store"
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/x/tss/types"
+)
+
+const granter = "band1granter"
+
+type mockQuerier struct {
+	des      []types.DE
+	groups   []types.GroupResult
+	signings map[tss.SigningID]*client.SigningResponse
+}
+
+func (m mockQuerier) QueryAllDEs(string) ([]types.DE, error) {
+	return m.des, nil
+}
+
+func (m mockQuerier) QueryAllGroups() ([]types.GroupResult, error) {
+	return m.groups, nil
+}
+
+func (m mockQuerier) QueryPendingSignings(string) (*types.QueryPendingSigningsResponse, error) {
+	var sids []tss.SigningID
+	for sid := range m.signings {
+		sids = append(sids, sid)
+	}
+
+	return &types.QueryPendingSigningsResponse{PendingSignings: sids}, nil
+}
+
+func (m mockQuerier) QuerySigning(sid tss.SigningID) (*client.SigningResponse, error) {
+	res, ok := m.signings[sid]
+	if !ok {
+		return nil, fmt.Errorf("signing %d not found", sid)
+	}
+
+	return res, nil
+}
+
+func newDE(i byte) types.DE {
+	return types.DE{PubD: tss.Point{i, 1}, PubE: tss.Point{i, 2}}
+}
+
+func newGroupResult(gid tss.GroupID, pubKey tss.Point, status types.GroupStatus) types.GroupResult {
+	return types.GroupResult{
+		Group:   types.Group{ID: gid, PubKey: pubKey, Status: status},
+		Members: []types.Member{{ID: 1, GroupID: gid, Address: granter}},
+	}
+}
+
+func TestReconcileAndPrune(t *testing.T) {
+	s := store.NewStore(dbm.NewMemDB())
+	require.NoError(t, s.Unlock([]byte("passphrase")))
+
+	now := time.Unix(1_700_000_000, 0)
+
+	// Groups: 1 is active, 2 is expired, 3 is active but missing in the store, 6 is not found on chain
+	require.NoError(t, s.SetGroup(store.Group{GroupPubKey: tss.Point{1}, MemberID: 1}))
+	require.NoError(t, s.SetGroup(store.Group{GroupPubKey: tss.Point{2}, MemberID: 1}))
+	require.NoError(t, s.SetGroup(store.Group{GroupPubKey: tss.Point{6}, MemberID: 1}))
+
+	// DKGs: 1 has its group computed, 4 is in progress, 5 has fallen, 6 is not found on chain
+	require.NoError(t, s.SetDKG(store.DKG{GroupID: 1, MemberID: 1}))
+	require.NoError(t, s.SetDKG(store.DKG{GroupID: 4, MemberID: 1}))
+	require.NoError(t, s.SetDKG(store.DKG{GroupID: 5, MemberID: 1}))
+	require.NoError(t, s.SetDKG(store.DKG{GroupID: 6, MemberID: 1}))
+
+	// DEs: 1 is on chain, 2 is used by a pending signing, 3 is orphaned, 4 is missing in the store,
+	// 5 is not on chain but generated within the grace period
+	for _, i := range []byte{1, 2, 3} {
+		require.NoError(t, s.SetDE(store.DE{PubDE: newDE(i), CreatedAt: now.Add(-time.Hour)}))
+	}
+	require.NoError(t, s.SetDE(store.DE{PubDE: newDE(5), CreatedAt: now.Add(-time.Minute)}))
+
+	q := mockQuerier{
+		des: []types.DE{newDE(1), newDE(4)},
+		groups: []types.GroupResult{
+			newGroupResult(1, tss.Point{1}, types.GROUP_STATUS_ACTIVE),
+			newGroupResult(2, tss.Point{2}, types.GROUP_STATUS_EXPIRED),
+			newGroupResult(3, tss.Point{3}, types.GROUP_STATUS_ACTIVE),
+			newGroupResult(4, tss.Point{4}, types.GROUP_STATUS_ROUND_2),
+			newGroupResult(5, tss.Point{5}, types.GROUP_STATUS_FALLEN),
+		},
+		signings: map[tss.SigningID]*client.SigningResponse{
+			1: client.NewSigningResponse(&types.QuerySigningResponse{
+				SigningResult: types.SigningResult{
+					CurrentSigningAttempt: &types.SigningAttempt{
+						AssignedMembers: []types.AssignedMember{
+							{MemberID: 1, Address: granter, PubD: newDE(2).PubD, PubE: newDE(2).PubE},
+						},
+					},
+				},
+			}),
+		},
+	}
+
+	report, err := Reconcile(q, s, granter, 10*time.Minute, now)
+	require.NoError(t, err)
+	require.True(t, report.HasIssues())
+	require.True(t, report.HasOrphaned())
+
+	require.Len(t, report.Groups, 3)
+	require.Equal(t, []GroupEntry{
+		{GroupID: 2, PubKey: tss.Point{2}, MemberID: 1, Status: types.GROUP_STATUS_EXPIRED},
+	}, report.OrphanedGroups)
+	require.Equal(t, []GroupEntry{{PubKey: tss.Point{6}, MemberID: 1}}, report.UnknownGroups)
+	require.Equal(t, []tss.GroupID{3}, report.MissingGroups)
+
+	require.Len(t, report.DKGs, 4)
+	require.Equal(t, []DKGEntry{
+		{GroupID: 1, MemberID: 1, Status: types.GROUP_STATUS_ACTIVE},
+		{GroupID: 5, MemberID: 1, Status: types.GROUP_STATUS_FALLEN},
+	}, report.OrphanedDKGs)
+	require.Equal(t, []DKGEntry{{GroupID: 6, MemberID: 1}}, report.UnknownDKGs)
+
+	require.Equal(t, 2, report.OnChainDEs)
+	require.Equal(t, 4, report.LocalDEs)
+	require.Equal(t, 1, report.InUseDEs)
+	require.Equal(t, 1, report.RecentDEs)
+	require.Equal(t, []types.DE{newDE(3)}, report.OrphanedDEs)
+	require.Equal(t, []types.DE{newDE(4)}, report.MissingDEs)
+
+	// Pruning deletes only the orphaned entries
+	require.NoError(t, Prune(s, report))
+
+	_, err = s.GetGroup(tss.Point{1})
+	require.NoError(t, err)
+	_, err = s.GetGroup(tss.Point{2})
+	require.Error(t, err)
+	_, err = s.GetGroup(tss.Point{6})
+	require.NoError(t, err)
+
+	_, err = s.GetDKG(4)
+	require.NoError(t, err)
+	_, err = s.GetDKG(1)
+	require.Error(t, err)
+	_, err = s.GetDKG(5)
+	require.Error(t, err)
+	_, err = s.GetDKG(6)
+	require.NoError(t, err)
+
+	require.True(t, s.HasDE(newDE(1)))
+	require.True(t, s.HasDE(newDE(2)))
+	require.False(t, s.HasDE(newDE(3)))
+	require.True(t, s.HasDE(newDE(5)))
+
+	report, err = Reconcile(q, s, granter, 10*time.Minute, now)
+	require.NoError(t, err)
+	require.False(t, report.HasOrphaned())
+	require.Empty(t, report.OrphanedGroups)
+	require.Empty(t, report.OrphanedDKGs)
+	require.Empty(t, report.OrphanedDEs)
+
+	// The recent DE is orphaned once the grace period has passed
+	report, err = Reconcile(q, s, granter, 10*time.Minute, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, []types.DE{newDE(5)}, report.OrphanedDEs)
+}
@@ -0,0 +1,109 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ArchiveVersion is the version of the archive format written by EncryptArchive.
+const ArchiveVersion = 1
+
+// Archive holds the secrets exported from the store.
+type Archive struct {
+	Groups []Group `json:"groups,omitempty"` // Groups information
+	DKGs   []DKG   `json:"dkgs,omitempty"`   // DKGs information
+	DEs    []DE    `json:"des,omitempty"`    // Private DEs
+}
+
+// archiveFile is the password-protected encoding of an archive.
+type archiveFile struct {
+	Version uint32    `json:"version"` // Version of the archive format
+	KDF     KDFParams `json:"kdf"`     // Parameters to derive the key from the passphrase
+	Data    []byte    `json:"data"`    // Sealed JSON of the archive
+}
+
+// archiveAssociatedData binds the sealed data of an archive to its format version.
+func archiveAssociatedData(version uint32) []byte {
+	return []byte(fmt.Sprintf("cylinder-archive-v%d", version))
+}
+
+// EncryptArchive encodes the archive into a file protected by the given passphrase.
+func EncryptArchive(archive Archive, passphrase []byte) ([]byte, error) {
+	params, err := NewKDFParams()
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := params.DeriveKey(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := seal(key, bz, archiveAssociatedData(ArchiveVersion))
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(archiveFile{
+		Version: ArchiveVersion,
+		KDF:     params,
+		Data:    data,
+	})
+}
+
+// DecryptArchive decodes the archive from a file protected by the given passphrase.
+func DecryptArchive(bz []byte, passphrase []byte) (Archive, error) {
+	var file archiveFile
+	if err := json.Unmarshal(bz, &file); err != nil {
+		return Archive{}, fmt.Errorf("invalid archive: %w", err)
+	}
+
+	if file.Version != ArchiveVersion {
+		return Archive{}, fmt.Errorf("unsupported archive version: %d", file.Version)
+	}
+
+	key, err := file.KDF.DeriveKey(passphrase)
+	if err != nil {
+		return Archive{}, err
+	}
+
+	data, err := open(key, file.Data, archiveAssociatedData(file.Version))
+	if err != nil {
+		return Archive{}, err
+	}
+
+	var archive Archive
+	if err := json.Unmarshal(data, &archive); err != nil {
+		return Archive{}, err
+	}
+
+	return archive, nil
+}
+
+// Import stores every record of the archive.
+func (s *Store) Import(archive Archive) error {
+	for _, group := range archive.Groups {
+		if err := s.SetGroup(group); err != nil {
+			return err
+		}
+	}
+
+	for _, dkg := range archive.DKGs {
+		if err := s.SetDKG(dkg); err != nil {
+			return err
+		}
+	}
+
+	for _, de := range archive.DEs {
+		if err := s.SetDE(de); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
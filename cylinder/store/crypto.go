@@ -0,0 +1,95 @@
+package store
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// keySize is the size of the AES-256 key derived from a passphrase.
+const keySize = 32
+
+// KDFParams are the parameters of the argon2id function that derives an encryption key from a passphrase.
+type KDFParams struct {
+	Salt    []byte `json:"salt"`    // Random salt of the derivation
+	Time    uint32 `json:"time"`    // Number of passes over the memory
+	Memory  uint32 `json:"memory"`  // Size of the memory in KiB
+	Threads uint8  `json:"threads"` // Number of threads
+}
+
+// defaultKDFParams are the parameters used for newly created stores and archives.
+var defaultKDFParams = KDFParams{
+	Time:    3,
+	Memory:  64 * 1024,
+	Threads: 4,
+}
+
+// NewKDFParams creates the default key derivation parameters with a random salt.
+func NewKDFParams() (KDFParams, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return KDFParams{}, err
+	}
+
+	params := defaultKDFParams
+	params.Salt = salt
+
+	return params, nil
+}
+
+// DeriveKey derives the encryption key from the given passphrase.
+func (p KDFParams) DeriveKey(passphrase []byte) ([]byte, error) {
+	if len(p.Salt) == 0 || p.Time == 0 || p.Memory == 0 || p.Threads == 0 {
+		return nil, fmt.Errorf("invalid key derivation parameters")
+	}
+
+	return argon2.IDKey(passphrase, p.Salt, p.Time, p.Memory, p.Threads, keySize), nil
+}
+
+// seal encrypts and authenticates the plaintext and the associated data with AES-256-GCM.
+// The result is the random nonce followed by the ciphertext.
+func seal(key []byte, plaintext []byte, associatedData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, associatedData), nil
+}
+
+// open decrypts the sealed data and verifies that neither it nor the associated data was modified.
+func open(key []byte, sealed []byte, associatedData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return nil, fmt.Errorf("sealed data is too short")
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, associatedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: wrong passphrase or corrupted data")
+	}
+
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
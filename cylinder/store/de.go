@@ -1,6 +1,8 @@
 package store
 
 import (
+	"time"
+
 	"github.com/bandprotocol/chain/v3/pkg/tss"
 	"github.com/bandprotocol/chain/v3/x/tss/types"
 )
@@ -10,4 +12,9 @@ type DE struct {
 	PubDE types.DE   `json:"pub_d_e"` // Public key of D and E
 	PrivD tss.Scalar `json:"priv_d"`  // Private key d
 	PrivE tss.Scalar `json:"priv_e"`  // Private key e
+
+	CreatedAt time.Time `json:"created_at"` // Time the DE is generated, zero for DEs generated by older versions
+
+	SigningID   tss.SigningID `json:"signing_id,omitempty"`   // ID of the signing the DE is used for, zero if unused
+	MessageHash []byte        `json:"message_hash,omitempty"` // Hash of the message the DE signs, empty if unused
 }
@@ -0,0 +1,189 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	dbm "github.com/cometbft/cometbft-db"
+)
+
+// EncryptionVersion is the version of the record encryption scheme of the store.
+const EncryptionVersion = 1
+
+// checkValue is sealed into the encryption header to verify the passphrase when unlocking the store.
+var checkValue = []byte("cylinder")
+
+var (
+	// ErrStoreLocked is returned when the store is accessed before it is unlocked.
+	ErrStoreLocked = errors.New("store is locked")
+	// ErrPlaintextStore is returned when unlocking a store that was written without encryption.
+	ErrPlaintextStore = errors.New("store is not encrypted; run `cylinder store migrate` to encrypt it")
+)
+
+// encryptionHeader describes how the records of the store are encrypted.
+type encryptionHeader struct {
+	Version uint32    `json:"version"` // Version of the encryption scheme
+	KDF     KDFParams `json:"kdf"`     // Parameters to derive the key from the passphrase
+	Check   []byte    `json:"check"`   // Sealed check value to verify the passphrase
+}
+
+// newEncryptionHeader creates a new encryption header and the key of the given passphrase.
+func newEncryptionHeader(passphrase []byte) (encryptionHeader, []byte, error) {
+	params, err := NewKDFParams()
+	if err != nil {
+		return encryptionHeader{}, nil, err
+	}
+
+	key, err := params.DeriveKey(passphrase)
+	if err != nil {
+		return encryptionHeader{}, nil, err
+	}
+
+	check, err := seal(key, checkValue, EncryptionHeaderStoreKey)
+	if err != nil {
+		return encryptionHeader{}, nil, err
+	}
+
+	return encryptionHeader{
+		Version: EncryptionVersion,
+		KDF:     params,
+		Check:   check,
+	}, key, nil
+}
+
+// deriveKey derives the key of the given passphrase and verifies it against the check value.
+func (h encryptionHeader) deriveKey(passphrase []byte) ([]byte, error) {
+	if h.Version != EncryptionVersion {
+		return nil, fmt.Errorf("unsupported store encryption version: %d", h.Version)
+	}
+
+	key, err := h.KDF.DeriveKey(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := open(key, h.Check, EncryptionHeaderStoreKey); err != nil {
+		return nil, fmt.Errorf("failed to unlock store: wrong passphrase")
+	}
+
+	return key, nil
+}
+
+// IsEncrypted checks if the store has been set up with encryption.
+func (s *Store) IsEncrypted() (bool, error) {
+	return s.DB.Has(EncryptionHeaderStoreKey)
+}
+
+// Unlock derives the encryption key of the store from the given passphrase. An empty store is set up
+// with encryption using the passphrase, while a store written without encryption must be migrated first.
+func (s *Store) Unlock(passphrase []byte) error {
+	bz, err := s.DB.Get(EncryptionHeaderStoreKey)
+	if err != nil {
+		return err
+	}
+
+	if bz == nil {
+		empty, err := s.isEmpty()
+		if err != nil {
+			return err
+		}
+		if !empty {
+			return ErrPlaintextStore
+		}
+
+		return s.setUpEncryption(passphrase)
+	}
+
+	var header encryptionHeader
+	if err := json.Unmarshal(bz, &header); err != nil {
+		return err
+	}
+
+	key, err := header.deriveKey(passphrase)
+	if err != nil {
+		return err
+	}
+
+	s.key = key
+	return nil
+}
+
+// Migrate encrypts all records of a store that was written without encryption with the given passphrase
+// and unlocks the store.
+func (s *Store) Migrate(passphrase []byte) error {
+	encrypted, err := s.IsEncrypted()
+	if err != nil {
+		return err
+	}
+	if encrypted {
+		return fmt.Errorf("store is already encrypted")
+	}
+
+	header, encKey, err := newEncryptionHeader(passphrase)
+	if err != nil {
+		return err
+	}
+
+	// Encrypt every record in the same batch as the header, so a failed migration leaves the
+	// store untouched.
+	batch := s.DB.NewBatch()
+	defer batch.Close()
+
+	for _, prefix := range [][]byte{DKGStoreKeyPrefix, GroupStoreKeyPrefix, DEStoreKeyPrefix} {
+		if err := s.iterate(prefix, func(key []byte, value []byte) error {
+			sealed, err := seal(encKey, value, key)
+			if err != nil {
+				return err
+			}
+
+			return batch.Set(key, sealed)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return s.writeHeader(batch, header, encKey)
+}
+
+// setUpEncryption writes a new encryption header of the given passphrase and unlocks the store.
+func (s *Store) setUpEncryption(passphrase []byte) error {
+	header, key, err := newEncryptionHeader(passphrase)
+	if err != nil {
+		return err
+	}
+
+	batch := s.DB.NewBatch()
+	defer batch.Close()
+
+	return s.writeHeader(batch, header, key)
+}
+
+// writeHeader writes the encryption header with the batch and unlocks the store with the key.
+func (s *Store) writeHeader(batch dbm.Batch, header encryptionHeader, key []byte) error {
+	bz, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+
+	if err := batch.Set(EncryptionHeaderStoreKey, bz); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	s.key = key
+	return nil
+}
+
+// isEmpty checks if the store has no record at all.
+func (s *Store) isEmpty() (bool, error) {
+	iterator, err := s.DB.Iterator(nil, nil)
+	if err != nil {
+		return false, err
+	}
+	defer iterator.Close()
+
+	return !iterator.Valid(), iterator.Error()
+}
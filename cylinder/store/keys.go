@@ -16,6 +16,9 @@ var (
 	GroupStoreKeyPrefix = []byte{0x02}
 	// DEStoreKeyPrefix is the prefix for DE store.
 	DEStoreKeyPrefix = []byte{0x03}
+
+	// EncryptionHeaderStoreKey is the key that keeps the encryption header of the store.
+	EncryptionHeaderStoreKey = append(GlobalStoreKeyPrefix, []byte("EncryptionHeader")...)
 )
 
 // DKGStoreKey returns the key to retrieve all data for a group.
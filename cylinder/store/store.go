@@ -1,8 +1,10 @@
 package store
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"sync"
 
 	dbm "github.com/cometbft/cometbft-db"
 
@@ -12,9 +14,15 @@ import (
 	"github.com/bandprotocol/chain/v3/x/tss/types"
 )
 
-// Store represents a data store for storing data information for Cylinder process
+// Store represents a data store for storing data information for Cylinder process.
+// Every record is encrypted with a key derived from a passphrase, so the store has to be
+// unlocked before it is used.
 type Store struct {
 	DB dbm.DB
+
+	key []byte // Encryption key of the records, set when the store is unlocked
+
+	deMu sync.Mutex // Serializes using DEs, so a DE is used for only one message
 }
 
 // NewStore creates a new instance of Store with the provided database.
@@ -26,54 +34,41 @@ func NewStore(db dbm.DB) *Store {
 
 // SetDKG stores the dkg information by the given group id.
 func (s *Store) SetDKG(dkg DKG) error {
-	bytes, err := json.Marshal(dkg)
-	if err != nil {
-		return err
-	}
-
-	return s.DB.Set(DKGStoreKey(dkg.GroupID), bytes)
+	return s.set(DKGStoreKey(dkg.GroupID), dkg, false)
 }
 
 // GetAllDKGs retrieves all DKGs information
 func (s *Store) GetAllDKGs() ([]DKG, error) {
-	iterator, err := s.DB.Iterator(DKGStoreKeyPrefix, storetypes.PrefixEndBytes(DKGStoreKeyPrefix))
-	if err != nil {
-		return nil, err
-	}
-	defer iterator.Close()
-
 	var dkgs []DKG
-	for ; iterator.Valid(); iterator.Next() {
+	err := s.iterateRecords(DKGStoreKeyPrefix, func(bz []byte) error {
 		var dkg DKG
-		err = json.Unmarshal(iterator.Value(), &dkg)
-		if err != nil {
-			return nil, err
+		if err := json.Unmarshal(bz, &dkg); err != nil {
+			return err
 		}
 
 		dkgs = append(dkgs, dkg)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return dkgs, err
+	return dkgs, nil
 }
 
 // GetDKG retrieves the dkg information by the given group id.
 func (s *Store) GetDKG(groupID tss.GroupID) (DKG, error) {
-	bytes, err := s.DB.Get(DKGStoreKey(groupID))
+	var dkg DKG
+	found, err := s.get(DKGStoreKey(groupID), &dkg)
 	if err != nil {
 		return DKG{}, err
 	}
 
-	if bytes == nil {
+	if !found {
 		return DKG{}, fmt.Errorf("DKG with group ID (%d) doesn't exist", groupID)
 	}
 
-	var dkg DKG
-	err = json.Unmarshal(bytes, &dkg)
-	if err != nil {
-		return DKG{}, err
-	}
-
-	return dkg, err
+	return dkg, nil
 }
 
 // DeleteDKG deletes the dkg information by the given group id.
@@ -83,106 +78,85 @@ func (s *Store) DeleteDKG(groupID tss.GroupID) error {
 
 // SetGroup stores the group information
 func (s *Store) SetGroup(group Group) error {
-	bytes, err := json.Marshal(group)
-	if err != nil {
-		return err
-	}
-
-	return s.DB.Set(GroupStoreKey(group.GroupPubKey), bytes)
+	return s.set(GroupStoreKey(group.GroupPubKey), group, false)
 }
 
 // GetAllGroups retrieves all groups information
 func (s *Store) GetAllGroups() ([]Group, error) {
-	iterator, err := s.DB.Iterator(GroupStoreKeyPrefix, storetypes.PrefixEndBytes(GroupStoreKeyPrefix))
-	if err != nil {
-		return nil, err
-	}
-	defer iterator.Close()
-
 	var groups []Group
-	for ; iterator.Valid(); iterator.Next() {
+	err := s.iterateRecords(GroupStoreKeyPrefix, func(bz []byte) error {
 		var group Group
-		err = json.Unmarshal(iterator.Value(), &group)
-		if err != nil {
-			return nil, err
+		if err := json.Unmarshal(bz, &group); err != nil {
+			return err
 		}
 
 		groups = append(groups, group)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return groups, err
+	return groups, nil
 }
 
 // GetGroup retrieves the group information by the given public key.
 func (s *Store) GetGroup(pubKey tss.Point) (Group, error) {
-	bytes, err := s.DB.Get(GroupStoreKey(pubKey))
+	var group Group
+	found, err := s.get(GroupStoreKey(pubKey), &group)
 	if err != nil {
 		return Group{}, err
 	}
 
-	if bytes == nil {
+	if !found {
 		return Group{}, fmt.Errorf("group with public key (%s) doesn't exist", pubKey)
 	}
 
-	var group Group
-	err = json.Unmarshal(bytes, &group)
-	if err != nil {
-		return Group{}, err
-	}
+	return group, nil
+}
 
-	return group, err
+// DeleteGroup deletes the group information by the given public key.
+func (s *Store) DeleteGroup(pubKey tss.Point) error {
+	return s.DB.DeleteSync(GroupStoreKey(pubKey))
 }
 
 // SetDE stores the private (d, E)
 func (s *Store) SetDE(privDE DE) error {
-	bytes, err := json.Marshal(privDE)
-	if err != nil {
-		return err
-	}
-
-	return s.DB.SetSync(DEStoreKey(privDE.PubDE), bytes)
+	return s.set(DEStoreKey(privDE.PubDE), privDE, true)
 }
 
 // GetAllDEs retrieves all DEs information
 func (s *Store) GetAllDEs() ([]DE, error) {
-	iterator, err := s.DB.Iterator(DEStoreKeyPrefix, storetypes.PrefixEndBytes(DEStoreKeyPrefix))
-	if err != nil {
-		return nil, err
-	}
-	defer iterator.Close()
-
 	var des []DE
-	for ; iterator.Valid(); iterator.Next() {
+	err := s.iterateRecords(DEStoreKeyPrefix, func(bz []byte) error {
 		var de DE
-		err = json.Unmarshal(iterator.Value(), &de)
-		if err != nil {
-			return nil, err
+		if err := json.Unmarshal(bz, &de); err != nil {
+			return err
 		}
 
 		des = append(des, de)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return des, err
+	return des, nil
 }
 
 // GetDE retrieves the private (d, E) by the given public (D, E)
 func (s *Store) GetDE(pubDE types.DE) (DE, error) {
-	bytes, err := s.DB.Get(DEStoreKey(pubDE))
+	var de DE
+	found, err := s.get(DEStoreKey(pubDE), &de)
 	if err != nil {
 		return DE{}, err
 	}
 
-	if bytes == nil {
+	if !found {
 		return DE{}, fmt.Errorf("DE with public DE (%s) doesn't exist", pubDE)
 	}
 
-	var de DE
-	err = json.Unmarshal(bytes, &de)
-	if err != nil {
-		return DE{}, err
-	}
-
-	return de, err
+	return de, nil
 }
 
 func (s *Store) HasDE(pubDE types.DE) bool {
@@ -190,7 +164,115 @@ func (s *Store) HasDE(pubDE types.DE) bool {
 	return err == nil && bytes != nil
 }
 
+// UseDE retrieves the private (d, E) by the given public (D, E) to sign the message of the given signing.
+// The first use is recorded and the private (d, E) is refused for any other signing or message, so that it
+// never signs two different messages, while the same signing can be retried until the (d, E) is deleted.
+func (s *Store) UseDE(pubDE types.DE, signingID tss.SigningID, message []byte) (DE, error) {
+	s.deMu.Lock()
+	defer s.deMu.Unlock()
+
+	de, err := s.GetDE(pubDE)
+	if err != nil {
+		return DE{}, err
+	}
+
+	messageHash := tss.Hash(message)
+	if len(de.MessageHash) != 0 {
+		if de.SigningID != signingID || !bytes.Equal(de.MessageHash, messageHash) {
+			return DE{}, fmt.Errorf("DE with public DE (%s) is already used for signing %d", pubDE, de.SigningID)
+		}
+		return de, nil
+	}
+
+	de.SigningID = signingID
+	de.MessageHash = messageHash
+	if err := s.SetDE(de); err != nil {
+		return DE{}, err
+	}
+
+	return de, nil
+}
+
 // DeleteDE deletes the private (d, E) by the given public (D, E)
 func (s *Store) DeleteDE(pubDE types.DE) error {
 	return s.DB.DeleteSync(DEStoreKey(pubDE))
 }
+
+// set encrypts the JSON of the value and stores it by the given key. The key is authenticated
+// along with the value, so a record can't be moved to another key.
+func (s *Store) set(key []byte, value any, sync bool) error {
+	if s.key == nil {
+		return ErrStoreLocked
+	}
+
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	sealed, err := seal(s.key, bz, key)
+	if err != nil {
+		return err
+	}
+
+	if sync {
+		return s.DB.SetSync(key, sealed)
+	}
+	return s.DB.Set(key, sealed)
+}
+
+// get retrieves and decrypts the record of the given key into the value.
+func (s *Store) get(key []byte, value any) (bool, error) {
+	if s.key == nil {
+		return false, ErrStoreLocked
+	}
+
+	sealed, err := s.DB.Get(key)
+	if err != nil {
+		return false, err
+	}
+
+	if sealed == nil {
+		return false, nil
+	}
+
+	bz, err := open(s.key, sealed, key)
+	if err != nil {
+		return false, err
+	}
+
+	return true, json.Unmarshal(bz, value)
+}
+
+// iterateRecords decrypts every record under the given prefix and passes it to the callback.
+func (s *Store) iterateRecords(prefix []byte, cb func(bz []byte) error) error {
+	if s.key == nil {
+		return ErrStoreLocked
+	}
+
+	return s.iterate(prefix, func(key []byte, sealed []byte) error {
+		bz, err := open(s.key, sealed, key)
+		if err != nil {
+			return err
+		}
+
+		return cb(bz)
+	})
+}
+
+// iterate passes every raw key and value under the given prefix to the callback.
+func (s *Store) iterate(prefix []byte, cb func(key []byte, value []byte) error) error {
+	iterator, err := s.DB.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if err := cb(iterator.Key(), iterator.Value()); err != nil {
+			return err
+		}
+	}
+
+	return iterator.Error()
+}
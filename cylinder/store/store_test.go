@@ -0,0 +1,136 @@
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/x/tss/types"
+)
+
+func TestMain(m *testing.M) {
+	// Use cheap key derivation to keep the tests fast.
+	defaultKDFParams = KDFParams{Time: 1, Memory: 64, Threads: 1}
+	os.Exit(m.Run())
+}
+
+var (
+	testGroup = Group{
+		GroupPubKey: tss.Point([]byte{0x02, 0x01}),
+		MemberID:    1,
+		PrivKey:     tss.Scalar([]byte("group private key")),
+	}
+	testDE = DE{
+		PubDE: types.DE{PubD: []byte{0x02, 0x02}, PubE: []byte{0x02, 0x03}},
+		PrivD: tss.Scalar([]byte("private d")),
+		PrivE: tss.Scalar([]byte("private e")),
+	}
+)
+
+func TestStoreEncryption(t *testing.T) {
+	db := dbm.NewMemDB()
+	s := NewStore(db)
+
+	require.ErrorIs(t, s.SetGroup(testGroup), ErrStoreLocked)
+
+	// An empty store is set up with the first passphrase.
+	require.NoError(t, s.Unlock([]byte("passphrase")))
+	encrypted, err := s.IsEncrypted()
+	require.NoError(t, err)
+	require.True(t, encrypted)
+
+	require.NoError(t, s.SetGroup(testGroup))
+	require.NoError(t, s.SetDE(testDE))
+
+	// Secrets are not written in plain text.
+	raw, err := db.Get(GroupStoreKey(testGroup.GroupPubKey))
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(raw, []byte(`"priv_key"`)))
+
+	// The store can only be reopened with the same passphrase.
+	require.ErrorContains(t, NewStore(db).Unlock([]byte("wrong")), "wrong passphrase")
+
+	reopened := NewStore(db)
+	require.NoError(t, reopened.Unlock([]byte("passphrase")))
+	group, err := reopened.GetGroup(testGroup.GroupPubKey)
+	require.NoError(t, err)
+	require.Equal(t, testGroup, group)
+	des, err := reopened.GetAllDEs()
+	require.NoError(t, err)
+	require.Equal(t, []DE{testDE}, des)
+
+	// A record moved to another key fails authentication.
+	otherKey := GroupStoreKey(tss.Point([]byte{0x02, 0x09}))
+	require.NoError(t, db.Set(otherKey, raw))
+	_, err = reopened.GetGroup(tss.Point([]byte{0x02, 0x09}))
+	require.ErrorContains(t, err, "failed to decrypt")
+}
+
+func TestStoreMigrate(t *testing.T) {
+	db := dbm.NewMemDB()
+
+	// Write records the way earlier versions did.
+	bz, err := json.Marshal(testGroup)
+	require.NoError(t, err)
+	require.NoError(t, db.Set(GroupStoreKey(testGroup.GroupPubKey), bz))
+	bz, err = json.Marshal(testDE)
+	require.NoError(t, err)
+	require.NoError(t, db.Set(DEStoreKey(testDE.PubDE), bz))
+
+	s := NewStore(db)
+	require.ErrorIs(t, s.Unlock([]byte("passphrase")), ErrPlaintextStore)
+
+	require.NoError(t, s.Migrate([]byte("passphrase")))
+	require.ErrorContains(t, s.Migrate([]byte("passphrase")), "already encrypted")
+
+	raw, err := db.Get(DEStoreKey(testDE.PubDE))
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(raw, []byte(`"priv_d"`)))
+
+	reopened := NewStore(db)
+	require.NoError(t, reopened.Unlock([]byte("passphrase")))
+	groups, err := reopened.GetAllGroups()
+	require.NoError(t, err)
+	require.Equal(t, []Group{testGroup}, groups)
+	de, err := reopened.GetDE(testDE.PubDE)
+	require.NoError(t, err)
+	require.Equal(t, testDE, de)
+}
+
+func TestArchive(t *testing.T) {
+	archive := Archive{Groups: []Group{testGroup}, DEs: []DE{testDE}}
+
+	bz, err := EncryptArchive(archive, []byte("archive passphrase"))
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(bz, []byte("priv_key")))
+
+	decrypted, err := DecryptArchive(bz, []byte("archive passphrase"))
+	require.NoError(t, err)
+	require.Equal(t, archive, decrypted)
+
+	_, err = DecryptArchive(bz, []byte("wrong"))
+	require.ErrorContains(t, err, "failed to decrypt")
+
+	// Archives of an unknown version are rejected.
+	var file archiveFile
+	require.NoError(t, json.Unmarshal(bz, &file))
+	file.Version = 2
+	bz, err = json.Marshal(file)
+	require.NoError(t, err)
+	_, err = DecryptArchive(bz, []byte("archive passphrase"))
+	require.ErrorContains(t, err, "unsupported archive version")
+
+	// Records of the archive are imported into an unlocked store.
+	s := NewStore(dbm.NewMemDB())
+	require.NoError(t, s.Unlock([]byte("passphrase")))
+	require.NoError(t, s.Import(archive))
+	group, err := s.GetGroup(testGroup.GroupPubKey)
+	require.NoError(t, err)
+	require.Equal(t, testGroup, group)
+}
@@ -2,6 +2,7 @@ package de
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/bandprotocol/chain/v3/cylinder"
 	"github.com/bandprotocol/chain/v3/cylinder/client"
 	"github.com/bandprotocol/chain/v3/cylinder/context"
+	"github.com/bandprotocol/chain/v3/cylinder/metrics"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 	"github.com/bandprotocol/chain/v3/x/tss/types"
 )
@@ -23,26 +25,36 @@ var _ cylinder.Worker = &DE{}
 // DE is a worker responsible for generating own nonce (DE) of signing process
 type DE struct {
 	context       *context.Context
+	granters      cylinder.Granters
 	logger        *logger.Logger
 	client        *client.Client
 	assignEventCh <-chan ctypes.ResultEvent
 	useEventCh    <-chan ctypes.ResultEvent
 	deleteEventCh <-chan ctypes.ResultEvent
-	cntUsed       uint64
+	cntUsed       map[string]uint64         // Number of used DEs since the last update by granter address
+	trackers      map[string]*DemandTracker // Recent DE usage by granter address
 }
 
 // New creates a new instance of the DE worker.
 // It initializes the necessary components and returns the created DE instance or an error if initialization fails.
-func New(ctx *context.Context) (*DE, error) {
+func New(ctx *context.Context, granters cylinder.Granters) (*DE, error) {
 	cli, err := client.New(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	trackers := make(map[string]*DemandTracker, len(granters))
+	for _, granter := range granters {
+		trackers[granter.Address] = NewDemandTracker(ctx.Config.DEDemandWindow)
+	}
+
 	return &DE{
-		context: ctx,
-		logger:  ctx.Logger.With("worker", "DE"),
-		client:  cli,
+		context:  ctx,
+		granters: granters,
+		logger:   ctx.Logger.With("worker", "DE"),
+		client:   cli,
+		cntUsed:  make(map[string]uint64),
+		trackers: trackers,
 	}, nil
 }
 
@@ -50,10 +62,9 @@ func New(ctx *context.Context) (*DE, error) {
 // It returns an error if the subscription fails.
 func (de *DE) subscribe() (err error) {
 	subscriptionQuery := fmt.Sprintf(
-		"%s.%s = '%s'",
+		"%s.%s EXISTS",
 		types.EventTypeRequestSignature,
 		types.AttributeKeyAddress,
-		de.context.Config.Granter,
 	)
 	de.assignEventCh, err = de.client.Subscribe("DE-assigned", subscriptionQuery, 1000)
 	if err != nil {
@@ -61,10 +72,9 @@ func (de *DE) subscribe() (err error) {
 	}
 
 	subscriptionQuery = fmt.Sprintf(
-		"tm.event = 'Tx' AND %s.%s = '%s'",
+		"tm.event = 'Tx' AND %s.%s EXISTS",
 		types.EventTypeSubmitSignature,
 		types.AttributeKeyAddress,
-		de.context.Config.Granter,
 	)
 	de.useEventCh, err = de.client.Subscribe("DE-submitted", subscriptionQuery, 1000)
 	if err != nil {
@@ -72,17 +82,16 @@ func (de *DE) subscribe() (err error) {
 	}
 
 	subscriptionQuery = fmt.Sprintf(
-		"tm.event = 'Tx' AND %s.%s = '%s'",
+		"tm.event = 'Tx' AND %s.%s EXISTS",
 		types.EventTypeDEDeleted,
 		types.AttributeKeyAddress,
-		de.context.Config.Granter,
 	)
 	de.deleteEventCh, err = de.client.Subscribe("DE-deleted", subscriptionQuery, 1000)
 
 	return
 }
 
-// deleteDEFromABCIEvents signs the specific signingID if the given events contain a request_signature event.
+// deleteDEFromABCIEvents deletes the private DEs of the granters that are used or deleted in the given events.
 func (de *DE) deleteDEFromABCIEvents(abciEvents []abci.Event) {
 	events := sdk.StringifyEvents(abciEvents)
 	for _, ev := range events {
@@ -94,81 +103,150 @@ func (de *DE) deleteDEFromABCIEvents(abciEvents []abci.Event) {
 			}
 
 			for _, pubDE := range pubDEs {
-				go de.deleteDE(pubDE.PubDE)
+				granter, ok := de.granters.Get(pubDE.Address)
+				if !ok {
+					continue
+				}
+
+				go de.deleteDE(granter, pubDE.PubDE)
+			}
+		}
+	}
+}
+
+// handleAssignedEvent records the DEs of the granters assigned to the signings in the given events and
+// replenishes DEs of the granters that used at least their minimum number of DEs.
+func (de *DE) handleAssignedEvent(ev ctypes.ResultEvent) {
+	now := time.Now()
+	for _, address := range ev.Events[types.EventTypeRequestSignature+"."+types.AttributeKeyAddress] {
+		granter, ok := de.granters.Get(address)
+		if !ok {
+			continue
+		}
+
+		de.trackers[address].Record(now, 1)
+		de.cntUsed[address] += 1
+		if de.cntUsed[address] >= granter.MinDE {
+			if err := de.replenishDE(granter); err != nil {
+				de.logger.Error(":cold_sweat: Failed to replenish DE of %s: %s", address, err)
 			}
+			de.cntUsed[address] = 0
 		}
 	}
 }
 
-// deleteDE deletes the specific DE.
-func (de *DE) deleteDE(pubDE types.DE) {
+// deleteDE deletes the specific DE of the granter.
+func (de *DE) deleteDE(granter cylinder.Granter, pubDE types.DE) {
 	// Log
-	logger := de.logger.With("D", hex.EncodeToString(pubDE.PubD), "E", hex.EncodeToString(pubDE.PubE))
+	logger := de.logger.With(
+		"granter", granter.Address,
+		"D", hex.EncodeToString(pubDE.PubD),
+		"E", hex.EncodeToString(pubDE.PubE),
+	)
 	logger.Info(":delivery_truck: Removing DE")
 
-	// Remove DE from storage
-	err := de.context.Store.DeleteDE(pubDE)
+	// Remove DE from the signer
+	err := granter.Signer.DeleteDE(pubDE)
 	if err != nil {
-		de.logger.Error(":cold_sweat: Failed to remove DE: %s", err)
+		logger.Error(":cold_sweat: Failed to remove DE: %s", err)
 		return
 	}
+
+	metrics.IncDeletedDE(granter.Address)
 }
 
-func (de *DE) getDECount() (uint64, error) {
+func (de *DE) getDECount(granter cylinder.Granter) (uint64, error) {
 	// Query DE information
-	deRes, err := de.client.QueryDE(de.context.Config.Granter, 0, 1)
+	deRes, err := de.client.QueryDE(granter.Address, 0, 1)
 	if err != nil {
-		de.logger.Error(":cold_sweat: Failed to query DE information: %s", err)
+		de.logger.Error(":cold_sweat: Failed to query DE information of %s: %s", granter.Address, err)
 		return 0, err
 	}
 
+	metrics.SetOnChainDEs(granter.Address, deRes.GetRemaining())
 	return deRes.GetRemaining(), nil
 }
 
-// updateDE updates DE if the remaining DE is too low.
-func (de *DE) updateDE(numNewDE uint64) {
-	canUpdate, err := de.canUpdateDE()
+// replenishDE submits new DEs of the granter if the DEs on chain don't cover the forecasted demand.
+// The demand is forecasted from the recent DE usage and the pending signings of the granter over
+// two checking intervals, so the DEs last until the check after the next one.
+func (de *DE) replenishDE(granter cylinder.Granter) error {
+	remaining, err := de.getDECount(granter)
 	if err != nil {
-		de.logger.Error(":cold_sweat: Cannot update DE: %s", err)
+		return err
+	}
+
+	pendingRes, err := de.client.QueryPendingSignings(granter.Address)
+	if err != nil {
+		return fmt.Errorf("failed to query pending signings: %w", err)
+	}
+
+	params, err := de.client.QueryTSSParams()
+	if err != nil {
+		return fmt.Errorf("failed to query tss params: %w", err)
+	}
+
+	plan := PlanRefill(
+		remaining,
+		uint64(len(pendingRes.PendingSignings)),
+		de.trackers[granter.Address].Rate(time.Now()),
+		2*de.context.Config.CheckingDEInterval,
+		granter.MinDE,
+		granter.MaxDE,
+		params.MaxDESize,
+	)
+	metrics.SetDERefillPlan(granter.Address, plan.Rate*60, plan.Pending, plan.Target)
+
+	logger := de.logger.With(
+		"granter", granter.Address,
+		"remaining", plan.Remaining,
+		"pending", plan.Pending,
+		"rate_per_minute", fmt.Sprintf("%.2f", plan.Rate*60),
+		"target", plan.Target,
+	)
+	if plan.Refill == 0 {
+		logger.Debug(":ok_hand: DEs on chain cover the forecasted demand")
+		return nil
+	}
+
+	logger.Info(":bar_chart: Refilling %d DEs to cover the forecasted demand", plan.Refill)
+	de.updateDE(granter, plan.Refill)
+
+	return nil
+}
+
+// updateDE generates and submits the given number of new DEs of the granter.
+func (de *DE) updateDE(granter cylinder.Granter, numNewDE uint64) {
+	logger := de.logger.With("granter", granter.Address)
+
+	canUpdate, err := de.canUpdateDE(granter)
+	if err != nil {
+		logger.Error(":cold_sweat: Cannot update DE: %s", err)
 		return
 	}
 	if !canUpdate {
-		de.logger.Debug(
+		logger.Debug(
 			":cold_sweat: Cannot update DE: the granter is not a member of the current or incoming group and gas price isn't set in the config",
 		)
 		return
 	}
 
-	de.logger.Info(":delivery_truck: Updating DE")
+	logger.Info(":delivery_truck: Updating DE")
 
-	// Generate new DE pairs
-	privDEs, err := GenerateDEs(
-		numNewDE,
-		de.context.Config.RandomSecret,
-		de.context.Store,
-	)
+	// Generate new DE pairs, the private DEs are kept by the signer
+	pubDEs, err := granter.Signer.GenerateDEs(numNewDE)
 	if err != nil {
-		de.logger.Error(":cold_sweat: Failed to generate new DE pairs: %s", err)
+		logger.Error(":cold_sweat: Failed to generate new DE pairs: %s", err)
 		return
 	}
-
-	// Store all DEs in the store
-	var pubDEs []types.DE
-	for _, privDE := range privDEs {
-		pubDEs = append(pubDEs, privDE.PubDE)
-
-		if err := de.context.Store.SetDE(privDE); err != nil {
-			de.logger.Error(":cold_sweat: Failed to set new DE in the store: %s", err)
-			return
-		}
-	}
+	metrics.AddGeneratedDEs(granter.Address, len(pubDEs))
 
 	// Send MsgDE
-	de.context.MsgCh <- types.NewMsgSubmitDEs(pubDEs, de.context.Config.Granter)
+	de.context.MsgCh <- types.NewMsgSubmitDEs(pubDEs, granter.Address)
 }
 
-// canUpdateDE checks if the system allows to update DEs into the system and chain.
-func (de *DE) canUpdateDE() (bool, error) {
+// canUpdateDE checks if the system allows to update DEs of the granter into the system and chain.
+func (de *DE) canUpdateDE(granter cylinder.Granter) (bool, error) {
 	gasPrices, err := sdk.ParseDecCoins(de.context.Config.GasPrices)
 	if err != nil {
 		de.logger.Debug(":cold_sweat: Failed to parse gas prices from config: %s", err)
@@ -182,33 +260,33 @@ func (de *DE) canUpdateDE() (bool, error) {
 
 	// If the address is a member of the current group, the system can submit DEs to the chain
 	// without paying gas.
-	resp, err := de.client.QueryMember(de.context.Config.Granter)
+	resp, err := de.client.QueryMember(granter.Address)
 	if err != nil {
 		return false, fmt.Errorf("failed to query member information: %w", err)
 	}
 
-	if resp.CurrentGroupMember.Address == de.context.Config.Granter ||
-		resp.IncomingGroupMember.Address == de.context.Config.Granter {
+	if resp.CurrentGroupMember.Address == granter.Address ||
+		resp.IncomingGroupMember.Address == granter.Address {
 		return true, nil
 	}
 
 	return false, nil
 }
 
-// intervalUpdateDE updates DE on the chain so that the remaining DE is
-// always above the minimum threshold.
+// intervalUpdateDE replenishes DE of every granter on the chain so that the remaining DE
+// always covers the forecasted demand of the granter. A granter that fails doesn't stop the
+// others; the errors of all failed granters are returned together.
 func (de *DE) intervalUpdateDE() error {
-	deCount, err := de.getDECount()
-	if err != nil {
-		return err
-	}
-
-	if deCount < 2*de.context.Config.MinDE {
-		de.updateDE(2*de.context.Config.MinDE - deCount)
-		de.cntUsed = 0
+	var errs []error
+	for _, granter := range de.granters {
+		if err := de.replenishDE(granter); err != nil {
+			errs = append(errs, fmt.Errorf("failed to replenish DE of %s: %w", granter.Address, err))
+			continue
+		}
+		de.cntUsed[granter.Address] = 0
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // Start starts the DE worker.
@@ -222,10 +300,10 @@ func (de *DE) Start() {
 		return
 	}
 
-	// Update one time when starting worker first time.
+	// Update one time when starting worker first time. The granters that fail are retried at
+	// the next interval.
 	if err := de.intervalUpdateDE(); err != nil {
-		de.context.ErrCh <- err
-		return
+		de.logger.Error(":cold_sweat: Failed to do an initial update DE: %s", err)
 	}
 
 	// Remove DE if there is used DE or deleted DE event.
@@ -248,12 +326,8 @@ func (de *DE) Start() {
 			if err := de.intervalUpdateDE(); err != nil {
 				de.logger.Error(":cold_sweat: Failed to do an interval update DE: %s", err)
 			}
-		case <-de.assignEventCh:
-			de.cntUsed += 1
-			if de.cntUsed >= de.context.Config.MinDE {
-				de.updateDE(de.cntUsed)
-				de.cntUsed = 0
-			}
+		case ev := <-de.assignEventCh:
+			de.handleAssignedEvent(ev)
 		}
 	}
 }
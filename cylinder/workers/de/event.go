@@ -11,7 +11,8 @@ import (
 
 // PubDE represents the data structure for public D,E being retrieved from events.
 type PubDE struct {
-	PubDE types.DE
+	PubDE   types.DE
+	Address string // Address of the owner of the DE
 }
 
 // ParsePubDEFromEvents parses the events into PubDE struct from the given events and event type.
@@ -31,6 +32,11 @@ func ParsePubDEFromEvents(events sdk.StringEvents, eventType string) ([]PubDE, e
 		return nil, errors.New("length of public D and e are not equal")
 	}
 
+	addresses := event.GetEventValues(events, eventType, types.AttributeKeyAddress)
+	if len(addresses) != len(pubDs) {
+		return nil, errors.New("length of addresses and public D are not equal")
+	}
+
 	var pubDEs []PubDE
 	for i, pubD := range pubDs {
 		pubDEs = append(pubDEs, PubDE{
@@ -38,6 +44,7 @@ func ParsePubDEFromEvents(events sdk.StringEvents, eventType string) ([]PubDE, e
 				PubD: pubD,
 				PubE: pubEs[i],
 			},
+			Address: addresses[i],
 		})
 	}
 
@@ -43,6 +43,7 @@ func TestParseSubmitSignEvents(t *testing.T) {
 						PubD: []byte("pubD"),
 						PubE: []byte("pubE"),
 					},
+					Address: "member 1",
 				},
 			},
 			"",
@@ -78,12 +79,14 @@ func TestParseSubmitSignEvents(t *testing.T) {
 						PubD: []byte("pubD 1"),
 						PubE: []byte("pubE 1"),
 					},
+					Address: "member 1",
 				},
 				{
 					PubDE: types.DE{
 						PubD: []byte("pubD 2"),
 						PubE: []byte("pubE 2"),
 					},
+					Address: "member 1",
 				},
 			},
 			"",
@@ -116,12 +119,14 @@ func TestParseSubmitSignEvents(t *testing.T) {
 						PubD: []byte("pubD 1"),
 						PubE: []byte("pubE 1"),
 					},
+					Address: "member 1",
 				},
 				{
 					PubDE: types.DE{
 						PubD: []byte("pubD 2"),
 						PubE: []byte("pubE 2"),
 					},
+					Address: "member 1",
 				},
 			},
 			"",
@@ -174,12 +179,14 @@ func TestParseSubmitSignEvents(t *testing.T) {
 						PubD: []byte("pubD 1"),
 						PubE: []byte("pubE 1"),
 					},
+					Address: "member 1",
 				},
 				{
 					PubDE: types.DE{
 						PubD: []byte("pubD 2"),
 						PubE: []byte("pubE 2"),
 					},
+					Address: "member 1",
 				},
 			},
 			"",
@@ -0,0 +1,98 @@
+package de
+
+import (
+	"math"
+	"time"
+)
+
+// DemandTracker tracks the DEs of a granter assigned to signings within a sliding window.
+type DemandTracker struct {
+	window     time.Duration
+	assignedAt []time.Time // Times that a DE was assigned, ordered from the oldest
+}
+
+// NewDemandTracker creates a new instance of the DemandTracker with the given window.
+func NewDemandTracker(window time.Duration) *DemandTracker {
+	return &DemandTracker{
+		window: window,
+	}
+}
+
+// Record records n DEs assigned at the given time.
+func (t *DemandTracker) Record(now time.Time, n int) {
+	for i := 0; i < n; i++ {
+		t.assignedAt = append(t.assignedAt, now)
+	}
+}
+
+// Rate returns the number of DEs assigned per second within the window before the given time.
+func (t *DemandTracker) Rate(now time.Time) float64 {
+	if t.window <= 0 {
+		return 0
+	}
+
+	// Drop the assignments that are out of the window
+	cutoff := now.Add(-t.window)
+	i := 0
+	for i < len(t.assignedAt) && !t.assignedAt[i].After(cutoff) {
+		i++
+	}
+	t.assignedAt = t.assignedAt[i:]
+
+	return float64(len(t.assignedAt)) / t.window.Seconds()
+}
+
+// RefillPlan is the decision of how many DEs to submit for a granter.
+type RefillPlan struct {
+	Remaining uint64  // Number of DEs of the granter remaining on chain
+	Pending   uint64  // Number of pending signings of the granter
+	Rate      float64 // Number of DEs assigned per second recently
+	Target    uint64  // Number of DEs that should be on chain
+	Refill    uint64  // Number of DEs to submit
+}
+
+// PlanRefill decides how many DEs to submit so that the DEs on chain cover the forecasted demand over
+// the horizon. The forecasted demand is the recent rate of DE usage over the horizon, or the number of
+// pending signings if it is higher during a burst of signings. The target is the forecasted demand plus
+// minDE as a margin, bounded between 2*minDE and the smaller of maxDE and maxDESize of the chain,
+// where a zero maxDE means no bound. Above 2*minDE, DEs are only submitted in batches of at least minDE
+// to save transaction fees.
+func PlanRefill(
+	remaining uint64,
+	pending uint64,
+	rate float64,
+	horizon time.Duration,
+	minDE uint64,
+	maxDE uint64,
+	maxDESize uint64,
+) RefillPlan {
+	plan := RefillPlan{
+		Remaining: remaining,
+		Pending:   pending,
+		Rate:      rate,
+	}
+
+	demand := uint64(math.Ceil(rate * horizon.Seconds()))
+	if pending > demand {
+		demand = pending
+	}
+
+	upperBound := maxDESize
+	if maxDE != 0 && maxDE < upperBound {
+		upperBound = maxDE
+	}
+
+	plan.Target = min(max(demand+minDE, 2*minDE), upperBound)
+
+	if remaining >= plan.Target {
+		return plan
+	}
+
+	deficit := plan.Target - remaining
+	if remaining >= 2*minDE && deficit < minDE {
+		return plan
+	}
+
+	plan.Refill = deficit
+	return plan
+}
@@ -0,0 +1,59 @@
+package de_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/cylinder/workers/de"
+)
+
+func TestDemandTracker(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+	tracker := de.NewDemandTracker(10 * time.Minute)
+	require.Equal(t, float64(0), tracker.Rate(start))
+
+	tracker.Record(start, 30)
+	tracker.Record(start.Add(5*time.Minute), 30)
+	require.InDelta(t, 60.0/600, tracker.Rate(start.Add(5*time.Minute)), 1e-9)
+
+	// The first assignments are out of the window
+	require.InDelta(t, 30.0/600, tracker.Rate(start.Add(10*time.Minute)), 1e-9)
+	require.Equal(t, float64(0), tracker.Rate(start.Add(20*time.Minute)))
+
+	// A zero window disables the tracking
+	require.Equal(t, float64(0), de.NewDemandTracker(0).Rate(start))
+}
+
+func TestPlanRefill(t *testing.T) {
+	horizon := 10 * time.Minute
+
+	tests := []struct {
+		name      string
+		remaining uint64
+		pending   uint64
+		rate      float64
+		maxDE     uint64
+		maxDESize uint64
+		expTarget uint64
+		expRefill uint64
+	}{
+		{"quiet - refill to the floor", 3, 0, 0, 0, 300, 10, 7},
+		{"quiet - enough DEs", 10, 0, 0, 0, 300, 10, 0},
+		{"demand over the horizon", 10, 0, 0.1, 0, 300, 65, 55},
+		{"pending signings during a burst", 10, 40, 0.01, 0, 300, 45, 35},
+		{"small deficit above the floor", 62, 0, 0.1, 0, 300, 65, 0},
+		{"bounded by max DE", 10, 0, 1, 100, 300, 100, 90},
+		{"bounded by max DE size", 10, 0, 1, 0, 50, 50, 40},
+		{"max DE size below the floor", 2, 0, 0, 0, 8, 8, 6},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			plan := de.PlanRefill(test.remaining, test.pending, test.rate, horizon, 5, test.maxDE, test.maxDESize)
+			require.Equal(t, test.expTarget, plan.Target)
+			require.Equal(t, test.expRefill, plan.Refill)
+		})
+	}
+}
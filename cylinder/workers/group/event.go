@@ -8,9 +8,10 @@ import (
 	"github.com/bandprotocol/chain/v3/x/tss/types"
 )
 
-// Event represents the parsed information from a create_group event.
+// Event represents the parsed information from a group event.
 type Event struct {
-	GroupID tss.GroupID
+	GroupID   tss.GroupID
+	Addresses []string // Addresses of the members, only set in a create_group event
 }
 
 // ParseEvent parses the event from the given events.
@@ -22,6 +23,7 @@ func ParseEvent(events sdk.StringEvents, evType string) (*Event, error) {
 	}
 
 	return &Event{
-		GroupID: tss.GroupID(gid),
+		GroupID:   tss.GroupID(gid),
+		Addresses: event.GetEventValues(events, evType, types.AttributeKeyAddress),
 	}, nil
 }
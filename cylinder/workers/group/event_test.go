@@ -39,7 +39,8 @@ func TestParseEvent(t *testing.T) {
 			}),
 			types.EventTypeCreateGroup,
 			&group.Event{
-				GroupID: 1,
+				GroupID:   1,
+				Addresses: []string{"member 1", "member 2", "member 3"},
 			},
 			"",
 		},
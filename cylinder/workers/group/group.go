@@ -7,36 +7,38 @@ import (
 
 // Group is a worker responsible for group creation process of TSS module
 type Group struct {
-	context *context.Context
-	workers []cylinder.Worker
+	context  *context.Context
+	granters cylinder.Granters
+	workers  []cylinder.Worker
 }
 
 var _ cylinder.Worker = &Group{}
 
 // New creates a new instance of the Group worker.
 // It initializes the necessary components and returns the created Group instance or an error if initialization fails.
-func New(ctx *context.Context) (*Group, error) {
+func New(ctx *context.Context, granters cylinder.Granters) (*Group, error) {
 	return &Group{
-		context: ctx,
+		context:  ctx,
+		granters: granters,
 	}, nil
 }
 
 // Start starts the Group worker.
 // It start worker of each round of group creation process.
 func (g *Group) Start() {
-	round1, err := NewRound1(g.context)
+	round1, err := NewRound1(g.context, g.granters)
 	if err != nil {
 		g.context.ErrCh <- err
 		return
 	}
 
-	round2, err := NewRound2(g.context)
+	round2, err := NewRound2(g.context, g.granters)
 	if err != nil {
 		g.context.ErrCh <- err
 		return
 	}
 
-	round3, err := NewRound3(g.context)
+	round3, err := NewRound3(g.context, g.granters)
 	if err != nil {
 		g.context.ErrCh <- err
 		return
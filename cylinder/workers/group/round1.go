@@ -12,7 +12,7 @@ import (
 	"github.com/bandprotocol/chain/v3/cylinder"
 	"github.com/bandprotocol/chain/v3/cylinder/client"
 	"github.com/bandprotocol/chain/v3/cylinder/context"
-	"github.com/bandprotocol/chain/v3/cylinder/store"
+	"github.com/bandprotocol/chain/v3/cylinder/metrics"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 	"github.com/bandprotocol/chain/v3/pkg/tss"
 	"github.com/bandprotocol/chain/v3/x/tss/types"
@@ -20,17 +20,18 @@ import (
 
 // Round1 is a worker responsible for round1 in the DKG process of TSS module
 type Round1 struct {
-	context *context.Context
-	logger  *logger.Logger
-	client  *client.Client
-	eventCh <-chan ctypes.ResultEvent
+	context  *context.Context
+	granters cylinder.Granters
+	logger   *logger.Logger
+	client   *client.Client
+	eventCh  <-chan ctypes.ResultEvent
 }
 
 var _ cylinder.Worker = &Round1{}
 
 // NewRound1 creates a new instance of the Round1 worker.
 // It initializes the necessary components and returns the created Round1 instance or an error if initialization fails.
-func NewRound1(ctx *context.Context) (*Round1, error) {
+func NewRound1(ctx *context.Context, granters cylinder.Granters) (*Round1, error) {
 	// create http client
 	cli, err := client.New(ctx)
 	if err != nil {
@@ -38,9 +39,10 @@ func NewRound1(ctx *context.Context) (*Round1, error) {
 	}
 
 	return &Round1{
-		context: ctx,
-		logger:  ctx.Logger.With("worker", "Round1"),
-		client:  cli,
+		context:  ctx,
+		granters: granters,
+		logger:   ctx.Logger.With("worker", "Round1"),
+		client:   cli,
 	}, nil
 }
 
@@ -48,10 +50,9 @@ func NewRound1(ctx *context.Context) (*Round1, error) {
 // It returns an error if the subscription fails.
 func (r *Round1) subscribe() (err error) {
 	subscriptionQuery := fmt.Sprintf(
-		"tm.event = 'NewBlock' AND %s.%s = '%s'",
+		"tm.event = 'NewBlock' AND %s.%s EXISTS",
 		types.EventTypeCreateGroup,
 		types.AttributeKeyAddress,
-		r.context.Config.Granter,
 	)
 	r.eventCh, err = r.client.Subscribe("Round1", subscriptionQuery, 1000)
 	return
@@ -68,26 +69,32 @@ func (r *Round1) handleABCIEvents(abciEvents []abci.Event) {
 				return
 			}
 
-			go r.handleGroup(event.GroupID)
+			// Route the group to the granters that are members of it
+			granters := r.granters.Filter(event.Addresses)
+			if len(granters) > 0 {
+				go r.handleGroup(event.GroupID, granters)
+			}
 		}
 	}
 }
 
-// handlePendingGroups processes the pending groups.
+// handlePendingGroups processes the pending groups of every granter.
 func (r *Round1) handlePendingGroups() {
-	res, err := r.client.QueryPendingGroups(r.context.Config.Granter)
-	if err != nil {
-		r.logger.Error(":cold_sweat: Failed to get pending groups: %s", err)
-		return
-	}
+	for _, granter := range r.granters {
+		res, err := r.client.QueryPendingGroups(granter.Address)
+		if err != nil {
+			r.logger.Error(":cold_sweat: Failed to get pending groups of %s: %s", granter.Address, err)
+			continue
+		}
 
-	for _, gid := range res.PendingGroups {
-		go r.handleGroup(tss.GroupID(gid))
+		for _, gid := range res.PendingGroups {
+			go r.handleGroup(tss.GroupID(gid), cylinder.Granters{granter})
+		}
 	}
 }
 
-// handleGroup processes an incoming group.
-func (r *Round1) handleGroup(gid tss.GroupID) {
+// handleGroup processes an incoming group for the given granters.
+func (r *Round1) handleGroup(gid tss.GroupID, granters cylinder.Granters) {
 	logger := r.logger.With("gid", gid)
 
 	// Query group detail
@@ -101,8 +108,18 @@ func (r *Round1) handleGroup(gid tss.GroupID) {
 		return
 	}
 
+	for _, granter := range granters {
+		r.handleMember(groupRes, granter)
+	}
+}
+
+// handleMember submits the round1 information of the granter if it is a member of the group.
+func (r *Round1) handleMember(groupRes *client.GroupResult, granter cylinder.Granter) {
+	gid := groupRes.Group.ID
+	logger := r.logger.With("gid", gid, "granter", granter.Address)
+
 	// Check if the user is member in the group
-	mid, err := groupRes.GetMemberID(r.context.Config.Granter)
+	mid, err := groupRes.GetMemberID(granter.Address)
 	if err != nil {
 		return
 	}
@@ -110,38 +127,17 @@ func (r *Round1) handleGroup(gid tss.GroupID) {
 	// Log
 	logger.Info(":delivery_truck: Processing incoming group")
 
-	// Generate round1 data
-	data, err := tss.GenerateRound1Info(mid, groupRes.Group.Threshold, groupRes.DKGContext)
-	if err != nil {
-		logger.Error(":cold_sweat: Failed to generate round1 data with error: %s", err)
-		return
-	}
-
-	// Set group data
-	dkg := store.DKG{
-		GroupID:        gid,
-		MemberID:       mid,
-		Coefficients:   data.Coefficients,
-		OneTimePrivKey: data.OneTimePrivKey,
-	}
-	err = r.context.Store.SetDKG(dkg)
+	// Generate round1 information, the secrets are kept by the signer
+	round1Info, err := granter.Signer.GenerateRound1Info(gid, mid, groupRes.Group.Threshold, groupRes.DKGContext)
 	if err != nil {
-		logger.Error(":cold_sweat: Failed to set DKG with error: %s", err)
+		logger.Error(":cold_sweat: Failed to generate round1 information with error: %s", err)
+		metrics.IncDKGRound(granter.Address, gid, 1, metrics.DKGResultFailed)
 		return
 	}
+	metrics.IncDKGRound(granter.Address, gid, 1, metrics.DKGResultSubmitted)
 
 	// Generate message
-	msg := types.NewMsgSubmitDKGRound1(
-		gid,
-		types.Round1Info{
-			MemberID:           mid,
-			CoefficientCommits: data.CoefficientCommits,
-			OneTimePubKey:      data.OneTimePubKey,
-			A0Signature:        data.A0Signature,
-			OneTimeSignature:   data.OneTimeSignature,
-		},
-		r.context.Config.Granter,
-	)
+	msg := types.NewMsgSubmitDKGRound1(gid, round1Info, granter.Address)
 
 	// Send the message to the message channel
 	r.context.MsgCh <- msg
@@ -12,6 +12,7 @@ import (
 	"github.com/bandprotocol/chain/v3/cylinder"
 	"github.com/bandprotocol/chain/v3/cylinder/client"
 	"github.com/bandprotocol/chain/v3/cylinder/context"
+	"github.com/bandprotocol/chain/v3/cylinder/metrics"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 	"github.com/bandprotocol/chain/v3/pkg/tss"
 	"github.com/bandprotocol/chain/v3/x/tss/types"
@@ -19,26 +20,28 @@ import (
 
 // Round2 is a worker responsible for round2 in the DKG process of TSS module
 type Round2 struct {
-	context *context.Context
-	logger  *logger.Logger
-	client  *client.Client
-	eventCh <-chan ctypes.ResultEvent
+	context  *context.Context
+	granters cylinder.Granters
+	logger   *logger.Logger
+	client   *client.Client
+	eventCh  <-chan ctypes.ResultEvent
 }
 
 var _ cylinder.Worker = &Round2{}
 
 // NewRound2 creates a new instance of the Round2 worker.
 // It initializes the necessary components and returns the created Round2 instance or an error if initialization fails.
-func NewRound2(ctx *context.Context) (*Round2, error) {
+func NewRound2(ctx *context.Context, granters cylinder.Granters) (*Round2, error) {
 	cli, err := client.New(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Round2{
-		context: ctx,
-		logger:  ctx.Logger.With("worker", "Round2"),
-		client:  cli,
+		context:  ctx,
+		granters: granters,
+		logger:   ctx.Logger.With("worker", "Round2"),
+		client:   cli,
 	}, nil
 }
 
@@ -65,26 +68,28 @@ func (r *Round2) handleABCIEvents(abciEvents []abci.Event) {
 				return
 			}
 
-			go r.handleGroup(event.GroupID)
+			go r.handleGroup(event.GroupID, r.granters)
 		}
 	}
 }
 
-// handlePendingGroups processes the pending groups.
+// handlePendingGroups processes the pending groups of every granter.
 func (r *Round2) handlePendingGroups() {
-	res, err := r.client.QueryPendingGroups(r.context.Config.Granter)
-	if err != nil {
-		r.logger.Error(":cold_sweat: Failed to get pending groups: %s", err)
-		return
-	}
+	for _, granter := range r.granters {
+		res, err := r.client.QueryPendingGroups(granter.Address)
+		if err != nil {
+			r.logger.Error(":cold_sweat: Failed to get pending groups of %s: %s", granter.Address, err)
+			continue
+		}
 
-	for _, gid := range res.PendingGroups {
-		go r.handleGroup(tss.GroupID(gid))
+		for _, gid := range res.PendingGroups {
+			go r.handleGroup(tss.GroupID(gid), cylinder.Granters{granter})
+		}
 	}
 }
 
-// handleGroup processes an incoming group.
-func (r *Round2) handleGroup(gid tss.GroupID) {
+// handleGroup processes an incoming group for the given granters.
+func (r *Round2) handleGroup(gid tss.GroupID, granters cylinder.Granters) {
 	logger := r.logger.With("gid", gid)
 
 	// Query group detail
@@ -98,22 +103,24 @@ func (r *Round2) handleGroup(gid tss.GroupID) {
 		return
 	}
 
+	for _, granter := range granters {
+		r.handleMember(groupRes, granter)
+	}
+}
+
+// handleMember processes the group for the granter if it is a member of the group.
+func (r *Round2) handleMember(groupRes *client.GroupResult, granter cylinder.Granter) {
+	gid := groupRes.Group.ID
+	logger := r.logger.With("gid", gid, "granter", granter.Address)
+
 	// Check if the user is member in the group
-	isMember := groupRes.IsMember(r.context.Config.Granter)
-	if !isMember {
+	if !groupRes.IsMember(granter.Address) {
 		return
 	}
 
 	// Log
 	logger.Info(":delivery_truck: Processing incoming group")
 
-	// Get dkg data of the group
-	dkg, err := r.context.Store.GetDKG(gid)
-	if err != nil {
-		logger.Error(":cold_sweat: Failed to find group in store: %s", err)
-		return
-	}
-
 	// Get all one time public keys in the group
 	oneTimePubKeys := make(tss.Points, groupRes.Group.Size_)
 	for _, data := range groupRes.Round1Infos {
@@ -121,27 +128,16 @@ func (r *Round2) handleGroup(gid tss.GroupID) {
 	}
 
 	// Compute encrypted secret shares
-	encSecretShares, err := tss.ComputeEncryptedSecretShares(
-		dkg.MemberID,
-		dkg.OneTimePrivKey,
-		oneTimePubKeys,
-		dkg.Coefficients,
-		tss.DefaultNonce16Generator{},
-	)
+	round2Info, err := granter.Signer.ComputeRound2Info(gid, oneTimePubKeys)
 	if err != nil {
-		logger.Error(":cold_sweat: Failed to genrate encrypted secret shares: %s", err)
+		logger.Error(":cold_sweat: Failed to compute round2 information: %s", err)
+		metrics.IncDKGRound(granter.Address, gid, 2, metrics.DKGResultFailed)
 		return
 	}
+	metrics.IncDKGRound(granter.Address, gid, 2, metrics.DKGResultSubmitted)
 
 	// Generate message for round 2
-	msg := types.NewMsgSubmitDKGRound2(
-		gid,
-		types.Round2Info{
-			MemberID:              dkg.MemberID,
-			EncryptedSecretShares: encSecretShares,
-		},
-		r.context.Config.Granter,
-	)
+	msg := types.NewMsgSubmitDKGRound2(gid, round2Info, granter.Address)
 
 	r.context.MsgCh <- msg
 }
@@ -12,7 +12,7 @@ import (
 	"github.com/bandprotocol/chain/v3/cylinder"
 	"github.com/bandprotocol/chain/v3/cylinder/client"
 	"github.com/bandprotocol/chain/v3/cylinder/context"
-	"github.com/bandprotocol/chain/v3/cylinder/store"
+	"github.com/bandprotocol/chain/v3/cylinder/metrics"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 	"github.com/bandprotocol/chain/v3/pkg/tss"
 	"github.com/bandprotocol/chain/v3/x/tss/types"
@@ -20,26 +20,28 @@ import (
 
 // Round3 is a worker responsible for round3 in the DKG process of TSS module
 type Round3 struct {
-	context *context.Context
-	logger  *logger.Logger
-	client  *client.Client
-	eventCh <-chan ctypes.ResultEvent
+	context  *context.Context
+	granters cylinder.Granters
+	logger   *logger.Logger
+	client   *client.Client
+	eventCh  <-chan ctypes.ResultEvent
 }
 
 var _ cylinder.Worker = &Round3{}
 
 // NewRound3 creates a new instance of the Round3 worker.
 // It initializes the necessary components and returns the created Round3 instance or an error if initialization fails.
-func NewRound3(ctx *context.Context) (*Round3, error) {
+func NewRound3(ctx *context.Context, granters cylinder.Granters) (*Round3, error) {
 	cli, err := client.New(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Round3{
-		context: ctx,
-		logger:  ctx.Logger.With("worker", "Round3"),
-		client:  cli,
+		context:  ctx,
+		granters: granters,
+		logger:   ctx.Logger.With("worker", "Round3"),
+		client:   cli,
 	}, nil
 }
 
@@ -66,26 +68,28 @@ func (r *Round3) handleABCIEvents(abciEvents []abci.Event) {
 				return
 			}
 
-			go r.handleGroup(event.GroupID)
+			go r.handleGroup(event.GroupID, r.granters)
 		}
 	}
 }
 
-// handlePendingGroups processes the pending groups.
+// handlePendingGroups processes the pending groups of every granter.
 func (r *Round3) handlePendingGroups() {
-	res, err := r.client.QueryPendingGroups(r.context.Config.Granter)
-	if err != nil {
-		r.logger.Error(":cold_sweat: Failed to get pending groups: %s", err)
-		return
-	}
+	for _, granter := range r.granters {
+		res, err := r.client.QueryPendingGroups(granter.Address)
+		if err != nil {
+			r.logger.Error(":cold_sweat: Failed to get pending groups of %s: %s", granter.Address, err)
+			continue
+		}
 
-	for _, gid := range res.PendingGroups {
-		go r.handleGroup(tss.GroupID(gid))
+		for _, gid := range res.PendingGroups {
+			go r.handleGroup(tss.GroupID(gid), cylinder.Granters{granter})
+		}
 	}
 }
 
-// handleGroup processes an incoming group.
-func (r *Round3) handleGroup(gid tss.GroupID) {
+// handleGroup processes an incoming group for the given granters.
+func (r *Round3) handleGroup(gid tss.GroupID, granters cylinder.Granters) {
 	logger := r.logger.With("gid", gid)
 
 	// Query group detail
@@ -99,71 +103,42 @@ func (r *Round3) handleGroup(gid tss.GroupID) {
 		return
 	}
 
+	for _, granter := range granters {
+		r.handleMember(groupRes, granter)
+	}
+}
+
+// handleMember processes the group for the granter if it is a member of the group.
+func (r *Round3) handleMember(groupRes *client.GroupResult, granter cylinder.Granter) {
+	gid := groupRes.Group.ID
+	logger := r.logger.With("gid", gid, "granter", granter.Address)
+
 	// Check if the user is member in the group
-	if !groupRes.IsMember(r.context.Config.Granter) {
+	if !groupRes.IsMember(granter.Address) {
 		return
 	}
 
 	// Log
 	logger.Info(":delivery_truck: Processing incoming group")
 
-	group, err := r.context.Store.GetGroup(groupRes.Group.PubKey)
+	// Compute own private key and sign own public key, or get complaints
+	result, err := granter.Signer.ComputeRound3(groupRes)
 	if err != nil {
-		// Set DKG data
-		dkg, err := r.context.Store.GetDKG(gid)
-		if err != nil {
-			logger.Error(":cold_sweat: Failed to find group in store: %s", err)
-			return
-		}
-
-		// Get own private key
-		ownPrivKey, complaints, err := getOwnPrivKey(dkg, groupRes)
-		if err != nil {
-			logger.Error(":cold_sweat: Failed to get own private key or complaints: %s", err)
-			return
-		}
-
-		// If there is any complaint, send MsgComplain
-		if len(complaints) > 0 {
-			// Send message complaints
-			r.context.MsgCh <- types.NewMsgComplain(gid, complaints, r.context.Config.Granter)
-			return
-		}
-
-		// Generate own private key and update it in store
-		group = store.Group{
-			GroupPubKey: groupRes.Group.PubKey,
-			MemberID:    dkg.MemberID,
-			PrivKey:     ownPrivKey,
-		}
-
-		err = r.context.Store.SetGroup(group)
-		if err != nil {
-			logger.Error(":cold_sweat: Failed to set group with error: %s", err)
-			return
-		}
-
-		err = r.context.Store.DeleteDKG(gid)
-		if err != nil {
-			logger.Error(":cold_sweat: Failed to delete DKG with error: %s", err)
-			return
-		}
+		logger.Error(":cold_sweat: Failed to compute round3 result: %s", err)
+		metrics.IncDKGRound(granter.Address, gid, 3, metrics.DKGResultFailed)
+		return
 	}
 
-	// Sign own public key
-	ownPubKeySig, err := tss.SignOwnPubKey(
-		group.MemberID,
-		groupRes.DKGContext,
-		group.PrivKey.Point(),
-		group.PrivKey,
-	)
-	if err != nil {
-		logger.Error(":cold_sweat: Failed to sign own public key: %s", err)
+	// If there is any complaint, send MsgComplain
+	if len(result.Complaints) > 0 {
+		metrics.IncDKGRound(granter.Address, gid, 3, metrics.DKGResultComplained)
+		r.context.MsgCh <- types.NewMsgComplain(gid, result.Complaints, granter.Address)
 		return
 	}
 
 	// Send MsgConfirm
-	r.context.MsgCh <- types.NewMsgConfirm(gid, group.MemberID, ownPubKeySig, r.context.Config.Granter)
+	metrics.IncDKGRound(granter.Address, gid, 3, metrics.DKGResultSubmitted)
+	r.context.MsgCh <- types.NewMsgConfirm(gid, result.MemberID, result.OwnPubKeySig, granter.Address)
 }
 
 // Start starts the Round3 worker.
@@ -7,6 +7,7 @@ import (
 	"github.com/bandprotocol/chain/v3/cylinder"
 	"github.com/bandprotocol/chain/v3/cylinder/client"
 	"github.com/bandprotocol/chain/v3/cylinder/context"
+	"github.com/bandprotocol/chain/v3/cylinder/metrics"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 )
 
@@ -85,12 +86,16 @@ func (s *Sender) sendMsgs(key *keyring.Record, msgs []sdk.Msg) {
 	res, err := s.client.BroadcastAndConfirm(logger, key, msgs)
 	if err != nil {
 		logger.Error(":anxious_face_with_sweat: Cannot send messages with error: %s", err)
+		metrics.IncTx(metrics.TxResultError, "", 0)
 		return
 	} else if res.Code != 0 {
 		logger.Error(":anxious_face_with_sweat: Cannot send messages with error code: codespace: %s, code: %d", res.Codespace, res.Code)
+		metrics.IncTx(metrics.TxResultFailure, res.Codespace, res.Code)
 		return
 	}
 
+	metrics.IncTx(metrics.TxResultSuccess, "", 0)
+
 	logger.Info(":smiling_face_with_sunglasses: Successfully broadcast tx with hash: %s", res.TxHash)
 }
 
@@ -0,0 +1,117 @@
+package signing
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
+	tunneltypes "github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// Content types that can be listed in the signing policy.
+const (
+	ContentTypeText    = "text"
+	ContentTypeFeeds   = "feeds"
+	ContentTypeTunnel  = "tunnel"
+	ContentTypeOracle  = "oracle"
+	ContentTypeBandtss = "bandtss"
+)
+
+// Originator types that can be listed in the signing policy, either alone to match every
+// originator of the type or followed by ":" and an identifier to match a specific originator.
+const (
+	OriginatorTypeDirect = "direct"
+	OriginatorTypeTunnel = "tunnel"
+)
+
+// Policy is the operator's allow and deny lists of content types and originators. A signing is
+// refused if it matches a deny list or if an allow list is set and the signing does not match it.
+type Policy struct {
+	AllowedContentTypes []string
+	DeniedContentTypes  []string
+	AllowedOriginators  []string
+	DeniedOriginators   []string
+}
+
+// NewPolicy creates a new signing policy.
+func NewPolicy(
+	allowedContentTypes []string,
+	deniedContentTypes []string,
+	allowedOriginators []string,
+	deniedOriginators []string,
+) Policy {
+	return Policy{
+		AllowedContentTypes: allowedContentTypes,
+		DeniedContentTypes:  deniedContentTypes,
+		AllowedOriginators:  allowedOriginators,
+		DeniedOriginators:   deniedOriginators,
+	}
+}
+
+// Check returns an error if the policy refuses to sign the given content from the given originator.
+func (p Policy) Check(content tsstypes.Content, originator tsstypes.Originator) error {
+	contentType, err := ContentTypeOf(content)
+	if err != nil {
+		return err
+	}
+	originatorID, err := OriginatorIDOf(originator)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(p.DeniedContentTypes, contentType) {
+		return fmt.Errorf("content type %s is denied", contentType)
+	}
+	if len(p.AllowedContentTypes) > 0 && !slices.Contains(p.AllowedContentTypes, contentType) {
+		return fmt.Errorf("content type %s is not allowed", contentType)
+	}
+	if matchOriginator(p.DeniedOriginators, originatorID) {
+		return fmt.Errorf("originator %s is denied", originatorID)
+	}
+	if len(p.AllowedOriginators) > 0 && !matchOriginator(p.AllowedOriginators, originatorID) {
+		return fmt.Errorf("originator %s is not allowed", originatorID)
+	}
+
+	return nil
+}
+
+// matchOriginator checks if the originator ID or its type is in the given list.
+func matchOriginator(list []string, originatorID string) bool {
+	originatorType, _, _ := strings.Cut(originatorID, ":")
+	return slices.Contains(list, originatorID) || slices.Contains(list, originatorType)
+}
+
+// ContentTypeOf returns the policy content type of the given content.
+func ContentTypeOf(content tsstypes.Content) (string, error) {
+	switch content.(type) {
+	case *tsstypes.TextSignatureOrder:
+		return ContentTypeText, nil
+	case *feedstypes.FeedsSignatureOrder:
+		return ContentTypeFeeds, nil
+	case *tunneltypes.TunnelSignatureOrder:
+		return ContentTypeTunnel, nil
+	case *oracletypes.OracleResultSignatureOrder:
+		return ContentTypeOracle, nil
+	case *bandtsstypes.GroupTransitionSignatureOrder:
+		return ContentTypeBandtss, nil
+	default:
+		return "", fmt.Errorf("unsupported content type: %T", content)
+	}
+}
+
+// OriginatorIDOf returns the policy identifier of the given originator, which is "direct:<requester>"
+// for a direct originator and "tunnel:<tunnel_id>" for a tunnel originator.
+func OriginatorIDOf(originator tsstypes.Originator) (string, error) {
+	switch o := originator.(type) {
+	case *tsstypes.DirectOriginator:
+		return fmt.Sprintf("%s:%s", OriginatorTypeDirect, o.Requester), nil
+	case *tsstypes.TunnelOriginator:
+		return fmt.Sprintf("%s:%d", OriginatorTypeTunnel, o.TunnelID), nil
+	default:
+		return "", fmt.Errorf("unsupported originator type: %T", originator)
+	}
+}
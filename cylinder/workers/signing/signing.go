@@ -12,6 +12,9 @@ import (
 	"github.com/bandprotocol/chain/v3/cylinder"
 	"github.com/bandprotocol/chain/v3/cylinder/client"
 	"github.com/bandprotocol/chain/v3/cylinder/context"
+	"github.com/bandprotocol/chain/v3/cylinder/metrics"
+	"github.com/bandprotocol/chain/v3/cylinder/signer"
+	"github.com/bandprotocol/chain/v3/pkg/event"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 	"github.com/bandprotocol/chain/v3/pkg/tss"
 	"github.com/bandprotocol/chain/v3/x/tss/types"
@@ -19,37 +22,73 @@ import (
 
 // Signing is a worker responsible for the signing process of the TSS module.
 type Signing struct {
-	context *context.Context
-	logger  *logger.Logger
-	client  *client.Client
-	eventCh <-chan ctypes.ResultEvent
+	context  *context.Context
+	granters cylinder.Granters
+	logger   *logger.Logger
+	client   *client.Client
+	eventCh  <-chan ctypes.ResultEvent
+
+	// verifier checks messages before signing, it is nil if verification is skipped.
+	verifier       *Verifier
+	verifierClient *client.Client
 }
 
 var _ cylinder.Worker = &Signing{}
 
 // New creates a new instance of the Signing worker.
 // It initializes the necessary components and returns the created Signing instance or an error if initialization fails.
-func New(ctx *context.Context) (*Signing, error) {
+func New(ctx *context.Context, granters cylinder.Granters) (*Signing, error) {
 	cli, err := client.New(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Signing{
-		context: ctx,
-		logger:  ctx.Logger.With("worker", "Signing"),
-		client:  cli,
-	}, nil
+	signing := &Signing{
+		context:  ctx,
+		granters: granters,
+		logger:   ctx.Logger.With("worker", "Signing"),
+		client:   cli,
+	}
+
+	cfg := ctx.Config
+	if cfg.SkipSigningVerification {
+		signing.logger.Info(":warning: Signing verification is skipped")
+		return signing, nil
+	}
+
+	// Verify messages with a second node if given, otherwise with the same node
+	verifierCli := cli
+	if cfg.VerifierNodeURI != "" && cfg.VerifierNodeURI != cfg.NodeURI {
+		verifierCli, err = client.NewWithNode(ctx, cfg.VerifierNodeURI)
+		if err != nil {
+			return nil, err
+		}
+		signing.verifierClient = verifierCli
+	} else {
+		signing.logger.Warn(
+			":warning: No verifier node is set, signing messages are verified against the same node " +
+				"that requests them, so a compromised node is not detected. Set verifier-node to a trusted node",
+		)
+	}
+
+	policy := NewPolicy(
+		cfg.AllowedContentTypes,
+		cfg.DeniedContentTypes,
+		cfg.AllowedOriginators,
+		cfg.DeniedOriginators,
+	)
+	signing.verifier = NewVerifier(verifierCli, ctx.Cdc, policy)
+
+	return signing, nil
 }
 
 // subscribe subscribes to the request_sign events and initializes the event channel for receiving events.
 // It returns an error if the subscription fails.
 func (s *Signing) subscribe() (err error) {
 	subscriptionQuery := fmt.Sprintf(
-		"%s.%s = '%s'",
+		"%s.%s EXISTS",
 		types.EventTypeRequestSignature,
 		types.AttributeKeyAddress,
-		s.context.Config.Granter,
 	)
 	s.eventCh, err = s.client.Subscribe("Signing", subscriptionQuery, 1000)
 	return
@@ -66,92 +105,108 @@ func (s *Signing) handleABCIEvents(abciEvents []abci.Event) {
 				return
 			}
 
-			for _, event := range events {
-				go s.handleSigning(event.SigningID)
+			// Route the signings to the granters assigned to them
+			addresses := event.GetEventValues(sdk.StringEvents{ev}, ev.Type, types.AttributeKeyAddress)
+			granters := s.granters.Filter(addresses)
+			if len(granters) == 0 {
+				continue
+			}
+
+			for _, signingEvent := range events {
+				go s.handleSigning(signingEvent.SigningID, granters)
 			}
 		}
 	}
 }
 
-// handleSigning processes an incoming signing request.
-func (s *Signing) handleSigning(sid tss.SigningID) {
+// handleSigning processes an incoming signing request for the given granters.
+func (s *Signing) handleSigning(sid tss.SigningID, granters cylinder.Granters) {
 	logger := s.logger.With("sid", sid)
 
 	// Query signing detail
 	signingRes, err := s.client.QuerySigning(sid)
 	if err != nil {
 		logger.Error(":cold_sweat: Failed to query signing information: %s", err)
+		for _, granter := range granters {
+			metrics.IncSigning(granter.Address, metrics.SigningResultFailed)
+		}
 		return
 	}
 
-	signing := signingRes.SigningResult.Signing
-	assignedMember, err := signingRes.GetAssignedMember(s.context.Config.Granter)
-	if err != nil {
+	// Find the granters that are assigned to the signing
+	var assignedGranters cylinder.Granters
+	for _, granter := range granters {
+		if _, err := signingRes.GetAssignedMember(granter.Address); err == nil {
+			assignedGranters = append(assignedGranters, granter)
+		}
+	}
+	if len(assignedGranters) == 0 {
 		return
 	}
 
 	// Log
 	logger.Info(":delivery_truck: Processing incoming signing request")
 
-	// Set group data
-	group, err := s.context.Store.GetGroup(signing.GroupPubKey)
-	if err != nil {
-		logger.Error(":cold_sweat: Failed to find group in store: %s", err)
-		return
+	// Verify the message before signing
+	signing := signingRes.SigningResult.Signing
+	if s.verifier != nil {
+		if err := s.verifier.Verify(signing); err != nil {
+			logger.Error(":no_entry: Refused to sign the message: %s", err)
+			for _, granter := range assignedGranters {
+				metrics.IncSigning(granter.Address, metrics.SigningResultRefused)
+			}
+			return
+		}
 	}
 
-	// Get private keys of DE
-	privDE, err := s.context.Store.GetDE(types.DE{
-		PubD: assignedMember.PubD,
-		PubE: assignedMember.PubE,
-	})
-	if err != nil {
-		logger.Error(":cold_sweat: Failed to get private DE from store: %s", err)
-		return
+	for _, granter := range assignedGranters {
+		s.sign(signingRes, granter)
 	}
+}
 
-	// Compute own private nonce
-	privNonce, err := tss.ComputeOwnPrivNonce(privDE.PrivD, privDE.PrivE, assignedMember.BindingFactor)
-	if err != nil {
-		logger.Error(":cold_sweat: Failed to compute own private nonce: %s", err)
-		return
-	}
+// sign signs the signing with the share of the granter kept by its signer.
+func (s *Signing) sign(signingRes *client.SigningResponse, granter cylinder.Granter) {
+	signing := signingRes.SigningResult.Signing
+	logger := s.logger.With("sid", signing.ID, "granter", granter.Address)
 
-	// Compute lagrange
-	lagrange, err := tss.ComputeLagrangeCoefficient(group.MemberID, signingRes.GetMemberIDs())
+	assignedMember, err := signingRes.GetAssignedMember(granter.Address)
 	if err != nil {
-		logger.Error(":cold_sweat: Failed to compute lagrange coefficient: %s", err)
 		return
 	}
 
-	// Sign the signing
-	sig, err := tss.SignSigning(
-		signing.GroupPubNonce,
-		signing.GroupPubKey,
-		signing.Message,
-		lagrange,
-		privNonce,
-		group.PrivKey,
-	)
+	result, err := granter.Signer.Sign(signer.SignRequest{
+		GroupPubKey:   signing.GroupPubKey,
+		GroupPubNonce: signing.GroupPubNonce,
+		SigningID:     signing.ID,
+		Message:       signing.Message,
+		PubDE:         types.DE{PubD: assignedMember.PubD, PubE: assignedMember.PubE},
+		BindingFactor: assignedMember.BindingFactor,
+		MemberIDs:     signingRes.GetMemberIDs(),
+	})
 	if err != nil {
 		logger.Error(":cold_sweat: Failed to sign signing: %s", err)
+		metrics.IncSigning(granter.Address, metrics.SigningResultFailed)
 		return
 	}
 
+	metrics.IncSigning(granter.Address, metrics.SigningResultSigned)
+
 	// Send MsgSigning
-	s.context.MsgCh <- types.NewMsgSubmitSignature(sid, group.MemberID, sig, s.context.Config.Granter)
+	s.context.MsgCh <- types.NewMsgSubmitSignature(signing.ID, result.MemberID, result.Signature, granter.Address)
 }
 
-// handlePendingSignings processes the pending signing requests.
+// handlePendingSignings processes the pending signing requests of every granter.
 func (s *Signing) handlePendingSignings() {
-	res, err := s.client.QueryPendingSignings(s.context.Config.Granter)
-	if err != nil {
-		s.logger.Error(":cold_sweat: Failed to get pending signings: %s", err)
-		return
-	}
+	for _, granter := range s.granters {
+		res, err := s.client.QueryPendingSignings(granter.Address)
+		if err != nil {
+			s.logger.Error(":cold_sweat: Failed to get pending signings of %s: %s", granter.Address, err)
+			continue
+		}
 
-	for _, sid := range res.PendingSignings {
-		go s.handleSigning(sid)
+		for _, sid := range res.PendingSignings {
+			go s.handleSigning(sid, cylinder.Granters{granter})
+		}
 	}
 }
 
@@ -181,5 +236,10 @@ func (s *Signing) Start() {
 // Stop stops the Signing worker.
 func (s *Signing) Stop() error {
 	s.logger.Info("stop")
+	if s.verifierClient != nil {
+		if err := s.verifierClient.Stop(); err != nil {
+			return err
+		}
+	}
 	return s.client.Stop()
 }
@@ -0,0 +1,283 @@
+package signing
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/cylinder/client"
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/x/bandtss"
+	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	"github.com/bandprotocol/chain/v3/x/oracle"
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+	tssmodule "github.com/bandprotocol/chain/v3/x/tss"
+	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
+	tunneltypes "github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// verifierClient is the client of the node used to independently verify signing messages.
+type verifierClient interface {
+	QuerySigning(signingID tss.SigningID) (*client.SigningResponse, error)
+	QueryBlockResults(height int64) (*ctypes.ResultBlockResults, error)
+	QueryOracleResult(requestID oracletypes.RequestID) (oracletypes.Result, error)
+	QueryPrices(signalIDs []string, height int64) ([]feedstypes.Price, error)
+	QueryTunnelPacket(tunnelID uint64, sequence uint64) (*tunneltypes.Packet, error)
+	QueryGroupTransition(height int64) (*bandtsstypes.GroupTransition, error)
+}
+
+// Verifier checks a signing message against the signing policy and recomputes the expected
+// message from the state of the verifier node before the message is signed.
+type Verifier struct {
+	client verifierClient
+	cdc    codec.Codec
+	policy Policy
+}
+
+// NewVerifier creates a new instance of the Verifier.
+func NewVerifier(
+	client verifierClient,
+	cdc codec.Codec,
+	policy Policy,
+) *Verifier {
+	return &Verifier{
+		client: client,
+		cdc:    cdc,
+		policy: policy,
+	}
+}
+
+// Verify returns an error if the message of the given signing should not be signed.
+func (v *Verifier) Verify(signing tsstypes.Signing) error {
+	res, err := v.client.QuerySigning(signing.ID)
+	if err != nil {
+		return fmt.Errorf("failed to query signing from verifier node: %w", err)
+	}
+
+	verified := res.SigningResult.Signing
+	if !bytes.Equal(verified.Message, signing.Message) {
+		return fmt.Errorf("message differs from verifier node")
+	}
+
+	content, originator, err := v.querySigningOrder(verified)
+	if err != nil {
+		return err
+	}
+
+	if err := v.policy.Check(content, originator); err != nil {
+		return err
+	}
+
+	expected, err := v.expectedMessage(verified, content, originator)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(expected, signing.Message) {
+		return fmt.Errorf("message differs from the expected message %X", expected)
+	}
+
+	return nil
+}
+
+// VerifyMessage returns an error if the given message should not be signed for the signing of the given ID.
+func (v *Verifier) VerifyMessage(signingID tss.SigningID, message []byte) error {
+	return v.Verify(tsstypes.Signing{ID: signingID, Message: message})
+}
+
+// querySigningOrder returns the content and originator of the signing from the event emitted
+// when the signing was created.
+func (v *Verifier) querySigningOrder(signing tsstypes.Signing) (tsstypes.Content, tsstypes.Originator, error) {
+	results, err := v.client.QueryBlockResults(int64(signing.CreatedHeight))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query block results: %w", err)
+	}
+
+	events := results.FinalizeBlockEvents
+	for _, txResult := range results.TxsResults {
+		events = append(events, txResult.Events...)
+	}
+
+	attrs, ok := findCreateSigningEvent(events, signing.ID)
+	if !ok {
+		return nil, nil, fmt.Errorf("create signing event not found at height %d", signing.CreatedHeight)
+	}
+
+	contentMsg, err := unmarshalText(attrs[tsstypes.AttributeKeyContentType], attrs[tsstypes.AttributeKeyContent])
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode content: %w", err)
+	}
+	content, ok := contentMsg.(tsstypes.Content)
+	if !ok {
+		return nil, nil, fmt.Errorf("%T is not a content", contentMsg)
+	}
+
+	originatorMsg, err := unmarshalText(
+		attrs[tsstypes.AttributeKeyOriginatorType],
+		attrs[tsstypes.AttributeKeyOriginator],
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode originator: %w", err)
+	}
+	originator, ok := originatorMsg.(tsstypes.Originator)
+	if !ok {
+		return nil, nil, fmt.Errorf("%T is not an originator", originatorMsg)
+	}
+
+	return content, originator, nil
+}
+
+// unmarshalText decodes the proto text into a new message of the given type URL. Internal contents
+// are not registered in the interface registry, so the type is looked up from the proto registry.
+func unmarshalText(typeURL string, text string) (proto.Message, error) {
+	typ := proto.MessageType(strings.TrimPrefix(typeURL, "/"))
+	if typ == nil {
+		return nil, fmt.Errorf("unknown type: %s", typeURL)
+	}
+
+	msg, ok := reflect.New(typ.Elem()).Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a proto message", typeURL)
+	}
+	if err := proto.UnmarshalText(text, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// findCreateSigningEvent returns the attributes of the create signing event of the given signing ID.
+func findCreateSigningEvent(events []abci.Event, signingID tss.SigningID) (map[string]string, bool) {
+	for _, event := range events {
+		if event.Type != tsstypes.EventTypeCreateSigning {
+			continue
+		}
+
+		attrs := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		if attrs[tsstypes.AttributeKeySigningID] == fmt.Sprintf("%d", signingID) {
+			return attrs, true
+		}
+	}
+
+	return nil, false
+}
+
+// expectedMessage recomputes the signing message in the same way as the tss module does.
+func (v *Verifier) expectedMessage(
+	signing tsstypes.Signing,
+	content tsstypes.Content,
+	originator tsstypes.Originator,
+) ([]byte, error) {
+	originatorBz, err := originator.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	contentMsg, err := v.encodeContent(signing, content, originator)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.Join([][]byte{
+		tss.Hash(originatorBz),
+		sdk.Uint64ToBigEndian(uint64(signing.CreatedTimestamp.Unix())),
+		sdk.Uint64ToBigEndian(uint64(signing.ID)),
+		contentMsg,
+	}, []byte("")), nil
+}
+
+// encodeContent recomputes the content message from the state of the verifier node in the same
+// way as the signature order handler of each module does.
+func (v *Verifier) encodeContent(
+	signing tsstypes.Signing,
+	content tsstypes.Content,
+	originator tsstypes.Originator,
+) ([]byte, error) {
+	switch c := content.(type) {
+	case *tsstypes.TextSignatureOrder:
+		return append([]byte(tssmodule.TextMsgPrefix), c.Message...), nil
+
+	case *feedstypes.FeedsSignatureOrder:
+		// Feeds signatures are requested by transactions, which see the prices of the previous block
+		prices, err := v.client.QueryPrices(c.SignalIDs, int64(signing.CreatedHeight)-1)
+		if err != nil {
+			return nil, err
+		}
+
+		return feedstypes.EncodeTSS(prices, signing.CreatedTimestamp.Unix(), c.Encoder)
+
+	case *tunneltypes.TunnelSignatureOrder:
+		tunnelOriginator, ok := originator.(*tsstypes.TunnelOriginator)
+		if !ok {
+			return nil, fmt.Errorf("tunnel content from non-tunnel originator %T", originator)
+		}
+
+		packet, err := v.client.QueryTunnelPacket(tunnelOriginator.TunnelID, c.Sequence)
+		if err != nil {
+			return nil, err
+		}
+
+		return tunneltypes.EncodeTSS(packet.Sequence, packet.Prices, packet.CreatedAt, c.Encoder)
+
+	case *oracletypes.OracleResultSignatureOrder:
+		result, err := v.client.QueryOracleResult(c.RequestID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch c.Encoder {
+		case oracletypes.ENCODER_PROTO:
+			bz, err := v.cdc.Marshal(&result)
+			if err != nil {
+				return nil, err
+			}
+			return append([]byte(oracle.EncoderProtoPrefix), bz...), nil
+		case oracletypes.ENCODER_FULL_ABI:
+			bz, err := result.PackFullABI()
+			if err != nil {
+				return nil, err
+			}
+			return append([]byte(oracle.EncoderFullABIPrefix), bz...), nil
+		case oracletypes.ENCODER_PARTIAL_ABI:
+			bz, err := result.PackPartialABI()
+			if err != nil {
+				return nil, err
+			}
+			return append([]byte(oracle.EncoderPartialABIPrefix), bz...), nil
+		default:
+			return nil, fmt.Errorf("unsupported oracle encoder: %d", c.Encoder)
+		}
+
+	case *bandtsstypes.GroupTransitionSignatureOrder:
+		// The content is recomputed from the transition in the bandtss state of the verifier node
+		// instead of the public key and transition time in the event.
+		transition, err := v.client.QueryGroupTransition(int64(signing.CreatedHeight))
+		if err != nil {
+			return nil, err
+		}
+		if transition.SigningID != signing.ID {
+			return nil, fmt.Errorf("signing %d is not the signing of the group transition", signing.ID)
+		}
+
+		return bytes.Join([][]byte{
+			[]byte(bandtss.GroupTransitionMsgPrefix),
+			transition.IncomingGroupPubKey,
+			sdk.Uint64ToBigEndian(uint64(transition.ExecTime.Unix())),
+		}, []byte("")), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported content type: %T", content)
+	}
+}
@@ -0,0 +1,291 @@
+package signing_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/cylinder/client"
+	"github.com/bandprotocol/chain/v3/cylinder/workers/signing"
+	"github.com/bandprotocol/chain/v3/pkg/tss"
+	"github.com/bandprotocol/chain/v3/x/bandtss"
+	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	"github.com/bandprotocol/chain/v3/x/oracle"
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+	tssmodule "github.com/bandprotocol/chain/v3/x/tss"
+	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
+	tunneltypes "github.com/bandprotocol/chain/v3/x/tunnel/types"
+)
+
+// mockVerifierClient serves the state of the verifier node from memory.
+type mockVerifierClient struct {
+	signings     map[tss.SigningID]tsstypes.Signing
+	blockResults map[int64]*ctypes.ResultBlockResults
+	results      map[oracletypes.RequestID]oracletypes.Result
+	transitions  map[int64]bandtsstypes.GroupTransition
+}
+
+func newMockVerifierClient() *mockVerifierClient {
+	return &mockVerifierClient{
+		signings:     make(map[tss.SigningID]tsstypes.Signing),
+		blockResults: make(map[int64]*ctypes.ResultBlockResults),
+		results:      make(map[oracletypes.RequestID]oracletypes.Result),
+		transitions:  make(map[int64]bandtsstypes.GroupTransition),
+	}
+}
+
+// addSigning adds a signing created at the given height with its create signing event.
+func (c *mockVerifierClient) addSigning(
+	signing tsstypes.Signing,
+	content tsstypes.Content,
+	originator tsstypes.Originator,
+) {
+	c.signings[signing.ID] = signing
+	c.blockResults[int64(signing.CreatedHeight)] = &ctypes.ResultBlockResults{
+		FinalizeBlockEvents: []abci.Event{abci.Event(sdk.NewEvent(
+			tsstypes.EventTypeCreateSigning,
+			sdk.NewAttribute(tsstypes.AttributeKeySigningID, fmt.Sprintf("%d", signing.ID)),
+			sdk.NewAttribute(tsstypes.AttributeKeyContentType, sdk.MsgTypeURL(content)),
+			sdk.NewAttribute(tsstypes.AttributeKeyContent, content.String()),
+			sdk.NewAttribute(tsstypes.AttributeKeyOriginatorType, sdk.MsgTypeURL(originator)),
+			sdk.NewAttribute(tsstypes.AttributeKeyOriginator, originator.String()),
+		))},
+	}
+}
+
+func (c *mockVerifierClient) QuerySigning(signingID tss.SigningID) (*client.SigningResponse, error) {
+	signing, ok := c.signings[signingID]
+	if !ok {
+		return nil, fmt.Errorf("signing not found")
+	}
+	return client.NewSigningResponse(&tsstypes.QuerySigningResponse{
+		SigningResult: tsstypes.SigningResult{Signing: signing},
+	}), nil
+}
+
+func (c *mockVerifierClient) QueryBlockResults(height int64) (*ctypes.ResultBlockResults, error) {
+	results, ok := c.blockResults[height]
+	if !ok {
+		return &ctypes.ResultBlockResults{Height: height}, nil
+	}
+	return results, nil
+}
+
+func (c *mockVerifierClient) QueryOracleResult(requestID oracletypes.RequestID) (oracletypes.Result, error) {
+	result, ok := c.results[requestID]
+	if !ok {
+		return oracletypes.Result{}, fmt.Errorf("result not found")
+	}
+	return result, nil
+}
+
+func (c *mockVerifierClient) QueryPrices(_ []string, _ int64) ([]feedstypes.Price, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (c *mockVerifierClient) QueryTunnelPacket(_ uint64, _ uint64) (*tunneltypes.Packet, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (c *mockVerifierClient) QueryGroupTransition(height int64) (*bandtsstypes.GroupTransition, error) {
+	transition, ok := c.transitions[height]
+	if !ok {
+		return nil, fmt.Errorf("no group transition at height %d", height)
+	}
+	return &transition, nil
+}
+
+func encodeSigning(
+	t *testing.T,
+	id tss.SigningID,
+	createdAt time.Time,
+	originator tsstypes.Originator,
+	contentMsg []byte,
+) []byte {
+	originatorBz, err := originator.Encode()
+	require.NoError(t, err)
+
+	return bytes.Join([][]byte{
+		tss.Hash(originatorBz),
+		sdk.Uint64ToBigEndian(uint64(createdAt.Unix())),
+		sdk.Uint64ToBigEndian(uint64(id)),
+		contentMsg,
+	}, []byte(""))
+}
+
+func TestVerifier(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	createdAt := time.Unix(1700000000, 0).UTC()
+	requester := "band1p40yh3zkmhcv0ecqp3mcazy83sa57rgjp07dun"
+	direct := tsstypes.NewDirectOriginator("bandchain", requester, "memo")
+	mockClient := newMockVerifierClient()
+
+	// Signing 1 is a text signing.
+	text := tsstypes.NewTextSignatureOrder([]byte("hello"))
+	textSigning := tsstypes.Signing{
+		ID:               1,
+		CreatedHeight:    10,
+		CreatedTimestamp: createdAt,
+		Message:          encodeSigning(t, 1, createdAt, &direct, append([]byte(tssmodule.TextMsgPrefix), "hello"...)),
+	}
+	mockClient.addSigning(textSigning, text, &direct)
+
+	// Signing 2 is an oracle result signing.
+	result := oracletypes.NewResult(
+		"client", 1, []byte("calldata"), 2, 2, 5, 2, 1000, 1010, oracletypes.RESOLVE_STATUS_SUCCESS, []byte("result"),
+	)
+	mockClient.results[5] = result
+	fullABI, err := result.PackFullABI()
+	require.NoError(t, err)
+	oracleSigning := tsstypes.Signing{
+		ID:               2,
+		CreatedHeight:    11,
+		CreatedTimestamp: createdAt,
+		Message:          encodeSigning(t, 2, createdAt, &direct, append([]byte(oracle.EncoderFullABIPrefix), fullABI...)),
+	}
+	mockClient.addSigning(
+		oracleSigning,
+		oracletypes.NewOracleResultSignatureOrder(5, oracletypes.ENCODER_FULL_ABI),
+		&direct,
+	)
+
+	// Signing 3 is a group transition signing from a tunnel originator.
+	tunnel := tsstypes.NewTunnelOriginator("bandchain", 1, "eth", "0x1234")
+	transition := bandtsstypes.NewGroupTransitionSignatureOrder([]byte{0x02, 0x03}, createdAt)
+	transitionMsg := bytes.Join([][]byte{
+		[]byte(bandtss.GroupTransitionMsgPrefix),
+		{0x02, 0x03},
+		sdk.Uint64ToBigEndian(uint64(createdAt.Unix())),
+	}, []byte(""))
+	transitionSigning := tsstypes.Signing{
+		ID:               3,
+		CreatedHeight:    12,
+		CreatedTimestamp: createdAt,
+		Message:          encodeSigning(t, 3, createdAt, &tunnel, transitionMsg),
+	}
+	mockClient.addSigning(transitionSigning, transition, &tunnel)
+	mockClient.transitions[12] = bandtsstypes.GroupTransition{
+		SigningID:           3,
+		IncomingGroupPubKey: []byte{0x02, 0x03},
+		ExecTime:            createdAt,
+	}
+
+	verifier := signing.NewVerifier(mockClient, cdc, signing.Policy{})
+	require.NoError(t, verifier.Verify(textSigning))
+	require.NoError(t, verifier.Verify(oracleSigning))
+	require.NoError(t, verifier.Verify(transitionSigning))
+
+	// Message that differs from the verifier node is refused.
+	tampered := textSigning
+	tampered.Message = encodeSigning(t, 1, createdAt, &direct, append([]byte(tssmodule.TextMsgPrefix), "evil"...))
+	require.ErrorContains(t, verifier.Verify(tampered), "differs from verifier node")
+
+	// Message that differs from the recomputed message is refused even if both nodes agree.
+	mockClient.results[5] = oracletypes.NewResult(
+		"client", 1, []byte("calldata"), 2, 2, 5, 2, 1000, 1010, oracletypes.RESOLVE_STATUS_SUCCESS, []byte("other"),
+	)
+	require.ErrorContains(t, verifier.Verify(oracleSigning), "differs from the expected message")
+
+	// Group transition message is recomputed from the bandtss state instead of the event.
+	forged := transitionSigning
+	forged.ID = 5
+	forged.CreatedHeight = 13
+	forgedMsg := bytes.Join([][]byte{
+		[]byte(bandtss.GroupTransitionMsgPrefix),
+		{0x09, 0x09},
+		sdk.Uint64ToBigEndian(uint64(createdAt.Unix())),
+	}, []byte(""))
+	forged.Message = encodeSigning(t, 5, createdAt, &tunnel, forgedMsg)
+	mockClient.addSigning(
+		forged,
+		bandtsstypes.NewGroupTransitionSignatureOrder([]byte{0x09, 0x09}, createdAt),
+		&tunnel,
+	)
+	mockClient.transitions[13] = bandtsstypes.GroupTransition{
+		SigningID:           5,
+		IncomingGroupPubKey: []byte{0x02, 0x03},
+		ExecTime:            createdAt,
+	}
+	require.ErrorContains(t, verifier.Verify(forged), "differs from the expected message")
+
+	// Group transition signing that is not the signing of the transition in the bandtss state is refused.
+	mockClient.transitions[13] = mockClient.transitions[12]
+	require.ErrorContains(t, verifier.Verify(forged), "is not the signing of the group transition")
+
+	// Signing without a create signing event is refused.
+	missing := textSigning
+	missing.ID = 4
+	mockClient.signings[4] = missing
+	require.ErrorContains(t, verifier.Verify(missing), "create signing event not found")
+
+	// Signing refused by the policy.
+	verifier = signing.NewVerifier(mockClient, cdc, signing.NewPolicy(nil, []string{signing.ContentTypeText}, nil, nil))
+	require.ErrorContains(t, verifier.Verify(textSigning), "content type text is denied")
+	verifier = signing.NewVerifier(mockClient, cdc, signing.NewPolicy(nil, nil, []string{signing.OriginatorTypeDirect}, nil))
+	require.NoError(t, verifier.Verify(textSigning))
+	require.ErrorContains(t, verifier.Verify(transitionSigning), "originator tunnel:1 is not allowed")
+}
+
+func TestPolicyCheck(t *testing.T) {
+	direct := tsstypes.NewDirectOriginator("bandchain", "band1requester", "")
+	tunnel := tsstypes.NewTunnelOriginator("bandchain", 7, "eth", "0x1234")
+	text := tsstypes.NewTextSignatureOrder([]byte("hello"))
+	feeds := &feedstypes.FeedsSignatureOrder{SignalIDs: []string{"CS:BTC-USD"}, Encoder: feedstypes.ENCODER_FIXED_POINT_ABI}
+
+	tests := []struct {
+		name       string
+		policy     signing.Policy
+		content    tsstypes.Content
+		originator tsstypes.Originator
+		expErr     string
+	}{
+		{"empty policy", signing.Policy{}, text, &direct, ""},
+		{
+			"allowed content type",
+			signing.NewPolicy([]string{signing.ContentTypeFeeds}, nil, nil, nil),
+			feeds, &direct, "",
+		},
+		{
+			"not allowed content type",
+			signing.NewPolicy([]string{signing.ContentTypeFeeds}, nil, nil, nil),
+			text, &direct, "content type text is not allowed",
+		},
+		{
+			"denied originator id",
+			signing.NewPolicy(nil, nil, nil, []string{"direct:band1requester"}),
+			feeds, &direct, "originator direct:band1requester is denied",
+		},
+		{
+			"allowed originator id",
+			signing.NewPolicy(nil, nil, []string{"tunnel:7"}, nil),
+			feeds, &tunnel, "",
+		},
+		{
+			"deny takes precedence",
+			signing.NewPolicy(nil, nil, []string{"tunnel"}, []string{"tunnel:7"}),
+			feeds, &tunnel, "originator tunnel:7 is denied",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Check(tc.content, tc.originator)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -36,17 +36,46 @@ type Config struct {
 	// DistributionOffsetPercentage defines the range of the percentage for price distribution.
 	DistributionOffsetPercentage uint64 `mapstructure:"distribution-offset-pct"`
 
-	// Bothan is the URL for connecting to Bothan.
+	// Bothan is the comma-separated URLs for connecting to Bothan instances.
 	Bothan string `mapstructure:"bothan"`
 
+	// BothanQuorumPolicy is the policy for combining prices from multiple Bothan instances.
+	BothanQuorumPolicy string `mapstructure:"bothan-quorum-policy"`
+
+	// BothanMinAgreement is the number of Bothan instances that must agree on a price.
+	BothanMinAgreement uint64 `mapstructure:"bothan-min-agreement"`
+
+	// BothanAgreementBasisPoint is the allowable deviation in basis points for Bothan prices to agree.
+	BothanAgreementBasisPoint uint64 `mapstructure:"bothan-agreement-bps"`
+
 	// BothanTimeout is the timeout duration for Bothan requests.
 	BothanTimeout string `mapstructure:"bothan-timeout"`
 
+	// PriceGuardBasisPoint is the allowable deviation in basis points from the on-chain price.
+	// The guard is disabled if it is zero.
+	PriceGuardBasisPoint uint64 `mapstructure:"price-guard-bps"`
+
+	// PriceGuardAction is what to do with a price that fails the guard (unavailable or hold).
+	PriceGuardAction string `mapstructure:"price-guard-action"`
+
 	// LogLevel is the level of logging for the logger.
 	LogLevel string `mapstructure:"log-level"`
 
 	// UpdaterQueryInterval is the interval for updater querying chain.
 	UpdaterQueryInterval string `mapstructure:"updater-query-interval"`
+
+	// MetricsListenAddr is the address to serve prometheus metrics and the health check on.
+	// Metrics are disabled if it is empty.
+	MetricsListenAddr string `mapstructure:"metrics-listen-addr"`
+
+	// DryRun records signal prices to DryRunLog instead of submitting them.
+	DryRun bool `mapstructure:"dry-run"`
+
+	// DryRunLog is the JSONL file to record dry-run prices to.
+	DryRunLog string `mapstructure:"dry-run-log"`
+
+	// DryRunCompareDelay is the duration to wait before comparing dry-run prices with the on-chain price.
+	DryRunCompareDelay string `mapstructure:"dry-run-compare-delay"`
 }
 
 // Context holds the runtime context for the application.
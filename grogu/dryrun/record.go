@@ -0,0 +1,142 @@
+package dryrun
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// Record is a signal price that would have been submitted in dry-run mode, written as one JSON line.
+type Record struct {
+	Time     time.Time `json:"time"`
+	UUID     string    `json:"uuid,omitempty"`
+	SignalID string    `json:"signal_id"`
+	Status   string    `json:"status"`
+	Price    uint64    `json:"price"`
+	// ChainStatus, ChainPrice and ChainTimestamp are the on-chain price at the time of comparison.
+	// They are empty if the on-chain price could not be queried.
+	ChainStatus    string `json:"chain_status,omitempty"`
+	ChainPrice     uint64 `json:"chain_price,omitempty"`
+	ChainTimestamp int64  `json:"chain_timestamp,omitempty"`
+	// DeviationBasisPoint is the deviation of the price from the on-chain price in basis points.
+	// It is only set if both prices are available.
+	DeviationBasisPoint *int64 `json:"deviation_bps,omitempty"`
+}
+
+// NewRecord creates a new record of the signal price compared with the given on-chain price, if any.
+func NewRecord(
+	submittedAt time.Time,
+	uuid string,
+	signalPrice types.SignalPrice,
+	chainPrice *types.Price,
+) Record {
+	record := Record{
+		Time:     submittedAt.UTC(),
+		UUID:     uuid,
+		SignalID: signalPrice.SignalID,
+		Status:   signalPrice.Status.String(),
+		Price:    signalPrice.Price,
+	}
+	if chainPrice == nil {
+		return record
+	}
+
+	record.ChainStatus = chainPrice.Status.String()
+	record.ChainPrice = chainPrice.Price
+	record.ChainTimestamp = chainPrice.Timestamp
+	if signalPrice.Status == types.SIGNAL_PRICE_STATUS_AVAILABLE &&
+		chainPrice.Status == types.PRICE_STATUS_AVAILABLE && chainPrice.Price != 0 {
+		diff := math.Abs(float64(signalPrice.Price) - float64(chainPrice.Price))
+		dev := int64(diff * 10000 / float64(chainPrice.Price))
+		record.DeviationBasisPoint = &dev
+	}
+
+	return record
+}
+
+// SignalSummary summarizes the records of a signal.
+type SignalSummary struct {
+	SignalID    string
+	Submissions int
+	Available   int
+	Unavailable int
+	Unsupported int
+	// Compared is the number of records with a deviation from the on-chain price.
+	Compared            int
+	MeanDeviationBps    float64
+	MaxDeviationBps     int64
+	MeanIntervalSeconds float64
+	FirstTime           time.Time
+	LastTime            time.Time
+}
+
+// Summarize reads JSON line records and summarizes them by signal ID, sorted by signal ID.
+func Summarize(reader io.Reader) ([]SignalSummary, error) {
+	summaries := make(map[string]*SignalSummary)
+	totalDeviations := make(map[string]int64)
+
+	scanner := bufio.NewScanner(reader)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("invalid record at line %d: %w", line, err)
+		}
+
+		summary, ok := summaries[record.SignalID]
+		if !ok {
+			summary = &SignalSummary{SignalID: record.SignalID, FirstTime: record.Time}
+			summaries[record.SignalID] = summary
+		}
+
+		summary.Submissions++
+		switch record.Status {
+		case types.SIGNAL_PRICE_STATUS_AVAILABLE.String():
+			summary.Available++
+		case types.SIGNAL_PRICE_STATUS_UNAVAILABLE.String():
+			summary.Unavailable++
+		case types.SIGNAL_PRICE_STATUS_UNSUPPORTED.String():
+			summary.Unsupported++
+		}
+		if record.DeviationBasisPoint != nil {
+			summary.Compared++
+			totalDeviations[record.SignalID] += *record.DeviationBasisPoint
+			summary.MaxDeviationBps = max(summary.MaxDeviationBps, *record.DeviationBasisPoint)
+		}
+		if record.Time.Before(summary.FirstTime) {
+			summary.FirstTime = record.Time
+		}
+		if record.Time.After(summary.LastTime) {
+			summary.LastTime = record.Time
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	result := make([]SignalSummary, 0, len(summaries))
+	for signalID, summary := range summaries {
+		if summary.Compared > 0 {
+			summary.MeanDeviationBps = float64(totalDeviations[signalID]) / float64(summary.Compared)
+		}
+		if summary.Submissions > 1 {
+			summary.MeanIntervalSeconds = summary.LastTime.Sub(summary.FirstTime).Seconds() /
+				float64(summary.Submissions-1)
+		}
+		result = append(result, *summary)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].SignalID < result[j].SignalID
+	})
+
+	return result, nil
+}
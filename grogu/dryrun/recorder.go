@@ -0,0 +1,154 @@
+package dryrun
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/grogu/signaller"
+	"github.com/bandprotocol/chain/v3/grogu/submitter"
+	"github.com/bandprotocol/chain/v3/pkg/logger"
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// Recorder takes the place of the submitter in dry-run mode. Instead of broadcasting the signal
+// prices, it records them along with the on-chain prices as JSON lines.
+type Recorder struct {
+	feedQuerier         signaller.FeedQuerier
+	submitSignalPriceCh <-chan submitter.SignalPriceSubmission
+	logger              *logger.Logger
+	pendingSignalIDs    *sync.Map
+	// How long to wait after a would-be submission before comparing it with the on-chain price
+	compareDelay time.Duration
+
+	writeMu sync.Mutex
+	encoder *json.Encoder
+
+	// Prices that would have been submitted, used in place of the validator prices on chain
+	priceMu         sync.RWMutex
+	validatorPrices map[string]types.ValidatorPrice
+}
+
+func NewRecorder(
+	feedQuerier signaller.FeedQuerier,
+	submitSignalPriceCh <-chan submitter.SignalPriceSubmission,
+	logger *logger.Logger,
+	pendingSignalIDs *sync.Map,
+	compareDelay time.Duration,
+	writer io.Writer,
+) *Recorder {
+	return &Recorder{
+		feedQuerier:         feedQuerier,
+		submitSignalPriceCh: submitSignalPriceCh,
+		logger:              logger,
+		pendingSignalIDs:    pendingSignalIDs,
+		compareDelay:        compareDelay,
+		encoder:             json.NewEncoder(writer),
+		validatorPrices:     make(map[string]types.ValidatorPrice),
+	}
+}
+
+func (r *Recorder) Start() {
+	for {
+		priceSubmission := <-r.submitSignalPriceCh
+		r.recordSubmission(priceSubmission, time.Now())
+		go func(sps submitter.SignalPriceSubmission, submittedAt time.Time) {
+			time.Sleep(r.compareDelay)
+			r.writeRecords(sps, submittedAt)
+		}(priceSubmission, time.Now())
+	}
+}
+
+// recordSubmission treats the signal prices as submitted so that the signaller schedules the
+// next submissions as it would after a successful transaction.
+func (r *Recorder) recordSubmission(sps submitter.SignalPriceSubmission, submittedAt time.Time) {
+	r.priceMu.Lock()
+	for _, signalPrice := range sps.SignalPrices {
+		r.validatorPrices[signalPrice.SignalID] = types.NewValidatorPrice(signalPrice, submittedAt.Unix(), 0)
+	}
+	r.priceMu.Unlock()
+
+	for _, signalPrice := range sps.SignalPrices {
+		r.pendingSignalIDs.Delete(signalPrice.SignalID)
+	}
+
+	r.logger.Info("[DryRun] recorded %d signal prices without submitting", len(sps.SignalPrices))
+}
+
+func (r *Recorder) writeRecords(sps submitter.SignalPriceSubmission, submittedAt time.Time) {
+	signalIDs := make([]string, 0, len(sps.SignalPrices))
+	for _, signalPrice := range sps.SignalPrices {
+		signalIDs = append(signalIDs, signalPrice.SignalID)
+	}
+
+	chainPrices := make(map[string]types.Price, len(signalIDs))
+	resp, err := r.feedQuerier.QueryPrices(signalIDs)
+	if err != nil {
+		r.logger.Error("[DryRun] failed to query on-chain prices: %v", err)
+	} else {
+		for _, price := range resp.Prices {
+			chainPrices[price.SignalID] = price
+		}
+	}
+
+	r.writeMu.Lock()
+	defer r.writeMu.Unlock()
+
+	for _, signalPrice := range sps.SignalPrices {
+		var chainPrice *types.Price
+		if price, ok := chainPrices[signalPrice.SignalID]; ok {
+			chainPrice = &price
+		}
+
+		record := NewRecord(submittedAt, sps.UUID, signalPrice, chainPrice)
+		if err := r.encoder.Encode(record); err != nil {
+			r.logger.Error("[DryRun] failed to write record: %v", err)
+			return
+		}
+	}
+}
+
+// FeedQuerier wraps the given feed querier so that the signaller sees the recorded prices as the
+// validator prices and always considers the validator required to feed prices.
+func (r *Recorder) FeedQuerier() signaller.FeedQuerier {
+	return &feedQuerier{FeedQuerier: r.feedQuerier, recorder: r}
+}
+
+type feedQuerier struct {
+	signaller.FeedQuerier
+	recorder *Recorder
+}
+
+func (q *feedQuerier) QueryValidValidator(_ sdk.ValAddress) (*types.QueryValidValidatorResponse, error) {
+	return &types.QueryValidValidatorResponse{Valid: true}, nil
+}
+
+func (q *feedQuerier) QueryValidatorPrices(valAddress sdk.ValAddress) (*types.QueryValidatorPricesResponse, error) {
+	resp, err := q.FeedQuerier.QueryValidatorPrices(valAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	q.recorder.priceMu.RLock()
+	defer q.recorder.priceMu.RUnlock()
+
+	seen := make(map[string]bool, len(resp.ValidatorPrices))
+	validatorPrices := make([]types.ValidatorPrice, 0, len(resp.ValidatorPrices))
+	for _, valPrice := range resp.ValidatorPrices {
+		if recorded, ok := q.recorder.validatorPrices[valPrice.SignalID]; ok {
+			valPrice = recorded
+		}
+		seen[valPrice.SignalID] = true
+		validatorPrices = append(validatorPrices, valPrice)
+	}
+	for signalID, recorded := range q.recorder.validatorPrices {
+		if !seen[signalID] {
+			validatorPrices = append(validatorPrices, recorded)
+		}
+	}
+
+	return &types.QueryValidatorPricesResponse{ValidatorPrices: validatorPrices}, nil
+}
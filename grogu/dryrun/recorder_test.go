@@ -0,0 +1,129 @@
+package dryrun
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/grogu/signaller/testutil"
+	"github.com/bandprotocol/chain/v3/grogu/submitter"
+	"github.com/bandprotocol/chain/v3/pkg/logger"
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func TestRecorder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockFeedQuerier := testutil.NewMockFeedQuerier(ctrl)
+	mockFeedQuerier.EXPECT().QueryValidatorPrices(gomock.Any()).
+		Return(&types.QueryValidatorPricesResponse{ValidatorPrices: []types.ValidatorPrice{
+			{SignalID: "signal1", SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Price: 100, Timestamp: 1},
+			{SignalID: "signal2", SignalPriceStatus: types.SIGNAL_PRICE_STATUS_AVAILABLE, Price: 200, Timestamp: 1},
+		}}, nil).
+		AnyTimes()
+	mockFeedQuerier.EXPECT().QueryPrices([]string{"signal1", "signal3"}).
+		Return(&types.QueryPricesResponse{Prices: []types.Price{
+			types.NewPrice(types.PRICE_STATUS_AVAILABLE, "signal1", 1000, 10),
+			types.NewPrice(types.PRICE_STATUS_NOT_IN_CURRENT_FEEDS, "signal3", 0, 0),
+		}}, nil)
+
+	allowLevel, _ := log.ParseLogLevel("info")
+	pendingSignalIDs := sync.Map{}
+	pendingSignalIDs.Store("signal1", struct{}{})
+	buf := &bytes.Buffer{}
+	recorder := NewRecorder(
+		mockFeedQuerier,
+		make(chan submitter.SignalPriceSubmission),
+		logger.NewLogger(allowLevel),
+		&pendingSignalIDs,
+		0,
+		buf,
+	)
+
+	sps := submitter.SignalPriceSubmission{
+		SignalPrices: []types.SignalPrice{
+			types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "signal1", 1010),
+			types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_UNAVAILABLE, "signal3", 0),
+		},
+		UUID: "uuid1",
+	}
+	submittedAt := time.Unix(100, 0)
+	recorder.recordSubmission(sps, submittedAt)
+	recorder.writeRecords(sps, submittedAt)
+
+	// The recorded prices are no longer pending and replace the validator prices on chain.
+	_, pending := pendingSignalIDs.Load("signal1")
+	require.False(t, pending)
+
+	valid, err := recorder.FeedQuerier().QueryValidValidator(sdk.ValAddress("val"))
+	require.NoError(t, err)
+	require.True(t, valid.Valid)
+
+	resp, err := recorder.FeedQuerier().QueryValidatorPrices(sdk.ValAddress("val"))
+	require.NoError(t, err)
+	require.Len(t, resp.ValidatorPrices, 3)
+	require.Equal(t, uint64(1010), resp.ValidatorPrices[0].Price)
+	require.Equal(t, int64(100), resp.ValidatorPrices[0].Timestamp)
+	require.Equal(t, uint64(200), resp.ValidatorPrices[1].Price)
+	require.Equal(t, "signal3", resp.ValidatorPrices[2].SignalID)
+
+	// The records are written as JSON lines and compared with the on-chain prices.
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	require.Contains(t, lines[0], `"signal_id":"signal1"`)
+	require.Contains(t, lines[0], `"deviation_bps":100`)
+	require.NotContains(t, lines[1], "deviation_bps")
+
+	summaries, err := Summarize(buf)
+	require.NoError(t, err)
+	require.Equal(t, []SignalSummary{
+		{
+			SignalID:         "signal1",
+			Submissions:      1,
+			Available:        1,
+			Compared:         1,
+			MeanDeviationBps: 100,
+			MaxDeviationBps:  100,
+			FirstTime:        submittedAt.UTC(),
+			LastTime:         submittedAt.UTC(),
+		},
+		{
+			SignalID:    "signal3",
+			Submissions: 1,
+			Unavailable: 1,
+			FirstTime:   submittedAt.UTC(),
+			LastTime:    submittedAt.UTC(),
+		},
+	}, summaries)
+}
+
+func TestSummarize(t *testing.T) {
+	input := strings.Join([]string{
+		`{"time":"2024-01-01T00:00:00Z","signal_id":"CS:BTC-USD","status":"SIGNAL_PRICE_STATUS_AVAILABLE","price":100,"deviation_bps":10}`,
+		``,
+		`{"time":"2024-01-01T00:01:00Z","signal_id":"CS:BTC-USD","status":"SIGNAL_PRICE_STATUS_AVAILABLE","price":100,"deviation_bps":30}`,
+		`{"time":"2024-01-01T00:02:00Z","signal_id":"CS:BTC-USD","status":"SIGNAL_PRICE_STATUS_UNSUPPORTED","price":0}`,
+	}, "\n")
+
+	summaries, err := Summarize(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	require.Equal(t, 3, summaries[0].Submissions)
+	require.Equal(t, 2, summaries[0].Available)
+	require.Equal(t, 1, summaries[0].Unsupported)
+	require.Equal(t, 2, summaries[0].Compared)
+	require.Equal(t, float64(20), summaries[0].MeanDeviationBps)
+	require.Equal(t, int64(30), summaries[0].MaxDeviationBps)
+	require.Equal(t, float64(60), summaries[0].MeanIntervalSeconds)
+
+	_, err = Summarize(strings.NewReader("not json"))
+	require.ErrorContains(t, err, "line 1")
+}
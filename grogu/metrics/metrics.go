@@ -0,0 +1,130 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	bothanQueries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grogu_signaller_bothan_queries_total",
+		Help: "Number of price queries to Bothan by endpoint and result",
+	}, []string{"endpoint", "result"})
+	bothanQuerySeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grogu_signaller_bothan_query_duration_seconds",
+		Help:    "Time taken to query prices from Bothan by endpoint",
+		Buckets: []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5},
+	}, []string{"endpoint"})
+	signalPrices = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grogu_signaller_signal_prices_total",
+		Help: "Number of signal prices sent for submission by signal ID and status",
+	}, []string{"signal_id", "status"})
+	priceGuardAlerts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grogu_signaller_price_guard_alerts_total",
+		Help: "Number of prices that deviated too much from the on-chain price by signal ID",
+	}, []string{"signal_id"})
+	signalStaleness = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "grogu_signaller_signal_staleness_seconds",
+		Help: "Time since the last on-chain price of the validator by signal ID",
+	}, []string{"signal_id"})
+
+	submissions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grogu_submitter_submissions_total",
+		Help: "Number of signal price submissions by result",
+	}, []string{"result"})
+	submissionSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grogu_submitter_submission_duration_seconds",
+		Help:    "Time from receiving a signal price submission until it is committed or abandoned",
+		Buckets: []float64{1, 2, 3, 5, 8, 13, 21, 34, 55, 89, 144, 300},
+	}, []string{"result"})
+	txResults = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grogu_submitter_tx_results_total",
+		Help: "Number of broadcasted and committed transactions by stage, codespace and code",
+	}, []string{"stage", "codespace", "code"})
+	idleKeys = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "grogu_submitter_idle_keys",
+		Help: "Number of keys available for submitting transactions",
+	})
+	totalKeys = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "grogu_submitter_keys",
+		Help: "Number of keys in the keyring used for submitting transactions",
+	})
+
+	registryChecks = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grogu_updater_registry_checks_total",
+		Help: "Number of Bothan registry checks by result",
+	}, []string{"result"})
+)
+
+// collectors returns all grogu metrics to be registered.
+func collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		bothanQueries,
+		bothanQuerySeconds,
+		signalPrices,
+		priceGuardAlerts,
+		signalStaleness,
+		submissions,
+		submissionSeconds,
+		txResults,
+		idleKeys,
+		totalKeys,
+		registryChecks,
+	}
+}
+
+func resultLabel(success bool) string {
+	if success {
+		return "success"
+	}
+	return "failure"
+}
+
+// ObserveBothanQuery records a price query to the given Bothan endpoint.
+func ObserveBothanQuery(endpoint string, success bool, duration time.Duration) {
+	bothanQueries.WithLabelValues(endpoint, resultLabel(success)).Inc()
+	bothanQuerySeconds.WithLabelValues(endpoint).Observe(duration.Seconds())
+}
+
+// IncSignalPrice records a signal price sent for submission.
+func IncSignalPrice(signalID string, status string) {
+	signalPrices.WithLabelValues(signalID, status).Inc()
+}
+
+// IncPriceGuardAlert records a price that failed the price guard.
+func IncPriceGuardAlert(signalID string) {
+	priceGuardAlerts.WithLabelValues(signalID).Inc()
+}
+
+// SetSignalStaleness replaces the staleness of all signals with the given values.
+func SetSignalStaleness(staleness map[string]time.Duration) {
+	signalStaleness.Reset()
+	for signalID, d := range staleness {
+		signalStaleness.WithLabelValues(signalID).Set(d.Seconds())
+	}
+}
+
+// ObserveSubmission records the final result of a signal price submission.
+func ObserveSubmission(success bool, duration time.Duration) {
+	result := resultLabel(success)
+	submissions.WithLabelValues(result).Inc()
+	submissionSeconds.WithLabelValues(result).Observe(duration.Seconds())
+}
+
+// IncTxResult records the result code of a transaction at the given stage (broadcast or commit).
+func IncTxResult(stage string, codespace string, code uint32) {
+	txResults.WithLabelValues(stage, codespace, strconv.FormatUint(uint64(code), 10)).Inc()
+}
+
+// SetKeys records the number of idle keys and the number of all keys.
+func SetKeys(idle int, total int) {
+	idleKeys.Set(float64(idle))
+	totalKeys.Set(float64(total))
+}
+
+// IncRegistryCheck records a Bothan registry check with its result, e.g. "match" or "updated".
+func IncRegistryCheck(result string) {
+	registryChecks.WithLabelValues(result).Inc()
+}
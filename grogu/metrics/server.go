@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// HealthCheck returns nil if grogu is healthy or the reason why it is not.
+type HealthCheck func() error
+
+// Listen registers all grogu metrics and serves them on /metrics along with the health check on
+// /healthz. It blocks until the server fails.
+func Listen(listenAddr string, healthCheck HealthCheck) error {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collectors()...)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	mux.Handle("/healthz", HealthHandler(healthCheck))
+
+	server := &http.Server{
+		Addr:              listenAddr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	return server.ListenAndServe()
+}
+
+// HealthHandler returns an HTTP handler that responds with 200 if the health check passes or 503
+// with the reason otherwise.
+func HealthHandler(healthCheck HealthCheck) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if err := healthCheck(); err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = fmt.Fprintf(w, "unhealthy: %v\n", err)
+			return
+		}
+		_, _ = fmt.Fprintln(w, "ok")
+	})
+}
@@ -115,3 +115,15 @@ func (q *FeedQuerier) QueryReferenceSourceConfig() (*feeds.QueryReferenceSourceC
 	in := feeds.QueryReferenceSourceConfigRequest{}
 	return getMaxBlockHeightResponse(fs, &in, q.maxBlockHeight)
 }
+
+func (q *FeedQuerier) QueryPrices(signalIDs []string) (*feeds.QueryPricesResponse, error) {
+	fs := make([]QueryFunction[feeds.QueryPricesRequest, feeds.QueryPricesResponse], 0, len(q.queryClients))
+	for _, queryClient := range q.queryClients {
+		fs = append(fs, queryClient.Prices)
+	}
+
+	in := feeds.QueryPricesRequest{
+		SignalIds: signalIDs,
+	}
+	return getMaxBlockHeightResponse(fs, &in, q.maxBlockHeight)
+}
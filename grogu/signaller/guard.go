@@ -0,0 +1,91 @@
+package signaller
+
+import (
+	"fmt"
+
+	"github.com/bandprotocol/chain/v3/grogu/metrics"
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// PriceGuardAction defines what to do with a price that deviates too much from the on-chain price.
+type PriceGuardAction string
+
+const (
+	// PriceGuardActionUnavailable submits the price as unavailable instead.
+	PriceGuardActionUnavailable PriceGuardAction = "unavailable"
+	// PriceGuardActionHold does not submit the price at all.
+	PriceGuardActionHold PriceGuardAction = "hold"
+)
+
+// ParsePriceGuardAction parses the given string into a price guard action.
+func ParsePriceGuardAction(action string) (PriceGuardAction, error) {
+	switch a := PriceGuardAction(action); a {
+	case PriceGuardActionUnavailable, PriceGuardActionHold:
+		return a, nil
+	default:
+		return "", fmt.Errorf("unknown price guard action: %s", action)
+	}
+}
+
+// PriceGuardConfig holds the settings for guarding prices against the on-chain price.
+type PriceGuardConfig struct {
+	// MaxDeviationBasisPoint is the allowable deviation from the on-chain price in basis points.
+	// The guard is disabled if it is zero.
+	MaxDeviationBasisPoint int64
+	Action                 PriceGuardAction
+}
+
+// Enabled returns true if the price guard is enabled.
+func (c PriceGuardConfig) Enabled() bool {
+	return c.MaxDeviationBasisPoint > 0
+}
+
+func (s *Signaller) updateChainPriceMap() bool {
+	if !s.priceGuardConfig.Enabled() {
+		return true
+	}
+
+	resp, err := s.feedQuerier.QueryPrices(s.getAllSignalIDs())
+	if err != nil {
+		s.logger.Error("[Signaller] failed to query on-chain prices: %v", err)
+		return false
+	}
+
+	s.signalIDToChainPrice = sliceToMap(resp.Prices, func(price types.Price) string {
+		return price.SignalID
+	})
+
+	return true
+}
+
+// guardSignalPrice checks the signal price against the current on-chain price. It returns the
+// price to be submitted and false if the price should be held instead.
+func (s *Signaller) guardSignalPrice(signalPrice types.SignalPrice) (types.SignalPrice, bool) {
+	if !s.priceGuardConfig.Enabled() || signalPrice.Status != types.SIGNAL_PRICE_STATUS_AVAILABLE {
+		return signalPrice, true
+	}
+
+	chainPrice, ok := s.signalIDToChainPrice[signalPrice.SignalID]
+	if !ok || chainPrice.Status != types.PRICE_STATUS_AVAILABLE {
+		return signalPrice, true
+	}
+
+	if !isDeviated(s.priceGuardConfig.MaxDeviationBasisPoint+1, chainPrice.Price, signalPrice.Price) {
+		return signalPrice, true
+	}
+
+	s.logger.Error(
+		"[Signaller] price guard alert: price of %s (%d) deviates from on-chain price (%d) by more than %d bps",
+		signalPrice.SignalID,
+		signalPrice.Price,
+		chainPrice.Price,
+		s.priceGuardConfig.MaxDeviationBasisPoint,
+	)
+	metrics.IncPriceGuardAlert(signalPrice.SignalID)
+
+	if s.priceGuardConfig.Action == PriceGuardActionHold {
+		return signalPrice, false
+	}
+
+	return types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_UNAVAILABLE, signalPrice.SignalID, 0), true
+}
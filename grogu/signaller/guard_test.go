@@ -0,0 +1,52 @@
+package signaller
+
+import (
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func (s *SignallerTestSuite) TestGuardSignalPrice() {
+	s.Signaller.signalIDToChainPrice = map[string]types.Price{
+		"signal1": {Status: types.PRICE_STATUS_AVAILABLE, SignalID: "signal1", Price: 10000},
+		"signal2": {Status: types.PRICE_STATUS_NOT_READY, SignalID: "signal2"},
+	}
+	deviated := types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "signal1", 12000)
+	unavailable := types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_UNAVAILABLE, "signal1", 0)
+
+	// Disabled guard passes every price.
+	price, ok := s.Signaller.guardSignalPrice(deviated)
+	s.Require().True(ok)
+	s.Require().Equal(deviated, price)
+
+	s.Signaller.priceGuardConfig = PriceGuardConfig{MaxDeviationBasisPoint: 500, Action: PriceGuardActionUnavailable}
+
+	// Price within the allowable deviation is passed.
+	withinGuard := types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "signal1", 10500)
+	price, ok = s.Signaller.guardSignalPrice(withinGuard)
+	s.Require().True(ok)
+	s.Require().Equal(withinGuard, price)
+
+	// Deviated price is submitted as unavailable.
+	price, ok = s.Signaller.guardSignalPrice(deviated)
+	s.Require().True(ok)
+	s.Require().Equal(unavailable, price)
+
+	// Price without available on-chain price is passed.
+	noChainPrice := types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "signal2", 12000)
+	price, ok = s.Signaller.guardSignalPrice(noChainPrice)
+	s.Require().True(ok)
+	s.Require().Equal(noChainPrice, price)
+
+	// Deviated price is held.
+	s.Signaller.priceGuardConfig.Action = PriceGuardActionHold
+	_, ok = s.Signaller.guardSignalPrice(deviated)
+	s.Require().False(ok)
+}
+
+func (s *SignallerTestSuite) TestParsePriceGuardAction() {
+	action, err := ParsePriceGuardAction("hold")
+	s.Require().NoError(err)
+	s.Require().Equal(PriceGuardActionHold, action)
+
+	_, err = ParsePriceGuardAction("ignore")
+	s.Require().Error(err)
+}
@@ -0,0 +1,99 @@
+package signaller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bandprotocol/chain/v3/grogu/metrics"
+)
+
+// feedSubmission is the interval of a current feed and the time the validator last submitted its price.
+type feedSubmission struct {
+	interval      int64
+	lastSubmitted int64
+}
+
+// feedHealthTracker keeps a snapshot of the current feeds so that their health can be checked
+// outside of the signaller loop.
+type feedHealthTracker struct {
+	mu sync.RWMutex
+	// updated is false until the current feeds have been queried at least once
+	updated bool
+	// required is false if the validator is not required to feed prices
+	required bool
+	feeds    map[string]feedSubmission
+}
+
+func (t *feedHealthTracker) setNotRequired() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.updated = true
+	t.required = false
+	t.feeds = nil
+}
+
+func (t *feedHealthTracker) setFeeds(feeds map[string]feedSubmission) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.updated = true
+	t.required = true
+	t.feeds = feeds
+}
+
+// staleSignalIDs returns the sorted signal IDs of the feeds that have not been submitted within
+// their interval at the given time.
+func (t *feedHealthTracker) staleSignalIDs(now int64) ([]string, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if !t.updated {
+		return nil, fmt.Errorf("current feeds have not been queried yet")
+	}
+	if !t.required {
+		return nil, nil
+	}
+
+	var stale []string
+	for signalID, feed := range t.feeds {
+		if now-feed.lastSubmitted > feed.interval {
+			stale = append(stale, signalID)
+		}
+	}
+	sort.Strings(stale)
+
+	return stale, nil
+}
+
+// updateFeedHealth takes a snapshot of the current feeds and the latest validator prices.
+func (s *Signaller) updateFeedHealth() {
+	now := time.Now()
+	feeds := make(map[string]feedSubmission, len(s.signalIDToFeed))
+	staleness := make(map[string]time.Duration, len(s.signalIDToFeed))
+	for signalID, feed := range s.signalIDToFeed {
+		// lastSubmitted stays zero if the validator has never submitted the price of the feed
+		lastSubmitted := s.signalIDToValidatorPrice[signalID].Timestamp
+		feeds[signalID] = feedSubmission{interval: feed.Interval, lastSubmitted: lastSubmitted}
+		staleness[signalID] = now.Sub(time.Unix(lastSubmitted, 0))
+	}
+
+	s.feedHealth.setFeeds(feeds)
+	metrics.SetSignalStaleness(staleness)
+}
+
+// CheckFeedsSubmitted returns an error if any current feed has not been submitted within its interval.
+func (s *Signaller) CheckFeedsSubmitted() error {
+	stale, err := s.feedHealth.staleSignalIDs(time.Now().Unix())
+	if err != nil {
+		return err
+	}
+	if len(stale) > 0 {
+		return fmt.Errorf("feeds not submitted within their interval: %s", strings.Join(stale, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package signaller
+
+import (
+	"time"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func (s *SignallerTestSuite) TestCheckFeedsSubmitted() {
+	// Feeds have not been queried yet.
+	s.Require().Error(s.Signaller.CheckFeedsSubmitted())
+
+	now := time.Now().Unix()
+	s.Signaller.signalIDToFeed = map[string]types.FeedWithDeviation{
+		"signal1": {SignalID: "signal1", Interval: 60},
+		"signal2": {SignalID: "signal2", Interval: 60},
+	}
+	s.Signaller.signalIDToValidatorPrice = map[string]types.ValidatorPrice{
+		"signal1": {SignalID: "signal1", Timestamp: now - 10},
+		"signal2": {SignalID: "signal2", Timestamp: now - 30},
+	}
+	s.Signaller.updateFeedHealth()
+	s.Require().NoError(s.Signaller.CheckFeedsSubmitted())
+
+	// signal2 is past its interval and signal3 has never been submitted.
+	s.Signaller.signalIDToFeed["signal2"] = types.FeedWithDeviation{SignalID: "signal2", Interval: 20}
+	s.Signaller.signalIDToFeed["signal3"] = types.FeedWithDeviation{SignalID: "signal3", Interval: 60}
+	s.Signaller.updateFeedHealth()
+	err := s.Signaller.CheckFeedsSubmitted()
+	s.Require().ErrorContains(err, "signal2, signal3")
+
+	// Validator that is not required to feed prices is healthy.
+	s.Signaller.feedHealth.setNotRequired()
+	s.Require().NoError(s.Signaller.CheckFeedsSubmitted())
+}
@@ -0,0 +1,241 @@
+package signaller
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	bothan "github.com/bandprotocol/bothan/bothan-api/client/go-client/proto/bothan/v1"
+
+	"github.com/bandprotocol/chain/v3/grogu/metrics"
+)
+
+// QuorumPolicy defines how prices from multiple Bothan endpoints are combined.
+type QuorumPolicy string
+
+const (
+	// QuorumPolicyFirstHealthy uses the price from the first endpoint, in configured order,
+	// that returns the signal as available.
+	QuorumPolicyFirstHealthy QuorumPolicy = "first-healthy"
+	// QuorumPolicyMedian uses the median of all available prices of the signal.
+	QuorumPolicyMedian QuorumPolicy = "median"
+	// QuorumPolicyAgreement uses the median of all available prices of the signal only if
+	// enough endpoints agree with the median, otherwise the signal is reported as unavailable.
+	QuorumPolicyAgreement QuorumPolicy = "agreement"
+)
+
+// ParseQuorumPolicy parses the given string into a quorum policy.
+func ParseQuorumPolicy(policy string) (QuorumPolicy, error) {
+	switch p := QuorumPolicy(policy); p {
+	case QuorumPolicyFirstHealthy, QuorumPolicyMedian, QuorumPolicyAgreement:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unknown quorum policy: %s", policy)
+	}
+}
+
+// QuorumConfig holds the settings for combining prices from multiple Bothan endpoints.
+type QuorumConfig struct {
+	Policy QuorumPolicy
+	// MinAgreement is the minimum number of endpoints whose price must be within
+	// AgreementBasisPoint of the median. Only used by the agreement policy.
+	MinAgreement int
+	// AgreementBasisPoint is the allowable deviation from the median in basis points.
+	AgreementBasisPoint int64
+}
+
+// BothanEndpoint is a Bothan instance that the signaller queries prices from.
+type BothanEndpoint struct {
+	Name   string
+	Client BothanClient
+}
+
+// EndpointHealth is the health of a Bothan endpoint based on its latest price queries.
+type EndpointHealth struct {
+	Name                string
+	Healthy             bool
+	ConsecutiveFailures uint64
+	LastError           string
+	LastSuccess         time.Time
+}
+
+// bothanResult is the result of querying prices from a Bothan endpoint.
+type bothanResult struct {
+	endpoint int
+	prices   []*bothan.Price
+	uuid     string
+	err      error
+}
+
+// endpointHealthTracker keeps track of the health of all Bothan endpoints.
+type endpointHealthTracker struct {
+	mu     sync.RWMutex
+	health []EndpointHealth
+}
+
+func newEndpointHealthTracker(endpoints []BothanEndpoint) *endpointHealthTracker {
+	health := make([]EndpointHealth, len(endpoints))
+	for i, endpoint := range endpoints {
+		health[i] = EndpointHealth{Name: endpoint.Name, Healthy: true}
+	}
+	return &endpointHealthTracker{health: health}
+}
+
+// update records the result of a query and returns true if the health of the endpoint changed.
+func (t *endpointHealthTracker) update(endpoint int, err error, now time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	h := &t.health[endpoint]
+	wasHealthy := h.Healthy
+	if err != nil {
+		h.Healthy = false
+		h.ConsecutiveFailures++
+		h.LastError = err.Error()
+	} else {
+		h.Healthy = true
+		h.ConsecutiveFailures = 0
+		h.LastError = ""
+		h.LastSuccess = now
+	}
+	return wasHealthy != h.Healthy
+}
+
+func (t *endpointHealthTracker) snapshot() []EndpointHealth {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return slices.Clone(t.health)
+}
+
+// queryBothans queries prices of the given signal IDs from all Bothan endpoints concurrently.
+// The results are ordered by the configured order of the endpoints.
+func (s *Signaller) queryBothans(signalIDs []string) []bothanResult {
+	results := make([]bothanResult, len(s.bothanEndpoints))
+
+	var wg sync.WaitGroup
+	wg.Add(len(s.bothanEndpoints))
+	for i, endpoint := range s.bothanEndpoints {
+		go func(i int, endpoint BothanEndpoint) {
+			defer wg.Done()
+
+			start := time.Now()
+			res, err := endpoint.Client.GetPrices(signalIDs)
+			metrics.ObserveBothanQuery(endpoint.Name, err == nil, time.Since(start))
+			if err != nil {
+				results[i] = bothanResult{endpoint: i, err: err}
+				return
+			}
+			results[i] = bothanResult{endpoint: i, prices: res.Prices, uuid: res.Uuid}
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	now := time.Now()
+	for _, result := range results {
+		name := s.bothanEndpoints[result.endpoint].Name
+		changed := s.endpointHealth.update(result.endpoint, result.err, now)
+		switch {
+		case result.err != nil && changed:
+			s.logger.Error("[Signaller] bothan endpoint %s became unhealthy: %v", name, result.err)
+		case result.err != nil:
+			s.logger.Debug("[Signaller] bothan endpoint %s is still unhealthy: %v", name, result.err)
+		case changed:
+			s.logger.Info("[Signaller] bothan endpoint %s recovered", name)
+		}
+	}
+
+	return results
+}
+
+// EndpointHealth returns the health of all Bothan endpoints in configured order.
+func (s *Signaller) EndpointHealth() []EndpointHealth {
+	return s.endpointHealth.snapshot()
+}
+
+// combinePrices combines the prices from all successful Bothan results into one price per signal
+// according to the quorum policy. It also returns the UUID of the primary endpoint result, which
+// is used for monitoring, or an empty string if the primary endpoint failed.
+func combinePrices(
+	results []bothanResult,
+	signalIDs []string,
+	config QuorumConfig,
+) ([]*bothan.Price, string, bool) {
+	var uuid string
+	found := false
+	pricesBySignalID := make(map[string][]*bothan.Price, len(signalIDs))
+	for _, result := range results {
+		if result.err != nil {
+			continue
+		}
+		if result.endpoint == 0 {
+			uuid = result.uuid
+		}
+		found = true
+		for _, price := range result.prices {
+			pricesBySignalID[price.SignalId] = append(pricesBySignalID[price.SignalId], price)
+		}
+	}
+	if !found {
+		return nil, "", false
+	}
+
+	combined := make([]*bothan.Price, 0, len(signalIDs))
+	for _, signalID := range signalIDs {
+		prices, ok := pricesBySignalID[signalID]
+		if !ok {
+			continue
+		}
+		combined = append(combined, combineSignalPrices(signalID, prices, config))
+	}
+
+	return combined, uuid, true
+}
+
+// combineSignalPrices combines the prices of a signal from multiple endpoints, ordered by the
+// configured order of the endpoints, into one price.
+func combineSignalPrices(signalID string, prices []*bothan.Price, config QuorumConfig) *bothan.Price {
+	if config.Policy == QuorumPolicyFirstHealthy {
+		for _, price := range prices {
+			if price.Status == bothan.Status_STATUS_AVAILABLE {
+				return price
+			}
+		}
+		return prices[0]
+	}
+
+	available := make([]uint64, 0, len(prices))
+	for _, price := range prices {
+		if price.Status == bothan.Status_STATUS_AVAILABLE {
+			available = append(available, price.Price)
+		}
+	}
+	// If no endpoint has the price, use the status reported by the first endpoint.
+	if len(available) == 0 {
+		return prices[0]
+	}
+
+	median := medianPrice(available)
+	if config.Policy == QuorumPolicyAgreement {
+		agreed := 0
+		for _, price := range available {
+			if !isDeviated(config.AgreementBasisPoint+1, median, price) {
+				agreed++
+			}
+		}
+		if agreed < config.MinAgreement {
+			return &bothan.Price{SignalId: signalID, Status: bothan.Status_STATUS_UNAVAILABLE}
+		}
+	}
+
+	return &bothan.Price{SignalId: signalID, Price: median, Status: bothan.Status_STATUS_AVAILABLE}
+}
+
+// medianPrice returns the lower median of the given prices so that the result is always a price
+// reported by one of the endpoints.
+func medianPrice(prices []uint64) uint64 {
+	sorted := slices.Clone(prices)
+	slices.Sort(sorted)
+	return sorted[(len(sorted)-1)/2]
+}
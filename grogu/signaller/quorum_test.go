@@ -0,0 +1,142 @@
+package signaller
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	bothan "github.com/bandprotocol/bothan/bothan-api/client/go-client/proto/bothan/v1"
+)
+
+func availablePrice(signalID string, price uint64) *bothan.Price {
+	return &bothan.Price{SignalId: signalID, Price: price, Status: bothan.Status_STATUS_AVAILABLE}
+}
+
+func TestParseQuorumPolicy(t *testing.T) {
+	policy, err := ParseQuorumPolicy("agreement")
+	assert.NoError(t, err)
+	assert.Equal(t, QuorumPolicyAgreement, policy)
+
+	_, err = ParseQuorumPolicy("majority")
+	assert.Error(t, err)
+}
+
+func TestCombineSignalPrices(t *testing.T) {
+	unavailable := &bothan.Price{SignalId: "signal1", Status: bothan.Status_STATUS_UNAVAILABLE}
+
+	tests := []struct {
+		name     string
+		prices   []*bothan.Price
+		config   QuorumConfig
+		expected *bothan.Price
+	}{
+		{
+			"First healthy",
+			[]*bothan.Price{availablePrice("signal1", 1000), availablePrice("signal1", 2000)},
+			QuorumConfig{Policy: QuorumPolicyFirstHealthy},
+			availablePrice("signal1", 1000),
+		},
+		{
+			"First healthy skips unavailable",
+			[]*bothan.Price{unavailable, availablePrice("signal1", 2000)},
+			QuorumConfig{Policy: QuorumPolicyFirstHealthy},
+			availablePrice("signal1", 2000),
+		},
+		{
+			"First healthy of all unavailable",
+			[]*bothan.Price{unavailable, unavailable},
+			QuorumConfig{Policy: QuorumPolicyFirstHealthy},
+			unavailable,
+		},
+		{
+			"Median ignores outlier",
+			[]*bothan.Price{
+				availablePrice("signal1", 1000),
+				availablePrice("signal1", 99999),
+				availablePrice("signal1", 1001),
+			},
+			QuorumConfig{Policy: QuorumPolicyMedian},
+			availablePrice("signal1", 1001),
+		},
+		{
+			"Median skips unavailable",
+			[]*bothan.Price{unavailable, availablePrice("signal1", 1000)},
+			QuorumConfig{Policy: QuorumPolicyMedian},
+			availablePrice("signal1", 1000),
+		},
+		{
+			"Median of all unavailable",
+			[]*bothan.Price{unavailable, unavailable},
+			QuorumConfig{Policy: QuorumPolicyMedian},
+			unavailable,
+		},
+		{
+			"Agreement reached",
+			[]*bothan.Price{
+				availablePrice("signal1", 1000),
+				availablePrice("signal1", 1005),
+				availablePrice("signal1", 2000),
+			},
+			QuorumConfig{Policy: QuorumPolicyAgreement, MinAgreement: 2, AgreementBasisPoint: 50},
+			availablePrice("signal1", 1005),
+		},
+		{
+			"Agreement not reached",
+			[]*bothan.Price{
+				availablePrice("signal1", 1000),
+				availablePrice("signal1", 1100),
+				availablePrice("signal1", 2000),
+			},
+			QuorumConfig{Policy: QuorumPolicyAgreement, MinAgreement: 2, AgreementBasisPoint: 50},
+			unavailable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, combineSignalPrices("signal1", test.prices, test.config))
+		})
+	}
+}
+
+func TestCombinePrices(t *testing.T) {
+	config := QuorumConfig{Policy: QuorumPolicyMedian}
+
+	// Prices are combined from healthy endpoints only and the uuid comes from the primary endpoint.
+	results := []bothanResult{
+		{endpoint: 0, err: errors.New("connection refused")},
+		{endpoint: 1, prices: []*bothan.Price{availablePrice("signal1", 1000)}, uuid: "uuid1"},
+		{endpoint: 2, prices: []*bothan.Price{availablePrice("signal1", 1002)}, uuid: "uuid2"},
+	}
+	prices, uuid, ok := combinePrices(results, []string{"signal1", "signal2"}, config)
+	assert.True(t, ok)
+	assert.Equal(t, "", uuid)
+	assert.Equal(t, []*bothan.Price{availablePrice("signal1", 1000)}, prices)
+
+	// Fail if every endpoint fails.
+	_, _, ok = combinePrices(results[:1], []string{"signal1"}, config)
+	assert.False(t, ok)
+}
+
+func TestEndpointHealthTracker(t *testing.T) {
+	tracker := newEndpointHealthTracker([]BothanEndpoint{{Name: "bothan1"}, {Name: "bothan2"}})
+
+	assert.True(t, tracker.update(0, errors.New("timeout"), time.Unix(10, 0)))
+	assert.False(t, tracker.update(0, errors.New("timeout"), time.Unix(11, 0)))
+	assert.False(t, tracker.update(1, nil, time.Unix(11, 0)))
+
+	health := tracker.snapshot()
+	assert.Equal(t, EndpointHealth{
+		Name:                "bothan1",
+		Healthy:             false,
+		ConsecutiveFailures: 2,
+		LastError:           "timeout",
+	}, health[0])
+	assert.Equal(t, EndpointHealth{Name: "bothan2", Healthy: true, LastSuccess: time.Unix(11, 0)}, health[1])
+
+	// Recovering resets the failures.
+	assert.True(t, tracker.update(0, nil, time.Unix(12, 0)))
+	assert.Equal(t, uint64(0), tracker.snapshot()[0].ConsecutiveFailures)
+}
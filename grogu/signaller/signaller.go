@@ -8,6 +8,7 @@ import (
 
 	bothan "github.com/bandprotocol/bothan/bothan-api/client/go-client/proto/bothan/v1"
 
+	"github.com/bandprotocol/chain/v3/grogu/metrics"
 	"github.com/bandprotocol/chain/v3/grogu/submitter"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 	"github.com/bandprotocol/chain/v3/x/feeds/types"
@@ -21,7 +22,13 @@ const (
 type Signaller struct {
 	feedQuerier  FeedQuerier
 	cometQuerier CometQuerier
-	bothanClient BothanClient
+	// Bothan instances to query prices from and how to combine their prices
+	bothanEndpoints []BothanEndpoint
+	quorumConfig    QuorumConfig
+	endpointHealth  *endpointHealthTracker
+	feedHealth      *feedHealthTracker
+	// Guard against prices that deviate too much from the on-chain price
+	priceGuardConfig PriceGuardConfig
 	// How often to check for signal changes
 	interval         time.Duration
 	submitCh         chan<- submitter.SignalPriceSubmission
@@ -34,6 +41,7 @@ type Signaller struct {
 
 	signalIDToFeed           map[string]types.FeedWithDeviation
 	signalIDToValidatorPrice map[string]types.ValidatorPrice
+	signalIDToChainPrice     map[string]types.Price
 	params                   *types.Params
 	blockTime                int64
 }
@@ -41,7 +49,9 @@ type Signaller struct {
 func New(
 	feedQuerier FeedQuerier,
 	cometQuerier CometQuerier,
-	bothanClient BothanClient,
+	bothanEndpoints []BothanEndpoint,
+	quorumConfig QuorumConfig,
+	priceGuardConfig PriceGuardConfig,
 	interval time.Duration,
 	submitCh chan<- submitter.SignalPriceSubmission,
 	logger *logger.Logger,
@@ -53,7 +63,11 @@ func New(
 	return &Signaller{
 		feedQuerier:                  feedQuerier,
 		cometQuerier:                 cometQuerier,
-		bothanClient:                 bothanClient,
+		bothanEndpoints:              bothanEndpoints,
+		quorumConfig:                 quorumConfig,
+		endpointHealth:               newEndpointHealthTracker(bothanEndpoints),
+		feedHealth:                   &feedHealthTracker{},
+		priceGuardConfig:             priceGuardConfig,
 		interval:                     interval,
 		submitCh:                     submitCh,
 		logger:                       logger,
@@ -63,6 +77,7 @@ func New(
 		distributionOffsetPercentage: distributionOffsetPercentage,
 		signalIDToFeed:               make(map[string]types.FeedWithDeviation),
 		signalIDToValidatorPrice:     make(map[string]types.ValidatorPrice),
+		signalIDToChainPrice:         make(map[string]types.Price),
 		params:                       nil,
 	}
 }
@@ -79,6 +94,7 @@ func (s *Signaller) Start() {
 
 		if !resp.Valid {
 			s.logger.Info("[Signaller] validator is not required to feed prices")
+			s.feedHealth.setNotRequired()
 			continue
 		}
 
@@ -86,6 +102,7 @@ func (s *Signaller) Start() {
 			s.logger.Error("[Signaller] failed to update internal variables")
 			continue
 		}
+		s.updateFeedHealth()
 
 		s.execute()
 	}
@@ -116,7 +133,8 @@ func (s *Signaller) updateInternalVariables() bool {
 		}
 	}
 
-	return success
+	// On-chain prices are queried for the current feeds, so they must be updated afterwards
+	return success && s.updateChainPriceMap()
 }
 
 func (s *Signaller) updateParams() bool {
@@ -183,14 +201,13 @@ func (s *Signaller) execute() {
 	}
 
 	s.logger.Debug("[Signaller] querying prices from bothan: %v", nonPendingSignalIDs)
-	res, err := s.bothanClient.GetPrices(nonPendingSignalIDs)
-	if err != nil {
-		s.logger.Error("[Signaller] failed to query prices from bothan: %v", err)
+	results := s.queryBothans(nonPendingSignalIDs)
+	prices, uuid, ok := combinePrices(results, nonPendingSignalIDs, s.quorumConfig)
+	if !ok {
+		s.logger.Error("[Signaller] failed to query prices from all bothan endpoints")
 		return
 	}
 
-	prices, uuid := res.Prices, res.Uuid
-
 	s.logger.Debug("[Signaller] filtering prices")
 	signalPrices := s.filterAndPrepareSignalPrices(prices, nonPendingSignalIDs, latestBlockTime)
 	if len(signalPrices) == 0 {
@@ -198,6 +215,10 @@ func (s *Signaller) execute() {
 		return
 	}
 
+	for _, signalPrice := range signalPrices {
+		metrics.IncSignalPrice(signalPrice.SignalID, signalPrice.Status.String())
+	}
+
 	s.logger.Debug("[Signaller] submitting prices: %v", signalPrices)
 	s.submitPrices(signalPrices, uuid)
 }
@@ -263,6 +284,11 @@ func (s *Signaller) filterAndPrepareSignalPrices(
 			continue
 		}
 
+		signalPrice, ok = s.guardSignalPrice(signalPrice)
+		if !ok {
+			continue
+		}
+
 		if !s.isPriceValid(signalPrice, currentTime) {
 			continue
 		}
@@ -122,7 +122,9 @@ func (s *SignallerTestSuite) SetupTest() {
 	s.Signaller = New(
 		mockFeedQuerier,
 		mockCometClient,
-		mockBothanClient,
+		[]BothanEndpoint{{Name: "bothan", Client: mockBothanClient}},
+		QuorumConfig{Policy: QuorumPolicyMedian, MinAgreement: 1, AgreementBasisPoint: 50},
+		PriceGuardConfig{},
 		time.Second,
 		submitCh,
 		l,
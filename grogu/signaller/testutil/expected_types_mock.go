@@ -155,6 +155,21 @@ func (mr *MockFeedQuerierMockRecorder) QueryParams() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryParams", reflect.TypeOf((*MockFeedQuerier)(nil).QueryParams))
 }
 
+// QueryPrices mocks base method.
+func (m *MockFeedQuerier) QueryPrices(signalIDs []string) (*types.QueryPricesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryPrices", signalIDs)
+	ret0, _ := ret[0].(*types.QueryPricesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryPrices indicates an expected call of QueryPrices.
+func (mr *MockFeedQuerierMockRecorder) QueryPrices(signalIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryPrices", reflect.TypeOf((*MockFeedQuerier)(nil).QueryPrices), signalIDs)
+}
+
 // QueryValidValidator mocks base method.
 func (m *MockFeedQuerier) QueryValidValidator(valAddress types0.ValAddress) (*types.QueryValidValidatorResponse, error) {
 	m.ctrl.T.Helper()
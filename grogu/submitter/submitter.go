@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 
+	"github.com/bandprotocol/chain/v3/grogu/metrics"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 	"github.com/bandprotocol/chain/v3/x/feeds/types"
 )
@@ -76,6 +77,7 @@ func New(
 	for _, record := range records {
 		idleKeyIDChannel <- record.Name
 	}
+	metrics.SetKeys(len(idleKeyIDChannel), cap(idleKeyIDChannel))
 
 	return &Submitter{
 		clientCtx:           clientCtx,
@@ -99,6 +101,7 @@ func (s *Submitter) Start() {
 	for {
 		priceSubmission := <-s.submitSignalPriceCh
 		keyID := <-s.idleKeyIDChannel
+		metrics.SetKeys(len(s.idleKeyIDChannel), cap(s.idleKeyIDChannel))
 		go func(sps SignalPriceSubmission, kid string) {
 			s.logger.Debug("[Submitter] starting submission")
 			s.submitPrice(sps, kid)
@@ -108,9 +111,13 @@ func (s *Submitter) Start() {
 
 func (s *Submitter) submitPrice(pricesSubmission SignalPriceSubmission, keyID string) {
 	signalPrices, uuid := pricesSubmission.SignalPrices, pricesSubmission.UUID
+	start := time.Now()
+	success := false
 	defer func() {
+		metrics.ObserveSubmission(success, time.Since(start))
 		s.removePending(signalPrices)
 		s.idleKeyIDChannel <- keyID
+		metrics.SetKeys(len(s.idleKeyIDChannel), cap(s.idleKeyIDChannel))
 	}()
 
 	msg := types.MsgSubmitSignalPrices{
@@ -139,6 +146,7 @@ func (s *Submitter) submitPrice(pricesSubmission SignalPriceSubmission, keyID st
 			s.logger.Error("[Submitter] failed to broadcast: %v", err)
 			continue
 		}
+		metrics.IncTxResult("broadcast", txResp.Codespace, txResp.Code)
 
 		// if the transaction is out of gas, increase the gas adjustment
 		if txResp.Codespace == sdkerrors.RootCodespace && txResp.Code == sdkerrors.ErrOutOfGas.ABCICode() {
@@ -155,10 +163,12 @@ func (s *Submitter) submitPrice(pricesSubmission SignalPriceSubmission, keyID st
 			s.logger.Error("[Submitter] failed to get tx response: %v", err)
 			continue
 		}
+		metrics.IncTxResult("commit", finalizedTxResp.Codespace, finalizedTxResp.Code)
 
 		switch {
 		case finalizedTxResp.Code == 0:
 			s.logger.Info("[Submitter] price submitted at %v", finalizedTxResp.TxHash)
+			success = true
 			s.pushMonitoringRecords(uuid, finalizedTxResp.TxHash)
 			return
 		case finalizedTxResp.Codespace == sdkerrors.RootCodespace && finalizedTxResp.Code == sdkerrors.ErrOutOfGas.ABCICode():
@@ -173,6 +183,12 @@ func (s *Submitter) submitPrice(pricesSubmission SignalPriceSubmission, keyID st
 }
 
 func (s *Submitter) pushMonitoringRecords(uuid, txHash string) {
+	// Prices that are not from the primary Bothan have no monitoring records to push
+	if uuid == "" {
+		s.logger.Debug("[Submitter] no monitoring uuid, skipping push")
+		return
+	}
+
 	bothanInfo, err := s.bothanClient.GetInfo()
 	if err != nil {
 		s.logger.Error("[Submitter] failed to query Bothan info: %v", err)
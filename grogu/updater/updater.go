@@ -6,6 +6,7 @@ import (
 
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 
+	"github.com/bandprotocol/chain/v3/grogu/metrics"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 )
 
@@ -47,6 +48,7 @@ func (u *Updater) checkAndUpdateBothan() {
 	chainConfig, err := u.feedQuerier.QueryReferenceSourceConfig()
 	if err != nil {
 		u.logger.Error("[Updater] failed to query chain config: %v", err)
+		metrics.IncRegistryCheck("chain_error")
 		return
 	}
 
@@ -54,17 +56,20 @@ func (u *Updater) checkAndUpdateBothan() {
 
 	if rfc.RegistryIPFSHash == "[NOT_SET]" || rfc.RegistryVersion == "[NOT_SET]" {
 		u.logger.Debug("[Updater] reference source config is not set, skipping update")
+		metrics.IncRegistryCheck("not_set")
 		return
 	}
 
 	bothanInfo, err := u.bothanClient.GetInfo()
 	if err != nil {
 		u.logger.Error("[Updater] failed to query Bothan info: %v", err)
+		metrics.IncRegistryCheck("bothan_error")
 		return
 	}
 
 	if rfc.RegistryIPFSHash == bothanInfo.RegistryIpfsHash {
 		u.logger.Debug("[Updater] chain and Bothan config match, skipping update")
+		metrics.IncRegistryCheck("match")
 		return
 	}
 
@@ -72,8 +77,10 @@ func (u *Updater) checkAndUpdateBothan() {
 	err = u.bothanClient.UpdateRegistry(rfc.RegistryIPFSHash, rfc.RegistryVersion)
 	if err != nil {
 		u.logger.Error("[Updater] failed to update registry: %v", err)
+		metrics.IncRegistryCheck("update_error")
 		return
 	}
 
 	u.logger.Info("[Updater] successfully updated registry with IPFS hash: %s", rfc.RegistryIPFSHash)
+	metrics.IncRegistryCheck("updated")
 }
@@ -8,17 +8,26 @@ import (
 	"github.com/peterbourgon/diskv"
 )
 
+// DefaultCacheSizeMax is the default maximum size (in bytes) of the in-memory cache of the files.
+const DefaultCacheSizeMax = uint64(32 * 1024 * 1024) // 32MB
+
 type Cache struct {
 	fileCache *diskv.Diskv
 }
 
-// New creates and returns a new file-backed data caching instance.
+// New creates and returns a new file-backed data caching instance with the default in-memory cache size.
 func New(basePath string) Cache {
+	return NewWithCacheSize(basePath, DefaultCacheSizeMax)
+}
+
+// NewWithCacheSize creates and returns a new file-backed data caching instance that keeps up to
+// cacheSizeMax bytes of the files in memory.
+func NewWithCacheSize(basePath string, cacheSizeMax uint64) Cache {
 	return Cache{
 		fileCache: diskv.New(diskv.Options{
 			BasePath:     basePath,
 			Transform:    func(s string) []string { return []string{} },
-			CacheSizeMax: 32 * 1024 * 1024, // 32MB TODO: Make this configurable
+			CacheSizeMax: cacheSizeMax,
 		}),
 	}
 }
@@ -49,6 +58,25 @@ func (c Cache) GetFile(filename string) ([]byte, error) {
 	return data, nil
 }
 
+// HasFile checks if the file exists in the file storage.
+func (c Cache) HasFile(filename string) bool {
+	return c.fileCache.Has(filename)
+}
+
+// RemoveFile removes the file from the file storage. Returns error if the file does not exist.
+func (c Cache) RemoveFile(filename string) error {
+	return c.fileCache.Erase(filename)
+}
+
+// GetFilenames returns the names of all files in the file storage.
+func (c Cache) GetFilenames() []string {
+	var filenames []string
+	for filename := range c.fileCache.Keys(nil) {
+		filenames = append(filenames, filename)
+	}
+	return filenames
+}
+
 // MustGetFile loads the file from the file storage. Panics if the file does not exist.
 func (c Cache) MustGetFile(filename string) []byte {
 	data, err := c.GetFile(filename)
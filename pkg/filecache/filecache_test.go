@@ -143,3 +143,29 @@ func TestGetFileInconsistentContent(t *testing.T) {
 	_, err = f.GetFile(filename)
 	require.Error(t, err)
 }
+
+func TestRemoveFile(t *testing.T) {
+	dir := t.TempDir()
+
+	f := filecache.New(dir)
+	filename := f.AddFile([]byte("BAND"))
+	require.True(t, f.HasFile(filename))
+
+	require.NoError(t, f.RemoveFile(filename))
+	require.False(t, f.HasFile(filename))
+	_, err := os.Stat(filepath.Join(dir, filename))
+	require.True(t, os.IsNotExist(err))
+
+	require.Error(t, f.RemoveFile(filename))
+}
+
+func TestGetFilenames(t *testing.T) {
+	dir := t.TempDir()
+
+	f := filecache.NewWithCacheSize(dir, 0)
+	require.Empty(t, f.GetFilenames())
+
+	filename1 := f.AddFile([]byte("BAND"))
+	filename2 := f.AddFile([]byte("HELLO_WORLD"))
+	require.ElementsMatch(t, []string{filename1, filename2}, f.GetFilenames())
+}
@@ -64,5 +64,5 @@ bandd tx tss add-grantees $(cylinder keys list -a --home $HOME_PATH) --gas-price
 
 sleep 6
 
-# run cylinder
-cylinder run --home $HOME_PATH
+# run cylinder with a passphrase for the local store
+CYLINDER_PASSPHRASE="${CYLINDER_PASSPHRASE:-localnet-passphrase}" cylinder run --home $HOME_PATH
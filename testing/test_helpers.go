@@ -38,6 +38,7 @@ import (
 	band "github.com/bandprotocol/chain/v3/app"
 	"github.com/bandprotocol/chain/v3/pkg/filecache"
 	"github.com/bandprotocol/chain/v3/testing/testdata"
+	globalfeetypes "github.com/bandprotocol/chain/v3/x/globalfee/types"
 	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
 )
 
@@ -260,6 +261,10 @@ func GenesisStateWithValSet(app *band.BandApp, dir string) band.GenesisState {
 	oracleGenesis.OracleScripts = GenerateOracleScripts(dir)
 	genesisState[oracletypes.ModuleName] = app.AppCodec().MustMarshalJSON(oracleGenesis)
 
+	// Txs of tests are sent without fees, so no global minimum gas prices are required
+	globalfeeGenesis := globalfeetypes.DefaultGenesisState()
+	genesisState[globalfeetypes.ModuleName] = app.AppCodec().MustMarshalJSON(globalfeeGenesis)
+
 	return genesisState
 }
 
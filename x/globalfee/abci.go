@@ -0,0 +1,19 @@
+package globalfee
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/globalfee/keeper"
+	"github.com/bandprotocol/chain/v3/x/globalfee/types"
+)
+
+// EndBlocker is a handler function for the EndBlock ABCI request.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker)
+
+	// adjust the base gas price of the next block from the gas used by this block
+	k.UpdateBaseGasPrice(ctx)
+
+	return nil
+}
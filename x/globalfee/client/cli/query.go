@@ -19,6 +19,8 @@ func GetQueryCmd() *cobra.Command {
 	}
 	queryCmd.AddCommand(
 		GetQueryCmdParams(),
+		GetQueryCmdBaseGasPrice(),
+		GetQueryCmdBaseGasPriceHistory(),
 	)
 	return queryCmd
 }
@@ -46,3 +48,60 @@ func GetQueryCmdParams() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetQueryCmdBaseGasPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-gas-price",
+		Short: "Show the base gas price",
+		Long:  "Show the base gas price of the fee market that txs of the next block have to pay",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BaseGasPrice(cmd.Context(), &types.QueryBaseGasPriceRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetQueryCmdBaseGasPriceHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-gas-price-history",
+		Short: "Show the base gas price history",
+		Long:  "Show the base gas prices and the gas used of the recent blocks",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BaseGasPriceHistory(
+				cmd.Context(),
+				&types.QueryBaseGasPriceHistoryRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "base-gas-price-history")
+	return cmd
+}
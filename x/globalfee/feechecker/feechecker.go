@@ -4,6 +4,7 @@ import (
 	"math"
 
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,6 +17,7 @@ import (
 	feedskeeper "github.com/bandprotocol/chain/v3/x/feeds/keeper"
 	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 	"github.com/bandprotocol/chain/v3/x/globalfee/keeper"
+	"github.com/bandprotocol/chain/v3/x/globalfee/types"
 	oraclekeeper "github.com/bandprotocol/chain/v3/x/oracle/keeper"
 	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
 	tsskeeper "github.com/bandprotocol/chain/v3/x/tss/keeper"
@@ -76,31 +78,38 @@ func (fc FeeChecker) CheckTxFee(
 
 	feeCoins := feeTx.GetFee()
 	gas := feeTx.GetGas()
-	bondDenom, err := fc.StakingKeeper.BondDenom(ctx)
+
+	// With the fee market enabled, txs have to pay the base gas price in its denom and are prioritized by the
+	// tip above it.
+	priorityDenom, baseGasPrice, err := fc.getBaseGasPrice(ctx)
 	if err != nil {
 		return nil, 0, err
 	}
-	priority := getTxPriority(feeCoins, int64(gas), bondDenom)
+	priority := getTxPriority(feeCoins, int64(gas), priorityDenom, baseGasPrice)
 
-	// Ensure that the provided fees meet minimum-gas-prices and globalFees,
-	// if this is a CheckTx. This is only for local mempool purposes, and thus
-	// is only ran on check tx.
+	// Check if this tx should be free or not. The messages are executed again in DeliverTx, so checking them
+	// there must not consume the gas of the tx.
+	bypassCtx := ctx
 	if !ctx.IsCheckTx() {
-		return feeCoins, priority, nil
+		bypassCtx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
 	}
-
-	// Check if this tx should be free or not
-	if fc.IsBypassMinFeeTx(ctx, tx) {
+	if fc.IsBypassMinFeeTx(bypassCtx, tx) {
+		if !ctx.IsCheckTx() {
+			return feeCoins, priority, nil
+		}
 		return sdk.Coins{}, int64(math.MaxInt64), nil
 	}
 
-	minGasPrices := getMinGasPrices(ctx)
-	globalMinGasPrices, err := fc.GetGlobalMinGasPrices(ctx)
+	// Ensure that the provided fees meet the global fees and the base gas price. The node's own
+	// minimum-gas-prices are only for local mempool purposes, and thus are only required on check tx.
+	allGasPrices, err := fc.GetTxMinGasPrices(ctx, tx.GetMsgs())
 	if err != nil {
 		return nil, 0, err
 	}
-
-	allGasPrices := CombinedGasPricesRequirement(minGasPrices, globalMinGasPrices)
+	if ctx.IsCheckTx() {
+		allGasPrices = CombinedGasPricesRequirement(getMinGasPrices(ctx), allGasPrices)
+	}
+	allGasPrices = applyBaseGasPrice(allGasPrices, priorityDenom, baseGasPrice)
 
 	// Calculate all fees from all gas prices
 	var allFees sdk.Coins
@@ -128,10 +137,16 @@ func (fc FeeChecker) CheckTxFee(
 // IsBypassMinFeeTx checks whether tx is min fee bypassable.
 func (fc FeeChecker) IsBypassMinFeeTx(ctx sdk.Context, tx sdk.Tx) bool {
 	newCtx, _ := ctx.CacheContext()
+	params := fc.GlobalfeeKeeper.GetParams(ctx)
+
+	var gas uint64
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		gas = feeTx.GetGas()
+	}
 
 	// Check if all messages are free
 	for _, msg := range tx.GetMsgs() {
-		if !fc.IsBypassMinFeeMsg(newCtx, msg) {
+		if !fc.isBypassMinFeeMsg(newCtx, params, msg, gas) {
 			return false
 		}
 	}
@@ -141,6 +156,21 @@ func (fc FeeChecker) IsBypassMinFeeTx(ctx sdk.Context, tx sdk.Tx) bool {
 
 // IsBypassMinFeeMsg checks whether msg is min fee bypassable.
 func (fc FeeChecker) IsBypassMinFeeMsg(ctx sdk.Context, msg sdk.Msg) bool {
+	return fc.isBypassMinFeeMsg(ctx, fc.GlobalfeeKeeper.GetParams(ctx), msg, 0)
+}
+
+// isBypassMinFeeMsg checks whether msg is min fee bypassable according to the msg fee rules of the given params
+// in a tx with the given gas. A zero gas skips the max bypass gas check.
+func (fc FeeChecker) isBypassMinFeeMsg(ctx sdk.Context, params types.Params, msg sdk.Msg, gas uint64) bool {
+	rule, ok := params.GetMsgFeeRule(sdk.MsgTypeURL(msg))
+	if !ok || !rule.Bypass {
+		return false
+	}
+	if rule.MaxBypassGas != 0 && gas > rule.MaxBypassGas {
+		return false
+	}
+
+	// Check that the message is valid, so that invalid messages cannot be sent for free.
 	switch msg := msg.(type) {
 	case *oracletypes.MsgReportData:
 		if err := checkValidMsgReport(ctx, fc.OracleKeeper, msg); err != nil {
@@ -214,17 +244,90 @@ func (fc FeeChecker) IsBypassMinFeeMsg(ctx sdk.Context, msg sdk.Msg) bool {
 			}
 
 			// Check if this message should be free or not.
-			if !fc.IsBypassMinFeeMsg(ctx, m) {
+			if !fc.isBypassMinFeeMsg(ctx, params, m, gas) {
 				return false
 			}
 		}
 	default:
+		// Messages that cannot be validated here are never free, even if a rule exempts them.
 		return false
 	}
 
 	return true
 }
 
+// GetTxMinGasPrices returns the minimum gas prices of a tx with the given messages. Each message requires the
+// minimum gas prices of its msg fee rule if set, or the global min gas prices otherwise. The tx has to pay the
+// highest price among its messages in a denom that all of them accept; messages that are free to send do not
+// restrict the denoms.
+func (fc FeeChecker) GetTxMinGasPrices(ctx sdk.Context, msgs []sdk.Msg) (sdk.DecCoins, error) {
+	globalMinGasPrices, err := fc.GetGlobalMinGasPrices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := fc.GlobalfeeKeeper.GetParams(ctx)
+
+	var txMinGasPrices sdk.DecCoins
+	for _, msg := range flattenMsgs(msgs) {
+		msgMinGasPrices := globalMinGasPrices
+		if rule, ok := params.GetMsgFeeRule(sdk.MsgTypeURL(msg)); ok && !rule.MinimumGasPrices.Empty() {
+			msgMinGasPrices = rule.MinimumGasPrices.Sort()
+		}
+
+		if msgMinGasPrices.IsZero() {
+			continue
+		}
+		if txMinGasPrices == nil {
+			txMinGasPrices = msgMinGasPrices
+			continue
+		}
+
+		txMinGasPrices = MaxGasPricesOfCommonDenoms(txMinGasPrices, msgMinGasPrices)
+		if txMinGasPrices.Empty() {
+			return nil, sdkerrors.ErrInsufficientFee.Wrap("messages of the tx accept no common fee denom")
+		}
+	}
+
+	// all messages are free to send
+	if txMinGasPrices == nil {
+		return globalMinGasPrices, nil
+	}
+
+	return txMinGasPrices, nil
+}
+
+// GetTxRequiredGasPrices returns the gas prices that a tx with the given messages is required to pay, which are
+// its minimum gas prices raised to the base gas price. The node's own minimum gas prices are not included.
+func (fc FeeChecker) GetTxRequiredGasPrices(ctx sdk.Context, msgs []sdk.Msg) (sdk.DecCoins, error) {
+	denom, baseGasPrice, err := fc.getBaseGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txMinGasPrices, err := fc.GetTxMinGasPrices(ctx, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return applyBaseGasPrice(txMinGasPrices, denom, baseGasPrice), nil
+}
+
+// getBaseGasPrice returns the denom and the amount of the base gas price. The base gas price is zero in the bond
+// denom if the fee market is disabled.
+func (fc FeeChecker) getBaseGasPrice(ctx sdk.Context) (string, sdkmath.LegacyDec, error) {
+	if feeMarket := fc.GlobalfeeKeeper.GetParams(ctx).FeeMarket; feeMarket.GetEnabled() {
+		return feeMarket.Denom, fc.GlobalfeeKeeper.GetBaseGasPrice(ctx), nil
+	}
+
+	bondDenom, err := fc.StakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return "", sdkmath.LegacyDec{}, err
+	}
+
+	return bondDenom, sdkmath.LegacyZeroDec(), nil
+}
+
 // GetGlobalMinGasPrices returns global min gas prices
 func (fc FeeChecker) GetGlobalMinGasPrices(ctx sdk.Context) (sdk.DecCoins, error) {
 	globalMinGasPrices := fc.GlobalfeeKeeper.GetParams(ctx).MinimumGasPrices
@@ -24,6 +24,7 @@ import (
 	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
 	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
 	"github.com/bandprotocol/chain/v3/x/globalfee/feechecker"
+	globalfeetypes "github.com/bandprotocol/chain/v3/x/globalfee/types"
 	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
 	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
 )
@@ -787,6 +788,326 @@ func (suite *FeeCheckerTestSuite) TestIsBypassMinFeeTxAndCheckTxFee() {
 	}
 }
 
+func (suite *FeeCheckerTestSuite) TestMsgFeeRules() {
+	requestMsg := oracletypes.NewMsgRequestData(
+		1,
+		BasicCalldata,
+		1,
+		1,
+		BasicClientID,
+		bandtesting.Coins100000000uband,
+		bandtesting.TestDefaultPrepareGas,
+		bandtesting.TestDefaultExecuteGas,
+		bandtesting.FeePayer.Address,
+		0,
+	)
+	reportMsg := oracletypes.NewMsgReportData(
+		suite.requestID,
+		[]oracletypes.RawReport{},
+		bandtesting.Validators[0].ValAddress,
+	)
+	requestTypeURL := sdk.MsgTypeURL(requestMsg)
+	reportTypeURL := sdk.MsgTypeURL(reportMsg)
+
+	testCases := []struct {
+		name                string
+		rules               []globalfeetypes.MsgFeeRule
+		msgs                []sdk.Msg
+		gasPrices           sdk.DecCoins
+		expIsBypassMinFeeTx bool
+		expErr              error
+		expParamsErr        bool
+	}{
+		{
+			name:         "message type without a validator cannot be exempt by rule",
+			rules:        []globalfeetypes.MsgFeeRule{globalfeetypes.NewMsgFeeRule(requestTypeURL, nil, true, 0)},
+			expParamsErr: true,
+		},
+		{
+			name: "exempt message type within max bypass gas",
+			rules: []globalfeetypes.MsgFeeRule{
+				globalfeetypes.NewMsgFeeRule(reportTypeURL, nil, true, 1000000),
+			},
+			msgs:                []sdk.Msg{reportMsg},
+			expIsBypassMinFeeTx: true,
+		},
+		{
+			name: "exempt message type exceeding max bypass gas",
+			rules: []globalfeetypes.MsgFeeRule{
+				globalfeetypes.NewMsgFeeRule(reportTypeURL, nil, true, 999999),
+			},
+			msgs:                []sdk.Msg{reportMsg},
+			expIsBypassMinFeeTx: false,
+			expErr:              sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:                "valid report is not exempt without rule",
+			rules:               []globalfeetypes.MsgFeeRule{},
+			msgs:                []sdk.Msg{reportMsg},
+			expIsBypassMinFeeTx: false,
+			expErr:              sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name: "message type with higher minimum gas prices",
+			rules: []globalfeetypes.MsgFeeRule{
+				globalfeetypes.NewMsgFeeRule(
+					requestTypeURL,
+					sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(1, 2))),
+					false,
+					0,
+				),
+			},
+			msgs:                []sdk.Msg{requestMsg},
+			gasPrices:           sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(5, 3))),
+			expIsBypassMinFeeTx: false,
+			expErr:              sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name: "message type with higher minimum gas prices and enough fee",
+			rules: []globalfeetypes.MsgFeeRule{
+				globalfeetypes.NewMsgFeeRule(
+					requestTypeURL,
+					sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(1, 2))),
+					false,
+					0,
+				),
+			},
+			msgs:                []sdk.Msg{requestMsg},
+			gasPrices:           sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(1, 2))),
+			expIsBypassMinFeeTx: false,
+		},
+		{
+			name: "message type with minimum gas prices in another denom",
+			rules: []globalfeetypes.MsgFeeRule{
+				globalfeetypes.NewMsgFeeRule(
+					requestTypeURL,
+					sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdkmath.LegacyNewDecWithPrec(1, 2))),
+					false,
+					0,
+				),
+			},
+			msgs:                []sdk.Msg{requestMsg, reportMsg},
+			gasPrices:           sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(1, 2))),
+			expIsBypassMinFeeTx: false,
+			expErr:              sdkerrors.ErrInsufficientFee,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			err := suite.FeeChecker.GlobalfeeKeeper.SetParams(ctx, globalfeetypes.NewParams(
+				sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(25, 4))),
+				tc.rules,
+				globalfeetypes.DefaultFeeMarketParams(),
+				nil,
+				0,
+			))
+			if tc.expParamsErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			stubTx := &StubTx{Msgs: tc.msgs, GasPrices: tc.gasPrices}
+
+			suite.Require().Equal(tc.expIsBypassMinFeeTx, suite.FeeChecker.IsBypassMinFeeTx(ctx, stubTx))
+
+			_, _, err = suite.FeeChecker.CheckTxFee(ctx, stubTx)
+			suite.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+}
+
+func (suite *FeeCheckerTestSuite) TestFeeMarket() {
+	requestMsg := oracletypes.NewMsgRequestData(
+		1,
+		BasicCalldata,
+		1,
+		1,
+		BasicClientID,
+		bandtesting.Coins100000000uband,
+		bandtesting.TestDefaultPrepareGas,
+		bandtesting.TestDefaultExecuteGas,
+		bandtesting.FeePayer.Address,
+		0,
+	)
+	reportMsg := oracletypes.NewMsgReportData(
+		suite.requestID,
+		[]oracletypes.RawReport{},
+		bandtesting.Validators[0].ValAddress,
+	)
+
+	testCases := []struct {
+		name        string
+		enabled     bool
+		msgs        []sdk.Msg
+		gasPrices   sdk.DecCoins
+		expPriority int64
+		expErr      error
+	}{
+		{
+			name:        "disabled fee market requires the global min gas prices only",
+			enabled:     false,
+			msgs:        []sdk.Msg{requestMsg},
+			gasPrices:   sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(1, 2))),
+			expPriority: 100,
+		},
+		{
+			name:      "gas price below the base gas price",
+			enabled:   true,
+			msgs:      []sdk.Msg{requestMsg},
+			gasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(1, 2))),
+			expErr:    sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:        "gas price at the base gas price",
+			enabled:     true,
+			msgs:        []sdk.Msg{requestMsg},
+			gasPrices:   sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(2, 2))),
+			expPriority: 0,
+		},
+		{
+			name:        "priority from the tip above the base gas price",
+			enabled:     true,
+			msgs:        []sdk.Msg{requestMsg},
+			gasPrices:   sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(3, 2))),
+			expPriority: 100,
+		},
+		{
+			name:        "bypass message is not affected",
+			enabled:     true,
+			msgs:        []sdk.Msg{reportMsg},
+			expPriority: math.MaxInt64,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			feeMarket := globalfeetypes.NewFeeMarketParams(
+				tc.enabled,
+				"uband",
+				sdkmath.LegacyNewDecWithPrec(1, 2),
+				sdkmath.LegacyOneDec(),
+				1000000,
+				8,
+			)
+			err := suite.FeeChecker.GlobalfeeKeeper.SetParams(ctx, globalfeetypes.NewParams(
+				sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(25, 4))),
+				globalfeetypes.DefaultMsgFeeRules(),
+				&feeMarket,
+				nil,
+				0,
+			))
+			suite.Require().NoError(err)
+			suite.FeeChecker.GlobalfeeKeeper.SetBaseGasPrice(ctx, sdkmath.LegacyNewDecWithPrec(2, 2))
+
+			stubTx := &StubTx{Msgs: tc.msgs, GasPrices: tc.gasPrices}
+
+			_, priority, err := suite.FeeChecker.CheckTxFee(ctx, stubTx)
+			suite.Require().ErrorIs(err, tc.expErr)
+			if tc.expErr == nil {
+				suite.Require().Equal(tc.expPriority, priority)
+			}
+
+			// the base gas price is also enforced when the tx is delivered
+			_, _, err = suite.FeeChecker.CheckTxFee(ctx.WithIsCheckTx(false), stubTx)
+			suite.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+}
+
+func (suite *FeeCheckerTestSuite) TestMinGasPricesOfNodeOnlyInCheckTx() {
+	requestMsg := oracletypes.NewMsgRequestData(
+		1,
+		BasicCalldata,
+		1,
+		1,
+		BasicClientID,
+		bandtesting.Coins100000000uband,
+		bandtesting.TestDefaultPrepareGas,
+		bandtesting.TestDefaultExecuteGas,
+		bandtesting.FeePayer.Address,
+		0,
+	)
+
+	ctx, _ := suite.ctx.CacheContext()
+	err := suite.FeeChecker.GlobalfeeKeeper.SetParams(ctx, globalfeetypes.NewParams(
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(25, 4))),
+		globalfeetypes.DefaultMsgFeeRules(),
+		globalfeetypes.DefaultFeeMarketParams(),
+		nil,
+		0,
+	))
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(1, 1))))
+	stubTx := &StubTx{
+		Msgs:      []sdk.Msg{requestMsg},
+		GasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(1, 2))),
+	}
+
+	// the min gas prices of the node are only required by its mempool
+	_, _, err = suite.FeeChecker.CheckTxFee(ctx, stubTx)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	_, _, err = suite.FeeChecker.CheckTxFee(ctx.WithIsCheckTx(false), stubTx)
+	suite.Require().NoError(err)
+
+	// the global min gas prices are required in both
+	stubTx.GasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(1, 4)))
+	_, _, err = suite.FeeChecker.CheckTxFee(ctx.WithIsCheckTx(false), stubTx)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+}
+
+func (suite *FeeCheckerTestSuite) TestGetTxRequiredGasPrices() {
+	requestMsg := oracletypes.NewMsgRequestData(
+		1,
+		BasicCalldata,
+		1,
+		1,
+		BasicClientID,
+		bandtesting.Coins100000000uband,
+		bandtesting.TestDefaultPrepareGas,
+		bandtesting.TestDefaultExecuteGas,
+		bandtesting.FeePayer.Address,
+		0,
+	)
+
+	ctx, _ := suite.ctx.CacheContext()
+	feeMarket := globalfeetypes.NewFeeMarketParams(
+		true,
+		"uband",
+		sdkmath.LegacyNewDecWithPrec(1, 2),
+		sdkmath.LegacyOneDec(),
+		1000000,
+		8,
+	)
+	err := suite.FeeChecker.GlobalfeeKeeper.SetParams(ctx, globalfeetypes.NewParams(
+		sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec("uatom", sdkmath.LegacyNewDecWithPrec(1, 3)),
+			sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(25, 4)),
+		),
+		globalfeetypes.DefaultMsgFeeRules(),
+		&feeMarket,
+		nil,
+		0,
+	))
+	suite.Require().NoError(err)
+	suite.FeeChecker.GlobalfeeKeeper.SetBaseGasPrice(ctx, sdkmath.LegacyNewDecWithPrec(2, 2))
+
+	// the gas price in the denom of the base gas price is raised to the base gas price, while the min gas prices
+	// of the node are not required
+	ctx = ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(1, 1))))
+	gasPrices, err := suite.FeeChecker.GetTxRequiredGasPrices(ctx, []sdk.Msg{requestMsg})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("uatom", sdkmath.LegacyNewDecWithPrec(1, 3)),
+		sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(2, 2)),
+	), gasPrices)
+}
+
 func (suite *FeeCheckerTestSuite) TestDefaultZeroGlobalFee() {
 	coins, err := suite.FeeChecker.DefaultZeroGlobalFee(suite.ctx)
 
@@ -3,14 +3,17 @@ package feechecker
 import (
 	"math"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	oraclekeeper "github.com/bandprotocol/chain/v3/x/oracle/keeper"
 	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
 )
 
-// getTxPriority returns priority of the provided fee based on gas prices of uband
-func getTxPriority(fee sdk.Coins, gas int64, denom string) int64 {
+// getTxPriority returns priority of the provided fee based on gas prices of uband above the given base gas price
+func getTxPriority(fee sdk.Coins, gas int64, denom string, baseGasPrice sdkmath.LegacyDec) int64 {
 	ok, c := fee.Find(denom)
 	if !ok {
 		return 0
@@ -20,6 +23,12 @@ func getTxPriority(fee sdk.Coins, gas int64, denom string) int64 {
 	// otherwise, if gas_price < 1, the priority will be 0.
 	priority := int64(math.MaxInt64)
 	gasPrice := c.Amount.MulRaw(10000).QuoRaw(gas)
+	if baseGasPrice.IsPositive() {
+		gasPrice = gasPrice.Sub(baseGasPrice.MulInt64(10000).Ceil().TruncateInt())
+		if gasPrice.IsNegative() {
+			return 0
+		}
+	}
 	if gasPrice.IsInt64() {
 		priority = gasPrice.Int64()
 	}
@@ -58,6 +67,57 @@ func CombinedGasPricesRequirement(globalMinGasPrices, minGasPrices sdk.DecCoins)
 	return allGasPrices.Sort()
 }
 
+// applyBaseGasPrice raises the gas price of the given denom in gasPrices to at least the base gas price. Gas prices
+// that do not accept the denom are left unchanged.
+func applyBaseGasPrice(gasPrices sdk.DecCoins, denom string, baseGasPrice sdkmath.LegacyDec) sdk.DecCoins {
+	if !baseGasPrice.IsPositive() {
+		return gasPrices
+	}
+
+	applied := make(sdk.DecCoins, len(gasPrices))
+	for i, gp := range gasPrices {
+		applied[i] = gp
+		if gp.Denom == denom && gp.Amount.LT(baseGasPrice) {
+			applied[i] = sdk.NewDecCoinFromDec(denom, baseGasPrice)
+		}
+	}
+
+	return applied
+}
+
+// MaxGasPricesOfCommonDenoms returns the higher gas price of each denom found in both gasPricesA and gasPricesB.
+// Both gasPricesA and gasPricesB must be valid, i.e. without zero amounts.
+func MaxGasPricesOfCommonDenoms(gasPricesA, gasPricesB sdk.DecCoins) sdk.DecCoins {
+	var gasPrices sdk.DecCoins
+	for _, gpA := range gasPricesA {
+		amountB := gasPricesB.AmountOf(gpA.Denom)
+		if amountB.IsZero() {
+			continue
+		}
+		gasPrices = append(gasPrices, sdk.NewDecCoinFromDec(gpA.Denom, sdkmath.LegacyMaxDec(gpA.Amount, amountB)))
+	}
+
+	return gasPrices.Sort()
+}
+
+// flattenMsgs returns the given messages with the messages executed by authz MsgExec in place of it.
+func flattenMsgs(msgs []sdk.Msg) []sdk.Msg {
+	var flattened []sdk.Msg
+	for _, msg := range msgs {
+		flattened = append(flattened, msg)
+
+		if exec, ok := msg.(*authz.MsgExec); ok {
+			execMsgs, err := exec.GetMessages()
+			if err != nil {
+				continue
+			}
+			flattened = append(flattened, flattenMsgs(execMsgs)...)
+		}
+	}
+
+	return flattened
+}
+
 func checkValidMsgReport(ctx sdk.Context, oracleKeeper *oraclekeeper.Keeper, msg *oracletypes.MsgReportData) error {
 	validator, err := sdk.ValAddressFromBech32(msg.Validator)
 	if err != nil {
@@ -125,3 +125,38 @@ func (s *utilsTestSuite) TestCombinedGasPricesRequirement() {
 		})
 	}
 }
+
+func (s *utilsTestSuite) TestMaxGasPricesOfCommonDenoms() {
+	coin1 := sdk.NewDecCoin("photon", math.NewInt(1))
+	coin2 := sdk.NewDecCoin("stake", math.NewInt(2))
+	coin1High := sdk.NewDecCoin("photon", math.NewInt(10))
+	coinNewDenom := sdk.NewDecCoin("quark", math.NewInt(1))
+
+	tests := map[string]struct {
+		a        sdk.DecCoins
+		b        sdk.DecCoins
+		expected sdk.DecCoins
+	}{
+		"same denoms, higher prices": {
+			a:        sdk.DecCoins{coin1, coin2}.Sort(),
+			b:        sdk.DecCoins{coin1High, coin2}.Sort(),
+			expected: sdk.DecCoins{coin1High, coin2}.Sort(),
+		},
+		"some common denoms": {
+			a:        sdk.DecCoins{coin1High, coin2}.Sort(),
+			b:        sdk.DecCoins{coin1, coinNewDenom}.Sort(),
+			expected: sdk.DecCoins{coin1High},
+		},
+		"no common denom": {
+			a:        sdk.DecCoins{coin2},
+			b:        sdk.DecCoins{coin1, coinNewDenom}.Sort(),
+			expected: nil,
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.Require().Equal(test.expected, feechecker.MaxGasPricesOfCommonDenoms(test.a, test.b))
+		})
+	}
+}
@@ -7,12 +7,31 @@ import (
 	"github.com/stretchr/testify/require"
 
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/bandprotocol/chain/v3/x/globalfee/types"
 )
 
 func TestDefaultGenesis(t *testing.T) {
 	encCfg := moduletestutil.MakeTestEncodingConfig()
 	gotJSON := AppModuleBasic{}.DefaultGenesis(encCfg.Codec)
-	assert.JSONEq(t, `{"params":{"minimum_gas_prices":[]}}`, string(gotJSON))
+
+	var gotGenesis types.GenesisState
+	require.NoError(t, encCfg.Codec.UnmarshalJSON(gotJSON, &gotGenesis))
+	assert.Empty(t, gotGenesis.Params.MinimumGasPrices)
+	require.Len(t, gotGenesis.Params.MsgFeeRules, len(types.DefaultMsgFeeRules()))
+	for i, rule := range types.DefaultMsgFeeRules() {
+		assert.Equal(t, rule.MsgTypeURL, gotGenesis.Params.MsgFeeRules[i].MsgTypeURL)
+		assert.Equal(t, rule.Bypass, gotGenesis.Params.MsgFeeRules[i].Bypass)
+		assert.Empty(t, gotGenesis.Params.MsgFeeRules[i].MinimumGasPrices)
+	}
+
+	rule, ok := gotGenesis.Params.GetMsgFeeRule("/band.oracle.v1.MsgReportData")
+	require.True(t, ok)
+	assert.True(t, rule.Bypass)
+
+	assert.Equal(t, types.DefaultRefundableMsgTypeURLs(), gotGenesis.Params.RefundableMsgTypeURLs)
+	assert.True(t, gotGenesis.Params.IsRefundableMsgTypeURL("/band.oracle.v1.MsgReportData"))
+	assert.False(t, gotGenesis.Params.IsRefundableMsgTypeURL("/band.oracle.v1.MsgRequestData"))
 }
 
 func TestValidateGenesis(t *testing.T) {
@@ -50,6 +69,46 @@ func TestValidateGenesis(t *testing.T) {
 			src:    `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"},{"denom":"ZLX", "amount":"2"}]}}`,
 			expErr: false,
 		},
+		"msg fee rules": {
+			src: `{"params":{"msg_fee_rules":[` +
+				`{"msg_type_url":"/band.oracle.v1.MsgReportData","bypass":true,"max_bypass_gas":"500000"},` +
+				`{"msg_type_url":"/band.oracle.v1.MsgRequestData","minimum_gas_prices":[{"denom":"ALX","amount":"2"}]}` +
+				`]}}`,
+		},
+		"duplicate msg fee rules not allowed": {
+			src: `{"params":{"msg_fee_rules":[` +
+				`{"msg_type_url":"/band.oracle.v1.MsgReportData","bypass":true},` +
+				`{"msg_type_url":"/band.oracle.v1.MsgReportData","bypass":true}` +
+				`]}}`,
+			expErr: true,
+		},
+		"invalid msg type url not allowed": {
+			src:    `{"params":{"msg_fee_rules":[{"msg_type_url":"MsgReportData","bypass":true}]}}`,
+			expErr: true,
+		},
+		"max bypass gas without bypass not allowed": {
+			src: `{"params":{"msg_fee_rules":[` +
+				`{"msg_type_url":"/band.oracle.v1.MsgReportData","minimum_gas_prices":[{"denom":"ALX","amount":"2"}],` +
+				`"max_bypass_gas":"500000"}]}}`,
+			expErr: true,
+		},
+		"msg fee rule without effect not allowed": {
+			src:    `{"params":{"msg_fee_rules":[{"msg_type_url":"/band.oracle.v1.MsgReportData"}]}}`,
+			expErr: true,
+		},
+		"refundable msg type urls": {
+			src: `{"params":{"refundable_msg_type_urls":` +
+				`["/band.oracle.v1.MsgReportData","/band.feeds.v1beta1.MsgSubmitSignalPrices"]}}`,
+		},
+		"duplicate refundable msg type urls not allowed": {
+			src: `{"params":{"refundable_msg_type_urls":` +
+				`["/band.oracle.v1.MsgReportData","/band.oracle.v1.MsgReportData"]}}`,
+			expErr: true,
+		},
+		"invalid refundable msg type url not allowed": {
+			src:    `{"params":{"refundable_msg_type_urls":["MsgReportData"]}}`,
+			expErr: true,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
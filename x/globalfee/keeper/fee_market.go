@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/globalfee/types"
+)
+
+// SetBaseGasPrice sets the base gas price of the next block.
+func (k Keeper) SetBaseGasPrice(ctx sdk.Context, baseGasPrice sdkmath.LegacyDec) {
+	bz, err := baseGasPrice.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.BaseGasPriceKey, bz)
+}
+
+// GetBaseGasPrice returns the base gas price of the next block, bounded by the min and max base gas prices of
+// the fee market parameters. It is the min base gas price if the base gas price has never been set, and zero if
+// the fee market is not configured.
+func (k Keeper) GetBaseGasPrice(ctx sdk.Context) sdkmath.LegacyDec {
+	feeMarket := k.GetParams(ctx).FeeMarket
+	if feeMarket == nil {
+		return sdkmath.LegacyZeroDec()
+	}
+
+	bz := ctx.KVStore(k.storeKey).Get(types.BaseGasPriceKey)
+	if bz == nil {
+		return feeMarket.ClampBaseGasPrice(sdkmath.LegacyDec{})
+	}
+
+	var baseGasPrice sdkmath.LegacyDec
+	if err := baseGasPrice.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return feeMarket.ClampBaseGasPrice(baseGasPrice)
+}
+
+// SetBaseGasPriceRecord sets the base gas price record of a block.
+func (k Keeper) SetBaseGasPriceRecord(ctx sdk.Context, record types.BaseGasPriceRecord) {
+	ctx.KVStore(k.storeKey).Set(types.BaseGasPriceRecordKey(record.Height), k.cdc.MustMarshal(&record))
+}
+
+// DeleteBaseGasPriceRecord deletes the base gas price record of the block at the given height.
+func (k Keeper) DeleteBaseGasPriceRecord(ctx sdk.Context, height int64) {
+	ctx.KVStore(k.storeKey).Delete(types.BaseGasPriceRecordKey(height))
+}
+
+// GetBaseGasPriceRecords returns the base gas price records of the recent blocks ordered by height.
+func (k Keeper) GetBaseGasPriceRecords(ctx sdk.Context) (records []types.BaseGasPriceRecord) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.BaseGasPriceRecordKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.BaseGasPriceRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// UpdateBaseGasPrice records the base gas price and the gas used of the current block and adjusts the base gas
+// price of the next block from them. It does nothing if the fee market is disabled.
+func (k Keeper) UpdateBaseGasPrice(ctx sdk.Context) {
+	feeMarket := k.GetParams(ctx).FeeMarket
+	if !feeMarket.GetEnabled() {
+		return
+	}
+
+	var blockGasUsed uint64
+	if blockGasMeter := ctx.BlockGasMeter(); blockGasMeter != nil {
+		blockGasUsed = blockGasMeter.GasConsumedToLimit()
+	}
+
+	baseGasPrice := k.GetBaseGasPrice(ctx)
+	k.SetBaseGasPriceRecord(ctx, types.BaseGasPriceRecord{
+		Height:       ctx.BlockHeight(),
+		BaseGasPrice: baseGasPrice,
+		BlockGasUsed: blockGasUsed,
+	})
+	k.DeleteBaseGasPriceRecord(ctx, ctx.BlockHeight()-types.BaseGasPriceHistoryLength)
+
+	k.SetBaseGasPrice(ctx, feeMarket.NextBaseGasPrice(baseGasPrice, blockGasUsed))
+}
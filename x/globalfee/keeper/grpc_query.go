@@ -3,7 +3,13 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/prefix"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/bandprotocol/chain/v3/x/globalfee/types"
 )
@@ -22,3 +28,45 @@ func (q Querier) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*t
 		Params: q.GetParams(ctx),
 	}, nil
 }
+
+// BaseGasPrice returns the base gas price of the next block
+func (q Querier) BaseGasPrice(
+	stdCtx context.Context,
+	_ *types.QueryBaseGasPriceRequest,
+) (*types.QueryBaseGasPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return &types.QueryBaseGasPriceResponse{
+		Enabled:      q.GetParams(ctx).FeeMarket.GetEnabled(),
+		BaseGasPrice: q.GetBaseGasPrice(ctx),
+	}, nil
+}
+
+// BaseGasPriceHistory returns the base gas prices and the gas used of the recent blocks
+func (q Querier) BaseGasPriceHistory(
+	stdCtx context.Context,
+	req *types.QueryBaseGasPriceHistoryRequest,
+) (*types.QueryBaseGasPriceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	var records []types.BaseGasPriceRecord
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.BaseGasPriceRecordKeyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var record types.BaseGasPriceRecord
+		if err := q.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBaseGasPriceHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
@@ -77,3 +78,56 @@ func TestQueryParams(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryBaseGasPrice(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	q := keeper.Querier{Keeper: k}
+
+	// fee market not configured
+	gotResp, err := q.BaseGasPrice(ctx, &types.QueryBaseGasPriceRequest{})
+	require.NoError(t, err)
+	assert.False(t, gotResp.Enabled)
+	assert.Equal(t, math.LegacyZeroDec(), gotResp.BaseGasPrice)
+
+	feeMarket := types.NewFeeMarketParams(
+		true,
+		"uband",
+		math.LegacyNewDecWithPrec(1, 3),
+		math.LegacyNewDecWithPrec(1, 1),
+		1000,
+		8,
+	)
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", math.LegacyNewDecWithPrec(1, 3)))
+	require.NoError(t, k.SetParams(ctx, types.NewParams(minGasPrices, nil, &feeMarket, nil, 0)))
+	for height := int64(1); height <= 3; height++ {
+		blockCtx := ctx.WithBlockHeight(height).WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+		blockCtx.BlockGasMeter().ConsumeGas(2000, "txs")
+		k.UpdateBaseGasPrice(blockCtx)
+	}
+
+	gotResp, err = q.BaseGasPrice(ctx, &types.QueryBaseGasPriceRequest{})
+	require.NoError(t, err)
+	assert.True(t, gotResp.Enabled)
+	assert.Equal(t, math.LegacyMustNewDecFromStr("0.001423828125"), gotResp.BaseGasPrice)
+
+	gotHistory, err := q.BaseGasPriceHistory(ctx, &types.QueryBaseGasPriceHistoryRequest{
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []types.BaseGasPriceRecord{
+		{Height: 1, BaseGasPrice: math.LegacyNewDecWithPrec(1, 3), BlockGasUsed: 2000},
+		{Height: 2, BaseGasPrice: math.LegacyNewDecWithPrec(1125, 6), BlockGasUsed: 2000},
+	}, gotHistory.Records)
+	require.NotNil(t, gotHistory.Pagination.NextKey)
+
+	_, err = q.BaseGasPriceHistory(ctx, nil)
+	require.Error(t, err)
+}
@@ -149,3 +149,53 @@ func (s *IntegrationTestSuite) TestParams() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestUpdateBaseGasPrice() {
+	ctx := s.ctx.WithBlockHeight(10).WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+
+	// the fee market is disabled by default
+	s.globalfeeKeeper.UpdateBaseGasPrice(ctx)
+	s.Require().Empty(s.globalfeeKeeper.GetBaseGasPriceRecords(ctx))
+
+	feeMarket := types.NewFeeMarketParams(
+		true,
+		"uband",
+		math.LegacyNewDecWithPrec(1, 3),
+		math.LegacyNewDecWithPrec(1, 1),
+		1000,
+		8,
+	)
+	params := types.DefaultParams()
+	params.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", math.LegacyNewDecWithPrec(1, 3)))
+	params.FeeMarket = &feeMarket
+	s.Require().NoError(s.globalfeeKeeper.SetParams(ctx, params))
+	s.Require().Equal(math.LegacyNewDecWithPrec(1, 3), s.globalfeeKeeper.GetBaseGasPrice(ctx))
+
+	// a full block raises the base gas price by 1/8
+	ctx.BlockGasMeter().ConsumeGas(2000, "txs")
+	s.globalfeeKeeper.UpdateBaseGasPrice(ctx)
+	s.Require().Equal(math.LegacyNewDecWithPrec(1125, 6), s.globalfeeKeeper.GetBaseGasPrice(ctx))
+
+	// an empty block lowers it back, bounded by the min base gas price
+	ctx = ctx.WithBlockHeight(11).WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+	s.globalfeeKeeper.UpdateBaseGasPrice(ctx)
+	s.Require().Equal(math.LegacyNewDecWithPrec(1, 3), s.globalfeeKeeper.GetBaseGasPrice(ctx))
+
+	s.Require().Equal([]types.BaseGasPriceRecord{
+		{Height: 10, BaseGasPrice: math.LegacyNewDecWithPrec(1, 3), BlockGasUsed: 2000},
+		{Height: 11, BaseGasPrice: math.LegacyNewDecWithPrec(1125, 6), BlockGasUsed: 0},
+	}, s.globalfeeKeeper.GetBaseGasPriceRecords(ctx))
+
+	// records older than the history length are pruned
+	ctx = ctx.WithBlockHeight(10 + types.BaseGasPriceHistoryLength)
+	s.globalfeeKeeper.UpdateBaseGasPrice(ctx)
+	records := s.globalfeeKeeper.GetBaseGasPriceRecords(ctx)
+	s.Require().Len(records, 2)
+	s.Require().Equal(int64(11), records[0].Height)
+	s.Require().Equal(int64(10+types.BaseGasPriceHistoryLength), records[1].Height)
+
+	// the base gas price follows the bounds of the params
+	feeMarket.MinBaseGasPrice = math.LegacyNewDecWithPrec(2, 3)
+	s.Require().NoError(s.globalfeeKeeper.SetParams(ctx, params))
+	s.Require().Equal(math.LegacyNewDecWithPrec(2, 3), s.globalfeeKeeper.GetBaseGasPrice(ctx))
+}
@@ -0,0 +1,44 @@
+package v2
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/globalfee/types"
+)
+
+const (
+	ModuleName = "globalfee"
+)
+
+// Migrate migrates the x/globalfee module state from the consensus version 1 to
+// version 2. Specifically, it sets the default msg fee rules, which replace the
+// fee exemptions of the validators' messages that were hardcoded in version 1, and
+// the default fee market and gas refund params added in version 2.
+func Migrate(
+	ctx sdk.Context,
+	store storetypes.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	var currParams types.Params
+	if bz := store.Get(types.ParamsKeyPrefix); bz != nil {
+		if err := cdc.Unmarshal(bz, &currParams); err != nil {
+			return err
+		}
+	}
+
+	currParams.MsgFeeRules = types.DefaultMsgFeeRules()
+	currParams.FeeMarket = types.DefaultFeeMarketParams()
+	currParams.RefundableMsgTypeURLs = types.DefaultRefundableMsgTypeURLs()
+	currParams.MaxRefundableGas = types.DefaultMaxRefundableGas
+	if err := currParams.Validate(); err != nil {
+		return err
+	}
+
+	bz := cdc.MustMarshal(&currParams)
+	store.Set(types.ParamsKeyPrefix, bz)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/bandprotocol/chain/v3/x/globalfee"
+	v2 "github.com/bandprotocol/chain/v3/x/globalfee/migrations/v2"
+	"github.com/bandprotocol/chain/v3/x/globalfee/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(globalfee.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v2.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// the params of version 1 only have the minimum gas prices
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(25, 4)))
+	store.Set(types.ParamsKeyPrefix, cdc.MustMarshal(&types.Params{MinimumGasPrices: minGasPrices}))
+
+	require.NoError(t, v2.Migrate(ctx, store, cdc))
+
+	var res types.Params
+	bz := store.Get(types.ParamsKeyPrefix)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+	require.Equal(t, minGasPrices, res.MinimumGasPrices)
+	require.Equal(t, types.DefaultMsgFeeRules(), res.MsgFeeRules)
+	require.Equal(t, types.DefaultFeeMarketParams(), res.FeeMarket)
+	require.Equal(t, types.DefaultRefundableMsgTypeURLs(), res.RefundableMsgTypeURLs)
+	require.Equal(t, types.DefaultMaxRefundableGas, res.MaxRefundableGas)
+}
@@ -0,0 +1,156 @@
+package posthandler
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/bandprotocol/chain/v3/x/globalfee/keeper"
+	"github.com/bandprotocol/chain/v3/x/globalfee/types"
+)
+
+// TxRequiredGasPricesGetter returns the gas prices that a tx with the given messages is required to pay.
+type TxRequiredGasPricesGetter func(ctx sdk.Context, msgs []sdk.Msg) (sdk.DecCoins, error)
+
+// RefundDecorator refunds the fee of the unused gas of a successfully executed tx whose messages are all
+// refundable. The unused gas is refunded at the gas prices that the tx is required to pay, up to the fee paid,
+// and the refund is paid from the refund pool and is limited by its balance.
+type RefundDecorator struct {
+	globalfeeKeeper        *keeper.Keeper
+	bankKeeper             types.BankKeeper
+	getTxRequiredGasPrices TxRequiredGasPricesGetter
+}
+
+// NewRefundDecorator creates a new RefundDecorator instance.
+func NewRefundDecorator(
+	globalfeeKeeper *keeper.Keeper,
+	bankKeeper types.BankKeeper,
+	getTxRequiredGasPrices TxRequiredGasPricesGetter,
+) RefundDecorator {
+	return RefundDecorator{
+		globalfeeKeeper:        globalfeeKeeper,
+		bankKeeper:             bankKeeper,
+		getTxRequiredGasPrices: getTxRequiredGasPrices,
+	}
+}
+
+// PostHandle implements sdk.PostDecorator.
+func (rd RefundDecorator) PostHandle(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	simulate bool,
+	success bool,
+	next sdk.PostHandler,
+) (sdk.Context, error) {
+	// fees are only paid for real when the tx is delivered.
+	if !success || simulate || ctx.IsCheckTx() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	if err := rd.refundFee(ctx, tx); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// refundFee refunds the fee of the unused gas of the tx to whoever paid the fee if the tx is refundable.
+func (rd RefundDecorator) refundFee(ctx sdk.Context, tx sdk.Tx) error {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil
+	}
+
+	fee := feeTx.GetFee()
+	if fee.IsZero() {
+		return nil
+	}
+
+	// the refund is not charged to the gas of the tx, so that it cannot make the tx run out of gas.
+	gasUsed := ctx.GasMeter().GasConsumed()
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	params := rd.globalfeeKeeper.GetParams(ctx)
+	for _, msg := range tx.GetMsgs() {
+		if !isRefundableMsg(params, msg) {
+			return nil
+		}
+	}
+
+	// only the unused gas below the max refundable gas is refunded, so that an inflated gas limit cannot drain
+	// the refund pool.
+	refundableGas := min(feeTx.GetGas(), params.MaxRefundableGas)
+	if gasUsed >= refundableGas {
+		return nil
+	}
+
+	gasPrices, err := rd.getTxRequiredGasPrices(ctx, tx.GetMsgs())
+	if err != nil {
+		return err
+	}
+
+	refund := getRefund(fee, gasPrices, refundableGas-gasUsed)
+	refund = refund.Min(rd.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.RefundPoolName)))
+	if refund.IsZero() {
+		return nil
+	}
+
+	refundee := sdk.AccAddress(feeTx.FeePayer())
+	if feeGranter := feeTx.FeeGranter(); feeGranter != nil {
+		refundee = feeGranter
+	}
+
+	if err := rd.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RefundPoolName, refundee, refund); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRefundFee,
+		sdk.NewAttribute(types.AttributeKeyRefundee, refundee.String()),
+		sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+	))
+
+	return nil
+}
+
+// getRefund returns the fee of the unused gas at the given gas prices, which is capped at the fee paid in each
+// denom, so that the tip above the gas prices is not refunded.
+func getRefund(fee sdk.Coins, gasPrices sdk.DecCoins, unusedGas uint64) sdk.Coins {
+	unusedGasDec := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(unusedGas))
+
+	refund := sdk.NewCoins()
+	for _, coin := range fee {
+		amount := gasPrices.AmountOf(coin.Denom).Mul(unusedGasDec).TruncateInt()
+		refund = refund.Add(sdk.NewCoin(coin.Denom, sdkmath.MinInt(amount, coin.Amount)))
+	}
+
+	return refund
+}
+
+// isRefundableMsg returns whether the message is refundable. An authz MsgExec is refundable if all of the
+// messages it executes are.
+func isRefundableMsg(params types.Params, msg sdk.Msg) bool {
+	exec, ok := msg.(*authz.MsgExec)
+	if !ok {
+		return params.IsRefundableMsgTypeURL(sdk.MsgTypeURL(msg))
+	}
+
+	msgs, err := exec.GetMessages()
+	if err != nil || len(msgs) == 0 {
+		return false
+	}
+
+	for _, m := range msgs {
+		if !isRefundableMsg(params, m) {
+			return false
+		}
+	}
+
+	return true
+}
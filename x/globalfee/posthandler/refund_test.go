@@ -0,0 +1,295 @@
+package posthandler_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	band "github.com/bandprotocol/chain/v3/app"
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	"github.com/bandprotocol/chain/v3/x/globalfee/feechecker"
+	"github.com/bandprotocol/chain/v3/x/globalfee/posthandler"
+	globalfeetypes "github.com/bandprotocol/chain/v3/x/globalfee/types"
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+type StubTx struct {
+	sdk.FeeTx
+	Msgs       []sdk.Msg
+	Fee        sdk.Coins
+	Gas        uint64
+	FeeGranted bool
+}
+
+func (st *StubTx) GetMsgs() []sdk.Msg {
+	return st.Msgs
+}
+
+func (st *StubTx) GetGas() uint64 {
+	return st.Gas
+}
+
+func (st *StubTx) GetFee() sdk.Coins {
+	return st.Fee
+}
+
+func (st *StubTx) FeePayer() []byte {
+	return bandtesting.FeePayer.Address
+}
+
+func (st *StubTx) FeeGranter() []byte {
+	if st.FeeGranted {
+		return bandtesting.Bob.Address
+	}
+	return nil
+}
+
+type RefundDecoratorTestSuite struct {
+	suite.Suite
+
+	app        *band.BandApp
+	ctx        sdk.Context
+	feeChecker feechecker.FeeChecker
+}
+
+func TestRefundDecoratorTestSuite(t *testing.T) {
+	suite.Run(t, new(RefundDecoratorTestSuite))
+}
+
+func (suite *RefundDecoratorTestSuite) SetupTest() {
+	dir := testutil.GetTempDir(suite.T())
+	suite.app = bandtesting.SetupWithCustomHome(false, dir)
+	_, err := suite.app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: suite.app.LastBlockHeight() + 1})
+	suite.Require().NoError(err)
+	_, err = suite.app.Commit()
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.app.BaseApp.NewUncachedContext(false, cmtproto.Header{})
+
+	// the unused gas is refunded at the min gas price of 0.01uband up to the gas limit of 200000
+	err = suite.app.GlobalFeeKeeper.SetParams(suite.ctx, globalfeetypes.NewParams(
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", sdkmath.LegacyNewDecWithPrec(1, 2))),
+		globalfeetypes.DefaultMsgFeeRules(),
+		globalfeetypes.DefaultFeeMarketParams(),
+		globalfeetypes.DefaultRefundableMsgTypeURLs(),
+		200000,
+	))
+	suite.Require().NoError(err)
+
+	suite.feeChecker = feechecker.NewFeeChecker(
+		suite.app.AppCodec(),
+		&suite.app.AuthzKeeper,
+		&suite.app.OracleKeeper,
+		&suite.app.GlobalFeeKeeper,
+		suite.app.StakingKeeper,
+		suite.app.TSSKeeper,
+		&suite.app.BandtssKeeper,
+		&suite.app.FeedsKeeper,
+	)
+
+	err = suite.app.BankKeeper.SendCoinsFromAccountToModule(
+		suite.ctx,
+		bandtesting.FeePayer.Address,
+		globalfeetypes.RefundPoolName,
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 1000)),
+	)
+	suite.Require().NoError(err)
+}
+
+func (suite *RefundDecoratorTestSuite) TestRefundFee() {
+	reportMsg := oracletypes.NewMsgReportData(1, []oracletypes.RawReport{}, bandtesting.Validators[0].ValAddress)
+	signalPricesMsg := feedstypes.NewMsgSubmitSignalPrices(bandtesting.Validators[0].ValAddress.String(), 0, nil)
+	sendMsg := banktypes.NewMsgSend(
+		bandtesting.FeePayer.Address,
+		bandtesting.Alice.Address,
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 1)),
+	)
+	execReportMsg := authz.NewMsgExec(bandtesting.Alice.Address, []sdk.Msg{reportMsg})
+	execSendMsg := authz.NewMsgExec(bandtesting.Alice.Address, []sdk.Msg{sendMsg})
+
+	testCases := []struct {
+		name        string
+		msgs        []sdk.Msg
+		fee         int64
+		gasLimit    uint64
+		gasUsed     uint64
+		success     bool
+		feeGranted  bool
+		expRefund   int64
+		expRefundee sdk.AccAddress
+	}{
+		{
+			name:        "refund unused gas of refundable messages",
+			msgs:        []sdk.Msg{reportMsg, signalPricesMsg},
+			fee:         1000,
+			gasLimit:    100000,
+			gasUsed:     25000,
+			success:     true,
+			expRefund:   750,
+			expRefundee: bandtesting.FeePayer.Address,
+		},
+		{
+			name:        "refund unused gas of refundable messages executed by authz",
+			msgs:        []sdk.Msg{&execReportMsg},
+			fee:         1000,
+			gasLimit:    100000,
+			gasUsed:     50000,
+			success:     true,
+			expRefund:   500,
+			expRefundee: bandtesting.FeePayer.Address,
+		},
+		{
+			name:        "refund to fee granter",
+			msgs:        []sdk.Msg{reportMsg},
+			fee:         1000,
+			gasLimit:    100000,
+			gasUsed:     50000,
+			success:     true,
+			feeGranted:  true,
+			expRefund:   500,
+			expRefundee: bandtesting.Bob.Address,
+		},
+		{
+			name:        "refund of inflated fee limited by the min gas price",
+			msgs:        []sdk.Msg{reportMsg},
+			fee:         100000,
+			gasLimit:    100000,
+			gasUsed:     50000,
+			success:     true,
+			expRefund:   500,
+			expRefundee: bandtesting.FeePayer.Address,
+		},
+		{
+			name:        "refund limited by the fee paid",
+			msgs:        []sdk.Msg{reportMsg},
+			fee:         100,
+			gasLimit:    100000,
+			gasUsed:     50000,
+			success:     true,
+			expRefund:   100,
+			expRefundee: bandtesting.FeePayer.Address,
+		},
+		{
+			name:        "refund of inflated gas limit limited by the max refundable gas",
+			msgs:        []sdk.Msg{reportMsg},
+			fee:         10000,
+			gasLimit:    1000000,
+			gasUsed:     150000,
+			success:     true,
+			expRefund:   500,
+			expRefundee: bandtesting.FeePayer.Address,
+		},
+		{
+			name:        "refund limited by the refund pool",
+			msgs:        []sdk.Msg{reportMsg},
+			fee:         2000,
+			gasLimit:    200000,
+			gasUsed:     50000,
+			success:     true,
+			expRefund:   1000,
+			expRefundee: bandtesting.FeePayer.Address,
+		},
+		{
+			name:        "no refund for gas used above the max refundable gas",
+			msgs:        []sdk.Msg{reportMsg},
+			fee:         10000,
+			gasLimit:    1000000,
+			gasUsed:     250000,
+			success:     true,
+			expRefundee: bandtesting.FeePayer.Address,
+		},
+		{
+			name:        "no refund for failed tx",
+			msgs:        []sdk.Msg{reportMsg},
+			fee:         1000,
+			gasLimit:    100000,
+			gasUsed:     50000,
+			success:     false,
+			expRefundee: bandtesting.FeePayer.Address,
+		},
+		{
+			name:        "no refund with non-refundable message",
+			msgs:        []sdk.Msg{reportMsg, sendMsg},
+			fee:         1000,
+			gasLimit:    100000,
+			gasUsed:     50000,
+			success:     true,
+			expRefundee: bandtesting.FeePayer.Address,
+		},
+		{
+			name:        "no refund with non-refundable message executed by authz",
+			msgs:        []sdk.Msg{&execSendMsg},
+			fee:         1000,
+			gasLimit:    100000,
+			gasUsed:     50000,
+			success:     true,
+			expRefundee: bandtesting.FeePayer.Address,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			balance := suite.app.BankKeeper.GetBalance(ctx, tc.expRefundee, "uband")
+
+			ctx = ctx.WithGasMeter(storetypes.NewGasMeter(tc.gasLimit)).WithEventManager(sdk.NewEventManager())
+			ctx.GasMeter().ConsumeGas(tc.gasUsed, "msgs")
+
+			tx := &StubTx{
+				Msgs:       tc.msgs,
+				Fee:        sdk.NewCoins(sdk.NewInt64Coin("uband", tc.fee)),
+				Gas:        tc.gasLimit,
+				FeeGranted: tc.feeGranted,
+			}
+			postHandler := sdk.ChainPostDecorators(
+				posthandler.NewRefundDecorator(
+					&suite.app.GlobalFeeKeeper,
+					suite.app.BankKeeper,
+					suite.feeChecker.GetTxRequiredGasPrices,
+				),
+			)
+			_, err := postHandler(ctx, tx, false, tc.success)
+			suite.Require().NoError(err)
+			// the refund does not consume the gas of the tx
+			suite.Require().Equal(tc.gasUsed, ctx.GasMeter().GasConsumed())
+
+			suite.Require().Equal(
+				balance.AddAmount(sdkmath.NewInt(tc.expRefund)),
+				suite.app.BankKeeper.GetBalance(ctx, tc.expRefundee, "uband"),
+			)
+			suite.Require().Equal(
+				sdkmath.NewInt(1000-tc.expRefund),
+				suite.app.BankKeeper.GetBalance(
+					ctx,
+					authtypes.NewModuleAddress(globalfeetypes.RefundPoolName),
+					"uband",
+				).Amount,
+			)
+
+			if tc.expRefund == 0 {
+				suite.Require().Empty(ctx.EventManager().Events())
+			} else {
+				suite.Require().Contains(ctx.EventManager().Events(), sdk.NewEvent(
+					globalfeetypes.EventTypeRefundFee,
+					sdk.NewAttribute(globalfeetypes.AttributeKeyRefundee, tc.expRefundee.String()),
+					sdk.NewAttribute(globalfeetypes.AttributeKeyRefund, sdk.NewInt64Coin("uband", tc.expRefund).String()),
+					sdk.NewAttribute(globalfeetypes.AttributeKeyGasUsed, fmt.Sprintf("%d", tc.gasUsed)),
+				))
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package types
+
+const (
+	EventTypeRefundFee = "refund_fee"
+
+	AttributeKeyRefundee = "refundee"
+	AttributeKeyRefund   = "refund"
+	AttributeKeyGasUsed  = "gas_used"
+)
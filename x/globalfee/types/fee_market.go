@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	DefaultBaseGasPriceDenom             = "uband"
+	DefaultMinBaseGasPrice               = sdkmath.LegacyNewDecWithPrec(25, 4)
+	DefaultMaxBaseGasPrice               = sdkmath.LegacyNewDecWithPrec(25, 2)
+	DefaultTargetBlockGas                = uint64(25_000_000)
+	DefaultBaseGasPriceChangeDenominator = uint64(8)
+)
+
+// NewFeeMarketParams returns FeeMarketParams instance with the given values.
+func NewFeeMarketParams(
+	enabled bool,
+	denom string,
+	minBaseGasPrice sdkmath.LegacyDec,
+	maxBaseGasPrice sdkmath.LegacyDec,
+	targetBlockGas uint64,
+	baseGasPriceChangeDenominator uint64,
+) FeeMarketParams {
+	return FeeMarketParams{
+		Enabled:                       enabled,
+		MinBaseGasPrice:               minBaseGasPrice,
+		MaxBaseGasPrice:               maxBaseGasPrice,
+		TargetBlockGas:                targetBlockGas,
+		BaseGasPriceChangeDenominator: baseGasPriceChangeDenominator,
+		Denom:                         denom,
+	}
+}
+
+// DefaultFeeMarketParams returns the default fee market parameters, which keep the fee market disabled.
+func DefaultFeeMarketParams() *FeeMarketParams {
+	feeMarket := NewFeeMarketParams(
+		false,
+		DefaultBaseGasPriceDenom,
+		DefaultMinBaseGasPrice,
+		DefaultMaxBaseGasPrice,
+		DefaultTargetBlockGas,
+		DefaultBaseGasPriceChangeDenominator,
+	)
+	return &feeMarket
+}
+
+// Validate does the sanity check on the fee market parameters. The parameters are only checked if the fee
+// market is enabled.
+func (p FeeMarketParams) Validate() error {
+	if !p.Enabled {
+		return nil
+	}
+
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return fmt.Errorf("invalid base gas price denom: %w", err)
+	}
+	if p.MinBaseGasPrice.IsNil() || !p.MinBaseGasPrice.IsPositive() {
+		return fmt.Errorf("min base gas price must be positive: %s", p.MinBaseGasPrice)
+	}
+	if p.MaxBaseGasPrice.IsNil() || p.MaxBaseGasPrice.LT(p.MinBaseGasPrice) {
+		return fmt.Errorf(
+			"max base gas price must not be less than min base gas price: %s < %s",
+			p.MaxBaseGasPrice,
+			p.MinBaseGasPrice,
+		)
+	}
+	if p.TargetBlockGas == 0 {
+		return fmt.Errorf("target block gas must be positive")
+	}
+	if p.BaseGasPriceChangeDenominator == 0 {
+		return fmt.Errorf("base gas price change denominator must be positive")
+	}
+
+	return nil
+}
+
+// ClampBaseGasPrice returns the given base gas price bounded by the min and max base gas prices.
+func (p FeeMarketParams) ClampBaseGasPrice(baseGasPrice sdkmath.LegacyDec) sdkmath.LegacyDec {
+	if baseGasPrice.IsNil() || baseGasPrice.LT(p.MinBaseGasPrice) {
+		return p.MinBaseGasPrice
+	}
+	if baseGasPrice.GT(p.MaxBaseGasPrice) {
+		return p.MaxBaseGasPrice
+	}
+
+	return baseGasPrice
+}
+
+// NextBaseGasPrice returns the base gas price of the next block given the base gas price and the gas used of
+// the current block. The price rises when the block uses more gas than the target and falls when it uses less,
+// by at most 1/BaseGasPriceChangeDenominator of it per block, and stays within the min and max base gas prices.
+func (p FeeMarketParams) NextBaseGasPrice(baseGasPrice sdkmath.LegacyDec, blockGasUsed uint64) sdkmath.LegacyDec {
+	baseGasPrice = p.ClampBaseGasPrice(baseGasPrice)
+
+	target := sdkmath.NewIntFromUint64(p.TargetBlockGas)
+	gasUsedDelta := sdkmath.NewIntFromUint64(blockGasUsed).Sub(target)
+	change := baseGasPrice.MulInt(gasUsedDelta).
+		QuoInt(target).
+		QuoInt(sdkmath.NewIntFromUint64(p.BaseGasPriceChangeDenominator))
+
+	return p.ClampBaseGasPrice(baseGasPrice.Add(change))
+}
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestValidateFeeMarketParams(t *testing.T) {
+	tests := map[string]struct {
+		feeMarket FeeMarketParams
+		expectErr bool
+	}{
+		"default, pass": {
+			*DefaultFeeMarketParams(),
+			false,
+		},
+		"disabled without values, pass": {
+			FeeMarketParams{},
+			false,
+		},
+		"enabled, pass": {
+			NewFeeMarketParams(true, "uband", math.LegacyNewDecWithPrec(1, 3), math.LegacyNewDecWithPrec(1, 1), 1000, 8),
+			false,
+		},
+		"enabled with equal bounds, pass": {
+			NewFeeMarketParams(true, "uband", math.LegacyNewDecWithPrec(1, 3), math.LegacyNewDecWithPrec(1, 3), 1000, 8),
+			false,
+		},
+		"enabled without denom, fail": {
+			NewFeeMarketParams(true, "", math.LegacyNewDecWithPrec(1, 3), math.LegacyNewDecWithPrec(1, 1), 1000, 8),
+			true,
+		},
+		"enabled with zero min base gas price, fail": {
+			NewFeeMarketParams(true, "uband", math.LegacyZeroDec(), math.LegacyNewDecWithPrec(1, 1), 1000, 8),
+			true,
+		},
+		"enabled with max below min base gas price, fail": {
+			NewFeeMarketParams(true, "uband", math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(1, 3), 1000, 8),
+			true,
+		},
+		"enabled with zero target block gas, fail": {
+			NewFeeMarketParams(true, "uband", math.LegacyNewDecWithPrec(1, 3), math.LegacyNewDecWithPrec(1, 1), 0, 8),
+			true,
+		},
+		"enabled with zero change denominator, fail": {
+			NewFeeMarketParams(true, "uband", math.LegacyNewDecWithPrec(1, 3), math.LegacyNewDecWithPrec(1, 1), 1000, 0),
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.feeMarket.Validate()
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNextBaseGasPrice(t *testing.T) {
+	feeMarket := NewFeeMarketParams(
+		true,
+		"uband",
+		math.LegacyNewDecWithPrec(1, 3),
+		math.LegacyNewDecWithPrec(1, 1),
+		1000,
+		8,
+	)
+
+	tests := map[string]struct {
+		baseGasPrice math.LegacyDec
+		blockGasUsed uint64
+		expected     math.LegacyDec
+	}{
+		"block at target": {
+			math.LegacyNewDecWithPrec(8, 3),
+			1000,
+			math.LegacyNewDecWithPrec(8, 3),
+		},
+		"full block": {
+			math.LegacyNewDecWithPrec(8, 3),
+			2000,
+			math.LegacyNewDecWithPrec(9, 3),
+		},
+		"empty block": {
+			math.LegacyNewDecWithPrec(8, 3),
+			0,
+			math.LegacyNewDecWithPrec(7, 3),
+		},
+		"block above target": {
+			math.LegacyNewDecWithPrec(8, 3),
+			1500,
+			math.LegacyNewDecWithPrec(85, 4),
+		},
+		"bounded by min base gas price": {
+			math.LegacyNewDecWithPrec(1, 3),
+			0,
+			math.LegacyNewDecWithPrec(1, 3),
+		},
+		"bounded by max base gas price": {
+			math.LegacyNewDecWithPrec(1, 1),
+			5000,
+			math.LegacyNewDecWithPrec(1, 1),
+		},
+		"unset base gas price starts from min base gas price": {
+			math.LegacyDec{},
+			1000,
+			math.LegacyNewDecWithPrec(1, 3),
+		},
+		"base gas price out of bounds": {
+			math.LegacyOneDec(),
+			1000,
+			math.LegacyNewDecWithPrec(1, 1),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, test.expected, feeMarket.NextBaseGasPrice(test.baseGasPrice, test.blockGasUsed))
+		})
+	}
+}
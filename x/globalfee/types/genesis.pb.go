@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -79,6 +81,21 @@ type Params struct {
 	// values allowed. For more information see
 	// https://docs.cosmos.network/main/modules/auth#concepts
 	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices,omitempty" yaml:"minimum_gas_prices"`
+	// MsgFeeRules is the list of fee rules of specific message types. Messages
+	// without a rule pay the minimum gas prices and are never fee-exempt.
+	MsgFeeRules []MsgFeeRule `protobuf:"bytes,2,rep,name=msg_fee_rules,json=msgFeeRules,proto3" json:"msg_fee_rules"`
+	// FeeMarket is the parameters of the dynamic base gas price. The fee market
+	// is disabled if unset.
+	FeeMarket *FeeMarketParams `protobuf:"bytes,3,opt,name=fee_market,json=feeMarket,proto3" json:"fee_market,omitempty"`
+	// RefundableMsgTypeURLs is the list of the message types whose txs get the
+	// fee of their unused gas refunded from the refund pool after a successful
+	// execution. A tx is refunded only if all of its messages are refundable.
+	RefundableMsgTypeURLs []string `protobuf:"bytes,4,rep,name=refundable_msg_type_urls,json=refundableMsgTypeUrls,proto3" json:"refundable_msg_type_urls,omitempty"`
+	// MaxRefundableGas caps the gas limit of a refundable tx for its refund.
+	// Only the unused gas below the cap is refunded at the minimum gas prices of
+	// the tx, so that a tx cannot drain the refund pool with an inflated gas
+	// limit or fee.
+	MaxRefundableGas uint64 `protobuf:"varint,5,opt,name=max_refundable_gas,json=maxRefundableGas,proto3" json:"max_refundable_gas,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -121,9 +138,260 @@ func (m *Params) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoi
 	return nil
 }
 
+func (m *Params) GetMsgFeeRules() []MsgFeeRule {
+	if m != nil {
+		return m.MsgFeeRules
+	}
+	return nil
+}
+
+func (m *Params) GetFeeMarket() *FeeMarketParams {
+	if m != nil {
+		return m.FeeMarket
+	}
+	return nil
+}
+
+func (m *Params) GetRefundableMsgTypeURLs() []string {
+	if m != nil {
+		return m.RefundableMsgTypeURLs
+	}
+	return nil
+}
+
+func (m *Params) GetMaxRefundableGas() uint64 {
+	if m != nil {
+		return m.MaxRefundableGas
+	}
+	return 0
+}
+
+// MsgFeeRule defines the minimum gas prices and the fee exemption of a message
+// type.
+type MsgFeeRule struct {
+	// MsgTypeURL is the type URL of the message that the rule applies to.
+	MsgTypeURL string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// MinimumGasPrices overrides the global minimum gas prices for the message if
+	// set. A tx pays the highest price among its messages in a denom that all of
+	// them accept.
+	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices"`
+	// Bypass is a flag indicating whether a valid message is exempt from the
+	// minimum fee. A tx is exempt only if all of its messages are. Only the
+	// messages of validators' oracle, feeds and tss duties, which are validated
+	// before being exempt, and the authz messages executing them can be exempt.
+	Bypass bool `protobuf:"varint,3,opt,name=bypass,proto3" json:"bypass,omitempty"`
+	// MaxBypassGas is the maximum gas of a fee-exempt tx containing the message.
+	// Zero means no limit.
+	MaxBypassGas uint64 `protobuf:"varint,4,opt,name=max_bypass_gas,json=maxBypassGas,proto3" json:"max_bypass_gas,omitempty"`
+}
+
+func (m *MsgFeeRule) Reset()         { *m = MsgFeeRule{} }
+func (m *MsgFeeRule) String() string { return proto.CompactTextString(m) }
+func (*MsgFeeRule) ProtoMessage()    {}
+func (*MsgFeeRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3b4cca9ed9ac312, []int{2}
+}
+func (m *MsgFeeRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeeRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeeRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFeeRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeeRule.Merge(m, src)
+}
+func (m *MsgFeeRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeeRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeeRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFeeRule proto.InternalMessageInfo
+
+func (m *MsgFeeRule) GetMsgTypeURL() string {
+	if m != nil {
+		return m.MsgTypeURL
+	}
+	return ""
+}
+
+func (m *MsgFeeRule) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinimumGasPrices
+	}
+	return nil
+}
+
+func (m *MsgFeeRule) GetBypass() bool {
+	if m != nil {
+		return m.Bypass
+	}
+	return false
+}
+
+func (m *MsgFeeRule) GetMaxBypassGas() uint64 {
+	if m != nil {
+		return m.MaxBypassGas
+	}
+	return 0
+}
+
+// FeeMarketParams defines the parameters of the dynamic base gas price, which
+// is adjusted every block from the gas used by the block against a target, in
+// the manner of EIP-1559.
+type FeeMarketParams struct {
+	// Enabled is a flag indicating whether txs have to pay the base gas price.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// MinBaseGasPrice is the lower bound of the base gas price.
+	MinBaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_base_gas_price,json=minBaseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_gas_price"`
+	// MaxBaseGasPrice is the upper bound of the base gas price.
+	MaxBaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_base_gas_price,json=maxBaseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_base_gas_price"`
+	// TargetBlockGas is the gas used by a block at which the base gas price
+	// stays the same.
+	TargetBlockGas uint64 `protobuf:"varint,4,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// BaseGasPriceChangeDenominator bounds the change of the base gas price per
+	// block to 1/BaseGasPriceChangeDenominator of it when the block uses twice
+	// the target gas or none.
+	BaseGasPriceChangeDenominator uint64 `protobuf:"varint,5,opt,name=base_gas_price_change_denominator,json=baseGasPriceChangeDenominator,proto3" json:"base_gas_price_change_denominator,omitempty"`
+	// Denom is the denom of the base gas price. The minimum gas prices and the
+	// minimum gas prices of the msg fee rules must accept it.
+	Denom string `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *FeeMarketParams) Reset()         { *m = FeeMarketParams{} }
+func (m *FeeMarketParams) String() string { return proto.CompactTextString(m) }
+func (*FeeMarketParams) ProtoMessage()    {}
+func (*FeeMarketParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3b4cca9ed9ac312, []int{3}
+}
+func (m *FeeMarketParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeMarketParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMarketParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeMarketParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMarketParams.Merge(m, src)
+}
+func (m *FeeMarketParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMarketParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMarketParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeMarketParams proto.InternalMessageInfo
+
+func (m *FeeMarketParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *FeeMarketParams) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *FeeMarketParams) GetBaseGasPriceChangeDenominator() uint64 {
+	if m != nil {
+		return m.BaseGasPriceChangeDenominator
+	}
+	return 0
+}
+
+func (m *FeeMarketParams) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// BaseGasPriceRecord is the base gas price and the gas used of a block.
+type BaseGasPriceRecord struct {
+	// Height is the height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// BaseGasPrice is the base gas price that txs of the block had to pay.
+	BaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_gas_price,json=baseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_gas_price"`
+	// BlockGasUsed is the gas used by the block.
+	BlockGasUsed uint64 `protobuf:"varint,3,opt,name=block_gas_used,json=blockGasUsed,proto3" json:"block_gas_used,omitempty"`
+}
+
+func (m *BaseGasPriceRecord) Reset()         { *m = BaseGasPriceRecord{} }
+func (m *BaseGasPriceRecord) String() string { return proto.CompactTextString(m) }
+func (*BaseGasPriceRecord) ProtoMessage()    {}
+func (*BaseGasPriceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3b4cca9ed9ac312, []int{4}
+}
+func (m *BaseGasPriceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseGasPriceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseGasPriceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseGasPriceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseGasPriceRecord.Merge(m, src)
+}
+func (m *BaseGasPriceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseGasPriceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseGasPriceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseGasPriceRecord proto.InternalMessageInfo
+
+func (m *BaseGasPriceRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BaseGasPriceRecord) GetBlockGasUsed() uint64 {
+	if m != nil {
+		return m.BlockGasUsed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "band.globalfee.v1beta1.GenesisState")
 	proto.RegisterType((*Params)(nil), "band.globalfee.v1beta1.Params")
+	proto.RegisterType((*MsgFeeRule)(nil), "band.globalfee.v1beta1.MsgFeeRule")
+	proto.RegisterType((*FeeMarketParams)(nil), "band.globalfee.v1beta1.FeeMarketParams")
+	proto.RegisterType((*BaseGasPriceRecord)(nil), "band.globalfee.v1beta1.BaseGasPriceRecord")
 }
 
 func init() {
@@ -131,29 +399,56 @@ func init() {
 }
 
 var fileDescriptor_c3b4cca9ed9ac312 = []byte{
-	// 338 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x4e, 0x02, 0x31,
-	0x18, 0xc7, 0xaf, 0x31, 0x61, 0x38, 0x1c, 0xc8, 0xc5, 0x18, 0x24, 0xa4, 0x67, 0x2e, 0x0e, 0x24,
-	0x6a, 0x1b, 0x60, 0x73, 0x44, 0x13, 0x16, 0x07, 0x82, 0x9b, 0x0b, 0xf6, 0x4a, 0x3d, 0x1a, 0xaf,
-	0xf7, 0x5d, 0x68, 0x21, 0xf2, 0x16, 0x3e, 0x87, 0xcf, 0xe0, 0x2e, 0x23, 0xa3, 0x13, 0x1a, 0xd8,
-	0x1c, 0x7d, 0x02, 0x73, 0xd7, 0x13, 0x48, 0x70, 0x6a, 0x93, 0xfe, 0xbe, 0xff, 0xef, 0xcb, 0xbf,
-	0xee, 0x59, 0xc8, 0x92, 0x21, 0x8d, 0x62, 0x08, 0x59, 0xfc, 0x28, 0x04, 0x9d, 0x36, 0x43, 0x61,
-	0x58, 0x93, 0x46, 0x22, 0x11, 0x5a, 0x6a, 0x92, 0x8e, 0xc1, 0x80, 0x77, 0x9c, 0x51, 0x64, 0x43,
-	0x91, 0x82, 0xaa, 0x1d, 0x45, 0x10, 0x41, 0x8e, 0xd0, 0xec, 0x66, 0xe9, 0x1a, 0xe6, 0xa0, 0x15,
-	0x68, 0x1a, 0x32, 0xbd, 0x0d, 0xe4, 0x20, 0x13, 0xfb, 0x1e, 0x3c, 0xb8, 0x87, 0x5d, 0x1b, 0x7f,
-	0x67, 0x98, 0x11, 0x5e, 0xcf, 0x2d, 0xa5, 0x6c, 0xcc, 0x94, 0xae, 0xa2, 0x53, 0xd4, 0x28, 0xb7,
-	0x30, 0xf9, 0x5f, 0x47, 0x7a, 0x39, 0xd5, 0xa9, 0xce, 0x97, 0xbe, 0xf3, 0xbd, 0xf4, 0x2b, 0x76,
-	0xea, 0x02, 0x94, 0x34, 0x42, 0xa5, 0x66, 0xd6, 0x2f, 0x72, 0x82, 0x77, 0xe4, 0x96, 0x2c, 0xec,
-	0xbd, 0x21, 0xd7, 0x53, 0x32, 0x91, 0x6a, 0xa2, 0x06, 0x11, 0xd3, 0x83, 0x74, 0x2c, 0xb9, 0xc8,
-	0x4c, 0x07, 0x8d, 0x72, 0xab, 0x4e, 0xec, 0xaa, 0x24, 0x5b, 0x75, 0xa3, 0xb9, 0x11, 0xfc, 0x1a,
-	0x64, 0xd2, 0x49, 0x0b, 0x4f, 0x7d, 0x7f, 0x7e, 0xeb, 0xfc, 0x59, 0xfa, 0x27, 0x33, 0xa6, 0xe2,
-	0xab, 0x60, 0x9f, 0x0a, 0x5e, 0x3f, 0xfd, 0xf3, 0x48, 0x9a, 0xd1, 0x24, 0x24, 0x1c, 0x14, 0x2d,
-	0x7a, 0xb1, 0xc7, 0xa5, 0x1e, 0x3e, 0x51, 0x33, 0x4b, 0x85, 0xfe, 0x13, 0xea, 0x7e, 0xa5, 0xc8,
-	0xe8, 0x32, 0xdd, 0xcb, 0x13, 0x3a, 0xb7, 0xf3, 0x15, 0x46, 0x8b, 0x15, 0x46, 0x5f, 0x2b, 0x8c,
-	0x5e, 0xd6, 0xd8, 0x59, 0xac, 0xb1, 0xf3, 0xb1, 0xc6, 0xce, 0x7d, 0x6b, 0x27, 0x38, 0xeb, 0x2b,
-	0xef, 0x96, 0x43, 0x4c, 0xf9, 0x88, 0xc9, 0x84, 0x4e, 0xdb, 0xf4, 0x79, 0xe7, 0x5f, 0x73, 0x51,
-	0x58, 0xca, 0xa1, 0xf6, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x42, 0xa4, 0x5e, 0xf6, 0x01,
-	0x00, 0x00,
+	// 772 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x6f, 0xf3, 0x44,
+	0x10, 0x8d, 0x93, 0x7c, 0xe1, 0xeb, 0x36, 0xa4, 0xd1, 0xaa, 0xad, 0xdc, 0x52, 0x9c, 0x12, 0x55,
+	0x22, 0x12, 0xad, 0x4d, 0xdb, 0x1b, 0x47, 0x37, 0x6a, 0x38, 0xa4, 0x52, 0xb5, 0x50, 0x21, 0x71,
+	0xc0, 0xac, 0xed, 0x89, 0x63, 0xc5, 0xeb, 0x8d, 0xbc, 0x4e, 0x95, 0x9c, 0xf8, 0x0b, 0xfc, 0x0e,
+	0xb8, 0x72, 0xe4, 0x07, 0xf4, 0x58, 0x71, 0x42, 0x1c, 0x52, 0x94, 0xde, 0x38, 0x22, 0x71, 0x44,
+	0x42, 0xbb, 0x76, 0x12, 0x97, 0xb6, 0x12, 0x02, 0x4e, 0xed, 0xcc, 0xbe, 0x7d, 0xf3, 0xde, 0xdb,
+	0x49, 0x82, 0x8e, 0x5c, 0x1a, 0xfb, 0x56, 0x10, 0x71, 0x97, 0x46, 0x03, 0x00, 0xeb, 0xf6, 0xd4,
+	0x85, 0x94, 0x9e, 0x5a, 0x01, 0xc4, 0x20, 0x42, 0x61, 0x8e, 0x13, 0x9e, 0x72, 0xbc, 0x2b, 0x51,
+	0xe6, 0x0a, 0x65, 0xe6, 0xa8, 0xfd, 0xed, 0x80, 0x07, 0x5c, 0x41, 0x2c, 0xf9, 0x5f, 0x86, 0xde,
+	0xdf, 0xf3, 0xb8, 0x60, 0x5c, 0x38, 0xd9, 0x41, 0x56, 0xe4, 0x47, 0x46, 0x56, 0x59, 0x2e, 0x15,
+	0xeb, 0x59, 0x1e, 0x0f, 0xe3, 0xec, 0xbc, 0xfd, 0x35, 0xaa, 0xf7, 0xb2, 0xc9, 0x9f, 0xa5, 0x34,
+	0x05, 0x7c, 0x8d, 0x6a, 0x63, 0x9a, 0x50, 0x26, 0x74, 0xed, 0x50, 0xeb, 0x6c, 0x9e, 0x19, 0xe6,
+	0xcb, 0x4a, 0xcc, 0x6b, 0x85, 0xb2, 0xf5, 0xbb, 0x79, 0xab, 0xf4, 0xdb, 0xbc, 0xd5, 0xcc, 0x6e,
+	0x1d, 0x73, 0x16, 0xa6, 0xc0, 0xc6, 0xe9, 0x8c, 0xe4, 0x3c, 0xed, 0x87, 0x0a, 0xaa, 0x65, 0x60,
+	0xfc, 0xa3, 0x86, 0x30, 0x0b, 0xe3, 0x90, 0x4d, 0x98, 0x13, 0x50, 0xa9, 0x37, 0xf4, 0x40, 0x4e,
+	0xaa, 0x74, 0x36, 0xcf, 0x0e, 0xcc, 0x5c, 0xb8, 0x94, 0xba, 0x1a, 0xd3, 0x05, 0xef, 0x82, 0x87,
+	0xb1, 0x3d, 0xce, 0xe7, 0x1c, 0x3c, 0xbf, 0xbf, 0x9e, 0xf9, 0xfb, 0xbc, 0xb5, 0x37, 0xa3, 0x2c,
+	0xfa, 0xa4, 0xfd, 0x1c, 0xd5, 0xfe, 0xee, 0xa1, 0xf5, 0x51, 0x10, 0xa6, 0xc3, 0x89, 0x6b, 0x7a,
+	0x9c, 0xe5, 0x29, 0xe5, 0x7f, 0x4e, 0x84, 0x3f, 0xb2, 0xd2, 0xd9, 0x18, 0xc4, 0x72, 0xa0, 0x20,
+	0xcd, 0x9c, 0xa3, 0x47, 0xc5, 0xb5, 0x62, 0xc0, 0x7d, 0xf4, 0x2e, 0x13, 0x81, 0x33, 0x00, 0x70,
+	0x92, 0x49, 0x04, 0x42, 0x2f, 0x2b, 0xe1, 0xed, 0xd7, 0x22, 0xba, 0x12, 0xc1, 0x25, 0x00, 0x99,
+	0x44, 0x60, 0x57, 0xa5, 0x7c, 0xb2, 0xc9, 0x56, 0x1d, 0x81, 0x2f, 0x11, 0x92, 0x4c, 0x8c, 0x26,
+	0x23, 0x48, 0xf5, 0x8a, 0x4a, 0xfb, 0xc3, 0xd7, 0xa8, 0x2e, 0x01, 0xae, 0x14, 0x30, 0x4b, 0x92,
+	0x6c, 0x0c, 0x96, 0x0d, 0x4c, 0x90, 0x9e, 0xc0, 0x60, 0x12, 0xfb, 0xd4, 0x8d, 0xc0, 0x91, 0x02,
+	0xa5, 0x11, 0x67, 0x92, 0x44, 0x42, 0xaf, 0x1e, 0x56, 0x3a, 0x1b, 0xf6, 0xde, 0x62, 0xde, 0xda,
+	0x21, 0x2b, 0xcc, 0x95, 0x08, 0x3e, 0x9f, 0x8d, 0xe1, 0x86, 0xf4, 0x05, 0xd9, 0x49, 0x9e, 0xb5,
+	0x93, 0x48, 0xe0, 0x63, 0x84, 0x19, 0x9d, 0x3a, 0x05, 0xde, 0x80, 0x0a, 0xfd, 0xcd, 0xa1, 0xd6,
+	0xa9, 0x92, 0x26, 0xa3, 0xd3, 0x35, 0x59, 0x8f, 0x8a, 0xf6, 0x9f, 0x1a, 0x42, 0x6b, 0xaf, 0xf8,
+	0x63, 0x54, 0x2f, 0xaa, 0x50, 0x8b, 0xb4, 0x61, 0x37, 0x16, 0xf3, 0x16, 0x5a, 0x8f, 0x26, 0x88,
+	0xad, 0xe6, 0xe1, 0x6f, 0x5e, 0x5c, 0x8b, 0xf2, 0x3f, 0x58, 0x8b, 0x73, 0x99, 0xeb, 0x7f, 0x7f,
+	0xd9, 0x5d, 0x54, 0x73, 0x67, 0x63, 0x2a, 0x84, 0x7a, 0x87, 0xb7, 0x24, 0xaf, 0xf0, 0x11, 0x6a,
+	0xc8, 0x1c, 0xb2, 0x4a, 0x65, 0x50, 0x55, 0x19, 0xd4, 0x19, 0x9d, 0xda, 0xaa, 0x29, 0xfd, 0xff,
+	0x51, 0x46, 0x5b, 0x7f, 0x7b, 0x20, 0xac, 0xa3, 0x77, 0x20, 0x96, 0x01, 0xf9, 0xca, 0xff, 0x5b,
+	0xb2, 0x2c, 0xf1, 0x57, 0xca, 0xac, 0x23, 0xed, 0xac, 0xdd, 0xea, 0x65, 0x15, 0xd2, 0xa9, 0xb4,
+	0xf3, 0xcb, 0xbc, 0xf5, 0x5e, 0x26, 0x5e, 0xf8, 0x23, 0x33, 0xe4, 0x16, 0xa3, 0xe9, 0xd0, 0xec,
+	0x43, 0x40, 0xbd, 0x59, 0x17, 0xbc, 0x9f, 0x7e, 0x38, 0x41, 0x79, 0x24, 0x5d, 0xf0, 0xc8, 0x16,
+	0x0b, 0x63, 0x9b, 0x0a, 0x58, 0x9a, 0x51, 0xfc, 0x52, 0xf3, 0x53, 0xfe, 0xca, 0xbf, 0xe7, 0xa7,
+	0xd3, 0x27, 0xfc, 0x1d, 0xd4, 0x4c, 0x69, 0x12, 0x40, 0xea, 0xb8, 0x11, 0xf7, 0x46, 0x85, 0x54,
+	0x1a, 0x59, 0xdf, 0x96, 0xed, 0x1e, 0x15, 0xf8, 0x53, 0xf4, 0xc1, 0x53, 0x15, 0x8e, 0x37, 0xa4,
+	0x71, 0x00, 0x8e, 0x0f, 0x31, 0x67, 0x61, 0x4c, 0x53, 0x9e, 0xe4, 0x4b, 0xf5, 0xbe, 0x5b, 0x18,
+	0x71, 0xa1, 0x50, 0xdd, 0x35, 0x08, 0x6f, 0xa3, 0x37, 0xea, 0x8e, 0x5e, 0x93, 0x36, 0x48, 0x56,
+	0xb4, 0xbf, 0xd7, 0x10, 0x2e, 0x4a, 0x23, 0xe0, 0xf1, 0xc4, 0x97, 0x8f, 0x39, 0x84, 0x30, 0x18,
+	0xa6, 0x2a, 0xf9, 0x0a, 0xc9, 0x2b, 0xfc, 0x05, 0x6a, 0xfc, 0x5f, 0xa1, 0xd7, 0x8b, 0x72, 0xe5,
+	0x96, 0xac, 0xa2, 0x70, 0x26, 0x02, 0x7c, 0x95, 0x76, 0x95, 0xd4, 0xdd, 0x3c, 0x89, 0x1b, 0x01,
+	0xbe, 0xdd, 0xbf, 0x5b, 0x18, 0xda, 0xfd, 0xc2, 0xd0, 0x7e, 0x5d, 0x18, 0xda, 0xb7, 0x8f, 0x46,
+	0xe9, 0xfe, 0xd1, 0x28, 0xfd, 0xfc, 0x68, 0x94, 0xbe, 0x3c, 0x2b, 0x2c, 0xaf, 0xfc, 0xfc, 0xab,
+	0x6f, 0x66, 0x8f, 0x47, 0x96, 0x37, 0xa4, 0x61, 0x6c, 0xdd, 0x9e, 0x5b, 0xd3, 0xc2, 0x0f, 0x86,
+	0x5a, 0x66, 0xb7, 0xa6, 0x40, 0xe7, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd6, 0xbd, 0x45, 0x67,
+	0x4f, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -209,6 +504,98 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxRefundableGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxRefundableGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RefundableMsgTypeURLs) > 0 {
+		for iNdEx := len(m.RefundableMsgTypeURLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RefundableMsgTypeURLs[iNdEx])
+			copy(dAtA[i:], m.RefundableMsgTypeURLs[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RefundableMsgTypeURLs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.FeeMarket != nil {
+		{
+			size, err := m.FeeMarket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgFeeRules) > 0 {
+		for iNdEx := len(m.MsgFeeRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFeeRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeeRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeeRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeeRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBypassGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBypassGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Bypass {
+		i--
+		if m.Bypass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.MinimumGasPrices) > 0 {
 		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -216,60 +603,710 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeURL) > 0 {
+		i -= len(m.MsgTypeURL)
+		copy(dAtA[i:], m.MsgTypeURL)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MsgTypeURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeMarketParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMarketParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMarketParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BaseGasPriceChangeDenominator != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BaseGasPriceChangeDenominator))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MaxBaseGasPrice.Size()
+		i -= size
+		if _, err := m.MaxBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinBaseGasPrice.Size()
+		i -= size
+		if _, err := m.MinBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseGasPriceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseGasPriceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseGasPriceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockGasUsed != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MsgFeeRules) > 0 {
+		for _, e := range m.MsgFeeRules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.FeeMarket != nil {
+		l = m.FeeMarket.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.RefundableMsgTypeURLs) > 0 {
+		for _, s := range m.RefundableMsgTypeURLs {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxRefundableGas != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxRefundableGas))
+	}
+	return n
+}
+
+func (m *MsgFeeRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeURL)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Bypass {
+		n += 2
+	}
+	if m.MaxBypassGas != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxBypassGas))
+	}
+	return n
+}
+
+func (m *FeeMarketParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.MinBaseGasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxBaseGasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovGenesis(uint64(m.TargetBlockGas))
+	}
+	if m.BaseGasPriceChangeDenominator != 0 {
+		n += 1 + sovGenesis(uint64(m.BaseGasPriceChangeDenominator))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *BaseGasPriceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.BlockGasUsed != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockGasUsed))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFeeRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFeeRules = append(m.MsgFeeRules, MsgFeeRule{})
+			if err := m.MsgFeeRules[len(m.MsgFeeRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMarket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeMarket == nil {
+				m.FeeMarket = &FeeMarketParams{}
+			}
+			if err := m.FeeMarket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundableMsgTypeURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundableMsgTypeURLs = append(m.RefundableMsgTypeURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRefundableGas", wireType)
+			}
+			m.MaxRefundableGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRefundableGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFeeRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFeeRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFeeRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0xa
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bypass", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Bypass = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBypassGas", wireType)
+			}
+			m.MaxBypassGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBypassGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.MinimumGasPrices) > 0 {
-		for _, e := range m.MinimumGasPrices {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *FeeMarketParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -292,17 +1329,37 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeMarketParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeMarketParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseGasPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -312,25 +1369,130 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPriceChangeDenominator", wireType)
+			}
+			m.BaseGasPriceChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseGasPriceChangeDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -352,7 +1514,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *BaseGasPriceRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -375,17 +1537,36 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: BaseGasPriceRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BaseGasPriceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -395,26 +1576,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
-			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasUsed", wireType)
+			}
+			m.BlockGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName is the name of the this module
 	ModuleName = "globalfee"
@@ -12,6 +16,25 @@ const (
 
 	// RouterKey is the msg router key for the globalfee module
 	RouterKey = ModuleName
+
+	// RefundPoolName is the name of the module account that pays the fee refunds.
+	RefundPoolName = ModuleName
+
+	// BaseGasPriceHistoryLength is the number of the recent blocks whose base gas price records are kept.
+	BaseGasPriceHistoryLength = 1000
+)
+
+var (
+	ParamsKeyPrefix = []byte{0x01}
+
+	// BaseGasPriceKey is the key of the base gas price of the next block.
+	BaseGasPriceKey = []byte{0x02}
+
+	// BaseGasPriceRecordKeyPrefix is the prefix of the base gas price records of the recent blocks.
+	BaseGasPriceRecordKeyPrefix = []byte{0x03}
 )
 
-var ParamsKeyPrefix = []byte{0x01}
+// BaseGasPriceRecordKey returns the key of the base gas price record of the block at the given height.
+func BaseGasPriceRecordKey(height int64) []byte {
+	return append(BaseGasPriceRecordKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
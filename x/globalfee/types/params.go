@@ -1,20 +1,133 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	feedstypes "github.com/bandprotocol/chain/v3/x/feeds/types"
+	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
+	tsstypes "github.com/bandprotocol/chain/v3/x/tss/types"
 )
 
+// DefaultMaxRefundableGas is the default maximum gas limit of a refundable tx for its refund.
+var DefaultMaxRefundableGas = uint64(1_000_000)
+
 // NewParams returns Params instance with the given values.
-func NewParams(minimumGasPrices sdk.DecCoins) Params {
+func NewParams(
+	minimumGasPrices sdk.DecCoins,
+	msgFeeRules []MsgFeeRule,
+	feeMarket *FeeMarketParams,
+	refundableMsgTypeURLs []string,
+	maxRefundableGas uint64,
+) Params {
 	return Params{
-		MinimumGasPrices: minimumGasPrices,
+		MinimumGasPrices:      minimumGasPrices,
+		MsgFeeRules:           msgFeeRules,
+		FeeMarket:             feeMarket,
+		RefundableMsgTypeURLs: refundableMsgTypeURLs,
+		MaxRefundableGas:      maxRefundableGas,
 	}
 }
 
 // DefaultParams returns default parameters
 func DefaultParams() Params {
-	return Params{MinimumGasPrices: sdk.DecCoins{}}
+	return NewParams(
+		sdk.DecCoins{},
+		DefaultMsgFeeRules(),
+		DefaultFeeMarketParams(),
+		DefaultRefundableMsgTypeURLs(),
+		DefaultMaxRefundableGas,
+	)
+}
+
+// NewMsgFeeRule returns MsgFeeRule instance with the given values.
+func NewMsgFeeRule(
+	msgTypeURL string,
+	minimumGasPrices sdk.DecCoins,
+	bypass bool,
+	maxBypassGas uint64,
+) MsgFeeRule {
+	return MsgFeeRule{
+		MsgTypeURL:       msgTypeURL,
+		MinimumGasPrices: minimumGasPrices,
+		Bypass:           bypass,
+		MaxBypassGas:     maxBypassGas,
+	}
+}
+
+// BypassableMsgTypeURLs returns the message types that can be exempt from the minimum fee, which are the
+// messages of validators' oracle, feeds and tss duties and the authz messages executing them. The fee checker
+// validates these messages before exempting them, so that invalid messages cannot be sent for free.
+func BypassableMsgTypeURLs() []string {
+	return []string{
+		sdk.MsgTypeURL(&oracletypes.MsgReportData{}),
+		sdk.MsgTypeURL(&feedstypes.MsgSubmitSignalPrices{}),
+		sdk.MsgTypeURL(&tsstypes.MsgSubmitDKGRound1{}),
+		sdk.MsgTypeURL(&tsstypes.MsgSubmitDKGRound2{}),
+		sdk.MsgTypeURL(&tsstypes.MsgConfirm{}),
+		sdk.MsgTypeURL(&tsstypes.MsgComplain{}),
+		sdk.MsgTypeURL(&tsstypes.MsgSubmitDEs{}),
+		sdk.MsgTypeURL(&tsstypes.MsgSubmitSignature{}),
+		sdk.MsgTypeURL(&authz.MsgExec{}),
+	}
+}
+
+// IsBypassableMsgTypeURL returns whether the message of the given type URL can be exempt from the minimum fee.
+func IsBypassableMsgTypeURL(msgTypeURL string) bool {
+	for _, bypassableMsgTypeURL := range BypassableMsgTypeURLs() {
+		if bypassableMsgTypeURL == msgTypeURL {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DefaultMsgFeeRules returns the default fee rules, which exempt all bypassable messages from the minimum fee.
+func DefaultMsgFeeRules() []MsgFeeRule {
+	msgTypeURLs := BypassableMsgTypeURLs()
+
+	rules := make([]MsgFeeRule, len(msgTypeURLs))
+	for i, msgTypeURL := range msgTypeURLs {
+		rules[i] = NewMsgFeeRule(msgTypeURL, nil, true, 0)
+	}
+
+	return rules
+}
+
+// DefaultRefundableMsgTypeURLs returns the default refundable message types, which are the messages of
+// validators' oracle and feeds duties.
+func DefaultRefundableMsgTypeURLs() []string {
+	return []string{
+		sdk.MsgTypeURL(&oracletypes.MsgReportData{}),
+		sdk.MsgTypeURL(&feedstypes.MsgSubmitSignalPrices{}),
+	}
+}
+
+// IsRefundableMsgTypeURL returns whether the message of the given type URL is refundable.
+func (p Params) IsRefundableMsgTypeURL(msgTypeURL string) bool {
+	for _, refundableMsgTypeURL := range p.RefundableMsgTypeURLs {
+		if refundableMsgTypeURL == msgTypeURL {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetMsgFeeRule returns the fee rule of the given message type URL and whether it exists.
+func (p Params) GetMsgFeeRule(msgTypeURL string) (MsgFeeRule, bool) {
+	for _, rule := range p.MsgFeeRules {
+		if rule.MsgTypeURL == msgTypeURL {
+			return rule, true
+		}
+	}
+
+	return MsgFeeRule{}, false
 }
 
 // this requires the fee non-negative
@@ -27,6 +140,108 @@ func validateMinimumGasPrices(i interface{}) error {
 	return v.Validate()
 }
 
+func validateMsgFeeRules(i interface{}) error {
+	v, ok := i.([]MsgFeeRule)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("type: %T, expected []MsgFeeRule", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, rule := range v {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+		if seen[rule.MsgTypeURL] {
+			return fmt.Errorf("duplicate msg fee rule: %s", rule.MsgTypeURL)
+		}
+		seen[rule.MsgTypeURL] = true
+	}
+
+	return nil
+}
+
+func validateRefundableMsgTypeURLs(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("type: %T, expected []string", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, msgTypeURL := range v {
+		if !strings.HasPrefix(msgTypeURL, "/") {
+			return fmt.Errorf("invalid refundable msg type url: %q", msgTypeURL)
+		}
+		if seen[msgTypeURL] {
+			return fmt.Errorf("duplicate refundable msg type url: %s", msgTypeURL)
+		}
+		seen[msgTypeURL] = true
+	}
+
+	return nil
+}
+
+// Validate does the sanity check on the msg fee rule.
+func (r MsgFeeRule) Validate() error {
+	if !strings.HasPrefix(r.MsgTypeURL, "/") {
+		return fmt.Errorf("invalid msg type url: %q", r.MsgTypeURL)
+	}
+	if err := validateMinimumGasPrices(r.MinimumGasPrices); err != nil {
+		return fmt.Errorf("invalid minimum gas prices of %s: %w", r.MsgTypeURL, err)
+	}
+	if r.Bypass && !IsBypassableMsgTypeURL(r.MsgTypeURL) {
+		return fmt.Errorf("msg type %s cannot be exempt from the minimum fee", r.MsgTypeURL)
+	}
+	if !r.Bypass && r.MaxBypassGas != 0 {
+		return fmt.Errorf("max bypass gas of %s is set without bypass", r.MsgTypeURL)
+	}
+	if !r.Bypass && r.MinimumGasPrices.Empty() {
+		return fmt.Errorf("msg fee rule of %s has no effect", r.MsgTypeURL)
+	}
+
+	return nil
+}
+
+// validateBaseGasPriceDenom checks that the minimum gas prices accept the denom of the base gas price if the fee
+// market is enabled, as the base gas price is only applied to the gas prices of its denom.
+func (p Params) validateBaseGasPriceDenom() error {
+	if !p.FeeMarket.Enabled {
+		return nil
+	}
+
+	denom := p.FeeMarket.Denom
+	if p.MinimumGasPrices.AmountOf(denom).IsZero() {
+		return fmt.Errorf("minimum gas prices must accept the base gas price denom %s", denom)
+	}
+	for _, rule := range p.MsgFeeRules {
+		if !rule.MinimumGasPrices.Empty() && rule.MinimumGasPrices.AmountOf(denom).IsZero() {
+			return fmt.Errorf(
+				"minimum gas prices of %s must accept the base gas price denom %s",
+				rule.MsgTypeURL,
+				denom,
+			)
+		}
+	}
+
+	return nil
+}
+
 func (p Params) Validate() error {
-	return validateMinimumGasPrices(p.MinimumGasPrices)
+	if err := validateMinimumGasPrices(p.MinimumGasPrices); err != nil {
+		return err
+	}
+
+	if err := validateMsgFeeRules(p.MsgFeeRules); err != nil {
+		return err
+	}
+
+	if p.FeeMarket != nil {
+		if err := p.FeeMarket.Validate(); err != nil {
+			return err
+		}
+		if err := p.validateBaseGasPriceDenom(); err != nil {
+			return err
+		}
+	}
+
+	return validateRefundableMsgTypeURLs(p.RefundableMsgTypeURLs)
 }
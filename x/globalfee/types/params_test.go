@@ -62,3 +62,105 @@ func TestValidateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMsgFeeRules(t *testing.T) {
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", math.LegacyNewDecWithPrec(1, 2)))
+	bypassable := BypassableMsgTypeURLs()[0]
+	notBypassable := "/band.oracle.v1.MsgRequestData"
+
+	tests := map[string]struct {
+		rules     []MsgFeeRule
+		expectErr bool
+	}{
+		"default rules, pass": {
+			DefaultMsgFeeRules(),
+			false,
+		},
+		"minimum gas prices of any message type, pass": {
+			[]MsgFeeRule{NewMsgFeeRule(notBypassable, minGasPrices, false, 0)},
+			false,
+		},
+		"bypassable message type with max bypass gas, pass": {
+			[]MsgFeeRule{NewMsgFeeRule(bypassable, nil, true, 100000)},
+			false,
+		},
+		"bypass of a message type that is not bypassable, fail": {
+			[]MsgFeeRule{NewMsgFeeRule(notBypassable, nil, true, 0)},
+			true,
+		},
+		"max bypass gas without bypass, fail": {
+			[]MsgFeeRule{NewMsgFeeRule(bypassable, minGasPrices, false, 100000)},
+			true,
+		},
+		"rule without effect, fail": {
+			[]MsgFeeRule{NewMsgFeeRule(notBypassable, nil, false, 0)},
+			true,
+		},
+		"duplicate rules, fail": {
+			[]MsgFeeRule{NewMsgFeeRule(bypassable, nil, true, 0), NewMsgFeeRule(bypassable, nil, true, 0)},
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateMsgFeeRules(test.rules)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateBaseGasPriceDenom(t *testing.T) {
+	feeMarket := NewFeeMarketParams(
+		true,
+		"uband",
+		math.LegacyNewDecWithPrec(1, 3),
+		math.LegacyNewDecWithPrec(1, 1),
+		1000,
+		8,
+	)
+	uband := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uband", math.LegacyNewDecWithPrec(1, 2)))
+	uatom := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", math.LegacyNewDecWithPrec(1, 2)))
+	requestTypeURL := "/band.oracle.v1.MsgRequestData"
+
+	tests := map[string]struct {
+		params    Params
+		expectErr bool
+	}{
+		"minimum gas prices in the base gas price denom, pass": {
+			NewParams(uband, DefaultMsgFeeRules(), &feeMarket, nil, 0),
+			false,
+		},
+		"disabled fee market without minimum gas prices, pass": {
+			NewParams(nil, DefaultMsgFeeRules(), DefaultFeeMarketParams(), nil, 0),
+			false,
+		},
+		"no minimum gas prices, fail": {
+			NewParams(nil, DefaultMsgFeeRules(), &feeMarket, nil, 0),
+			true,
+		},
+		"minimum gas prices in another denom, fail": {
+			NewParams(uatom, DefaultMsgFeeRules(), &feeMarket, nil, 0),
+			true,
+		},
+		"msg fee rule in another denom, fail": {
+			NewParams(uband, []MsgFeeRule{NewMsgFeeRule(requestTypeURL, uatom, false, 0)}, &feeMarket, nil, 0),
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.params.Validate()
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -5,7 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -112,9 +115,199 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryBaseGasPriceRequest is request type for the Query/BaseGasPrice RPC method.
+type QueryBaseGasPriceRequest struct {
+}
+
+func (m *QueryBaseGasPriceRequest) Reset()         { *m = QueryBaseGasPriceRequest{} }
+func (m *QueryBaseGasPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseGasPriceRequest) ProtoMessage()    {}
+func (*QueryBaseGasPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f47ea1f06a5d914e, []int{2}
+}
+func (m *QueryBaseGasPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseGasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseGasPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseGasPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseGasPriceRequest.Merge(m, src)
+}
+func (m *QueryBaseGasPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseGasPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseGasPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseGasPriceRequest proto.InternalMessageInfo
+
+// QueryBaseGasPriceResponse is response type for the Query/BaseGasPrice RPC method.
+type QueryBaseGasPriceResponse struct {
+	// enabled is a flag indicating whether the fee market is enabled.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// base_gas_price is the base gas price in the bond denom that txs of the next block have to pay.
+	BaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_gas_price,json=baseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_gas_price"`
+}
+
+func (m *QueryBaseGasPriceResponse) Reset()         { *m = QueryBaseGasPriceResponse{} }
+func (m *QueryBaseGasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseGasPriceResponse) ProtoMessage()    {}
+func (*QueryBaseGasPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f47ea1f06a5d914e, []int{3}
+}
+func (m *QueryBaseGasPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseGasPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseGasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseGasPriceResponse.Merge(m, src)
+}
+func (m *QueryBaseGasPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseGasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseGasPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseGasPriceResponse proto.InternalMessageInfo
+
+func (m *QueryBaseGasPriceResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// QueryBaseGasPriceHistoryRequest is request type for the Query/BaseGasPriceHistory RPC method.
+type QueryBaseGasPriceHistoryRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBaseGasPriceHistoryRequest) Reset()         { *m = QueryBaseGasPriceHistoryRequest{} }
+func (m *QueryBaseGasPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseGasPriceHistoryRequest) ProtoMessage()    {}
+func (*QueryBaseGasPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f47ea1f06a5d914e, []int{4}
+}
+func (m *QueryBaseGasPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseGasPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseGasPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseGasPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseGasPriceHistoryRequest.Merge(m, src)
+}
+func (m *QueryBaseGasPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseGasPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseGasPriceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseGasPriceHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryBaseGasPriceHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBaseGasPriceHistoryResponse is response type for the Query/BaseGasPriceHistory RPC method.
+type QueryBaseGasPriceHistoryResponse struct {
+	// records is the list of base gas price records ordered by height.
+	Records []BaseGasPriceRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBaseGasPriceHistoryResponse) Reset()         { *m = QueryBaseGasPriceHistoryResponse{} }
+func (m *QueryBaseGasPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseGasPriceHistoryResponse) ProtoMessage()    {}
+func (*QueryBaseGasPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f47ea1f06a5d914e, []int{5}
+}
+func (m *QueryBaseGasPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseGasPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseGasPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseGasPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseGasPriceHistoryResponse.Merge(m, src)
+}
+func (m *QueryBaseGasPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseGasPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseGasPriceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseGasPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryBaseGasPriceHistoryResponse) GetRecords() []BaseGasPriceRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryBaseGasPriceHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "band.globalfee.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "band.globalfee.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryBaseGasPriceRequest)(nil), "band.globalfee.v1beta1.QueryBaseGasPriceRequest")
+	proto.RegisterType((*QueryBaseGasPriceResponse)(nil), "band.globalfee.v1beta1.QueryBaseGasPriceResponse")
+	proto.RegisterType((*QueryBaseGasPriceHistoryRequest)(nil), "band.globalfee.v1beta1.QueryBaseGasPriceHistoryRequest")
+	proto.RegisterType((*QueryBaseGasPriceHistoryResponse)(nil), "band.globalfee.v1beta1.QueryBaseGasPriceHistoryResponse")
 }
 
 func init() {
@@ -122,26 +315,44 @@ func init() {
 }
 
 var fileDescriptor_f47ea1f06a5d914e = []byte{
-	// 295 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xbf, 0x4b, 0x03, 0x31,
-	0x14, 0xc7, 0x2f, 0xa2, 0x1d, 0xe2, 0x16, 0x8b, 0x68, 0x95, 0xa8, 0x87, 0x83, 0x28, 0x24, 0xb4,
-	0x5d, 0x9d, 0x3a, 0x3b, 0x68, 0xdd, 0xdc, 0x72, 0xe7, 0x33, 0x3d, 0xb8, 0xe6, 0x5d, 0x2f, 0xb9,
-	0x62, 0x57, 0x71, 0x16, 0xc1, 0x7f, 0xaa, 0x63, 0xc1, 0xc5, 0x49, 0xe4, 0xce, 0x3f, 0x44, 0xee,
-	0x07, 0xa2, 0xd8, 0x82, 0x5b, 0x78, 0xf9, 0xbc, 0xef, 0xfb, 0xf0, 0xa5, 0x7e, 0xa0, 0xcc, 0xad,
-	0xd4, 0x31, 0x06, 0x2a, 0xbe, 0x03, 0x90, 0xd3, 0x6e, 0x00, 0x4e, 0x75, 0xe5, 0x24, 0x83, 0x74,
-	0x26, 0x92, 0x14, 0x1d, 0xb2, 0xed, 0x92, 0x11, 0xdf, 0x8c, 0x68, 0x98, 0x4e, 0x5b, 0xa3, 0xc6,
-	0x0a, 0x91, 0xe5, 0xab, 0xa6, 0x3b, 0xfb, 0x1a, 0x51, 0xc7, 0x20, 0x55, 0x12, 0x49, 0x65, 0x0c,
-	0x3a, 0xe5, 0x22, 0x34, 0xb6, 0xf9, 0x3d, 0x5e, 0x71, 0x4f, 0x83, 0x01, 0x1b, 0x35, 0x94, 0xdf,
-	0xa6, 0xec, 0xaa, 0x14, 0xb8, 0x54, 0xa9, 0x1a, 0xdb, 0x21, 0x4c, 0x32, 0xb0, 0xce, 0xbf, 0xa6,
-	0x5b, 0xbf, 0xa6, 0x36, 0x41, 0x63, 0x81, 0x9d, 0xd3, 0x56, 0x52, 0x4d, 0x76, 0xc8, 0x21, 0x39,
-	0xd9, 0xec, 0x71, 0xb1, 0xdc, 0x57, 0xd4, 0x7b, 0x83, 0xf5, 0xf9, 0xfb, 0x81, 0x37, 0x6c, 0x76,
-	0x7a, 0x4f, 0x84, 0x6e, 0x54, 0xa9, 0xec, 0x91, 0xd0, 0x56, 0x8d, 0xb0, 0xd3, 0x55, 0x11, 0x7f,
-	0xad, 0x3a, 0x67, 0xff, 0x62, 0x6b, 0x57, 0xff, 0xe8, 0xe1, 0xf5, 0xf3, 0x65, 0x6d, 0x8f, 0xed,
-	0x2e, 0xa9, 0xa0, 0x16, 0x1a, 0x5c, 0xcc, 0x73, 0x4e, 0x16, 0x39, 0x27, 0x1f, 0x39, 0x27, 0xcf,
-	0x05, 0xf7, 0x16, 0x05, 0xf7, 0xde, 0x0a, 0xee, 0xdd, 0xf4, 0x74, 0xe4, 0x46, 0x59, 0x20, 0x42,
-	0x1c, 0xcb, 0xf2, 0x66, 0xd5, 0x55, 0x88, 0xb1, 0x0c, 0x47, 0x2a, 0x32, 0x72, 0xda, 0x97, 0xf7,
-	0x3f, 0x62, 0xdd, 0x2c, 0x01, 0x1b, 0xb4, 0x2a, 0xa8, 0xff, 0x15, 0x00, 0x00, 0xff, 0xff, 0xa3,
-	0xae, 0xd5, 0xe1, 0xe8, 0x01, 0x00, 0x00,
+	// 582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x31, 0x6f, 0x13, 0x31,
+	0x14, 0xc7, 0xe3, 0x16, 0x5a, 0x70, 0x2b, 0x06, 0xb7, 0x42, 0xe9, 0x15, 0x5d, 0xd2, 0x03, 0x41,
+	0xda, 0x8a, 0x33, 0x49, 0x07, 0x18, 0x98, 0xa2, 0x8a, 0x22, 0xd4, 0xa1, 0x84, 0x01, 0x89, 0x25,
+	0xf2, 0x5d, 0x1e, 0x8e, 0x45, 0x72, 0xbe, 0x9e, 0x2f, 0x15, 0x59, 0x11, 0x33, 0x42, 0x62, 0xe7,
+	0x53, 0x20, 0x31, 0xf0, 0x05, 0x3a, 0x56, 0xb0, 0x20, 0x86, 0x0a, 0x25, 0xac, 0x7c, 0x07, 0x74,
+	0xb6, 0xd3, 0x26, 0xea, 0x85, 0xb6, 0x5b, 0x7c, 0xfe, 0xbf, 0xf7, 0xff, 0xf9, 0xff, 0x9e, 0x82,
+	0xbd, 0x80, 0x45, 0x2d, 0xca, 0x3b, 0x32, 0x60, 0x9d, 0xd7, 0x00, 0xf4, 0xa0, 0x1a, 0x40, 0xca,
+	0xaa, 0x74, 0xbf, 0x07, 0x49, 0xdf, 0x8f, 0x13, 0x99, 0x4a, 0x72, 0x33, 0xd3, 0xf8, 0x27, 0x1a,
+	0xdf, 0x6a, 0x9c, 0x65, 0x2e, 0xb9, 0xd4, 0x12, 0x9a, 0xfd, 0x32, 0x6a, 0x67, 0x25, 0x94, 0xaa,
+	0x2b, 0x55, 0xd3, 0x5c, 0x98, 0x83, 0xbd, 0xda, 0x30, 0x27, 0x1a, 0x30, 0x05, 0xc6, 0xe1, 0xc4,
+	0x2f, 0x66, 0x5c, 0x44, 0x2c, 0x15, 0x32, 0xb2, 0xda, 0x5b, 0x5c, 0x4a, 0xde, 0x01, 0xca, 0x62,
+	0x41, 0x59, 0x14, 0xc9, 0x54, 0x5f, 0x8e, 0x3a, 0xdd, 0x99, 0x82, 0xcd, 0x21, 0x02, 0x25, 0xac,
+	0xca, 0x5b, 0xc6, 0xe4, 0x79, 0xe6, 0xb2, 0xc7, 0x12, 0xd6, 0x55, 0x0d, 0xd8, 0xef, 0x81, 0x4a,
+	0xbd, 0x17, 0x78, 0x69, 0xe2, 0xab, 0x8a, 0x65, 0xa4, 0x80, 0x3c, 0xc6, 0x73, 0xb1, 0xfe, 0x52,
+	0x44, 0x65, 0x54, 0x59, 0xa8, 0xb9, 0x7e, 0xfe, 0xb3, 0x7d, 0x53, 0x57, 0xbf, 0x72, 0x78, 0x5c,
+	0x2a, 0x34, 0x6c, 0x8d, 0xe7, 0xe0, 0xa2, 0x6e, 0x5a, 0x67, 0x0a, 0x76, 0x98, 0xda, 0x4b, 0x44,
+	0x08, 0x23, 0xc3, 0x0f, 0x08, 0xaf, 0xe4, 0x5c, 0x5a, 0xdf, 0x22, 0x9e, 0x87, 0x88, 0x05, 0x1d,
+	0x68, 0x69, 0xe3, 0x6b, 0x8d, 0xd1, 0x91, 0xbc, 0xc4, 0x37, 0xb2, 0xa4, 0x9a, 0x9c, 0x65, 0x69,
+	0x8a, 0x10, 0x8a, 0x33, 0x65, 0x54, 0xb9, 0x5e, 0xaf, 0x66, 0xce, 0xbf, 0x8e, 0x4b, 0xab, 0x26,
+	0x4e, 0xd5, 0x7a, 0xe3, 0x0b, 0x49, 0xbb, 0x2c, 0x6d, 0xfb, 0xbb, 0xc0, 0x59, 0xd8, 0xdf, 0x86,
+	0xf0, 0xfb, 0x97, 0xfb, 0xd8, 0x66, 0xbf, 0x0d, 0x61, 0x63, 0x31, 0x18, 0xb3, 0xf6, 0x04, 0x2e,
+	0x9d, 0xe1, 0x79, 0x2a, 0x54, 0x2a, 0x93, 0xbe, 0x65, 0x26, 0x4f, 0x30, 0x3e, 0x1d, 0x89, 0x4d,
+	0xe4, 0xae, 0x6f, 0x3b, 0x66, 0xcd, 0x7c, 0xb3, 0x21, 0xa7, 0xa1, 0xf0, 0xd1, 0x7b, 0x1b, 0x63,
+	0x95, 0xde, 0x57, 0x84, 0xcb, 0xd3, 0xbd, 0x6c, 0x04, 0xcf, 0xf0, 0x7c, 0x02, 0xa1, 0x4c, 0x5a,
+	0x59, 0xf6, 0xb3, 0x95, 0x85, 0xda, 0xc6, 0xb4, 0xec, 0x27, 0x13, 0xcc, 0x4a, 0xec, 0x1c, 0x46,
+	0x0d, 0xc8, 0xce, 0x04, 0xf8, 0x8c, 0x06, 0xbf, 0x77, 0x2e, 0xb8, 0x01, 0x19, 0x27, 0xaf, 0xfd,
+	0x9d, 0xc5, 0x57, 0x35, 0x39, 0x79, 0x8f, 0xf0, 0x9c, 0x19, 0x3a, 0x99, 0x0a, 0x76, 0x76, 0xcf,
+	0x9c, 0xcd, 0x0b, 0x69, 0x8d, 0xb3, 0xb7, 0xf6, 0xee, 0xc7, 0x9f, 0x4f, 0x33, 0xab, 0x64, 0x25,
+	0x67, 0xa9, 0xcd, 0x8a, 0x91, 0xcf, 0x08, 0x2f, 0x8e, 0xbf, 0x9f, 0x3c, 0xf8, 0xaf, 0x41, 0xce,
+	0x26, 0x3a, 0xd5, 0x4b, 0x54, 0x58, 0xb0, 0x75, 0x0d, 0x76, 0x9b, 0xac, 0xe5, 0x80, 0x4d, 0x6e,
+	0x27, 0xf9, 0x86, 0xf0, 0x52, 0xce, 0x98, 0xc9, 0xc3, 0x0b, 0xbb, 0x4e, 0x2e, 0xa1, 0xf3, 0xe8,
+	0xf2, 0x85, 0x96, 0xba, 0xaa, 0xa9, 0x37, 0xc9, 0xfa, 0xb9, 0xd4, 0xcd, 0xb6, 0x29, 0xad, 0xef,
+	0x1e, 0x0e, 0x5c, 0x74, 0x34, 0x70, 0xd1, 0xef, 0x81, 0x8b, 0x3e, 0x0e, 0xdd, 0xc2, 0xd1, 0xd0,
+	0x2d, 0xfc, 0x1c, 0xba, 0x85, 0x57, 0x35, 0x2e, 0xd2, 0x76, 0x2f, 0xf0, 0x43, 0xd9, 0xa5, 0x19,
+	0x90, 0xfe, 0x73, 0x09, 0x65, 0x87, 0x86, 0x6d, 0x26, 0x22, 0x7a, 0xb0, 0x45, 0xdf, 0x8e, 0xd9,
+	0xa4, 0xfd, 0x18, 0x54, 0x30, 0xa7, 0x45, 0x5b, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x09, 0x8f,
+	0x35, 0x6e, 0x60, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,6 +369,10 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries parameters of globalfee module
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BaseGasPrice queries the base gas price of the next block
+	BaseGasPrice(ctx context.Context, in *QueryBaseGasPriceRequest, opts ...grpc.CallOption) (*QueryBaseGasPriceResponse, error)
+	// BaseGasPriceHistory queries the base gas prices and the gas used of the recent blocks
+	BaseGasPriceHistory(ctx context.Context, in *QueryBaseGasPriceHistoryRequest, opts ...grpc.CallOption) (*QueryBaseGasPriceHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -177,10 +392,32 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) BaseGasPrice(ctx context.Context, in *QueryBaseGasPriceRequest, opts ...grpc.CallOption) (*QueryBaseGasPriceResponse, error) {
+	out := new(QueryBaseGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/band.globalfee.v1beta1.Query/BaseGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseGasPriceHistory(ctx context.Context, in *QueryBaseGasPriceHistoryRequest, opts ...grpc.CallOption) (*QueryBaseGasPriceHistoryResponse, error) {
+	out := new(QueryBaseGasPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/band.globalfee.v1beta1.Query/BaseGasPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries parameters of globalfee module
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BaseGasPrice queries the base gas price of the next block
+	BaseGasPrice(context.Context, *QueryBaseGasPriceRequest) (*QueryBaseGasPriceResponse, error)
+	// BaseGasPriceHistory queries the base gas prices and the gas used of the recent blocks
+	BaseGasPriceHistory(context.Context, *QueryBaseGasPriceHistoryRequest) (*QueryBaseGasPriceHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -190,6 +427,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) BaseGasPrice(ctx context.Context, req *QueryBaseGasPriceRequest) (*QueryBaseGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseGasPrice not implemented")
+}
+func (*UnimplementedQueryServer) BaseGasPriceHistory(ctx context.Context, req *QueryBaseGasPriceHistoryRequest) (*QueryBaseGasPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseGasPriceHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -213,6 +456,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BaseGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.globalfee.v1beta1.Query/BaseGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseGasPrice(ctx, req.(*QueryBaseGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseGasPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseGasPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseGasPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.globalfee.v1beta1.Query/BaseGasPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseGasPriceHistory(ctx, req.(*QueryBaseGasPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "band.globalfee.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -221,6 +500,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "BaseGasPrice",
+			Handler:    _Query_BaseGasPrice_Handler,
+		},
+		{
+			MethodName: "BaseGasPriceHistory",
+			Handler:    _Query_BaseGasPriceHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/globalfee/v1beta1/query.proto",
@@ -282,6 +569,156 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBaseGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseGasPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseGasPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseGasPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseGasPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseGasPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseGasPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -313,11 +750,66 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryBaseGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBaseGasPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBaseGasPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -452,6 +944,366 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBaseGasPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseGasPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseGasPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseGasPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseGasPriceHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseGasPriceHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseGasPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseGasPriceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseGasPriceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseGasPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, BaseGasPriceRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8,12 +8,22 @@ import (
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
 )
 
-// BeginBlocker re-calculates and saves the rolling seed value based on block hashes.
+// BeginBlocker allocates oracle rewards, spawns requests from due recurring requests and prunes the IBC request
+// counters of the previous rate limit windows.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker)
 
 	// Reward a portion of block rewards (inflation + tx fee) to active oracle validators.
-	return k.AllocateTokens(ctx, ctx.VoteInfos())
+	if err := k.AllocateTokens(ctx, ctx.VoteInfos()); err != nil {
+		return err
+	}
+
+	// Spawn oracle requests for recurring requests that are due in this block.
+	k.ProcessRecurringRequests(ctx)
+
+	// Remove the IBC request counters of the previous rate limit windows once a new window starts.
+	k.PruneIBCRequestCounters(ctx)
+	return nil
 }
 
 // EndBlocker cleans up the state during end block. See comment in the implementation!
@@ -29,7 +39,7 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	k.SetPendingResolveList(ctx, []types.RequestID{})
 	// Lastly, we clean up data requests that are supposed to be expired.
 	k.ProcessExpiredRequests(ctx)
-	// NOTE: We can remove old requests from state to optimize space, using `k.DeleteRequest`
-	// and `k.DeleteReports`. We don't do that now as it is premature optimization at this state.
+	// Finally, we prune the results of expired requests that are out of the retention policy.
+	k.PruneResults(ctx)
 	return nil
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	band "github.com/bandprotocol/chain/v3/app"
 	bandtesting "github.com/bandprotocol/chain/v3/testing"
@@ -316,7 +317,42 @@ func (s *AppTestSuite) TestExpiredRequestOracleData() {
 		types.RESOLVE_STATUS_EXPIRED,
 		[]byte{},
 	)
+	// Data source fees of unreported raw requests are refunded to the requester.
+	escrowAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+	requester := bandtesting.Validators[0].Address.String()
+	refund := "9000000uband"
 	expectEvents := []abci.Event{{
+		Type: "coin_spent",
+		Attributes: []abci.EventAttribute{
+			{Key: "spender", Value: escrowAddr},
+			{Key: "amount", Value: refund},
+		},
+	}, {
+		Type: "coin_received",
+		Attributes: []abci.EventAttribute{
+			{Key: "receiver", Value: requester},
+			{Key: "amount", Value: refund},
+		},
+	}, {
+		Type: "transfer",
+		Attributes: []abci.EventAttribute{
+			{Key: "recipient", Value: requester},
+			{Key: "sender", Value: escrowAddr},
+			{Key: "amount", Value: refund},
+		},
+	}, {
+		Type: "message",
+		Attributes: []abci.EventAttribute{
+			{Key: "sender", Value: escrowAddr},
+		},
+	}, {
+		Type: types.EventTypeRefundDataSourceFee,
+		Attributes: []abci.EventAttribute{
+			{Key: types.AttributeKeyID, Value: fmt.Sprint(resPacket.RequestID)},
+			{Key: types.AttributeKeyPayer, Value: requester},
+			{Key: types.AttributeKeyFee, Value: refund},
+		},
+	}, {
 		Type: types.EventTypeResolve,
 		Attributes: []abci.EventAttribute{
 			{Key: types.AttributeKeyID, Value: fmt.Sprint(resPacket.RequestID)},
@@ -53,6 +53,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Get active status of a validator",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
 				},
+				{
+					RpcMethod:      "ValidatorReliability",
+					Use:            "validator-reliability [validator-address]",
+					Short:          "Get oracle reliability and sampling weight of a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
+				},
 				{
 					RpcMethod: "IsReporter",
 					Use:       "is-reporter [validator-address] [reporter-address]",
@@ -78,6 +84,40 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Get current parameters of Bandchain's oracle module",
 				},
+				{
+					RpcMethod:      "RecurringRequest",
+					Use:            "recurring-request [id]",
+					Short:          "Get a recurring request details and its remaining deposit",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recurring_request_id"}},
+				},
+				{
+					RpcMethod: "RecurringRequests",
+					Use:       "recurring-requests",
+					Short:     "Get all recurring requests",
+				},
+				{
+					RpcMethod:      "RecurringRequestSpawns",
+					Use:            "recurring-request-spawns [id]",
+					Short:          "Get list of request IDs spawned by given recurring request",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recurring_request_id"}},
+				},
+				{
+					RpcMethod:      "FeeEscrow",
+					Use:            "fee-escrow [request-id]",
+					Short:          "Get the data source fees escrowed for given request",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "request_id"}},
+				},
+				{
+					RpcMethod: "PrunedResults",
+					Use:       "pruned-results",
+					Short:     "Get the range of request results pruned by the result retention policy",
+				},
+				{
+					RpcMethod:      "ChannelPrepaidBalance",
+					Use:            "channel-prepaid-balance [channel-id]",
+					Short:          "Get the prepaid fee account of an oracle IBC channel and its balance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}},
+				},
 				{
 					RpcMethod: "RequestVerification",
 					Use:       "verify-request [chain-id] [validator-addr] [request-id] [data-source-external-id] [reporter-pubkey] [reporter-signature-hex]",
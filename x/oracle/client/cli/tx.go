@@ -32,10 +32,13 @@ const (
 	flagPrepareGas    = "prepare-gas"
 	flagExecuteGas    = "execute-gas"
 	flagTSSEncoder    = "tss-encoder"
+	flagCallback      = "callback"
 	flagFeeLimit      = "fee-limit"
 	flagFee           = "fee"
 	flagTreasury      = "treasury"
 	flagExpiration    = "expiration"
+	flagInterval      = "interval"
+	flagDeposit       = "deposit"
 )
 
 // NewTxCmd returns the transaction commands for this module
@@ -54,6 +57,9 @@ func NewTxCmd() *cobra.Command {
 		GetCmdCreateOracleScript(),
 		GetCmdEditOracleScript(),
 		GetCmdActivate(),
+		GetCmdCreateRecurringRequest(),
+		GetCmdUpdateRecurringRequest(),
+		GetCmdCancelRecurringRequest(),
 		GetCmdAddReporters(),
 		GetCmdRemoveReporters(),
 	)
@@ -133,6 +139,11 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --fee-l
 				return err
 			}
 
+			callback, err := cmd.Flags().GetString(flagCallback)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgRequestData(
 				oracleScriptID,
 				calldata,
@@ -145,6 +156,7 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --fee-l
 				clientCtx.GetFromAddress(),
 				types.Encoder(tssEncoder),
 			)
+			msg.Callback = callback
 
 			err = msg.ValidateBasic()
 			if err != nil {
@@ -162,6 +174,7 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --fee-l
 		String(flagFeeLimit, "", "The maximum tokens paid to all data source and TSS signature providers, if any")
 	cmd.Flags().
 		Int32(flagTSSEncoder, 0, "The encode type of oracle result that will be sent to TSS (1=proto, 2=ABI, 3=Partial ABI)")
+	cmd.Flags().String(flagCallback, "", "The name of the module to be notified when the request is resolved")
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -585,6 +598,253 @@ $ %s tx oracle activate --from mykey
 	return cmd
 }
 
+// GetCmdCreateRecurringRequest implements the create recurring request command handler.
+func GetCmdCreateRecurringRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-recurring-request [oracle-script-id] [ask-count] [min-count] [interval] (-c [calldata]) (-m [client-id]) (--prepare-gas=[prepare-gas] (--execute-gas=[execute-gas])) (--fee-limit=[fee-limit]) (--deposit=[deposit])",
+		Short: "Create a recurring request that makes a data request every given number of blocks",
+		Args:  cobra.ExactArgs(4),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a recurring request via an existing oracle script. The deposit is kept in a
+dedicated fee payer account and pays for every spawned request until it cannot cover the fee limit.
+Example:
+$ %s tx oracle create-recurring-request 1 4 3 100 -c 1234abcdef --fee-limit 10uband --deposit 1000uband --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			int64OracleScriptID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			oracleScriptID := types.OracleScriptID(int64OracleScriptID)
+
+			askCount, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			minCount, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			interval, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			calldata, err := cmd.Flags().GetBytesHex(flagCalldata)
+			if err != nil {
+				return err
+			}
+
+			clientID, err := cmd.Flags().GetString(flagClientID)
+			if err != nil {
+				return err
+			}
+
+			prepareGas, err := cmd.Flags().GetUint64(flagPrepareGas)
+			if err != nil {
+				return err
+			}
+
+			executeGas, err := cmd.Flags().GetUint64(flagExecuteGas)
+			if err != nil {
+				return err
+			}
+
+			coinStr, err := cmd.Flags().GetString(flagFeeLimit)
+			if err != nil {
+				return err
+			}
+
+			feeLimit, err := sdk.ParseCoinsNormalized(coinStr)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(flagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			tssEncoder, err := cmd.Flags().GetInt32(flagTSSEncoder)
+			if err != nil {
+				return err
+			}
+
+			callback, err := cmd.Flags().GetString(flagCallback)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateRecurringRequest(
+				oracleScriptID,
+				calldata,
+				askCount,
+				minCount,
+				clientID,
+				feeLimit,
+				prepareGas,
+				executeGas,
+				types.Encoder(tssEncoder),
+				callback,
+				interval,
+				deposit,
+				clientCtx.GetFromAddress(),
+			)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().BytesHexP(flagCalldata, "c", nil, "Calldata used in calling the oracle script")
+	cmd.Flags().StringP(flagClientID, "m", "", "Requester can match up the request with response by clientID")
+	cmd.Flags().Uint64(flagPrepareGas, 50000, "Prepare gas used in fee counting for prepare request")
+	cmd.Flags().Uint64(flagExecuteGas, 300000, "Execute gas used in fee counting for execute request")
+	cmd.Flags().
+		String(flagFeeLimit, "", "The maximum tokens paid to all data source and TSS signature providers for each request")
+	cmd.Flags().String(flagDeposit, "", "The initial deposit used to pay for the spawned requests")
+	cmd.Flags().
+		Int32(flagTSSEncoder, 0, "The encode type of oracle result that will be sent to TSS (1=proto, 2=ABI, 3=Partial ABI)")
+	cmd.Flags().String(flagCallback, "", "The name of the module to be notified when a spawned request is resolved")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdUpdateRecurringRequest implements the update recurring request command handler.
+func GetCmdUpdateRecurringRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-recurring-request [id] [interval] (--fee-limit=[fee-limit]) (--deposit=[deposit])",
+		Short: "Update the interval and fee limit of a recurring request and top up its deposit",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the interval and fee limit of a recurring request and top up its deposit.
+An inactive recurring request is reactivated by this command.
+Example:
+$ %s tx oracle update-recurring-request 1 200 --fee-limit 10uband --deposit 1000uband --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			interval, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			coinStr, err := cmd.Flags().GetString(flagFeeLimit)
+			if err != nil {
+				return err
+			}
+
+			feeLimit, err := sdk.ParseCoinsNormalized(coinStr)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(flagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateRecurringRequest(
+				types.RecurringRequestID(id),
+				interval,
+				feeLimit,
+				deposit,
+				clientCtx.GetFromAddress(),
+			)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().
+		String(flagFeeLimit, "", "The maximum tokens paid to all data source and TSS signature providers for each request")
+	cmd.Flags().String(flagDeposit, "", "The additional deposit used to pay for the spawned requests")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCancelRecurringRequest implements the cancel recurring request command handler.
+func GetCmdCancelRecurringRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-recurring-request [id]",
+		Short: "Cancel a recurring request and refund its remaining deposit",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a recurring request and refund its remaining deposit to the owner.
+Example:
+$ %s tx oracle cancel-recurring-request 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelRecurringRequest(types.RecurringRequestID(id), clientCtx.GetFromAddress())
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdAddReporters implements the add reporters command handler.
 func GetCmdAddReporters() *cobra.Command {
 	cmd := &cobra.Command{
@@ -21,6 +21,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *types.GenesisState) {
 	k.SetOracleScriptCount(ctx, 0)
 	k.SetRequestCount(ctx, 0)
 	k.SetRequestLastExpired(ctx, 0)
+	k.SetResultLastPruned(ctx, 0)
 	for _, dataSource := range data.DataSources {
 		_ = k.AddDataSource(ctx, dataSource)
 	}
@@ -28,6 +29,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *types.GenesisState) {
 		_ = k.AddOracleScript(ctx, oracleScript)
 	}
 
+	k.SetRecurringRequestCount(ctx, data.RecurringRequestCount)
+	for _, recurringRequest := range data.RecurringRequests {
+		k.SetRecurringRequest(ctx, recurringRequest)
+		if recurringRequest.IsActive {
+			k.ScheduleRecurringRequest(ctx, recurringRequest)
+		}
+	}
+
 	k.SetPort(ctx, types.PortID)
 	// Only try to bind to port if it is not already bound, since we may already own
 	// port capability from capability InitGenesis
@@ -47,5 +56,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		Params:        k.GetParams(ctx),
 		DataSources:   k.GetAllDataSources(ctx),
 		OracleScripts: k.GetAllOracleScripts(ctx),
+
+		RecurringRequests:     k.GetAllRecurringRequests(ctx),
+		RecurringRequestCount: k.GetRecurringRequestCount(ctx),
 	}
 }
@@ -0,0 +1,101 @@
+package oracle_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	band "github.com/bandprotocol/chain/v3/app"
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/x/oracle"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func setupGenesisTestApp(t *testing.T) *band.BandApp {
+	app := bandtesting.SetupWithCustomHome(false, testutil.GetTempDir(t))
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: app.LastBlockHeight() + 1})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+	return app
+}
+
+func TestExportImportRecurringRequests(t *testing.T) {
+	app := setupGenesisTestApp(t)
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{Height: 10})
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("uband", 100000))
+
+	for _, interval := range []uint64{10, 20} {
+		msg := types.NewMsgCreateRecurringRequest(
+			1, []byte("calldata"), 1, 1, "client-id", deposit,
+			bandtesting.TestDefaultPrepareGas, bandtesting.TestDefaultExecuteGas,
+			0, "", interval, deposit, bandtesting.Alice.Address,
+		)
+		_, err := app.OracleKeeper.AddRecurringRequest(ctx, msg, bandtesting.Alice.Address)
+		require.NoError(t, err)
+	}
+	_, err := app.OracleKeeper.RemoveRecurringRequest(ctx, 1, bandtesting.Alice.Address)
+	require.NoError(t, err)
+
+	genesis := oracle.ExportGenesis(ctx, app.OracleKeeper)
+	require.NoError(t, genesis.Validate())
+	require.Equal(t, uint64(2), genesis.RecurringRequestCount)
+	require.Len(t, genesis.RecurringRequests, 1)
+
+	newApp := setupGenesisTestApp(t)
+	newCtx := newApp.BaseApp.NewUncachedContext(false, cmtproto.Header{Height: 10})
+	oracle.InitGenesis(newCtx, newApp.OracleKeeper, genesis)
+
+	require.Equal(t, genesis.RecurringRequests, newApp.OracleKeeper.GetAllRecurringRequests(newCtx))
+	require.Equal(t, uint64(2), newApp.OracleKeeper.GetRecurringRequestCount(newCtx))
+	require.Equal(
+		t,
+		[]types.RecurringRequestID{2},
+		newApp.OracleKeeper.GetDueRecurringRequestIDs(newCtx.WithBlockHeight(11), types.MaxRecurringRequestSpawnsPerBlock),
+	)
+}
+
+func TestValidateGenesisRecurringRequests(t *testing.T) {
+	recurringRequest := types.NewRecurringRequest(
+		1, 1, []byte("calldata"), 1, 1, "client-id", nil, 1, 1, 0, "", 10, 11,
+		bandtesting.Bob.Address, bandtesting.Alice.Address,
+	)
+
+	testCases := []struct {
+		name    string
+		genesis types.GenesisState
+		expErr  bool
+	}{
+		{
+			"valid",
+			types.GenesisState{RecurringRequests: []types.RecurringRequest{recurringRequest}, RecurringRequestCount: 1},
+			false,
+		},
+		{"id above count", types.GenesisState{RecurringRequests: []types.RecurringRequest{recurringRequest}}, true},
+		{
+			"duplicate id",
+			types.GenesisState{
+				RecurringRequests:     []types.RecurringRequest{recurringRequest, recurringRequest},
+				RecurringRequestCount: 1,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -70,6 +70,13 @@ func ValidateOracleChannelParams(
 	return nil
 }
 
+// isSupportedVersion returns whether the given version is supported by the oracle module. Channels of version
+// bandchain-2 pay the data source fees of their requests from their prepaid accounts and get the expiration
+// heights of their requests in the acknowledgements.
+func isSupportedVersion(version string) bool {
+	return version == types.Version || version == types.Version2
+}
+
 // OnChanOpenInit implements the IBCModule interface
 func (im IBCModule) OnChanOpenInit(
 	ctx sdk.Context,
@@ -89,8 +96,8 @@ func (im IBCModule) OnChanOpenInit(
 		version = types.Version
 	}
 
-	if version != types.Version {
-		return "", types.ErrInvalidVersion.Wrapf("got %s, expected %s", version, types.Version)
+	if !isSupportedVersion(version) {
+		return "", types.ErrInvalidVersion.Wrapf("got %s, expected %s or %s", version, types.Version, types.Version2)
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -116,11 +123,12 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
+	if !isSupportedVersion(counterpartyVersion) {
 		return "", types.ErrInvalidVersion.Wrapf(
-			"invalid counterparty version: got: %s, expected %s",
+			"invalid counterparty version: got: %s, expected %s or %s",
 			counterpartyVersion,
 			types.Version,
+			types.Version2,
 		)
 	}
 
@@ -135,7 +143,7 @@ func (im IBCModule) OnChanOpenTry(
 		}
 	}
 
-	return types.Version, nil
+	return counterpartyVersion, nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -146,11 +154,12 @@ func (im IBCModule) OnChanOpenAck(
 	counterpartyChannelID string,
 	counterpartyVersion string,
 ) error {
-	if counterpartyVersion != types.Version {
+	if !isSupportedVersion(counterpartyVersion) {
 		return types.ErrInvalidVersion.Wrapf(
-			"invalid counterparty version: %s, expected %s",
+			"invalid counterparty version: %s, expected %s or %s",
 			counterpartyVersion,
 			types.Version,
+			types.Version2,
 		)
 	}
 	return nil
@@ -197,13 +206,11 @@ func (im IBCModule) OnRecvPacket(
 		return channeltypes.NewErrorAcknowledgement(errors.New("cannot unmarshal oracle request packet data"))
 	}
 
-	id, err := im.keeper.OnRecvPacket(ctx, packet, data, relayer)
+	ack, err := im.keeper.OnRecvPacket(ctx, packet, data, relayer)
 	if err != nil {
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
-	return channeltypes.NewResultAcknowledgement(
-		types.ModuleCdc.MustMarshalJSON(types.NewOracleRequestPacketAcknowledgement(id)),
-	)
+	return channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalJSON(ack))
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface
@@ -0,0 +1,75 @@
+package oracle
+
+import (
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/keeper"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+var _ porttypes.IBCModule = PrepayMiddleware{}
+
+// PrepayMiddleware wraps an ICS-20 transfer module to credit the tokens of transfers with a prepay memo
+// to the prepaid account of an oracle channel, which pays the data source fees of the requests from
+// that channel.
+type PrepayMiddleware struct {
+	porttypes.IBCModule
+
+	keeper keeper.Keeper
+}
+
+// NewPrepayMiddleware creates a new PrepayMiddleware given the underlying transfer module and the oracle keeper
+func NewPrepayMiddleware(app porttypes.IBCModule, k keeper.Keeper) PrepayMiddleware {
+	return PrepayMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface. The receiver of a transfer with a prepay memo is
+// replaced by the prepaid account of the oracle channel in the memo before the transfer is processed.
+// Transfers without a well-formed prepay memo are processed as usual.
+func (im PrepayMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	channelID, ok := types.ParsePrepayMemo(data.Memo)
+	if !ok {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	// Only channels paying the fees of their requests from their prepaid accounts can be prepaid, so that the
+	// tokens are never stuck in the prepaid account of another channel.
+	if !im.keeper.IsPrepaidChannel(ctx, channelID) {
+		return channeltypes.NewErrorAcknowledgement(
+			types.ErrInvalidPrepayMemo.Wrapf("oracle channel %s not found or not prepaid", channelID),
+		)
+	}
+
+	data.Receiver = keeper.GetChannelPrepaidAccount(channelID).String()
+	packet.Data = data.GetBytes()
+
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if ack.Success() {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypePrepayChannelFee,
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeySender, data.Sender),
+			sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
+		))
+	}
+
+	return ack
+}
@@ -1,12 +1,14 @@
 package oracle_test
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
@@ -14,12 +16,14 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	band "github.com/bandprotocol/chain/v3/app"
 	"github.com/bandprotocol/chain/v3/pkg/obi"
 	bandtesting "github.com/bandprotocol/chain/v3/testing"
 	"github.com/bandprotocol/chain/v3/testing/testdata"
+	oraclekeeper "github.com/bandprotocol/chain/v3/x/oracle/keeper"
 	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
 )
 
@@ -50,11 +54,7 @@ func (suite *IBCTestSuite) SetupTest() {
 	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
 	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
 
-	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
-	suite.path.EndpointA.ChannelConfig.PortID = oracletypes.ModuleName
-	suite.path.EndpointA.ChannelConfig.Version = oracletypes.Version
-	suite.path.EndpointB.ChannelConfig.PortID = oracletypes.ModuleName
-	suite.path.EndpointB.ChannelConfig.Version = oracletypes.Version
+	suite.path = suite.newOraclePath(oracletypes.Version)
 
 	suite.bandApp = suite.chainB.App.(*band.BandApp)
 
@@ -72,8 +72,20 @@ func (suite *IBCTestSuite) SetupTest() {
 	suite.coordinator.CommitBlock(suite.chainB)
 }
 
+// newOraclePath returns a path between the oracle ports of chain A and chain B with the given channel version.
+func (suite *IBCTestSuite) newOraclePath(version string) *ibctesting.Path {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.PortID = oracletypes.ModuleName
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.PortID = oracletypes.ModuleName
+	path.EndpointB.ChannelConfig.Version = version
+	return path
+}
+
 func (suite *IBCTestSuite) sendReport(requestID oracletypes.RequestID, report oracletypes.Report, needToResolve bool) {
 	suite.bandApp.OracleKeeper.SetReport(suite.chainB.GetContext(), requestID, report)
+	err := suite.bandApp.OracleKeeper.PayDataSourceFees(suite.chainB.GetContext(), requestID, report.RawReports)
+	suite.Require().NoError(err)
 	if needToResolve {
 		suite.bandApp.OracleKeeper.AddPendingRequest(suite.chainB.GetContext(), requestID)
 	}
@@ -102,6 +114,44 @@ func (suite *IBCTestSuite) sendOracleRequestPacket(
 	return packet
 }
 
+func (suite *IBCTestSuite) relayPacket(path *ibctesting.Path, packet channeltypes.Packet) channeltypes.Acknowledgement {
+	_, ackBz, err := path.RelayPacketWithResults(packet)
+	suite.Require().NoError(err)
+
+	var ack channeltypes.Acknowledgement
+	err = channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack)
+	suite.Require().NoError(err)
+	return ack
+}
+
+func (suite *IBCTestSuite) parseOracleRequestPacketAcknowledgement(
+	ack channeltypes.Acknowledgement,
+) *oracletypes.OracleRequestPacketAcknowledgement {
+	var oracleAck oracletypes.OracleRequestPacketAcknowledgement
+	err := oracletypes.ModuleCdc.UnmarshalJSON(ack.GetResult(), &oracleAck)
+	suite.Require().NoError(err)
+	return &oracleAck
+}
+
+func (suite *IBCTestSuite) parseOracleRequestPacketAcknowledgementV2(
+	ack channeltypes.Acknowledgement,
+) *oracletypes.OracleRequestPacketAcknowledgementV2 {
+	var oracleAck oracletypes.OracleRequestPacketAcknowledgementV2
+	err := oracletypes.ModuleCdc.UnmarshalJSON(ack.GetResult(), &oracleAck)
+	suite.Require().NoError(err)
+	return &oracleAck
+}
+
+// prepayChannelFee sends the given amount from the sender of chain B to the prepaid account of the given channel.
+func (suite *IBCTestSuite) prepayChannelFee(channelID string, amount sdk.Coins) {
+	_, err := suite.chainB.SendMsgs(banktypes.NewMsgSend(
+		suite.chainB.SenderAccount.GetAddress(),
+		oraclekeeper.GetChannelPrepaidAccount(channelID),
+		amount,
+	))
+	suite.Require().NoError(err)
+}
+
 func (suite *IBCTestSuite) checkChainBTreasuryBalances(expect sdk.Coins) {
 	treasuryBalances := suite.bandApp.BankKeeper.GetAllBalances(
 		suite.chainB.GetContext(),
@@ -110,6 +160,14 @@ func (suite *IBCTestSuite) checkChainBTreasuryBalances(expect sdk.Coins) {
 	suite.Require().Equal(expect, treasuryBalances)
 }
 
+func (suite *IBCTestSuite) checkChainBEscrowBalances(expect sdk.Coins) {
+	escrowBalances := suite.bandApp.BankKeeper.GetAllBalances(
+		suite.chainB.GetContext(),
+		authtypes.NewModuleAddress(oracletypes.ModuleName),
+	)
+	suite.Require().Equal(expect, escrowBalances)
+}
+
 func (suite *IBCTestSuite) checkChainBSenderBalances(expect sdk.Coins) {
 	b := suite.bandApp.BankKeeper.GetAllBalances(
 		suite.chainB.GetContext(),
@@ -137,11 +195,19 @@ func (suite *IBCTestSuite) TestHandleIBCRequestSuccess() {
 	)
 	packet := suite.sendOracleRequestPacket(path, 1, oracleRequestPacket, timeoutHeight)
 
-	err := path.RelayPacket(packet)
-	suite.Require().NoError(err) // relay committed
+	ack := suite.relayPacket(path, packet)
+	suite.Require().True(ack.Success())
 
-	// Treasury get fees from relayer
-	suite.checkChainBTreasuryBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(12000000))))
+	// The acknowledgement of a bandchain-1 channel keeps its original format
+	suite.Require().JSONEq(`{"request_id":"1"}`, string(ack.GetResult()))
+	suite.Require().Equal(
+		oracletypes.NewOracleRequestPacketAcknowledgement(1),
+		suite.parseOracleRequestPacketAcknowledgement(ack),
+	)
+
+	// Fees from relayer are held in escrow until the raw requests are reported
+	suite.checkChainBTreasuryBalances(sdk.NewCoins())
+	suite.checkChainBEscrowBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(12000000))))
 
 	raws1 := []oracletypes.RawReport{
 		oracletypes.NewRawReport(1, 0, []byte("data1")),
@@ -165,6 +231,10 @@ func (suite *IBCTestSuite) TestHandleIBCRequestSuccess() {
 		true,
 	)
 
+	// Treasury is paid for reported raw requests and the rest is refunded on resolve
+	suite.checkChainBTreasuryBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(6000000))))
+	suite.checkChainBEscrowBalances(sdk.NewCoins())
+
 	oracleResponsePacket := oracletypes.NewOracleResponsePacketData(
 		path.EndpointA.ClientID,
 		1,
@@ -697,7 +767,8 @@ func (suite *IBCTestSuite) TestIBCResolveRequestOutOfGas() {
 	err := path.RelayPacket(packet)
 	suite.Require().NoError(err) // relay committed
 
-	suite.checkChainBTreasuryBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(6000000))))
+	suite.checkChainBTreasuryBalances(sdk.NewCoins())
+	suite.checkChainBEscrowBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(6000000))))
 	suite.checkChainBSenderBalances(expectedSenderBalance)
 
 	raws := []oracletypes.RawReport{
@@ -711,6 +782,11 @@ func (suite *IBCTestSuite) TestIBCResolveRequestOutOfGas() {
 		true,
 	)
 
+	// Only one of two validators reported, so half of the fees are refunded to the sender
+	suite.checkChainBTreasuryBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(3000000))))
+	suite.checkChainBEscrowBalances(sdk.NewCoins())
+	suite.checkChainBSenderBalances(expectedSenderBalance.Add(sdk.NewCoin("uband", math.NewInt(3000000))))
+
 	commitment := suite.bandApp.IBCKeeper.ChannelKeeper.GetPacketCommitment(
 		suite.chainB.GetContext(),
 		path.EndpointB.ChannelConfig.PortID,
@@ -766,7 +842,8 @@ func (suite *IBCTestSuite) TestIBCResolveReadNilExternalData() {
 	err := path.RelayPacket(packet)
 	suite.Require().NoError(err) // relay committed
 
-	suite.checkChainBTreasuryBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(4000000))))
+	suite.checkChainBTreasuryBalances(sdk.NewCoins())
+	suite.checkChainBEscrowBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(4000000))))
 	suite.checkChainBSenderBalances(expectedSenderBalance)
 
 	raws1 := []oracletypes.RawReport{
@@ -843,6 +920,10 @@ func (suite *IBCTestSuite) TestIBCResolveRequestNoReturnData() {
 		bandtesting.FeePayer.Address.String(),
 		bandtesting.Coins100000000uband,
 	))
+	suite.bandApp.OracleKeeper.SetFeeEscrow(
+		suite.chainB.GetContext(),
+		oracletypes.NewFeeEscrow(1, bandtesting.FeePayer.Address.String(), nil, sdk.NewCoins()),
+	)
 
 	raws := []oracletypes.RawReport{oracletypes.NewRawReport(1, 0, []byte("test"))}
 	suite.sendReport(
@@ -905,6 +986,10 @@ func (suite *IBCTestSuite) TestIBCResolveRequestWasmFailure() {
 		bandtesting.FeePayer.Address.String(),
 		bandtesting.Coins100000000uband,
 	))
+	suite.bandApp.OracleKeeper.SetFeeEscrow(
+		suite.chainB.GetContext(),
+		oracletypes.NewFeeEscrow(1, bandtesting.FeePayer.Address.String(), nil, sdk.NewCoins()),
+	)
 
 	raws := []oracletypes.RawReport{oracletypes.NewRawReport(1, 0, []byte("test"))}
 	suite.sendReport(
@@ -967,6 +1052,10 @@ func (suite *IBCTestSuite) TestIBCResolveRequestCallReturnDataSeveralTimes() {
 		bandtesting.FeePayer.Address.String(),
 		bandtesting.Coins100000000uband,
 	))
+	suite.bandApp.OracleKeeper.SetFeeEscrow(
+		suite.chainB.GetContext(),
+		oracletypes.NewFeeEscrow(1, bandtesting.FeePayer.Address.String(), nil, sdk.NewCoins()),
+	)
 
 	raws := []oracletypes.RawReport{oracletypes.NewRawReport(1, 0, []byte("test"))}
 	suite.sendReport(
@@ -1005,6 +1094,332 @@ func (suite *IBCTestSuite) TestIBCResolveRequestCallReturnDataSeveralTimes() {
 	suite.Equal(expectCommitment, commitment)
 }
 
+func (suite *IBCTestSuite) TestHandleIBCRequestWithPrepaidFee() {
+	path := suite.newOraclePath(oracletypes.Version2)
+	suite.coordinator.Setup(path)
+
+	// prepay the fees of the requests from the channel
+	prepaidAccount := oraclekeeper.GetChannelPrepaidAccount(path.EndpointB.ChannelID)
+	prepaid := sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(12000000)))
+	suite.prepayChannelFee(path.EndpointB.ChannelID, prepaid)
+
+	expectedBalance := suite.bandApp.BankKeeper.GetAllBalances(
+		suite.chainB.GetContext(),
+		suite.chainB.SenderAccount.GetAddress(),
+	)
+
+	// send request from A to B
+	timeoutHeight := clienttypes.NewHeight(10, 110)
+	oracleRequestPacket := oracletypes.NewOracleRequestPacketData(
+		path.EndpointA.ClientID,
+		1,
+		[]byte("test"),
+		4,
+		2,
+		0,
+		sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(12000000))),
+		bandtesting.TestDefaultPrepareGas,
+		bandtesting.TestDefaultExecuteGas,
+	)
+	packet := suite.sendOracleRequestPacket(path, 1, oracleRequestPacket, timeoutHeight)
+
+	ack := suite.relayPacket(path, packet)
+	suite.Require().True(ack.Success())
+
+	// The acknowledgement of a bandchain-2 channel tells the requester chain when the request expires
+	request, err := suite.bandApp.OracleKeeper.GetRequest(suite.chainB.GetContext(), 1)
+	suite.Require().NoError(err)
+	expirationBlockCount := int64(suite.bandApp.OracleKeeper.GetParams(suite.chainB.GetContext()).ExpirationBlockCount)
+	suite.Require().Equal(
+		oracletypes.NewOracleRequestPacketAcknowledgementV2(1, request.RequestHeight+expirationBlockCount),
+		suite.parseOracleRequestPacketAcknowledgementV2(ack),
+	)
+
+	// Fees are collected from the prepaid account instead of the relayer
+	suite.checkChainBSenderBalances(expectedBalance)
+	suite.checkChainBEscrowBalances(prepaid)
+	suite.Require().True(suite.bandApp.BankKeeper.GetAllBalances(suite.chainB.GetContext(), prepaidAccount).IsZero())
+
+	raws := []oracletypes.RawReport{
+		oracletypes.NewRawReport(1, 0, []byte("data1")),
+		oracletypes.NewRawReport(2, 0, []byte("data2")),
+		oracletypes.NewRawReport(3, 0, []byte("data3")),
+	}
+	suite.sendReport(
+		oracletypes.RequestID(1),
+		oracletypes.NewReport(sdk.ValAddress(suite.chainB.Vals.Validators[0].Address), true, raws),
+		false,
+	)
+	suite.sendReport(
+		oracletypes.RequestID(1),
+		oracletypes.NewReport(sdk.ValAddress(suite.chainB.Vals.Validators[2].Address), true, raws),
+		true,
+	)
+
+	// The unused fees are refunded to the prepaid account
+	refund := sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(6000000)))
+	suite.checkChainBTreasuryBalances(sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(6000000))))
+	suite.checkChainBEscrowBalances(sdk.NewCoins())
+	suite.Require().Equal(refund, suite.bandApp.BankKeeper.GetAllBalances(suite.chainB.GetContext(), prepaidAccount))
+
+	// The governance withdraws the remaining prepaid fees
+	msgServer := oraclekeeper.NewMsgServerImpl(suite.bandApp.OracleKeeper)
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	_, err = msgServer.WithdrawChannelPrepaidFee(
+		suite.chainB.GetContext(),
+		oracletypes.NewMsgWithdrawChannelPrepaidFee(
+			suite.bandApp.OracleKeeper.GetAuthority(),
+			path.EndpointB.ChannelID,
+			receiver,
+			refund.Add(refund...),
+		),
+	)
+	suite.Require().ErrorIs(err, oracletypes.ErrInsufficientPrepaidFee)
+
+	_, err = msgServer.WithdrawChannelPrepaidFee(
+		suite.chainB.GetContext(),
+		oracletypes.NewMsgWithdrawChannelPrepaidFee(
+			receiver.String(),
+			path.EndpointB.ChannelID,
+			receiver,
+			refund,
+		),
+	)
+	suite.Require().Error(err)
+
+	_, err = msgServer.WithdrawChannelPrepaidFee(
+		suite.chainB.GetContext(),
+		oracletypes.NewMsgWithdrawChannelPrepaidFee(
+			suite.bandApp.OracleKeeper.GetAuthority(),
+			path.EndpointB.ChannelID,
+			receiver,
+			refund,
+		),
+	)
+	suite.Require().NoError(err)
+	suite.checkChainBSenderBalances(expectedBalance.Add(refund...))
+	suite.Require().True(suite.bandApp.BankKeeper.GetAllBalances(suite.chainB.GetContext(), prepaidAccount).IsZero())
+}
+
+func (suite *IBCTestSuite) TestHandleIBCRequestWithInsufficientPrepaidFee() {
+	path := suite.newOraclePath(oracletypes.Version2)
+	suite.coordinator.Setup(path)
+
+	prepaidAccount := oraclekeeper.GetChannelPrepaidAccount(path.EndpointB.ChannelID)
+	prepaid := sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(6000000)))
+	suite.prepayChannelFee(path.EndpointB.ChannelID, prepaid)
+
+	expectedBalance := suite.bandApp.BankKeeper.GetAllBalances(
+		suite.chainB.GetContext(),
+		suite.chainB.SenderAccount.GetAddress(),
+	)
+
+	timeoutHeight := clienttypes.NewHeight(10, 110)
+	oracleRequestPacket := oracletypes.NewOracleRequestPacketData(
+		path.EndpointA.ClientID,
+		1,
+		[]byte("test"),
+		4,
+		2,
+		0,
+		sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(12000000))),
+		bandtesting.TestDefaultPrepareGas,
+		bandtesting.TestDefaultExecuteGas,
+	)
+	packet := suite.sendOracleRequestPacket(path, 1, oracleRequestPacket, timeoutHeight)
+
+	ack := suite.relayPacket(path, packet)
+	suite.Require().False(ack.Success())
+
+	// The request fails instead of charging the relayer
+	suite.Require().Equal(uint64(0), suite.bandApp.OracleKeeper.GetRequestCount(suite.chainB.GetContext()))
+	suite.checkChainBSenderBalances(expectedBalance)
+	suite.checkChainBEscrowBalances(sdk.NewCoins())
+	suite.Require().Equal(prepaid, suite.bandApp.BankKeeper.GetAllBalances(suite.chainB.GetContext(), prepaidAccount))
+}
+
+func (suite *IBCTestSuite) TestIBCRequestRateLimit() {
+	// open another channel on the same connection, and so the same light client, as the path of the suite
+	otherPath := suite.newOraclePath(oracletypes.Version)
+	otherPath.EndpointA.ClientID = suite.path.EndpointA.ClientID
+	otherPath.EndpointA.ConnectionID = suite.path.EndpointA.ConnectionID
+	otherPath.EndpointB.ClientID = suite.path.EndpointB.ClientID
+	otherPath.EndpointB.ConnectionID = suite.path.EndpointB.ConnectionID
+	suite.coordinator.CreateChannels(otherPath)
+
+	params := suite.bandApp.OracleKeeper.GetParams(suite.chainB.GetContext())
+	params.IBCRequestRateLimitWindow = 1000
+	params.MaxIBCRequestsPerChannel = 2
+	params.MaxIBCRequestsPerLightClient = 3
+	err := suite.bandApp.OracleKeeper.SetParams(suite.chainB.GetContext(), params)
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.chainB)
+
+	timeoutHeight := clienttypes.NewHeight(10, 110)
+	testCases := []struct {
+		path     *ibctesting.Path
+		clientID string
+		success  bool
+	}{
+		{suite.path, "client-1", true},
+		{suite.path, "client-2", true},
+		// exceeds the limit of the channel regardless of the client ID of the request
+		{suite.path, "client-3", false},
+		{otherPath, "client-4", true},
+		// exceeds the limit of the light client shared by both channels
+		{otherPath, "client-5", false},
+	}
+
+	sequences := make(map[*ibctesting.Path]uint64)
+	for i, tc := range testCases {
+		oracleRequestPacket := oracletypes.NewOracleRequestPacketData(
+			tc.clientID,
+			1,
+			[]byte("test"),
+			1,
+			1,
+			0,
+			sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(6000000))),
+			bandtesting.TestDefaultPrepareGas,
+			bandtesting.TestDefaultExecuteGas,
+		)
+		sequences[tc.path]++
+		packet := suite.sendOracleRequestPacket(tc.path, sequences[tc.path], oracleRequestPacket, timeoutHeight)
+
+		ack := suite.relayPacket(tc.path, packet)
+		suite.Require().Equal(tc.success, ack.Success(), "request %d", i)
+	}
+
+	suite.Require().Equal(uint64(3), suite.bandApp.OracleKeeper.GetRequestCount(suite.chainB.GetContext()))
+
+	lightClientKey := oracletypes.IBCLightClientRequestCounterStoreKey(suite.path.EndpointB.ClientID)
+	suite.Require().Equal(
+		uint64(3),
+		suite.bandApp.OracleKeeper.GetIBCRequestCounter(suite.chainB.GetContext(), lightClientKey).Count,
+	)
+
+	// The counters are pruned at the start of the next window
+	params.IBCRequestRateLimitWindow = 5
+	err = suite.bandApp.OracleKeeper.SetParams(suite.chainB.GetContext(), params)
+	suite.Require().NoError(err)
+	for i := 0; i < 5; i++ {
+		suite.coordinator.CommitBlock(suite.chainB)
+	}
+
+	for _, key := range [][]byte{
+		oracletypes.IBCChannelRequestCounterStoreKey(suite.path.EndpointB.ChannelID),
+		oracletypes.IBCChannelRequestCounterStoreKey(otherPath.EndpointB.ChannelID),
+		lightClientKey,
+	} {
+		suite.Require().Equal(
+			oracletypes.IBCRequestCounter{},
+			suite.bandApp.OracleKeeper.GetIBCRequestCounter(suite.chainB.GetContext(), key),
+		)
+	}
+}
+
+func (suite *IBCTestSuite) TestPrepayChannelFeeWithTransfer() {
+	prepaidPath := suite.newOraclePath(oracletypes.Version2)
+	suite.coordinator.Setup(prepaidPath)
+
+	transferPath := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(transferPath)
+
+	amount := sdk.NewCoin("uband", math.NewInt(1000000))
+	voucherDenom := ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(
+		transferPath.EndpointB.ChannelConfig.PortID,
+		transferPath.EndpointB.ChannelID,
+		amount.Denom,
+	)).IBCDenom()
+	timeoutHeight := clienttypes.NewHeight(10, 110)
+
+	testCases := []struct {
+		name string
+		memo string
+		// the channel whose prepaid account is credited, or empty if the receiver is credited
+		channelID string
+		success   bool
+	}{
+		{
+			"prepay oracle channel",
+			fmt.Sprintf(`{"oracle":{"prepay_channel_id":"%s"}}`, prepaidPath.EndpointB.ChannelID),
+			prepaidPath.EndpointB.ChannelID,
+			true,
+		},
+		{
+			"oracle channel not prepaid",
+			fmt.Sprintf(`{"oracle":{"prepay_channel_id":"%s"}}`, suite.path.EndpointB.ChannelID),
+			suite.path.EndpointB.ChannelID,
+			false,
+		},
+		{
+			"oracle channel not found",
+			`{"oracle":{"prepay_channel_id":"channel-99"}}`,
+			"channel-99",
+			false,
+		},
+		{
+			"invalid prepay memo",
+			`{"oracle":{"prepay_channel_id":""}}`,
+			"",
+			true,
+		},
+		{
+			"oracle memo of other application",
+			`{"oracle":"price"}`,
+			"",
+			true,
+		},
+	}
+
+	expectedReceiverBalance := sdk.NewCoin(voucherDenom, math.ZeroInt())
+	for _, tc := range testCases {
+		msg := ibctransfertypes.NewMsgTransfer(
+			transferPath.EndpointA.ChannelConfig.PortID,
+			transferPath.EndpointA.ChannelID,
+			amount,
+			suite.chainA.SenderAccount.GetAddress().String(),
+			suite.chainB.SenderAccount.GetAddress().String(),
+			timeoutHeight,
+			0,
+			tc.memo,
+		)
+		res, err := suite.chainA.SendMsgs(msg)
+		suite.Require().NoError(err, tc.name)
+
+		packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+		suite.Require().NoError(err, tc.name)
+
+		ack := suite.relayPacket(transferPath, packet)
+		suite.Require().Equal(tc.success, ack.Success(), tc.name)
+
+		if tc.channelID == "" {
+			// the transfer is handled as an ordinary transfer to the receiver
+			expectedReceiverBalance.Amount = expectedReceiverBalance.Amount.Add(amount.Amount)
+			continue
+		}
+
+		prepaidBalance := suite.bandApp.BankKeeper.GetBalance(
+			suite.chainB.GetContext(),
+			oraclekeeper.GetChannelPrepaidAccount(tc.channelID),
+			voucherDenom,
+		)
+		if tc.success {
+			suite.Require().Equal(amount.Amount, prepaidBalance.Amount, tc.name)
+		} else {
+			suite.Require().True(prepaidBalance.IsZero(), tc.name)
+		}
+	}
+
+	// the receiver is credited only by the transfers without a prepay memo
+	receiverBalance := suite.bandApp.BankKeeper.GetBalance(
+		suite.chainB.GetContext(),
+		suite.chainB.SenderAccount.GetAddress(),
+		voucherDenom,
+	)
+	suite.Require().Equal(expectedReceiverBalance, receiverBalance)
+}
+
 func TestIBCTestSuite(t *testing.T) {
 	suite.Run(t, new(IBCTestSuite))
 }
@@ -0,0 +1,70 @@
+package index
+
+import (
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+const (
+	// FlagEnable is the app config key to enable the oracle request index.
+	FlagEnable = "oracle-index.enable"
+	// FlagPriceOracleScriptIDs is the app config key of the standard price reference oracle scripts.
+	FlagPriceOracleScriptIDs = "oracle-index.price-oracle-script-ids"
+)
+
+// DefaultConfigTemplate is the app config template of the oracle request index.
+const DefaultConfigTemplate = `
+###############################################################################
+###                        Oracle Request Index                             ###
+###############################################################################
+
+[oracle-index]
+
+# Enable defines if the node keeps a local index of resolved oracle requests to serve the
+# RequestSearch and RequestPrice queries. The index is stored in the data directory and can be
+# rebuilt from the blocks with the "oracle-index rebuild" command.
+enable = {{ .OracleIndex.Enable }}
+
+# PriceOracleScriptIDs defines the IDs of the standard price reference oracle scripts whose
+# results are indexed as prices for the RequestPrice query.
+price-oracle-script-ids = [{{ range $i, $id := .OracleIndex.PriceOracleScriptIDs }}{{ if $i }}, {{ end }}{{ $id }}{{ end }}]
+`
+
+// Config defines the app config of the oracle request index.
+type Config struct {
+	Enable               bool     `mapstructure:"enable"`
+	PriceOracleScriptIDs []uint64 `mapstructure:"price-oracle-script-ids"`
+}
+
+// DefaultConfig returns the default config of the oracle request index.
+func DefaultConfig() Config {
+	return Config{
+		Enable:               false,
+		PriceOracleScriptIDs: []uint64{},
+	}
+}
+
+// ConfigFromAppOptions reads the config of the oracle request index from the app options.
+func ConfigFromAppOptions(appOpts servertypes.AppOptions) Config {
+	cfg := Config{
+		Enable: cast.ToBool(appOpts.Get(FlagEnable)),
+	}
+	for _, id := range cast.ToSlice(appOpts.Get(FlagPriceOracleScriptIDs)) {
+		cfg.PriceOracleScriptIDs = append(cfg.PriceOracleScriptIDs, cast.ToUint64(id))
+	}
+
+	return cfg
+}
+
+// OracleScriptIDs returns the IDs of the standard price reference oracle scripts.
+func (cfg Config) OracleScriptIDs() []types.OracleScriptID {
+	ids := make([]types.OracleScriptID, 0, len(cfg.PriceOracleScriptIDs))
+	for _, id := range cfg.PriceOracleScriptIDs {
+		ids = append(ids, types.OracleScriptID(id))
+	}
+
+	return ids
+}
@@ -0,0 +1,499 @@
+package index
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bandprotocol/chain/v3/pkg/obi"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+const (
+	// DBName is the name of the database of the index in the data directory of the node.
+	DBName = "oracle_index"
+)
+
+var (
+	// LatestHeightKey is the key of the latest block height that has been indexed.
+	LatestHeightKey = []byte{0x00}
+
+	// PendingRequestKeyPrefix is the prefix of the requests that haven't been resolved yet.
+	PendingRequestKeyPrefix = []byte{0x01}
+
+	// RequestKeyPrefix is the prefix of the successfully resolved requests by their input.
+	RequestKeyPrefix = []byte{0x02}
+
+	// PriceKeyPrefix is the prefix of the latest prices by ask count, min count and symbol.
+	PriceKeyPrefix = []byte{0x03}
+)
+
+var _ types.RequestIndex = &Index{}
+
+// Index is a node-local index of resolved oracle requests. It is fed from the request and resolve
+// events of the blocks, so it is kept off the consensus state and can be rebuilt from the blocks.
+type Index struct {
+	db                   dbm.DB
+	priceOracleScriptIDs map[types.OracleScriptID]bool
+}
+
+// pendingRequest is the input of a request needed to index it when it is resolved.
+type pendingRequest struct {
+	OracleScriptID types.OracleScriptID
+	Calldata       []byte
+	AskCount       uint64
+	MinCount       uint64
+}
+
+// priceInput is the input of the standard price reference oracle script.
+type priceInput struct {
+	Symbols    []string
+	Multiplier uint64
+}
+
+// priceOutput is the output of the standard price reference oracle script.
+type priceOutput struct {
+	Rates []uint64
+}
+
+// NewIndex creates a new instance of the Index on the given database. The results of the given
+// oracle scripts are indexed as prices of the standard price reference.
+func NewIndex(db dbm.DB, priceOracleScriptIDs []types.OracleScriptID) *Index {
+	ids := make(map[types.OracleScriptID]bool, len(priceOracleScriptIDs))
+	for _, id := range priceOracleScriptIDs {
+		ids[id] = true
+	}
+
+	return &Index{
+		db:                   db,
+		priceOracleScriptIDs: ids,
+	}
+}
+
+// Open opens the index in the data directory of the node home.
+func Open(homeDir string, backend dbm.BackendType, cfg Config) (*Index, error) {
+	db, err := dbm.NewDB(DBName, backend, filepath.Join(homeDir, "data"))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewIndex(db, cfg.OracleScriptIDs()), nil
+}
+
+// Close closes the database of the index.
+func (idx *Index) Close() error {
+	return idx.db.Close()
+}
+
+// LatestHeight returns the latest block height that has been indexed.
+func (idx *Index) LatestHeight() (int64, error) {
+	bz, err := idx.db.Get(LatestHeightKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+// Reset deletes everything in the index.
+func (idx *Index) Reset() error {
+	it, err := idx.db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return batch.WriteSync()
+}
+
+// blockWriter collects the changes of the index from a block to write them at once.
+type blockWriter struct {
+	batch    dbm.Batch
+	requests map[types.RequestID]pendingRequest // Requests made in the block
+	prices   map[string]types.PriceResult       // Prices resolved in the block by their key
+}
+
+// IndexBlock indexes the requests and results from the events of the block at the given height.
+// Indexing a block twice leaves the index unchanged, so blocks can be replayed safely.
+func (idx *Index) IndexBlock(height int64, blockTime time.Time, events []abci.Event) error {
+	w := blockWriter{
+		batch:    idx.db.NewBatch(),
+		requests: make(map[types.RequestID]pendingRequest),
+		prices:   make(map[string]types.PriceResult),
+	}
+	defer w.batch.Close()
+
+	stringEvents := sdk.StringifyEvents(events)
+
+	// Requests have to be recorded before the results since a request can be resolved in the
+	// same block.
+	for _, event := range stringEvents {
+		if event.Type != types.EventTypeRequest {
+			continue
+		}
+		for _, attrs := range splitEvent(event, types.AttributeKeyID) {
+			id, req, err := parseRequestEvent(attrs)
+			if err != nil {
+				return fmt.Errorf("failed to parse request event at height %d: %w", height, err)
+			}
+			w.requests[id] = req
+		}
+	}
+
+	for _, event := range stringEvents {
+		if event.Type != types.EventTypeResolve {
+			continue
+		}
+		for _, attrs := range splitEvent(event, types.AttributeKeyID) {
+			if err := idx.indexResolveEvent(&w, attrs, blockTime); err != nil {
+				return fmt.Errorf("failed to index resolve event at height %d: %w", height, err)
+			}
+		}
+	}
+
+	// Keep the requests that are still pending for the following blocks.
+	for id, req := range w.requests {
+		if err := w.batch.Set(pendingRequestKey(id), encodePendingRequest(req)); err != nil {
+			return err
+		}
+	}
+
+	for key, price := range w.prices {
+		bz, err := price.Marshal()
+		if err != nil {
+			return err
+		}
+		if err := w.batch.Set([]byte(key), bz); err != nil {
+			return err
+		}
+	}
+
+	if err := w.batch.Set(LatestHeightKey, sdk.Uint64ToBigEndian(uint64(height))); err != nil {
+		return err
+	}
+
+	return w.batch.Write()
+}
+
+// indexResolveEvent indexes the request of the resolve event if it has been resolved successfully.
+func (idx *Index) indexResolveEvent(w *blockWriter, attrs map[string]string, blockTime time.Time) error {
+	rawID, err := strconv.ParseUint(attrs[types.AttributeKeyID], 10, 64)
+	if err != nil {
+		return err
+	}
+	id := types.RequestID(rawID)
+
+	req, ok := w.requests[id]
+	if ok {
+		delete(w.requests, id)
+	} else {
+		bz, err := idx.db.Get(pendingRequestKey(id))
+		if err != nil {
+			return err
+		}
+		// The request was made before the first indexed block.
+		if bz == nil {
+			return nil
+		}
+		if err := w.batch.Delete(pendingRequestKey(id)); err != nil {
+			return err
+		}
+
+		req, err = decodePendingRequest(bz)
+		if err != nil {
+			return err
+		}
+	}
+
+	if attrs[types.AttributeKeyResolveStatus] != strconv.Itoa(int(types.RESOLVE_STATUS_SUCCESS)) {
+		return nil
+	}
+
+	key := requestKey(req.OracleScriptID, req.Calldata, req.AskCount, req.MinCount, id)
+	if err := w.batch.Set(key, []byte{}); err != nil {
+		return err
+	}
+
+	if !idx.priceOracleScriptIDs[req.OracleScriptID] {
+		return nil
+	}
+
+	result, err := hex.DecodeString(attrs[types.AttributeKeyResult])
+	if err != nil {
+		return err
+	}
+
+	return idx.indexPrices(w, id, req, result, blockTime)
+}
+
+// indexPrices indexes the prices in the result of a standard price reference request. Results that
+// don't follow the standard price reference are ignored.
+func (idx *Index) indexPrices(
+	w *blockWriter,
+	id types.RequestID,
+	req pendingRequest,
+	result []byte,
+	blockTime time.Time,
+) error {
+	var input priceInput
+	var output priceOutput
+	if obi.Decode(req.Calldata, &input) != nil || obi.Decode(result, &output) != nil ||
+		len(input.Symbols) != len(output.Rates) {
+		return nil
+	}
+
+	for i, symbol := range input.Symbols {
+		key := string(priceKey(req.AskCount, req.MinCount, symbol))
+
+		// Keep the price of the later request if the requests are resolved out of order.
+		latest, ok := w.prices[key]
+		if !ok {
+			bz, err := idx.db.Get([]byte(key))
+			if err != nil {
+				return err
+			}
+			if bz != nil {
+				if err := latest.Unmarshal(bz); err != nil {
+					return err
+				}
+			}
+		}
+		if latest.RequestID > id {
+			continue
+		}
+
+		w.prices[key] = types.PriceResult{
+			Symbol:      symbol,
+			Multiplier:  input.Multiplier,
+			Px:          output.Rates[i],
+			RequestID:   id,
+			ResolveTime: blockTime.Unix(),
+		}
+	}
+
+	return nil
+}
+
+// SearchRequests implements types.RequestIndex.
+func (idx *Index) SearchRequests(
+	oracleScriptID types.OracleScriptID,
+	calldata []byte,
+	askCount uint64,
+	minCount uint64,
+	pagination *query.PageRequest,
+) ([]types.RequestID, *query.PageResponse, error) {
+	store := prefix.NewStore(
+		dbadapter.Store{DB: idx.db},
+		requestInputPrefix(oracleScriptID, calldata, askCount, minCount),
+	)
+
+	var ids []types.RequestID
+	pageRes, err := query.Paginate(store, pagination, func(key []byte, _ []byte) error {
+		ids = append(ids, types.RequestID(math.MaxUint64-sdk.BigEndianToUint64(key)))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ids, pageRes, nil
+}
+
+// GetPrice implements types.RequestIndex.
+func (idx *Index) GetPrice(symbol string, askCount uint64, minCount uint64) (types.PriceResult, error) {
+	bz, err := idx.db.Get(priceKey(askCount, minCount, symbol))
+	if err != nil {
+		return types.PriceResult{}, err
+	}
+	if bz == nil {
+		return types.PriceResult{}, fmt.Errorf(
+			"price not found: symbol %s, ask count %d, min count %d", symbol, askCount, minCount,
+		)
+	}
+
+	var price types.PriceResult
+	if err := price.Unmarshal(bz); err != nil {
+		return types.PriceResult{}, err
+	}
+
+	return price, nil
+}
+
+// GetPrices implements types.RequestIndex.
+func (idx *Index) GetPrices(
+	askCount uint64,
+	minCount uint64,
+	pagination *query.PageRequest,
+) ([]*types.PriceResult, *query.PageResponse, error) {
+	store := prefix.NewStore(dbadapter.Store{DB: idx.db}, pricePrefix(askCount, minCount))
+
+	var prices []*types.PriceResult
+	pageRes, err := query.Paginate(store, pagination, func(_ []byte, value []byte) error {
+		var price types.PriceResult
+		if err := price.Unmarshal(value); err != nil {
+			return err
+		}
+		prices = append(prices, &price)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return prices, pageRes, nil
+}
+
+// splitEvent splits the attributes of the events merged by StringifyEvents back into one map per
+// event. The key attribute is the first attribute of every event.
+func splitEvent(event sdk.StringEvent, key string) []map[string]string {
+	var attrsList []map[string]string
+	for _, attr := range event.Attributes {
+		if attr.Key == key {
+			attrsList = append(attrsList, make(map[string]string))
+		}
+		if len(attrsList) == 0 {
+			continue
+		}
+		attrsList[len(attrsList)-1][attr.Key] = attr.Value
+	}
+
+	return attrsList
+}
+
+// parseRequestEvent parses the request ID and the input of the request from the request event.
+func parseRequestEvent(attrs map[string]string) (types.RequestID, pendingRequest, error) {
+	id, err := strconv.ParseUint(attrs[types.AttributeKeyID], 10, 64)
+	if err != nil {
+		return 0, pendingRequest{}, err
+	}
+
+	oracleScriptID, err := strconv.ParseUint(attrs[types.AttributeKeyOracleScriptID], 10, 64)
+	if err != nil {
+		return 0, pendingRequest{}, err
+	}
+
+	calldata, err := hex.DecodeString(attrs[types.AttributeKeyCalldata])
+	if err != nil {
+		return 0, pendingRequest{}, err
+	}
+
+	askCount, err := strconv.ParseUint(attrs[types.AttributeKeyAskCount], 10, 64)
+	if err != nil {
+		return 0, pendingRequest{}, err
+	}
+
+	minCount, err := strconv.ParseUint(attrs[types.AttributeKeyMinCount], 10, 64)
+	if err != nil {
+		return 0, pendingRequest{}, err
+	}
+
+	return types.RequestID(id), pendingRequest{
+		OracleScriptID: types.OracleScriptID(oracleScriptID),
+		Calldata:       calldata,
+		AskCount:       askCount,
+		MinCount:       minCount,
+	}, nil
+}
+
+// encodePendingRequest encodes the pending request as oracle script ID | ask count | min count | calldata.
+func encodePendingRequest(req pendingRequest) []byte {
+	bz := make([]byte, 0, 24+len(req.Calldata))
+	bz = binary.BigEndian.AppendUint64(bz, uint64(req.OracleScriptID))
+	bz = binary.BigEndian.AppendUint64(bz, req.AskCount)
+	bz = binary.BigEndian.AppendUint64(bz, req.MinCount)
+	return append(bz, req.Calldata...)
+}
+
+// decodePendingRequest decodes the pending request encoded by encodePendingRequest.
+func decodePendingRequest(bz []byte) (pendingRequest, error) {
+	if len(bz) < 24 {
+		return pendingRequest{}, fmt.Errorf("invalid pending request length: %d", len(bz))
+	}
+
+	return pendingRequest{
+		OracleScriptID: types.OracleScriptID(binary.BigEndian.Uint64(bz[0:8])),
+		AskCount:       binary.BigEndian.Uint64(bz[8:16]),
+		MinCount:       binary.BigEndian.Uint64(bz[16:24]),
+		Calldata:       bz[24:],
+	}, nil
+}
+
+// pendingRequestKey returns the key of the pending request.
+func pendingRequestKey(id types.RequestID) []byte {
+	return append(PendingRequestKeyPrefix, sdk.Uint64ToBigEndian(uint64(id))...)
+}
+
+// requestInputPrefix returns the prefix of the requests with the given input.
+func requestInputPrefix(
+	oracleScriptID types.OracleScriptID,
+	calldata []byte,
+	askCount uint64,
+	minCount uint64,
+) []byte {
+	calldataHash := sha256.Sum256(calldata)
+
+	bz := make([]byte, 0, len(RequestKeyPrefix)+56)
+	bz = append(bz, RequestKeyPrefix...)
+	bz = binary.BigEndian.AppendUint64(bz, uint64(oracleScriptID))
+	bz = append(bz, calldataHash[:]...)
+	bz = binary.BigEndian.AppendUint64(bz, askCount)
+	return binary.BigEndian.AppendUint64(bz, minCount)
+}
+
+// requestKey returns the key of the resolved request. The request ID is inverted so that the
+// latest request comes first.
+func requestKey(
+	oracleScriptID types.OracleScriptID,
+	calldata []byte,
+	askCount uint64,
+	minCount uint64,
+	id types.RequestID,
+) []byte {
+	return binary.BigEndian.AppendUint64(
+		requestInputPrefix(oracleScriptID, calldata, askCount, minCount),
+		math.MaxUint64-uint64(id),
+	)
+}
+
+// pricePrefix returns the prefix of the prices resolved with the given ask and min count.
+func pricePrefix(askCount uint64, minCount uint64) []byte {
+	bz := make([]byte, 0, len(PriceKeyPrefix)+16)
+	bz = append(bz, PriceKeyPrefix...)
+	bz = binary.BigEndian.AppendUint64(bz, askCount)
+	return binary.BigEndian.AppendUint64(bz, minCount)
+}
+
+// priceKey returns the key of the price of the symbol.
+func priceKey(askCount uint64, minCount uint64, symbol string) []byte {
+	return append(pricePrefix(askCount, minCount), []byte(symbol)...)
+}
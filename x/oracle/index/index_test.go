@@ -0,0 +1,130 @@
+package index_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bandprotocol/chain/v3/pkg/obi"
+	"github.com/bandprotocol/chain/v3/x/oracle/index"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func requestEvent(id types.RequestID, oracleScriptID types.OracleScriptID, calldata []byte) abci.Event {
+	return abci.Event{
+		Type: types.EventTypeRequest,
+		Attributes: []abci.EventAttribute{
+			{Key: types.AttributeKeyID, Value: fmt.Sprintf("%d", id)},
+			{Key: types.AttributeKeyClientID, Value: "client"},
+			{Key: types.AttributeKeyOracleScriptID, Value: fmt.Sprintf("%d", oracleScriptID)},
+			{Key: types.AttributeKeyCalldata, Value: hex.EncodeToString(calldata)},
+			{Key: types.AttributeKeyAskCount, Value: "4"},
+			{Key: types.AttributeKeyMinCount, Value: "3"},
+		},
+	}
+}
+
+func resolveEvent(id types.RequestID, status types.ResolveStatus, result []byte) abci.Event {
+	return abci.Event{
+		Type: types.EventTypeResolve,
+		Attributes: []abci.EventAttribute{
+			{Key: types.AttributeKeyID, Value: fmt.Sprintf("%d", id)},
+			{Key: types.AttributeKeyResolveStatus, Value: fmt.Sprintf("%d", status)},
+			{Key: types.AttributeKeyResult, Value: hex.EncodeToString(result)},
+		},
+	}
+}
+
+func TestIndexBlock(t *testing.T) {
+	idx := index.NewIndex(dbm.NewMemDB(), []types.OracleScriptID{360})
+
+	priceCalldata := obi.MustEncode(struct {
+		Symbols    []string
+		Multiplier uint64
+	}{[]string{"BTC", "ETH"}, 1000000000})
+	otherCalldata := []byte("other")
+	blockTime := time.Unix(1700000000, 0)
+
+	// Request 1 is resolved in the same block, request 2 and 3 in the next block and request 4 fails.
+	require.NoError(t, idx.IndexBlock(10, blockTime, []abci.Event{
+		requestEvent(1, 360, priceCalldata),
+		requestEvent(2, 360, priceCalldata),
+		requestEvent(3, 1, otherCalldata),
+		requestEvent(4, 1, otherCalldata),
+		resolveEvent(1, types.RESOLVE_STATUS_SUCCESS, obi.MustEncode([]uint64{100, 10})),
+	}))
+	require.NoError(t, idx.IndexBlock(11, blockTime.Add(3*time.Second), []abci.Event{
+		resolveEvent(2, types.RESOLVE_STATUS_SUCCESS, obi.MustEncode([]uint64{200, 20})),
+		resolveEvent(3, types.RESOLVE_STATUS_SUCCESS, []byte("result")),
+		resolveEvent(4, types.RESOLVE_STATUS_FAILURE, nil),
+		// request made before the first indexed block
+		resolveEvent(5, types.RESOLVE_STATUS_SUCCESS, nil),
+	}))
+
+	height, err := idx.LatestHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(11), height)
+
+	ids, _, err := idx.SearchRequests(360, priceCalldata, 4, 3, nil)
+	require.NoError(t, err)
+	require.Equal(t, []types.RequestID{2, 1}, ids)
+
+	ids, pageRes, err := idx.SearchRequests(360, priceCalldata, 4, 3, &query.PageRequest{Limit: 1, CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, []types.RequestID{2}, ids)
+	require.Equal(t, uint64(2), pageRes.Total)
+
+	ids, _, err = idx.SearchRequests(1, otherCalldata, 4, 3, nil)
+	require.NoError(t, err)
+	require.Equal(t, []types.RequestID{3}, ids)
+
+	ids, _, err = idx.SearchRequests(360, priceCalldata, 16, 10, nil)
+	require.NoError(t, err)
+	require.Empty(t, ids)
+
+	price, err := idx.GetPrice("ETH", 4, 3)
+	require.NoError(t, err)
+	require.Equal(t, types.PriceResult{
+		Symbol:      "ETH",
+		Multiplier:  1000000000,
+		Px:          20,
+		RequestID:   2,
+		ResolveTime: blockTime.Unix() + 3,
+	}, price)
+
+	_, err = idx.GetPrice("ETH", 16, 10)
+	require.ErrorContains(t, err, "price not found")
+
+	prices, _, err := idx.GetPrices(4, 3, nil)
+	require.NoError(t, err)
+	require.Len(t, prices, 2)
+	require.Equal(t, "BTC", prices[0].Symbol)
+	require.Equal(t, uint64(200), prices[0].Px)
+
+	// Replaying a block leaves the index unchanged.
+	require.NoError(t, idx.IndexBlock(10, blockTime, []abci.Event{
+		requestEvent(1, 360, priceCalldata),
+		resolveEvent(1, types.RESOLVE_STATUS_SUCCESS, obi.MustEncode([]uint64{100, 10})),
+	}))
+	ids, _, err = idx.SearchRequests(360, priceCalldata, 4, 3, nil)
+	require.NoError(t, err)
+	require.Equal(t, []types.RequestID{2, 1}, ids)
+
+	price, err = idx.GetPrice("BTC", 4, 3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), price.Px)
+
+	require.NoError(t, idx.Reset())
+	height, err = idx.LatestHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), height)
+}
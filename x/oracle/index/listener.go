@@ -0,0 +1,59 @@
+package index
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ storetypes.ABCIListener = &Listener{}
+
+// Listener feeds the index with the events of every finalized block.
+type Listener struct {
+	index *Index
+}
+
+// NewListener creates a new instance of the Listener of the given index.
+func NewListener(index *Index) *Listener {
+	return &Listener{index: index}
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener.
+func (l *Listener) ListenFinalizeBlock(
+	ctx context.Context,
+	req abci.RequestFinalizeBlock,
+	res abci.ResponseFinalizeBlock,
+) error {
+	latestHeight, err := l.index.LatestHeight()
+	if err != nil {
+		return err
+	}
+	if latestHeight != 0 && req.Height > latestHeight+1 {
+		sdk.UnwrapSDKContext(ctx).Logger().Error(
+			"oracle index is missing blocks, rebuild it to serve requests of the missing blocks",
+			"latest_indexed_height", latestHeight,
+			"height", req.Height,
+		)
+	}
+
+	return l.index.IndexBlock(req.Height, req.Time, BlockEvents(res))
+}
+
+// ListenCommit implements storetypes.ABCIListener.
+func (l *Listener) ListenCommit(context.Context, abci.ResponseCommit, []*storetypes.StoreKVPair) error {
+	return nil
+}
+
+// BlockEvents returns the events of the transactions and the block in the finalize block response.
+func BlockEvents(res abci.ResponseFinalizeBlock) []abci.Event {
+	var events []abci.Event
+	for _, txResult := range res.TxResults {
+		events = append(events, txResult.Events...)
+	}
+
+	return append(events, res.Events...)
+}
@@ -8,7 +8,7 @@ import (
 
 // FeeCollector define fee collector
 type FeeCollector interface {
-	Collect(sdk.Context, sdk.Coins, sdk.AccAddress) error
+	Collect(sdk.Context, sdk.Coins) error
 	Collected() sdk.Coins
 }
 
@@ -28,7 +28,7 @@ func newFeeCollector(bankKeeper types.BankKeeper, feeLimit sdk.Coins, payer sdk.
 	}
 }
 
-func (coll *feeCollector) Collect(ctx sdk.Context, coins sdk.Coins, treasury sdk.AccAddress) error {
+func (coll *feeCollector) Collect(ctx sdk.Context, coins sdk.Coins) error {
 	coll.collected = coll.collected.Add(coins...)
 
 	// If found any collected coin that exceed limit then return error
@@ -44,8 +44,8 @@ func (coll *feeCollector) Collect(ctx sdk.Context, coins sdk.Coins, treasury sdk
 		}
 	}
 
-	// Actual send coins
-	return coll.bankKeeper.SendCoins(ctx, coll.payer, treasury, coins)
+	// Hold the coins in the oracle module until the raw requests are reported
+	return coll.bankKeeper.SendCoinsFromAccountToModule(ctx, coll.payer, types.ModuleName, coins)
 }
 
 func (coll *feeCollector) Collected() sdk.Coins {
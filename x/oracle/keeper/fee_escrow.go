@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// HasFeeEscrow checks if the fee escrow of this request ID exists in the storage.
+func (k Keeper) HasFeeEscrow(ctx sdk.Context, reqID types.RequestID) bool {
+	return ctx.KVStore(k.storeKey).Has(types.FeeEscrowStoreKey(reqID))
+}
+
+// GetFeeEscrow returns the fee escrow of the given request ID or error if not exists.
+func (k Keeper) GetFeeEscrow(ctx sdk.Context, reqID types.RequestID) (types.FeeEscrow, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.FeeEscrowStoreKey(reqID))
+	if bz == nil {
+		return types.FeeEscrow{}, types.ErrFeeEscrowNotFound.Wrapf("reqID: %d", reqID)
+	}
+	var escrow types.FeeEscrow
+	k.cdc.MustUnmarshal(bz, &escrow)
+	return escrow, nil
+}
+
+// SetFeeEscrow saves the given fee escrow to the storage without performing validation.
+func (k Keeper) SetFeeEscrow(ctx sdk.Context, escrow types.FeeEscrow) {
+	ctx.KVStore(k.storeKey).Set(types.FeeEscrowStoreKey(escrow.RequestID), k.cdc.MustMarshal(&escrow))
+}
+
+// DeleteFeeEscrow removes the fee escrow of the given request ID from the storage.
+func (k Keeper) DeleteFeeEscrow(ctx sdk.Context, reqID types.RequestID) {
+	ctx.KVStore(k.storeKey).Delete(types.FeeEscrowStoreKey(reqID))
+}
+
+// GetAllFeeEscrows returns the list of all fee escrows in the store, or nil if there is none.
+func (k Keeper) GetAllFeeEscrows(ctx sdk.Context) (escrows []types.FeeEscrow) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.FeeEscrowStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var escrow types.FeeEscrow
+		k.cdc.MustUnmarshal(iterator.Value(), &escrow)
+		escrows = append(escrows, escrow)
+	}
+	return escrows
+}
+
+// PayDataSourceFees pays the treasury of each raw request reported by the validator from the
+// fee escrow of the given request. Every unresolved request has a fee escrow, so it returns error
+// if the fee escrow does not exist.
+func (k Keeper) PayDataSourceFees(ctx sdk.Context, reqID types.RequestID, rawReports []types.RawReport) error {
+	escrow, err := k.GetFeeEscrow(ctx, reqID)
+	if err != nil {
+		return err
+	}
+
+	// Treasury can be any address, so coins are sent from the module address directly instead of
+	// SendCoinsFromModuleToAccount which rejects blocked addresses.
+	escrowAddr := authtypes.NewModuleAddress(types.ModuleName)
+	for _, rawReport := range rawReports {
+		for _, rawFee := range escrow.RawRequestFees {
+			if rawFee.ExternalID != rawReport.ExternalID {
+				continue
+			}
+
+			treasury, err := sdk.AccAddressFromBech32(rawFee.Treasury)
+			if err != nil {
+				return err
+			}
+			if err := k.bankKeeper.SendCoins(ctx, escrowAddr, treasury, rawFee.Fee); err != nil {
+				return err
+			}
+			escrow.Remaining = escrow.Remaining.Sub(rawFee.Fee...)
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypePayDataSourceFee,
+				sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", reqID)),
+				sdk.NewAttribute(types.AttributeKeyExternalID, fmt.Sprintf("%d", rawFee.ExternalID)),
+				sdk.NewAttribute(types.AttributeKeyDataSourceID, fmt.Sprintf("%d", rawFee.DataSourceID)),
+				sdk.NewAttribute(types.AttributeKeyTreasury, rawFee.Treasury),
+				sdk.NewAttribute(types.AttributeKeyFee, rawFee.Fee.String()),
+			))
+		}
+	}
+
+	k.SetFeeEscrow(ctx, escrow)
+	return nil
+}
+
+// RefundDataSourceFees refunds the remaining fees of the given request to its payer and removes
+// the fee escrow. It does nothing if the request has no fee escrow.
+func (k Keeper) RefundDataSourceFees(ctx sdk.Context, reqID types.RequestID) error {
+	escrow, err := k.GetFeeEscrow(ctx, reqID)
+	if err != nil {
+		return nil
+	}
+	k.DeleteFeeEscrow(ctx, reqID)
+
+	if escrow.Remaining.IsZero() {
+		return nil
+	}
+
+	payer, err := sdk.AccAddressFromBech32(escrow.Payer)
+	if err != nil {
+		return err
+	}
+	escrowAddr := authtypes.NewModuleAddress(types.ModuleName)
+	if err := k.bankKeeper.SendCoins(ctx, escrowAddr, payer, escrow.Remaining); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRefundDataSourceFee,
+		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", reqID)),
+		sdk.NewAttribute(types.AttributeKeyPayer, escrow.Payer),
+		sdk.NewAttribute(types.AttributeKeyFee, escrow.Remaining.String()),
+	))
+	return nil
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func (suite *KeeperTestSuite) setFeeEscrow(ctx sdk.Context) {
+	suite.oracleKeeper.SetFeeEscrow(ctx, types.NewFeeEscrow(
+		42,
+		alice.String(),
+		[]types.RawRequestFee{
+			types.NewRawRequestFee(1, 1, treasury.String(), coins1000000uband),
+			types.NewRawRequestFee(2, 2, bob.String(), coins1000000uband),
+		},
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 4000000)),
+	))
+}
+
+func (suite *KeeperTestSuite) TestGetSetDeleteFeeEscrow() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	_, err := k.GetFeeEscrow(ctx, 42)
+	require.ErrorIs(err, types.ErrFeeEscrowNotFound)
+	require.False(k.HasFeeEscrow(ctx, 42))
+
+	suite.setFeeEscrow(ctx)
+	require.True(k.HasFeeEscrow(ctx, 42))
+	escrow, err := k.GetFeeEscrow(ctx, 42)
+	require.NoError(err)
+	require.Equal(alice.String(), escrow.Payer)
+	require.Equal([]types.FeeEscrow{escrow}, k.GetAllFeeEscrows(ctx))
+
+	k.DeleteFeeEscrow(ctx, 42)
+	require.False(k.HasFeeEscrow(ctx, 42))
+	require.Nil(k.GetAllFeeEscrows(ctx))
+}
+
+func (suite *KeeperTestSuite) TestPayDataSourceFees() {
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.setFeeEscrow(ctx)
+	escrowAddr := authtypes.NewModuleAddress(types.ModuleName)
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), escrowAddr, bob, coins1000000uband).Return(nil)
+
+	// Only the treasury of the reported raw request is paid.
+	err := k.PayDataSourceFees(ctx, 42, []types.RawReport{types.NewRawReport(2, 0, []byte("data"))})
+	require.NoError(err)
+
+	escrow, err := k.GetFeeEscrow(ctx, 42)
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("uband", 3000000)), escrow.Remaining)
+	require.Equal(sdk.Events{sdk.NewEvent(
+		types.EventTypePayDataSourceFee,
+		sdk.NewAttribute(types.AttributeKeyID, "42"),
+		sdk.NewAttribute(types.AttributeKeyExternalID, "2"),
+		sdk.NewAttribute(types.AttributeKeyDataSourceID, "2"),
+		sdk.NewAttribute(types.AttributeKeyTreasury, bob.String()),
+		sdk.NewAttribute(types.AttributeKeyFee, coins1000000uband.String()),
+	)}, ctx.EventManager().Events())
+
+	// Every unresolved request has a fee escrow, so a missing one is an error.
+	err = k.PayDataSourceFees(ctx, 43, []types.RawReport{types.NewRawReport(1, 0, []byte("data"))})
+	require.ErrorIs(err, types.ErrFeeEscrowNotFound)
+}
+
+func (suite *KeeperTestSuite) TestAddReportAfterResolvedPaysNothing() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.SetRequest(ctx, 42, defaultRequest()) // See report_test.go
+	suite.setFeeEscrow(ctx)
+	escrowAddr := authtypes.NewModuleAddress(types.ModuleName)
+	refund := sdk.NewCoins(sdk.NewInt64Coin("uband", 4000000))
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), escrowAddr, alice, refund).Return(nil)
+	k.ResolveFailure(ctx, 42, "REASON")
+
+	// The late report is saved, but its treasuries are not paid as the fees were already refunded.
+	err := k.AddReport(ctx, 42, validators[0].Address, false, []types.RawReport{
+		types.NewRawReport(1, 0, []byte("data1/1")),
+		types.NewRawReport(2, 0, []byte("data2/1")),
+		types.NewRawReport(3, 0, []byte("data3/1")),
+	})
+	require.NoError(err)
+	require.True(k.HasReport(ctx, 42, validators[0].Address))
+	require.False(k.HasFeeEscrow(ctx, 42))
+}
+
+func (suite *KeeperTestSuite) TestRefundDataSourceFees() {
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	suite.setFeeEscrow(ctx)
+	escrowAddr := authtypes.NewModuleAddress(types.ModuleName)
+	refund := sdk.NewCoins(sdk.NewInt64Coin("uband", 4000000))
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), escrowAddr, alice, refund).Return(nil)
+
+	require.NoError(k.RefundDataSourceFees(ctx, 42))
+	require.False(k.HasFeeEscrow(ctx, 42))
+	require.Equal(sdk.Events{sdk.NewEvent(
+		types.EventTypeRefundDataSourceFee,
+		sdk.NewAttribute(types.AttributeKeyID, "42"),
+		sdk.NewAttribute(types.AttributeKeyPayer, alice.String()),
+		sdk.NewAttribute(types.AttributeKeyFee, refund.String()),
+	)}, ctx.EventManager().Events())
+
+	// Refunding twice does nothing as the fee escrow is already removed.
+	require.NoError(k.RefundDataSourceFees(ctx, 42))
+}
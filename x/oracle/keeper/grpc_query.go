@@ -10,7 +10,12 @@ import (
 
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"cosmossdk.io/store/prefix"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -109,7 +114,10 @@ func (k Querier) Request(c context.Context, req *types.QueryRequestRequest) (*ty
 				),
 			)
 		}
-		result := k.MustGetResult(ctx, rid)
+		result, err := k.GetResult(ctx, rid)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
 		return &types.QueryRequestResponse{Request: nil, Reports: nil, Result: &result, Signing: signingResult}, nil
 	}
 
@@ -310,21 +318,94 @@ func (k Querier) Params(c context.Context, req *types.QueryParamsRequest) (*type
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
-// RequestSearch queries the latest request that match the given input.
+// RequestSearch queries the latest requests that match the given input from the request index
+// of the node.
 func (k Querier) RequestSearch(
 	c context.Context,
 	req *types.QueryRequestSearchRequest,
 ) (*types.QueryRequestSearchResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "This feature can be taken from extra/rest branch")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if k.requestIndex == nil {
+		return nil, status.Error(codes.Unimplemented, "oracle request index is not enabled on this node")
+	}
+
+	calldata, err := hex.DecodeString(req.Calldata)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid calldata: %s", err))
+	}
+
+	ids, pageRes, err := k.requestIndex.SearchRequests(
+		types.OracleScriptID(req.OracleScriptId),
+		calldata,
+		req.AskCount,
+		req.MinCount,
+		req.Pagination,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(ids) == 0 {
+		return nil, status.Error(codes.NotFound, "no request matches the given input")
+	}
+
+	// The index keeps the requests whose results are pruned from the chain, so skip them
+	lastPruned := k.GetResultLastPruned(sdk.UnwrapSDKContext(c))
+	requests := make([]*types.QueryRequestResponse, 0, len(ids))
+	for _, id := range ids {
+		if id <= lastPruned {
+			continue
+		}
+		request, err := k.Request(c, &types.QueryRequestRequest{RequestId: uint64(id)})
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+	if len(requests) == 0 {
+		return nil, status.Error(codes.NotFound, "results of the requests matching the given input are pruned")
+	}
+
+	return &types.QueryRequestSearchResponse{
+		Request:    requests[0],
+		Requests:   requests,
+		Pagination: pageRes,
+	}, nil
 }
 
 // RequestPrice queries the latest price on standard price reference oracle
-// script.
+// script from the request index of the node.
 func (k Querier) RequestPrice(
 	c context.Context,
 	req *types.QueryRequestPriceRequest,
 ) (*types.QueryRequestPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "This feature can be taken from extra/rest branch")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if k.requestIndex == nil {
+		return nil, status.Error(codes.Unimplemented, "oracle request index is not enabled on this node")
+	}
+
+	// List the prices of all symbols if no symbol is given
+	if len(req.Symbols) == 0 {
+		prices, pageRes, err := k.requestIndex.GetPrices(req.AskCount, req.MinCount, req.Pagination)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &types.QueryRequestPriceResponse{PriceResults: prices, Pagination: pageRes}, nil
+	}
+
+	prices := make([]*types.PriceResult, 0, len(req.Symbols))
+	for _, symbol := range req.Symbols {
+		price, err := k.requestIndex.GetPrice(symbol, req.AskCount, req.MinCount)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		prices = append(prices, &price)
+	}
+
+	return &types.QueryRequestPriceResponse{PriceResults: prices}, nil
 }
 
 // RequestVerification verifies oracle request for validation before executing data sources
@@ -484,3 +565,151 @@ func (k Querier) RequestVerification(
 		IsDelay:      false,
 	}, nil
 }
+
+// RecurringRequest queries recurring request info and its remaining deposit for given recurring request id.
+func (k Querier) RecurringRequest(
+	c context.Context,
+	req *types.QueryRecurringRequestRequest,
+) (*types.QueryRecurringRequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	recurringRequest, err := k.GetRecurringRequest(ctx, types.RecurringRequestID(req.RecurringRequestId))
+	if err != nil {
+		return nil, err
+	}
+	feePayer, err := sdk.AccAddressFromBech32(recurringRequest.FeePayer)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryRecurringRequestResponse{
+		RecurringRequest: &recurringRequest,
+		Deposit:          k.bankKeeper.GetAllBalances(ctx, feePayer),
+	}, nil
+}
+
+// RecurringRequests queries all recurring requests.
+func (k Querier) RecurringRequests(
+	c context.Context,
+	req *types.QueryRecurringRequestsRequest,
+) (*types.QueryRecurringRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var recurringRequests []*types.RecurringRequest
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RecurringRequestStoreKeyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var recurringRequest types.RecurringRequest
+		if err := k.cdc.Unmarshal(value, &recurringRequest); err != nil {
+			return err
+		}
+
+		recurringRequests = append(recurringRequests, &recurringRequest)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecurringRequestsResponse{RecurringRequests: recurringRequests, Pagination: pageRes}, nil
+}
+
+// RecurringRequestSpawns queries the IDs of unexpired requests spawned by the given recurring request.
+func (k Querier) RecurringRequestSpawns(
+	c context.Context,
+	req *types.QueryRecurringRequestSpawnsRequest,
+) (*types.QueryRecurringRequestSpawnsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	id := types.RecurringRequestID(req.RecurringRequestId)
+	if !k.HasRecurringRequest(ctx, id) {
+		return nil, types.ErrRecurringRequestNotFound.Wrapf("id: %d", id)
+	}
+
+	var requestIDs []uint64
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RecurringRequestSpawnsPrefixKey(id))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		requestIDs = append(requestIDs, sdk.BigEndianToUint64(key))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecurringRequestSpawnsResponse{RequestIDs: requestIDs, Pagination: pageRes}, nil
+}
+
+// FeeEscrow queries the data source fees escrowed for the given request id.
+func (k Querier) FeeEscrow(
+	c context.Context,
+	req *types.QueryFeeEscrowRequest,
+) (*types.QueryFeeEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	escrow, err := k.GetFeeEscrow(ctx, types.RequestID(req.RequestId))
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryFeeEscrowResponse{FeeEscrow: &escrow}, nil
+}
+
+// ValidatorReliability queries the oracle reliability of a validator and its resulting sampling weight.
+func (k Querier) ValidatorReliability(
+	c context.Context,
+	req *types.QueryValidatorReliabilityRequest,
+) (*types.QueryValidatorReliabilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	val, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryValidatorReliabilityResponse{
+		Reliability:              k.GetValidatorReliability(ctx, val),
+		SamplingWeightPercentage: k.GetSamplingWeightPercentage(ctx, k.GetParams(ctx), val),
+	}, nil
+}
+
+// PrunedResults queries the range of request results that have been pruned from state.
+func (k Querier) PrunedResults(
+	c context.Context,
+	req *types.QueryPrunedResultsRequest,
+) (*types.QueryPrunedResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	lastPruned := k.GetResultLastPruned(ctx)
+	if lastPruned == 0 {
+		return &types.QueryPrunedResultsResponse{}, nil
+	}
+	return &types.QueryPrunedResultsResponse{FromRequestID: 1, ToRequestID: uint64(lastPruned)}, nil
+}
+
+// ChannelPrepaidBalance queries the prepaid fee account of an oracle IBC channel and its balance.
+func (k Querier) ChannelPrepaidBalance(
+	c context.Context,
+	req *types.QueryChannelPrepaidBalanceRequest,
+) (*types.QueryChannelPrepaidBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	prepaidAccount := GetChannelPrepaidAccount(req.ChannelId)
+	return &types.QueryChannelPrepaidBalanceResponse{
+		Address: prepaidAccount.String(),
+		Balance: k.bankKeeper.GetAllBalances(ctx, prepaidAccount),
+	}, nil
+}
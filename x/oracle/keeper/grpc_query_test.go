@@ -3,13 +3,23 @@ package keeper_test
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	oracleindex "github.com/bandprotocol/chain/v3/x/oracle/index"
 	"github.com/bandprotocol/chain/v3/x/oracle/keeper"
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
 )
@@ -427,6 +437,7 @@ func (suite *KeeperTestSuite) TestRequestVerificationFailedValidatorAlreadyRepor
 	k := suite.oracleKeeper
 	k.SetRequest(ctx, types.RequestID(1), defaultRequest())
 	k.SetRequestCount(ctx, 1)
+	k.SetFeeEscrow(ctx, types.NewFeeEscrow(1, alice.String(), nil, sdk.NewCoins()))
 
 	err := k.AddReport(
 		ctx,
@@ -722,3 +733,133 @@ func (suite *KeeperTestSuite) TestPendingRequestsSuccess() {
 	require.Equal(&types.QueryPendingRequestsResponse{RequestIDs: []uint64{3}}, r)
 	require.NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestRequestSearchAndPrice() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	// the queries are unimplemented without the request index
+	_, err := suite.queryClient.RequestSearch(ctx, &types.QueryRequestSearchRequest{})
+	require.Equal(codes.Unimplemented, status.Code(err))
+	_, err = suite.queryClient.RequestPrice(ctx, &types.QueryRequestPriceRequest{})
+	require.Equal(codes.Unimplemented, status.Code(err))
+
+	k := suite.oracleKeeper
+	idx := oracleindex.NewIndex(dbm.NewMemDB(), nil)
+	k.SetRequestIndex(idx)
+	querier := keeper.Querier{Keeper: k}
+
+	request := defaultRequest()
+	result := types.NewResult(
+		basicClientID, 1, basicCalldata, 2, 2, 1, 2, bandtesting.ParseTime(0).Unix(), ctx.BlockTime().Unix(),
+		types.RESOLVE_STATUS_SUCCESS, basicResult,
+	)
+	k.SetRequest(ctx, 1, request)
+	k.SetResult(ctx, 1, result)
+
+	require.NoError(idx.IndexBlock(1, ctx.BlockTime(), []abci.Event{
+		{
+			Type: types.EventTypeRequest,
+			Attributes: []abci.EventAttribute{
+				{Key: types.AttributeKeyID, Value: "1"},
+				{Key: types.AttributeKeyOracleScriptID, Value: "1"},
+				{Key: types.AttributeKeyCalldata, Value: hex.EncodeToString(basicCalldata)},
+				{Key: types.AttributeKeyAskCount, Value: "2"},
+				{Key: types.AttributeKeyMinCount, Value: "2"},
+			},
+		},
+		{
+			Type: types.EventTypeResolve,
+			Attributes: []abci.EventAttribute{
+				{Key: types.AttributeKeyID, Value: "1"},
+				{Key: types.AttributeKeyResolveStatus, Value: fmt.Sprintf("%d", types.RESOLVE_STATUS_SUCCESS)},
+				{Key: types.AttributeKeyResult, Value: hex.EncodeToString(basicResult)},
+			},
+		},
+	}))
+
+	res, err := querier.RequestSearch(ctx, &types.QueryRequestSearchRequest{
+		OracleScriptId: 1,
+		Calldata:       hex.EncodeToString(basicCalldata),
+		AskCount:       2,
+		MinCount:       2,
+	})
+	require.NoError(err)
+	require.Equal(&request, res.Request.Request)
+	require.Equal(&result, res.Request.Result)
+	require.Len(res.Requests, 1)
+
+	_, err = querier.RequestSearch(ctx, &types.QueryRequestSearchRequest{
+		OracleScriptId: 1,
+		Calldata:       hex.EncodeToString(basicCalldata),
+		AskCount:       4,
+		MinCount:       2,
+	})
+	require.Equal(codes.NotFound, status.Code(err))
+
+	_, err = querier.RequestPrice(ctx, &types.QueryRequestPriceRequest{Symbols: []string{"BTC"}, AskCount: 2, MinCount: 2})
+	require.Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *KeeperTestSuite) TestRequestSearchSkipsPrunedResults() {
+	ctx := suite.ctx
+	require := suite.Require()
+	k := suite.oracleKeeper
+	idx := oracleindex.NewIndex(dbm.NewMemDB(), nil)
+	k.SetRequestIndex(idx)
+	querier := keeper.Querier{Keeper: k}
+
+	// requests 1 to 3 are resolved with the same input, and requests 1 and 2 are expired
+	var events []abci.Event
+	for id := 1; id <= 3; id++ {
+		events = append(events, abci.Event{
+			Type: types.EventTypeRequest,
+			Attributes: []abci.EventAttribute{
+				{Key: types.AttributeKeyID, Value: fmt.Sprintf("%d", id)},
+				{Key: types.AttributeKeyOracleScriptID, Value: "1"},
+				{Key: types.AttributeKeyCalldata, Value: hex.EncodeToString(basicCalldata)},
+				{Key: types.AttributeKeyAskCount, Value: "2"},
+				{Key: types.AttributeKeyMinCount, Value: "2"},
+			},
+		}, abci.Event{
+			Type: types.EventTypeResolve,
+			Attributes: []abci.EventAttribute{
+				{Key: types.AttributeKeyID, Value: fmt.Sprintf("%d", id)},
+				{Key: types.AttributeKeyResolveStatus, Value: fmt.Sprintf("%d", types.RESOLVE_STATUS_SUCCESS)},
+				{Key: types.AttributeKeyResult, Value: hex.EncodeToString(basicResult)},
+			},
+		})
+		k.SetResult(ctx, types.RequestID(id), types.NewResult(
+			basicClientID, 1, basicCalldata, 2, 2, types.RequestID(id), 2, bandtesting.ParseTime(0).Unix(),
+			ctx.BlockTime().Unix(), types.RESOLVE_STATUS_SUCCESS, basicResult,
+		))
+	}
+	require.NoError(idx.IndexBlock(1, ctx.BlockTime(), events))
+	k.SetRequest(ctx, 3, defaultRequest())
+	k.SetRequestLastExpired(ctx, 2)
+
+	search := &types.QueryRequestSearchRequest{
+		OracleScriptId: 1,
+		Calldata:       hex.EncodeToString(basicCalldata),
+		AskCount:       2,
+		MinCount:       2,
+	}
+	res, err := querier.RequestSearch(ctx, search)
+	require.NoError(err)
+	require.Len(res.Requests, 3)
+
+	// the results of requests 1 and 2 are pruned, so they are skipped
+	k.DeleteResult(ctx, 1)
+	k.DeleteResult(ctx, 2)
+	k.SetResultLastPruned(ctx, 2)
+
+	res, err = querier.RequestSearch(ctx, search)
+	require.NoError(err)
+	require.Len(res.Requests, 1)
+	require.Equal(types.RequestID(3), res.Request.Result.RequestID)
+
+	// a page of pruned requests only is not found
+	search.Pagination = &query.PageRequest{Offset: 1, Limit: 2}
+	_, err = querier.RequestSearch(ctx, search)
+	require.Equal(codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// SetHooks sets the oracle hooks. It panics if the hooks have already been set.
+func (k *Keeper) SetHooks(hooks types.OracleHooks) {
+	if k.hooks != nil {
+		panic("cannot set oracle hooks twice")
+	}
+	k.hooks = hooks
+}
+
+// afterRequestResolved notifies the registered hooks that the request has been resolved.
+// State changes made by the hooks are discarded if any of them returns an error or panics,
+// so that a misbehaving subscriber cannot prevent the request from being resolved.
+func (k Keeper) afterRequestResolved(ctx sdk.Context, id types.RequestID, request types.Request, result types.Result) {
+	if k.hooks == nil {
+		return
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := k.safeCallHooks(cacheCtx, request, result); err != nil {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeRequestCallbackFail,
+			sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyCallback, request.Callback),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		))
+		return
+	}
+
+	writeFn()
+}
+
+// safeCallHooks calls the hooks and converts a panic into an error.
+func (k Keeper) safeCallHooks(ctx sdk.Context, request types.Request, result types.Result) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			ctx.Logger().Error(fmt.Sprintf("Panic recovered: %v", r))
+			err = types.ErrHookPanic
+		}
+	}()
+
+	return k.hooks.AfterRequestResolved(ctx, request, result)
+}
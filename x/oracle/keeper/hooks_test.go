@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+var _ types.OracleHooks = &mockOracleHooks{}
+
+// mockOracleHooks records the resolved requests and results.
+type mockOracleHooks struct {
+	err    error
+	panics bool
+
+	requests []types.Request
+	results  []types.Result
+}
+
+func (h *mockOracleHooks) AfterRequestResolved(ctx sdk.Context, request types.Request, result types.Result) error {
+	h.requests = append(h.requests, request)
+	h.results = append(h.results, result)
+	if h.panics {
+		panic("hook panic")
+	}
+	return h.err
+}
+
+func (suite *KeeperTestSuite) TestAfterRequestResolvedHooks() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	hooks := &mockOracleHooks{}
+	suite.oracleKeeper.SetHooks(types.NewMultiOracleHooks(hooks))
+	k := suite.oracleKeeper
+
+	request := defaultRequest() // See report_test.go
+	request.Callback = "tunnel"
+	k.SetRequest(ctx, 42, request)
+	k.SetReport(ctx, 42, types.NewReport(validators[0].Address, true, nil))
+	k.ResolveFailure(ctx, 42, "reason")
+
+	require.Len(hooks.requests, 1)
+	require.Equal(request, hooks.requests[0])
+	require.Equal(types.RequestID(42), hooks.results[0].RequestID)
+	require.Equal(types.RESOLVE_STATUS_FAILURE, hooks.results[0].ResolveStatus)
+
+	// setting the hooks twice is not allowed
+	require.Panics(func() { suite.oracleKeeper.SetHooks(types.NewMultiOracleHooks()) })
+}
+
+func (suite *KeeperTestSuite) TestAfterRequestResolvedHooksFailed() {
+	testCases := []struct {
+		name  string
+		hooks *mockOracleHooks
+	}{
+		{name: "hook returns error", hooks: &mockOracleHooks{err: errors.New("hook error")}},
+		{name: "hook panics", hooks: &mockOracleHooks{panics: true}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+			require := suite.Require()
+
+			suite.oracleKeeper.SetHooks(types.NewMultiOracleHooks(tc.hooks))
+			k := suite.oracleKeeper
+
+			request := defaultRequest()
+			request.Callback = "tunnel"
+			k.SetRequest(ctx, 42, request)
+			k.ResolveExpired(ctx, 42)
+
+			// the result is still saved even though the hook failed
+			require.Equal(types.RESOLVE_STATUS_EXPIRED, k.MustGetResult(ctx, 42).ResolveStatus)
+			require.Len(tc.hooks.requests, 1)
+
+			events := ctx.EventManager().Events()
+			require.Equal(types.EventTypeRequestCallbackFail, events[0].Type)
+			id, ok := events[0].GetAttribute(types.AttributeKeyID)
+			require.True(ok)
+			require.Equal("42", id.Value)
+			callback, ok := events[0].GetAttribute(types.AttributeKeyCallback)
+			require.True(ok)
+			require.Equal("tunnel", callback.Value)
+		})
+	}
+}
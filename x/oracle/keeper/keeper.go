@@ -37,10 +37,14 @@ type Keeper struct {
 	authzKeeper       types.AuthzKeeper
 	ics4Wrapper       porttypes.ICS4Wrapper
 	portKeeper        types.PortKeeper
+	channelKeeper     types.ChannelKeeper
 	rollingseedKepper types.RollingseedKeeper
 	bandtssKeeper     types.BandtssKeeper
 	scopedKeeper      capabilitykeeper.ScopedKeeper
 
+	hooks        types.OracleHooks
+	requestIndex types.RequestIndex
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -51,6 +55,7 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	key storetypes.StoreKey,
 	fileDir string,
+	fileCacheSize uint64,
 	feeCollectorName string,
 	authKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
@@ -59,6 +64,7 @@ func NewKeeper(
 	authzKeeper types.AuthzKeeper,
 	ics4Wrapper porttypes.ICS4Wrapper,
 	portKeeper types.PortKeeper,
+	channelKeeper types.ChannelKeeper,
 	rollingseedKepper types.RollingseedKeeper,
 	bandtssKeeper types.BandtssKeeper,
 	scopeKeeper capabilitykeeper.ScopedKeeper,
@@ -68,7 +74,7 @@ func NewKeeper(
 	return Keeper{
 		storeKey:          key,
 		cdc:               cdc,
-		fileCache:         filecache.New(fileDir),
+		fileCache:         filecache.NewWithCacheSize(fileDir, fileCacheSize),
 		feeCollectorName:  feeCollectorName,
 		owasmVM:           owasmVM,
 		authKeeper:        authKeeper,
@@ -78,6 +84,7 @@ func NewKeeper(
 		authzKeeper:       authzKeeper,
 		ics4Wrapper:       ics4Wrapper,
 		portKeeper:        portKeeper,
+		channelKeeper:     channelKeeper,
 		rollingseedKepper: rollingseedKepper,
 		bandtssKeeper:     bandtssKeeper,
 		scopedKeeper:      scopeKeeper,
@@ -85,6 +92,15 @@ func NewKeeper(
 	}
 }
 
+// SetRequestIndex sets the node-local index of resolved requests that serves the RequestSearch and
+// RequestPrice queries. It panics if the index has already been set.
+func (k *Keeper) SetRequestIndex(requestIndex types.RequestIndex) {
+	if k.requestIndex != nil {
+		panic("cannot set oracle request index twice")
+	}
+	k.requestIndex = requestIndex
+}
+
 // GetAuthority returns the x/oracle module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -121,6 +137,22 @@ func (k Keeper) GetRequestLastExpired(ctx sdk.Context) types.RequestID {
 	return types.RequestID(binary.BigEndian.Uint64(bz))
 }
 
+// SetResultLastPruned sets the ID of the last request whose result is pruned.
+func (k Keeper) SetResultLastPruned(ctx sdk.Context, id types.RequestID) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(id))
+	ctx.KVStore(k.storeKey).Set(types.ResultLastPrunedStoreKey, bz)
+}
+
+// GetResultLastPruned returns the ID of the last request whose result is pruned, or 0 if none.
+func (k Keeper) GetResultLastPruned(ctx sdk.Context) types.RequestID {
+	bz := ctx.KVStore(k.storeKey).Get(types.ResultLastPrunedStoreKey)
+	if bz == nil {
+		return 0
+	}
+	return types.RequestID(binary.BigEndian.Uint64(bz))
+}
+
 // GetNextRequestID increments and returns the current number of requests.
 func (k Keeper) GetNextRequestID(ctx sdk.Context) types.RequestID {
 	requestNumber := k.GetRequestCount(ctx)
@@ -26,6 +26,7 @@ import (
 
 	owasm "github.com/bandprotocol/go-owasm/api"
 
+	"github.com/bandprotocol/chain/v3/pkg/filecache"
 	bandtesting "github.com/bandprotocol/chain/v3/testing"
 	"github.com/bandprotocol/chain/v3/x/oracle/keeper"
 	oracletestutil "github.com/bandprotocol/chain/v3/x/oracle/testutil"
@@ -87,6 +88,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 		encCfg.Codec,
 		key,
 		suite.fileDir,
+		filecache.DefaultCacheSizeMax,
 		authtypes.FeeCollectorName,
 		suite.authKeeper,
 		suite.bankKeeper,
@@ -95,6 +97,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 		suite.authzKeeper,
 		nil,
 		nil,
+		nil,
 		suite.rollingseedKeeper,
 		suite.bandtssKeeper,
 		capabilitykeeper.ScopedKeeper{},
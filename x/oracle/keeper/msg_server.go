@@ -290,6 +290,96 @@ func (k msgServer) Activate(goCtx context.Context, msg *types.MsgActivate) (*typ
 	return &types.MsgActivateResponse{}, nil
 }
 
+func (k msgServer) CreateRecurringRequest(
+	goCtx context.Context,
+	msg *types.MsgCreateRecurringRequest,
+) (*types.MsgCreateRecurringRequestResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	recurringRequest, err := k.AddRecurringRequest(ctx, msg, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateRecurringRequest,
+		sdk.NewAttribute(types.AttributeKeyRecurringRequestID, fmt.Sprintf("%d", recurringRequest.ID)),
+		sdk.NewAttribute(types.AttributeKeyOracleScriptID, fmt.Sprintf("%d", recurringRequest.OracleScriptID)),
+		sdk.NewAttribute(types.AttributeKeyInterval, fmt.Sprintf("%d", recurringRequest.Interval)),
+		sdk.NewAttribute(types.AttributeKeyNextHeight, fmt.Sprintf("%d", recurringRequest.NextHeight)),
+		sdk.NewAttribute(types.AttributeKeyFeePayer, recurringRequest.FeePayer),
+		sdk.NewAttribute(types.AttributeKeyOwner, recurringRequest.Owner),
+		sdk.NewAttribute(types.AttributeKeyDeposit, msg.InitialDeposit.String()),
+	))
+
+	return &types.MsgCreateRecurringRequestResponse{RecurringRequestID: recurringRequest.ID}, nil
+}
+
+func (k msgServer) UpdateRecurringRequest(
+	goCtx context.Context,
+	msg *types.MsgUpdateRecurringRequest,
+) (*types.MsgUpdateRecurringRequestResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	recurringRequest, err := k.ModifyRecurringRequest(
+		ctx,
+		msg.RecurringRequestID,
+		msg.Interval,
+		msg.FeeLimit,
+		msg.Deposit,
+		owner,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateRecurringRequest,
+		sdk.NewAttribute(types.AttributeKeyRecurringRequestID, fmt.Sprintf("%d", recurringRequest.ID)),
+		sdk.NewAttribute(types.AttributeKeyInterval, fmt.Sprintf("%d", recurringRequest.Interval)),
+		sdk.NewAttribute(types.AttributeKeyNextHeight, fmt.Sprintf("%d", recurringRequest.NextHeight)),
+		sdk.NewAttribute(types.AttributeKeyFeeLimit, recurringRequest.FeeLimit.String()),
+		sdk.NewAttribute(types.AttributeKeyDeposit, msg.Deposit.String()),
+	))
+
+	return &types.MsgUpdateRecurringRequestResponse{}, nil
+}
+
+func (k msgServer) CancelRecurringRequest(
+	goCtx context.Context,
+	msg *types.MsgCancelRecurringRequest,
+) (*types.MsgCancelRecurringRequestResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	refund, err := k.RemoveRecurringRequest(ctx, msg.RecurringRequestID, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelRecurringRequest,
+		sdk.NewAttribute(types.AttributeKeyRecurringRequestID, fmt.Sprintf("%d", msg.RecurringRequestID)),
+		sdk.NewAttribute(types.AttributeKeyDeposit, refund.String()),
+	))
+
+	return &types.MsgCancelRecurringRequestResponse{}, nil
+}
+
 func (k msgServer) UpdateParams(
 	goCtx context.Context,
 	msg *types.MsgUpdateParams,
@@ -314,3 +404,35 @@ func (k msgServer) UpdateParams(
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (k msgServer) WithdrawChannelPrepaidFee(
+	goCtx context.Context,
+	msg *types.MsgWithdrawChannelPrepaidFee,
+) (*types.MsgWithdrawChannelPrepaidFeeResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf(
+			"invalid authority; expected %s, got %s",
+			k.authority,
+			msg.Authority,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.WithdrawChannelPrepaidFee(ctx, msg.ChannelId, receiver, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeWithdrawChannelPrepaidFee,
+		sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelId),
+		sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+		sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+	))
+
+	return &types.MsgWithdrawChannelPrepaidFeeResponse{}, nil
+}
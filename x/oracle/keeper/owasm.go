@@ -29,6 +29,7 @@ func (k Keeper) GetSpanSize(ctx sdk.Context) uint64 {
 // GetRandomValidators returns a pseudorandom subset of active validators. Each validator has
 // chance of getting selected directly proportional to the amount of voting power it has.
 func (k Keeper) GetRandomValidators(ctx sdk.Context, size int, id uint64) ([]sdk.ValAddress, error) {
+	params := k.GetParams(ctx)
 	valOperators := []sdk.ValAddress{}
 	valPowers := []uint64{}
 	err := k.stakingKeeper.IterateBondedValidatorsByPower(ctx,
@@ -39,7 +40,10 @@ func (k Keeper) GetRandomValidators(ctx sdk.Context, size int, id uint64) ([]sdk
 			}
 			if k.GetValidatorStatus(ctx, operator).IsActive {
 				valOperators = append(valOperators, operator)
-				valPowers = append(valPowers, val.GetTokens().Uint64())
+				// Blend the validator power with its oracle reliability. The weight is kept positive
+				// so that every active validator still has a chance to be chosen.
+				weight := val.GetTokens().MulRaw(int64(k.GetSamplingWeightPercentage(ctx, params, operator))).QuoRaw(100)
+				valPowers = append(valPowers, max(weight.Uint64(), 1))
 			}
 			return false
 		})
@@ -57,7 +61,7 @@ func (k Keeper) GetRandomValidators(ctx sdk.Context, size int, id uint64) ([]sdk
 	if err != nil {
 		return nil, types.ErrBadDrbgInitialization.Wrap(err.Error())
 	}
-	tryCount := int(k.GetParams(ctx).SamplingTryCount)
+	tryCount := int(params.SamplingTryCount)
 	chosenValIndexes := bandrng.ChooseSomeMaxWeight(rng, valPowers, size, tryCount)
 	validators := make([]sdk.ValAddress, size)
 	for i, idx := range chosenValIndexes {
@@ -110,6 +114,7 @@ func (k Keeper) PrepareRequest(
 		feePayer.String(),
 		r.GetFeeLimit(),
 	)
+	req.Callback = r.GetCallback()
 
 	// Create an execution environment and call Owasm prepare function.
 	env := types.NewPrepareEnv(
@@ -146,6 +151,7 @@ func (k Keeper) PrepareRequest(
 	// We now have everything we need to the request, so let's add it to the store.
 	req.FeeLimit = req.FeeLimit.Sub(totalFees...)
 	id := k.AddRequest(ctx, req)
+	k.recordAssignments(ctx, params, validators)
 
 	// Emit an event describing a data request and asked validators.
 	event := sdk.NewEvent(types.EventTypeRequest)
@@ -159,6 +165,9 @@ func (k Keeper) PrepareRequest(
 		sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", output.GasUsed)),
 		sdk.NewAttribute(types.AttributeKeyTotalFees, totalFees.String()),
 	)
+	if req.Callback != "" {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyCallback, req.Callback))
+	}
 	for _, val := range req.RequestedValidators {
 		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyValidator, val))
 	}
@@ -168,12 +177,19 @@ func (k Keeper) PrepareRequest(
 	ctx.GasMeter().ConsumeGas(params.BaseOwasmGas, "BASE_OWASM_FEE")
 	ctx.GasMeter().ConsumeGas(r.GetExecuteGas(), "OWASM_EXECUTE_FEE")
 
-	// Emit an event for each of the raw data requests.
+	// Emit an event for each of the raw data requests and keep track of the fees to pay on reports.
+	var rawRequestFees []types.RawRequestFee
 	for _, rawReq := range env.GetRawRequests() {
 		ds, err := k.GetDataSource(ctx, rawReq.DataSourceID)
 		if err != nil {
 			return 0, err
 		}
+		if !ds.Fee.Empty() {
+			rawRequestFees = append(
+				rawRequestFees,
+				types.NewRawRequestFee(rawReq.ExternalID, rawReq.DataSourceID, ds.Treasury, ds.Fee),
+			)
+		}
 		ctx.EventManager().EmitEvent(sdk.NewEvent(
 			types.EventTypeRawRequest,
 			sdk.NewAttribute(types.AttributeKeyDataSourceID, fmt.Sprintf("%d", rawReq.DataSourceID)),
@@ -183,6 +199,8 @@ func (k Keeper) PrepareRequest(
 			sdk.NewAttribute(types.AttributeKeyFee, ds.Fee.String()),
 		))
 	}
+	// The fee escrow is saved even without fees, as reports are settled against it until resolution.
+	k.SetFeeEscrow(ctx, types.NewFeeEscrow(id, feePayer.String(), rawRequestFees, totalFees))
 	return id, nil
 }
 
@@ -204,7 +222,7 @@ func (k Keeper) ResolveRequest(ctx sdk.Context, reqID types.RequestID) {
 	}
 }
 
-// CollectFee subtract fee from fee payer and send them to treasury
+// CollectFee subtract fee from fee payer and hold them in the oracle module until reported
 func (k Keeper) CollectFee(
 	ctx sdk.Context,
 	payer sdk.AccAddress,
@@ -230,12 +248,12 @@ func (k Keeper) CollectFee(
 			fee = fee.Add(c)
 		}
 
-		treasury, err := sdk.AccAddressFromBech32(ds.Treasury)
-		if err != nil {
+		// Treasury must be valid as the escrowed fee is paid to it when the raw request is reported.
+		if _, err := sdk.AccAddressFromBech32(ds.Treasury); err != nil {
 			return nil, err
 		}
 
-		if err := collector.Collect(ctx, fee, treasury); err != nil {
+		if err := collector.Collect(ctx, fee); err != nil {
 			return nil, err
 		}
 	}
@@ -195,9 +195,9 @@ func (suite *KeeperTestSuite) TestPrepareRequestSuccessBasic() {
 		GetRollingSeed(gomock.Any()).
 		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY"))
 
-	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, treasury, coins1000000uband)
-	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, treasury, coins1000000uband)
-	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, treasury, coins1000000uband)
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), alice, types.ModuleName, coins1000000uband)
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), alice, types.ModuleName, coins1000000uband)
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), alice, types.ModuleName, coins1000000uband)
 
 	id, err := k.PrepareRequest(ctx, msg, alice, nil)
 	require.NoError(err)
@@ -223,6 +223,23 @@ func (suite *KeeperTestSuite) TestPrepareRequestSuccessBasic() {
 		sdk.NewCoins(sdk.NewInt64Coin("uband", 97000000)),
 	), k.MustGetRequest(ctx, 1))
 
+	// Assignments are not recorded while reliability sampling is disabled.
+	require.Equal(uint64(0), k.GetValidatorReliability(ctx, validators[0].Address).AssignedCount)
+
+	// Data source fees are held in escrow until the raw requests are reported.
+	escrow, err := k.GetFeeEscrow(ctx, 1)
+	require.NoError(err)
+	require.Equal(types.NewFeeEscrow(
+		1,
+		alice.String(),
+		[]types.RawRequestFee{
+			types.NewRawRequestFee(1, 1, treasury.String(), coins1000000uband),
+			types.NewRawRequestFee(2, 2, treasury.String(), coins1000000uband),
+			types.NewRawRequestFee(3, 3, treasury.String(), coins1000000uband),
+		},
+		sdk.NewCoins(sdk.NewInt64Coin("uband", 3000000)),
+	), escrow)
+
 	// assert gas consumption
 	params := k.GetParams(ctx)
 	require.Equal(2, wrappedGasMeter.CountRecord(params.BaseOwasmGas, "BASE_OWASM_FEE"))
@@ -295,7 +312,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestNotEnoughMaxFee() {
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1000000uband).
+		SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1000000uband).
 		Return(nil).AnyTimes()
 
 	ctx = ctx.WithBlockTime(bandtesting.ParseTime(1581589790)).WithBlockHeight(42)
@@ -388,7 +405,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestNotEnoughFund() {
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY"))
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.Alice.Address, bandtesting.Treasury.Address, bandtesting.Coins1000000uband).
+		SendCoinsFromAccountToModule(gomock.Any(), bandtesting.Alice.Address, types.ModuleName, bandtesting.Coins1000000uband).
 		Return(errorsmod.Wrapf(
 			sdkerrors.ErrInsufficientFunds,
 			"spendable balance %s is smaller than %s",
@@ -467,7 +484,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestInvalidAskCountFail() {
 		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY"))
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1000000uband).
+		SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1000000uband).
 		Return(nil).AnyTimes()
 
 	params := k.GetParams(ctx)
@@ -551,7 +568,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestBaseOwasmFeePanic() {
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1000000uband).
+		SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1000000uband).
 		Return(nil).AnyTimes()
 
 	params := k.GetParams(ctx)
@@ -597,7 +614,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestPerValidatorRequestFeePanic() {
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1000000uband).
+		SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1000000uband).
 		Return(nil).AnyTimes()
 
 	params := k.GetParams(ctx)
@@ -746,7 +763,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestUnknownDataSource() {
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1000000uband).
+		SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1000000uband).
 		Return(nil).AnyTimes()
 
 	m := types.NewMsgRequestData(4, obi.MustEncode(testdata.Wasm4Input{
@@ -772,7 +789,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestInvalidDataSourceCount() {
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1000000uband).
+		SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1000000uband).
 		Return(nil).AnyTimes()
 
 	params := k.GetParams(ctx)
@@ -809,7 +826,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestTooMuchWasmGas() {
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1000000uband).
+		SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1000000uband).
 		Return(nil).AnyTimes()
 
 	m := types.NewMsgRequestData(
@@ -858,7 +875,7 @@ func (suite *KeeperTestSuite) TestPrepareRequestTooLargeCalldata() {
 		AnyTimes()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1000000uband).
+		SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1000000uband).
 		Return(nil).AnyTimes()
 
 	m := types.NewMsgRequestData(
@@ -1267,10 +1284,10 @@ func (suite *KeeperTestSuite) TestCollectFeeBasicSuccess() {
 
 	oracletestutil.ChainGoMockCalls(
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1000000uband).
+			SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1000000uband).
 			Return(nil),
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(2000000)))).
+			SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(2000000)))).
 			Return(nil),
 	)
 
@@ -1294,10 +1311,10 @@ func (suite *KeeperTestSuite) TestCollectFeeBasicSuccessWithOtherAskCount() {
 
 	oracletestutil.ChainGoMockCalls(
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(4000000)))).
+			SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(4000000)))).
 			Return(nil),
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(8000000)))).
+			SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(8000000)))).
 			Return(nil),
 	)
 
@@ -1320,7 +1337,7 @@ func (suite *KeeperTestSuite) TestCollectFeeWithMixedAndFeeNotEnough() {
 	require := suite.Require()
 
 	suite.bankKeeper.EXPECT().
-		SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1000000uband).
+		SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1000000uband).
 		Return(nil)
 
 	raws := rawRequestsFromFees(ctx, k, []sdk.Coins{
@@ -1347,10 +1364,10 @@ func (suite *KeeperTestSuite) TestCollectFeeWithEnoughFeeButInsufficientBalance(
 
 	oracletestutil.ChainGoMockCalls(
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.Alice.Address, bandtesting.Treasury.Address, bandtesting.Coins1000000uband).
+			SendCoinsFromAccountToModule(gomock.Any(), bandtesting.Alice.Address, types.ModuleName, bandtesting.Coins1000000uband).
 			Return(nil),
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.Alice.Address, bandtesting.Treasury.Address, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(2000000)))).
+			SendCoinsFromAccountToModule(gomock.Any(), bandtesting.Alice.Address, types.ModuleName, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(2000000)))).
 			Return(errorsmod.Wrapf(
 				sdkerrors.ErrInsufficientFunds,
 				"spendable balance %s is smaller than %s",
@@ -1380,10 +1397,10 @@ func (suite *KeeperTestSuite) TestCollectFeeWithWithManyUnitSuccess() {
 
 	oracletestutil.ChainGoMockCalls(
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, bandtesting.Coins1000000uband).
+			SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, bandtesting.Coins1000000uband).
 			Return(nil),
 		suite.bankKeeper.EXPECT().
-			SendCoins(gomock.Any(), bandtesting.FeePayer.Address, bandtesting.Treasury.Address, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(2000000)), sdk.NewCoin("uabc", math.NewInt(1000000)))).
+			SendCoinsFromAccountToModule(gomock.Any(), bandtesting.FeePayer.Address, types.ModuleName, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(2000000)), sdk.NewCoin("uabc", math.NewInt(1000000)))).
 			Return(nil),
 	)
 
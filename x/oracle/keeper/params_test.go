@@ -77,4 +77,13 @@ func (suite *KeeperTestSuite) TestGetSetParams() {
 	}
 	err = k.SetParams(ctx, expectedParams)
 	require.EqualError(fmt.Errorf("max raw request count must be positive: 0"), err.Error())
+
+	expectedParams = types.DefaultParams()
+	expectedParams.ReliabilityWeightPercentage = types.MaxReliabilityWeightPercentage
+	require.NoError(k.SetParams(ctx, expectedParams))
+	require.Equal(expectedParams, k.GetParams(ctx))
+
+	expectedParams.ReliabilityWeightPercentage = 100
+	err = k.SetParams(ctx, expectedParams)
+	require.EqualError(err, "reliability weight percentage must not exceed 90: 100")
 }
@@ -0,0 +1,417 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// SetRecurringRequestCount sets the number of recurring request count to the given value.
+func (k Keeper) SetRecurringRequestCount(ctx sdk.Context, count uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	ctx.KVStore(k.storeKey).Set(types.RecurringRequestCountStoreKey, bz)
+}
+
+// GetRecurringRequestCount returns the current number of all recurring requests ever created.
+func (k Keeper) GetRecurringRequestCount(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.RecurringRequestCountStoreKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GetNextRecurringRequestID increments and returns the current number of recurring requests.
+func (k Keeper) GetNextRecurringRequestID(ctx sdk.Context) types.RecurringRequestID {
+	count := k.GetRecurringRequestCount(ctx)
+	k.SetRecurringRequestCount(ctx, count+1)
+	return types.RecurringRequestID(count + 1)
+}
+
+// HasRecurringRequest checks if the recurring request of this ID exists in the storage.
+func (k Keeper) HasRecurringRequest(ctx sdk.Context, id types.RecurringRequestID) bool {
+	return ctx.KVStore(k.storeKey).Has(types.RecurringRequestStoreKey(id))
+}
+
+// GetRecurringRequest returns the recurring request struct for the given ID or error if not exists.
+func (k Keeper) GetRecurringRequest(ctx sdk.Context, id types.RecurringRequestID) (types.RecurringRequest, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RecurringRequestStoreKey(id))
+	if bz == nil {
+		return types.RecurringRequest{}, types.ErrRecurringRequestNotFound.Wrapf("id: %d", id)
+	}
+	var recurringRequest types.RecurringRequest
+	k.cdc.MustUnmarshal(bz, &recurringRequest)
+	return recurringRequest, nil
+}
+
+// MustGetRecurringRequest returns the recurring request struct for the given ID. Panic if not exists.
+func (k Keeper) MustGetRecurringRequest(ctx sdk.Context, id types.RecurringRequestID) types.RecurringRequest {
+	recurringRequest, err := k.GetRecurringRequest(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return recurringRequest
+}
+
+// SetRecurringRequest saves the given recurring request to the storage without performing validation.
+func (k Keeper) SetRecurringRequest(ctx sdk.Context, recurringRequest types.RecurringRequest) {
+	ctx.KVStore(k.storeKey).Set(
+		types.RecurringRequestStoreKey(recurringRequest.ID),
+		k.cdc.MustMarshal(&recurringRequest),
+	)
+}
+
+// DeleteRecurringRequest removes the recurring request of the given ID from the storage.
+func (k Keeper) DeleteRecurringRequest(ctx sdk.Context, id types.RecurringRequestID) {
+	ctx.KVStore(k.storeKey).Delete(types.RecurringRequestStoreKey(id))
+}
+
+// GetAllRecurringRequests returns the list of all recurring requests in the store, or nil if there is none.
+func (k Keeper) GetAllRecurringRequests(ctx sdk.Context) (recurringRequests []types.RecurringRequest) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.RecurringRequestStoreKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var recurringRequest types.RecurringRequest
+		k.cdc.MustUnmarshal(iterator.Value(), &recurringRequest)
+		recurringRequests = append(recurringRequests, recurringRequest)
+	}
+	return recurringRequests
+}
+
+// ScheduleRecurringRequest puts the recurring request into the queue at its next height.
+func (k Keeper) ScheduleRecurringRequest(ctx sdk.Context, recurringRequest types.RecurringRequest) {
+	ctx.KVStore(k.storeKey).Set(
+		types.RecurringRequestQueueStoreKey(recurringRequest.NextHeight, recurringRequest.ID),
+		[]byte{0x01},
+	)
+}
+
+// unscheduleRecurringRequest removes the recurring request from the queue.
+func (k Keeper) unscheduleRecurringRequest(ctx sdk.Context, recurringRequest types.RecurringRequest) {
+	ctx.KVStore(k.storeKey).Delete(
+		types.RecurringRequestQueueStoreKey(recurringRequest.NextHeight, recurringRequest.ID),
+	)
+}
+
+// GetDueRecurringRequestIDs returns the IDs of recurring requests scheduled at or before the
+// current block height, ordered by their scheduled height, up to the given limit.
+func (k Keeper) GetDueRecurringRequestIDs(ctx sdk.Context, limit int) (ids []types.RecurringRequestID) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.RecurringRequestQueueStoreKeyPrefix,
+		types.RecurringRequestQueueByHeightPrefixKey(ctx.BlockHeight()+1),
+	)
+	defer iterator.Close()
+	for ; iterator.Valid() && len(ids) < limit; iterator.Next() {
+		key := iterator.Key()
+		ids = append(ids, types.RecurringRequestID(sdk.BigEndianToUint64(key[len(key)-8:])))
+	}
+	return ids
+}
+
+// AddRecurringRequestSpawn records that the request was spawned by the recurring request. The record is
+// kept until the request expires.
+func (k Keeper) AddRecurringRequestSpawn(ctx sdk.Context, id types.RecurringRequestID, reqID types.RequestID) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RecurringRequestSpawnStoreKey(id, reqID), []byte{0x01})
+	store.Set(types.SpawnedRequestStoreKey(reqID), sdk.Uint64ToBigEndian(uint64(id)))
+}
+
+// RemoveRecurringRequestSpawn removes the record of the given request if it was spawned by a recurring request.
+func (k Keeper) RemoveRecurringRequestSpawn(ctx sdk.Context, reqID types.RequestID) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SpawnedRequestStoreKey(reqID))
+	if bz == nil {
+		return
+	}
+	id := types.RecurringRequestID(sdk.BigEndianToUint64(bz))
+	store.Delete(types.RecurringRequestSpawnStoreKey(id, reqID))
+	store.Delete(types.SpawnedRequestStoreKey(reqID))
+}
+
+// GetRecurringRequestSpawns returns the IDs of the unexpired requests spawned by the recurring request.
+func (k Keeper) GetRecurringRequestSpawns(ctx sdk.Context, id types.RecurringRequestID) (reqIDs []types.RequestID) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.RecurringRequestSpawnsPrefixKey(id))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		reqIDs = append(reqIDs, types.RequestID(sdk.BigEndianToUint64(key[len(key)-8:])))
+	}
+	return reqIDs
+}
+
+// AddRecurringRequest creates a new recurring request with a dedicated fee payer account funded
+// with the initial deposit from the owner. The first request is spawned in the next block.
+func (k Keeper) AddRecurringRequest(
+	ctx sdk.Context,
+	msg *types.MsgCreateRecurringRequest,
+	owner sdk.AccAddress,
+) (types.RecurringRequest, error) {
+	if !k.HasOracleScript(ctx, msg.OracleScriptID) {
+		return types.RecurringRequest{}, types.ErrOracleScriptNotFound.Wrapf("id: %d", msg.OracleScriptID)
+	}
+	if err := k.validateRecurringRequestInterval(ctx, msg.Interval); err != nil {
+		return types.RecurringRequest{}, err
+	}
+
+	id := k.GetNextRecurringRequestID(ctx)
+	feePayer, err := k.GenerateRecurringRequestAccount(ctx, fmt.Sprintf("%d", id))
+	if err != nil {
+		return types.RecurringRequest{}, err
+	}
+
+	if !msg.InitialDeposit.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, owner, feePayer, msg.InitialDeposit); err != nil {
+			return types.RecurringRequest{}, err
+		}
+	}
+
+	recurringRequest := types.NewRecurringRequest(
+		id,
+		msg.OracleScriptID,
+		msg.Calldata,
+		msg.AskCount,
+		msg.MinCount,
+		msg.ClientID,
+		msg.FeeLimit,
+		msg.PrepareGas,
+		msg.ExecuteGas,
+		msg.TSSEncoder,
+		msg.Callback,
+		msg.Interval,
+		ctx.BlockHeight()+1,
+		feePayer,
+		owner,
+	)
+	k.SetRecurringRequest(ctx, recurringRequest)
+	k.ScheduleRecurringRequest(ctx, recurringRequest)
+
+	return recurringRequest, nil
+}
+
+// ModifyRecurringRequest changes the interval and fee limit of the recurring request and tops up
+// its deposit. The recurring request is (re)activated and its next request is spawned interval
+// blocks from now, which requires the deposit to cover the fee of a spawn.
+func (k Keeper) ModifyRecurringRequest(
+	ctx sdk.Context,
+	id types.RecurringRequestID,
+	interval uint64,
+	feeLimit sdk.Coins,
+	deposit sdk.Coins,
+	owner sdk.AccAddress,
+) (types.RecurringRequest, error) {
+	recurringRequest, err := k.GetRecurringRequest(ctx, id)
+	if err != nil {
+		return types.RecurringRequest{}, err
+	}
+	if recurringRequest.Owner != owner.String() {
+		return types.RecurringRequest{}, types.ErrNotRecurringRequestOwner.Wrapf("address: %s", owner)
+	}
+	if err := k.validateRecurringRequestInterval(ctx, interval); err != nil {
+		return types.RecurringRequest{}, err
+	}
+
+	feePayer := sdk.MustAccAddressFromBech32(recurringRequest.FeePayer)
+	if !deposit.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, owner, feePayer, deposit); err != nil {
+			return types.RecurringRequest{}, err
+		}
+	}
+
+	spawnCost := k.getRecurringRequestSpawnCost(ctx, feeLimit)
+	if balance := k.bankKeeper.SpendableCoins(ctx, feePayer); !balance.IsAllGTE(spawnCost) {
+		return types.RecurringRequest{}, types.ErrInvalidDeposit.Wrapf(
+			"deposit %s cannot cover the fee limit and spawn fee %s",
+			balance,
+			spawnCost,
+		)
+	}
+
+	if recurringRequest.IsActive {
+		k.unscheduleRecurringRequest(ctx, recurringRequest)
+	}
+
+	recurringRequest.Interval = interval
+	recurringRequest.FeeLimit = feeLimit
+	recurringRequest.NextHeight = ctx.BlockHeight() + int64(interval)
+	recurringRequest.IsActive = true
+	k.SetRecurringRequest(ctx, recurringRequest)
+	k.ScheduleRecurringRequest(ctx, recurringRequest)
+
+	return recurringRequest, nil
+}
+
+// RemoveRecurringRequest removes the recurring request and refunds the remaining deposit of its
+// fee payer account to the owner. The unused fees of its unresolved requests are refunded to the
+// owner when the requests are resolved.
+func (k Keeper) RemoveRecurringRequest(
+	ctx sdk.Context,
+	id types.RecurringRequestID,
+	owner sdk.AccAddress,
+) (sdk.Coins, error) {
+	recurringRequest, err := k.GetRecurringRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if recurringRequest.Owner != owner.String() {
+		return nil, types.ErrNotRecurringRequestOwner.Wrapf("address: %s", owner)
+	}
+
+	feePayer := sdk.MustAccAddressFromBech32(recurringRequest.FeePayer)
+	refund := k.bankKeeper.SpendableCoins(ctx, feePayer)
+	if !refund.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, feePayer, owner, refund); err != nil {
+			return nil, err
+		}
+	}
+
+	// Nothing refers to the fee payer account once the recurring request is removed, so the unused fees of
+	// its unresolved requests are refunded to the owner instead.
+	for _, reqID := range k.GetRecurringRequestSpawns(ctx, id) {
+		if escrow, err := k.GetFeeEscrow(ctx, reqID); err == nil {
+			escrow.Payer = owner.String()
+			k.SetFeeEscrow(ctx, escrow)
+		}
+		k.RemoveRecurringRequestSpawn(ctx, reqID)
+	}
+
+	if recurringRequest.IsActive {
+		k.unscheduleRecurringRequest(ctx, recurringRequest)
+	}
+	k.DeleteRecurringRequest(ctx, id)
+
+	return refund, nil
+}
+
+// ProcessRecurringRequests spawns oracle requests for all recurring requests that are due in this
+// block. Every attempt to spawn a request is charged the spawn fee, and a recurring request whose
+// deposit cannot cover the spawn fee and its fee limit is deactivated, while a failure to prepare
+// the request only skips the current round.
+func (k Keeper) ProcessRecurringRequests(ctx sdk.Context) {
+	spawnFee := k.GetParams(ctx).RecurringRequestSpawnFee
+	for _, id := range k.GetDueRecurringRequestIDs(ctx, types.MaxRecurringRequestSpawnsPerBlock) {
+		recurringRequest := k.MustGetRecurringRequest(ctx, id)
+		k.unscheduleRecurringRequest(ctx, recurringRequest)
+
+		feePayer := sdk.MustAccAddressFromBech32(recurringRequest.FeePayer)
+		deposit := k.bankKeeper.SpendableCoins(ctx, feePayer)
+		if !deposit.IsAllGTE(recurringRequest.FeeLimit.Add(spawnFee...)) {
+			k.deactivateRecurringRequest(ctx, recurringRequest, deposit)
+			continue
+		}
+		if err := k.chargeSpawnFee(ctx, feePayer, spawnFee); err != nil {
+			k.deactivateRecurringRequest(ctx, recurringRequest, deposit)
+			continue
+		}
+
+		cacheCtx, writeFn := ctx.CacheContext()
+		reqID, err := k.PrepareRequest(cacheCtx, &recurringRequest, feePayer, nil)
+		if err != nil {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeSpawnRecurringRequestFail,
+				sdk.NewAttribute(types.AttributeKeyRecurringRequestID, fmt.Sprintf("%d", id)),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			))
+		} else {
+			writeFn()
+			k.AddRecurringRequestSpawn(ctx, id, reqID)
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeSpawnRecurringRequest,
+				sdk.NewAttribute(types.AttributeKeyRecurringRequestID, fmt.Sprintf("%d", id)),
+				sdk.NewAttribute(types.AttributeKeyRequestID, fmt.Sprintf("%d", reqID)),
+			))
+		}
+
+		recurringRequest.NextHeight = ctx.BlockHeight() + int64(recurringRequest.Interval)
+		k.SetRecurringRequest(ctx, recurringRequest)
+		k.ScheduleRecurringRequest(ctx, recurringRequest)
+	}
+}
+
+// deactivateRecurringRequest stops the recurring request from spawning requests as its deposit is
+// exhausted. It is already removed from the queue.
+func (k Keeper) deactivateRecurringRequest(
+	ctx sdk.Context,
+	recurringRequest types.RecurringRequest,
+	deposit sdk.Coins,
+) {
+	recurringRequest.IsActive = false
+	k.SetRecurringRequest(ctx, recurringRequest)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDeactivateRecurringRequest,
+		sdk.NewAttribute(types.AttributeKeyRecurringRequestID, fmt.Sprintf("%d", recurringRequest.ID)),
+		sdk.NewAttribute(types.AttributeKeyDeposit, deposit.String()),
+		sdk.NewAttribute(types.AttributeKeyFeeLimit, recurringRequest.FeeLimit.String()),
+	))
+}
+
+// validateRecurringRequestInterval returns error if the interval is shorter than the minimum interval.
+func (k Keeper) validateRecurringRequestInterval(ctx sdk.Context, interval uint64) error {
+	minInterval := k.GetParams(ctx).MinRecurringRequestInterval
+	if interval < minInterval {
+		return types.ErrInvalidInterval.Wrapf("interval %d is shorter than the minimum %d", interval, minInterval)
+	}
+	return nil
+}
+
+// getRecurringRequestSpawnCost returns the maximum cost of spawning a request with the given fee limit.
+func (k Keeper) getRecurringRequestSpawnCost(ctx sdk.Context, feeLimit sdk.Coins) sdk.Coins {
+	return feeLimit.Add(k.GetParams(ctx).RecurringRequestSpawnFee...)
+}
+
+// chargeSpawnFee sends the spawn fee of a recurring request from its fee payer to the fee collector.
+func (k Keeper) chargeSpawnFee(ctx sdk.Context, feePayer sdk.AccAddress, spawnFee sdk.Coins) error {
+	if spawnFee.IsZero() {
+		return nil
+	}
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, feePayer, k.feeCollectorName, spawnFee)
+}
+
+// GenerateRecurringRequestAccount generates a new fee payer account for the given key
+func (k Keeper) GenerateRecurringRequestAccount(ctx sdk.Context, key string) (sdk.AccAddress, error) {
+	header := ctx.BlockHeader()
+
+	buf := []byte(key)
+	buf = append(buf, header.AppHash...)
+	buf = append(buf, header.DataHash...)
+
+	moduleCred, err := authtypes.NewModuleCredential(
+		types.ModuleName,
+		[]byte(types.RecurringRequestAccountsKey),
+		buf,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	accAddr := sdk.AccAddress(moduleCred.Address())
+
+	if acc := k.authKeeper.GetAccount(ctx, accAddr); acc != nil {
+		// this should not happen
+		return nil, types.ErrAccountAlreadyExist.Wrapf(
+			"existing account for newly generated key account address %s",
+			accAddr.String(),
+		)
+	}
+
+	acc, err := authtypes.NewBaseAccountWithPubKey(moduleCred)
+	if err != nil {
+		return nil, err
+	}
+
+	k.authKeeper.NewAccount(ctx, acc)
+	k.authKeeper.SetAccount(ctx, acc)
+
+	return accAddr, nil
+}
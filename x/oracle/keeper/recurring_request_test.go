@@ -0,0 +1,344 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+var spawnFee = types.DefaultRecurringRequestSpawnFee
+
+func (suite *KeeperTestSuite) createRecurringRequest(ctx sdk.Context, interval uint64) types.RecurringRequest {
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil)
+	suite.authKeeper.EXPECT().NewAccount(gomock.Any(), gomock.Any())
+	suite.authKeeper.EXPECT().SetAccount(gomock.Any(), gomock.Any())
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, gomock.Any(), coins100000000uband).Return(nil)
+
+	msg := types.NewMsgCreateRecurringRequest(
+		1,
+		basicCalldata,
+		1,
+		1,
+		basicClientID,
+		coins100000000uband,
+		testDefaultPrepareGas,
+		testDefaultExecuteGas,
+		0,
+		"",
+		interval,
+		coins100000000uband,
+		alice,
+	)
+	recurringRequest, err := suite.oracleKeeper.AddRecurringRequest(ctx, msg, alice)
+	suite.Require().NoError(err)
+	return recurringRequest
+}
+
+// spawnRecurringRequest spawns a request from the due recurring request of the given fee payer, which pays
+// 3 data sources of 1000000uband each.
+func (suite *KeeperTestSuite) spawnRecurringRequest(ctx sdk.Context, feePayer sdk.AccAddress) {
+	suite.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), feePayer).Return(coins100000000uband.Add(spawnFee...))
+	suite.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), feePayer, authtypes.FeeCollectorName, spawnFee).
+		Return(nil)
+	suite.rollingseedKeeper.EXPECT().
+		GetRollingSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY"))
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), feePayer, types.ModuleName, coins1000000uband).Times(3)
+
+	suite.oracleKeeper.ProcessRecurringRequests(ctx)
+}
+
+func (suite *KeeperTestSuite) TestAddRecurringRequest() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	recurringRequest := suite.createRecurringRequest(ctx, 5)
+	require.Equal(types.RecurringRequestID(1), recurringRequest.ID)
+	require.Equal(int64(11), recurringRequest.NextHeight)
+	require.True(recurringRequest.IsActive)
+	require.Equal(alice.String(), recurringRequest.Owner)
+	require.NotEqual(alice.String(), recurringRequest.FeePayer)
+	require.Equal(uint64(1), k.GetRecurringRequestCount(ctx))
+	require.Equal(recurringRequest, k.MustGetRecurringRequest(ctx, 1))
+
+	// The recurring request is due only from the next block.
+	require.Empty(k.GetDueRecurringRequestIDs(ctx, types.MaxRecurringRequestSpawnsPerBlock))
+	require.Equal(
+		[]types.RecurringRequestID{1},
+		k.GetDueRecurringRequestIDs(ctx.WithBlockHeight(11), types.MaxRecurringRequestSpawnsPerBlock),
+	)
+}
+
+func (suite *KeeperTestSuite) TestAddRecurringRequestIntervalTooShort() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+	msg := types.NewMsgCreateRecurringRequest(
+		1, basicCalldata, 1, 1, basicClientID, coins100000000uband,
+		testDefaultPrepareGas, testDefaultExecuteGas, 0, "", 1, coins100000000uband, alice,
+	)
+	_, err := k.AddRecurringRequest(ctx, msg, alice)
+	require.ErrorIs(err, types.ErrInvalidInterval)
+	require.Equal(uint64(0), k.GetRecurringRequestCount(ctx))
+}
+
+func (suite *KeeperTestSuite) TestAddRecurringRequestOracleScriptNotFound() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	msg := types.NewMsgCreateRecurringRequest(
+		999, basicCalldata, 1, 1, basicClientID, coins100000000uband,
+		testDefaultPrepareGas, testDefaultExecuteGas, 0, "", 5, coins100000000uband, alice,
+	)
+	_, err := k.AddRecurringRequest(ctx, msg, alice)
+	require.ErrorIs(err, types.ErrOracleScriptNotFound)
+	require.Equal(uint64(0), k.GetRecurringRequestCount(ctx))
+}
+
+func (suite *KeeperTestSuite) TestProcessRecurringRequestsSpawn() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx.WithBlockHeight(10)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+	recurringRequest := suite.createRecurringRequest(ctx, 5)
+	feePayer := sdk.MustAccAddressFromBech32(recurringRequest.FeePayer)
+
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	suite.spawnRecurringRequest(ctx, feePayer)
+
+	require.Equal([]types.RequestID{1}, k.GetRecurringRequestSpawns(ctx, 1))
+	require.Equal(feePayer.String(), k.MustGetRequest(ctx, 1).Requester)
+	require.Equal(int64(16), k.MustGetRecurringRequest(ctx, 1).NextHeight)
+	require.Empty(k.GetDueRecurringRequestIDs(ctx, types.MaxRecurringRequestSpawnsPerBlock))
+	require.Equal(
+		[]types.RecurringRequestID{1},
+		k.GetDueRecurringRequestIDs(ctx.WithBlockHeight(16), types.MaxRecurringRequestSpawnsPerBlock),
+	)
+
+	events := ctx.EventManager().Events()
+	require.Equal(types.EventTypeSpawnRecurringRequest, events[len(events)-1].Type)
+}
+
+func (suite *KeeperTestSuite) TestProcessRecurringRequestsFailToPrepare() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	recurringRequest := suite.createRecurringRequest(ctx, 5)
+	feePayer := sdk.MustAccAddressFromBech32(recurringRequest.FeePayer)
+
+	// No active validator, so the request cannot be prepared but the schedule continues and the spawn fee
+	// is still charged.
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	suite.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), feePayer).Return(coins100000000uband.Add(spawnFee...))
+	suite.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), feePayer, authtypes.FeeCollectorName, spawnFee).
+		Return(nil)
+	suite.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).Return(nil)
+
+	k.ProcessRecurringRequests(ctx)
+
+	require.Empty(k.GetRecurringRequestSpawns(ctx, 1))
+	require.Equal(uint64(0), k.GetRequestCount(ctx))
+	require.True(k.MustGetRecurringRequest(ctx, 1).IsActive)
+	require.Equal(int64(16), k.MustGetRecurringRequest(ctx, 1).NextHeight)
+	require.Equal(sdk.Events{sdk.NewEvent(
+		types.EventTypeSpawnRecurringRequestFail,
+		sdk.NewAttribute(types.AttributeKeyRecurringRequestID, "1"),
+		sdk.NewAttribute(types.AttributeKeyReason, "0 < 1: insufficient available validators"),
+	)}, ctx.EventManager().Events())
+}
+
+func (suite *KeeperTestSuite) TestProcessRecurringRequestsDepositExhausted() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	recurringRequest := suite.createRecurringRequest(ctx, 5)
+	feePayer := sdk.MustAccAddressFromBech32(recurringRequest.FeePayer)
+
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	suite.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), feePayer).Return(coins1000000uband)
+
+	k.ProcessRecurringRequests(ctx)
+
+	require.False(k.MustGetRecurringRequest(ctx, 1).IsActive)
+	require.Empty(k.GetRecurringRequestSpawns(ctx, 1))
+	require.Empty(k.GetDueRecurringRequestIDs(ctx.WithBlockHeight(100), types.MaxRecurringRequestSpawnsPerBlock))
+	require.Equal(sdk.Events{sdk.NewEvent(
+		types.EventTypeDeactivateRecurringRequest,
+		sdk.NewAttribute(types.AttributeKeyRecurringRequestID, "1"),
+		sdk.NewAttribute(types.AttributeKeyDeposit, coins1000000uband.String()),
+		sdk.NewAttribute(types.AttributeKeyFeeLimit, coins100000000uband.String()),
+	)}, ctx.EventManager().Events())
+}
+
+func (suite *KeeperTestSuite) TestProcessRecurringRequestsFreeRequestExhausted() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	// A recurring request without fee limit is still charged the spawn fee, so it stops when the
+	// deposit runs out.
+	recurringRequest := suite.createRecurringRequest(ctx, 5)
+	recurringRequest.FeeLimit = sdk.NewCoins()
+	k.SetRecurringRequest(ctx, recurringRequest)
+	feePayer := sdk.MustAccAddressFromBech32(recurringRequest.FeePayer)
+
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	suite.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), feePayer).Return(coins10uband)
+
+	k.ProcessRecurringRequests(ctx)
+
+	require.False(k.MustGetRecurringRequest(ctx, 1).IsActive)
+	require.Empty(k.GetDueRecurringRequestIDs(ctx.WithBlockHeight(100), types.MaxRecurringRequestSpawnsPerBlock))
+}
+
+func (suite *KeeperTestSuite) TestModifyRecurringRequest() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	recurringRequest := suite.createRecurringRequest(ctx, 5)
+	feePayer := sdk.MustAccAddressFromBech32(recurringRequest.FeePayer)
+
+	// Only the owner can update the recurring request.
+	_, err := k.ModifyRecurringRequest(ctx, 1, 20, coins1000000uband, coinsZero, bob)
+	require.ErrorIs(err, types.ErrNotRecurringRequestOwner)
+
+	// Deactivated recurring request is reactivated with the top up.
+	suite.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), feePayer).Return(coinsZero)
+	k.ProcessRecurringRequests(ctx.WithBlockHeight(11))
+	require.False(k.MustGetRecurringRequest(ctx, 1).IsActive)
+
+	// The interval must not be shorter than the minimum interval.
+	_, err = k.ModifyRecurringRequest(ctx, 1, 1, coins1000000uband, coinsZero, alice)
+	require.ErrorIs(err, types.ErrInvalidInterval)
+
+	// The recurring request is not reactivated if the deposit cannot cover the fee limit and spawn fee.
+	suite.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), feePayer).Return(coins1000000uband)
+	_, err = k.ModifyRecurringRequest(ctx, 1, 20, coins1000000uband, coinsZero, alice)
+	require.ErrorIs(err, types.ErrInvalidDeposit)
+	require.False(k.MustGetRecurringRequest(ctx, 1).IsActive)
+
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, feePayer, coins10uband).Return(nil)
+	suite.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), feePayer).Return(coins1000000uband.Add(spawnFee...))
+
+	recurringRequest, err = k.ModifyRecurringRequest(ctx, 1, 20, coins1000000uband, coins10uband, alice)
+	require.NoError(err)
+	require.True(recurringRequest.IsActive)
+	require.Equal(uint64(20), recurringRequest.Interval)
+	require.Equal(coins1000000uband, recurringRequest.FeeLimit)
+	require.Equal(int64(30), recurringRequest.NextHeight)
+	require.Equal(recurringRequest, k.MustGetRecurringRequest(ctx, 1))
+	require.Equal(
+		[]types.RecurringRequestID{1},
+		k.GetDueRecurringRequestIDs(ctx.WithBlockHeight(30), types.MaxRecurringRequestSpawnsPerBlock),
+	)
+}
+
+func (suite *KeeperTestSuite) TestRemoveRecurringRequest() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	recurringRequest := suite.createRecurringRequest(ctx, 5)
+	feePayer := sdk.MustAccAddressFromBech32(recurringRequest.FeePayer)
+	k.AddRecurringRequestSpawn(ctx, 1, 42)
+	k.SetFeeEscrow(ctx, types.NewFeeEscrow(42, feePayer.String(), nil, coins1000000uband))
+
+	// Only the owner can cancel the recurring request.
+	_, err := k.RemoveRecurringRequest(ctx, 1, bob)
+	require.ErrorIs(err, types.ErrNotRecurringRequestOwner)
+
+	suite.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), feePayer).Return(coins1000000uband)
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), feePayer, alice, coins1000000uband).Return(nil)
+
+	refund, err := k.RemoveRecurringRequest(ctx, 1, alice)
+	require.NoError(err)
+	require.Equal(coins1000000uband, refund)
+	require.False(k.HasRecurringRequest(ctx, 1))
+	require.Empty(k.GetRecurringRequestSpawns(ctx, 1))
+	require.False(ctx.KVStore(suite.key).Has(types.SpawnedRequestStoreKey(42)))
+	escrow, err := k.GetFeeEscrow(ctx, 42)
+	require.NoError(err)
+	require.Equal(alice.String(), escrow.Payer)
+	require.Empty(k.GetDueRecurringRequestIDs(ctx.WithBlockHeight(11), types.MaxRecurringRequestSpawnsPerBlock))
+
+	_, err = k.RemoveRecurringRequest(ctx, 1, alice)
+	require.ErrorIs(err, types.ErrRecurringRequestNotFound)
+}
+
+func (suite *KeeperTestSuite) TestRemoveRecurringRequestWithPendingSpawn() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx.WithBlockHeight(10)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+	recurringRequest := suite.createRecurringRequest(ctx, 5)
+	feePayer := sdk.MustAccAddressFromBech32(recurringRequest.FeePayer)
+
+	ctx = ctx.WithBlockHeight(11)
+	suite.spawnRecurringRequest(ctx, feePayer)
+	require.Equal([]types.RequestID{1}, k.GetRecurringRequestSpawns(ctx, 1))
+
+	// The recurring request is cancelled while its spawned request is pending.
+	ctx = ctx.WithBlockHeight(12)
+	suite.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), feePayer).Return(coins10uband)
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), feePayer, alice, coins10uband).Return(nil)
+	_, err := k.RemoveRecurringRequest(ctx, 1, alice)
+	require.NoError(err)
+
+	// The unused fees of the spawned request are refunded to the owner when it expires.
+	escrowAddr := authtypes.NewModuleAddress(types.ModuleName)
+	remaining := sdk.NewCoins(sdk.NewInt64Coin("uband", 3000000))
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), escrowAddr, alice, remaining).Return(nil)
+
+	expirationHeight := int64(11 + k.GetParams(ctx).ExpirationBlockCount)
+	k.ProcessExpiredRequests(ctx.WithBlockHeight(expirationHeight))
+
+	require.Equal(types.RESOLVE_STATUS_EXPIRED, k.MustGetResult(ctx, 1).ResolveStatus)
+	require.False(k.HasFeeEscrow(ctx, 1))
+	require.False(ctx.KVStore(suite.key).Has(types.SpawnedRequestStoreKey(1)))
+}
+
+func (suite *KeeperTestSuite) TestRecurringRequestSpawnRemovedOnExpiration() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx.WithBlockHeight(10)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+	recurringRequest := suite.createRecurringRequest(ctx, 5)
+	feePayer := sdk.MustAccAddressFromBech32(recurringRequest.FeePayer)
+
+	ctx = ctx.WithBlockHeight(11)
+	suite.spawnRecurringRequest(ctx, feePayer)
+
+	// The unused fees are refunded to the fee payer of the active recurring request.
+	escrowAddr := authtypes.NewModuleAddress(types.ModuleName)
+	remaining := sdk.NewCoins(sdk.NewInt64Coin("uband", 3000000))
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), escrowAddr, feePayer, remaining).Return(nil)
+
+	expirationHeight := int64(11 + k.GetParams(ctx).ExpirationBlockCount)
+	k.ProcessExpiredRequests(ctx.WithBlockHeight(expirationHeight))
+
+	// The expired request is no longer recorded as a spawn of the recurring request.
+	require.Empty(k.GetRecurringRequestSpawns(ctx, 1))
+	require.False(ctx.KVStore(suite.key).Has(types.SpawnedRequestStoreKey(1)))
+	require.True(k.HasResult(ctx, 1))
+}
@@ -1,25 +1,184 @@
 package keeper
 
 import (
+	"github.com/cosmos/gogoproto/proto"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
 )
 
-// OnRecvPacket processes a cross chain oracle request. Data source fees
-// are collected from the relayer account.
+// OnRecvPacket processes a cross chain oracle request and returns the acknowledgement to be sent back to the
+// requester chain. Data source fees are collected from the prepaid account of the channel if the channel is
+// of version bandchain-2, or from the relayer account otherwise.
 func (k Keeper) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	data types.OracleRequestPacketData,
 	relayer sdk.AccAddress,
-) (types.RequestID, error) {
+) (proto.Message, error) {
 	if err := data.ValidateBasic(); err != nil {
-		return 0, err
+		return nil, err
+	}
+	if err := k.consumeIBCRequestQuota(ctx, packet.DestinationPort, packet.DestinationChannel); err != nil {
+		return nil, err
 	}
 	ibcChannel := types.NewIBCChannel(packet.DestinationPort, packet.DestinationChannel)
 
-	return k.PrepareRequest(ctx, &data, relayer, &ibcChannel)
+	version := k.getChannelVersion(ctx, packet.DestinationPort, packet.DestinationChannel)
+	feePayer := relayer
+	if version == types.Version2 {
+		// The requests of a prepaid channel never fall back to the relayer, so that the counterparty chain
+		// can cap the total spending of the channel with its prepaid balance.
+		feePayer = GetChannelPrepaidAccount(packet.DestinationChannel)
+		if prepaid := k.bankKeeper.SpendableCoins(ctx, feePayer); !prepaid.IsAllGTE(data.FeeLimit) {
+			return nil, types.ErrInsufficientPrepaidFee.Wrapf(
+				"channel: %s, prepaid: %s, fee limit: %s",
+				packet.DestinationChannel,
+				prepaid,
+				data.FeeLimit,
+			)
+		}
+	}
+
+	id, err := k.PrepareRequest(ctx, &data, feePayer, &ibcChannel)
+	if err != nil {
+		return nil, err
+	}
+
+	if version != types.Version2 {
+		return types.NewOracleRequestPacketAcknowledgement(id), nil
+	}
+
+	// The request is expired at its expiration height if it is not resolved by then.
+	request := k.MustGetRequest(ctx, id)
+	expirationHeight := request.RequestHeight + int64(k.GetParams(ctx).ExpirationBlockCount)
+
+	return types.NewOracleRequestPacketAcknowledgementV2(id, expirationHeight), nil
+}
+
+// IsPrepaidChannel returns whether the oracle channel of the given ID exists and pays the data source fees of
+// its requests from its prepaid account, i.e. is of version bandchain-2.
+func (k Keeper) IsPrepaidChannel(ctx sdk.Context, channelID string) bool {
+	return k.getChannelVersion(ctx, k.GetPort(ctx), channelID) == types.Version2
+}
+
+// getChannelVersion returns the version of the given channel, or an empty string if not found.
+func (k Keeper) getChannelVersion(ctx sdk.Context, portID string, channelID string) string {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return ""
+	}
+	return channel.Version
+}
+
+// GetChannelPrepaidAccount returns the account holding the prepaid data source fees of the requests from
+// the given channel.
+func GetChannelPrepaidAccount(channelID string) sdk.AccAddress {
+	return address.Module(types.ModuleName, []byte(types.ChannelPrepaidAccountsKey), []byte(channelID))
+}
+
+// WithdrawChannelPrepaidFee sends the given amount of tokens from the prepaid account of the given channel to
+// the receiver.
+func (k Keeper) WithdrawChannelPrepaidFee(
+	ctx sdk.Context,
+	channelID string,
+	receiver sdk.AccAddress,
+	amount sdk.Coins,
+) error {
+	prepaidAccount := GetChannelPrepaidAccount(channelID)
+	if prepaid := k.bankKeeper.SpendableCoins(ctx, prepaidAccount); !prepaid.IsAllGTE(amount) {
+		return types.ErrInsufficientPrepaidFee.Wrapf("channel: %s, prepaid: %s, amount: %s", channelID, prepaid, amount)
+	}
+
+	return k.bankKeeper.SendCoins(ctx, prepaidAccount, receiver, amount)
+}
+
+// GetIBCRequestCounter returns the IBC request counter stored under the given key, or an empty counter if
+// not exists.
+func (k Keeper) GetIBCRequestCounter(ctx sdk.Context, key []byte) types.IBCRequestCounter {
+	var counter types.IBCRequestCounter
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &counter)
+	}
+	return counter
+}
+
+// SetIBCRequestCounter saves the given IBC request counter under the given key.
+func (k Keeper) SetIBCRequestCounter(ctx sdk.Context, key []byte, counter types.IBCRequestCounter) {
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&counter))
+}
+
+// PruneIBCRequestCounters removes the IBC request counters of the previous rate limit windows at the start of
+// every window. All counters are removed if rate limiting is disabled.
+func (k Keeper) PruneIBCRequestCounters(ctx sdk.Context) {
+	window := k.GetParams(ctx).IBCRequestRateLimitWindow
+	if window != 0 && uint64(ctx.BlockHeight())%window != 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, prefix := range [][]byte{
+		types.IBCChannelRequestCounterKeyPrefix,
+		types.IBCLightClientRequestCounterKeyPrefix,
+	} {
+		var keys [][]byte
+		iterator := storetypes.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
+
+// consumeIBCRequestQuota counts an IBC request from the given channel in the current rate limit window and
+// returns error if it exceeds the limit of the channel or of the light client of the channel. Both are
+// identified by the chain state rather than the request, so that the counterparty cannot evade the limits.
+func (k Keeper) consumeIBCRequestQuota(ctx sdk.Context, portID string, channelID string) error {
+	params := k.GetParams(ctx)
+	if params.IBCRequestRateLimitWindow == 0 {
+		return nil
+	}
+	window := uint64(ctx.BlockHeight()) / params.IBCRequestRateLimitWindow
+
+	channelKey := types.IBCChannelRequestCounterStoreKey(channelID)
+	if !k.incrementIBCRequestCounter(ctx, channelKey, window, params.MaxIBCRequestsPerChannel) {
+		return types.ErrIBCRequestRateLimited.Wrapf("channel: %s", channelID)
+	}
+
+	lightClientID, _, err := k.channelKeeper.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+	lightClientKey := types.IBCLightClientRequestCounterStoreKey(lightClientID)
+	if !k.incrementIBCRequestCounter(ctx, lightClientKey, window, params.MaxIBCRequestsPerLightClient) {
+		return types.ErrIBCRequestRateLimited.Wrapf("light client: %s", lightClientID)
+	}
+
+	return nil
+}
+
+// incrementIBCRequestCounter increments the IBC request counter under the given key in the given window.
+// It returns false without incrementing if the count would exceed the given limit, where zero means unlimited.
+func (k Keeper) incrementIBCRequestCounter(ctx sdk.Context, key []byte, window uint64, limit uint64) bool {
+	counter := k.GetIBCRequestCounter(ctx, key)
+	if counter.Window != window {
+		counter = types.IBCRequestCounter{Window: window}
+	}
+	if limit != 0 && counter.Count >= limit {
+		return false
+	}
+
+	counter.Count++
+	k.SetIBCRequestCounter(ctx, key, counter)
+	return true
 }
@@ -35,7 +35,14 @@ func (k Keeper) AddReport(
 		return err
 	}
 	k.SetReport(ctx, rid, types.NewReport(val, reportInTime, rawReports))
-	return nil
+	k.recordReport(ctx, k.GetParams(ctx), val, uint64(ctx.BlockHeight()-k.MustGetRequest(ctx, rid).RequestHeight))
+
+	// The remaining fees are refunded to the payer on resolution, so the treasuries of the raw
+	// requests in a report that arrives after the request is resolved are not paid.
+	if k.HasResult(ctx, rid) {
+		return nil
+	}
+	return k.PayDataSourceFees(ctx, rid, rawReports)
 }
 
 func (k Keeper) CheckValidReport(
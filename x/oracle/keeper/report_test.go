@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	bandtesting "github.com/bandprotocol/chain/v3/testing"
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
 )
@@ -23,6 +25,7 @@ func (suite *KeeperTestSuite) TestAddReportSuccess() {
 	require := suite.Require()
 
 	k.SetRequest(ctx, 1, defaultRequest())
+	k.SetFeeEscrow(ctx, types.NewFeeEscrow(1, alice.String(), nil, sdk.NewCoins()))
 	err := k.AddReport(ctx, 1,
 		validators[0].Address, true, []types.RawReport{
 			types.NewRawReport(1, 0, []byte("data1/1")),
@@ -75,6 +78,7 @@ func (suite *KeeperTestSuite) TestDuplicateReport() {
 	require := suite.Require()
 
 	k.SetRequest(ctx, 1, defaultRequest())
+	k.SetFeeEscrow(ctx, types.NewFeeEscrow(1, alice.String(), nil, sdk.NewCoins()))
 	err := k.AddReport(ctx, 1,
 		validators[0].Address, true, []types.RawReport{
 			types.NewRawReport(1, 0, []byte("data1/1")),
@@ -80,9 +80,10 @@ func (k Keeper) ProcessExpiredRequests(ctx sdk.Context) {
 			}
 		}
 
-		// Cleanup request and reports
+		// Cleanup request, reports and the record of the recurring request that spawned it
 		k.DeleteRequest(ctx, currentReqID)
 		k.DeleteReports(ctx, currentReqID)
+		k.RemoveRecurringRequestSpawn(ctx, currentReqID)
 
 		// Set last expired request ID to be this current request.
 		k.SetRequestLastExpired(ctx, currentReqID)
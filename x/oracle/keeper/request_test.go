@@ -264,6 +264,9 @@ func (suite *KeeperTestSuite) TestProcessExpiredRequests() {
 	k.AddRequest(ctx, req2)
 	k.AddRequest(ctx, req3)
 	k.AddRequest(ctx, req4)
+	for id := types.RequestID(1); id <= 4; id++ {
+		k.SetFeeEscrow(ctx, types.NewFeeEscrow(id, alice.String(), nil, sdk.NewCoins()))
+	}
 
 	// Initially validator 0 & 1 are active.
 	require.True(k.GetValidatorStatus(ctx, validators[0].Address).IsActive)
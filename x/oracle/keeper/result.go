@@ -30,6 +30,11 @@ func (k Keeper) SetResult(ctx sdk.Context, reqID types.RequestID, result types.R
 	ctx.KVStore(k.storeKey).Set(types.ResultStoreKey(reqID), k.cdc.MustMarshal(&result))
 }
 
+// DeleteResult deletes the result of the given request ID from the store.
+func (k Keeper) DeleteResult(ctx sdk.Context, id types.RequestID) {
+	ctx.KVStore(k.storeKey).Delete(types.ResultStoreKey(id))
+}
+
 // MarshalResult marshal the result
 func (k Keeper) MarshalResult(ctx sdk.Context, result types.Result) ([]byte, error) {
 	return k.cdc.Marshal(&result)
@@ -39,6 +44,9 @@ func (k Keeper) MarshalResult(ctx sdk.Context, result types.Result) ([]byte, err
 func (k Keeper) GetResult(ctx sdk.Context, id types.RequestID) (types.Result, error) {
 	bz := ctx.KVStore(k.storeKey).Get(types.ResultStoreKey(id))
 	if bz == nil {
+		if id <= k.GetResultLastPruned(ctx) {
+			return types.Result{}, types.ErrResultPruned.Wrapf("id: %d", id)
+		}
 		return types.Result{}, types.ErrResultNotFound.Wrapf("id: %d", id)
 	}
 	var result types.Result
@@ -65,7 +73,10 @@ func (k Keeper) ResolveSuccess(
 	gasUsed uint64,
 	encoder types.Encoder,
 ) {
-	k.SaveResult(ctx, id, types.RESOLVE_STATUS_SUCCESS, result)
+	if err := k.SaveResult(ctx, id, types.RESOLVE_STATUS_SUCCESS, result); err != nil {
+		k.emitResolveFailureEvent(ctx, id, err.Error())
+		return
+	}
 
 	event := sdk.NewEvent(
 		types.EventTypeResolve,
@@ -162,32 +173,54 @@ func (k Keeper) handleCreateSigningFailed(
 
 // ResolveFailure resolves the given request as failure with the given reason.
 func (k Keeper) ResolveFailure(ctx sdk.Context, id types.RequestID, reason string) {
-	k.SaveResult(ctx, id, types.RESOLVE_STATUS_FAILURE, []byte{})
+	if err := k.SaveResult(ctx, id, types.RESOLVE_STATUS_FAILURE, []byte{}); err != nil {
+		reason = err.Error()
+	}
+	k.emitResolveFailureEvent(ctx, id, reason)
+}
+
+// ResolveExpired resolves the given request as expired.
+func (k Keeper) ResolveExpired(ctx sdk.Context, id types.RequestID) {
+	if err := k.SaveResult(ctx, id, types.RESOLVE_STATUS_EXPIRED, []byte{}); err != nil {
+		k.emitResolveFailureEvent(ctx, id, err.Error())
+		return
+	}
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeResolve,
 		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
-		sdk.NewAttribute(types.AttributeKeyResolveStatus, fmt.Sprintf("%d", types.RESOLVE_STATUS_FAILURE)),
-		sdk.NewAttribute(types.AttributeKeyReason, reason),
+		sdk.NewAttribute(types.AttributeKeyResolveStatus, fmt.Sprintf("%d", types.RESOLVE_STATUS_EXPIRED)),
 	))
 }
 
-// ResolveExpired resolves the given request as expired.
-func (k Keeper) ResolveExpired(ctx sdk.Context, id types.RequestID) {
-	k.SaveResult(ctx, id, types.RESOLVE_STATUS_EXPIRED, []byte{})
+// emitResolveFailureEvent emits the resolve event of the given request resolved as failure with the given reason.
+func (k Keeper) emitResolveFailureEvent(ctx sdk.Context, id types.RequestID, reason string) {
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeResolve,
 		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
-		sdk.NewAttribute(types.AttributeKeyResolveStatus, fmt.Sprintf("%d", types.RESOLVE_STATUS_EXPIRED)),
+		sdk.NewAttribute(types.AttributeKeyResolveStatus, fmt.Sprintf("%d", types.RESOLVE_STATUS_FAILURE)),
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
 	))
 }
 
-// SaveResult saves the result packets for the request with the given resolve status and result.
+// SaveResult saves the result packets for the request with the given resolve status and result and
+// refunds the remaining data source fees. If the refund fails, the fee escrow is kept and the request is
+// saved as failure instead, and the refund error is returned.
 func (k Keeper) SaveResult(
 	ctx sdk.Context, id types.RequestID, status types.ResolveStatus, result []byte,
-) {
+) error {
+	// Refund in a cache context so that a failed refund leaves the fee escrow untouched.
+	cacheCtx, writeFn := ctx.CacheContext()
+	refundErr := k.RefundDataSourceFees(cacheCtx, id)
+	if refundErr != nil {
+		status = types.RESOLVE_STATUS_FAILURE
+		result = []byte{}
+	} else {
+		writeFn()
+	}
+
 	r := k.MustGetRequest(ctx, id)
 	reportCount := k.GetReportCount(ctx, id)
-	k.SetResult(ctx, id, types.NewResult(
+	res := types.NewResult(
 		r.ClientID,                         // ClientID
 		r.OracleScriptID,                   // OracleScriptID
 		r.Calldata,                         // Calldata
@@ -199,7 +232,9 @@ func (k Keeper) SaveResult(
 		ctx.BlockTime().Unix(),             // ResolveTime
 		status,                             // ResolveStatus
 		result,                             // Result
-	))
+	)
+	k.SetResult(ctx, id, res)
+	k.afterRequestResolved(ctx, id, r, res)
 
 	if r.IBCChannel != nil {
 		sourceChannel := r.IBCChannel.ChannelId
@@ -211,7 +246,7 @@ func (k Keeper) SaveResult(
 				types.EventTypeSendPacketFail,
 				sdk.NewAttribute(types.AttributeKeyReason, "Module does not own channel capability"),
 			))
-			return
+			return refundErr
 		}
 
 		packetData := types.NewOracleResponsePacketData(
@@ -233,4 +268,64 @@ func (k Keeper) SaveResult(
 			))
 		}
 	}
+
+	return refundErr
+}
+
+// PruneResults deletes the results of expired requests that fall outside the result retention policy, in
+// chronological order and up to MaxPrunedResultsPerBlock results per block. A result is pruned once it is
+// older than ResultRetentionPeriod or is not among the latest ResultRetentionCount expired requests,
+// whichever comes first. Pruning stops at the first result that must be kept, so pruned results always
+// form the range [1, ResultLastPruned].
+func (k Keeper) PruneResults(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.MaxPrunedResultsPerBlock == 0 ||
+		(params.ResultRetentionPeriod == 0 && params.ResultRetentionCount == 0) {
+		return
+	}
+
+	lastPruned := k.GetResultLastPruned(ctx)
+	lastExpired := k.GetRequestLastExpired(ctx)
+	currentReqID := lastPruned + 1
+	for pruned := uint64(0); currentReqID <= lastExpired && pruned < params.MaxPrunedResultsPerBlock; pruned++ {
+		if !k.isResultPrunable(ctx, params, currentReqID, lastExpired) {
+			break
+		}
+		k.DeleteResult(ctx, currentReqID)
+		currentReqID++
+	}
+
+	if currentReqID == lastPruned+1 {
+		return
+	}
+
+	k.SetResultLastPruned(ctx, currentReqID-1)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePruneResults,
+		sdk.NewAttribute(types.AttributeKeyFromID, fmt.Sprintf("%d", lastPruned+1)),
+		sdk.NewAttribute(types.AttributeKeyToID, fmt.Sprintf("%d", currentReqID-1)),
+	))
+}
+
+// isResultPrunable returns whether the result of the given expired request is outside the result retention
+// policy of the given params.
+func (k Keeper) isResultPrunable(
+	ctx sdk.Context,
+	params types.Params,
+	id types.RequestID,
+	lastExpired types.RequestID,
+) bool {
+	if params.ResultRetentionCount != 0 && uint64(lastExpired-id) >= params.ResultRetentionCount {
+		return true
+	}
+	if params.ResultRetentionPeriod == 0 {
+		return false
+	}
+
+	result, err := k.GetResult(ctx, id)
+	if err != nil {
+		// Every expired request has a result, but there is nothing to keep if it does not.
+		return true
+	}
+	return ctx.BlockTime().Unix()-result.ResolveTime >= int64(params.ResultRetentionPeriod)
 }
@@ -1,9 +1,13 @@
 package keeper_test
 
 import (
+	"errors"
+	"time"
+
 	"go.uber.org/mock/gomock"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	bandtesting "github.com/bandprotocol/chain/v3/testing"
 	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
@@ -44,7 +48,7 @@ func (suite *KeeperTestSuite) TestSaveResultOK() {
 	ctx = ctx.WithBlockTime(bandtesting.ParseTime(200))
 	k.SetRequest(ctx, 42, defaultRequest()) // See report_test.go
 	k.SetReport(ctx, 42, types.NewReport(validators[0].Address, true, nil))
-	k.SaveResult(ctx, 42, types.RESOLVE_STATUS_SUCCESS, basicResult)
+	require.NoError(k.SaveResult(ctx, 42, types.RESOLVE_STATUS_SUCCESS, basicResult))
 	expect := types.NewResult(
 		basicClientID, 1, basicCalldata, 2, 2, 42, 1, bandtesting.ParseTime(0).Unix(),
 		bandtesting.ParseTime(200).Unix(), types.RESOLVE_STATUS_SUCCESS, basicResult,
@@ -54,6 +58,35 @@ func (suite *KeeperTestSuite) TestSaveResultOK() {
 	require.Equal(expect, result)
 }
 
+func (suite *KeeperTestSuite) TestSaveResultRefundFailed() {
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.SetRequest(ctx, 42, defaultRequest()) // See report_test.go
+	suite.setFeeEscrow(ctx)                 // See fee_escrow_test.go
+	escrowAddr := authtypes.NewModuleAddress(types.ModuleName)
+	refundErr := errors.New("insufficient funds")
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), escrowAddr, alice, gomock.Any()).Return(refundErr).Times(2)
+
+	// The request is saved as failure and the fee escrow is kept when the refund fails.
+	err := k.SaveResult(ctx, 42, types.RESOLVE_STATUS_SUCCESS, basicResult)
+	require.ErrorIs(err, refundErr)
+	require.Equal(types.RESOLVE_STATUS_FAILURE, k.MustGetResult(ctx, 42).ResolveStatus)
+	require.Empty(k.MustGetResult(ctx, 42).Result)
+	require.True(k.HasFeeEscrow(ctx, 42))
+
+	// Resolving the request as success emits the failure of the refund instead.
+	k.ResolveSuccess(ctx, 42, defaultRequest().Requester, defaultRequest().FeeLimit, basicResult, 1234, 0)
+	require.Equal(types.RESOLVE_STATUS_FAILURE, k.MustGetResult(ctx, 42).ResolveStatus)
+	require.Equal(sdk.Events{sdk.NewEvent(
+		types.EventTypeResolve,
+		sdk.NewAttribute(types.AttributeKeyID, "42"),
+		sdk.NewAttribute(types.AttributeKeyResolveStatus, "2"),
+		sdk.NewAttribute(types.AttributeKeyReason, refundErr.Error()),
+	)}, ctx.EventManager().Events())
+}
+
 func (suite *KeeperTestSuite) TestResolveSuccess() {
 	ctx := suite.ctx
 	k := suite.oracleKeeper
@@ -233,3 +266,78 @@ func (suite *KeeperTestSuite) TestResolveExpired() {
 		sdk.NewAttribute(types.AttributeKeyResolveStatus, "3"),
 	)}, ctx.EventManager().Events())
 }
+
+func (suite *KeeperTestSuite) TestPruneResults() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	// Requests #1-#6 are expired and resolved 10 seconds apart, request #7 is still pending.
+	for i := int64(1); i <= 6; i++ {
+		k.SetResult(ctx, types.RequestID(i), types.NewResult(
+			basicClientID, 1, basicCalldata, 1, 1, types.RequestID(i), 1, i*10, i*10, types.RESOLVE_STATUS_SUCCESS,
+			basicResult,
+		))
+	}
+	k.SetRequestCount(ctx, 7)
+	k.SetRequestLastExpired(ctx, 6)
+	ctx = ctx.WithBlockTime(time.Unix(100, 0))
+
+	// Nothing is pruned while the retention policy is disabled.
+	k.PruneResults(ctx)
+	require.Equal(types.RequestID(0), k.GetResultLastPruned(ctx))
+	require.True(k.HasResult(ctx, 1))
+
+	// Keeping the latest 4 results prunes #1 and #2, but the budget only allows one per block.
+	params := k.GetParams(ctx)
+	params.ResultRetentionCount = 4
+	params.MaxPrunedResultsPerBlock = 1
+	require.NoError(k.SetParams(ctx, params))
+
+	k.PruneResults(ctx)
+	require.Equal(types.RequestID(1), k.GetResultLastPruned(ctx))
+	require.False(k.HasResult(ctx, 1))
+	require.True(k.HasResult(ctx, 2))
+
+	k.PruneResults(ctx)
+	k.PruneResults(ctx)
+	require.Equal(types.RequestID(2), k.GetResultLastPruned(ctx))
+	require.True(k.HasResult(ctx, 3))
+
+	// Results resolved at least 60 seconds ago are also pruned, whichever policy comes first.
+	params.ResultRetentionPeriod = 60
+	params.MaxPrunedResultsPerBlock = 10
+	require.NoError(k.SetParams(ctx, params))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.PruneResults(ctx)
+	require.Equal(types.RequestID(4), k.GetResultLastPruned(ctx))
+	require.False(k.HasResult(ctx, 4))
+	require.True(k.HasResult(ctx, 5))
+	require.Equal(sdk.Events{sdk.NewEvent(
+		types.EventTypePruneResults,
+		sdk.NewAttribute(types.AttributeKeyFromID, "3"),
+		sdk.NewAttribute(types.AttributeKeyToID, "4"),
+	)}, ctx.EventManager().Events())
+
+	// Results of requests that are not expired yet are never pruned.
+	k.SetResult(ctx, 7, types.NewResult(
+		basicClientID, 1, basicCalldata, 1, 1, 7, 1, 0, 0, types.RESOLVE_STATUS_SUCCESS, basicResult,
+	))
+	k.PruneResults(ctx.WithBlockTime(time.Unix(1000, 0)))
+	require.Equal(types.RequestID(6), k.GetResultLastPruned(ctx))
+	require.True(k.HasResult(ctx, 7))
+
+	// Pruned results are reported as pruned rather than not found.
+	_, err := k.GetResult(ctx, 6)
+	require.ErrorIs(err, types.ErrResultPruned)
+	_, err = k.GetResult(ctx, 8)
+	require.ErrorIs(err, types.ErrResultNotFound)
+
+	res, err := suite.queryClient.PrunedResults(ctx, &types.QueryPrunedResultsRequest{})
+	require.NoError(err)
+	require.Equal(&types.QueryPrunedResultsResponse{FromRequestID: 1, ToRequestID: 6}, res)
+
+	_, err = suite.queryClient.Request(ctx, &types.QueryRequestRequest{RequestId: 3})
+	require.ErrorContains(err, types.ErrResultPruned.Error())
+}
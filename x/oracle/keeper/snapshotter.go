@@ -159,14 +159,24 @@ func writeFileToSnapshot(
 	return nil
 }
 
-func restoreV1(ctx sdk.Context, k *Keeper, compressedCode []byte, foundCode map[string]bool) error {
-	// uncompress code
+// UncompressSnapshotPayload returns the data source executable or compiled oracle script of the given
+// payload of the oracle snapshot extension.
+func UncompressSnapshotPayload(compressedCode []byte) ([]byte, error) {
 	code, err := gzip.Uncompress(
 		compressedCode,
 		max(types.MaxExecutableSize, types.MaxWasmCodeSize, types.MaxCompiledWasmCodeSize),
 	)
 	if err != nil {
-		return types.ErrUncompressionFailed.Wrap(err.Error())
+		return nil, types.ErrUncompressionFailed.Wrap(err.Error())
+	}
+	return code, nil
+}
+
+func restoreV1(ctx sdk.Context, k *Keeper, compressedCode []byte, foundCode map[string]bool) error {
+	// uncompress code
+	code, err := UncompressSnapshotPayload(compressedCode)
+	if err != nil {
+		return err
 	}
 
 	// check if we really need this file or not first
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// GetValidatorReliability returns the oracle reliability for the given validator. Note that the
+// reliability is default to zero counts, so new validators start without any record.
+func (k Keeper) GetValidatorReliability(ctx sdk.Context, val sdk.ValAddress) types.ValidatorReliability {
+	bz := ctx.KVStore(k.storeKey).Get(types.ValidatorReliabilityStoreKey(val))
+	if bz == nil {
+		return types.NewValidatorReliability(val, 0, 0, 0)
+	}
+	var reliability types.ValidatorReliability
+	k.cdc.MustUnmarshal(bz, &reliability)
+	return reliability
+}
+
+// SetValidatorReliability sets the oracle reliability for the given validator.
+func (k Keeper) SetValidatorReliability(
+	ctx sdk.Context,
+	val sdk.ValAddress,
+	reliability types.ValidatorReliability,
+) {
+	ctx.KVStore(k.storeKey).Set(types.ValidatorReliabilityStoreKey(val), k.cdc.MustMarshal(&reliability))
+}
+
+// GetSamplingWeightPercentage returns the percentage of the validator power used as its weight in
+// request sampling under the given params. It is always 100 if reliability sampling is disabled.
+func (k Keeper) GetSamplingWeightPercentage(ctx sdk.Context, params types.Params, val sdk.ValAddress) uint64 {
+	if !params.ReliabilitySamplingEnabled {
+		return 100
+	}
+	return k.GetValidatorReliability(ctx, val).SamplingWeightPercentage(params.ReliabilityWeightPercentage)
+}
+
+// recordAssignments increases the assigned count of all validators chosen for a request. Nothing is
+// recorded if reliability sampling is disabled.
+func (k Keeper) recordAssignments(ctx sdk.Context, params types.Params, vals []sdk.ValAddress) {
+	if !params.ReliabilitySamplingEnabled {
+		return
+	}
+	for _, val := range vals {
+		reliability := k.GetValidatorReliability(ctx, val)
+		reliability.AssignedCount++
+		if params.ReliabilityWindowSize != 0 && reliability.AssignedCount > params.ReliabilityWindowSize {
+			reliability = reliability.Decay()
+		}
+		k.SetValidatorReliability(ctx, val, reliability)
+	}
+}
+
+// recordReport increases the reported count of the validator and accumulates its report latency.
+// Nothing is recorded if reliability sampling is disabled.
+func (k Keeper) recordReport(ctx sdk.Context, params types.Params, val sdk.ValAddress, latency uint64) {
+	if !params.ReliabilitySamplingEnabled {
+		return
+	}
+	reliability := k.GetValidatorReliability(ctx, val)
+	reliability.ReportedCount++
+	reliability.TotalReportLatency += latency
+	k.SetValidatorReliability(ctx, val, reliability)
+}
@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func (suite *KeeperTestSuite) TestGetSetValidatorReliability() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	// Validator without any record starts with zero counts.
+	require.Equal(types.NewValidatorReliability(validators[0].Address, 0, 0, 0),
+		k.GetValidatorReliability(ctx, validators[0].Address))
+
+	reliability := types.NewValidatorReliability(validators[0].Address, 10, 8, 20)
+	k.SetValidatorReliability(ctx, validators[0].Address, reliability)
+	require.Equal(reliability, k.GetValidatorReliability(ctx, validators[0].Address))
+}
+
+func (suite *KeeperTestSuite) TestGetSamplingWeightPercentage() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.SetValidatorReliability(ctx, validators[0].Address, types.NewValidatorReliability(validators[0].Address, 10, 5, 5))
+
+	// Reliability is ignored while reliability sampling is disabled.
+	params := k.GetParams(ctx)
+	require.Equal(uint64(100), k.GetSamplingWeightPercentage(ctx, params, validators[0].Address))
+
+	params.ReliabilitySamplingEnabled = true
+	params.ReliabilityWeightPercentage = 50
+	require.Equal(uint64(75), k.GetSamplingWeightPercentage(ctx, params, validators[0].Address))
+	require.Equal(uint64(100), k.GetSamplingWeightPercentage(ctx, params, validators[1].Address))
+}
+
+func (suite *KeeperTestSuite) TestGetRandomValidatorsWithReliability() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	suite.rollingseedKeeper.
+		EXPECT().
+		GetRollingSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_1_WITH_LONG_ENOUGH_ENTROPY")).
+		AnyTimes()
+
+	// The validator with the most power never reports.
+	k.SetValidatorReliability(ctx, validators[0].Address, types.NewValidatorReliability(validators[0].Address, 10, 0, 0))
+
+	vals, err := k.GetRandomValidators(ctx, 1, 1)
+	require.NoError(err)
+	require.Equal([]sdk.ValAddress{validators[0].Address}, vals)
+
+	params := k.GetParams(ctx)
+	params.ReliabilitySamplingEnabled = true
+	params.ReliabilityWeightPercentage = 90
+	require.NoError(k.SetParams(ctx, params))
+
+	vals, err = k.GetRandomValidators(ctx, 1, 1)
+	require.NoError(err)
+	require.Equal([]sdk.ValAddress{validators[2].Address}, vals)
+}
+
+func (suite *KeeperTestSuite) TestAddReportRecordsValidatorReliability() {
+	ctx := suite.ctx.WithBlockHeight(4)
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	// The default request is made at block height 1.
+	rawReports := []types.RawReport{
+		types.NewRawReport(1, 0, []byte("data1/1")),
+		types.NewRawReport(2, 0, []byte("data2/1")),
+		types.NewRawReport(3, 0, []byte("data3/1")),
+	}
+	for id := types.RequestID(1); id <= 2; id++ {
+		k.SetRequest(ctx, id, defaultRequest())
+		k.SetFeeEscrow(ctx, types.NewFeeEscrow(id, alice.String(), nil, sdk.NewCoins()))
+	}
+
+	// Nothing is recorded while reliability sampling is disabled.
+	require.NoError(k.AddReport(ctx, 1, validators[0].Address, true, rawReports))
+	require.Equal(
+		types.NewValidatorReliability(validators[0].Address, 0, 0, 0),
+		k.GetValidatorReliability(ctx, validators[0].Address),
+	)
+
+	params := k.GetParams(ctx)
+	params.ReliabilitySamplingEnabled = true
+	require.NoError(k.SetParams(ctx, params))
+	require.NoError(k.AddReport(ctx, 2, validators[0].Address, true, rawReports))
+	require.Equal(
+		types.NewValidatorReliability(validators[0].Address, 0, 1, 3),
+		k.GetValidatorReliability(ctx, validators[0].Address),
+	)
+}
+
+func (suite *KeeperTestSuite) TestPrepareRequestDecaysValidatorReliability() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+
+	// Reliability does not affect the sampling weight, so validator #0 is chosen as in the basic case.
+	params := k.GetParams(ctx)
+	params.ReliabilitySamplingEnabled = true
+	params.ReliabilityWeightPercentage = 0
+	params.ReliabilityWindowSize = 10
+	require.NoError(k.SetParams(ctx, params))
+	k.SetValidatorReliability(ctx, validators[0].Address, types.NewValidatorReliability(validators[0].Address, 9, 6, 12))
+
+	suite.rollingseedKeeper.
+		EXPECT().
+		GetRollingSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY")).
+		Times(2)
+	suite.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), alice, types.ModuleName, coins1000000uband).
+		Times(6)
+
+	msg := types.NewMsgRequestData(
+		1, basicCalldata, 1, 1, basicClientID, coins100000000uband, testDefaultPrepareGas, testDefaultExecuteGas, alice, 0,
+	)
+
+	// The assignment fills the window, so the counts are kept as they are.
+	_, err := k.PrepareRequest(ctx, msg, alice, nil)
+	require.NoError(err)
+	require.Equal(
+		types.NewValidatorReliability(validators[0].Address, 10, 6, 12),
+		k.GetValidatorReliability(ctx, validators[0].Address),
+	)
+
+	// The next assignment exceeds the window, so all counts are halved.
+	_, err = k.PrepareRequest(ctx, msg, alice, nil)
+	require.NoError(err)
+	require.Equal(
+		types.NewValidatorReliability(validators[0].Address, 5, 3, 6),
+		k.GetValidatorReliability(ctx, validators[0].Address),
+	)
+}
@@ -0,0 +1,70 @@
+package v3
+
+import (
+	"encoding/binary"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+const (
+	ModuleName = "oracle"
+)
+
+// Migrate migrates the x/oracle module state from the consensus version 2 to
+// version 3. Specifically, it sets the default values of the parameters added in
+// version 3 and creates empty fee escrows for the requests that are pending at the
+// migration, as they paid their data source fees on request, so that their reports
+// can be settled against the escrows.
+func Migrate(
+	ctx sdk.Context,
+	store storetypes.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	var currParams types.Params
+	if bz := store.Get(types.ParamsKeyPrefix); bz != nil {
+		if err := cdc.Unmarshal(bz, &currParams); err != nil {
+			return err
+		}
+	}
+
+	currParams.ReliabilityWeightPercentage = types.DefaultReliabilityWeightPercentage
+	currParams.ReliabilityWindowSize = types.DefaultReliabilityWindowSize
+	currParams.MaxPrunedResultsPerBlock = types.DefaultMaxPrunedResultsPerBlock
+	currParams.MinRecurringRequestInterval = types.DefaultMinRecurringRequestInterval
+	currParams.RecurringRequestSpawnFee = types.DefaultRecurringRequestSpawnFee
+	if err := currParams.Validate(); err != nil {
+		return err
+	}
+	store.Set(types.ParamsKeyPrefix, cdc.MustMarshal(&currParams))
+
+	requestCount := types.RequestID(getUint64(store, types.RequestCountStoreKey))
+	for id := types.RequestID(getUint64(store, types.RequestLastExpiredStoreKey)) + 1; id <= requestCount; id++ {
+		bz := store.Get(types.RequestStoreKey(id))
+		if bz == nil || store.Has(types.ResultStoreKey(id)) || store.Has(types.FeeEscrowStoreKey(id)) {
+			continue
+		}
+
+		var request types.Request
+		if err := cdc.Unmarshal(bz, &request); err != nil {
+			return err
+		}
+		escrow := types.NewFeeEscrow(id, request.Requester, nil, sdk.NewCoins())
+		store.Set(types.FeeEscrowStoreKey(id), cdc.MustMarshal(&escrow))
+	}
+
+	return nil
+}
+
+// getUint64 returns the uint64 value stored under the given key, or 0 if not exists.
+func getUint64(store storetypes.KVStore, key []byte) uint64 {
+	bz := store.Get(key)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
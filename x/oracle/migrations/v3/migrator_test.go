@@ -0,0 +1,73 @@
+package v3_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/bandprotocol/chain/v3/x/oracle"
+	v3 "github.com/bandprotocol/chain/v3/x/oracle/migrations/v3"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(oracle.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v3.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	requester := sdk.AccAddress([]byte("requester")).String()
+	setUint64 := func(key []byte, value uint64) {
+		bz := make([]byte, 8)
+		binary.BigEndian.PutUint64(bz, value)
+		store.Set(key, bz)
+	}
+
+	// the parameters added in version 3 are zero
+	params := types.DefaultParams()
+	params.ReliabilityWeightPercentage = 0
+	params.ReliabilityWindowSize = 0
+	params.MaxPrunedResultsPerBlock = 0
+	params.MinRecurringRequestInterval = 0
+	params.RecurringRequestSpawnFee = nil
+	store.Set(types.ParamsKeyPrefix, cdc.MustMarshal(&params))
+
+	// request 1 is expired, request 2 is resolved, requests 3 and 4 are pending and request 4 already has a
+	// fee escrow
+	setUint64(types.RequestCountStoreKey, 4)
+	setUint64(types.RequestLastExpiredStoreKey, 1)
+	for id := types.RequestID(2); id <= 4; id++ {
+		store.Set(types.RequestStoreKey(id), cdc.MustMarshal(&types.Request{Requester: requester}))
+	}
+	store.Set(types.ResultStoreKey(2), cdc.MustMarshal(&types.Result{RequestID: 2}))
+	escrow := types.NewFeeEscrow(4, requester, nil, sdk.NewCoins(sdk.NewInt64Coin("uband", 10)))
+	store.Set(types.FeeEscrowStoreKey(4), cdc.MustMarshal(&escrow))
+
+	require.NoError(t, v3.Migrate(ctx, store, cdc))
+
+	var resParams types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ParamsKeyPrefix), &resParams))
+	require.Equal(t, types.DefaultParams(), resParams)
+
+	require.False(t, store.Has(types.FeeEscrowStoreKey(1)))
+	require.False(t, store.Has(types.FeeEscrowStoreKey(2)))
+
+	var res types.FeeEscrow
+	require.NoError(t, cdc.Unmarshal(store.Get(types.FeeEscrowStoreKey(3)), &res))
+	require.Equal(t, types.RequestID(3), res.RequestID)
+	require.Equal(t, requester, res.Payer)
+	require.True(t, res.Remaining.IsZero())
+
+	require.NoError(t, cdc.Unmarshal(store.Get(types.FeeEscrowStoreKey(4)), &res))
+	require.Equal(t, escrow, res)
+}
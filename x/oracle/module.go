@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
+	"github.com/bandprotocol/chain/v3/pkg/filecache"
 	"github.com/bandprotocol/chain/v3/x/oracle/client/cli"
 	"github.com/bandprotocol/chain/v3/x/oracle/exported"
 	"github.com/bandprotocol/chain/v3/x/oracle/keeper"
@@ -27,7 +28,7 @@ import (
 )
 
 // ConsensusVersion defines the current x/oracle module consensus version.
-const ConsensusVersion = 2
+const ConsensusVersion = 3
 
 var (
 	_ module.AppModuleBasic      = AppModuleBasic{}
@@ -45,6 +46,7 @@ var (
 // Module init related flags
 const (
 	FlagWithOwasmCacheSize = "oracle-script-cache-size"
+	FlagWithFileCacheSize  = "oracle-file-cache-size"
 )
 
 // AppModuleBasic is Band Oracle's module basic object.
@@ -136,6 +138,11 @@ func (am AppModule) IsAppModule() {}
 // AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
 func AddModuleInitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().Uint32(FlagWithOwasmCacheSize, 100, "Number of oracle scripts to cache")
+	startCmd.Flags().Uint64(
+		FlagWithFileCacheSize,
+		filecache.DefaultCacheSizeMax,
+		"Maximum size (in bytes) of data source and oracle script files to cache in memory",
+	)
 }
 
 // RegisterServices registers module services.
@@ -148,6 +155,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the oracle module.
@@ -147,6 +147,17 @@ func RandomizedGenState(simState *module.SimulationState) {
 			oracleRewardPercentage,
 			inactivePenaltyDuration,
 			ibcRequestEnabled,
+			types.DefaultReliabilitySamplingEnabled,
+			types.DefaultReliabilityWeightPercentage,
+			types.DefaultReliabilityWindowSize,
+			types.DefaultResultRetentionPeriod,
+			types.DefaultResultRetentionCount,
+			types.DefaultMaxPrunedResultsPerBlock,
+			types.DefaultIBCRequestRateLimitWindow,
+			types.DefaultMaxIBCRequestsPerChannel,
+			types.DefaultMaxIBCRequestsPerLightClient,
+			types.DefaultMinRecurringRequestInterval,
+			types.DefaultRecurringRequestSpawnFee,
 		),
 		[]types.DataSource{},
 		[]types.OracleScript{},
@@ -152,7 +152,7 @@ func (suite *SimTestSuite) TestSimulateMsgRequestData() {
 // TestSimulateMsgReportData tests the normal scenario of a valid message of type TypeMsgReportData
 func (suite *SimTestSuite) TestSimulateMsgReportData() {
 	// Prepare request that we will simulate to send report to
-	suite.app.OracleKeeper.AddRequest(
+	id := suite.app.OracleKeeper.AddRequest(
 		suite.ctx,
 		types.NewRequest(types.OracleScriptID(1),
 			[]byte("calldata"),
@@ -173,6 +173,10 @@ func (suite *SimTestSuite) TestSimulateMsgReportData() {
 			sdk.NewCoins(sdk.NewInt64Coin("band", 1000)),
 		),
 	)
+	suite.app.OracleKeeper.SetFeeEscrow(
+		suite.ctx,
+		types.NewFeeEscrow(id, suite.accs[0].Address.String(), nil, sdk.NewCoins()),
+	)
 
 	// Simulate MsgReportData
 	op := simulation.SimulateMsgReportData(
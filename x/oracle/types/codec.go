@@ -27,7 +27,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgCreateOracleScript{}, "oracle/CreateOracleScript")
 	legacy.RegisterAminoMsg(cdc, &MsgEditOracleScript{}, "oracle/EditOracleScript")
 	legacy.RegisterAminoMsg(cdc, &MsgActivate{}, "oracle/Activate")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateRecurringRequest{}, "oracle/CreateRecurringRequest")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateRecurringRequest{}, "oracle/UpdateRecurringRequest")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelRecurringRequest{}, "oracle/CancelRecurringRequest")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "oracle/UpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawChannelPrepaidFee{}, "oracle/WithdrawChannelPrepaidFee")
 
 	cdc.RegisterConcrete(&OracleResultSignatureOrder{}, "oracle/OracleResultSignatureOrder", nil)
 	cdc.RegisterConcrete(Params{}, "oracle/Params", nil)
@@ -43,7 +47,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgCreateOracleScript{},
 		&MsgEditOracleScript{},
 		&MsgActivate{},
+		&MsgCreateRecurringRequest{},
+		&MsgUpdateRecurringRequest{},
+		&MsgCancelRecurringRequest{},
 		&MsgUpdateParams{},
+		&MsgWithdrawChannelPrepaidFee{},
 	)
 
 	registry.RegisterImplementations(
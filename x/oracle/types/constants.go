@@ -8,12 +8,17 @@ const (
 	MaxClientIDLength    = 128
 	MaxSchemaLength      = 512
 	MaxURLLength         = 128
+	MaxCallbackLength    = 64
 
 	MaxExecutableSize       = 8 * 1024        // 8kB
 	MaxWasmCodeSize         = 512 * 1024      // 512kB
 	MaxCompiledWasmCodeSize = 1 * 1024 * 1024 // 1MB
 
 	MaximumOwasmGas = 8000000
+
+	// MaxRecurringRequestSpawnsPerBlock is the maximum number of requests spawned from recurring
+	// requests in a single block. Remaining due recurring requests are spawned in the next blocks.
+	MaxRecurringRequestSpawnsPerBlock = 100
 )
 
 var DoNotModifyBytes = []byte(DoNotModify)
@@ -53,6 +53,18 @@ var (
 	ErrInvalidRequestID         = errorsmod.Register(ModuleName, 47, "invalid request id")
 	ErrInvalidOracleEncoder     = errorsmod.Register(ModuleName, 48, "invalid oracle encoder")
 	ErrCreateSigningPanic       = errorsmod.Register(ModuleName, 49, "panic in creating tss signing")
+	ErrInvalidCallback          = errorsmod.Register(ModuleName, 50, "invalid callback")
+	ErrHookPanic                = errorsmod.Register(ModuleName, 51, "panic in oracle hooks")
+	ErrRecurringRequestNotFound = errorsmod.Register(ModuleName, 52, "recurring request not found")
+	ErrInvalidInterval          = errorsmod.Register(ModuleName, 53, "invalid interval")
+	ErrInvalidDeposit           = errorsmod.Register(ModuleName, 54, "invalid deposit")
+	ErrAccountAlreadyExist      = errorsmod.Register(ModuleName, 55, "account already exist")
+	ErrNotRecurringRequestOwner = errorsmod.Register(ModuleName, 56, "not recurring request owner")
+	ErrFeeEscrowNotFound        = errorsmod.Register(ModuleName, 57, "fee escrow not found")
+	ErrResultPruned             = errorsmod.Register(ModuleName, 58, "result has been pruned")
+	ErrIBCRequestRateLimited    = errorsmod.Register(ModuleName, 59, "ibc request rate limit exceeded")
+	ErrInvalidPrepayMemo        = errorsmod.Register(ModuleName, 60, "invalid prepay memo")
+	ErrInsufficientPrepaidFee   = errorsmod.Register(ModuleName, 61, "insufficient prepaid fee")
 )
 
 // WrapMaxError wraps an error message with additional info of the current and max values.
@@ -1,21 +1,33 @@
 package types
 
 const (
-	EventTypeCreateDataSource      = "create_data_source"
-	EventTypeEditDataSource        = "edit_data_source"
-	EventTypeCreateOracleScript    = "create_oracle_script"
-	EventTypeEditOracleScript      = "edit_oracle_script"
-	EventTypeRequest               = "request"
-	EventTypeRawRequest            = "raw_request"
-	EventTypeReport                = "report"
-	EventTypeActivate              = "activate"
-	EventTypeDeactivate            = "deactivate"
-	EventTypeAddReporter           = "add_reporter"
-	EventTypeRemoveReporter        = "remove_reporter"
-	EventTypeResolve               = "resolve"
-	EventTypeSendPacketFail        = "send_packet_fail"
-	EventTypeUpdateParams          = "update_params"
-	EventTypeHandleRequestSignFail = "handle_request_sign_fail"
+	EventTypeCreateDataSource           = "create_data_source"
+	EventTypeEditDataSource             = "edit_data_source"
+	EventTypeCreateOracleScript         = "create_oracle_script"
+	EventTypeEditOracleScript           = "edit_oracle_script"
+	EventTypeRequest                    = "request"
+	EventTypeRawRequest                 = "raw_request"
+	EventTypeReport                     = "report"
+	EventTypeActivate                   = "activate"
+	EventTypeDeactivate                 = "deactivate"
+	EventTypeAddReporter                = "add_reporter"
+	EventTypeRemoveReporter             = "remove_reporter"
+	EventTypeResolve                    = "resolve"
+	EventTypeSendPacketFail             = "send_packet_fail"
+	EventTypeUpdateParams               = "update_params"
+	EventTypeHandleRequestSignFail      = "handle_request_sign_fail"
+	EventTypeRequestCallbackFail        = "request_callback_fail"
+	EventTypeCreateRecurringRequest     = "create_recurring_request"
+	EventTypeUpdateRecurringRequest     = "update_recurring_request"
+	EventTypeCancelRecurringRequest     = "cancel_recurring_request"
+	EventTypeSpawnRecurringRequest      = "spawn_recurring_request"
+	EventTypeSpawnRecurringRequestFail  = "spawn_recurring_request_fail"
+	EventTypeDeactivateRecurringRequest = "deactivate_recurring_request"
+	EventTypePayDataSourceFee           = "pay_data_source_fee"
+	EventTypeRefundDataSourceFee        = "refund_data_source_fee"
+	EventTypePruneResults               = "prune_results"
+	EventTypePrepayChannelFee           = "prepay_channel_fee"
+	EventTypeWithdrawChannelPrepaidFee  = "withdraw_channel_prepaid_fee"
 
 	AttributeKeyID                  = "id"
 	AttributeKeySigningID           = "signing_id"
@@ -38,4 +50,22 @@ const (
 	AttributeKeyParams              = "params"
 	AttributeKeySigningErrCodespace = "signing_error_codespace"
 	AttributeKeySigningErrCode      = "signing_error_code"
+	AttributeKeyCallback            = "callback"
+	AttributeKeyRecurringRequestID  = "recurring_request_id"
+	AttributeKeyRequestID           = "request_id"
+	AttributeKeyInterval            = "interval"
+	AttributeKeyNextHeight          = "next_height"
+	AttributeKeyFeePayer            = "fee_payer"
+	AttributeKeyOwner               = "owner"
+	AttributeKeyDeposit             = "deposit"
+	AttributeKeyFeeLimit            = "fee_limit"
+	AttributeKeyTreasury            = "treasury"
+	AttributeKeyPayer               = "payer"
+	AttributeKeyFromID              = "from_id"
+	AttributeKeyToID                = "to_id"
+	AttributeKeyChannelID           = "channel_id"
+	AttributeKeySender              = "sender"
+	AttributeKeyAmount              = "amount"
+	AttributeKeyDenom               = "denom"
+	AttributeKeyReceiver            = "receiver"
 )
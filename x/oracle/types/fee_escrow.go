@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewRawRequestFee(
+	externalID ExternalID,
+	dataSourceID DataSourceID,
+	treasury string,
+	fee sdk.Coins,
+) RawRequestFee {
+	return RawRequestFee{
+		ExternalID:   externalID,
+		DataSourceID: dataSourceID,
+		Treasury:     treasury,
+		Fee:          fee,
+	}
+}
+
+func NewFeeEscrow(
+	requestID RequestID,
+	payer string,
+	rawRequestFees []RawRequestFee,
+	remaining sdk.Coins,
+) FeeEscrow {
+	return FeeEscrow{
+		RequestID:      requestID,
+		Payer:          payer,
+		RawRequestFees: rawRequestFees,
+		Remaining:      remaining,
+	}
+}
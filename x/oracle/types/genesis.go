@@ -2,8 +2,10 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState instanc e
@@ -21,6 +23,8 @@ func DefaultGenesisState() *GenesisState {
 		Params:        DefaultParams(),
 		DataSources:   []DataSource{},
 		OracleScripts: []OracleScript{},
+
+		RecurringRequests: []RecurringRequest{},
 	}
 }
 
@@ -37,4 +41,28 @@ func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.R
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
-func (g GenesisState) Validate() error { return nil }
+func (g GenesisState) Validate() error {
+	seen := make(map[RecurringRequestID]bool)
+	for _, recurringRequest := range g.RecurringRequests {
+		if recurringRequest.ID == 0 || uint64(recurringRequest.ID) > g.RecurringRequestCount {
+			return fmt.Errorf(
+				"invalid recurring request id %d with count %d",
+				recurringRequest.ID,
+				g.RecurringRequestCount,
+			)
+		}
+		if seen[recurringRequest.ID] {
+			return fmt.Errorf("duplicate recurring request id %d", recurringRequest.ID)
+		}
+		seen[recurringRequest.ID] = true
+
+		if _, err := sdk.AccAddressFromBech32(recurringRequest.FeePayer); err != nil {
+			return fmt.Errorf("invalid fee payer of recurring request %d: %w", recurringRequest.ID, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(recurringRequest.Owner); err != nil {
+			return fmt.Errorf("invalid owner of recurring request %d: %w", recurringRequest.ID, err)
+		}
+	}
+
+	return nil
+}
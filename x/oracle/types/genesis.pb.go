@@ -31,6 +31,11 @@ type GenesisState struct {
 	DataSources []DataSource `protobuf:"bytes,2,rep,name=data_sources,json=dataSources,proto3" json:"data_sources"`
 	// OracleScripts are list of oracle scripts to be installed during genesis phase.
 	OracleScripts []OracleScript `protobuf:"bytes,3,rep,name=oracle_scripts,json=oracleScripts,proto3" json:"oracle_scripts"`
+	// RecurringRequests are the recurring requests whose deposits are held by
+	// their fee payer accounts.
+	RecurringRequests []RecurringRequest `protobuf:"bytes,4,rep,name=recurring_requests,json=recurringRequests,proto3" json:"recurring_requests"`
+	// RecurringRequestCount is the number of recurring requests ever created.
+	RecurringRequestCount uint64 `protobuf:"varint,5,opt,name=recurring_request_count,json=recurringRequestCount,proto3" json:"recurring_request_count,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +92,20 @@ func (m *GenesisState) GetOracleScripts() []OracleScript {
 	return nil
 }
 
+func (m *GenesisState) GetRecurringRequests() []RecurringRequest {
+	if m != nil {
+		return m.RecurringRequests
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecurringRequestCount() uint64 {
+	if m != nil {
+		return m.RecurringRequestCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "band.oracle.v1.GenesisState")
 }
@@ -94,25 +113,28 @@ func init() {
 func init() { proto.RegisterFile("band/oracle/v1/genesis.proto", fileDescriptor_b23429f682cd4ce7) }
 
 var fileDescriptor_b23429f682cd4ce7 = []byte{
-	// 277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x31, 0x4b, 0xc3, 0x40,
-	0x14, 0x80, 0x73, 0x56, 0x3a, 0xa4, 0xb5, 0x43, 0x10, 0x09, 0xb1, 0x9c, 0xc5, 0xa9, 0xd3, 0x1d,
-	0x6d, 0xfd, 0x05, 0x55, 0x90, 0x4e, 0x8a, 0xd9, 0x5c, 0xca, 0xcb, 0xe5, 0x48, 0x03, 0x6d, 0x2e,
-	0xdc, 0xbb, 0x06, 0xfd, 0x17, 0xfe, 0xac, 0x8e, 0x1d, 0x1c, 0x9c, 0x44, 0x92, 0x3f, 0x22, 0xb9,
-	0x44, 0xd1, 0x6c, 0xc7, 0xfb, 0xbe, 0xf7, 0x1d, 0x3c, 0x77, 0x1c, 0x41, 0x16, 0x73, 0xa5, 0x41,
-	0x6c, 0x25, 0x2f, 0x66, 0x3c, 0x91, 0x99, 0xc4, 0x14, 0x59, 0xae, 0x95, 0x51, 0xde, 0xa8, 0xa6,
-	0xac, 0xa1, 0xac, 0x98, 0x05, 0xe7, 0x89, 0x4a, 0x94, 0x45, 0xbc, 0x7e, 0x35, 0x56, 0x70, 0xd9,
-	0x69, 0xb4, 0xbe, 0x85, 0xd7, 0xef, 0xc4, 0x1d, 0xde, 0x37, 0xd1, 0xd0, 0x80, 0x91, 0xde, 0x8d,
-	0xdb, 0xcf, 0x41, 0xc3, 0x0e, 0x7d, 0x32, 0x21, 0xd3, 0xc1, 0xfc, 0x82, 0xfd, 0xff, 0x84, 0x3d,
-	0x5a, 0xba, 0x3c, 0x3d, 0x7c, 0x5e, 0x39, 0x4f, 0xad, 0xeb, 0xdd, 0xba, 0xc3, 0x18, 0x0c, 0xac,
-	0x51, 0xed, 0xb5, 0x90, 0xe8, 0x9f, 0x4c, 0x7a, 0xd3, 0xc1, 0x3c, 0xe8, 0xee, 0xde, 0x81, 0x81,
-	0xd0, 0x2a, 0xed, 0xfe, 0x20, 0xfe, 0x9d, 0xa0, 0xb7, 0x72, 0x47, 0x8d, 0xba, 0x46, 0xa1, 0xd3,
-	0xdc, 0xa0, 0xdf, 0xb3, 0x99, 0x71, 0x37, 0xf3, 0x60, 0x5f, 0xa1, 0x95, 0xda, 0xd0, 0x99, 0xfa,
-	0x33, 0xc3, 0xe5, 0xea, 0x50, 0x52, 0x72, 0x2c, 0x29, 0xf9, 0x2a, 0x29, 0x79, 0xab, 0xa8, 0x73,
-	0xac, 0xa8, 0xf3, 0x51, 0x51, 0xe7, 0x99, 0x27, 0xa9, 0xd9, 0xec, 0x23, 0x26, 0xd4, 0x8e, 0xd7,
-	0x59, 0x7b, 0x06, 0xa1, 0xb6, 0x5c, 0x6c, 0x20, 0xcd, 0x78, 0xb1, 0xe0, 0x2f, 0x3f, 0xb7, 0x32,
-	0xaf, 0xb9, 0xc4, 0xa8, 0x6f, 0x8d, 0xc5, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x92, 0xdd,
-	0xef, 0x8b, 0x01, 0x00, 0x00,
+	// 332 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x4f, 0x4f, 0xc2, 0x30,
+	0x18, 0xc6, 0x37, 0x40, 0x0e, 0x05, 0x49, 0x6c, 0xfc, 0xb3, 0x20, 0x99, 0x8b, 0x27, 0x4e, 0x6b,
+	0x00, 0xe3, 0x07, 0x00, 0x13, 0xc3, 0x49, 0x33, 0xe2, 0xc5, 0xcb, 0x52, 0x4a, 0x33, 0x96, 0xc0,
+	0x3a, 0xfb, 0x76, 0x44, 0xbf, 0x85, 0x1f, 0x8b, 0x23, 0x47, 0x4f, 0xc6, 0xb0, 0x2f, 0x62, 0xd6,
+	0x0d, 0xa3, 0xf5, 0xf6, 0xe6, 0x7d, 0x7e, 0xcf, 0xaf, 0x4d, 0x5e, 0xd4, 0x9b, 0xd3, 0x64, 0x41,
+	0x84, 0xa4, 0x6c, 0xc5, 0xc9, 0x66, 0x40, 0x22, 0x9e, 0x70, 0x88, 0xc1, 0x4f, 0xa5, 0x50, 0x02,
+	0x77, 0x8a, 0xd4, 0x2f, 0x53, 0x7f, 0x33, 0xe8, 0x9e, 0x46, 0x22, 0x12, 0x3a, 0x22, 0xc5, 0x54,
+	0x52, 0xdd, 0x4b, 0xc3, 0x51, 0xf1, 0x3a, 0xbc, 0xce, 0x6b, 0xa8, 0x7d, 0x5f, 0x4a, 0x67, 0x8a,
+	0x2a, 0x8e, 0x6f, 0x50, 0x33, 0xa5, 0x92, 0xae, 0xc1, 0xb1, 0x3d, 0xbb, 0xdf, 0x1a, 0x9e, 0xfb,
+	0x7f, 0x1f, 0xf1, 0x1f, 0x75, 0x3a, 0x6e, 0x6c, 0x3f, 0xaf, 0xac, 0xa0, 0x62, 0xf1, 0x04, 0xb5,
+	0x17, 0x54, 0xd1, 0x10, 0x44, 0x26, 0x19, 0x07, 0xa7, 0xe6, 0xd5, 0xfb, 0xad, 0x61, 0xd7, 0xec,
+	0xde, 0x51, 0x45, 0x67, 0x1a, 0xa9, 0xfa, 0xad, 0xc5, 0xcf, 0x06, 0xf0, 0x14, 0x75, 0x4a, 0x34,
+	0x04, 0x26, 0xe3, 0x54, 0x81, 0x53, 0xd7, 0x9a, 0x9e, 0xa9, 0x79, 0xd0, 0xd3, 0x4c, 0x43, 0x95,
+	0xe8, 0x58, 0xfc, 0xda, 0x01, 0x7e, 0x42, 0x58, 0x72, 0x96, 0x49, 0x19, 0x27, 0x51, 0x28, 0xf9,
+	0x4b, 0xc6, 0x41, 0x81, 0xd3, 0xd0, 0x3a, 0xcf, 0xd4, 0x05, 0x07, 0x32, 0x28, 0xc1, 0x4a, 0x79,
+	0x22, 0x8d, 0x3d, 0xe0, 0x5b, 0x74, 0xf1, 0x4f, 0x1b, 0x32, 0x91, 0x25, 0xca, 0x39, 0xf2, 0xec,
+	0x7e, 0x23, 0x38, 0x33, 0x3b, 0x93, 0x22, 0x1c, 0x4f, 0xb7, 0x7b, 0xd7, 0xde, 0xed, 0x5d, 0xfb,
+	0x6b, 0xef, 0xda, 0xef, 0xb9, 0x6b, 0xed, 0x72, 0xd7, 0xfa, 0xc8, 0x5d, 0xeb, 0x99, 0x44, 0xb1,
+	0x5a, 0x66, 0x73, 0x9f, 0x89, 0x35, 0x29, 0xbe, 0xa5, 0xaf, 0xc2, 0xc4, 0x8a, 0xb0, 0x25, 0x8d,
+	0x13, 0xb2, 0x19, 0x91, 0xd7, 0xc3, 0xe9, 0xd4, 0x5b, 0xca, 0x61, 0xde, 0xd4, 0xc4, 0xe8, 0x3b,
+	0x00, 0x00, 0xff, 0xff, 0xe5, 0x78, 0x54, 0x3a, 0x1a, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +157,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RecurringRequestCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RecurringRequestCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RecurringRequests) > 0 {
+		for iNdEx := len(m.RecurringRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecurringRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.OracleScripts) > 0 {
 		for iNdEx := len(m.OracleScripts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -207,6 +248,15 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RecurringRequests) > 0 {
+		for _, e := range m.RecurringRequests {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RecurringRequestCount != 0 {
+		n += 1 + sovGenesis(uint64(m.RecurringRequestCount))
+	}
 	return n
 }
 
@@ -346,6 +396,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecurringRequests = append(m.RecurringRequests, RecurringRequest{})
+			if err := m.RecurringRequests[len(m.RecurringRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringRequestCount", wireType)
+			}
+			m.RecurringRequestCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecurringRequestCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OracleHooks defines the interface for modules that want to be notified when oracle requests
+// are resolved.
+type OracleHooks interface {
+	// AfterRequestResolved is called after the result of a request has been saved to the store,
+	// regardless of its resolve status. Hooks can use the Callback field of the request to tell
+	// whether the request was made on their behalf.
+	AfterRequestResolved(ctx sdk.Context, request Request, result Result) error
+}
+
+var _ OracleHooks = MultiOracleHooks{}
+
+// MultiOracleHooks combines multiple oracle hooks, all hook functions are run in array sequence.
+type MultiOracleHooks []OracleHooks
+
+// NewMultiOracleHooks creates a new MultiOracleHooks instance.
+func NewMultiOracleHooks(hooks ...OracleHooks) MultiOracleHooks {
+	return hooks
+}
+
+// AfterRequestResolved calls AfterRequestResolved of every hook and returns the first error.
+func (h MultiOracleHooks) AfterRequestResolved(ctx sdk.Context, request Request, result Result) error {
+	for i := range h {
+		if err := h[i].AfterRequestResolved(ctx, request, result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -9,5 +9,8 @@ type OracleScriptID uint64
 // RequestID is the type-safe unique identifier type for data requests.
 type RequestID uint64
 
+// RecurringRequestID is the type-safe unique identifier type for recurring requests.
+type RecurringRequestID uint64
+
 // ExternalID is the type-safe unique identifier type for raw data requests.
 type ExternalID uint64
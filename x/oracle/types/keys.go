@@ -11,6 +11,10 @@ const (
 	// Version defines the current version the IBC oracle module supports
 	Version = "bandchain-1"
 
+	// Version2 defines the version of the IBC oracle module whose request acknowledgements carry the
+	// expiration height of the request
+	Version2 = "bandchain-2"
+
 	// StoreKey to be used when creating the KVStore.
 	StoreKey = ModuleName
 
@@ -22,6 +26,12 @@ const (
 
 	// PortID is the default port id that oracle module binds to.
 	PortID = ModuleName
+
+	// RecurringRequestAccountsKey is used to derive the fee payer accounts of recurring requests.
+	RecurringRequestAccountsKey = "recurring-request-accounts"
+
+	// ChannelPrepaidAccountsKey is used to derive the prepaid fee accounts of IBC channels.
+	ChannelPrepaidAccountsKey = "channel-prepaid-accounts"
 )
 
 var (
@@ -33,12 +43,16 @@ var (
 	RequestCountStoreKey = append(GlobalStoreKeyPrefix, []byte("RequestCount")...)
 	// RequestLastExpiredStoreKey is the key that keeps the ID of the last expired request, or 0 if none.
 	RequestLastExpiredStoreKey = append(GlobalStoreKeyPrefix, []byte("RequestLastExpired")...)
+	// ResultLastPrunedStoreKey is the key that keeps the ID of the last request whose result is pruned, or 0 if none.
+	ResultLastPrunedStoreKey = append(GlobalStoreKeyPrefix, []byte("ResultLastPruned")...)
 	// PendingResolveListStoreKey is the key that keeps the list of pending-resolve requests.
 	PendingResolveListStoreKey = append(GlobalStoreKeyPrefix, []byte("PendingList")...)
 	// DataSourceCountStoreKey is the key that keeps the total data source count.
 	DataSourceCountStoreKey = append(GlobalStoreKeyPrefix, []byte("DataSourceCount")...)
 	// OracleScriptCountStoreKey is the key that keeps the total oracle script count.
 	OracleScriptCountStoreKey = append(GlobalStoreKeyPrefix, []byte("OracleScriptCount")...)
+	// RecurringRequestCountStoreKey is the key that keeps the total recurring request count.
+	RecurringRequestCountStoreKey = append(GlobalStoreKeyPrefix, []byte("RecurringRequestCount")...)
 
 	// RequestStoreKeyPrefix is the prefix for request store.
 	RequestStoreKeyPrefix = []byte{0x01}
@@ -54,6 +68,22 @@ var (
 	ParamsKeyPrefix = []byte{0x06}
 	// SigningResultStoreKeyPrefix is the prefix for signing ID store.
 	SigningResultStoreKeyPrefix = []byte{0x07}
+	// RecurringRequestStoreKeyPrefix is the prefix for recurring request store.
+	RecurringRequestStoreKeyPrefix = []byte{0x08}
+	// RecurringRequestQueueStoreKeyPrefix is the prefix for the queue of recurring requests ordered by next height.
+	RecurringRequestQueueStoreKeyPrefix = []byte{0x09}
+	// RecurringRequestSpawnStoreKeyPrefix is the prefix for the request IDs spawned by recurring requests.
+	RecurringRequestSpawnStoreKeyPrefix = []byte{0x0a}
+	// FeeEscrowStoreKeyPrefix is the prefix for data source fee escrow store.
+	FeeEscrowStoreKeyPrefix = []byte{0x0b}
+	// ValidatorReliabilityKeyPrefix is the prefix for validator oracle reliability store.
+	ValidatorReliabilityKeyPrefix = []byte{0x0c}
+	// IBCChannelRequestCounterKeyPrefix is the prefix for the IBC request counters of channels.
+	IBCChannelRequestCounterKeyPrefix = []byte{0x0d}
+	// IBCLightClientRequestCounterKeyPrefix is the prefix for the IBC request counters of light clients.
+	IBCLightClientRequestCounterKeyPrefix = []byte{0x0e}
+	// SpawnedRequestStoreKeyPrefix is the prefix for the recurring request IDs of the spawned requests.
+	SpawnedRequestStoreKeyPrefix = []byte{0x0f}
 	// ResultStoreKeyPrefix is the prefix for request result store.
 	ResultStoreKeyPrefix = []byte{0xff}
 
@@ -102,3 +132,54 @@ func ReportsOfValidatorPrefixKey(reqID RequestID, val sdk.ValAddress) []byte {
 	buf = append(buf, val.Bytes()...)
 	return buf
 }
+
+// RecurringRequestStoreKey returns the key to retrieve a specific recurring request from the store.
+func RecurringRequestStoreKey(id RecurringRequestID) []byte {
+	return append(RecurringRequestStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(id))...)
+}
+
+// RecurringRequestQueueByHeightPrefixKey returns the prefix key to get all recurring requests
+// scheduled at the given height.
+func RecurringRequestQueueByHeightPrefixKey(height int64) []byte {
+	return append(RecurringRequestQueueStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// RecurringRequestQueueStoreKey returns the key of a recurring request in the schedule queue.
+func RecurringRequestQueueStoreKey(height int64, id RecurringRequestID) []byte {
+	return append(RecurringRequestQueueByHeightPrefixKey(height), sdk.Uint64ToBigEndian(uint64(id))...)
+}
+
+// RecurringRequestSpawnsPrefixKey returns the prefix key to get all request IDs spawned by a recurring request.
+func RecurringRequestSpawnsPrefixKey(id RecurringRequestID) []byte {
+	return append(RecurringRequestSpawnStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(id))...)
+}
+
+// RecurringRequestSpawnStoreKey returns the key of a request spawned by a recurring request.
+func RecurringRequestSpawnStoreKey(id RecurringRequestID, reqID RequestID) []byte {
+	return append(RecurringRequestSpawnsPrefixKey(id), sdk.Uint64ToBigEndian(uint64(reqID))...)
+}
+
+// SpawnedRequestStoreKey returns the key to retrieve the recurring request ID of a spawned request.
+func SpawnedRequestStoreKey(reqID RequestID) []byte {
+	return append(SpawnedRequestStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(reqID))...)
+}
+
+// FeeEscrowStoreKey returns the key to retrieve the data source fee escrow of a request.
+func FeeEscrowStoreKey(reqID RequestID) []byte {
+	return append(FeeEscrowStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(reqID))...)
+}
+
+// ValidatorReliabilityStoreKey returns the key to a validator's oracle reliability.
+func ValidatorReliabilityStoreKey(v sdk.ValAddress) []byte {
+	return append(ValidatorReliabilityKeyPrefix, v.Bytes()...)
+}
+
+// IBCChannelRequestCounterStoreKey returns the key to the IBC request counter of a channel.
+func IBCChannelRequestCounterStoreKey(channelID string) []byte {
+	return append(IBCChannelRequestCounterKeyPrefix, []byte(channelID)...)
+}
+
+// IBCLightClientRequestCounterStoreKey returns the key to the IBC request counter of a light client.
+func IBCLightClientRequestCounterStoreKey(lightClientID string) []byte {
+	return append(IBCLightClientRequestCounterKeyPrefix, []byte(lightClientID)...)
+}
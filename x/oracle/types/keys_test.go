@@ -50,3 +50,23 @@ func TestReportsOfValidatorPrefixKey(t *testing.T) {
 	expect, _ := hex.DecodeString("020000000000000014b80f2a5df7d5710b15622d1a9f1e3830ded5bda8")
 	require.Equal(t, expect, ReportsOfValidatorPrefixKey(20, val))
 }
+
+func TestRecurringRequestStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("080000000000000005")
+	require.Equal(t, expect, RecurringRequestStoreKey(5))
+}
+
+func TestRecurringRequestQueueStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("0900000000000000640000000000000005")
+	require.Equal(t, expect, RecurringRequestQueueStoreKey(100, 5))
+}
+
+func TestRecurringRequestSpawnStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("0a00000000000000050000000000000014")
+	require.Equal(t, expect, RecurringRequestSpawnStoreKey(5, 20))
+}
+
+func TestSpawnedRequestStoreKey(t *testing.T) {
+	expect, _ := hex.DecodeString("0f0000000000000014")
+	require.Equal(t, expect, SpawnedRequestStoreKey(20))
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"bytes"
 
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,7 +19,11 @@ var (
 	_ sdk.Msg = (*MsgCreateOracleScript)(nil)
 	_ sdk.Msg = (*MsgEditOracleScript)(nil)
 	_ sdk.Msg = (*MsgActivate)(nil)
+	_ sdk.Msg = (*MsgCreateRecurringRequest)(nil)
+	_ sdk.Msg = (*MsgUpdateRecurringRequest)(nil)
+	_ sdk.Msg = (*MsgCancelRecurringRequest)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgWithdrawChannelPrepaidFee)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgRequestData)(nil)
 	_ sdk.HasValidateBasic = (*MsgReportData)(nil)
@@ -26,7 +32,11 @@ var (
 	_ sdk.HasValidateBasic = (*MsgCreateOracleScript)(nil)
 	_ sdk.HasValidateBasic = (*MsgEditOracleScript)(nil)
 	_ sdk.HasValidateBasic = (*MsgActivate)(nil)
+	_ sdk.HasValidateBasic = (*MsgCreateRecurringRequest)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateRecurringRequest)(nil)
+	_ sdk.HasValidateBasic = (*MsgCancelRecurringRequest)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgWithdrawChannelPrepaidFee)(nil)
 )
 
 // NewMsgRequestData creates a new MsgRequestData instance.
@@ -63,34 +73,42 @@ func (m MsgRequestData) ValidateBasic() error {
 	if err := sdk.VerifyAddressFormat(sender); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("sender: %s", m.Sender)
 	}
-	if m.MinCount <= 0 {
-		return ErrInvalidMinCount.Wrapf("got: %d", m.MinCount)
+	return validateRequestSpec(&m)
+}
+
+// validateRequestSpec checks the request parameters shared by every request-making message.
+func validateRequestSpec(r RequestSpec) error {
+	if r.GetMinCount() <= 0 {
+		return ErrInvalidMinCount.Wrapf("got: %d", r.GetMinCount())
 	}
-	if m.AskCount < m.MinCount {
-		return ErrInvalidAskCount.Wrapf("got: %d, min count: %d", m.AskCount, m.MinCount)
+	if r.GetAskCount() < r.GetMinCount() {
+		return ErrInvalidAskCount.Wrapf("got: %d, min count: %d", r.GetAskCount(), r.GetMinCount())
 	}
-	if len(m.ClientID) > MaxClientIDLength {
-		return WrapMaxError(ErrTooLongClientID, len(m.ClientID), MaxClientIDLength)
+	if len(r.GetClientID()) > MaxClientIDLength {
+		return WrapMaxError(ErrTooLongClientID, len(r.GetClientID()), MaxClientIDLength)
 	}
-	if m.PrepareGas <= 0 {
-		return ErrInvalidOwasmGas.Wrapf("invalid prepare gas: %d", m.PrepareGas)
+	if r.GetPrepareGas() <= 0 {
+		return ErrInvalidOwasmGas.Wrapf("invalid prepare gas: %d", r.GetPrepareGas())
 	}
-	if m.ExecuteGas <= 0 {
-		return ErrInvalidOwasmGas.Wrapf("invalid execute gas: %d", m.ExecuteGas)
+	if r.GetExecuteGas() <= 0 {
+		return ErrInvalidOwasmGas.Wrapf("invalid execute gas: %d", r.GetExecuteGas())
 	}
-	if m.PrepareGas+m.ExecuteGas > MaximumOwasmGas {
+	if r.GetPrepareGas()+r.GetExecuteGas() > MaximumOwasmGas {
 		return ErrInvalidOwasmGas.Wrapf(
 			"sum of prepare gas and execute gas (%d) exceed %d",
-			m.PrepareGas+m.ExecuteGas,
+			r.GetPrepareGas()+r.GetExecuteGas(),
 			MaximumOwasmGas,
 		)
 	}
-	if !m.FeeLimit.IsValid() {
-		return sdkerrors.ErrInvalidCoins.Wrap(m.FeeLimit.String())
+	if !r.GetFeeLimit().IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrap(r.GetFeeLimit().String())
 	}
 
-	if _, ok := Encoder_name[int32(m.TSSEncoder)]; !ok {
-		return ErrInvalidOracleEncoder.Wrapf("invalid encoder type: %d", m.TSSEncoder)
+	if _, ok := Encoder_name[int32(r.GetTSSEncoder())]; !ok {
+		return ErrInvalidOracleEncoder.Wrapf("invalid encoder type: %d", r.GetTSSEncoder())
+	}
+	if err := ValidateCallback(r.GetCallback()); err != nil {
+		return err
 	}
 
 	return nil
@@ -378,6 +396,113 @@ func (m MsgActivate) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgCreateRecurringRequest creates a new MsgCreateRecurringRequest instance.
+func NewMsgCreateRecurringRequest(
+	oracleScriptID OracleScriptID,
+	calldata []byte,
+	askCount, minCount uint64,
+	clientID string,
+	feeLimit sdk.Coins,
+	prepareGas, executeGas uint64,
+	tssEncoder Encoder,
+	callback string,
+	interval uint64,
+	initialDeposit sdk.Coins,
+	owner sdk.AccAddress,
+) *MsgCreateRecurringRequest {
+	return &MsgCreateRecurringRequest{
+		OracleScriptID: oracleScriptID,
+		Calldata:       calldata,
+		AskCount:       askCount,
+		MinCount:       minCount,
+		ClientID:       clientID,
+		FeeLimit:       feeLimit,
+		PrepareGas:     prepareGas,
+		ExecuteGas:     executeGas,
+		TSSEncoder:     tssEncoder,
+		Callback:       callback,
+		Interval:       interval,
+		InitialDeposit: initialDeposit,
+		Owner:          owner.String(),
+	}
+}
+
+// ValidateBasic checks whether the given MsgCreateRecurringRequest instance (sdk.Msg interface).
+func (m MsgCreateRecurringRequest) ValidateBasic() error {
+	owner, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("owner: %s", m.Owner)
+	}
+	if m.Interval == 0 {
+		return ErrInvalidInterval.Wrap("interval must be positive")
+	}
+	if !m.InitialDeposit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrap(m.InitialDeposit.String())
+	}
+	return validateRequestSpec(&m)
+}
+
+// NewMsgUpdateRecurringRequest creates a new MsgUpdateRecurringRequest instance.
+func NewMsgUpdateRecurringRequest(
+	id RecurringRequestID,
+	interval uint64,
+	feeLimit sdk.Coins,
+	deposit sdk.Coins,
+	owner sdk.AccAddress,
+) *MsgUpdateRecurringRequest {
+	return &MsgUpdateRecurringRequest{
+		RecurringRequestID: id,
+		Interval:           interval,
+		FeeLimit:           feeLimit,
+		Deposit:            deposit,
+		Owner:              owner.String(),
+	}
+}
+
+// ValidateBasic checks whether the given MsgUpdateRecurringRequest instance (sdk.Msg interface).
+func (m MsgUpdateRecurringRequest) ValidateBasic() error {
+	owner, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("owner: %s", m.Owner)
+	}
+	if m.Interval == 0 {
+		return ErrInvalidInterval.Wrap("interval must be positive")
+	}
+	if !m.FeeLimit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrap(m.FeeLimit.String())
+	}
+	if !m.Deposit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrap(m.Deposit.String())
+	}
+	return nil
+}
+
+// NewMsgCancelRecurringRequest creates a new MsgCancelRecurringRequest instance.
+func NewMsgCancelRecurringRequest(id RecurringRequestID, owner sdk.AccAddress) *MsgCancelRecurringRequest {
+	return &MsgCancelRecurringRequest{
+		RecurringRequestID: id,
+		Owner:              owner.String(),
+	}
+}
+
+// ValidateBasic checks whether the given MsgCancelRecurringRequest instance (sdk.Msg interface).
+func (m MsgCancelRecurringRequest) ValidateBasic() error {
+	owner, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("owner: %s", m.Owner)
+	}
+	return nil
+}
+
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
 func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
 	return &MsgUpdateParams{
@@ -398,3 +523,39 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgWithdrawChannelPrepaidFee creates a new MsgWithdrawChannelPrepaidFee instance.
+func NewMsgWithdrawChannelPrepaidFee(
+	authority string,
+	channelID string,
+	receiver sdk.AccAddress,
+	amount sdk.Coins,
+) *MsgWithdrawChannelPrepaidFee {
+	return &MsgWithdrawChannelPrepaidFee{
+		Authority: authority,
+		ChannelId: channelID,
+		Receiver:  receiver.String(),
+		Amount:    amount,
+	}
+}
+
+// ValidateBasic checks whether the given MsgWithdrawChannelPrepaidFee instance (sdk.Msg interface).
+func (m *MsgWithdrawChannelPrepaidFee) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if err := host.ChannelIdentifierValidator(m.ChannelId); err != nil {
+		return err
+	}
+	receiver, err := sdk.AccAddressFromBech32(m.Receiver)
+	if err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(receiver); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("receiver: %s", m.Receiver)
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return sdkerrors.ErrInvalidCoins.Wrap(m.Amount.String())
+	}
+	return nil
+}
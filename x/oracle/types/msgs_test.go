@@ -371,9 +371,17 @@ func TestMsgRequestDataValidation(t *testing.T) {
 		{false, NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", BadCoins, 1, 1, GoodTestAddr, 0)},
 		{false, NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 0, 1, GoodTestAddr, 0)},
 		{false, NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 0, GoodTestAddr, 0)},
+		{true, withCallback(NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, GoodTestAddr, 0), "tunnel")},
+		{false, withCallback(NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, GoodTestAddr, 0), "tun-nel")},
+		{false, withCallback(NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, GoodTestAddr, 0), strings.Repeat("x", 65))},
 	})
 }
 
+func withCallback(msg *MsgRequestData, callback string) *MsgRequestData {
+	msg.Callback = callback
+	return msg
+}
+
 func TestMsgReportDataValidation(t *testing.T) {
 	performValidateTests(t, []validateTestCase{
 		{true, NewMsgReportData(1, []RawReport{{1, 1, []byte("data1")}, {2, 2, []byte("data2")}}, GoodTestValAddr)},
@@ -389,3 +397,47 @@ func TestMsgActivateValidation(t *testing.T) {
 		{false, NewMsgActivate(EmptyValAddr)},
 	})
 }
+
+func TestMsgCreateRecurringRequestValidation(t *testing.T) {
+	performValidateTests(t, []validateTestCase{
+		{true, NewMsgCreateRecurringRequest(1, []byte("calldata"), 10, 5, "client-id", FeeCoins, 1, 1, 0, "", 10, FeeCoins, GoodTestAddr)},
+		{true, NewMsgCreateRecurringRequest(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, 0, "tunnel", 10, GoodCoins, GoodTestAddr)},
+		{false, NewMsgCreateRecurringRequest(1, []byte("calldata"), 10, 5, "client-id", FeeCoins, 1, 1, 0, "", 0, FeeCoins, GoodTestAddr)},
+		{false, NewMsgCreateRecurringRequest(1, []byte("calldata"), 10, 5, "client-id", FeeCoins, 1, 1, 0, "", 10, BadCoins, GoodTestAddr)},
+		{false, NewMsgCreateRecurringRequest(1, []byte("calldata"), 10, 5, "client-id", BadCoins, 1, 1, 0, "", 10, FeeCoins, GoodTestAddr)},
+		{false, NewMsgCreateRecurringRequest(1, []byte("calldata"), 2, 5, "client-id", FeeCoins, 1, 1, 0, "", 10, FeeCoins, GoodTestAddr)},
+		{false, NewMsgCreateRecurringRequest(1, []byte("calldata"), 10, 5, "client-id", FeeCoins, 0, 1, 0, "", 10, FeeCoins, GoodTestAddr)},
+		{false, NewMsgCreateRecurringRequest(1, []byte("calldata"), 10, 5, "client-id", FeeCoins, 1, 1, 0, "tun-nel", 10, FeeCoins, GoodTestAddr)},
+		{false, NewMsgCreateRecurringRequest(1, []byte("calldata"), 10, 5, "client-id", FeeCoins, 1, 1, 0, "", 10, FeeCoins, EmptyAddr)},
+	})
+}
+
+func TestMsgUpdateRecurringRequestValidation(t *testing.T) {
+	performValidateTests(t, []validateTestCase{
+		{true, NewMsgUpdateRecurringRequest(1, 10, FeeCoins, FeeCoins, GoodTestAddr)},
+		{true, NewMsgUpdateRecurringRequest(1, 10, GoodCoins, GoodCoins, GoodTestAddr)},
+		{false, NewMsgUpdateRecurringRequest(1, 0, FeeCoins, FeeCoins, GoodTestAddr)},
+		{false, NewMsgUpdateRecurringRequest(1, 10, BadCoins, FeeCoins, GoodTestAddr)},
+		{false, NewMsgUpdateRecurringRequest(1, 10, FeeCoins, BadCoins, GoodTestAddr)},
+		{false, NewMsgUpdateRecurringRequest(1, 10, FeeCoins, FeeCoins, EmptyAddr)},
+	})
+}
+
+func TestMsgCancelRecurringRequestValidation(t *testing.T) {
+	performValidateTests(t, []validateTestCase{
+		{true, NewMsgCancelRecurringRequest(1, GoodTestAddr)},
+		{false, NewMsgCancelRecurringRequest(1, EmptyAddr)},
+	})
+}
+
+func TestMsgWithdrawChannelPrepaidFeeValidation(t *testing.T) {
+	authority := GoodTestAddr.String()
+	performValidateTests(t, []validateTestCase{
+		{true, NewMsgWithdrawChannelPrepaidFee(authority, "channel-0", GoodTestAddr2, FeeCoins)},
+		{false, NewMsgWithdrawChannelPrepaidFee("", "channel-0", GoodTestAddr2, FeeCoins)},
+		{false, NewMsgWithdrawChannelPrepaidFee(authority, "", GoodTestAddr2, FeeCoins)},
+		{false, NewMsgWithdrawChannelPrepaidFee(authority, "channel-0", EmptyAddr, FeeCoins)},
+		{false, NewMsgWithdrawChannelPrepaidFee(authority, "channel-0", GoodTestAddr2, GoodCoins)},
+		{false, NewMsgWithdrawChannelPrepaidFee(authority, "channel-0", GoodTestAddr2, BadCoins)},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	bytes "bytes"
 	fmt "fmt"
 	github_com_bandprotocol_chain_v3_x_bandtss_types "github.com/bandprotocol/chain/v3/x/bandtss/types"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -460,6 +461,9 @@ type Request struct {
 	Requester string `protobuf:"bytes,12,opt,name=requester,proto3" json:"requester,omitempty"`
 	// FeeLimit is the maximum tokens that will be paid for this request.
 	FeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=fee_limit,json=feeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_limit"`
+	// Callback is the name of the module to be notified through the oracle hooks
+	// when the request is resolved. Empty if no module is subscribed.
+	Callback string `protobuf:"bytes,14,opt,name=callback,proto3" json:"callback,omitempty"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
@@ -586,6 +590,352 @@ func (m *Request) GetFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Request) GetCallback() string {
+	if m != nil {
+		return m.Callback
+	}
+	return ""
+}
+
+// RawRequestFee is the data source fee escrowed for one raw request, paid to
+// the treasury of the data source for each validator reporting the raw request.
+type RawRequestFee struct {
+	// ExternalID is an ID of the raw request
+	ExternalID ExternalID `protobuf:"varint,1,opt,name=external_id,json=externalId,proto3,casttype=ExternalID" json:"external_id,omitempty"`
+	// DataSourceID is an ID of data source script that relates to the raw request
+	DataSourceID DataSourceID `protobuf:"varint,2,opt,name=data_source_id,json=dataSourceId,proto3,casttype=DataSourceID" json:"data_source_id,omitempty"`
+	// Treasury is the account address of the data source treasury at the time
+	// of the request
+	Treasury string `protobuf:"bytes,3,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	// Fee is the amount paid to the treasury for each report of the raw request
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *RawRequestFee) Reset()         { *m = RawRequestFee{} }
+func (m *RawRequestFee) String() string { return proto.CompactTextString(m) }
+func (*RawRequestFee) ProtoMessage()    {}
+func (*RawRequestFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{5}
+}
+func (m *RawRequestFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RawRequestFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RawRequestFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RawRequestFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawRequestFee.Merge(m, src)
+}
+func (m *RawRequestFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *RawRequestFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawRequestFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RawRequestFee proto.InternalMessageInfo
+
+func (m *RawRequestFee) GetExternalID() ExternalID {
+	if m != nil {
+		return m.ExternalID
+	}
+	return 0
+}
+
+func (m *RawRequestFee) GetDataSourceID() DataSourceID {
+	if m != nil {
+		return m.DataSourceID
+	}
+	return 0
+}
+
+func (m *RawRequestFee) GetTreasury() string {
+	if m != nil {
+		return m.Treasury
+	}
+	return ""
+}
+
+func (m *RawRequestFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+// FeeEscrow is the data structure for storing data source fees held by the
+// oracle module until the raw requests of the request are reported. Every
+// unresolved request has one, and the remaining fees are refunded to the payer
+// on resolution, so reports that arrive after resolution pay nothing.
+type FeeEscrow struct {
+	// RequestID is ID of the request that the fees are escrowed for
+	RequestID RequestID `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3,casttype=RequestID" json:"request_id,omitempty"`
+	// Payer is the address of the account that paid the fees and receives the
+	// refund of the remaining fees
+	Payer string `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	// RawRequestFees is the list of fees for each raw request of the request
+	RawRequestFees []RawRequestFee `protobuf:"bytes,3,rep,name=raw_request_fees,json=rawRequestFees,proto3" json:"raw_request_fees"`
+	// Remaining is the amount of fees still held by the escrow
+	Remaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
+}
+
+func (m *FeeEscrow) Reset()         { *m = FeeEscrow{} }
+func (m *FeeEscrow) String() string { return proto.CompactTextString(m) }
+func (*FeeEscrow) ProtoMessage()    {}
+func (*FeeEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{6}
+}
+func (m *FeeEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeEscrow.Merge(m, src)
+}
+func (m *FeeEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeEscrow proto.InternalMessageInfo
+
+func (m *FeeEscrow) GetRequestID() RequestID {
+	if m != nil {
+		return m.RequestID
+	}
+	return 0
+}
+
+func (m *FeeEscrow) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *FeeEscrow) GetRawRequestFees() []RawRequestFee {
+	if m != nil {
+		return m.RawRequestFees
+	}
+	return nil
+}
+
+func (m *FeeEscrow) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
+	}
+	return nil
+}
+
+// RecurringRequest is the data structure for storing a request schedule that
+// periodically spawns oracle requests on behalf of its owner.
+type RecurringRequest struct {
+	// ID is the unique identifier of the recurring request.
+	ID RecurringRequestID `protobuf:"varint,1,opt,name=id,proto3,casttype=RecurringRequestID" json:"id,omitempty"`
+	// OracleScriptID is the identifier of the oracle script to be called.
+	OracleScriptID OracleScriptID `protobuf:"varint,2,opt,name=oracle_script_id,json=oracleScriptId,proto3,casttype=OracleScriptID" json:"oracle_script_id,omitempty"`
+	// Calldata is the OBI-encoded call parameters for the oracle script.
+	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is the number of validators to perform the oracle task.
+	AskCount uint64 `protobuf:"varint,4,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is the minimum number of validators sufficient to resolve the
+	// oracle tasks.
+	MinCount uint64 `protobuf:"varint,5,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// ClientID is the client-provided unique identifier to track the spawned
+	// requests.
+	ClientID string `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// FeeLimit is the maximum tokens that will be paid for each spawned request.
+	FeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=fee_limit,json=feeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_limit"`
+	// PrepareGas is amount of gas to pay to prepare raw requests
+	PrepareGas uint64 `protobuf:"varint,8,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
+	// ExecuteGas is amount of gas to reserve for executing
+	ExecuteGas uint64 `protobuf:"varint,9,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	// TSSEncoder is the mode of encoding oracle result signature order.
+	TSSEncoder Encoder `protobuf:"varint,10,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// Callback is the name of the module to be notified through the oracle hooks
+	// when a spawned request is resolved (optional).
+	Callback string `protobuf:"bytes,11,opt,name=callback,proto3" json:"callback,omitempty"`
+	// Interval is the number of blocks between two spawned requests.
+	Interval uint64 `protobuf:"varint,12,opt,name=interval,proto3" json:"interval,omitempty"`
+	// NextHeight is the block height at which the next request will be spawned.
+	NextHeight int64 `protobuf:"varint,13,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// FeePayer is the account holding the prepaid deposit that pays for the
+	// spawned requests.
+	FeePayer string `protobuf:"bytes,14,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// Owner is the address of the account who created the recurring request.
+	Owner string `protobuf:"bytes,15,opt,name=owner,proto3" json:"owner,omitempty"`
+	// IsActive is a flag indicating whether the recurring request still spawns
+	// requests. It is turned off when the deposit cannot cover the fee limit.
+	IsActive bool `protobuf:"varint,16,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+}
+
+func (m *RecurringRequest) Reset()         { *m = RecurringRequest{} }
+func (m *RecurringRequest) String() string { return proto.CompactTextString(m) }
+func (*RecurringRequest) ProtoMessage()    {}
+func (*RecurringRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{7}
+}
+func (m *RecurringRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecurringRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecurringRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecurringRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecurringRequest.Merge(m, src)
+}
+func (m *RecurringRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecurringRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecurringRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecurringRequest proto.InternalMessageInfo
+
+func (m *RecurringRequest) GetID() RecurringRequestID {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *RecurringRequest) GetOracleScriptID() OracleScriptID {
+	if m != nil {
+		return m.OracleScriptID
+	}
+	return 0
+}
+
+func (m *RecurringRequest) GetCalldata() []byte {
+	if m != nil {
+		return m.Calldata
+	}
+	return nil
+}
+
+func (m *RecurringRequest) GetAskCount() uint64 {
+	if m != nil {
+		return m.AskCount
+	}
+	return 0
+}
+
+func (m *RecurringRequest) GetMinCount() uint64 {
+	if m != nil {
+		return m.MinCount
+	}
+	return 0
+}
+
+func (m *RecurringRequest) GetClientID() string {
+	if m != nil {
+		return m.ClientID
+	}
+	return ""
+}
+
+func (m *RecurringRequest) GetFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeLimit
+	}
+	return nil
+}
+
+func (m *RecurringRequest) GetPrepareGas() uint64 {
+	if m != nil {
+		return m.PrepareGas
+	}
+	return 0
+}
+
+func (m *RecurringRequest) GetExecuteGas() uint64 {
+	if m != nil {
+		return m.ExecuteGas
+	}
+	return 0
+}
+
+func (m *RecurringRequest) GetTSSEncoder() Encoder {
+	if m != nil {
+		return m.TSSEncoder
+	}
+	return ENCODER_UNSPECIFIED
+}
+
+func (m *RecurringRequest) GetCallback() string {
+	if m != nil {
+		return m.Callback
+	}
+	return ""
+}
+
+func (m *RecurringRequest) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *RecurringRequest) GetNextHeight() int64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
+func (m *RecurringRequest) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func (m *RecurringRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *RecurringRequest) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
+	}
+	return false
+}
+
 // Report is the data structure for storing reports in the storage.
 type Report struct {
 	// Validator is a validator address who submit the report
@@ -602,7 +952,7 @@ func (m *Report) Reset()         { *m = Report{} }
 func (m *Report) String() string { return proto.CompactTextString(m) }
 func (*Report) ProtoMessage()    {}
 func (*Report) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{5}
+	return fileDescriptor_9714783eaff1514b, []int{8}
 }
 func (m *Report) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,7 +1037,7 @@ func (m *OracleRequestPacketData) Reset()         { *m = OracleRequestPacketData
 func (m *OracleRequestPacketData) String() string { return proto.CompactTextString(m) }
 func (*OracleRequestPacketData) ProtoMessage()    {}
 func (*OracleRequestPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{6}
+	return fileDescriptor_9714783eaff1514b, []int{9}
 }
 func (m *OracleRequestPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -790,7 +1140,7 @@ func (m *OracleRequestPacketAcknowledgement) Reset()         { *m = OracleReques
 func (m *OracleRequestPacketAcknowledgement) String() string { return proto.CompactTextString(m) }
 func (*OracleRequestPacketAcknowledgement) ProtoMessage()    {}
 func (*OracleRequestPacketAcknowledgement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{7}
+	return fileDescriptor_9714783eaff1514b, []int{10}
 }
 func (m *OracleRequestPacketAcknowledgement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -826,6 +1176,64 @@ func (m *OracleRequestPacketAcknowledgement) GetRequestID() RequestID {
 	return 0
 }
 
+// OracleRequestPacketAcknowledgementV2 encodes an oracle request
+// acknowledgement send back to requester chain on a channel of version
+// bandchain-2.
+type OracleRequestPacketAcknowledgementV2 struct {
+	// RequestID is BandChain's unique identifier for this oracle request.
+	RequestID RequestID `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3,casttype=RequestID" json:"request_id,omitempty"`
+	// ExpirationHeight is the BandChain height at which the request expires if it
+	// is not resolved yet, so the requester chain can time out sanely.
+	ExpirationHeight int64 `protobuf:"varint,2,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (m *OracleRequestPacketAcknowledgementV2) Reset()         { *m = OracleRequestPacketAcknowledgementV2{} }
+func (m *OracleRequestPacketAcknowledgementV2) String() string { return proto.CompactTextString(m) }
+func (*OracleRequestPacketAcknowledgementV2) ProtoMessage()    {}
+func (*OracleRequestPacketAcknowledgementV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{11}
+}
+func (m *OracleRequestPacketAcknowledgementV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleRequestPacketAcknowledgementV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleRequestPacketAcknowledgementV2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleRequestPacketAcknowledgementV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleRequestPacketAcknowledgementV2.Merge(m, src)
+}
+func (m *OracleRequestPacketAcknowledgementV2) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleRequestPacketAcknowledgementV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleRequestPacketAcknowledgementV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleRequestPacketAcknowledgementV2 proto.InternalMessageInfo
+
+func (m *OracleRequestPacketAcknowledgementV2) GetRequestID() RequestID {
+	if m != nil {
+		return m.RequestID
+	}
+	return 0
+}
+
+func (m *OracleRequestPacketAcknowledgementV2) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
 // OracleResponsePacketData encodes an oracle response from BandChain to the
 // requester.
 type OracleResponsePacketData struct {
@@ -856,7 +1264,7 @@ func (m *OracleResponsePacketData) Reset()         { *m = OracleResponsePacketDa
 func (m *OracleResponsePacketData) String() string { return proto.CompactTextString(m) }
 func (*OracleResponsePacketData) ProtoMessage()    {}
 func (*OracleResponsePacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{8}
+	return fileDescriptor_9714783eaff1514b, []int{12}
 }
 func (m *OracleResponsePacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -975,7 +1383,7 @@ func (m *Result) Reset()         { *m = Result{} }
 func (m *Result) String() string { return proto.CompactTextString(m) }
 func (*Result) ProtoMessage()    {}
 func (*Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{9}
+	return fileDescriptor_9714783eaff1514b, []int{13}
 }
 func (m *Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1095,7 +1503,7 @@ func (m *SigningResult) Reset()         { *m = SigningResult{} }
 func (m *SigningResult) String() string { return proto.CompactTextString(m) }
 func (*SigningResult) ProtoMessage()    {}
 func (*SigningResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{10}
+	return fileDescriptor_9714783eaff1514b, []int{14}
 }
 func (m *SigningResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1567,7 @@ func (m *ValidatorStatus) Reset()         { *m = ValidatorStatus{} }
 func (m *ValidatorStatus) String() string { return proto.CompactTextString(m) }
 func (*ValidatorStatus) ProtoMessage()    {}
 func (*ValidatorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{11}
+	return fileDescriptor_9714783eaff1514b, []int{15}
 }
 func (m *ValidatorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1214,7 +1622,7 @@ func (m *ActiveValidator) Reset()         { *m = ActiveValidator{} }
 func (m *ActiveValidator) String() string { return proto.CompactTextString(m) }
 func (*ActiveValidator) ProtoMessage()    {}
 func (*ActiveValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{12}
+	return fileDescriptor_9714783eaff1514b, []int{16}
 }
 func (m *ActiveValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1290,13 +1698,51 @@ type Params struct {
 	// IBCRequestEnabled is a flag indicating whether sending oracle request via
 	// IBC is allowed
 	IBCRequestEnabled bool `protobuf:"varint,11,opt,name=ibc_request_enabled,json=ibcRequestEnabled,proto3" json:"ibc_request_enabled,omitempty"`
+	// ReliabilitySamplingEnabled is a flag indicating whether validator
+	// sampling takes the oracle reliability of validators into account
+	ReliabilitySamplingEnabled bool `protobuf:"varint,12,opt,name=reliability_sampling_enabled,json=reliabilitySamplingEnabled,proto3" json:"reliability_sampling_enabled,omitempty"`
+	// ReliabilityWeightPercentage is the percentage of the sampling weight of a
+	// validator that depends on its oracle reliability instead of its power. It
+	// must not exceed 90, so that every validator keeps some sampling weight.
+	ReliabilityWeightPercentage uint64 `protobuf:"varint,13,opt,name=reliability_weight_percentage,json=reliabilityWeightPercentage,proto3" json:"reliability_weight_percentage,omitempty"`
+	// ResultRetentionPeriod is the duration (in seconds) after resolution that a
+	// request result is kept in state before it can be pruned. Zero disables
+	// pruning by age.
+	ResultRetentionPeriod uint64 `protobuf:"varint,14,opt,name=result_retention_period,json=resultRetentionPeriod,proto3" json:"result_retention_period,omitempty"`
+	// ResultRetentionCount is the number of the most recent expired request
+	// results that are always kept in state. Zero disables pruning by count.
+	ResultRetentionCount uint64 `protobuf:"varint,15,opt,name=result_retention_count,json=resultRetentionCount,proto3" json:"result_retention_count,omitempty"`
+	// MaxPrunedResultsPerBlock is the maximum number of request results pruned
+	// in a single block. Zero pauses pruning.
+	MaxPrunedResultsPerBlock uint64 `protobuf:"varint,16,opt,name=max_pruned_results_per_block,json=maxPrunedResultsPerBlock,proto3" json:"max_pruned_results_per_block,omitempty"`
+	// IBCRequestRateLimitWindow is the number of blocks of a window in which
+	// the number of IBC requests is rate limited. Zero disables rate limiting.
+	IBCRequestRateLimitWindow uint64 `protobuf:"varint,17,opt,name=ibc_request_rate_limit_window,json=ibcRequestRateLimitWindow,proto3" json:"ibc_request_rate_limit_window,omitempty"`
+	// MaxIBCRequestsPerChannel is the maximum number of IBC requests from a
+	// channel in a window. Zero means unlimited.
+	MaxIBCRequestsPerChannel uint64 `protobuf:"varint,18,opt,name=max_ibc_requests_per_channel,json=maxIbcRequestsPerChannel,proto3" json:"max_ibc_requests_per_channel,omitempty"`
+	// MaxIBCRequestsPerLightClient is the maximum number of IBC requests from
+	// all channels of a light client, i.e. of a counterparty chain, in a window.
+	// Zero means unlimited.
+	MaxIBCRequestsPerLightClient uint64 `protobuf:"varint,19,opt,name=max_ibc_requests_per_light_client,json=maxIbcRequestsPerLightClient,proto3" json:"max_ibc_requests_per_light_client,omitempty"`
+	// MinRecurringRequestInterval is the minimum number of blocks between two
+	// requests spawned by a recurring request.
+	MinRecurringRequestInterval uint64 `protobuf:"varint,20,opt,name=min_recurring_request_interval,json=minRecurringRequestInterval,proto3" json:"min_recurring_request_interval,omitempty"`
+	// RecurringRequestSpawnFee is the fee charged from the deposit of a
+	// recurring request for every attempt to spawn a request, which pays for
+	// preparing the request in the begin blocker.
+	RecurringRequestSpawnFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,21,rep,name=recurring_request_spawn_fee,json=recurringRequestSpawnFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recurring_request_spawn_fee"`
+	// ReliabilityWindowSize is the number of assigned requests after which the
+	// reliability counts of a validator are halved, so that recent reports weigh
+	// more than old ones. Zero disables the decay.
+	ReliabilityWindowSize uint64 `protobuf:"varint,22,opt,name=reliability_window_size,json=reliabilityWindowSize,proto3" json:"reliability_window_size,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{13}
+	return fileDescriptor_9714783eaff1514b, []int{17}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1402,6 +1848,214 @@ func (m *Params) GetIBCRequestEnabled() bool {
 	return false
 }
 
+func (m *Params) GetReliabilitySamplingEnabled() bool {
+	if m != nil {
+		return m.ReliabilitySamplingEnabled
+	}
+	return false
+}
+
+func (m *Params) GetReliabilityWeightPercentage() uint64 {
+	if m != nil {
+		return m.ReliabilityWeightPercentage
+	}
+	return 0
+}
+
+func (m *Params) GetResultRetentionPeriod() uint64 {
+	if m != nil {
+		return m.ResultRetentionPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetResultRetentionCount() uint64 {
+	if m != nil {
+		return m.ResultRetentionCount
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPrunedResultsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunedResultsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetIBCRequestRateLimitWindow() uint64 {
+	if m != nil {
+		return m.IBCRequestRateLimitWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxIBCRequestsPerChannel() uint64 {
+	if m != nil {
+		return m.MaxIBCRequestsPerChannel
+	}
+	return 0
+}
+
+func (m *Params) GetMaxIBCRequestsPerLightClient() uint64 {
+	if m != nil {
+		return m.MaxIBCRequestsPerLightClient
+	}
+	return 0
+}
+
+func (m *Params) GetMinRecurringRequestInterval() uint64 {
+	if m != nil {
+		return m.MinRecurringRequestInterval
+	}
+	return 0
+}
+
+func (m *Params) GetRecurringRequestSpawnFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RecurringRequestSpawnFee
+	}
+	return nil
+}
+
+func (m *Params) GetReliabilityWindowSize() uint64 {
+	if m != nil {
+		return m.ReliabilityWindowSize
+	}
+	return 0
+}
+
+// IBCRequestCounter is the number of IBC requests received in the current
+// rate limit window.
+type IBCRequestCounter struct {
+	// Window is the index of the rate limit window the count belongs to
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// Count is the number of requests received in the window
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *IBCRequestCounter) Reset()         { *m = IBCRequestCounter{} }
+func (m *IBCRequestCounter) String() string { return proto.CompactTextString(m) }
+func (*IBCRequestCounter) ProtoMessage()    {}
+func (*IBCRequestCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{18}
+}
+func (m *IBCRequestCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCRequestCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCRequestCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCRequestCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCRequestCounter.Merge(m, src)
+}
+func (m *IBCRequestCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCRequestCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCRequestCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCRequestCounter proto.InternalMessageInfo
+
+func (m *IBCRequestCounter) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *IBCRequestCounter) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// ValidatorReliability is the oracle reporting record of a validator that is
+// used to weight the validator in request sampling.
+type ValidatorReliability struct {
+	// Validator is the validator address
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// AssignedCount is the number of requests the validator was chosen for
+	AssignedCount uint64 `protobuf:"varint,2,opt,name=assigned_count,json=assignedCount,proto3" json:"assigned_count,omitempty"`
+	// ReportedCount is the number of requests the validator reported to
+	ReportedCount uint64 `protobuf:"varint,3,opt,name=reported_count,json=reportedCount,proto3" json:"reported_count,omitempty"`
+	// TotalReportLatency is the sum of blocks between request and report of all
+	// reports of the validator
+	TotalReportLatency uint64 `protobuf:"varint,4,opt,name=total_report_latency,json=totalReportLatency,proto3" json:"total_report_latency,omitempty"`
+}
+
+func (m *ValidatorReliability) Reset()         { *m = ValidatorReliability{} }
+func (m *ValidatorReliability) String() string { return proto.CompactTextString(m) }
+func (*ValidatorReliability) ProtoMessage()    {}
+func (*ValidatorReliability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{19}
+}
+func (m *ValidatorReliability) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorReliability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorReliability.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorReliability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorReliability.Merge(m, src)
+}
+func (m *ValidatorReliability) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorReliability) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorReliability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorReliability proto.InternalMessageInfo
+
+func (m *ValidatorReliability) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ValidatorReliability) GetAssignedCount() uint64 {
+	if m != nil {
+		return m.AssignedCount
+	}
+	return 0
+}
+
+func (m *ValidatorReliability) GetReportedCount() uint64 {
+	if m != nil {
+		return m.ReportedCount
+	}
+	return 0
+}
+
+func (m *ValidatorReliability) GetTotalReportLatency() uint64 {
+	if m != nil {
+		return m.TotalReportLatency
+	}
+	return 0
+}
+
 // PendingResolveList is a list of requests that are waiting to be resolved
 type PendingResolveList struct {
 	// RequestIDs is a list of request IDs that are waiting to be resolved
@@ -1412,7 +2066,7 @@ func (m *PendingResolveList) Reset()         { *m = PendingResolveList{} }
 func (m *PendingResolveList) String() string { return proto.CompactTextString(m) }
 func (*PendingResolveList) ProtoMessage()    {}
 func (*PendingResolveList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{14}
+	return fileDescriptor_9714783eaff1514b, []int{20}
 }
 func (m *PendingResolveList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1463,7 +2117,7 @@ func (m *IBCChannel) Reset()         { *m = IBCChannel{} }
 func (m *IBCChannel) String() string { return proto.CompactTextString(m) }
 func (*IBCChannel) ProtoMessage()    {}
 func (*IBCChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{15}
+	return fileDescriptor_9714783eaff1514b, []int{21}
 }
 func (m *IBCChannel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1525,7 +2179,7 @@ func (m *RequestVerification) Reset()         { *m = RequestVerification{} }
 func (m *RequestVerification) String() string { return proto.CompactTextString(m) }
 func (*RequestVerification) ProtoMessage()    {}
 func (*RequestVerification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{16}
+	return fileDescriptor_9714783eaff1514b, []int{22}
 }
 func (m *RequestVerification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1610,7 +2264,7 @@ func (m *PriceResult) Reset()         { *m = PriceResult{} }
 func (m *PriceResult) String() string { return proto.CompactTextString(m) }
 func (*PriceResult) ProtoMessage()    {}
 func (*PriceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{17}
+	return fileDescriptor_9714783eaff1514b, []int{23}
 }
 func (m *PriceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1686,7 +2340,7 @@ func (m *OracleResultSignatureOrder) Reset()         { *m = OracleResultSignatur
 func (m *OracleResultSignatureOrder) String() string { return proto.CompactTextString(m) }
 func (*OracleResultSignatureOrder) ProtoMessage()    {}
 func (*OracleResultSignatureOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{18}
+	return fileDescriptor_9714783eaff1514b, []int{24}
 }
 func (m *OracleResultSignatureOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1723,15 +2377,21 @@ func init() {
 	proto.RegisterType((*RawRequest)(nil), "band.oracle.v1.RawRequest")
 	proto.RegisterType((*RawReport)(nil), "band.oracle.v1.RawReport")
 	proto.RegisterType((*Request)(nil), "band.oracle.v1.Request")
+	proto.RegisterType((*RawRequestFee)(nil), "band.oracle.v1.RawRequestFee")
+	proto.RegisterType((*FeeEscrow)(nil), "band.oracle.v1.FeeEscrow")
+	proto.RegisterType((*RecurringRequest)(nil), "band.oracle.v1.RecurringRequest")
 	proto.RegisterType((*Report)(nil), "band.oracle.v1.Report")
 	proto.RegisterType((*OracleRequestPacketData)(nil), "band.oracle.v1.OracleRequestPacketData")
 	proto.RegisterType((*OracleRequestPacketAcknowledgement)(nil), "band.oracle.v1.OracleRequestPacketAcknowledgement")
+	proto.RegisterType((*OracleRequestPacketAcknowledgementV2)(nil), "band.oracle.v1.OracleRequestPacketAcknowledgementV2")
 	proto.RegisterType((*OracleResponsePacketData)(nil), "band.oracle.v1.OracleResponsePacketData")
 	proto.RegisterType((*Result)(nil), "band.oracle.v1.Result")
 	proto.RegisterType((*SigningResult)(nil), "band.oracle.v1.SigningResult")
 	proto.RegisterType((*ValidatorStatus)(nil), "band.oracle.v1.ValidatorStatus")
 	proto.RegisterType((*ActiveValidator)(nil), "band.oracle.v1.ActiveValidator")
 	proto.RegisterType((*Params)(nil), "band.oracle.v1.Params")
+	proto.RegisterType((*IBCRequestCounter)(nil), "band.oracle.v1.IBCRequestCounter")
+	proto.RegisterType((*ValidatorReliability)(nil), "band.oracle.v1.ValidatorReliability")
 	proto.RegisterType((*PendingResolveList)(nil), "band.oracle.v1.PendingResolveList")
 	proto.RegisterType((*IBCChannel)(nil), "band.oracle.v1.IBCChannel")
 	proto.RegisterType((*RequestVerification)(nil), "band.oracle.v1.RequestVerification")
@@ -1742,130 +2402,174 @@ func init() {
 func init() { proto.RegisterFile("band/oracle/v1/oracle.proto", fileDescriptor_9714783eaff1514b) }
 
 var fileDescriptor_9714783eaff1514b = []byte{
-	// 1965 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xdb, 0x6f, 0x63, 0x47,
-	0x19, 0xcf, 0xb1, 0x9d, 0xc4, 0xfe, 0xec, 0x38, 0xc9, 0x6c, 0xba, 0xf1, 0x7a, 0xb7, 0x71, 0x88,
-	0x0a, 0x2c, 0x2b, 0xb0, 0xc9, 0x16, 0x21, 0x76, 0x01, 0x89, 0xf8, 0xb2, 0xd4, 0x34, 0xda, 0x58,
-	0xe3, 0x64, 0x85, 0x90, 0xd0, 0xd1, 0xf8, 0x9c, 0x89, 0x33, 0xcd, 0xb9, 0x31, 0x73, 0x9c, 0x38,
-	0x7d, 0xe3, 0xad, 0xea, 0xd3, 0x3e, 0x23, 0x55, 0xaa, 0xd4, 0x37, 0x5e, 0x11, 0xff, 0x01, 0x88,
-	0xf2, 0x44, 0x1f, 0x91, 0x90, 0x52, 0xe4, 0x95, 0x10, 0xff, 0x00, 0x2f, 0xf0, 0x82, 0xe6, 0x72,
-	0x7c, 0xab, 0xe9, 0x76, 0x77, 0x0b, 0x0f, 0x3c, 0xf9, 0x7c, 0x97, 0x99, 0xf9, 0x6e, 0xbf, 0x6f,
-	0xbe, 0x31, 0xdc, 0xee, 0x91, 0xc0, 0xad, 0x85, 0x9c, 0x38, 0x1e, 0xad, 0x5d, 0xec, 0x9b, 0xaf,
-	0x6a, 0xc4, 0xc3, 0x38, 0x44, 0x45, 0x29, 0xac, 0x1a, 0xd6, 0xc5, 0x7e, 0x79, 0xab, 0x1f, 0xf6,
-	0x43, 0x25, 0xaa, 0xc9, 0x2f, 0xad, 0x55, 0xae, 0xf4, 0xc3, 0xb0, 0xef, 0xd1, 0x9a, 0xa2, 0x7a,
-	0x83, 0xd3, 0x5a, 0xcc, 0x7c, 0x2a, 0x62, 0xe2, 0x47, 0x46, 0x61, 0xc7, 0x09, 0x85, 0x1f, 0x8a,
-	0x5a, 0x8f, 0x08, 0x79, 0x46, 0x8f, 0xc6, 0x64, 0xbf, 0xe6, 0x84, 0x2c, 0xd0, 0xf2, 0xbd, 0x7f,
-	0x58, 0x00, 0x4d, 0x12, 0x93, 0x6e, 0x38, 0xe0, 0x0e, 0x45, 0x5b, 0xb0, 0x1c, 0x5e, 0x06, 0x94,
-	0x97, 0xac, 0x5d, 0xeb, 0x6e, 0x0e, 0x6b, 0x02, 0x21, 0xc8, 0x04, 0xc4, 0xa7, 0xa5, 0x94, 0x62,
-	0xaa, 0x6f, 0xb4, 0x0b, 0x79, 0x97, 0x0a, 0x87, 0xb3, 0x28, 0x66, 0x61, 0x50, 0x4a, 0x2b, 0xd1,
-	0x34, 0x0b, 0x95, 0x21, 0x7b, 0xca, 0x3c, 0xaa, 0x56, 0x66, 0x94, 0x78, 0x4c, 0x4b, 0x59, 0xcc,
-	0x29, 0x11, 0x03, 0x7e, 0x55, 0x5a, 0xd6, 0xb2, 0x84, 0x46, 0x3f, 0x87, 0xf4, 0x29, 0xa5, 0xa5,
-	0x95, 0xdd, 0xf4, 0xdd, 0xfc, 0xfd, 0x5b, 0x55, 0xed, 0x40, 0x55, 0x3a, 0x50, 0x35, 0x0e, 0x54,
-	0x1b, 0x21, 0x0b, 0xea, 0xdf, 0xfe, 0xf8, 0xba, 0xb2, 0xf4, 0xeb, 0x4f, 0x2b, 0x77, 0xfb, 0x2c,
-	0x3e, 0x1b, 0xf4, 0xaa, 0x4e, 0xe8, 0xd7, 0x8c, 0xb7, 0xfa, 0xe7, 0x5b, 0xc2, 0x3d, 0xaf, 0xc5,
-	0x57, 0x11, 0x15, 0x6a, 0x81, 0xc0, 0x72, 0xdf, 0x87, 0x99, 0xbf, 0x7f, 0x58, 0xb1, 0xf6, 0xfe,
-	0x64, 0x41, 0xe1, 0x48, 0x05, 0xb7, 0xab, 0x0c, 0xfe, 0x9f, 0x79, 0x7e, 0x13, 0x56, 0x84, 0x73,
-	0x46, 0x7d, 0x62, 0xfc, 0x36, 0x14, 0x7a, 0x00, 0xeb, 0x42, 0xe5, 0xc0, 0x76, 0x42, 0x97, 0xda,
-	0x03, 0xee, 0x95, 0x56, 0xa4, 0x42, 0x7d, 0x73, 0x74, 0x5d, 0x59, 0xd3, 0xe9, 0x69, 0x84, 0x2e,
-	0x3d, 0xc1, 0x87, 0x78, 0x4d, 0x4c, 0x48, 0xee, 0x19, 0x8f, 0x7e, 0x6b, 0x01, 0x60, 0x72, 0x89,
-	0xe9, 0x2f, 0x06, 0x54, 0xc4, 0xe8, 0x87, 0x90, 0xa7, 0xc3, 0x98, 0xf2, 0x80, 0x78, 0x36, 0x73,
-	0x95, 0x57, 0x99, 0xfa, 0x9d, 0xd1, 0x75, 0x05, 0x5a, 0x86, 0xdd, 0x6e, 0xfe, 0x73, 0x86, 0xc2,
-	0x90, 0x2c, 0x68, 0xbb, 0xe8, 0x11, 0x14, 0x5d, 0x12, 0x13, 0xdb, 0xd8, 0xc4, 0x5c, 0x15, 0x82,
-	0x4c, 0x7d, 0x77, 0x74, 0x5d, 0x29, 0x4c, 0x0a, 0x46, 0xed, 0x31, 0x43, 0xe3, 0x82, 0x3b, 0xa1,
-	0x5c, 0x19, 0x0a, 0x87, 0x78, 0x9e, 0xe4, 0xa9, 0x48, 0x15, 0xf0, 0x98, 0x36, 0x76, 0xff, 0xd2,
-	0x82, 0x9c, 0xb2, 0x3b, 0x0a, 0xf9, 0x2b, 0x9b, 0x7d, 0x1b, 0x72, 0x74, 0xc8, 0x62, 0x15, 0x43,
-	0x65, 0xf1, 0x1a, 0xce, 0x4a, 0x86, 0x0c, 0x95, 0x4c, 0xe6, 0x94, 0x1d, 0x99, 0x29, 0x1b, 0x7e,
-	0xbf, 0x0c, 0xab, 0x49, 0xe0, 0x1e, 0xc3, 0x86, 0x46, 0x9d, 0xad, 0x13, 0x3a, 0x31, 0xe3, 0x8d,
-	0xd1, 0x75, 0xa5, 0x38, 0x5d, 0x34, 0xca, 0x94, 0x39, 0x0e, 0x2e, 0x86, 0xd3, 0xf4, 0x6c, 0x04,
-	0x52, 0xb3, 0x11, 0x40, 0xfb, 0xb0, 0xc5, 0xf5, 0xb1, 0xd4, 0xb5, 0x2f, 0x88, 0xc7, 0x5c, 0x12,
-	0x87, 0x5c, 0x94, 0xd2, 0xbb, 0xe9, 0xbb, 0x39, 0x7c, 0x63, 0x2c, 0x7b, 0x32, 0x16, 0x49, 0x0f,
-	0x7d, 0x16, 0xd8, 0x4e, 0x38, 0x08, 0x62, 0x55, 0x5c, 0x19, 0x9c, 0xf5, 0x59, 0xd0, 0x90, 0x34,
-	0xfa, 0x2a, 0x14, 0xcd, 0x1a, 0xfb, 0x8c, 0xb2, 0xfe, 0x59, 0xac, 0x8a, 0x2c, 0x8d, 0xd7, 0x0c,
-	0xf7, 0x2d, 0xc5, 0x44, 0x5f, 0x81, 0x42, 0xa2, 0x26, 0xfb, 0x85, 0x2a, 0xb4, 0x34, 0xce, 0x1b,
-	0xde, 0x31, 0xf3, 0x29, 0xfa, 0x06, 0xe4, 0x1c, 0x8f, 0xd1, 0x40, 0xb9, 0xbf, 0xaa, 0x0a, 0xb1,
-	0x30, 0xba, 0xae, 0x64, 0x1b, 0x8a, 0xd9, 0x6e, 0xe2, 0xac, 0x16, 0xb7, 0x5d, 0xd4, 0x80, 0x02,
-	0x27, 0x97, 0xb6, 0x59, 0x2d, 0x4a, 0x59, 0x05, 0xdc, 0x72, 0x75, 0xb6, 0x81, 0x55, 0x27, 0xb5,
-	0x59, 0xcf, 0x48, 0xe4, 0xe2, 0x3c, 0x1f, 0x73, 0x04, 0x7a, 0x1b, 0xf2, 0xac, 0xe7, 0xd8, 0xce,
-	0x19, 0x09, 0x02, 0xea, 0x95, 0x72, 0xbb, 0xd6, 0xa2, 0x3d, 0xda, 0xf5, 0x46, 0x43, 0x6b, 0xd4,
-	0x8b, 0xb2, 0x26, 0x26, 0x34, 0x06, 0xd6, 0x73, 0xcc, 0x37, 0xaa, 0xc8, 0x22, 0xa2, 0xce, 0x20,
-	0xa6, 0x76, 0x9f, 0x88, 0x12, 0xa8, 0x28, 0x81, 0x61, 0xfd, 0x98, 0x08, 0xf4, 0x16, 0xe4, 0x63,
-	0x21, 0x6c, 0x1a, 0xc8, 0x3a, 0xe1, 0xa5, 0xfc, 0xae, 0x75, 0xb7, 0x78, 0x7f, 0x7b, 0xfe, 0xb4,
-	0x96, 0x16, 0xeb, 0xa3, 0x8e, 0xbb, 0x5d, 0x43, 0x63, 0x88, 0x85, 0x30, 0xdf, 0xe8, 0x0e, 0xe4,
-	0x92, 0x2c, 0xf1, 0x52, 0x41, 0x21, 0x7a, 0xc2, 0x40, 0x67, 0x90, 0x3b, 0xa5, 0xd4, 0xf6, 0x98,
-	0xcf, 0xe2, 0xd2, 0xda, 0x97, 0xdf, 0xd0, 0xb2, 0xa7, 0x94, 0x1e, 0xca, 0xcd, 0x4d, 0x1d, 0xff,
-	0xca, 0x82, 0x15, 0x03, 0xa4, 0x3b, 0x90, 0x1b, 0x17, 0x94, 0xe9, 0x69, 0x13, 0x06, 0xba, 0x07,
-	0x9b, 0x2c, 0xb0, 0x7b, 0xf4, 0x34, 0xe4, 0xd4, 0xe6, 0x54, 0x84, 0xde, 0x85, 0xc6, 0x4b, 0x16,
-	0xaf, 0xb3, 0xa0, 0xae, 0xf8, 0x58, 0xb3, 0xd1, 0x8f, 0x20, 0xaf, 0xf3, 0x2b, 0xf7, 0xd5, 0xb5,
-	0x29, 0xdd, 0x58, 0x94, 0x5e, 0xa9, 0x61, 0xb2, 0x0b, 0x3c, 0x61, 0x08, 0x63, 0xdc, 0xdf, 0xd2,
-	0xb0, 0xad, 0xb1, 0x62, 0xb2, 0xde, 0x21, 0xce, 0x39, 0x8d, 0x65, 0xf3, 0x98, 0x2d, 0x37, 0xeb,
-	0x73, 0xcb, 0x6d, 0x11, 0x3e, 0x53, 0x5f, 0x12, 0x3e, 0xe7, 0x3a, 0x94, 0x04, 0x1b, 0x11, 0xe7,
-	0xb3, 0x60, 0x23, 0xe2, 0x5c, 0x83, 0x6d, 0x06, 0x89, 0xcb, 0x73, 0x48, 0x9c, 0xc9, 0xfc, 0xca,
-	0x7f, 0x31, 0xf3, 0xb2, 0xd8, 0x23, 0x4e, 0x23, 0xc2, 0x75, 0xb1, 0xaf, 0xea, 0x62, 0x37, 0x2c,
-	0x59, 0xec, 0x73, 0x68, 0xc8, 0x3e, 0x0f, 0x0d, 0xb9, 0x97, 0x46, 0x83, 0x49, 0x34, 0x85, 0xbd,
-	0x05, 0x79, 0x3e, 0x70, 0xce, 0x83, 0xf0, 0xd2, 0xa3, 0x6e, 0x9f, 0xfa, 0x34, 0x88, 0xd1, 0x03,
-	0x80, 0xa4, 0x09, 0x8d, 0x3b, 0x6c, 0x79, 0x74, 0x5d, 0xc9, 0x99, 0x55, 0x2a, 0x79, 0x13, 0x62,
-	0x0c, 0xab, 0xb6, 0x6b, 0x8e, 0xf9, 0x43, 0x0a, 0x4a, 0xc9, 0x39, 0x22, 0x0a, 0x03, 0x41, 0x5f,
-	0xae, 0xa0, 0x66, 0x0d, 0x49, 0xbd, 0x80, 0x21, 0xaa, 0x3e, 0x02, 0x61, 0x4a, 0x20, 0x6d, 0xea,
-	0x23, 0x10, 0xba, 0x04, 0xe6, 0xbb, 0x6c, 0xe6, 0xb3, 0x5d, 0x56, 0xa9, 0x28, 0x94, 0x69, 0x95,
-	0xe5, 0x44, 0x45, 0xf1, 0x94, 0x4a, 0x53, 0xb6, 0x74, 0xad, 0x22, 0x62, 0x12, 0x0f, 0x84, 0xea,
-	0xd6, 0xc5, 0xfb, 0xaf, 0x7f, 0x06, 0x80, 0x5a, 0xab, 0xab, 0x94, 0x64, 0xc7, 0x9f, 0x22, 0xe5,
-	0xd4, 0xc1, 0xa9, 0x18, 0x78, 0xb1, 0xaa, 0x8f, 0x02, 0x36, 0x94, 0x89, 0xe4, 0x5f, 0xd2, 0xb2,
-	0x6d, 0x48, 0xc6, 0xff, 0x1f, 0x10, 0x67, 0xb3, 0xbb, 0xf2, 0xd2, 0xd9, 0x5d, 0x7d, 0x4e, 0x76,
-	0xb3, 0xcf, 0xcf, 0x6e, 0xee, 0x8b, 0x64, 0x17, 0x5e, 0x29, 0xbb, 0xf9, 0x05, 0xd9, 0xfd, 0xa3,
-	0x05, 0x6b, 0x5d, 0xd6, 0x0f, 0x58, 0xd0, 0x37, 0x49, 0x7e, 0x07, 0x40, 0x68, 0xc6, 0x04, 0x7a,
-	0x6f, 0xcb, 0x98, 0x18, 0x35, 0x15, 0x93, 0x87, 0x53, 0xbd, 0x48, 0x1a, 0xa3, 0xde, 0x0b, 0x4e,
-	0xe8, 0xd5, 0x9c, 0x33, 0xc2, 0x82, 0xda, 0xc5, 0x9b, 0xb5, 0xa1, 0xe2, 0xc7, 0x42, 0x98, 0xce,
-	0x34, 0x5e, 0x8d, 0x73, 0x66, 0xfb, 0xb6, 0x8b, 0xbe, 0x0e, 0xeb, 0x94, 0xf3, 0x90, 0xab, 0x91,
-	0x4c, 0x44, 0xc4, 0x49, 0x86, 0xe9, 0xa2, 0x62, 0x37, 0x12, 0x2e, 0x7a, 0x1d, 0x60, 0xa2, 0x68,
-	0xc0, 0x94, 0x1b, 0xeb, 0x18, 0x5f, 0x22, 0x58, 0x1f, 0xcf, 0x42, 0xc6, 0xf9, 0xdb, 0x90, 0x63,
-	0xc2, 0x26, 0x4e, 0xcc, 0x2e, 0xa8, 0xf2, 0x25, 0x8b, 0xb3, 0x4c, 0x1c, 0x28, 0x1a, 0x3d, 0x84,
-	0x65, 0xc1, 0x02, 0x73, 0xa6, 0x1c, 0x28, 0xf4, 0x7b, 0xa9, 0x9a, 0xbc, 0x97, 0xaa, 0xc7, 0xc9,
-	0x7b, 0xa9, 0x9e, 0x95, 0x3d, 0xf8, 0xe9, 0xa7, 0x15, 0x0b, 0xeb, 0x25, 0xe6, 0xc4, 0x03, 0x58,
-	0xd7, 0x7b, 0x8d, 0xcf, 0x45, 0x25, 0x58, 0x25, 0xae, 0xcb, 0xa9, 0x10, 0xe6, 0x62, 0x4d, 0x48,
-	0xf9, 0x88, 0x88, 0xc2, 0x4b, 0xca, 0x35, 0x0e, 0xb0, 0x26, 0xf6, 0x7e, 0x97, 0x81, 0x95, 0x0e,
-	0xe1, 0xc4, 0x17, 0x68, 0x1f, 0x5e, 0xf3, 0xc9, 0xd0, 0x9e, 0x9a, 0x97, 0x4c, 0x79, 0xa9, 0x24,
-	0x60, 0xe4, 0x93, 0xe1, 0x64, 0x4e, 0xd2, 0x85, 0xb6, 0x07, 0x6b, 0x72, 0xc9, 0xa4, 0xfc, 0xf5,
-	0xde, 0x79, 0x9f, 0x0c, 0x0f, 0x12, 0x04, 0xdc, 0x83, 0x4d, 0xa9, 0x93, 0xc0, 0xc5, 0x16, 0xec,
-	0xdd, 0x24, 0x84, 0xeb, 0x3e, 0x19, 0x36, 0x0c, 0xbf, 0xcb, 0xde, 0xa5, 0xa8, 0x06, 0x5b, 0xca,
-	0x04, 0x75, 0x37, 0xdb, 0x13, 0x75, 0x8d, 0x2a, 0xb9, 0x8f, 0xbe, 0xb6, 0x9b, 0xc9, 0x82, 0xef,
-	0xc0, 0x4d, 0x3a, 0x8c, 0x18, 0x27, 0xf2, 0x6d, 0x63, 0xf7, 0xbc, 0xd0, 0x39, 0x9f, 0xc1, 0xda,
-	0xd6, 0x44, 0x5a, 0x97, 0x42, 0x6d, 0xd2, 0x1b, 0x50, 0x94, 0xf7, 0x9c, 0x1d, 0x5e, 0x12, 0xe1,
-	0xab, 0x8b, 0x47, 0x61, 0x0f, 0x17, 0x24, 0xf7, 0x48, 0x32, 0xe5, 0xd5, 0xf3, 0x00, 0x6e, 0x45,
-	0x94, 0x4f, 0x46, 0xdf, 0x71, 0x54, 0x26, 0x57, 0xd9, 0xcd, 0x88, 0xf2, 0x71, 0xec, 0x4d, 0x64,
-	0xe4, 0xd2, 0x6f, 0x02, 0x12, 0xc4, 0x8f, 0x3c, 0x59, 0xc5, 0x31, 0xbf, 0x32, 0x26, 0xe9, 0xdb,
-	0x6d, 0x23, 0x91, 0x1c, 0xf3, 0x2b, 0x6d, 0xce, 0xf7, 0xa0, 0x64, 0x9a, 0x15, 0xa7, 0x97, 0x84,
-	0xbb, 0x76, 0x44, 0xb9, 0x43, 0x83, 0x98, 0xf4, 0x35, 0x2e, 0x33, 0xf8, 0x66, 0x68, 0xee, 0x12,
-	0x29, 0xee, 0x8c, 0xa5, 0xe8, 0x21, 0xdc, 0x62, 0x81, 0x2e, 0x2f, 0x3b, 0xa2, 0x01, 0xf1, 0xe2,
-	0x2b, 0xdb, 0x1d, 0x68, 0x7f, 0xcd, 0x68, 0xb9, 0x9d, 0x28, 0x74, 0xb4, 0xbc, 0x69, 0xc4, 0xa8,
-	0x05, 0x37, 0xe4, 0x54, 0x9b, 0x38, 0x45, 0x03, 0xd2, 0xf3, 0xa8, 0xab, 0x50, 0x9a, 0xad, 0xbf,
-	0x36, 0xba, 0xae, 0x6c, 0xb6, 0xeb, 0x0d, 0xe3, 0x53, 0x4b, 0x0b, 0xf1, 0x26, 0xeb, 0x39, 0xb3,
-	0x2c, 0x53, 0x89, 0xdf, 0x07, 0xd4, 0xa1, 0x81, 0xab, 0x61, 0x2c, 0xd1, 0x7f, 0xc8, 0x84, 0xba,
-	0xfe, 0x27, 0xfd, 0x4d, 0x16, 0x64, 0x5a, 0xde, 0xee, 0xe3, 0x26, 0x96, 0x0c, 0x5f, 0x3f, 0x81,
-	0xa9, 0x61, 0x19, 0x6d, 0xc3, 0xaa, 0xca, 0x7e, 0xd2, 0xe3, 0xf1, 0x8a, 0x24, 0xdb, 0xae, 0x04,
-	0xa1, 0x19, 0xc1, 0x93, 0x6e, 0x9e, 0xc3, 0x39, 0xc3, 0x19, 0x5f, 0xbc, 0x1f, 0xa5, 0xe0, 0x86,
-	0xb1, 0xf0, 0x09, 0xe5, 0xec, 0x94, 0x39, 0xda, 0xdb, 0xaf, 0x41, 0x56, 0xf5, 0x86, 0xc9, 0xd5,
-	0x91, 0x1f, 0x5d, 0x57, 0x56, 0x1b, 0x92, 0xd7, 0x6e, 0xe2, 0x55, 0x25, 0x6c, 0xbb, 0xb3, 0xa3,
-	0x69, 0x6a, 0x7e, 0x34, 0x9d, 0x6d, 0xd8, 0xe9, 0x17, 0x69, 0xd8, 0x73, 0x8f, 0xc7, 0xcc, 0x2b,
-	0xbf, 0x79, 0x97, 0x5f, 0xe6, 0xcd, 0x6b, 0xa2, 0xf4, 0x1b, 0x0b, 0xf2, 0x1d, 0xce, 0x1c, 0x6a,
-	0x9a, 0xae, 0x7c, 0xf8, 0x5f, 0xf9, 0xbd, 0xd0, 0x4b, 0x42, 0xae, 0x29, 0xb4, 0x03, 0xe0, 0x0f,
-	0xbc, 0x98, 0x45, 0x1e, 0x1b, 0x37, 0x8e, 0x29, 0x0e, 0x2a, 0x42, 0x2a, 0x1a, 0x1a, 0x30, 0xa7,
-	0xa2, 0xe1, 0x5c, 0x7c, 0x32, 0x2f, 0x12, 0x9f, 0xe7, 0x8f, 0x1b, 0x7b, 0x4f, 0x2d, 0x28, 0x8f,
-	0x87, 0xaa, 0x81, 0x17, 0xcb, 0x9e, 0x4e, 0xe2, 0x01, 0xa7, 0x47, 0x5c, 0x3e, 0x77, 0x5e, 0x7e,
-	0x68, 0x43, 0xfb, 0xb0, 0x9a, 0x4c, 0x98, 0xa9, 0xcf, 0x9d, 0x30, 0x71, 0xa2, 0xf7, 0x30, 0xf3,
-	0xde, 0x87, 0x95, 0xa5, 0x7b, 0xff, 0xb2, 0x60, 0x6d, 0xe6, 0xfa, 0x43, 0x3f, 0x80, 0x0a, 0x6e,
-	0x75, 0x8f, 0x0e, 0x9f, 0xb4, 0xec, 0xee, 0xf1, 0xc1, 0xf1, 0x49, 0xd7, 0x3e, 0xea, 0xb4, 0x1e,
-	0xdb, 0x27, 0x8f, 0xbb, 0x9d, 0x56, 0xa3, 0xfd, 0xa8, 0xdd, 0x6a, 0x6e, 0x2c, 0x95, 0xb7, 0xdf,
-	0xff, 0x60, 0xf7, 0xc6, 0x02, 0x35, 0xf4, 0x5d, 0xb8, 0x39, 0xc7, 0xee, 0x9e, 0x34, 0x1a, 0xad,
-	0x6e, 0x77, 0xc3, 0x2a, 0x97, 0xdf, 0xff, 0x60, 0xf7, 0x3f, 0x48, 0x17, 0xac, 0x7b, 0x74, 0xd0,
-	0x3e, 0x3c, 0xc1, 0xad, 0x8d, 0xd4, 0xc2, 0x75, 0x46, 0xba, 0x60, 0x5d, 0xeb, 0xa7, 0x9d, 0x36,
-	0x6e, 0x35, 0x37, 0xd2, 0x0b, 0xd7, 0x19, 0x69, 0x39, 0xf3, 0xde, 0x47, 0x3b, 0x4b, 0xf7, 0xde,
-	0x81, 0xd5, 0xe4, 0xad, 0xb9, 0x0d, 0x37, 0x5a, 0x8f, 0x1b, 0x47, 0xcd, 0x16, 0x9e, 0x75, 0x15,
-	0x6d, 0xc2, 0x5a, 0x22, 0xe8, 0xe0, 0xa3, 0xe3, 0xa3, 0x0d, 0x0b, 0x6d, 0xc1, 0x46, 0xc2, 0x7a,
-	0x74, 0x72, 0x78, 0x68, 0x1f, 0xd4, 0xdb, 0x1b, 0xa9, 0xe9, 0x1d, 0x3a, 0x07, 0xf8, 0xb8, 0x7d,
-	0xa0, 0x05, 0x69, 0x7d, 0x56, 0xbd, 0xfd, 0xf1, 0x68, 0xc7, 0xfa, 0x64, 0xb4, 0x63, 0xfd, 0x75,
-	0xb4, 0x63, 0x3d, 0x7d, 0xb6, 0xb3, 0xf4, 0xc9, 0xb3, 0x9d, 0xa5, 0x3f, 0x3f, 0xdb, 0x59, 0xfa,
-	0x59, 0xed, 0x0b, 0x0c, 0x03, 0xe6, 0x8f, 0x4c, 0x35, 0x0b, 0xf4, 0x56, 0x94, 0xc6, 0x9b, 0xff,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xb1, 0x6d, 0x99, 0xe4, 0x14, 0x00, 0x00,
+	// 2658 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcd, 0x6f, 0x63, 0x57,
+	0x15, 0x9f, 0x67, 0x3b, 0x89, 0x7d, 0x1c, 0x3b, 0xce, 0x9d, 0x4c, 0xe2, 0x64, 0x32, 0x71, 0x3a,
+	0x6a, 0x61, 0x18, 0xda, 0xb8, 0x33, 0x2d, 0x15, 0x1d, 0xbe, 0x1a, 0x3b, 0x4e, 0x6b, 0x9a, 0x4e,
+	0xac, 0xe7, 0xcc, 0x14, 0x21, 0xd0, 0xd3, 0xf5, 0x7b, 0x37, 0xc9, 0x6d, 0x9e, 0xdf, 0x33, 0xf7,
+	0x3e, 0x27, 0x4e, 0x77, 0xec, 0xaa, 0xae, 0xba, 0x60, 0x85, 0x54, 0xa9, 0x52, 0x77, 0x88, 0x0d,
+	0xa2, 0xf0, 0x2f, 0x50, 0x56, 0x54, 0x5d, 0x21, 0x21, 0x52, 0x48, 0x25, 0x84, 0xd8, 0xb3, 0x81,
+	0x0d, 0xba, 0x1f, 0xef, 0xcb, 0x49, 0x27, 0xed, 0x4c, 0x5b, 0x01, 0xab, 0xf8, 0x7c, 0xdc, 0x7b,
+	0xcf, 0x3d, 0xf7, 0x9c, 0xdf, 0x39, 0xe7, 0x05, 0xae, 0xf6, 0xb0, 0xe7, 0xd4, 0x7d, 0x86, 0x6d,
+	0x97, 0xd4, 0x0f, 0x6f, 0xe9, 0x5f, 0x6b, 0x03, 0xe6, 0x07, 0x3e, 0x2a, 0x0b, 0xe1, 0x9a, 0x66,
+	0x1d, 0xde, 0x5a, 0x9a, 0xdb, 0xf3, 0xf7, 0x7c, 0x29, 0xaa, 0x8b, 0x5f, 0x4a, 0x6b, 0xa9, 0xb6,
+	0xe7, 0xfb, 0x7b, 0x2e, 0xa9, 0x4b, 0xaa, 0x37, 0xdc, 0xad, 0x07, 0xb4, 0x4f, 0x78, 0x80, 0xfb,
+	0x03, 0xad, 0xb0, 0x62, 0xfb, 0xbc, 0xef, 0xf3, 0x7a, 0x0f, 0x73, 0x71, 0x46, 0x8f, 0x04, 0xf8,
+	0x56, 0xdd, 0xf6, 0xa9, 0xa7, 0xe5, 0x8b, 0x4a, 0x6e, 0xa9, 0x9d, 0x15, 0xa1, 0x44, 0xd7, 0xff,
+	0x69, 0x00, 0x6c, 0xe0, 0x00, 0x77, 0xfd, 0x21, 0xb3, 0x09, 0x9a, 0x83, 0x09, 0xff, 0xc8, 0x23,
+	0xac, 0x6a, 0xac, 0x1a, 0x37, 0x0a, 0xa6, 0x22, 0x10, 0x82, 0x9c, 0x87, 0xfb, 0xa4, 0x9a, 0x91,
+	0x4c, 0xf9, 0x1b, 0xad, 0x42, 0xd1, 0x21, 0xdc, 0x66, 0x74, 0x10, 0x50, 0xdf, 0xab, 0x66, 0xa5,
+	0x28, 0xc9, 0x42, 0x4b, 0x90, 0xdf, 0xa5, 0x2e, 0x91, 0x2b, 0x73, 0x52, 0x1c, 0xd1, 0x42, 0x16,
+	0x30, 0x82, 0xf9, 0x90, 0x1d, 0x57, 0x27, 0x94, 0x2c, 0xa4, 0xd1, 0x8f, 0x21, 0xbb, 0x4b, 0x48,
+	0x75, 0x72, 0x35, 0x7b, 0xa3, 0x78, 0x7b, 0x71, 0x4d, 0x9b, 0x2b, 0xee, 0xb6, 0xa6, 0xef, 0xb6,
+	0xd6, 0xf4, 0xa9, 0xd7, 0x78, 0xfa, 0xfd, 0x93, 0xda, 0xa5, 0x5f, 0x7c, 0x54, 0xbb, 0xb1, 0x47,
+	0x83, 0xfd, 0x61, 0x6f, 0xcd, 0xf6, 0xfb, 0xfa, 0x6e, 0xfa, 0xcf, 0x53, 0xdc, 0x39, 0xa8, 0x07,
+	0xc7, 0x03, 0xc2, 0xe5, 0x02, 0x6e, 0x8a, 0x7d, 0xef, 0xe4, 0xfe, 0xfe, 0x4e, 0xcd, 0xb8, 0xfe,
+	0x07, 0x03, 0xa6, 0xb7, 0xa5, 0xdf, 0xbb, 0xd2, 0xe0, 0x2f, 0xed, 0xe6, 0xf3, 0x30, 0xc9, 0xed,
+	0x7d, 0xd2, 0xc7, 0xfa, 0xde, 0x9a, 0x42, 0xcf, 0xc3, 0x0c, 0x97, 0x6f, 0x60, 0xd9, 0xbe, 0x43,
+	0xac, 0x21, 0x73, 0xab, 0x93, 0x42, 0xa1, 0x31, 0x7b, 0x7a, 0x52, 0x2b, 0xa9, 0xe7, 0x69, 0xfa,
+	0x0e, 0xb9, 0x67, 0x6e, 0x99, 0x25, 0x1e, 0x93, 0xcc, 0xd5, 0x37, 0xfa, 0x8d, 0x01, 0x60, 0xe2,
+	0x23, 0x93, 0xfc, 0x64, 0x48, 0x78, 0x80, 0xbe, 0x03, 0x45, 0x32, 0x0a, 0x08, 0xf3, 0xb0, 0x6b,
+	0x51, 0x47, 0xde, 0x2a, 0xd7, 0x58, 0x3e, 0x3d, 0xa9, 0x41, 0x4b, 0xb3, 0xdb, 0x1b, 0xff, 0x4a,
+	0x51, 0x26, 0x84, 0x0b, 0xda, 0x0e, 0xda, 0x84, 0xb2, 0x83, 0x03, 0x6c, 0x69, 0x9b, 0xa8, 0x23,
+	0x5d, 0x90, 0x6b, 0xac, 0x9e, 0x9e, 0xd4, 0xa6, 0xe3, 0x80, 0x91, 0x7b, 0xa4, 0x68, 0x73, 0xda,
+	0x89, 0x29, 0x47, 0xb8, 0xc2, 0xc6, 0xae, 0x2b, 0x78, 0xd2, 0x53, 0xd3, 0x66, 0x44, 0x6b, 0xbb,
+	0x7f, 0x6a, 0x40, 0x41, 0xda, 0x3d, 0xf0, 0xd9, 0x23, 0x9b, 0x7d, 0x15, 0x0a, 0x64, 0x44, 0x03,
+	0xe9, 0x43, 0x69, 0x71, 0xc9, 0xcc, 0x0b, 0x86, 0x70, 0x95, 0x78, 0xcc, 0x84, 0x1d, 0xb9, 0x84,
+	0x0d, 0x7f, 0x9d, 0x80, 0xa9, 0xd0, 0x71, 0x77, 0xa1, 0xa2, 0x12, 0xd2, 0x52, 0x0f, 0x1a, 0x9b,
+	0xf1, 0xf8, 0xe9, 0x49, 0xad, 0x9c, 0x0c, 0x1a, 0x69, 0xca, 0x18, 0xc7, 0x2c, 0xfb, 0x49, 0x3a,
+	0xed, 0x81, 0x4c, 0xda, 0x03, 0xe8, 0x16, 0xcc, 0x31, 0x75, 0x2c, 0x71, 0xac, 0x43, 0xec, 0x52,
+	0x07, 0x07, 0x3e, 0xe3, 0xd5, 0xec, 0x6a, 0xf6, 0x46, 0xc1, 0xbc, 0x1c, 0xc9, 0xee, 0x47, 0x22,
+	0x71, 0xc3, 0x3e, 0xf5, 0x2c, 0xdb, 0x1f, 0x7a, 0x81, 0x0c, 0xae, 0x9c, 0x99, 0xef, 0x53, 0xaf,
+	0x29, 0x68, 0xf4, 0x04, 0x94, 0xf5, 0x1a, 0x6b, 0x9f, 0xd0, 0xbd, 0xfd, 0x40, 0x06, 0x59, 0xd6,
+	0x2c, 0x69, 0xee, 0x4b, 0x92, 0x89, 0x1e, 0x83, 0xe9, 0x50, 0x4d, 0x40, 0x89, 0x0c, 0xb4, 0xac,
+	0x59, 0xd4, 0xbc, 0x1d, 0xda, 0x27, 0xe8, 0x6b, 0x50, 0xb0, 0x5d, 0x4a, 0x3c, 0x79, 0xfd, 0x29,
+	0x19, 0x88, 0xd3, 0xa7, 0x27, 0xb5, 0x7c, 0x53, 0x32, 0xdb, 0x1b, 0x66, 0x5e, 0x89, 0xdb, 0x0e,
+	0x6a, 0xc2, 0x34, 0xc3, 0x47, 0x96, 0x5e, 0xcd, 0xab, 0x79, 0x99, 0xb8, 0x4b, 0x6b, 0x69, 0x6c,
+	0x5b, 0x8b, 0x63, 0xb3, 0x91, 0x13, 0x99, 0x6b, 0x16, 0x59, 0xc4, 0xe1, 0xe8, 0x65, 0x28, 0xd2,
+	0x9e, 0x6d, 0xd9, 0xfb, 0xd8, 0xf3, 0x88, 0x5b, 0x2d, 0xac, 0x1a, 0xe7, 0xed, 0xd1, 0x6e, 0x34,
+	0x9b, 0x4a, 0xa3, 0x51, 0x16, 0x31, 0x11, 0xd3, 0x26, 0xd0, 0x9e, 0xad, 0x7f, 0xa3, 0x9a, 0x08,
+	0x22, 0x62, 0x0f, 0x03, 0x62, 0xed, 0x61, 0x5e, 0x05, 0xe9, 0x25, 0xd0, 0xac, 0x17, 0x31, 0x47,
+	0x2f, 0x41, 0x31, 0xe0, 0xdc, 0x22, 0x9e, 0x88, 0x13, 0x56, 0x2d, 0xae, 0x1a, 0x37, 0xca, 0xb7,
+	0x17, 0xc6, 0x4f, 0x6b, 0x29, 0xb1, 0x3a, 0x6a, 0xa7, 0xdb, 0xd5, 0xb4, 0x09, 0x01, 0xe7, 0xfa,
+	0x37, 0x5a, 0x86, 0x42, 0xf8, 0x4a, 0xac, 0x3a, 0x2d, 0x33, 0x3a, 0x66, 0xa0, 0x7d, 0x28, 0xec,
+	0x12, 0x62, 0xb9, 0xb4, 0x4f, 0x83, 0x6a, 0xe9, 0xf3, 0x07, 0xb4, 0xfc, 0x2e, 0x21, 0x5b, 0x62,
+	0xf3, 0x30, 0xca, 0x7a, 0xd8, 0x3e, 0xa8, 0x96, 0x15, 0xe4, 0x84, 0xb4, 0x8e, 0xf1, 0x5f, 0x65,
+	0xa0, 0x14, 0xbf, 0xc1, 0x26, 0x21, 0xff, 0x2d, 0x10, 0xf1, 0x6c, 0xa2, 0x16, 0x48, 0x30, 0x6d,
+	0x54, 0x3f, 0x7c, 0xef, 0xa9, 0x39, 0xed, 0xa6, 0x75, 0xc7, 0x61, 0x84, 0xf3, 0x6e, 0xc0, 0xa8,
+	0xb7, 0x77, 0xb6, 0x4a, 0xe4, 0xbe, 0xd0, 0x2a, 0xf1, 0xdb, 0x0c, 0x14, 0x36, 0x09, 0x69, 0x71,
+	0x9b, 0xf9, 0x47, 0xe8, 0x79, 0x80, 0x30, 0x6d, 0x22, 0x77, 0x2d, 0x9d, 0x9e, 0xd4, 0x0a, 0xda,
+	0xa7, 0xf2, 0xa6, 0x31, 0x11, 0x05, 0x42, 0xdb, 0x41, 0x6b, 0x30, 0x31, 0xc0, 0xc7, 0x84, 0xa9,
+	0x42, 0xf2, 0x80, 0x0b, 0x2a, 0x35, 0xf4, 0x0a, 0x54, 0x12, 0x39, 0x65, 0xed, 0x12, 0xa2, 0x40,
+	0xa1, 0x78, 0xfb, 0xda, 0x27, 0xe7, 0xd5, 0x26, 0x21, 0x3a, 0xb5, 0xca, 0x2c, 0xc9, 0xe4, 0x88,
+	0x8a, 0x28, 0xed, 0x63, 0xea, 0x51, 0x6f, 0xef, 0x8b, 0x70, 0x59, 0xbc, 0xbb, 0x76, 0xdc, 0x3f,
+	0x26, 0xa0, 0x62, 0x12, 0x7b, 0xc8, 0xe4, 0xa5, 0x34, 0xb2, 0x3e, 0x09, 0x99, 0x54, 0x98, 0x65,
+	0xa4, 0xc3, 0xd0, 0xb8, 0x5e, 0x7b, 0xc3, 0xcc, 0x50, 0xe7, 0x5c, 0x1c, 0xce, 0x7c, 0x4e, 0x38,
+	0x3c, 0x56, 0x89, 0x04, 0xa8, 0x62, 0x7e, 0x90, 0x06, 0x55, 0xcc, 0x0f, 0x14, 0xa8, 0xa6, 0x10,
+	0x77, 0x62, 0x0c, 0x71, 0x53, 0x38, 0x39, 0xf9, 0x40, 0x9c, 0x4c, 0x81, 0xc1, 0xd4, 0x17, 0x09,
+	0x06, 0x35, 0x28, 0x0e, 0x18, 0x19, 0x60, 0xa6, 0xf0, 0x2f, 0xaf, 0xf0, 0x4f, 0xb3, 0x04, 0xfe,
+	0x8d, 0x01, 0x64, 0xe1, 0x22, 0x80, 0x84, 0x87, 0x07, 0xc8, 0x24, 0x30, 0x15, 0xd3, 0xc0, 0x24,
+	0x64, 0xd4, 0x0b, 0x08, 0x3b, 0xc4, 0xae, 0xc4, 0xce, 0x9c, 0x19, 0xd1, 0xc2, 0x44, 0x8f, 0x8c,
+	0xa2, 0x3a, 0x56, 0x92, 0x25, 0x0a, 0x04, 0x4b, 0x17, 0xb1, 0x6f, 0x28, 0x77, 0xaa, 0xb4, 0x2a,
+	0x5f, 0x84, 0x1b, 0xbb, 0x84, 0x74, 0x64, 0x66, 0xad, 0x85, 0x7d, 0xde, 0xcc, 0x45, 0x99, 0xa8,
+	0x3a, 0xc0, 0xab, 0x50, 0xa0, 0xdc, 0xc2, 0x76, 0x40, 0x0f, 0x49, 0xb5, 0xb2, 0x6a, 0xdc, 0xc8,
+	0x9b, 0x79, 0xca, 0xd7, 0x25, 0xad, 0x83, 0xfd, 0xe7, 0x06, 0x4c, 0xea, 0xf6, 0x65, 0x19, 0x0a,
+	0x51, 0x19, 0xd7, 0x9d, 0x64, 0xcc, 0x40, 0x37, 0x61, 0x96, 0x7a, 0x56, 0x8f, 0xec, 0xfa, 0x8c,
+	0x58, 0x8c, 0x70, 0xdf, 0x3d, 0x54, 0x5d, 0x4a, 0xde, 0x9c, 0xa1, 0x5e, 0x43, 0xf2, 0x4d, 0xc5,
+	0x46, 0x2f, 0x40, 0x51, 0x21, 0x80, 0xd8, 0x37, 0x4c, 0xfe, 0xc5, 0x73, 0x93, 0x5f, 0x68, 0xe8,
+	0xc4, 0x07, 0x16, 0x32, 0xb8, 0x36, 0xee, 0x6f, 0x59, 0x58, 0x50, 0x99, 0xa1, 0xd3, 0xab, 0x83,
+	0xed, 0x03, 0x12, 0x08, 0x3c, 0x4e, 0x07, 0xaf, 0xf1, 0xc0, 0xe0, 0xfd, 0xdf, 0xc8, 0xc6, 0x54,
+	0x8a, 0x4d, 0x7e, 0x89, 0x29, 0x36, 0x75, 0x51, 0x8a, 0xe5, 0x2f, 0x4a, 0xb1, 0xc2, 0x43, 0xa7,
+	0x98, 0x7e, 0x68, 0x02, 0xd7, 0xcf, 0x79, 0xe7, 0x75, 0xfb, 0xc0, 0xf3, 0x8f, 0x5c, 0xe2, 0xec,
+	0x91, 0x3e, 0xf1, 0x82, 0x47, 0xa8, 0x61, 0xfa, 0x98, 0x9f, 0x19, 0xf0, 0xf8, 0xc5, 0xe7, 0xdc,
+	0xbf, 0xfd, 0x28, 0xd5, 0xf2, 0xeb, 0x30, 0x4b, 0x46, 0x03, 0xca, 0xb0, 0x98, 0xa6, 0x42, 0x04,
+	0xc8, 0x48, 0x04, 0xa8, 0xc4, 0x02, 0x85, 0x03, 0xda, 0xac, 0xdf, 0x65, 0xa0, 0x1a, 0x9a, 0xc5,
+	0x07, 0xbe, 0xc7, 0xc9, 0xc3, 0xc5, 0x79, 0xda, 0xea, 0xcc, 0x67, 0xb1, 0x5a, 0x84, 0xad, 0xc7,
+	0x75, 0x64, 0x66, 0x75, 0xd8, 0x7a, 0x5c, 0x45, 0xe6, 0x78, 0xcb, 0x9d, 0x3b, 0xdb, 0x72, 0x4b,
+	0x15, 0x99, 0xfc, 0x4a, 0x65, 0x22, 0x54, 0x91, 0x3c, 0xa9, 0xb2, 0x21, 0xfa, 0x7b, 0xa5, 0xc2,
+	0x03, 0x1c, 0x0c, 0xb9, 0x2c, 0x39, 0xe5, 0x73, 0x9a, 0x02, 0xa5, 0xd5, 0x95, 0x4a, 0xa2, 0xfd,
+	0x4f, 0x90, 0x62, 0x04, 0x65, 0x84, 0x0f, 0xdd, 0x40, 0x86, 0xed, 0xb4, 0xa9, 0x29, 0xed, 0xc9,
+	0x3f, 0x65, 0x05, 0x9a, 0x09, 0xc6, 0xff, 0x1f, 0x3e, 0xa4, 0x5f, 0x77, 0xf2, 0xa1, 0x5f, 0x77,
+	0xea, 0x82, 0xd7, 0xcd, 0x5f, 0xfc, 0xba, 0x85, 0x4f, 0xf3, 0xba, 0xf0, 0x48, 0xaf, 0x5b, 0x3c,
+	0xe7, 0x75, 0x7f, 0x6f, 0x40, 0xa9, 0x4b, 0xf7, 0x3c, 0xd9, 0x6e, 0xc9, 0x47, 0x7e, 0x0d, 0x80,
+	0x2b, 0x46, 0x9c, 0xa7, 0x2f, 0x0b, 0x9f, 0x68, 0x35, 0xe9, 0x93, 0x3b, 0x09, 0x88, 0x14, 0xc6,
+	0xc8, 0x8f, 0x47, 0xb6, 0xef, 0xd6, 0xed, 0x7d, 0x4c, 0xbd, 0xfa, 0xe1, 0x33, 0xf5, 0x91, 0xe4,
+	0x07, 0x9c, 0x6b, 0xc0, 0x8c, 0x56, 0x9b, 0x05, 0xbd, 0x7d, 0xdb, 0x41, 0x5f, 0x85, 0x19, 0xc2,
+	0x98, 0xcf, 0xe4, 0x7c, 0xce, 0x07, 0xd8, 0x0e, 0xbf, 0xac, 0x94, 0x25, 0xbb, 0x19, 0x72, 0xd1,
+	0x35, 0x80, 0x58, 0x51, 0x27, 0x53, 0x21, 0xd2, 0xd1, 0x77, 0x19, 0xc0, 0x4c, 0x34, 0x18, 0xeb,
+	0xcb, 0xa7, 0xaa, 0xb5, 0x91, 0xae, 0xd6, 0xe8, 0x0e, 0x4c, 0x70, 0xea, 0xe9, 0x33, 0xc5, 0x74,
+	0xa9, 0xbe, 0xab, 0xad, 0x85, 0xdf, 0xd5, 0xd6, 0x76, 0xc2, 0xef, 0x6a, 0x8d, 0xbc, 0x28, 0x0d,
+	0x6f, 0x7d, 0x54, 0x33, 0x4c, 0xb5, 0x44, 0x9f, 0xb8, 0x0e, 0x33, 0x6a, 0xaf, 0xe8, 0x5c, 0x54,
+	0x85, 0x29, 0xac, 0xfa, 0x06, 0x5d, 0xef, 0x43, 0x12, 0xcd, 0xc1, 0xc4, 0xc0, 0x3f, 0xd2, 0x3d,
+	0x7f, 0xce, 0x54, 0xc4, 0xf5, 0x5f, 0x16, 0x61, 0xb2, 0x83, 0x19, 0xee, 0x73, 0x74, 0x0b, 0xae,
+	0xf4, 0xf1, 0xc8, 0x4a, 0x36, 0xfa, 0x2a, 0xbc, 0xe4, 0x23, 0x98, 0xa8, 0x8f, 0x47, 0x71, 0x73,
+	0xaf, 0x02, 0xed, 0x3a, 0x94, 0xc4, 0x92, 0x38, 0xfc, 0xd5, 0xde, 0xc5, 0x3e, 0x1e, 0xad, 0x87,
+	0x19, 0x70, 0x13, 0x66, 0x85, 0x4e, 0x98, 0x2e, 0x16, 0xa7, 0xaf, 0x87, 0x2e, 0x9c, 0xe9, 0xe3,
+	0x51, 0x53, 0xf3, 0xbb, 0xf4, 0x75, 0x82, 0xea, 0x30, 0x27, 0x4d, 0x90, 0x2d, 0x83, 0x15, 0xab,
+	0xab, 0xac, 0x12, 0xfb, 0xa8, 0x6e, 0x62, 0x23, 0x5c, 0xf0, 0x2c, 0xcc, 0x27, 0xa0, 0xb9, 0xe7,
+	0xfa, 0xf6, 0x41, 0x2a, 0xd7, 0xe6, 0x62, 0x69, 0x43, 0x08, 0x95, 0x49, 0x8f, 0x43, 0x59, 0x94,
+	0x5f, 0xcb, 0x3f, 0xc2, 0xbc, 0x2f, 0xeb, 0xa1, 0xcc, 0x3d, 0x73, 0x5a, 0x70, 0xb7, 0x05, 0x53,
+	0x54, 0xc4, 0xe7, 0x61, 0x71, 0x40, 0x58, 0xfc, 0x1d, 0x24, 0xf2, 0x4a, 0x5c, 0x61, 0xe7, 0x07,
+	0x84, 0x45, 0xbe, 0xd7, 0x9e, 0x11, 0x4b, 0x9f, 0x04, 0xc4, 0x71, 0x7f, 0xe0, 0x8a, 0x28, 0x0e,
+	0xd8, 0xb1, 0x36, 0x49, 0x15, 0xdd, 0x4a, 0x28, 0xd9, 0x61, 0xc7, 0xca, 0x9c, 0x6f, 0x42, 0x55,
+	0x83, 0x15, 0x23, 0x47, 0x98, 0x39, 0xd6, 0x80, 0x30, 0x9b, 0x78, 0x01, 0xde, 0x23, 0xba, 0x17,
+	0x9e, 0xf7, 0x75, 0x2d, 0x11, 0xe2, 0x4e, 0x24, 0x45, 0x77, 0x60, 0x91, 0x7a, 0x2a, 0xbc, 0xac,
+	0x01, 0xf1, 0xb0, 0x1b, 0x1c, 0x5b, 0xce, 0x50, 0xdd, 0x57, 0x7f, 0x67, 0x58, 0x08, 0x15, 0x3a,
+	0x4a, 0xbe, 0xa1, 0xc5, 0xa8, 0x05, 0x97, 0x69, 0xcf, 0x8e, 0x2e, 0x45, 0x3c, 0xdc, 0x73, 0x89,
+	0x23, 0xb3, 0x34, 0xdf, 0xb8, 0x72, 0x7a, 0x52, 0x9b, 0x6d, 0x37, 0x9a, 0xfa, 0x4e, 0x2d, 0x25,
+	0x34, 0x67, 0x69, 0xcf, 0x4e, 0xb3, 0xd0, 0x0b, 0xb0, 0xcc, 0x88, 0x4b, 0x71, 0x8f, 0xba, 0x34,
+	0x38, 0xb6, 0xa2, 0x6b, 0x87, 0xfb, 0x4d, 0xcb, 0xa8, 0x5f, 0x4a, 0xe8, 0x74, 0xb5, 0x4a, 0xb8,
+	0x43, 0x03, 0xae, 0x25, 0x77, 0x38, 0x92, 0x75, 0x34, 0xe9, 0x83, 0x92, 0xbc, 0xc8, 0xd5, 0x84,
+	0xd2, 0xab, 0x52, 0x27, 0xe1, 0x88, 0xe7, 0x60, 0x41, 0xe1, 0x8a, 0xc5, 0x48, 0x40, 0x3c, 0x19,
+	0x0d, 0x03, 0xc2, 0xa8, 0xef, 0xc8, 0x5e, 0x3c, 0x67, 0x5e, 0x51, 0x62, 0x33, 0x94, 0x76, 0xa4,
+	0x50, 0xc4, 0xcf, 0x99, 0x75, 0xea, 0xb1, 0x66, 0x54, 0xfc, 0x8c, 0x2d, 0x53, 0x0f, 0xf6, 0x5d,
+	0x58, 0x16, 0x61, 0x3a, 0x60, 0x43, 0x8f, 0x38, 0x96, 0x52, 0xe1, 0xe2, 0x3c, 0x15, 0x81, 0xb2,
+	0x2f, 0xcf, 0x99, 0xd5, 0x3e, 0x1e, 0x75, 0xa4, 0x8a, 0x82, 0x36, 0xde, 0x21, 0x4c, 0x06, 0x21,
+	0xb2, 0xe0, 0x5a, 0xd2, 0xf5, 0x0c, 0x07, 0xba, 0x49, 0xb4, 0x8e, 0xa8, 0xe7, 0xf8, 0x47, 0xd5,
+	0x59, 0x09, 0x7b, 0xd7, 0x4e, 0x4f, 0x6a, 0x8b, 0xf1, 0x23, 0x98, 0x38, 0x50, 0xdd, 0xde, 0xab,
+	0x52, 0xc9, 0x5c, 0x8c, 0x1f, 0x63, 0x4c, 0x84, 0x7e, 0xa4, 0x0c, 0x4c, 0x1c, 0xa2, 0xcc, 0x0b,
+	0xbf, 0x67, 0xa1, 0x68, 0xe8, 0xad, 0xbe, 0x82, 0x47, 0xf1, 0x11, 0xc2, 0xc4, 0xf0, 0x0b, 0x96,
+	0x30, 0xbf, 0x1d, 0x9d, 0x90, 0x90, 0x20, 0x0a, 0x8f, 0x9d, 0xbb, 0xbb, 0x2b, 0x1f, 0x4e, 0xd5,
+	0xe0, 0xea, 0xe5, 0xe8, 0xe3, 0xcb, 0xf2, 0x99, 0x23, 0xb6, 0x84, 0xa2, 0xaa, 0xda, 0xe6, 0xf2,
+	0x99, 0x63, 0x12, 0x52, 0xd4, 0x84, 0x15, 0x51, 0x3e, 0x59, 0x38, 0x93, 0x47, 0x3e, 0x8b, 0x06,
+	0xb5, 0x39, 0x15, 0x1c, 0x7d, 0xea, 0x9d, 0x19, 0xdc, 0xc3, 0xd9, 0xed, 0x4d, 0x03, 0xae, 0x9e,
+	0xdd, 0x81, 0x0f, 0xf0, 0x91, 0x67, 0xed, 0x12, 0x52, 0xbd, 0xf2, 0xf9, 0x77, 0xe6, 0x55, 0x36,
+	0x66, 0x4c, 0x57, 0x9c, 0xb6, 0x49, 0x74, 0xa4, 0x26, 0xa2, 0x5d, 0x3e, 0x98, 0x42, 0xb9, 0xf9,
+	0x30, 0x52, 0xe3, 0x38, 0x97, 0x52, 0x81, 0x74, 0x1a, 0xf1, 0x5f, 0x84, 0x44, 0x56, 0xca, 0x60,
+	0x24, 0x4c, 0x94, 0x58, 0x1d, 0x37, 0x0a, 0xa9, 0x35, 0x25, 0x10, 0x3f, 0x89, 0xca, 0x8a, 0xd0,
+	0x1b, 0xfd, 0xd9, 0x80, 0xb9, 0x04, 0x72, 0x45, 0x27, 0xa2, 0xef, 0x9d, 0x19, 0x19, 0x1b, 0x8f,
+	0x7d, 0xf8, 0xde, 0x53, 0xd7, 0xb4, 0x73, 0xa2, 0x35, 0xe9, 0xe9, 0x34, 0x31, 0x55, 0x3e, 0x01,
+	0x65, 0xcc, 0x45, 0x8d, 0x25, 0x4e, 0xaa, 0x28, 0x94, 0x42, 0x6e, 0xe2, 0xdb, 0xb0, 0xc0, 0xf2,
+	0x48, 0x4d, 0xd5, 0x84, 0x52, 0xc8, 0x55, 0x6a, 0x4f, 0xc3, 0x5c, 0xe0, 0x07, 0xd8, 0x0d, 0x6b,
+	0x82, 0x8b, 0x03, 0xe2, 0xd9, 0xc7, 0xba, 0x22, 0x20, 0x29, 0x53, 0x35, 0x61, 0x4b, 0x49, 0xf4,
+	0xfd, 0xbe, 0x05, 0xa8, 0x43, 0x3c, 0x47, 0xf5, 0x15, 0xa2, 0x1d, 0xd9, 0xa2, 0x5c, 0x8e, 0x49,
+	0x71, 0xc3, 0x25, 0x2a, 0x64, 0x56, 0x4c, 0x41, 0x51, 0x57, 0x15, 0x0e, 0xa9, 0xdf, 0x87, 0xc4,
+	0xa7, 0x5c, 0xb4, 0x00, 0x53, 0xf2, 0xe8, 0xb0, 0xe9, 0x34, 0x27, 0x05, 0xd9, 0x76, 0x44, 0x57,
+	0xa0, 0x13, 0x2a, 0x6c, 0x2f, 0x0b, 0x66, 0x41, 0x73, 0xa2, 0x01, 0xe5, 0xdd, 0x0c, 0x5c, 0xd6,
+	0xef, 0x75, 0x9f, 0x30, 0xba, 0x4b, 0x6d, 0x05, 0xbf, 0x5f, 0x81, 0xbc, 0x6c, 0x56, 0xe2, 0x5e,
+	0xb6, 0x78, 0x7a, 0x52, 0x9b, 0x6a, 0x0a, 0x5e, 0x7b, 0xc3, 0x9c, 0x92, 0xc2, 0xb6, 0x93, 0x1e,
+	0xe1, 0x33, 0xe3, 0x23, 0x7c, 0xba, 0x83, 0xcc, 0x7e, 0x96, 0x0e, 0x72, 0xec, 0x73, 0x6b, 0xee,
+	0x91, 0x3f, 0xb7, 0x4e, 0x3c, 0xcc, 0xe7, 0x56, 0xed, 0xa5, 0x5f, 0x1b, 0x50, 0xec, 0x30, 0x6a,
+	0x13, 0xdd, 0x05, 0xce, 0xc3, 0x24, 0x3f, 0xee, 0xf7, 0x7c, 0x37, 0x74, 0xb9, 0xa2, 0xd0, 0x0a,
+	0x40, 0x7f, 0xe8, 0x06, 0x74, 0xe0, 0xd2, 0xa8, 0x93, 0x49, 0x70, 0x50, 0x19, 0x32, 0x83, 0x91,
+	0x8e, 0xa4, 0xcc, 0x60, 0x34, 0xe6, 0x9f, 0xdc, 0x67, 0xf1, 0xcf, 0xc5, 0xf3, 0xcf, 0xf5, 0xb7,
+	0x0c, 0x58, 0x8a, 0xa6, 0xbc, 0xa1, 0x1b, 0x88, 0x26, 0x13, 0x07, 0x43, 0x46, 0xb6, 0x99, 0x43,
+	0xd8, 0xa3, 0x8c, 0x9c, 0xb7, 0x60, 0x2a, 0x9c, 0xc4, 0x33, 0x0f, 0x9c, 0xc4, 0xcd, 0x50, 0xef,
+	0x4e, 0xee, 0x8d, 0x77, 0x6a, 0x97, 0x6e, 0xfe, 0xdb, 0x80, 0x52, 0xaa, 0x1f, 0x47, 0xdf, 0x86,
+	0x9a, 0xd9, 0xea, 0x6e, 0x6f, 0xdd, 0x6f, 0x59, 0xdd, 0x9d, 0xf5, 0x9d, 0x7b, 0x5d, 0x6b, 0xbb,
+	0xd3, 0xba, 0x6b, 0xdd, 0xbb, 0xdb, 0xed, 0xb4, 0x9a, 0xed, 0xcd, 0x76, 0x6b, 0xa3, 0x72, 0x69,
+	0x69, 0xe1, 0xcd, 0xb7, 0x57, 0x2f, 0x9f, 0xa3, 0x86, 0x9e, 0x83, 0xf9, 0x31, 0x76, 0xf7, 0x5e,
+	0xb3, 0xd9, 0xea, 0x76, 0x2b, 0xc6, 0xd2, 0xd2, 0x9b, 0x6f, 0xaf, 0x7e, 0x82, 0xf4, 0x9c, 0x75,
+	0x9b, 0xeb, 0xed, 0xad, 0x7b, 0x66, 0xab, 0x92, 0x39, 0x77, 0x9d, 0x96, 0x9e, 0xb3, 0xae, 0xf5,
+	0x83, 0x4e, 0xdb, 0x6c, 0x6d, 0x54, 0xb2, 0xe7, 0xae, 0xd3, 0xd2, 0xa5, 0xdc, 0x1b, 0xef, 0xae,
+	0x5c, 0xba, 0xf9, 0x1a, 0x4c, 0x85, 0x1f, 0xfa, 0x16, 0xe0, 0x72, 0xeb, 0x6e, 0x73, 0x7b, 0xa3,
+	0x65, 0xa6, 0xaf, 0x8a, 0x66, 0xa1, 0x14, 0x0a, 0x3a, 0xe6, 0xf6, 0xce, 0x76, 0xc5, 0x40, 0x73,
+	0x50, 0x09, 0x59, 0x9b, 0xf7, 0xb6, 0xb6, 0xac, 0xf5, 0x46, 0xbb, 0x92, 0x49, 0xee, 0xd0, 0x59,
+	0x37, 0x77, 0xda, 0xeb, 0x4a, 0x90, 0x55, 0x67, 0x35, 0xda, 0xef, 0x9f, 0xae, 0x18, 0x1f, 0x9c,
+	0xae, 0x18, 0x7f, 0x39, 0x5d, 0x31, 0xde, 0xfa, 0x78, 0xe5, 0xd2, 0x07, 0x1f, 0xaf, 0x5c, 0xfa,
+	0xe3, 0xc7, 0x2b, 0x97, 0x7e, 0x58, 0xff, 0x14, 0xd3, 0x89, 0xfe, 0x0f, 0xbc, 0xac, 0x19, 0xbd,
+	0x49, 0xa9, 0xf1, 0xcc, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x1c, 0x4a, 0x8a, 0x9d, 0x1f,
+	0x00, 0x00,
 }
 
 func (this *DataSource) Equal(that interface{}) bool {
@@ -2084,16 +2788,19 @@ func (this *Request) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Callback != that1.Callback {
+		return false
+	}
 	return true
 }
-func (this *Report) Equal(that interface{}) bool {
+func (this *RawRequestFee) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Report)
+	that1, ok := that.(*RawRequestFee)
 	if !ok {
-		that2, ok := that.(Report)
+		that2, ok := that.(RawRequestFee)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2105,30 +2812,33 @@ func (this *Report) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Validator != that1.Validator {
+	if this.ExternalID != that1.ExternalID {
 		return false
 	}
-	if this.InBeforeResolve != that1.InBeforeResolve {
+	if this.DataSourceID != that1.DataSourceID {
 		return false
 	}
-	if len(this.RawReports) != len(that1.RawReports) {
+	if this.Treasury != that1.Treasury {
 		return false
 	}
-	for i := range this.RawReports {
-		if !this.RawReports[i].Equal(&that1.RawReports[i]) {
+	if len(this.Fee) != len(that1.Fee) {
+		return false
+	}
+	for i := range this.Fee {
+		if !this.Fee[i].Equal(&that1.Fee[i]) {
 			return false
 		}
 	}
 	return true
 }
-func (this *OracleRequestPacketData) Equal(that interface{}) bool {
+func (this *FeeEscrow) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*OracleRequestPacketData)
+	that1, ok := that.(*FeeEscrow)
 	if !ok {
-		that2, ok := that.(OracleRequestPacketData)
+		that2, ok := that.(FeeEscrow)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2140,7 +2850,50 @@ func (this *OracleRequestPacketData) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ClientID != that1.ClientID {
+	if this.RequestID != that1.RequestID {
+		return false
+	}
+	if this.Payer != that1.Payer {
+		return false
+	}
+	if len(this.RawRequestFees) != len(that1.RawRequestFees) {
+		return false
+	}
+	for i := range this.RawRequestFees {
+		if !this.RawRequestFees[i].Equal(&that1.RawRequestFees[i]) {
+			return false
+		}
+	}
+	if len(this.Remaining) != len(that1.Remaining) {
+		return false
+	}
+	for i := range this.Remaining {
+		if !this.Remaining[i].Equal(&that1.Remaining[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RecurringRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RecurringRequest)
+	if !ok {
+		that2, ok := that.(RecurringRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
 		return false
 	}
 	if this.OracleScriptID != that1.OracleScriptID {
@@ -2155,6 +2908,9 @@ func (this *OracleRequestPacketData) Equal(that interface{}) bool {
 	if this.MinCount != that1.MinCount {
 		return false
 	}
+	if this.ClientID != that1.ClientID {
+		return false
+	}
 	if len(this.FeeLimit) != len(that1.FeeLimit) {
 		return false
 	}
@@ -2172,16 +2928,34 @@ func (this *OracleRequestPacketData) Equal(that interface{}) bool {
 	if this.TSSEncoder != that1.TSSEncoder {
 		return false
 	}
+	if this.Callback != that1.Callback {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.NextHeight != that1.NextHeight {
+		return false
+	}
+	if this.FeePayer != that1.FeePayer {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.IsActive != that1.IsActive {
+		return false
+	}
 	return true
 }
-func (this *OracleRequestPacketAcknowledgement) Equal(that interface{}) bool {
+func (this *Report) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*OracleRequestPacketAcknowledgement)
+	that1, ok := that.(*Report)
 	if !ok {
-		that2, ok := that.(OracleRequestPacketAcknowledgement)
+		that2, ok := that.(Report)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2193,19 +2967,30 @@ func (this *OracleRequestPacketAcknowledgement) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.RequestID != that1.RequestID {
+	if this.Validator != that1.Validator {
 		return false
 	}
+	if this.InBeforeResolve != that1.InBeforeResolve {
+		return false
+	}
+	if len(this.RawReports) != len(that1.RawReports) {
+		return false
+	}
+	for i := range this.RawReports {
+		if !this.RawReports[i].Equal(&that1.RawReports[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *OracleResponsePacketData) Equal(that interface{}) bool {
+func (this *OracleRequestPacketData) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*OracleResponsePacketData)
+	that1, ok := that.(*OracleRequestPacketData)
 	if !ok {
-		that2, ok := that.(OracleResponsePacketData)
+		that2, ok := that.(OracleRequestPacketData)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2220,26 +3005,130 @@ func (this *OracleResponsePacketData) Equal(that interface{}) bool {
 	if this.ClientID != that1.ClientID {
 		return false
 	}
-	if this.RequestID != that1.RequestID {
-		return false
-	}
-	if this.AnsCount != that1.AnsCount {
+	if this.OracleScriptID != that1.OracleScriptID {
 		return false
 	}
-	if this.RequestTime != that1.RequestTime {
+	if !bytes.Equal(this.Calldata, that1.Calldata) {
 		return false
 	}
-	if this.ResolveTime != that1.ResolveTime {
+	if this.AskCount != that1.AskCount {
 		return false
 	}
-	if this.ResolveStatus != that1.ResolveStatus {
+	if this.MinCount != that1.MinCount {
 		return false
 	}
-	if !bytes.Equal(this.Result, that1.Result) {
+	if len(this.FeeLimit) != len(that1.FeeLimit) {
 		return false
 	}
-	return true
-}
+	for i := range this.FeeLimit {
+		if !this.FeeLimit[i].Equal(&that1.FeeLimit[i]) {
+			return false
+		}
+	}
+	if this.PrepareGas != that1.PrepareGas {
+		return false
+	}
+	if this.ExecuteGas != that1.ExecuteGas {
+		return false
+	}
+	if this.TSSEncoder != that1.TSSEncoder {
+		return false
+	}
+	return true
+}
+func (this *OracleRequestPacketAcknowledgement) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleRequestPacketAcknowledgement)
+	if !ok {
+		that2, ok := that.(OracleRequestPacketAcknowledgement)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RequestID != that1.RequestID {
+		return false
+	}
+	return true
+}
+func (this *OracleRequestPacketAcknowledgementV2) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleRequestPacketAcknowledgementV2)
+	if !ok {
+		that2, ok := that.(OracleRequestPacketAcknowledgementV2)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RequestID != that1.RequestID {
+		return false
+	}
+	if this.ExpirationHeight != that1.ExpirationHeight {
+		return false
+	}
+	return true
+}
+func (this *OracleResponsePacketData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleResponsePacketData)
+	if !ok {
+		that2, ok := that.(OracleResponsePacketData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ClientID != that1.ClientID {
+		return false
+	}
+	if this.RequestID != that1.RequestID {
+		return false
+	}
+	if this.AnsCount != that1.AnsCount {
+		return false
+	}
+	if this.RequestTime != that1.RequestTime {
+		return false
+	}
+	if this.ResolveTime != that1.ResolveTime {
+		return false
+	}
+	if this.ResolveStatus != that1.ResolveStatus {
+		return false
+	}
+	if !bytes.Equal(this.Result, that1.Result) {
+		return false
+	}
+	return true
+}
 func (this *Result) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2403,6 +3292,104 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.IBCRequestEnabled != that1.IBCRequestEnabled {
 		return false
 	}
+	if this.ReliabilitySamplingEnabled != that1.ReliabilitySamplingEnabled {
+		return false
+	}
+	if this.ReliabilityWeightPercentage != that1.ReliabilityWeightPercentage {
+		return false
+	}
+	if this.ResultRetentionPeriod != that1.ResultRetentionPeriod {
+		return false
+	}
+	if this.ResultRetentionCount != that1.ResultRetentionCount {
+		return false
+	}
+	if this.MaxPrunedResultsPerBlock != that1.MaxPrunedResultsPerBlock {
+		return false
+	}
+	if this.IBCRequestRateLimitWindow != that1.IBCRequestRateLimitWindow {
+		return false
+	}
+	if this.MaxIBCRequestsPerChannel != that1.MaxIBCRequestsPerChannel {
+		return false
+	}
+	if this.MaxIBCRequestsPerLightClient != that1.MaxIBCRequestsPerLightClient {
+		return false
+	}
+	if this.MinRecurringRequestInterval != that1.MinRecurringRequestInterval {
+		return false
+	}
+	if len(this.RecurringRequestSpawnFee) != len(that1.RecurringRequestSpawnFee) {
+		return false
+	}
+	for i := range this.RecurringRequestSpawnFee {
+		if !this.RecurringRequestSpawnFee[i].Equal(&that1.RecurringRequestSpawnFee[i]) {
+			return false
+		}
+	}
+	if this.ReliabilityWindowSize != that1.ReliabilityWindowSize {
+		return false
+	}
+	return true
+}
+func (this *IBCRequestCounter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IBCRequestCounter)
+	if !ok {
+		that2, ok := that.(IBCRequestCounter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+func (this *ValidatorReliability) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorReliability)
+	if !ok {
+		that2, ok := that.(ValidatorReliability)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	if this.AssignedCount != that1.AssignedCount {
+		return false
+	}
+	if this.ReportedCount != that1.ReportedCount {
+		return false
+	}
+	if this.TotalReportLatency != that1.TotalReportLatency {
+		return false
+	}
 	return true
 }
 func (this *PendingResolveList) Equal(that interface{}) bool {
@@ -2734,6 +3721,13 @@ func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Callback) > 0 {
+		i -= len(m.Callback)
+		copy(dAtA[i:], m.Callback)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Callback)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if len(m.FeeLimit) > 0 {
 		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2837,7 +3831,7 @@ func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Report) Marshal() (dAtA []byte, err error) {
+func (m *RawRequestFee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2847,20 +3841,20 @@ func (m *Report) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Report) MarshalTo(dAtA []byte) (int, error) {
+func (m *RawRequestFee) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Report) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RawRequestFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RawReports) > 0 {
-		for iNdEx := len(m.RawReports) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RawReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2868,30 +3862,30 @@ func (m *Report) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintOracle(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x22
 		}
 	}
-	if m.InBeforeResolve {
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Treasury)))
 		i--
-		if m.InBeforeResolve {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1a
+	}
+	if m.DataSourceID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DataSourceID))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
+	if m.ExternalID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExternalID))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OracleRequestPacketData) Marshal() (dAtA []byte, err error) {
+func (m *FeeEscrow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2901,35 +3895,34 @@ func (m *OracleRequestPacketData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OracleRequestPacketData) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeeEscrow) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OracleRequestPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeeEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TSSEncoder != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.TSSEncoder))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.ExecuteGas != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ExecuteGas))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.PrepareGas != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.PrepareGas))
-		i--
-		dAtA[i] = 0x38
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if len(m.FeeLimit) > 0 {
-		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RawRequestFees) > 0 {
+		for iNdEx := len(m.RawRequestFees) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RawRequestFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2937,21 +3930,268 @@ func (m *OracleRequestPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error)
 				i = encodeVarintOracle(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.MinCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MinCount))
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Payer)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x12
 	}
-	if m.AskCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.AskCount))
+	if m.RequestID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RequestID))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x8
 	}
-	if len(m.Calldata) > 0 {
-		i -= len(m.Calldata)
+	return len(dAtA) - i, nil
+}
+
+func (m *RecurringRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecurringRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecurringRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Interval != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Callback) > 0 {
+		i -= len(m.Callback)
+		copy(dAtA[i:], m.Callback)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Callback)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.TSSEncoder != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TSSEncoder))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ExecuteGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExecuteGas))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PrepareGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PrepareGas))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.FeeLimit) > 0 {
+		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MinCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AskCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AskCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OracleScriptID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Report) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Report) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Report) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RawReports) > 0 {
+		for iNdEx := len(m.RawReports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RawReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.InBeforeResolve {
+		i--
+		if m.InBeforeResolve {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleRequestPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleRequestPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleRequestPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TSSEncoder != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TSSEncoder))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExecuteGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExecuteGas))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PrepareGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PrepareGas))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.FeeLimit) > 0 {
+		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MinCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AskCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AskCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
 		copy(dAtA[i:], m.Calldata)
 		i = encodeVarintOracle(dAtA, i, uint64(len(m.Calldata)))
 		i--
@@ -3000,6 +4240,39 @@ func (m *OracleRequestPacketAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *OracleRequestPacketAcknowledgementV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleRequestPacketAcknowledgementV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleRequestPacketAcknowledgementV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequestID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OracleResponsePacketData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3282,12 +4555,95 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.IBCRequestEnabled {
+	if m.ReliabilityWindowSize != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ReliabilityWindowSize))
 		i--
-		if m.IBCRequestEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.RecurringRequestSpawnFee) > 0 {
+		for iNdEx := len(m.RecurringRequestSpawnFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecurringRequestSpawnFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.MinRecurringRequestInterval != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinRecurringRequestInterval))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.MaxIBCRequestsPerLightClient != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxIBCRequestsPerLightClient))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MaxIBCRequestsPerChannel != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxIBCRequestsPerChannel))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.IBCRequestRateLimitWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.IBCRequestRateLimitWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MaxPrunedResultsPerBlock != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxPrunedResultsPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ResultRetentionCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ResultRetentionCount))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.ResultRetentionPeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ResultRetentionPeriod))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ReliabilityWeightPercentage != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ReliabilityWeightPercentage))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ReliabilitySamplingEnabled {
+		i--
+		if m.ReliabilitySamplingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.IBCRequestEnabled {
+		i--
+		if m.IBCRequestEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
 		dAtA[i] = 0x58
@@ -3345,6 +4701,84 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IBCRequestCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCRequestCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCRequestCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Window != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorReliability) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorReliability) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorReliability) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalReportLatency != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TotalReportLatency))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReportedCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ReportedCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AssignedCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AssignedCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PendingResolveList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3733,6 +5167,128 @@ func (m *Request) Size() (n int) {
 			n += 1 + l + sovOracle(uint64(l))
 		}
 	}
+	l = len(m.Callback)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *RawRequestFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExternalID != 0 {
+		n += 1 + sovOracle(uint64(m.ExternalID))
+	}
+	if m.DataSourceID != 0 {
+		n += 1 + sovOracle(uint64(m.DataSourceID))
+	}
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestID != 0 {
+		n += 1 + sovOracle(uint64(m.RequestID))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.RawRequestFees) > 0 {
+		for _, e := range m.RawRequestFees {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RecurringRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovOracle(uint64(m.ID))
+	}
+	if m.OracleScriptID != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptID))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.AskCount != 0 {
+		n += 1 + sovOracle(uint64(m.AskCount))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovOracle(uint64(m.MinCount))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.FeeLimit) > 0 {
+		for _, e := range m.FeeLimit {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.PrepareGas != 0 {
+		n += 1 + sovOracle(uint64(m.PrepareGas))
+	}
+	if m.ExecuteGas != 0 {
+		n += 1 + sovOracle(uint64(m.ExecuteGas))
+	}
+	if m.TSSEncoder != 0 {
+		n += 1 + sovOracle(uint64(m.TSSEncoder))
+	}
+	l = len(m.Callback)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovOracle(uint64(m.Interval))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovOracle(uint64(m.NextHeight))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.IsActive {
+		n += 3
+	}
 	return n
 }
 
@@ -3811,6 +5367,21 @@ func (m *OracleRequestPacketAcknowledgement) Size() (n int) {
 	return n
 }
 
+func (m *OracleRequestPacketAcknowledgementV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestID != 0 {
+		n += 1 + sovOracle(uint64(m.RequestID))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovOracle(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
 func (m *OracleResponsePacketData) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3976,43 +5547,116 @@ func (m *Params) Size() (n int) {
 	if m.IBCRequestEnabled {
 		n += 2
 	}
+	if m.ReliabilitySamplingEnabled {
+		n += 2
+	}
+	if m.ReliabilityWeightPercentage != 0 {
+		n += 1 + sovOracle(uint64(m.ReliabilityWeightPercentage))
+	}
+	if m.ResultRetentionPeriod != 0 {
+		n += 1 + sovOracle(uint64(m.ResultRetentionPeriod))
+	}
+	if m.ResultRetentionCount != 0 {
+		n += 1 + sovOracle(uint64(m.ResultRetentionCount))
+	}
+	if m.MaxPrunedResultsPerBlock != 0 {
+		n += 2 + sovOracle(uint64(m.MaxPrunedResultsPerBlock))
+	}
+	if m.IBCRequestRateLimitWindow != 0 {
+		n += 2 + sovOracle(uint64(m.IBCRequestRateLimitWindow))
+	}
+	if m.MaxIBCRequestsPerChannel != 0 {
+		n += 2 + sovOracle(uint64(m.MaxIBCRequestsPerChannel))
+	}
+	if m.MaxIBCRequestsPerLightClient != 0 {
+		n += 2 + sovOracle(uint64(m.MaxIBCRequestsPerLightClient))
+	}
+	if m.MinRecurringRequestInterval != 0 {
+		n += 2 + sovOracle(uint64(m.MinRecurringRequestInterval))
+	}
+	if len(m.RecurringRequestSpawnFee) > 0 {
+		for _, e := range m.RecurringRequestSpawnFee {
+			l = e.Size()
+			n += 2 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.ReliabilityWindowSize != 0 {
+		n += 2 + sovOracle(uint64(m.ReliabilityWindowSize))
+	}
 	return n
 }
 
-func (m *PendingResolveList) Size() (n int) {
+func (m *IBCRequestCounter) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.RequestIds) > 0 {
-		l = 0
-		for _, e := range m.RequestIds {
-			l += sovOracle(uint64(e))
-		}
-		n += 1 + sovOracle(uint64(l)) + l
+	if m.Window != 0 {
+		n += 1 + sovOracle(uint64(m.Window))
+	}
+	if m.Count != 0 {
+		n += 1 + sovOracle(uint64(m.Count))
 	}
 	return n
 }
 
-func (m *IBCChannel) Size() (n int) {
+func (m *ValidatorReliability) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.PortId)
+	l = len(m.Validator)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.AssignedCount != 0 {
+		n += 1 + sovOracle(uint64(m.AssignedCount))
+	}
+	if m.ReportedCount != 0 {
+		n += 1 + sovOracle(uint64(m.ReportedCount))
+	}
+	if m.TotalReportLatency != 0 {
+		n += 1 + sovOracle(uint64(m.TotalReportLatency))
 	}
 	return n
 }
 
-func (m *RequestVerification) Size() (n int) {
+func (m *PendingResolveList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RequestIds) > 0 {
+		l = 0
+		for _, e := range m.RequestIds {
+			l += sovOracle(uint64(e))
+		}
+		n += 1 + sovOracle(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *IBCChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *RequestVerification) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5191,6 +6835,38 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -5212,7 +6888,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Report) Unmarshal(dAtA []byte) error {
+func (m *RawRequestFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5235,17 +6911,17 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Report: wiretype end group for non-group")
+			return fmt.Errorf("proto: RawRequestFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RawRequestFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalID", wireType)
 			}
-			var stringLen uint64
+			m.ExternalID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5255,29 +6931,16 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExternalID |= ExternalID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InBeforeResolve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
 			}
-			var v int
+			m.DataSourceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5287,15 +6950,46 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DataSourceID |= DataSourceID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.InBeforeResolve = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5322,8 +7016,8 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawReports = append(m.RawReports, RawReport{})
-			if err := m.RawReports[len(m.RawReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5348,7 +7042,7 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
+func (m *FeeEscrow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5371,15 +7065,34 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleRequestPacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeEscrow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleRequestPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= RequestID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5407,13 +7120,13 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
+			m.Payer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawRequestFees", wireType)
 			}
-			m.OracleScriptID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5423,16 +7136,31 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawRequestFees = append(m.RawRequestFees, RawRequestFee{})
+			if err := m.RawRequestFees[len(m.RawRequestFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5442,31 +7170,81 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Calldata == nil {
-				m.Calldata = []byte{}
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecurringRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecurringRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecurringRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.AskCount = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5476,16 +7254,16 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AskCount |= uint64(b&0x7F) << shift
+				m.ID |= RecurringRequestID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
 			}
-			m.MinCount = 0
+			m.OracleScriptID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5495,14 +7273,118 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinCount |= uint64(b&0x7F) << shift
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+			}
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5526,19 +7408,1350 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeLimit = append(m.FeeLimit, types.Coin{})
+			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+			}
+			m.PrepareGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrepareGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+			}
+			m.ExecuteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
+			}
+			m.TSSEncoder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TSSEncoder |= Encoder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Report) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Report: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InBeforeResolve", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InBeforeResolve = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawReports = append(m.RawReports, RawReport{})
+			if err := m.RawReports[len(m.RawReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleRequestPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleRequestPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			}
+			m.OracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+			}
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeLimit = append(m.FeeLimit, types.Coin{})
+			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+			}
+			m.PrepareGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrepareGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+			}
+			m.ExecuteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
+			}
+			m.TSSEncoder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TSSEncoder |= Encoder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleRequestPacketAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleRequestPacketAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleRequestPacketAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= RequestID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleRequestPacketAcknowledgementV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleRequestPacketAcknowledgementV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleRequestPacketAcknowledgementV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= RequestID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleResponsePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleResponsePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= RequestID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
+			}
+			m.AnsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+			}
+			m.RequestTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+			}
+			m.ResolveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
+			}
+			m.ResolveStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveStatus |= ResolveStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			}
+			m.OracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+			}
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= RequestID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
+			}
+			m.AnsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+			}
+			m.RequestTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+			}
+			m.ResolveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
+			}
+			m.ResolveStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveStatus |= ResolveStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeLimit = append(m.FeeLimit, types.Coin{})
-			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningID", wireType)
 			}
-			m.PrepareGas = 0
+			m.SigningID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5548,16 +8761,16 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrepareGas |= uint64(b&0x7F) << shift
+				m.SigningID |= github_com_bandprotocol_chain_v3_x_bandtss_types.SigningID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCodespace", wireType)
 			}
-			m.ExecuteGas = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5567,16 +8780,29 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExecuteGas |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorCodespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
 			}
-			m.TSSEncoder = 0
+			m.ErrorCode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5586,7 +8812,7 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TSSEncoder |= Encoder(b&0x7F) << shift
+				m.ErrorCode |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5612,7 +8838,7 @@ func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleRequestPacketAcknowledgement) Unmarshal(dAtA []byte) error {
+func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5635,17 +8861,17 @@ func (m *OracleRequestPacketAcknowledgement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleRequestPacketAcknowledgement: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleRequestPacketAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
 			}
-			m.RequestID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5655,11 +8881,45 @@ func (m *OracleRequestPacketAcknowledgement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestID |= RequestID(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Since, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -5681,7 +8941,7 @@ func (m *OracleRequestPacketAcknowledgement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
+func (m *ActiveValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5704,15 +8964,15 @@ func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleResponsePacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActiveValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleResponsePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActiveValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5740,108 +9000,13 @@ func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
-			}
-			m.RequestID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestID |= RequestID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
-			}
-			m.AnsCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AnsCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
-			}
-			m.RequestTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
-			}
-			m.ResolveTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ResolveTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
-			}
-			m.ResolveStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ResolveStatus |= ResolveStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			var byteLen int
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5851,26 +9016,11 @@ func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Power |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
-			if m.Result == nil {
-				m.Result = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -5892,7 +9042,7 @@ func (m *OracleResponsePacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Result) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5915,17 +9065,17 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRawRequestCount", wireType)
 			}
-			var stringLen uint64
+			m.MaxRawRequestCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5935,29 +9085,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxRawRequestCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAskCount", wireType)
 			}
-			m.OracleScriptID = 0
+			m.MaxAskCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5967,16 +9104,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				m.MaxAskCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCalldataSize", wireType)
 			}
-			var byteLen int
+			m.MaxCalldataSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5986,31 +9123,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.MaxCalldataSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Calldata == nil {
-				m.Calldata = []byte{}
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReportDataSize", wireType)
 			}
-			m.AskCount = 0
+			m.MaxReportDataSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6020,16 +9142,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AskCount |= uint64(b&0x7F) << shift
+				m.MaxReportDataSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlockCount", wireType)
 			}
-			m.MinCount = 0
+			m.ExpirationBlockCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6039,16 +9161,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinCount |= uint64(b&0x7F) << shift
+				m.ExpirationBlockCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseOwasmGas", wireType)
 			}
-			m.RequestID = 0
+			m.BaseOwasmGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6058,16 +9180,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestID |= RequestID(b&0x7F) << shift
+				m.BaseOwasmGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnsCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PerValidatorRequestGas", wireType)
 			}
-			m.AnsCount = 0
+			m.PerValidatorRequestGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6077,16 +9199,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AnsCount |= uint64(b&0x7F) << shift
+				m.PerValidatorRequestGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SamplingTryCount", wireType)
 			}
-			m.RequestTime = 0
+			m.SamplingTryCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6096,16 +9218,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestTime |= int64(b&0x7F) << shift
+				m.SamplingTryCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleRewardPercentage", wireType)
 			}
-			m.ResolveTime = 0
+			m.OracleRewardPercentage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6115,16 +9237,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResolveTime |= int64(b&0x7F) << shift
+				m.OracleRewardPercentage |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolveStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivePenaltyDuration", wireType)
 			}
-			m.ResolveStatus = 0
+			m.InactivePenaltyDuration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6134,16 +9256,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResolveStatus |= ResolveStatus(b&0x7F) << shift
+				m.InactivePenaltyDuration |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCRequestEnabled", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6153,81 +9275,37 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
-			if m.Result == nil {
-				m.Result = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SigningResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.IBCRequestEnabled = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReliabilitySamplingEnabled", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SigningResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SigningResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ReliabilitySamplingEnabled = bool(v != 0)
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReliabilityWeightPercentage", wireType)
 			}
-			m.SigningID = 0
+			m.ReliabilityWeightPercentage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6237,16 +9315,16 @@ func (m *SigningResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SigningID |= github_com_bandprotocol_chain_v3_x_bandtss_types.SigningID(b&0x7F) << shift
+				m.ReliabilityWeightPercentage |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCodespace", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultRetentionPeriod", wireType)
 			}
-			var stringLen uint64
+			m.ResultRetentionPeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6256,29 +9334,35 @@ func (m *SigningResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ResultRetentionPeriod |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultRetentionCount", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ResultRetentionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResultRetentionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ErrorCodespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedResultsPerBlock", wireType)
 			}
-			m.ErrorCode = 0
+			m.MaxPrunedResultsPerBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6288,66 +9372,73 @@ func (m *SigningResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ErrorCode |= uint64(b&0x7F) << shift
+				m.MaxPrunedResultsPerBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCRequestRateLimitWindow", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
+			m.IBCRequestRateLimitWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IBCRequestRateLimitWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIBCRequestsPerChannel", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
+			m.MaxIBCRequestsPerChannel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIBCRequestsPerChannel |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIBCRequestsPerLightClient", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MaxIBCRequestsPerLightClient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIBCRequestsPerLightClient |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 20:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRecurringRequestInterval", wireType)
 			}
-			var v int
+			m.MinRecurringRequestInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6357,15 +9448,14 @@ func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MinRecurringRequestInterval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsActive = bool(v != 0)
-		case 2:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringRequestSpawnFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6392,10 +9482,30 @@ func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Since, dAtA[iNdEx:postIndex]); err != nil {
+			m.RecurringRequestSpawnFee = append(m.RecurringRequestSpawnFee, types.Coin{})
+			if err := m.RecurringRequestSpawnFee[len(m.RecurringRequestSpawnFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReliabilityWindowSize", wireType)
+			}
+			m.ReliabilityWindowSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReliabilityWindowSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -6417,7 +9527,7 @@ func (m *ValidatorStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActiveValidator) Unmarshal(dAtA []byte) error {
+func (m *IBCRequestCounter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6440,17 +9550,17 @@ func (m *ActiveValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: IBCRequestCounter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IBCRequestCounter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			var stringLen uint64
+			m.Window = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6460,29 +9570,16 @@ func (m *ActiveValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Window |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.Power = 0
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6492,7 +9589,7 @@ func (m *ActiveValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= uint64(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6518,7 +9615,7 @@ func (m *ActiveValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ValidatorReliability) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6541,112 +9638,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorReliability: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorReliability: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRawRequestCount", wireType)
-			}
-			m.MaxRawRequestCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxRawRequestCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAskCount", wireType)
-			}
-			m.MaxAskCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxAskCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxCalldataSize", wireType)
-			}
-			m.MaxCalldataSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxCalldataSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxReportDataSize", wireType)
-			}
-			m.MaxReportDataSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxReportDataSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlockCount", wireType)
-			}
-			m.ExpirationBlockCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpirationBlockCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseOwasmGas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			m.BaseOwasmGas = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6656,54 +9658,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BaseOwasmGas |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerValidatorRequestGas", wireType)
-			}
-			m.PerValidatorRequestGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PerValidatorRequestGas |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SamplingTryCount", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.SamplingTryCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SamplingTryCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 9:
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleRewardPercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignedCount", wireType)
 			}
-			m.OracleRewardPercentage = 0
+			m.AssignedCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6713,16 +9690,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleRewardPercentage |= uint64(b&0x7F) << shift
+				m.AssignedCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InactivePenaltyDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedCount", wireType)
 			}
-			m.InactivePenaltyDuration = 0
+			m.ReportedCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6732,16 +9709,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InactivePenaltyDuration |= uint64(b&0x7F) << shift
+				m.ReportedCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBCRequestEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReportLatency", wireType)
 			}
-			var v int
+			m.TotalReportLatency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6751,12 +9728,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TotalReportLatency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IBCRequestEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -65,12 +65,27 @@ func (p OracleRequestPacketData) GetBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&p))
 }
 
+// GetCallback returns an empty callback as requests from IBC are answered through the IBC response packet.
+func (p OracleRequestPacketData) GetCallback() string {
+	return ""
+}
+
 func NewOracleRequestPacketAcknowledgement(requestID RequestID) *OracleRequestPacketAcknowledgement {
 	return &OracleRequestPacketAcknowledgement{
 		RequestID: requestID,
 	}
 }
 
+func NewOracleRequestPacketAcknowledgementV2(
+	requestID RequestID,
+	expirationHeight int64,
+) *OracleRequestPacketAcknowledgementV2 {
+	return &OracleRequestPacketAcknowledgementV2{
+		RequestID:        requestID,
+		ExpirationHeight: expirationHeight,
+	}
+}
+
 // NewOracleResponsePacketData contructs a new OracleResponsePacketData instance
 func NewOracleResponsePacketData(
 	clientID string,
@@ -59,3 +59,23 @@ func TestGetBytesResponsePacket(t *testing.T) {
 		res.GetBytes(),
 	)
 }
+
+func TestGetBytesRequestPacketAcknowledgement(t *testing.T) {
+	ack := NewOracleRequestPacketAcknowledgement(1)
+
+	require.Equal(
+		t,
+		[]byte(`{"request_id":"1"}`),
+		sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(ack)),
+	)
+}
+
+func TestGetBytesRequestPacketAcknowledgementV2(t *testing.T) {
+	ack := NewOracleRequestPacketAcknowledgementV2(1, 100)
+
+	require.Equal(
+		t,
+		[]byte(`{"expiration_height":"100","request_id":"1"}`),
+		sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(ack)),
+	)
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -19,13 +21,41 @@ const (
 	DefaultOracleRewardPercentage  = uint64(70)
 	DefaultInactivePenaltyDuration = uint64(10 * time.Minute)
 	DefaultIBCRequestEnabled       = true
+
+	DefaultReliabilitySamplingEnabled  = false
+	DefaultReliabilityWeightPercentage = uint64(50)
+	DefaultReliabilityWindowSize       = uint64(200)
+
+	// MaxReliabilityWeightPercentage caps the reliability weight percentage, so that a validator that
+	// never reports keeps some sampling weight and the sampling never runs out of validators.
+	MaxReliabilityWeightPercentage = uint64(90)
+
+	// Result pruning is disabled by default, i.e. request results are kept forever.
+	DefaultResultRetentionPeriod    = uint64(0)
+	DefaultResultRetentionCount     = uint64(0)
+	DefaultMaxPrunedResultsPerBlock = uint64(100)
+
+	// IBC requests are not rate limited by default.
+	DefaultIBCRequestRateLimitWindow    = uint64(0)
+	DefaultMaxIBCRequestsPerChannel     = uint64(0)
+	DefaultMaxIBCRequestsPerLightClient = uint64(0)
+
+	DefaultMinRecurringRequestInterval = uint64(5)
 )
 
+// DefaultRecurringRequestSpawnFee is the default fee charged for every attempt to spawn a request from a
+// recurring request.
+var DefaultRecurringRequestSpawnFee = sdk.NewCoins(sdk.NewInt64Coin("uband", 500))
+
 // NewParams creates a new parameter configuration for the oracle module
 func NewParams(
 	maxRawRequestCount, maxAskCount, maxCalldataSize, maxReportDataSize, expirationBlockCount, baseRequestGas, perValidatorRequestGas,
 	samplingTryCount, oracleRewardPercentage, inactivePenaltyDuration uint64,
-	ibcRequestEnabled bool,
+	ibcRequestEnabled, reliabilitySamplingEnabled bool,
+	reliabilityWeightPercentage, reliabilityWindowSize uint64,
+	resultRetentionPeriod, resultRetentionCount, maxPrunedResultsPerBlock uint64,
+	ibcRequestRateLimitWindow, maxIBCRequestsPerChannel, maxIBCRequestsPerLightClient uint64,
+	minRecurringRequestInterval uint64, recurringRequestSpawnFee sdk.Coins,
 ) Params {
 	return Params{
 		MaxRawRequestCount:      maxRawRequestCount,
@@ -39,6 +69,21 @@ func NewParams(
 		OracleRewardPercentage:  oracleRewardPercentage,
 		InactivePenaltyDuration: inactivePenaltyDuration,
 		IBCRequestEnabled:       ibcRequestEnabled,
+
+		ReliabilitySamplingEnabled:  reliabilitySamplingEnabled,
+		ReliabilityWeightPercentage: reliabilityWeightPercentage,
+		ReliabilityWindowSize:       reliabilityWindowSize,
+
+		ResultRetentionPeriod:    resultRetentionPeriod,
+		ResultRetentionCount:     resultRetentionCount,
+		MaxPrunedResultsPerBlock: maxPrunedResultsPerBlock,
+
+		IBCRequestRateLimitWindow:    ibcRequestRateLimitWindow,
+		MaxIBCRequestsPerChannel:     maxIBCRequestsPerChannel,
+		MaxIBCRequestsPerLightClient: maxIBCRequestsPerLightClient,
+
+		MinRecurringRequestInterval: minRecurringRequestInterval,
+		RecurringRequestSpawnFee:    recurringRequestSpawnFee,
 	}
 }
 
@@ -56,6 +101,17 @@ func DefaultParams() Params {
 		DefaultOracleRewardPercentage,
 		DefaultInactivePenaltyDuration,
 		DefaultIBCRequestEnabled,
+		DefaultReliabilitySamplingEnabled,
+		DefaultReliabilityWeightPercentage,
+		DefaultReliabilityWindowSize,
+		DefaultResultRetentionPeriod,
+		DefaultResultRetentionCount,
+		DefaultMaxPrunedResultsPerBlock,
+		DefaultIBCRequestRateLimitWindow,
+		DefaultMaxIBCRequestsPerChannel,
+		DefaultMaxIBCRequestsPerLightClient,
+		DefaultMinRecurringRequestInterval,
+		DefaultRecurringRequestSpawnFee,
 	)
 }
 
@@ -94,6 +150,42 @@ func (p Params) Validate() error {
 	if err := validateBool()(p.IBCRequestEnabled); err != nil {
 		return err
 	}
+	if err := validateBool()(p.ReliabilitySamplingEnabled); err != nil {
+		return err
+	}
+	if err := validatePercentage(
+		"reliability weight percentage",
+		MaxReliabilityWeightPercentage,
+	)(p.ReliabilityWeightPercentage); err != nil {
+		return err
+	}
+	if err := validateUint64("reliability window size", false)(p.ReliabilityWindowSize); err != nil {
+		return err
+	}
+	if err := validateUint64("result retention period", false)(p.ResultRetentionPeriod); err != nil {
+		return err
+	}
+	if err := validateUint64("result retention count", false)(p.ResultRetentionCount); err != nil {
+		return err
+	}
+	if err := validateUint64("max pruned results per block", false)(p.MaxPrunedResultsPerBlock); err != nil {
+		return err
+	}
+	if err := validateUint64("ibc request rate limit window", false)(p.IBCRequestRateLimitWindow); err != nil {
+		return err
+	}
+	if err := validateUint64("max ibc requests per channel", false)(p.MaxIBCRequestsPerChannel); err != nil {
+		return err
+	}
+	if err := validateUint64("max ibc requests per light client", false)(p.MaxIBCRequestsPerLightClient); err != nil {
+		return err
+	}
+	if err := validateUint64("min recurring request interval", false)(p.MinRecurringRequestInterval); err != nil {
+		return err
+	}
+	if err := validateCoins("recurring request spawn fee")(p.RecurringRequestSpawnFee); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -111,6 +203,32 @@ func validateUint64(name string, positiveOnly bool) func(interface{}) error {
 	}
 }
 
+func validateCoins(name string) func(interface{}) error {
+	return func(i interface{}) error {
+		v, ok := i.(sdk.Coins)
+		if !ok {
+			return fmt.Errorf("invalid parameter type: %T", i)
+		}
+		if !v.IsValid() {
+			return fmt.Errorf("invalid %s: %s", name, v)
+		}
+		return nil
+	}
+}
+
+func validatePercentage(name string, maxPercentage uint64) func(interface{}) error {
+	return func(i interface{}) error {
+		v, ok := i.(uint64)
+		if !ok {
+			return fmt.Errorf("invalid parameter type: %T", i)
+		}
+		if v > maxPercentage {
+			return fmt.Errorf("%s must not exceed %d: %d", name, maxPercentage, v)
+		}
+		return nil
+	}
+}
+
 func validateBool() func(interface{}) error {
 	return func(i interface{}) error {
 		_, ok := i.(bool)
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// PrepayMemoKey is the key of the ICS-20 transfer memo object that prepays the data source fees of the
+// requests from an oracle channel, e.g. {"oracle":{"prepay_channel_id":"channel-0"}}.
+const PrepayMemoKey = "oracle"
+
+// PrepayMemo is the ICS-20 transfer memo object that prepays the data source fees of the requests from
+// an oracle channel.
+type PrepayMemo struct {
+	// PrepayChannelID is the ID of the oracle channel on BandChain to credit the transferred tokens to
+	PrepayChannelID string `json:"prepay_channel_id"`
+}
+
+// ParsePrepayMemo returns the oracle channel ID to be prepaid by the given ICS-20 transfer memo and whether
+// the memo is a prepay memo. Only a memo whose oracle object is a well-formed prepay memo with a valid channel
+// ID is a prepay memo, so that transfers with an oracle object of other applications are left untouched.
+func ParsePrepayMemo(memo string) (string, bool) {
+	var objects map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &objects); err != nil {
+		return "", false
+	}
+
+	bz, ok := objects[PrepayMemoKey]
+	if !ok {
+		return "", false
+	}
+
+	var prepayMemo PrepayMemo
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&prepayMemo); err != nil {
+		return "", false
+	}
+	if err := host.ChannelIdentifierValidator(prepayMemo.PrepayChannelID); err != nil {
+		return "", false
+	}
+
+	return prepayMemo.PrepayChannelID, true
+}
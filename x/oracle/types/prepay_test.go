@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePrepayMemo(t *testing.T) {
+	testCases := []struct {
+		name      string
+		memo      string
+		channelID string
+		ok        bool
+	}{
+		{"empty memo", "", "", false},
+		{"non json memo", "hello", "", false},
+		{"non object memo", `["oracle"]`, "", false},
+		{"other memo", `{"forward":{"receiver":"band1"}}`, "", false},
+		{"prepay memo", `{"oracle":{"prepay_channel_id":"channel-1"}}`, "channel-1", true},
+		{
+			"prepay memo with other keys",
+			`{"forward":{"receiver":"band1"},"oracle":{"prepay_channel_id":"channel-1"}}`,
+			"channel-1",
+			true,
+		},
+		{"oracle memo of other application", `{"oracle":"channel-1"}`, "", false},
+		{"oracle memo with unknown fields", `{"oracle":{"prepay_channel_id":"channel-1","id":1}}`, "", false},
+		{"empty channel id", `{"oracle":{}}`, "", false},
+		{"invalid channel id", `{"oracle":{"prepay_channel_id":"channel/1"}}`, "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			channelID, ok := ParsePrepayMemo(tc.memo)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.channelID, channelID)
+		})
+	}
+}
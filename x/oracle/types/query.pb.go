@@ -7,6 +7,9 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -1089,6 +1092,8 @@ type QueryRequestSearchRequest struct {
 	AskCount uint64 `protobuf:"varint,3,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
 	// MinCount is number of validators required for fulfilling the request
 	MinCount uint64 `protobuf:"varint,4,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryRequestSearchRequest) Reset()         { *m = QueryRequestSearchRequest{} }
@@ -1152,11 +1157,23 @@ func (m *QueryRequestSearchRequest) GetMinCount() uint64 {
 	return 0
 }
 
+func (m *QueryRequestSearchRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryRequestSearchResponse is response type for the Query/RequestSearch RPC
 // method.
 type QueryRequestSearchResponse struct {
-	// Request is details of an oracle request
+	// Request is details of the first request in the page, which is the latest
+	// matching request without pagination
 	Request *QueryRequestResponse `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// Requests is a list of matching requests from the latest resolved one
+	Requests []*QueryRequestResponse `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryRequestSearchResponse) Reset()         { *m = QueryRequestSearchResponse{} }
@@ -1199,6 +1216,20 @@ func (m *QueryRequestSearchResponse) GetRequest() *QueryRequestResponse {
 	return nil
 }
 
+func (m *QueryRequestSearchResponse) GetRequests() []*QueryRequestResponse {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *QueryRequestSearchResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryRequestPriceRequest is request type for the Query/RequestPrice RPC
 // method.
 type QueryRequestPriceRequest struct {
@@ -1208,6 +1239,9 @@ type QueryRequestPriceRequest struct {
 	AskCount uint64 `protobuf:"varint,2,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
 	// MinCount is number of validators required for fulfilling the request
 	MinCount uint64 `protobuf:"varint,3,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// pagination defines an optional pagination for the request. It is only
+	// applied when no symbol is given, to list the prices of all symbols.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryRequestPriceRequest) Reset()         { *m = QueryRequestPriceRequest{} }
@@ -1264,11 +1298,20 @@ func (m *QueryRequestPriceRequest) GetMinCount() uint64 {
 	return 0
 }
 
+func (m *QueryRequestPriceRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryRequestPriceResponse is response type for the Query/RequestPrice RPC
 // method.
 type QueryRequestPriceResponse struct {
 	// PriceResult is a list of price results for given symbols
 	PriceResults []*PriceResult `protobuf:"bytes,1,rep,name=price_results,json=priceResults,proto3" json:"price_results,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryRequestPriceResponse) Reset()         { *m = QueryRequestPriceResponse{} }
@@ -1311,6 +1354,13 @@ func (m *QueryRequestPriceResponse) GetPriceResults() []*PriceResult {
 	return nil
 }
 
+func (m *QueryRequestPriceResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryRequestVerificationRequest is request type for the
 // Query/RequestVerification RPC
 type QueryRequestVerificationRequest struct {
@@ -1519,1422 +1569,1777 @@ func (m *QueryRequestVerificationResponse) GetIsDelay() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*QueryCountsRequest)(nil), "band.oracle.v1.QueryCountsRequest")
-	proto.RegisterType((*QueryCountsResponse)(nil), "band.oracle.v1.QueryCountsResponse")
-	proto.RegisterType((*QueryDataRequest)(nil), "band.oracle.v1.QueryDataRequest")
-	proto.RegisterType((*QueryDataResponse)(nil), "band.oracle.v1.QueryDataResponse")
-	proto.RegisterType((*QueryDataSourceRequest)(nil), "band.oracle.v1.QueryDataSourceRequest")
-	proto.RegisterType((*QueryDataSourceResponse)(nil), "band.oracle.v1.QueryDataSourceResponse")
-	proto.RegisterType((*QueryOracleScriptRequest)(nil), "band.oracle.v1.QueryOracleScriptRequest")
-	proto.RegisterType((*QueryOracleScriptResponse)(nil), "band.oracle.v1.QueryOracleScriptResponse")
-	proto.RegisterType((*QueryRequestRequest)(nil), "band.oracle.v1.QueryRequestRequest")
-	proto.RegisterType((*QueryRequestResponse)(nil), "band.oracle.v1.QueryRequestResponse")
-	proto.RegisterType((*QueryPendingRequestsRequest)(nil), "band.oracle.v1.QueryPendingRequestsRequest")
-	proto.RegisterType((*QueryPendingRequestsResponse)(nil), "band.oracle.v1.QueryPendingRequestsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "band.oracle.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "band.oracle.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryValidatorRequest)(nil), "band.oracle.v1.QueryValidatorRequest")
-	proto.RegisterType((*QueryValidatorResponse)(nil), "band.oracle.v1.QueryValidatorResponse")
-	proto.RegisterType((*QueryIsReporterRequest)(nil), "band.oracle.v1.QueryIsReporterRequest")
-	proto.RegisterType((*QueryIsReporterResponse)(nil), "band.oracle.v1.QueryIsReporterResponse")
-	proto.RegisterType((*QueryReportersRequest)(nil), "band.oracle.v1.QueryReportersRequest")
-	proto.RegisterType((*QueryReportersResponse)(nil), "band.oracle.v1.QueryReportersResponse")
-	proto.RegisterType((*QueryActiveValidatorsRequest)(nil), "band.oracle.v1.QueryActiveValidatorsRequest")
-	proto.RegisterType((*QueryActiveValidatorsResponse)(nil), "band.oracle.v1.QueryActiveValidatorsResponse")
-	proto.RegisterType((*QueryRequestSearchRequest)(nil), "band.oracle.v1.QueryRequestSearchRequest")
-	proto.RegisterType((*QueryRequestSearchResponse)(nil), "band.oracle.v1.QueryRequestSearchResponse")
-	proto.RegisterType((*QueryRequestPriceRequest)(nil), "band.oracle.v1.QueryRequestPriceRequest")
-	proto.RegisterType((*QueryRequestPriceResponse)(nil), "band.oracle.v1.QueryRequestPriceResponse")
-	proto.RegisterType((*QueryRequestVerificationRequest)(nil), "band.oracle.v1.QueryRequestVerificationRequest")
-	proto.RegisterType((*QueryRequestVerificationResponse)(nil), "band.oracle.v1.QueryRequestVerificationResponse")
+// QueryRecurringRequestRequest is request type for the Query/RecurringRequest
+// RPC method.
+type QueryRecurringRequestRequest struct {
+	// RecurringRequestID is ID of a recurring request
+	RecurringRequestId uint64 `protobuf:"varint,1,opt,name=recurring_request_id,json=recurringRequestId,proto3" json:"recurring_request_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("band/oracle/v1/query.proto", fileDescriptor_e351f430ef3842d0) }
-
-var fileDescriptor_e351f430ef3842d0 = []byte{
-	// 1538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x3a, 0xae, 0x3f, 0x5e, 0x9c, 0xaf, 0x49, 0x68, 0x9d, 0x75, 0xe2, 0xa4, 0x9b, 0xd2,
-	0x3a, 0xfd, 0xf0, 0x36, 0x6d, 0x45, 0xa5, 0x82, 0x5a, 0x9a, 0xe6, 0x80, 0x85, 0xd4, 0x0f, 0x47,
-	0xea, 0x01, 0x01, 0x66, 0x62, 0x2f, 0xce, 0xaa, 0xf6, 0xae, 0xbb, 0xb3, 0xb6, 0x12, 0x55, 0xe1,
-	0xc0, 0x01, 0x09, 0x09, 0x04, 0x12, 0x02, 0x09, 0xf5, 0xc4, 0x81, 0x23, 0xb7, 0x5e, 0xe0, 0x2f,
-	0xe8, 0xb1, 0x2a, 0x17, 0x4e, 0x15, 0x4a, 0x39, 0xf1, 0x4f, 0x80, 0x76, 0xe6, 0xcd, 0x7a, 0x77,
-	0xbd, 0x76, 0xcd, 0xa1, 0x07, 0x6e, 0xd9, 0x79, 0xbf, 0xf7, 0xde, 0x6f, 0xde, 0x9b, 0x79, 0xf3,
-	0x73, 0x40, 0xdd, 0xa5, 0x56, 0x43, 0xb7, 0x1d, 0x5a, 0x6f, 0x19, 0x7a, 0x6f, 0x53, 0x7f, 0xd8,
-	0x35, 0x9c, 0x83, 0x72, 0xc7, 0xb1, 0x5d, 0x9b, 0xcc, 0x78, 0xb6, 0xb2, 0xb0, 0x95, 0x7b, 0x9b,
-	0xea, 0x62, 0xd3, 0x6e, 0xda, 0xdc, 0xa4, 0x7b, 0x7f, 0x09, 0x94, 0xba, 0xdc, 0xb4, 0xed, 0x66,
-	0xcb, 0xd0, 0x69, 0xc7, 0xd4, 0xa9, 0x65, 0xd9, 0x2e, 0x75, 0x4d, 0xdb, 0x62, 0x68, 0x5d, 0xaa,
-	0xdb, 0xac, 0x6d, 0xb3, 0x9a, 0x70, 0x13, 0x1f, 0x68, 0x2a, 0x44, 0x52, 0x63, 0x22, 0x6e, 0xd4,
-	0x16, 0x81, 0xdc, 0xf3, 0xa8, 0xdc, 0xb2, 0xbb, 0x96, 0xcb, 0xaa, 0xc6, 0xc3, 0xae, 0xc1, 0x5c,
-	0xed, 0x07, 0x05, 0x16, 0x42, 0xcb, 0xac, 0x63, 0x5b, 0xcc, 0x20, 0x67, 0x61, 0xbe, 0x41, 0x5d,
-	0x5a, 0x63, 0x76, 0xd7, 0xa9, 0x1b, 0xb5, 0xba, 0x67, 0xcd, 0x2b, 0x6b, 0x4a, 0x29, 0x59, 0x9d,
-	0xf5, 0x0c, 0x3b, 0x7c, 0x9d, 0x3b, 0x91, 0x32, 0x2c, 0x88, 0x4c, 0x35, 0x56, 0x77, 0xcc, 0x8e,
-	0x8b, 0xe8, 0x04, 0x47, 0xcf, 0x0b, 0xd3, 0x0e, 0xb7, 0x08, 0xfc, 0x3a, 0x4c, 0x3b, 0x22, 0x3d,
-	0x22, 0x27, 0x39, 0x32, 0x87, 0x8b, 0x1c, 0xa4, 0xe9, 0x30, 0xc7, 0x79, 0x6d, 0x53, 0x97, 0x22,
-	0x59, 0x52, 0x80, 0x2c, 0x27, 0xb5, 0x47, 0xd9, 0x1e, 0x27, 0x93, 0xad, 0x66, 0xbc, 0x85, 0xf7,
-	0x28, 0xdb, 0xd3, 0xce, 0xc0, 0x7c, 0xc0, 0x01, 0xb7, 0x41, 0x20, 0xe9, 0x01, 0x38, 0x38, 0x57,
-	0xe5, 0x7f, 0x6b, 0xd7, 0xe1, 0xb8, 0x0f, 0x14, 0xdb, 0x90, 0xf1, 0x4f, 0xc1, 0x4c, 0x70, 0xd3,
-	0x66, 0x03, 0x77, 0x9c, 0xeb, 0xef, 0xb8, 0xd2, 0xd0, 0xee, 0xc3, 0x89, 0x01, 0x7f, 0x4c, 0xf7,
-	0x36, 0x4c, 0x05, 0x02, 0x70, 0xef, 0xa9, 0x4b, 0x6a, 0x39, 0xdc, 0xf5, 0x72, 0xc0, 0x11, 0xfa,
-	0x91, 0xb5, 0x6d, 0xc8, 0xf3, 0xb8, 0x77, 0x02, 0x05, 0x93, 0xcc, 0x4a, 0x30, 0x17, 0x2e, 0xb1,
-	0xcf, 0x6d, 0x26, 0x58, 0xdf, 0x4a, 0x43, 0xfb, 0x18, 0x96, 0x62, 0xa2, 0x20, 0xbf, 0x9b, 0x30,
-	0x1d, 0x0a, 0x83, 0x0c, 0x97, 0xa3, 0x0c, 0x43, 0xce, 0xb9, 0x60, 0x06, 0xed, 0x0a, 0x9e, 0x17,
-	0x64, 0x26, 0x09, 0xae, 0x00, 0xc8, 0x9e, 0xfa, 0xd4, 0xb2, 0xb8, 0x52, 0x69, 0x68, 0x7f, 0x2b,
-	0xb0, 0x18, 0x76, 0x43, 0x46, 0x9b, 0x90, 0x46, 0x14, 0x72, 0x39, 0x11, 0xe5, 0x22, 0x3d, 0x24,
-	0x8e, 0xbc, 0xe5, 0xb9, 0x74, 0x6c, 0xc7, 0x65, 0xf9, 0xc4, 0xda, 0x64, 0x69, 0xea, 0xd2, 0xf1,
-	0x41, 0x17, 0xcf, 0xbc, 0x95, 0x7c, 0xfa, 0x62, 0x75, 0xa2, 0x2a, 0xc1, 0xa4, 0x0c, 0x29, 0xc7,
-	0x60, 0xdd, 0x96, 0x38, 0x6f, 0xb1, 0x6e, 0x9e, 0xb5, 0x8a, 0x28, 0x72, 0x15, 0xd2, 0xcc, 0x6c,
-	0x5a, 0xa6, 0xd5, 0xcc, 0x27, 0xb9, 0xc3, 0x4a, 0xd4, 0x61, 0x47, 0x98, 0xd1, 0x4f, 0xa2, 0xb5,
-	0x36, 0x14, 0xf8, 0x5e, 0xef, 0x1a, 0x56, 0x83, 0x9b, 0x39, 0x6f, 0x79, 0xe5, 0xc8, 0x6d, 0x98,
-	0xef, 0xd1, 0x96, 0xd9, 0xa0, 0xae, 0xed, 0xd4, 0x68, 0xa3, 0xe1, 0x18, 0x8c, 0x89, 0xd3, 0xbc,
-	0x75, 0xf2, 0xf9, 0x93, 0x0b, 0x2b, 0x78, 0xa5, 0xef, 0x4b, 0xcc, 0x4d, 0x01, 0xd9, 0x71, 0x1d,
-	0x2f, 0xd8, 0x5c, 0x2f, 0xb2, 0xae, 0xdd, 0x81, 0xe5, 0xf8, 0x74, 0x58, 0x62, 0x1d, 0xa6, 0xfa,
-	0xad, 0xf1, 0x32, 0x4d, 0x96, 0x92, 0x5b, 0x33, 0x47, 0x2f, 0x56, 0x01, 0xa1, 0x95, 0x6d, 0x56,
-	0x05, 0xbf, 0x57, 0xcc, 0x9f, 0x14, 0x77, 0xa9, 0x43, 0xdb, 0xfe, 0xa4, 0x78, 0x1f, 0x1b, 0x2f,
-	0x57, 0x31, 0xfa, 0x15, 0x48, 0x75, 0xf8, 0x0a, 0xf6, 0x6f, 0xa0, 0xaa, 0x02, 0x8f, 0xcd, 0x40,
-	0xac, 0xd6, 0x84, 0x37, 0x78, 0x30, 0x7f, 0x93, 0xaf, 0xab, 0x38, 0xf7, 0xf0, 0xb2, 0x07, 0x12,
-	0x21, 0xf1, 0xab, 0x90, 0x62, 0x2e, 0x75, 0xbb, 0x92, 0xf8, 0x6a, 0x94, 0xb8, 0xef, 0xb2, 0xc3,
-	0x61, 0x55, 0x84, 0x6b, 0xbf, 0x28, 0x18, 0xb3, 0xc2, 0xc4, 0x41, 0x33, 0x5e, 0x17, 0x7b, 0x72,
-	0x0b, 0xe6, 0x1c, 0x4c, 0xe1, 0x87, 0x4b, 0xf0, 0x70, 0xf9, 0xe7, 0x4f, 0x2e, 0x2c, 0x62, 0xb8,
-	0x70, 0x94, 0x59, 0xe9, 0x21, 0x4b, 0x70, 0x0d, 0xe7, 0x55, 0x90, 0x2e, 0xd6, 0x60, 0x15, 0xa6,
-	0x4c, 0x56, 0x93, 0x0e, 0x9c, 0x69, 0xa6, 0x0a, 0xa6, 0x0f, 0xf4, 0xfb, 0x24, 0x17, 0x5e, 0xdb,
-	0x21, 0xbe, 0x8d, 0x35, 0x0d, 0x24, 0xf2, 0x0f, 0x58, 0x26, 0x40, 0x70, 0x72, 0xe4, 0xde, 0x7d,
-	0xa4, 0x56, 0xc4, 0x4b, 0x71, 0xb3, 0xee, 0x9a, 0x3d, 0xc3, 0xa7, 0xe1, 0x9f, 0xe6, 0x4f, 0x60,
-	0x65, 0x88, 0x1d, 0xd3, 0xde, 0x00, 0xf0, 0x49, 0x8a, 0x4b, 0x13, 0x73, 0x44, 0x22, 0xde, 0xd5,
-	0x80, 0x8b, 0xf6, 0x58, 0xc1, 0x49, 0x8c, 0x29, 0x77, 0x0c, 0xea, 0xd4, 0xf7, 0xfe, 0xf3, 0x40,
-	0x27, 0x2a, 0x64, 0xea, 0xb4, 0xd5, 0xe2, 0xcf, 0x58, 0x42, 0xbc, 0x79, 0xf2, 0xdb, 0x7b, 0x10,
-	0x29, 0x7b, 0x10, 0x7a, 0x45, 0x33, 0x94, 0x3d, 0x10, 0xcf, 0x6c, 0x01, 0xb2, 0x6d, 0xd3, 0x42,
-	0x63, 0x52, 0x18, 0xdb, 0xa6, 0x25, 0x9e, 0xd7, 0x0f, 0x41, 0x8d, 0x23, 0x87, 0x9b, 0xbf, 0x1e,
-	0x9d, 0xca, 0xa7, 0xa2, 0x3b, 0x8f, 0x1b, 0xe6, 0xfe, 0x88, 0xd6, 0x2c, 0x7c, 0xca, 0x10, 0x70,
-	0xd7, 0x31, 0xfb, 0x8f, 0x6c, 0x1e, 0xd2, 0xec, 0xa0, 0xbd, 0x6b, 0xb7, 0x44, 0x55, 0xb3, 0x55,
-	0xf9, 0x19, 0xde, 0x4d, 0x62, 0xd4, 0x6e, 0x26, 0x23, 0xbb, 0xf9, 0x28, 0x5c, 0x6a, 0xcc, 0x87,
-	0x9b, 0x79, 0x17, 0xa6, 0x3b, 0xde, 0x42, 0x4d, 0xcc, 0x75, 0xd9, 0xcc, 0xc2, 0xc0, 0xa0, 0x42,
-	0x2f, 0x6f, 0x96, 0xe7, 0x3a, 0xfd, 0x0f, 0xa6, 0xfd, 0x96, 0x80, 0xd5, 0x60, 0xfc, 0xfb, 0x86,
-	0x63, 0x7e, 0x6a, 0xd6, 0xb9, 0x2c, 0x93, 0xdb, 0x5a, 0x82, 0x4c, 0x7d, 0x8f, 0x9a, 0x96, 0x6c,
-	0x64, 0xb6, 0x9a, 0xe6, 0xdf, 0x95, 0x06, 0xb9, 0x01, 0x59, 0xff, 0x5c, 0xe0, 0xf5, 0x1d, 0xe3,
-	0x8e, 0xf4, 0x7d, 0x22, 0x8f, 0xeb, 0x64, 0xe4, 0x71, 0xf5, 0x6e, 0xb1, 0xb1, 0xef, 0x1a, 0x8e,
-	0x45, 0x5b, 0x9e, 0x5d, 0xb4, 0x1a, 0xe4, 0x52, 0xa5, 0x11, 0xa3, 0x6b, 0x8e, 0x0d, 0xea, 0x1a,
-	0xef, 0xa0, 0xf9, 0x17, 0x2d, 0x25, 0x0e, 0x9a, 0xfc, 0x26, 0xcb, 0x90, 0xf5, 0x5e, 0x37, 0xea,
-	0x76, 0x1d, 0x23, 0x9f, 0xe6, 0x62, 0xaa, 0xbf, 0xc0, 0x7b, 0x43, 0xf7, 0x6b, 0x0d, 0xa3, 0x45,
-	0x0f, 0xf2, 0x19, 0xec, 0x0d, 0xdd, 0xdf, 0xf6, 0xbe, 0xb5, 0x7f, 0x14, 0x58, 0x1b, 0x5e, 0x3c,
-	0xec, 0xd1, 0xff, 0xbf, 0x7a, 0x4b, 0x90, 0x31, 0x19, 0x96, 0x20, 0xc5, 0xe7, 0x68, 0xda, 0x64,
-	0xbc, 0x02, 0x97, 0x7e, 0x9d, 0x85, 0x63, 0xbc, 0x02, 0xa4, 0x05, 0x29, 0xa1, 0xb3, 0x89, 0x16,
-	0x7b, 0xa1, 0x42, 0xda, 0x5c, 0x5d, 0x1f, 0x89, 0x11, 0x95, 0xd3, 0x96, 0x3e, 0xff, 0xfd, 0xaf,
-	0xef, 0x12, 0x0b, 0x64, 0x3e, 0xa0, 0xfb, 0xeb, 0x22, 0x47, 0x07, 0x92, 0x9e, 0xd4, 0x24, 0x6b,
-	0xb1, 0x71, 0x02, 0xc2, 0x5a, 0x3d, 0x39, 0x02, 0x81, 0x79, 0xd6, 0x79, 0x9e, 0x15, 0x52, 0x08,
-	0xe4, 0xf1, 0x0a, 0xa0, 0x3f, 0xf2, 0x25, 0xf9, 0x21, 0xf9, 0x46, 0x01, 0xe8, 0xab, 0x5b, 0x72,
-	0x7a, 0x68, 0xd8, 0x90, 0xee, 0x56, 0xcf, 0xbc, 0x12, 0x87, 0x24, 0x2e, 0x72, 0x12, 0x67, 0x49,
-	0x29, 0x42, 0x02, 0x7b, 0xc3, 0x90, 0x8c, 0xdf, 0xa9, 0x43, 0xf2, 0xa3, 0x02, 0xb9, 0xa0, 0x9a,
-	0x25, 0xa5, 0xd8, 0x5c, 0x31, 0x9a, 0x5b, 0xdd, 0x18, 0x03, 0x89, 0xbc, 0xae, 0x70, 0x5e, 0x65,
-	0x72, 0x7e, 0xe0, 0xc7, 0x17, 0x8e, 0x77, 0xa6, 0x3f, 0x8a, 0x8e, 0xfb, 0x43, 0xf2, 0x19, 0xa4,
-	0xe5, 0xf4, 0x58, 0x1f, 0x3d, 0x5f, 0x05, 0xa1, 0xb1, 0x86, 0xb0, 0x56, 0xe2, 0x5c, 0x34, 0xb2,
-	0x16, 0xe0, 0x82, 0xa7, 0x9d, 0xe9, 0x8f, 0xfa, 0x37, 0xe1, 0x90, 0xfc, 0xac, 0xc0, 0x6c, 0x44,
-	0x34, 0x92, 0x73, 0xb1, 0x39, 0xe2, 0x95, 0xac, 0x7a, 0x7e, 0x3c, 0x30, 0x12, 0xbb, 0xca, 0x89,
-	0x6d, 0x12, 0x3d, 0x40, 0xac, 0x23, 0xb0, 0xb5, 0x3e, 0xc1, 0x01, 0x55, 0x71, 0x48, 0xbe, 0x56,
-	0x20, 0xeb, 0x5f, 0x73, 0xf2, 0x66, 0x6c, 0xd2, 0xa8, 0x90, 0x54, 0x4f, 0xbf, 0x0a, 0x86, 0xac,
-	0x36, 0x39, 0xab, 0x73, 0x64, 0x23, 0xc0, 0xaa, 0xff, 0x8a, 0xc7, 0xf2, 0xf9, 0x49, 0x01, 0xe8,
-	0x8b, 0xa9, 0x21, 0xa7, 0x7c, 0x40, 0x1c, 0x0e, 0x39, 0xe5, 0x83, 0xaa, 0x4c, 0xdb, 0xe2, 0x94,
-	0xde, 0x21, 0xd7, 0x42, 0x1d, 0x14, 0xa0, 0x38, 0x42, 0x5e, 0x57, 0xc3, 0x22, 0xf1, 0x90, 0x7c,
-	0xa5, 0x40, 0xd6, 0xd7, 0x52, 0x43, 0x6a, 0x16, 0x15, 0x75, 0x43, 0x6a, 0x36, 0x20, 0xc9, 0x62,
-	0xaf, 0xa1, 0xa4, 0x10, 0x5f, 0xb2, 0xef, 0x15, 0x98, 0x8b, 0x4a, 0x2d, 0x12, 0x7f, 0x7c, 0x86,
-	0x28, 0x36, 0xf5, 0xc2, 0x98, 0x68, 0xe4, 0x78, 0x8a, 0x73, 0x2c, 0x92, 0xe5, 0x00, 0x47, 0xca,
-	0xc1, 0xb5, 0x7e, 0x7b, 0xbd, 0x81, 0x2c, 0x7e, 0x9f, 0x0c, 0x19, 0xc8, 0xa1, 0x9f, 0x40, 0x43,
-	0x06, 0x72, 0xf8, 0x07, 0x51, 0xec, 0x40, 0x16, 0xbf, 0x7a, 0xc8, 0x97, 0x0a, 0x4c, 0x87, 0x04,
-	0x17, 0xd9, 0x18, 0x75, 0xa5, 0x43, 0x8a, 0x51, 0x3d, 0x3b, 0x0e, 0x14, 0x39, 0x9c, 0xe4, 0x1c,
-	0x0a, 0x64, 0x69, 0x70, 0x06, 0xd4, 0x98, 0xc8, 0xfc, 0x85, 0x02, 0xb9, 0xa0, 0x5c, 0x1a, 0x32,
-	0x18, 0x63, 0x14, 0x9c, 0xba, 0x31, 0x06, 0x72, 0x0c, 0x22, 0x5c, 0x62, 0x31, 0xf2, 0x58, 0x81,
-	0x85, 0x18, 0x69, 0x40, 0xf4, 0x51, 0x59, 0x62, 0x14, 0x98, 0x7a, 0x71, 0x7c, 0x87, 0x11, 0xec,
-	0x7a, 0x1e, 0xf0, 0x40, 0x0e, 0xa4, 0xad, 0xca, 0xd3, 0xa3, 0xa2, 0xf2, 0xec, 0xa8, 0xa8, 0xfc,
-	0x79, 0x54, 0x54, 0xbe, 0x7d, 0x59, 0x9c, 0x78, 0xf6, 0xb2, 0x38, 0xf1, 0xc7, 0xcb, 0xe2, 0xc4,
-	0x07, 0x7a, 0xd3, 0x74, 0xf7, 0xba, 0xbb, 0xe5, 0xba, 0xdd, 0xd6, 0xbd, 0xc4, 0xfc, 0xbf, 0x6c,
-	0x75, 0xbb, 0xa5, 0x73, 0xe1, 0xa2, 0xf7, 0x2e, 0xeb, 0xfb, 0x32, 0xac, 0x7b, 0xd0, 0x31, 0xd8,
-	0x6e, 0x8a, 0x23, 0x2e, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x69, 0x17, 0x2e, 0x0f, 0x21, 0x14,
-	0x00, 0x00,
+func (m *QueryRecurringRequestRequest) Reset()         { *m = QueryRecurringRequestRequest{} }
+func (m *QueryRecurringRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecurringRequestRequest) ProtoMessage()    {}
+func (*QueryRecurringRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{28}
+}
+func (m *QueryRecurringRequestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecurringRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringRequestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecurringRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringRequestRequest.Merge(m, src)
+}
+func (m *QueryRecurringRequestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecurringRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringRequestRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryRecurringRequestRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Counts queries the number of existing data sources, oracle scripts, and
-	// requests.
-	Counts(ctx context.Context, in *QueryCountsRequest, opts ...grpc.CallOption) (*QueryCountsResponse, error)
-	// Data queries content of the data source or oracle script for given SHA256
-	// file hash.
-	Data(ctx context.Context, in *QueryDataRequest, opts ...grpc.CallOption) (*QueryDataResponse, error)
-	// DataSource queries data source summary info for given data source id.
-	DataSource(ctx context.Context, in *QueryDataSourceRequest, opts ...grpc.CallOption) (*QueryDataSourceResponse, error)
-	// OracleScript queries oracle script summary info for given oracle script id.
-	OracleScript(ctx context.Context, in *QueryOracleScriptRequest, opts ...grpc.CallOption) (*QueryOracleScriptResponse, error)
-	// Request queries request info for given request id.
-	Request(ctx context.Context, in *QueryRequestRequest, opts ...grpc.CallOption) (*QueryRequestResponse, error)
-	// PendingRequests queries list of pending request IDs assigned to given
-	// validator.
-	PendingRequests(ctx context.Context, in *QueryPendingRequestsRequest, opts ...grpc.CallOption) (*QueryPendingRequestsResponse, error)
-	// Validator queries properties of given validator address.
-	Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error)
-	// IsReporter queries grant of account on this validator.
-	IsReporter(ctx context.Context, in *QueryIsReporterRequest, opts ...grpc.CallOption) (*QueryIsReporterResponse, error)
-	// Reporters queries all reporters associated with given validator address.
-	Reporters(ctx context.Context, in *QueryReportersRequest, opts ...grpc.CallOption) (*QueryReportersResponse, error)
-	// ActiveValidators queries all active oracle validators.
-	ActiveValidators(ctx context.Context, in *QueryActiveValidatorsRequest, opts ...grpc.CallOption) (*QueryActiveValidatorsResponse, error)
-	// Params queries parameters used for runnning bandchain network.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// RequestSearch queries the latest request that match search criteria.
-	RequestSearch(ctx context.Context, in *QueryRequestSearchRequest, opts ...grpc.CallOption) (*QueryRequestSearchResponse, error)
-	// RequestPrice queries the latest price on standard price reference oracle
-	// script.
-	RequestPrice(ctx context.Context, in *QueryRequestPriceRequest, opts ...grpc.CallOption) (*QueryRequestPriceResponse, error)
-	// RequestVerification verifies a request to make sure that
-	// all information that will be used to report the data is valid
-	RequestVerification(ctx context.Context, in *QueryRequestVerificationRequest, opts ...grpc.CallOption) (*QueryRequestVerificationResponse, error)
+func (m *QueryRecurringRequestRequest) GetRecurringRequestId() uint64 {
+	if m != nil {
+		return m.RecurringRequestId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryRecurringRequestResponse is response type for the
+// Query/RecurringRequest RPC method.
+type QueryRecurringRequestResponse struct {
+	// RecurringRequest is the recurring request
+	RecurringRequest *RecurringRequest `protobuf:"bytes,1,opt,name=recurring_request,json=recurringRequest,proto3" json:"recurring_request,omitempty"`
+	// Deposit is the remaining balance of the fee payer of the recurring request
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryRecurringRequestResponse) Reset()         { *m = QueryRecurringRequestResponse{} }
+func (m *QueryRecurringRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecurringRequestResponse) ProtoMessage()    {}
+func (*QueryRecurringRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{29}
 }
-
-func (c *queryClient) Counts(ctx context.Context, in *QueryCountsRequest, opts ...grpc.CallOption) (*QueryCountsResponse, error) {
-	out := new(QueryCountsResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Counts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRecurringRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecurringRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRecurringRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringRequestResponse.Merge(m, src)
+}
+func (m *QueryRecurringRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecurringRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringRequestResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Data(ctx context.Context, in *QueryDataRequest, opts ...grpc.CallOption) (*QueryDataResponse, error) {
-	out := new(QueryDataResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Data", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRecurringRequestResponse proto.InternalMessageInfo
+
+func (m *QueryRecurringRequestResponse) GetRecurringRequest() *RecurringRequest {
+	if m != nil {
+		return m.RecurringRequest
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) DataSource(ctx context.Context, in *QueryDataSourceRequest, opts ...grpc.CallOption) (*QueryDataSourceResponse, error) {
-	out := new(QueryDataSourceResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/DataSource", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRecurringRequestResponse) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) OracleScript(ctx context.Context, in *QueryOracleScriptRequest, opts ...grpc.CallOption) (*QueryOracleScriptResponse, error) {
-	out := new(QueryOracleScriptResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/OracleScript", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryRecurringRequestsRequest is request type for the
+// Query/RecurringRequests RPC method.
+type QueryRecurringRequestsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Request(ctx context.Context, in *QueryRequestRequest, opts ...grpc.CallOption) (*QueryRequestResponse, error) {
-	out := new(QueryRequestResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Request", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRecurringRequestsRequest) Reset()         { *m = QueryRecurringRequestsRequest{} }
+func (m *QueryRecurringRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecurringRequestsRequest) ProtoMessage()    {}
+func (*QueryRecurringRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{30}
+}
+func (m *QueryRecurringRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecurringRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringRequestsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRecurringRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringRequestsRequest.Merge(m, src)
+}
+func (m *QueryRecurringRequestsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecurringRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringRequestsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) PendingRequests(ctx context.Context, in *QueryPendingRequestsRequest, opts ...grpc.CallOption) (*QueryPendingRequestsResponse, error) {
-	out := new(QueryPendingRequestsResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/PendingRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRecurringRequestsRequest proto.InternalMessageInfo
+
+func (m *QueryRecurringRequestsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error) {
-	out := new(QueryValidatorResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Validator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryRecurringRequestsResponse is response type for the
+// Query/RecurringRequests RPC method.
+type QueryRecurringRequestsResponse struct {
+	// RecurringRequests is a list of recurring requests
+	RecurringRequests []*RecurringRequest `protobuf:"bytes,1,rep,name=recurring_requests,json=recurringRequests,proto3" json:"recurring_requests,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) IsReporter(ctx context.Context, in *QueryIsReporterRequest, opts ...grpc.CallOption) (*QueryIsReporterResponse, error) {
-	out := new(QueryIsReporterResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/IsReporter", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRecurringRequestsResponse) Reset()         { *m = QueryRecurringRequestsResponse{} }
+func (m *QueryRecurringRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecurringRequestsResponse) ProtoMessage()    {}
+func (*QueryRecurringRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{31}
+}
+func (m *QueryRecurringRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecurringRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringRequestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRecurringRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringRequestsResponse.Merge(m, src)
+}
+func (m *QueryRecurringRequestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecurringRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringRequestsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Reporters(ctx context.Context, in *QueryReportersRequest, opts ...grpc.CallOption) (*QueryReportersResponse, error) {
-	out := new(QueryReportersResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Reporters", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRecurringRequestsResponse proto.InternalMessageInfo
+
+func (m *QueryRecurringRequestsResponse) GetRecurringRequests() []*RecurringRequest {
+	if m != nil {
+		return m.RecurringRequests
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ActiveValidators(ctx context.Context, in *QueryActiveValidatorsRequest, opts ...grpc.CallOption) (*QueryActiveValidatorsResponse, error) {
-	out := new(QueryActiveValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/ActiveValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRecurringRequestsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryRecurringRequestSpawnsRequest is request type for the
+// Query/RecurringRequestSpawns RPC method.
+type QueryRecurringRequestSpawnsRequest struct {
+	// RecurringRequestID is ID of a recurring request
+	RecurringRequestId uint64 `protobuf:"varint,1,opt,name=recurring_request_id,json=recurringRequestId,proto3" json:"recurring_request_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) RequestSearch(ctx context.Context, in *QueryRequestSearchRequest, opts ...grpc.CallOption) (*QueryRequestSearchResponse, error) {
-	out := new(QueryRequestSearchResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/RequestSearch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRecurringRequestSpawnsRequest) Reset()         { *m = QueryRecurringRequestSpawnsRequest{} }
+func (m *QueryRecurringRequestSpawnsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecurringRequestSpawnsRequest) ProtoMessage()    {}
+func (*QueryRecurringRequestSpawnsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{32}
+}
+func (m *QueryRecurringRequestSpawnsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecurringRequestSpawnsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringRequestSpawnsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRecurringRequestSpawnsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringRequestSpawnsRequest.Merge(m, src)
+}
+func (m *QueryRecurringRequestSpawnsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecurringRequestSpawnsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringRequestSpawnsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) RequestPrice(ctx context.Context, in *QueryRequestPriceRequest, opts ...grpc.CallOption) (*QueryRequestPriceResponse, error) {
-	out := new(QueryRequestPriceResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/RequestPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRecurringRequestSpawnsRequest proto.InternalMessageInfo
+
+func (m *QueryRecurringRequestSpawnsRequest) GetRecurringRequestId() uint64 {
+	if m != nil {
+		return m.RecurringRequestId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) RequestVerification(ctx context.Context, in *QueryRequestVerificationRequest, opts ...grpc.CallOption) (*QueryRequestVerificationResponse, error) {
-	out := new(QueryRequestVerificationResponse)
-	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/RequestVerification", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRecurringRequestSpawnsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Counts queries the number of existing data sources, oracle scripts, and
-	// requests.
-	Counts(context.Context, *QueryCountsRequest) (*QueryCountsResponse, error)
-	// Data queries content of the data source or oracle script for given SHA256
-	// file hash.
-	Data(context.Context, *QueryDataRequest) (*QueryDataResponse, error)
-	// DataSource queries data source summary info for given data source id.
-	DataSource(context.Context, *QueryDataSourceRequest) (*QueryDataSourceResponse, error)
-	// OracleScript queries oracle script summary info for given oracle script id.
-	OracleScript(context.Context, *QueryOracleScriptRequest) (*QueryOracleScriptResponse, error)
-	// Request queries request info for given request id.
-	Request(context.Context, *QueryRequestRequest) (*QueryRequestResponse, error)
-	// PendingRequests queries list of pending request IDs assigned to given
-	// validator.
-	PendingRequests(context.Context, *QueryPendingRequestsRequest) (*QueryPendingRequestsResponse, error)
-	// Validator queries properties of given validator address.
-	Validator(context.Context, *QueryValidatorRequest) (*QueryValidatorResponse, error)
-	// IsReporter queries grant of account on this validator.
-	IsReporter(context.Context, *QueryIsReporterRequest) (*QueryIsReporterResponse, error)
-	// Reporters queries all reporters associated with given validator address.
-	Reporters(context.Context, *QueryReportersRequest) (*QueryReportersResponse, error)
-	// ActiveValidators queries all active oracle validators.
-	ActiveValidators(context.Context, *QueryActiveValidatorsRequest) (*QueryActiveValidatorsResponse, error)
-	// Params queries parameters used for runnning bandchain network.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// RequestSearch queries the latest request that match search criteria.
-	RequestSearch(context.Context, *QueryRequestSearchRequest) (*QueryRequestSearchResponse, error)
-	// RequestPrice queries the latest price on standard price reference oracle
-	// script.
-	RequestPrice(context.Context, *QueryRequestPriceRequest) (*QueryRequestPriceResponse, error)
-	// RequestVerification verifies a request to make sure that
-	// all information that will be used to report the data is valid
-	RequestVerification(context.Context, *QueryRequestVerificationRequest) (*QueryRequestVerificationResponse, error)
+// QueryRecurringRequestSpawnsResponse is response type for the
+// Query/RecurringRequestSpawns RPC method.
+type QueryRecurringRequestSpawnsResponse struct {
+	// RequestIDs is a list of unexpired oracle request IDs spawned by the recurring
+	// request
+	RequestIDs []uint64 `protobuf:"varint,1,rep,packed,name=request_ids,json=requestIds,proto3" json:"request_ids,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryRecurringRequestSpawnsResponse) Reset()         { *m = QueryRecurringRequestSpawnsResponse{} }
+func (m *QueryRecurringRequestSpawnsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecurringRequestSpawnsResponse) ProtoMessage()    {}
+func (*QueryRecurringRequestSpawnsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{33}
 }
-
-func (*UnimplementedQueryServer) Counts(ctx context.Context, req *QueryCountsRequest) (*QueryCountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Counts not implemented")
+func (m *QueryRecurringRequestSpawnsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Data(ctx context.Context, req *QueryDataRequest) (*QueryDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Data not implemented")
+func (m *QueryRecurringRequestSpawnsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringRequestSpawnsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) DataSource(ctx context.Context, req *QueryDataSourceRequest) (*QueryDataSourceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DataSource not implemented")
+func (m *QueryRecurringRequestSpawnsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringRequestSpawnsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) OracleScript(ctx context.Context, req *QueryOracleScriptRequest) (*QueryOracleScriptResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OracleScript not implemented")
+func (m *QueryRecurringRequestSpawnsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Request(ctx context.Context, req *QueryRequestRequest) (*QueryRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Request not implemented")
+func (m *QueryRecurringRequestSpawnsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringRequestSpawnsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) PendingRequests(ctx context.Context, req *QueryPendingRequestsRequest) (*QueryPendingRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingRequests not implemented")
+
+var xxx_messageInfo_QueryRecurringRequestSpawnsResponse proto.InternalMessageInfo
+
+func (m *QueryRecurringRequestSpawnsResponse) GetRequestIDs() []uint64 {
+	if m != nil {
+		return m.RequestIDs
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) Validator(ctx context.Context, req *QueryValidatorRequest) (*QueryValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
+
+func (m *QueryRecurringRequestSpawnsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) IsReporter(ctx context.Context, req *QueryIsReporterRequest) (*QueryIsReporterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsReporter not implemented")
+
+// QueryFeeEscrowRequest is request type for the Query/FeeEscrow RPC method.
+type QueryFeeEscrowRequest struct {
+	// RequestID is ID of an oracle request
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
-func (*UnimplementedQueryServer) Reporters(ctx context.Context, req *QueryReportersRequest) (*QueryReportersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Reporters not implemented")
+
+func (m *QueryFeeEscrowRequest) Reset()         { *m = QueryFeeEscrowRequest{} }
+func (m *QueryFeeEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeEscrowRequest) ProtoMessage()    {}
+func (*QueryFeeEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{34}
 }
-func (*UnimplementedQueryServer) ActiveValidators(ctx context.Context, req *QueryActiveValidatorsRequest) (*QueryActiveValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActiveValidators not implemented")
+func (m *QueryFeeEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryFeeEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) RequestSearch(ctx context.Context, req *QueryRequestSearchRequest) (*QueryRequestSearchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestSearch not implemented")
+func (m *QueryFeeEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeEscrowRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) RequestPrice(ctx context.Context, req *QueryRequestPriceRequest) (*QueryRequestPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestPrice not implemented")
+func (m *QueryFeeEscrowRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) RequestVerification(ctx context.Context, req *QueryRequestVerificationRequest) (*QueryRequestVerificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestVerification not implemented")
+func (m *QueryFeeEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeEscrowRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryFeeEscrowRequest proto.InternalMessageInfo
 
-func _Query_Counts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Counts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/Counts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Counts(ctx, req.(*QueryCountsRequest))
+func (m *QueryFeeEscrowRequest) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Data_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Data(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/Data",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Data(ctx, req.(*QueryDataRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryFeeEscrowResponse is response type for the Query/FeeEscrow RPC method.
+type QueryFeeEscrowResponse struct {
+	// FeeEscrow is the data source fees held for the request
+	FeeEscrow *FeeEscrow `protobuf:"bytes,1,opt,name=fee_escrow,json=feeEscrow,proto3" json:"fee_escrow,omitempty"`
 }
 
-func _Query_DataSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDataSourceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DataSource(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/DataSource",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DataSource(ctx, req.(*QueryDataSourceRequest))
+func (m *QueryFeeEscrowResponse) Reset()         { *m = QueryFeeEscrowResponse{} }
+func (m *QueryFeeEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeEscrowResponse) ProtoMessage()    {}
+func (*QueryFeeEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{35}
+}
+func (m *QueryFeeEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryFeeEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeEscrowResponse.Merge(m, src)
+}
+func (m *QueryFeeEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeEscrowResponse.DiscardUnknown(m)
 }
 
-func _Query_OracleScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOracleScriptRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OracleScript(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/OracleScript",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OracleScript(ctx, req.(*QueryOracleScriptRequest))
+var xxx_messageInfo_QueryFeeEscrowResponse proto.InternalMessageInfo
+
+func (m *QueryFeeEscrowResponse) GetFeeEscrow() *FeeEscrow {
+	if m != nil {
+		return m.FeeEscrow
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Request_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRequestRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Request(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/Request",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Request(ctx, req.(*QueryRequestRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryValidatorReliabilityRequest is request type for the
+// Query/ValidatorReliability RPC method.
+type QueryValidatorReliabilityRequest struct {
+	// ValidatorAddress is address of a validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func _Query_PendingRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPendingRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingRequests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/PendingRequests",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingRequests(ctx, req.(*QueryPendingRequestsRequest))
+func (m *QueryValidatorReliabilityRequest) Reset()         { *m = QueryValidatorReliabilityRequest{} }
+func (m *QueryValidatorReliabilityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorReliabilityRequest) ProtoMessage()    {}
+func (*QueryValidatorReliabilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{36}
+}
+func (m *QueryValidatorReliabilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorReliabilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorReliabilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryValidatorReliabilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorReliabilityRequest.Merge(m, src)
+}
+func (m *QueryValidatorReliabilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorReliabilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorReliabilityRequest.DiscardUnknown(m)
 }
 
-func _Query_Validator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Validator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/Validator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Validator(ctx, req.(*QueryValidatorRequest))
+var xxx_messageInfo_QueryValidatorReliabilityRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorReliabilityRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_IsReporter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIsReporterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IsReporter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/IsReporter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IsReporter(ctx, req.(*QueryIsReporterRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryValidatorReliabilityResponse is response type for the
+// Query/ValidatorReliability RPC method.
+type QueryValidatorReliabilityResponse struct {
+	// Reliability is the oracle reporting record of the validator
+	Reliability ValidatorReliability `protobuf:"bytes,1,opt,name=reliability,proto3" json:"reliability"`
+	// SamplingWeightPercentage is the percentage of the validator power used as
+	// its weight in request sampling
+	SamplingWeightPercentage uint64 `protobuf:"varint,2,opt,name=sampling_weight_percentage,json=samplingWeightPercentage,proto3" json:"sampling_weight_percentage,omitempty"`
 }
 
-func _Query_Reporters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReportersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Reporters(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/Reporters",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Reporters(ctx, req.(*QueryReportersRequest))
+func (m *QueryValidatorReliabilityResponse) Reset()         { *m = QueryValidatorReliabilityResponse{} }
+func (m *QueryValidatorReliabilityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorReliabilityResponse) ProtoMessage()    {}
+func (*QueryValidatorReliabilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{37}
+}
+func (m *QueryValidatorReliabilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorReliabilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorReliabilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryValidatorReliabilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorReliabilityResponse.Merge(m, src)
+}
+func (m *QueryValidatorReliabilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorReliabilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorReliabilityResponse.DiscardUnknown(m)
 }
 
-func _Query_ActiveValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActiveValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ActiveValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/ActiveValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ActiveValidators(ctx, req.(*QueryActiveValidatorsRequest))
+var xxx_messageInfo_QueryValidatorReliabilityResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorReliabilityResponse) GetReliability() ValidatorReliability {
+	if m != nil {
+		return m.Reliability
 	}
-	return interceptor(ctx, in, info, handler)
+	return ValidatorReliability{}
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryValidatorReliabilityResponse) GetSamplingWeightPercentage() uint64 {
+	if m != nil {
+		return m.SamplingWeightPercentage
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_RequestSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRequestSearchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RequestSearch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/RequestSearch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RequestSearch(ctx, req.(*QueryRequestSearchRequest))
+// QueryPrunedResultsRequest is request type for the Query/PrunedResults RPC
+// method.
+type QueryPrunedResultsRequest struct {
+}
+
+func (m *QueryPrunedResultsRequest) Reset()         { *m = QueryPrunedResultsRequest{} }
+func (m *QueryPrunedResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPrunedResultsRequest) ProtoMessage()    {}
+func (*QueryPrunedResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{38}
+}
+func (m *QueryPrunedResultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrunedResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrunedResultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPrunedResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrunedResultsRequest.Merge(m, src)
+}
+func (m *QueryPrunedResultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrunedResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrunedResultsRequest.DiscardUnknown(m)
 }
 
-func _Query_RequestPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRequestPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPrunedResultsRequest proto.InternalMessageInfo
+
+// QueryPrunedResultsResponse is response type for the Query/PrunedResults RPC
+// method.
+type QueryPrunedResultsResponse struct {
+	// FromRequestID is the first request ID whose result has been pruned, or 0
+	// if no result has been pruned
+	FromRequestID uint64 `protobuf:"varint,1,opt,name=from_request_id,json=fromRequestId,proto3" json:"from_request_id,omitempty"`
+	// ToRequestID is the last request ID whose result has been pruned, or 0 if
+	// no result has been pruned
+	ToRequestID uint64 `protobuf:"varint,2,opt,name=to_request_id,json=toRequestId,proto3" json:"to_request_id,omitempty"`
+}
+
+func (m *QueryPrunedResultsResponse) Reset()         { *m = QueryPrunedResultsResponse{} }
+func (m *QueryPrunedResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPrunedResultsResponse) ProtoMessage()    {}
+func (*QueryPrunedResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{39}
+}
+func (m *QueryPrunedResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrunedResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrunedResultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RequestPrice(ctx, in)
+}
+func (m *QueryPrunedResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrunedResultsResponse.Merge(m, src)
+}
+func (m *QueryPrunedResultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrunedResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrunedResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPrunedResultsResponse proto.InternalMessageInfo
+
+func (m *QueryPrunedResultsResponse) GetFromRequestID() uint64 {
+	if m != nil {
+		return m.FromRequestID
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/RequestPrice",
+	return 0
+}
+
+func (m *QueryPrunedResultsResponse) GetToRequestID() uint64 {
+	if m != nil {
+		return m.ToRequestID
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RequestPrice(ctx, req.(*QueryRequestPriceRequest))
+	return 0
+}
+
+// QueryChannelPrepaidBalanceRequest is request type for the
+// Query/ChannelPrepaidBalance RPC method.
+type QueryChannelPrepaidBalanceRequest struct {
+	// ChannelID is the ID of an oracle IBC channel
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryChannelPrepaidBalanceRequest) Reset()         { *m = QueryChannelPrepaidBalanceRequest{} }
+func (m *QueryChannelPrepaidBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelPrepaidBalanceRequest) ProtoMessage()    {}
+func (*QueryChannelPrepaidBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{40}
+}
+func (m *QueryChannelPrepaidBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelPrepaidBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelPrepaidBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryChannelPrepaidBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelPrepaidBalanceRequest.Merge(m, src)
+}
+func (m *QueryChannelPrepaidBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelPrepaidBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelPrepaidBalanceRequest.DiscardUnknown(m)
 }
 
-func _Query_RequestVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRequestVerificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryChannelPrepaidBalanceRequest proto.InternalMessageInfo
+
+func (m *QueryChannelPrepaidBalanceRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RequestVerification(ctx, in)
+	return ""
+}
+
+// QueryChannelPrepaidBalanceResponse is response type for the
+// Query/ChannelPrepaidBalance RPC method.
+type QueryChannelPrepaidBalanceResponse struct {
+	// Address is the address of the prepaid fee account of the channel
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Balance is the prepaid fee balance of the channel
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *QueryChannelPrepaidBalanceResponse) Reset()         { *m = QueryChannelPrepaidBalanceResponse{} }
+func (m *QueryChannelPrepaidBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelPrepaidBalanceResponse) ProtoMessage()    {}
+func (*QueryChannelPrepaidBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e351f430ef3842d0, []int{41}
+}
+func (m *QueryChannelPrepaidBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelPrepaidBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelPrepaidBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/band.oracle.v1.Query/RequestVerification",
+}
+func (m *QueryChannelPrepaidBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelPrepaidBalanceResponse.Merge(m, src)
+}
+func (m *QueryChannelPrepaidBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelPrepaidBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelPrepaidBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelPrepaidBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryChannelPrepaidBalanceResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RequestVerification(ctx, req.(*QueryRequestVerificationRequest))
+	return ""
+}
+
+func (m *QueryChannelPrepaidBalanceResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "band.oracle.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Counts",
-			Handler:    _Query_Counts_Handler,
-		},
-		{
-			MethodName: "Data",
-			Handler:    _Query_Data_Handler,
-		},
-		{
-			MethodName: "DataSource",
-			Handler:    _Query_DataSource_Handler,
-		},
-		{
-			MethodName: "OracleScript",
-			Handler:    _Query_OracleScript_Handler,
-		},
-		{
-			MethodName: "Request",
-			Handler:    _Query_Request_Handler,
-		},
-		{
-			MethodName: "PendingRequests",
-			Handler:    _Query_PendingRequests_Handler,
-		},
-		{
-			MethodName: "Validator",
-			Handler:    _Query_Validator_Handler,
-		},
-		{
-			MethodName: "IsReporter",
-			Handler:    _Query_IsReporter_Handler,
-		},
-		{
-			MethodName: "Reporters",
-			Handler:    _Query_Reporters_Handler,
-		},
-		{
-			MethodName: "ActiveValidators",
-			Handler:    _Query_ActiveValidators_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "RequestSearch",
-			Handler:    _Query_RequestSearch_Handler,
-		},
-		{
-			MethodName: "RequestPrice",
-			Handler:    _Query_RequestPrice_Handler,
-		},
-		{
-			MethodName: "RequestVerification",
-			Handler:    _Query_RequestVerification_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "band/oracle/v1/query.proto",
+func init() {
+	proto.RegisterType((*QueryCountsRequest)(nil), "band.oracle.v1.QueryCountsRequest")
+	proto.RegisterType((*QueryCountsResponse)(nil), "band.oracle.v1.QueryCountsResponse")
+	proto.RegisterType((*QueryDataRequest)(nil), "band.oracle.v1.QueryDataRequest")
+	proto.RegisterType((*QueryDataResponse)(nil), "band.oracle.v1.QueryDataResponse")
+	proto.RegisterType((*QueryDataSourceRequest)(nil), "band.oracle.v1.QueryDataSourceRequest")
+	proto.RegisterType((*QueryDataSourceResponse)(nil), "band.oracle.v1.QueryDataSourceResponse")
+	proto.RegisterType((*QueryOracleScriptRequest)(nil), "band.oracle.v1.QueryOracleScriptRequest")
+	proto.RegisterType((*QueryOracleScriptResponse)(nil), "band.oracle.v1.QueryOracleScriptResponse")
+	proto.RegisterType((*QueryRequestRequest)(nil), "band.oracle.v1.QueryRequestRequest")
+	proto.RegisterType((*QueryRequestResponse)(nil), "band.oracle.v1.QueryRequestResponse")
+	proto.RegisterType((*QueryPendingRequestsRequest)(nil), "band.oracle.v1.QueryPendingRequestsRequest")
+	proto.RegisterType((*QueryPendingRequestsResponse)(nil), "band.oracle.v1.QueryPendingRequestsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "band.oracle.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "band.oracle.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryValidatorRequest)(nil), "band.oracle.v1.QueryValidatorRequest")
+	proto.RegisterType((*QueryValidatorResponse)(nil), "band.oracle.v1.QueryValidatorResponse")
+	proto.RegisterType((*QueryIsReporterRequest)(nil), "band.oracle.v1.QueryIsReporterRequest")
+	proto.RegisterType((*QueryIsReporterResponse)(nil), "band.oracle.v1.QueryIsReporterResponse")
+	proto.RegisterType((*QueryReportersRequest)(nil), "band.oracle.v1.QueryReportersRequest")
+	proto.RegisterType((*QueryReportersResponse)(nil), "band.oracle.v1.QueryReportersResponse")
+	proto.RegisterType((*QueryActiveValidatorsRequest)(nil), "band.oracle.v1.QueryActiveValidatorsRequest")
+	proto.RegisterType((*QueryActiveValidatorsResponse)(nil), "band.oracle.v1.QueryActiveValidatorsResponse")
+	proto.RegisterType((*QueryRequestSearchRequest)(nil), "band.oracle.v1.QueryRequestSearchRequest")
+	proto.RegisterType((*QueryRequestSearchResponse)(nil), "band.oracle.v1.QueryRequestSearchResponse")
+	proto.RegisterType((*QueryRequestPriceRequest)(nil), "band.oracle.v1.QueryRequestPriceRequest")
+	proto.RegisterType((*QueryRequestPriceResponse)(nil), "band.oracle.v1.QueryRequestPriceResponse")
+	proto.RegisterType((*QueryRequestVerificationRequest)(nil), "band.oracle.v1.QueryRequestVerificationRequest")
+	proto.RegisterType((*QueryRequestVerificationResponse)(nil), "band.oracle.v1.QueryRequestVerificationResponse")
+	proto.RegisterType((*QueryRecurringRequestRequest)(nil), "band.oracle.v1.QueryRecurringRequestRequest")
+	proto.RegisterType((*QueryRecurringRequestResponse)(nil), "band.oracle.v1.QueryRecurringRequestResponse")
+	proto.RegisterType((*QueryRecurringRequestsRequest)(nil), "band.oracle.v1.QueryRecurringRequestsRequest")
+	proto.RegisterType((*QueryRecurringRequestsResponse)(nil), "band.oracle.v1.QueryRecurringRequestsResponse")
+	proto.RegisterType((*QueryRecurringRequestSpawnsRequest)(nil), "band.oracle.v1.QueryRecurringRequestSpawnsRequest")
+	proto.RegisterType((*QueryRecurringRequestSpawnsResponse)(nil), "band.oracle.v1.QueryRecurringRequestSpawnsResponse")
+	proto.RegisterType((*QueryFeeEscrowRequest)(nil), "band.oracle.v1.QueryFeeEscrowRequest")
+	proto.RegisterType((*QueryFeeEscrowResponse)(nil), "band.oracle.v1.QueryFeeEscrowResponse")
+	proto.RegisterType((*QueryValidatorReliabilityRequest)(nil), "band.oracle.v1.QueryValidatorReliabilityRequest")
+	proto.RegisterType((*QueryValidatorReliabilityResponse)(nil), "band.oracle.v1.QueryValidatorReliabilityResponse")
+	proto.RegisterType((*QueryPrunedResultsRequest)(nil), "band.oracle.v1.QueryPrunedResultsRequest")
+	proto.RegisterType((*QueryPrunedResultsResponse)(nil), "band.oracle.v1.QueryPrunedResultsResponse")
+	proto.RegisterType((*QueryChannelPrepaidBalanceRequest)(nil), "band.oracle.v1.QueryChannelPrepaidBalanceRequest")
+	proto.RegisterType((*QueryChannelPrepaidBalanceResponse)(nil), "band.oracle.v1.QueryChannelPrepaidBalanceResponse")
 }
 
-func (m *QueryCountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("band/oracle/v1/query.proto", fileDescriptor_e351f430ef3842d0) }
+
+var fileDescriptor_e351f430ef3842d0 = []byte{
+	// 2288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0xdb, 0x8e, 0xed, 0x79, 0xb6, 0x13, 0xbb, 0xe2, 0xcd, 0x8e, 0xdb, 0xf1, 0xd8, 0xe9,
+	0x64, 0x13, 0x27, 0x59, 0x4f, 0xfb, 0x23, 0x24, 0xcb, 0x6e, 0xf6, 0x23, 0x63, 0x13, 0x18, 0x01,
+	0x1b, 0xef, 0x18, 0x05, 0x89, 0x03, 0x43, 0x79, 0xba, 0x3d, 0x6e, 0x65, 0xa6, 0x7b, 0xb6, 0xab,
+	0xc7, 0x89, 0x15, 0x99, 0x03, 0x07, 0x24, 0xa4, 0x45, 0x8b, 0x84, 0x40, 0x42, 0x48, 0x20, 0x0e,
+	0x70, 0x40, 0x5a, 0x71, 0x59, 0x21, 0x94, 0x0b, 0x37, 0xb4, 0xc7, 0xd5, 0x22, 0x24, 0x4e, 0x01,
+	0x39, 0x2b, 0x21, 0xf1, 0x4f, 0x80, 0xba, 0xea, 0x55, 0x7f, 0xf7, 0x78, 0x62, 0xad, 0x0f, 0x7b,
+	0xf2, 0x74, 0xbd, 0xaf, 0x5f, 0xbd, 0x7a, 0xf5, 0xea, 0xbd, 0x27, 0x83, 0xba, 0x4d, 0x6d, 0x43,
+	0x77, 0x5c, 0xda, 0x68, 0x99, 0xfa, 0xde, 0x8a, 0xfe, 0x7e, 0xd7, 0x74, 0xf7, 0xcb, 0x1d, 0xd7,
+	0xf1, 0x1c, 0x72, 0xc6, 0xa7, 0x95, 0x05, 0xad, 0xbc, 0xb7, 0xa2, 0x4e, 0x37, 0x9d, 0xa6, 0xc3,
+	0x49, 0xba, 0xff, 0x4b, 0x70, 0xa9, 0x17, 0x9a, 0x8e, 0xd3, 0x6c, 0x99, 0x3a, 0xed, 0x58, 0x3a,
+	0xb5, 0x6d, 0xc7, 0xa3, 0x9e, 0xe5, 0xd8, 0x0c, 0xa9, 0x33, 0x0d, 0x87, 0xb5, 0x1d, 0x56, 0x17,
+	0x62, 0xe2, 0x03, 0x49, 0xd7, 0xc5, 0x97, 0xbe, 0x4d, 0x99, 0x29, 0xec, 0xea, 0x7b, 0x2b, 0xdb,
+	0xa6, 0x47, 0x57, 0xf4, 0x0e, 0x6d, 0x5a, 0x36, 0xd7, 0x83, 0xbc, 0xa5, 0x28, 0xaf, 0xe4, 0x6a,
+	0x38, 0x96, 0xa4, 0xcf, 0x26, 0xb6, 0x81, 0xa0, 0x39, 0x51, 0x9b, 0x06, 0xf2, 0x9e, 0xaf, 0x7e,
+	0xdd, 0xe9, 0xda, 0x1e, 0xab, 0x99, 0xef, 0x77, 0x4d, 0xe6, 0x69, 0xbf, 0x54, 0xe0, 0x5c, 0x6c,
+	0x99, 0x75, 0x1c, 0x9b, 0x99, 0xe4, 0x3a, 0x4c, 0x19, 0xd4, 0xa3, 0x75, 0xe6, 0x74, 0xdd, 0x86,
+	0x59, 0x6f, 0xf8, 0xd4, 0xa2, 0xb2, 0xa0, 0x2c, 0x0e, 0xd5, 0xce, 0xfa, 0x84, 0x2d, 0xbe, 0xce,
+	0x85, 0x48, 0x19, 0xce, 0x09, 0x4b, 0x75, 0xd6, 0x70, 0xad, 0x8e, 0x87, 0xdc, 0x03, 0x9c, 0x7b,
+	0x4a, 0x90, 0xb6, 0x38, 0x45, 0xf0, 0x5f, 0x82, 0x09, 0x57, 0x98, 0x47, 0xce, 0x41, 0xce, 0x39,
+	0x8e, 0x8b, 0x9c, 0x49, 0xd3, 0x61, 0x92, 0xe3, 0xda, 0xa0, 0x1e, 0x45, 0xb0, 0x64, 0x16, 0x0a,
+	0x1c, 0xd4, 0x2e, 0x65, 0xbb, 0x1c, 0x4c, 0xa1, 0x36, 0xea, 0x2f, 0x7c, 0x83, 0xb2, 0x5d, 0xed,
+	0x2a, 0x4c, 0x45, 0x04, 0x70, 0x1b, 0x04, 0x86, 0x7c, 0x06, 0xce, 0x3c, 0x5e, 0xe3, 0xbf, 0xb5,
+	0xb7, 0xe0, 0x7c, 0xc0, 0x28, 0xb6, 0x21, 0xf5, 0x5f, 0x86, 0x33, 0xd1, 0x4d, 0x5b, 0x06, 0xee,
+	0x78, 0x3c, 0xdc, 0x71, 0xd5, 0xd0, 0x1e, 0xc0, 0xcb, 0x29, 0x79, 0x34, 0xf7, 0x06, 0x8c, 0x45,
+	0x14, 0x70, 0xe9, 0xb1, 0x55, 0xb5, 0x1c, 0x8f, 0xa0, 0x72, 0x44, 0x10, 0x42, 0xcd, 0xda, 0x06,
+	0x14, 0xb9, 0xde, 0xfb, 0x11, 0x87, 0x49, 0x64, 0x8b, 0x30, 0x19, 0x77, 0x71, 0x80, 0xed, 0x4c,
+	0xd4, 0xbf, 0x55, 0x43, 0xfb, 0x3e, 0xcc, 0x64, 0x68, 0x41, 0x7c, 0x77, 0x61, 0x22, 0xa6, 0x06,
+	0x11, 0x5e, 0x48, 0x22, 0x8c, 0x09, 0x8f, 0x47, 0x2d, 0x68, 0x37, 0x31, 0x5e, 0x10, 0x99, 0x04,
+	0x38, 0x07, 0x20, 0xcf, 0x34, 0x80, 0x56, 0xc0, 0x95, 0xaa, 0xa1, 0xfd, 0x57, 0x81, 0xe9, 0xb8,
+	0x18, 0x22, 0x5a, 0x81, 0x11, 0xe4, 0x42, 0x2c, 0x2f, 0x27, 0xb1, 0x48, 0x09, 0xc9, 0x47, 0x6e,
+	0xf9, 0x22, 0x1d, 0xc7, 0xf5, 0x58, 0x71, 0x60, 0x61, 0x70, 0x71, 0x6c, 0xf5, 0x7c, 0x5a, 0xc4,
+	0x27, 0x57, 0x86, 0x3e, 0x79, 0x36, 0x7f, 0xaa, 0x26, 0x99, 0x49, 0x19, 0x86, 0x5d, 0x93, 0x75,
+	0x5b, 0x22, 0xde, 0x32, 0xc5, 0x7c, 0x6a, 0x0d, 0xb9, 0xc8, 0x6d, 0x18, 0x61, 0x56, 0xd3, 0xb6,
+	0xec, 0x66, 0x71, 0x88, 0x0b, 0xcc, 0x25, 0x05, 0xb6, 0x04, 0x19, 0xe5, 0x24, 0xb7, 0xd6, 0x86,
+	0x59, 0xbe, 0xd7, 0x4d, 0xd3, 0x36, 0x38, 0x99, 0xe3, 0x96, 0x57, 0x8e, 0xbc, 0x0b, 0x53, 0x7b,
+	0xb4, 0x65, 0x19, 0xd4, 0x73, 0xdc, 0x3a, 0x35, 0x0c, 0xd7, 0x64, 0x4c, 0x44, 0x73, 0xe5, 0xe2,
+	0x67, 0x1f, 0x2f, 0xcd, 0x61, 0x7a, 0x78, 0x20, 0x79, 0xee, 0x0a, 0x96, 0x2d, 0xcf, 0xf5, 0x95,
+	0x4d, 0xee, 0x25, 0xd6, 0xb5, 0xfb, 0x70, 0x21, 0xdb, 0x1c, 0xba, 0x58, 0x87, 0xb1, 0xf0, 0x68,
+	0x7c, 0x4b, 0x83, 0x8b, 0x43, 0x95, 0x33, 0x87, 0xcf, 0xe6, 0x01, 0x59, 0xab, 0x1b, 0xac, 0x06,
+	0xc1, 0x59, 0xb1, 0x20, 0x53, 0x6c, 0x52, 0x97, 0xb6, 0x83, 0x4c, 0xf1, 0x4d, 0x3c, 0x78, 0xb9,
+	0x8a, 0xda, 0x6f, 0xc2, 0x70, 0x87, 0xaf, 0xe0, 0xf9, 0xa5, 0xbc, 0x2a, 0xf8, 0xf1, 0x30, 0x90,
+	0x57, 0x6b, 0xc2, 0x4b, 0x5c, 0x59, 0xb0, 0xc9, 0x93, 0x72, 0xce, 0x7b, 0x78, 0xd9, 0x23, 0x86,
+	0x10, 0xf8, 0x6d, 0x18, 0x66, 0x1e, 0xf5, 0xba, 0x12, 0xf8, 0x7c, 0x12, 0x78, 0x20, 0xb2, 0xc5,
+	0xd9, 0x6a, 0xc8, 0xae, 0x7d, 0xa4, 0xa0, 0xce, 0x2a, 0x13, 0x81, 0x66, 0x9e, 0x14, 0x7a, 0xb2,
+	0x0e, 0x93, 0x2e, 0x9a, 0x08, 0xd4, 0x0d, 0x70, 0x75, 0xc5, 0xcf, 0x3e, 0x5e, 0x9a, 0x46, 0x75,
+	0x71, 0x2d, 0x67, 0xa5, 0x84, 0x74, 0xc1, 0xeb, 0x98, 0xaf, 0xa2, 0x70, 0xd1, 0x07, 0xf3, 0x30,
+	0x66, 0xb1, 0xba, 0x14, 0xe0, 0x48, 0x47, 0x6b, 0x60, 0x05, 0x8c, 0xc1, 0x39, 0xc9, 0x85, 0x13,
+	0x0b, 0xe2, 0x77, 0xd1, 0xa7, 0x11, 0x43, 0x41, 0x80, 0x8d, 0x46, 0x00, 0x0e, 0xf6, 0xdc, 0x7b,
+	0xc0, 0xa9, 0x95, 0xf0, 0x52, 0xdc, 0x6d, 0x78, 0xd6, 0x9e, 0x19, 0xc0, 0x08, 0xa2, 0xf9, 0x07,
+	0x30, 0x97, 0x43, 0x47, 0xb3, 0x6f, 0x03, 0x04, 0x20, 0xc5, 0xa5, 0xc9, 0x08, 0x91, 0x84, 0x74,
+	0x2d, 0x22, 0xa2, 0x3d, 0x57, 0x30, 0x13, 0xa3, 0xc9, 0x2d, 0x93, 0xba, 0x8d, 0xdd, 0x17, 0x4e,
+	0xe8, 0x44, 0x85, 0xd1, 0x06, 0x6d, 0xb5, 0xf8, 0x33, 0x36, 0x20, 0xde, 0x3c, 0xf9, 0xed, 0x3f,
+	0x88, 0x94, 0x3d, 0x8c, 0xbd, 0xa2, 0xa3, 0x94, 0x3d, 0x14, 0xcf, 0xec, 0x2c, 0x14, 0xda, 0x96,
+	0x8d, 0xc4, 0x21, 0x41, 0x6c, 0x5b, 0xb6, 0x20, 0xde, 0x03, 0x08, 0xcb, 0x8b, 0xe2, 0x69, 0x7e,
+	0x03, 0xae, 0x94, 0xd1, 0xa9, 0x7e, 0x7d, 0x51, 0x16, 0x35, 0x10, 0x56, 0x19, 0xe5, 0x4d, 0xda,
+	0x94, 0xcf, 0x64, 0x2d, 0x22, 0xa9, 0xfd, 0x47, 0x01, 0x35, 0x6b, 0x97, 0xe8, 0xc5, 0xb7, 0x92,
+	0xe9, 0xfd, 0x72, 0xd2, 0x85, 0x59, 0xaf, 0x42, 0x98, 0xeb, 0xdf, 0xf1, 0x0f, 0x5f, 0xe4, 0x33,
+	0x4c, 0xf6, 0xfd, 0x29, 0x08, 0xa4, 0xc8, 0xd7, 0x63, 0x1b, 0x15, 0x99, 0xff, 0xea, 0x91, 0x1b,
+	0x45, 0x35, 0xd1, 0x9d, 0xfe, 0x59, 0xc1, 0xf7, 0x19, 0x6d, 0x6d, 0xba, 0x56, 0x58, 0x39, 0x14,
+	0x61, 0x84, 0xed, 0xb7, 0xb7, 0x9d, 0x96, 0x08, 0x95, 0x42, 0x4d, 0x7e, 0xc6, 0x8f, 0x68, 0xa0,
+	0xd7, 0x11, 0x0d, 0xf6, 0x3c, 0xa2, 0xa1, 0x63, 0x1f, 0xd1, 0x1f, 0x12, 0x81, 0x88, 0xc0, 0xf1,
+	0x84, 0xde, 0x81, 0x89, 0x8e, 0xbf, 0x50, 0x17, 0xaf, 0x9e, 0x0c, 0xf5, 0xd9, 0x54, 0x1a, 0x47,
+	0x29, 0xff, 0xa5, 0x1b, 0xef, 0x84, 0x1f, 0x49, 0x0f, 0x0f, 0x1c, 0xdf, 0xc3, 0x4f, 0x07, 0x60,
+	0x3e, 0x0a, 0xf4, 0x81, 0xe9, 0x5a, 0x3b, 0x56, 0x83, 0x13, 0xa5, 0xa3, 0x67, 0x60, 0xb4, 0xb1,
+	0x4b, 0x2d, 0x5b, 0xde, 0x97, 0x42, 0x6d, 0x84, 0x7f, 0x57, 0x0d, 0xf2, 0x36, 0x14, 0x82, 0xeb,
+	0x87, 0x59, 0xb2, 0x8f, 0x54, 0x14, 0xca, 0x24, 0x6a, 0x98, 0xc1, 0x44, 0x0d, 0xe3, 0x27, 0x4b,
+	0xf3, 0xb1, 0x67, 0xba, 0x36, 0x6d, 0xf9, 0x74, 0x71, 0xa3, 0x40, 0x2e, 0x55, 0x8d, 0x8c, 0xf2,
+	0xf1, 0x74, 0xba, 0x7c, 0xf4, 0xef, 0x73, 0x90, 0xcf, 0x86, 0xc5, 0x7d, 0x96, 0xdf, 0xe4, 0x02,
+	0x14, 0xfc, 0x22, 0x82, 0x7a, 0x5d, 0xd7, 0x2c, 0x8e, 0xf0, 0x9a, 0x35, 0x5c, 0xe0, 0xd1, 0x42,
+	0x1f, 0xd7, 0x0d, 0xb3, 0x45, 0xf7, 0x8b, 0xa3, 0x18, 0x2d, 0xf4, 0xf1, 0x86, 0xff, 0xad, 0xfd,
+	0x4f, 0x81, 0x85, 0x7c, 0xe7, 0xe1, 0x61, 0x7f, 0xf9, 0xbd, 0x37, 0x03, 0xa3, 0x16, 0x43, 0x17,
+	0x0c, 0xf3, 0xe7, 0x6a, 0xc4, 0x62, 0xc2, 0x03, 0x9b, 0x98, 0xf2, 0x6b, 0x66, 0xa3, 0xeb, 0xba,
+	0x61, 0x25, 0x24, 0x43, 0x67, 0x19, 0xa6, 0x5d, 0x49, 0xaa, 0xa7, 0x8a, 0x55, 0xe2, 0x26, 0xc4,
+	0xaa, 0x86, 0xf6, 0x0f, 0x05, 0x5f, 0x89, 0xb4, 0x4a, 0x74, 0xe8, 0xb7, 0x61, 0x2a, 0xa5, 0x13,
+	0x33, 0xdd, 0x42, 0xba, 0xbc, 0x4c, 0x28, 0x99, 0x4c, 0x9a, 0x24, 0x26, 0x8c, 0x18, 0x66, 0xc7,
+	0x61, 0x96, 0x87, 0xd9, 0x6e, 0x26, 0x76, 0x8f, 0xe4, 0x0d, 0x5a, 0x77, 0x2c, 0xbb, 0xb2, 0xec,
+	0x17, 0x54, 0x7f, 0xfc, 0xd7, 0xfc, 0x62, 0xd3, 0xf2, 0x76, 0xbb, 0xdb, 0xe5, 0x86, 0xd3, 0xc6,
+	0xce, 0x12, 0xff, 0x2c, 0x31, 0xe3, 0xa1, 0xee, 0xed, 0x77, 0x4c, 0xc6, 0x05, 0x58, 0x4d, 0xea,
+	0xd6, 0x9a, 0x39, 0xdb, 0x0a, 0x5e, 0xf7, 0x78, 0xea, 0x51, 0x8e, 0x9d, 0x7a, 0x9e, 0x2a, 0x50,
+	0xca, 0xb3, 0x84, 0x1e, 0xbc, 0x0f, 0x24, 0xe5, 0x41, 0x99, 0x84, 0x8e, 0x76, 0xe1, 0x54, 0xd2,
+	0x85, 0x5f, 0x60, 0x3a, 0xfa, 0x8d, 0x02, 0x5a, 0x26, 0xf8, 0xad, 0x0e, 0x7d, 0x64, 0xb3, 0x63,
+	0x87, 0x55, 0xc2, 0xbb, 0x03, 0xc7, 0xf6, 0xee, 0x6f, 0x15, 0xb8, 0xd4, 0x13, 0xe0, 0x31, 0x1b,
+	0x80, 0x2f, 0xce, 0x85, 0xb7, 0xb0, 0x7c, 0xbc, 0x67, 0x9a, 0x5f, 0x63, 0x0d, 0xd7, 0x79, 0xd4,
+	0x67, 0xbb, 0x58, 0xc3, 0x6a, 0x30, 0x22, 0x87, 0x7b, 0x79, 0x0d, 0x60, 0xc7, 0x34, 0xeb, 0x26,
+	0x5f, 0xc5, 0xc8, 0x9c, 0x49, 0x86, 0x49, 0x28, 0x56, 0xd8, 0x91, 0x3f, 0x35, 0x17, 0xf3, 0x63,
+	0xa4, 0x13, 0x68, 0x59, 0x74, 0xdb, 0x6a, 0x59, 0xde, 0xfe, 0x49, 0x55, 0xb5, 0x7f, 0x52, 0xe0,
+	0x62, 0x0f, 0xa3, 0xb8, 0xa7, 0x6f, 0xf9, 0xe7, 0x13, 0x2c, 0xe7, 0x15, 0x4a, 0x59, 0x2a, 0xb0,
+	0xab, 0x8a, 0x8a, 0x93, 0x3b, 0xa0, 0x32, 0xda, 0xee, 0xb4, 0xfc, 0x70, 0x7c, 0x64, 0x5a, 0xcd,
+	0x5d, 0xaf, 0xde, 0x31, 0xdd, 0x86, 0x69, 0x7b, 0xb4, 0x69, 0x62, 0x05, 0x52, 0x94, 0x1c, 0xdf,
+	0xe5, 0x0c, 0x9b, 0x01, 0x5d, 0x9b, 0xc5, 0x5a, 0x61, 0xd3, 0xed, 0xda, 0xa6, 0x81, 0x4f, 0xbc,
+	0x2c, 0x9a, 0x3f, 0x90, 0xc5, 0x5e, 0x82, 0x8a, 0xfb, 0xf8, 0x2a, 0x9c, 0xdd, 0x71, 0x9d, 0x76,
+	0xea, 0x12, 0x54, 0xa6, 0x0e, 0x9f, 0xcd, 0x4f, 0xdc, 0x73, 0x9d, 0x76, 0x10, 0x6f, 0xb5, 0x89,
+	0x9d, 0xc8, 0xa7, 0x41, 0xd6, 0x60, 0xc2, 0x73, 0xa2, 0x82, 0x1c, 0x67, 0xe5, 0xec, 0xe1, 0xb3,
+	0xf9, 0xb1, 0xef, 0x38, 0xa1, 0xd8, 0x98, 0xe7, 0x84, 0xe9, 0xb9, 0x82, 0xce, 0x5d, 0xdf, 0xa5,
+	0xb6, 0x6d, 0xb6, 0x36, 0x5d, 0xb3, 0x43, 0x2d, 0xa3, 0x42, 0x5b, 0xd4, 0x0e, 0x2b, 0xb3, 0x39,
+	0x80, 0x86, 0xa0, 0x87, 0x8f, 0x5e, 0x01, 0x57, 0xaa, 0x86, 0xf6, 0x57, 0x79, 0xc9, 0x73, 0x94,
+	0xe0, 0xd6, 0x56, 0x61, 0x24, 0x1e, 0x0e, 0xf9, 0x3d, 0x88, 0x64, 0xf4, 0x93, 0xf9, 0xb6, 0x50,
+	0x73, 0x22, 0xc9, 0x1c, 0x75, 0xaf, 0x7e, 0x5e, 0x84, 0xd3, 0x7c, 0x07, 0xa4, 0x05, 0xc3, 0x62,
+	0x8a, 0x47, 0xb4, 0xcc, 0x22, 0x39, 0x36, 0xf9, 0x53, 0x2f, 0xf5, 0xe4, 0x11, 0xfb, 0xd6, 0x66,
+	0x7e, 0xf4, 0xf7, 0xcf, 0x7f, 0x3e, 0x70, 0x8e, 0x4c, 0x45, 0xa6, 0x8a, 0x0d, 0x61, 0xa3, 0x03,
+	0x43, 0x1b, 0x7e, 0x0f, 0xb2, 0x90, 0xa9, 0x27, 0x32, 0xb6, 0x53, 0x2f, 0xf6, 0xe0, 0x40, 0x3b,
+	0x97, 0xb8, 0x9d, 0x39, 0x32, 0x1b, 0xb1, 0xe3, 0xbf, 0xfb, 0xfa, 0x93, 0x60, 0xe0, 0x77, 0x40,
+	0x3e, 0x54, 0x00, 0xc2, 0xd9, 0x19, 0xb9, 0x92, 0xab, 0x36, 0x36, 0xd5, 0x53, 0xaf, 0x1e, 0xc9,
+	0x87, 0x20, 0x96, 0x39, 0x88, 0xeb, 0x64, 0x31, 0x01, 0x02, 0x4b, 0x12, 0x86, 0x60, 0x82, 0x02,
+	0xe5, 0x80, 0xfc, 0x4a, 0x81, 0xf1, 0xe8, 0xac, 0x8c, 0x2c, 0x66, 0xda, 0xca, 0x98, 0xe8, 0xa9,
+	0xd7, 0xfa, 0xe0, 0x44, 0x5c, 0x37, 0x39, 0xae, 0x32, 0x79, 0x35, 0x35, 0xda, 0xc5, 0xe6, 0x91,
+	0xe9, 0x4f, 0x92, 0xcd, 0xe4, 0x01, 0xf9, 0x21, 0x8c, 0xc8, 0x3b, 0x70, 0xa9, 0x77, 0xcf, 0x24,
+	0x00, 0xf5, 0xd5, 0x58, 0x69, 0x8b, 0x1c, 0x8b, 0x46, 0x16, 0x22, 0x58, 0xe4, 0xab, 0xad, 0x3f,
+	0x09, 0x2f, 0xf0, 0x01, 0xf9, 0xbd, 0x02, 0x67, 0x13, 0x23, 0x29, 0x72, 0x23, 0xd3, 0x46, 0xf6,
+	0x9c, 0x4c, 0x7d, 0xb5, 0x3f, 0x66, 0x04, 0x76, 0x9b, 0x03, 0x5b, 0x21, 0x7a, 0x04, 0x58, 0x47,
+	0xf0, 0xd6, 0x43, 0x80, 0xa9, 0xec, 0x7e, 0x40, 0x7e, 0xaa, 0x40, 0x21, 0xc8, 0xad, 0xe4, 0x95,
+	0x4c, 0xa3, 0xc9, 0x31, 0x95, 0x7a, 0xe5, 0x28, 0x36, 0x44, 0xb5, 0xc2, 0x51, 0xdd, 0x20, 0xd7,
+	0x22, 0xa8, 0xc2, 0x19, 0x41, 0x26, 0x9e, 0xdf, 0x29, 0x00, 0xe1, 0xa8, 0x26, 0x27, 0xca, 0x53,
+	0xa3, 0xa7, 0x9c, 0x28, 0x4f, 0xcf, 0x7c, 0xb4, 0x0a, 0x87, 0x74, 0x87, 0xbc, 0x1e, 0x3b, 0x41,
+	0xc1, 0x94, 0x05, 0xc8, 0x3f, 0xd5, 0xf8, 0x08, 0xea, 0x80, 0x7c, 0xa0, 0x40, 0x21, 0x98, 0xd4,
+	0xe4, 0xf8, 0x2c, 0x39, 0x32, 0xca, 0xf1, 0x59, 0x6a, 0xe0, 0x93, 0x79, 0x0d, 0x25, 0x84, 0x6c,
+	0x97, 0xfd, 0x42, 0x81, 0xc9, 0xe4, 0x20, 0x87, 0x64, 0x87, 0x4f, 0xce, 0x3c, 0x48, 0x5d, 0xea,
+	0x93, 0x1b, 0x31, 0x5e, 0xe6, 0x18, 0x4b, 0xe4, 0x42, 0x04, 0x23, 0xe5, 0xcc, 0xf5, 0xf0, 0x78,
+	0xfd, 0x84, 0x2c, 0xa6, 0x9f, 0x39, 0x09, 0x39, 0x36, 0x60, 0xcd, 0x49, 0xc8, 0xf1, 0x71, 0x6b,
+	0x66, 0x42, 0x16, 0x33, 0x55, 0xf2, 0x13, 0x05, 0x26, 0x62, 0x53, 0x18, 0x72, 0xad, 0xd7, 0x95,
+	0x8e, 0xcd, 0xa3, 0xd4, 0xeb, 0xfd, 0xb0, 0x22, 0x86, 0x8b, 0x1c, 0xc3, 0x2c, 0x99, 0x49, 0xe7,
+	0x80, 0x3a, 0x13, 0x96, 0x7f, 0xac, 0xc0, 0x78, 0x74, 0xdc, 0x90, 0x93, 0x18, 0x33, 0x46, 0x29,
+	0xea, 0xb5, 0x3e, 0x38, 0xfb, 0x00, 0xc2, 0x47, 0x14, 0x8c, 0xfc, 0x5a, 0x81, 0x73, 0x19, 0x1d,
+	0x31, 0xd1, 0x7b, 0x59, 0xc9, 0x18, 0x3c, 0xa8, 0xcb, 0xfd, 0x0b, 0xf4, 0x40, 0xb7, 0xe7, 0x33,
+	0xee, 0xcb, 0x84, 0x44, 0x3e, 0x52, 0x60, 0x32, 0x59, 0xbc, 0xe7, 0x04, 0x6e, 0x4e, 0x57, 0x9b,
+	0x13, 0xb8, 0x79, 0x0d, 0xab, 0x76, 0x87, 0x83, 0xba, 0x45, 0x6e, 0xc6, 0x5c, 0x96, 0xec, 0xbf,
+	0xfc, 0x3b, 0x9f, 0x6e, 0x69, 0xf8, 0x7b, 0x37, 0x95, 0x6a, 0xe5, 0x48, 0x7f, 0x10, 0x82, 0x38,
+	0x2f, 0xf7, 0xcb, 0x8e, 0x90, 0x5f, 0xe1, 0x90, 0xe7, 0xc9, 0x5c, 0x4f, 0xc8, 0xe4, 0x6f, 0x0a,
+	0x9c, 0xcf, 0x6e, 0x84, 0xc8, 0x6a, 0x5f, 0x16, 0x63, 0x6d, 0x9d, 0xba, 0xf6, 0x42, 0x32, 0x08,
+	0x75, 0x9d, 0x43, 0x7d, 0x93, 0xbc, 0x71, 0x1c, 0xef, 0xea, 0x4c, 0xa0, 0x7d, 0xaa, 0xc0, 0x74,
+	0x56, 0xb1, 0x4f, 0x96, 0x8f, 0x7a, 0x74, 0x92, 0xfd, 0x8c, 0xba, 0xf2, 0x02, 0x12, 0xb8, 0x85,
+	0x37, 0xf9, 0x16, 0x6e, 0x93, 0xaf, 0xf4, 0xfd, 0x62, 0xe9, 0xd1, 0xee, 0xe3, 0x43, 0x05, 0x0a,
+	0x41, 0xfb, 0x95, 0xf3, 0x32, 0x24, 0xbb, 0xc1, 0x9c, 0x97, 0x21, 0xd5, 0xfc, 0x69, 0x6b, 0x1c,
+	0xdb, 0x12, 0xb9, 0x71, 0x54, 0xf1, 0xa1, 0x87, 0x3d, 0x22, 0x4f, 0x8b, 0xb1, 0x7e, 0x25, 0x27,
+	0x2d, 0x66, 0x75, 0x3c, 0x39, 0x69, 0x31, 0xb3, 0xfd, 0xc9, 0xbc, 0xef, 0x1d, 0xce, 0x29, 0x67,
+	0xab, 0xe4, 0x2f, 0x0a, 0xbc, 0x94, 0xd9, 0x68, 0x90, 0xec, 0x93, 0xea, 0xd5, 0xd9, 0xa8, 0xab,
+	0x2f, 0x22, 0x82, 0x18, 0x5f, 0xe3, 0x18, 0x57, 0xc9, 0x72, 0xb4, 0x9e, 0x17, 0x12, 0x4c, 0x7f,
+	0x12, 0x36, 0x4a, 0x07, 0x7a, 0x47, 0x28, 0xa8, 0x63, 0x9b, 0x51, 0xa9, 0x7e, 0x72, 0x58, 0x52,
+	0x3e, 0x3d, 0x2c, 0x29, 0xff, 0x3e, 0x2c, 0x29, 0x3f, 0x7b, 0x5e, 0x3a, 0xf5, 0xe9, 0xf3, 0xd2,
+	0xa9, 0x7f, 0x3e, 0x2f, 0x9d, 0xfa, 0x9e, 0x1e, 0xe9, 0x59, 0x7c, 0x44, 0xfc, 0xdf, 0x0d, 0x1a,
+	0x4e, 0x4b, 0xe7, 0xa3, 0x45, 0x7d, 0x6f, 0x4d, 0x7f, 0x2c, 0xad, 0xf1, 0x06, 0x66, 0x7b, 0x98,
+	0x73, 0xac, 0xfd, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x00, 0x1d, 0x06, 0x76, 0x21, 0x00, 0x00,
 }
 
-func (m *QueryCountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Counts queries the number of existing data sources, oracle scripts, and
+	// requests.
+	Counts(ctx context.Context, in *QueryCountsRequest, opts ...grpc.CallOption) (*QueryCountsResponse, error)
+	// Data queries content of the data source or oracle script for given SHA256
+	// file hash.
+	Data(ctx context.Context, in *QueryDataRequest, opts ...grpc.CallOption) (*QueryDataResponse, error)
+	// DataSource queries data source summary info for given data source id.
+	DataSource(ctx context.Context, in *QueryDataSourceRequest, opts ...grpc.CallOption) (*QueryDataSourceResponse, error)
+	// OracleScript queries oracle script summary info for given oracle script id.
+	OracleScript(ctx context.Context, in *QueryOracleScriptRequest, opts ...grpc.CallOption) (*QueryOracleScriptResponse, error)
+	// Request queries request info for given request id.
+	Request(ctx context.Context, in *QueryRequestRequest, opts ...grpc.CallOption) (*QueryRequestResponse, error)
+	// PendingRequests queries list of pending request IDs assigned to given
+	// validator.
+	PendingRequests(ctx context.Context, in *QueryPendingRequestsRequest, opts ...grpc.CallOption) (*QueryPendingRequestsResponse, error)
+	// Validator queries properties of given validator address.
+	Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error)
+	// IsReporter queries grant of account on this validator.
+	IsReporter(ctx context.Context, in *QueryIsReporterRequest, opts ...grpc.CallOption) (*QueryIsReporterResponse, error)
+	// Reporters queries all reporters associated with given validator address.
+	Reporters(ctx context.Context, in *QueryReportersRequest, opts ...grpc.CallOption) (*QueryReportersResponse, error)
+	// ActiveValidators queries all active oracle validators.
+	ActiveValidators(ctx context.Context, in *QueryActiveValidatorsRequest, opts ...grpc.CallOption) (*QueryActiveValidatorsResponse, error)
+	// Params queries parameters used for runnning bandchain network.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RequestSearch queries the latest request that match search criteria.
+	RequestSearch(ctx context.Context, in *QueryRequestSearchRequest, opts ...grpc.CallOption) (*QueryRequestSearchResponse, error)
+	// RequestPrice queries the latest price on standard price reference oracle
+	// script.
+	RequestPrice(ctx context.Context, in *QueryRequestPriceRequest, opts ...grpc.CallOption) (*QueryRequestPriceResponse, error)
+	// RequestVerification verifies a request to make sure that
+	// all information that will be used to report the data is valid
+	RequestVerification(ctx context.Context, in *QueryRequestVerificationRequest, opts ...grpc.CallOption) (*QueryRequestVerificationResponse, error)
+	// RecurringRequest queries the recurring request for given recurring request
+	// id along with its remaining deposit.
+	RecurringRequest(ctx context.Context, in *QueryRecurringRequestRequest, opts ...grpc.CallOption) (*QueryRecurringRequestResponse, error)
+	// RecurringRequests queries all recurring requests.
+	RecurringRequests(ctx context.Context, in *QueryRecurringRequestsRequest, opts ...grpc.CallOption) (*QueryRecurringRequestsResponse, error)
+	// RecurringRequestSpawns queries the IDs of unexpired oracle requests spawned
+	// by the given recurring request.
+	RecurringRequestSpawns(ctx context.Context, in *QueryRecurringRequestSpawnsRequest, opts ...grpc.CallOption) (*QueryRecurringRequestSpawnsResponse, error)
+	// ValidatorReliability queries the oracle reliability of a validator and its
+	// resulting sampling weight.
+	ValidatorReliability(ctx context.Context, in *QueryValidatorReliabilityRequest, opts ...grpc.CallOption) (*QueryValidatorReliabilityResponse, error)
+	// FeeEscrow queries the data source fees escrowed for given request id.
+	FeeEscrow(ctx context.Context, in *QueryFeeEscrowRequest, opts ...grpc.CallOption) (*QueryFeeEscrowResponse, error)
+	// PrunedResults queries the range of request results that have been pruned
+	// from state by the result retention policy.
+	PrunedResults(ctx context.Context, in *QueryPrunedResultsRequest, opts ...grpc.CallOption) (*QueryPrunedResultsResponse, error)
+	// ChannelPrepaidBalance queries the prepaid fee account of an oracle IBC
+	// channel and its balance.
+	ChannelPrepaidBalance(ctx context.Context, in *QueryChannelPrepaidBalanceRequest, opts ...grpc.CallOption) (*QueryChannelPrepaidBalanceResponse, error)
 }
 
-func (m *QueryCountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryCountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Counts(ctx context.Context, in *QueryCountsRequest, opts ...grpc.CallOption) (*QueryCountsResponse, error) {
+	out := new(QueryCountsResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Counts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryCountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Data(ctx context.Context, in *QueryDataRequest, opts ...grpc.CallOption) (*QueryDataResponse, error) {
+	out := new(QueryDataResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Data", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryCountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RequestCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.RequestCount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.OracleScriptCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OracleScriptCount))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.DataSourceCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.DataSourceCount))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) DataSource(ctx context.Context, in *QueryDataSourceRequest, opts ...grpc.CallOption) (*QueryDataSourceResponse, error) {
+	out := new(QueryDataSourceResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/DataSource", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OracleScript(ctx context.Context, in *QueryOracleScriptRequest, opts ...grpc.CallOption) (*QueryOracleScriptResponse, error) {
+	out := new(QueryOracleScriptResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/OracleScript", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Request(ctx context.Context, in *QueryRequestRequest, opts ...grpc.CallOption) (*QueryRequestResponse, error) {
+	out := new(QueryRequestResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Request", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DataHash) > 0 {
-		i -= len(m.DataHash)
-		copy(dAtA[i:], m.DataHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataHash)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PendingRequests(ctx context.Context, in *QueryPendingRequestsRequest, opts ...grpc.CallOption) (*QueryPendingRequestsResponse, error) {
+	out := new(QueryPendingRequestsResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/PendingRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error) {
+	out := new(QueryValidatorResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Validator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IsReporter(ctx context.Context, in *QueryIsReporterRequest, opts ...grpc.CallOption) (*QueryIsReporterResponse, error) {
+	out := new(QueryIsReporterResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/IsReporter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryDataSourceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Reporters(ctx context.Context, in *QueryReportersRequest, opts ...grpc.CallOption) (*QueryReportersResponse, error) {
+	out := new(QueryReportersResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Reporters", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDataSourceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryDataSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DataSourceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.DataSourceId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) ActiveValidators(ctx context.Context, in *QueryActiveValidatorsRequest, opts ...grpc.CallOption) (*QueryActiveValidatorsResponse, error) {
+	out := new(QueryActiveValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/ActiveValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryDataSourceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDataSourceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryDataSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DataSource != nil {
-		{
-			size, err := m.DataSource.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) RequestSearch(ctx context.Context, in *QueryRequestSearchRequest, opts ...grpc.CallOption) (*QueryRequestSearchResponse, error) {
+	out := new(QueryRequestSearchResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/RequestSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOracleScriptRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RequestPrice(ctx context.Context, in *QueryRequestPriceRequest, opts ...grpc.CallOption) (*QueryRequestPriceResponse, error) {
+	out := new(QueryRequestPriceResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/RequestPrice", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryOracleScriptRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryOracleScriptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OracleScriptId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OracleScriptId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) RequestVerification(ctx context.Context, in *QueryRequestVerificationRequest, opts ...grpc.CallOption) (*QueryRequestVerificationResponse, error) {
+	out := new(QueryRequestVerificationResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/RequestVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOracleScriptResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RecurringRequest(ctx context.Context, in *QueryRecurringRequestRequest, opts ...grpc.CallOption) (*QueryRecurringRequestResponse, error) {
+	out := new(QueryRecurringRequestResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/RecurringRequest", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryOracleScriptResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryOracleScriptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OracleScript != nil {
-		{
-			size, err := m.OracleScript.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) RecurringRequests(ctx context.Context, in *QueryRecurringRequestsRequest, opts ...grpc.CallOption) (*QueryRecurringRequestsResponse, error) {
+	out := new(QueryRecurringRequestsResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/RecurringRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRequestRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RecurringRequestSpawns(ctx context.Context, in *QueryRecurringRequestSpawnsRequest, opts ...grpc.CallOption) (*QueryRecurringRequestSpawnsResponse, error) {
+	out := new(QueryRecurringRequestSpawnsResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/RecurringRequestSpawns", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryRequestRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RequestId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) ValidatorReliability(ctx context.Context, in *QueryValidatorReliabilityRequest, opts ...grpc.CallOption) (*QueryValidatorReliabilityResponse, error) {
+	out := new(QueryValidatorReliabilityResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/ValidatorReliability", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRequestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FeeEscrow(ctx context.Context, in *QueryFeeEscrowRequest, opts ...grpc.CallOption) (*QueryFeeEscrowResponse, error) {
+	out := new(QueryFeeEscrowResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/FeeEscrow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryRequestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Signing != nil {
-		{
-			size, err := m.Signing.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Result != nil {
-		{
-			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Reports) > 0 {
-		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PrunedResults(ctx context.Context, in *QueryPrunedResultsRequest, opts ...grpc.CallOption) (*QueryPrunedResultsResponse, error) {
+	out := new(QueryPrunedResultsResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/PrunedResults", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPendingRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ChannelPrepaidBalance(ctx context.Context, in *QueryChannelPrepaidBalanceRequest, opts ...grpc.CallOption) (*QueryChannelPrepaidBalanceResponse, error) {
+	out := new(QueryChannelPrepaidBalanceResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Query/ChannelPrepaidBalance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPendingRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPendingRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Counts queries the number of existing data sources, oracle scripts, and
+	// requests.
+	Counts(context.Context, *QueryCountsRequest) (*QueryCountsResponse, error)
+	// Data queries content of the data source or oracle script for given SHA256
+	// file hash.
+	Data(context.Context, *QueryDataRequest) (*QueryDataResponse, error)
+	// DataSource queries data source summary info for given data source id.
+	DataSource(context.Context, *QueryDataSourceRequest) (*QueryDataSourceResponse, error)
+	// OracleScript queries oracle script summary info for given oracle script id.
+	OracleScript(context.Context, *QueryOracleScriptRequest) (*QueryOracleScriptResponse, error)
+	// Request queries request info for given request id.
+	Request(context.Context, *QueryRequestRequest) (*QueryRequestResponse, error)
+	// PendingRequests queries list of pending request IDs assigned to given
+	// validator.
+	PendingRequests(context.Context, *QueryPendingRequestsRequest) (*QueryPendingRequestsResponse, error)
+	// Validator queries properties of given validator address.
+	Validator(context.Context, *QueryValidatorRequest) (*QueryValidatorResponse, error)
+	// IsReporter queries grant of account on this validator.
+	IsReporter(context.Context, *QueryIsReporterRequest) (*QueryIsReporterResponse, error)
+	// Reporters queries all reporters associated with given validator address.
+	Reporters(context.Context, *QueryReportersRequest) (*QueryReportersResponse, error)
+	// ActiveValidators queries all active oracle validators.
+	ActiveValidators(context.Context, *QueryActiveValidatorsRequest) (*QueryActiveValidatorsResponse, error)
+	// Params queries parameters used for runnning bandchain network.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RequestSearch queries the latest request that match search criteria.
+	RequestSearch(context.Context, *QueryRequestSearchRequest) (*QueryRequestSearchResponse, error)
+	// RequestPrice queries the latest price on standard price reference oracle
+	// script.
+	RequestPrice(context.Context, *QueryRequestPriceRequest) (*QueryRequestPriceResponse, error)
+	// RequestVerification verifies a request to make sure that
+	// all information that will be used to report the data is valid
+	RequestVerification(context.Context, *QueryRequestVerificationRequest) (*QueryRequestVerificationResponse, error)
+	// RecurringRequest queries the recurring request for given recurring request
+	// id along with its remaining deposit.
+	RecurringRequest(context.Context, *QueryRecurringRequestRequest) (*QueryRecurringRequestResponse, error)
+	// RecurringRequests queries all recurring requests.
+	RecurringRequests(context.Context, *QueryRecurringRequestsRequest) (*QueryRecurringRequestsResponse, error)
+	// RecurringRequestSpawns queries the IDs of unexpired oracle requests spawned
+	// by the given recurring request.
+	RecurringRequestSpawns(context.Context, *QueryRecurringRequestSpawnsRequest) (*QueryRecurringRequestSpawnsResponse, error)
+	// ValidatorReliability queries the oracle reliability of a validator and its
+	// resulting sampling weight.
+	ValidatorReliability(context.Context, *QueryValidatorReliabilityRequest) (*QueryValidatorReliabilityResponse, error)
+	// FeeEscrow queries the data source fees escrowed for given request id.
+	FeeEscrow(context.Context, *QueryFeeEscrowRequest) (*QueryFeeEscrowResponse, error)
+	// PrunedResults queries the range of request results that have been pruned
+	// from state by the result retention policy.
+	PrunedResults(context.Context, *QueryPrunedResultsRequest) (*QueryPrunedResultsResponse, error)
+	// ChannelPrepaidBalance queries the prepaid fee account of an oracle IBC
+	// channel and its balance.
+	ChannelPrepaidBalance(context.Context, *QueryChannelPrepaidBalanceRequest) (*QueryChannelPrepaidBalanceResponse, error)
 }
 
-func (m *QueryPendingRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryPendingRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Counts(ctx context.Context, req *QueryCountsRequest) (*QueryCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Counts not implemented")
 }
-
-func (m *QueryPendingRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RequestIDs) > 0 {
-		dAtA7 := make([]byte, len(m.RequestIDs)*10)
-		var j6 int
-		for _, num := range m.RequestIDs {
-			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j6++
-			}
-			dAtA7[j6] = uint8(num)
-			j6++
-		}
-		i -= j6
-		copy(dAtA[i:], dAtA7[:j6])
-		i = encodeVarintQuery(dAtA, i, uint64(j6))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) Data(ctx context.Context, req *QueryDataRequest) (*QueryDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Data not implemented")
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) DataSource(ctx context.Context, req *QueryDataSourceRequest) (*QueryDataSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataSource not implemented")
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) OracleScript(ctx context.Context, req *QueryOracleScriptRequest) (*QueryOracleScriptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleScript not implemented")
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) Request(ctx context.Context, req *QueryRequestRequest) (*QueryRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Request not implemented")
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) PendingRequests(ctx context.Context, req *QueryPendingRequestsRequest) (*QueryPendingRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRequests not implemented")
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Validator(ctx context.Context, req *QueryValidatorRequest) (*QueryValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
+}
+func (*UnimplementedQueryServer) IsReporter(ctx context.Context, req *QueryIsReporterRequest) (*QueryIsReporterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsReporter not implemented")
+}
+func (*UnimplementedQueryServer) Reporters(ctx context.Context, req *QueryReportersRequest) (*QueryReportersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reporters not implemented")
+}
+func (*UnimplementedQueryServer) ActiveValidators(ctx context.Context, req *QueryActiveValidatorsRequest) (*QueryActiveValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveValidators not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) RequestSearch(ctx context.Context, req *QueryRequestSearchRequest) (*QueryRequestSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestSearch not implemented")
+}
+func (*UnimplementedQueryServer) RequestPrice(ctx context.Context, req *QueryRequestPriceRequest) (*QueryRequestPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPrice not implemented")
+}
+func (*UnimplementedQueryServer) RequestVerification(ctx context.Context, req *QueryRequestVerificationRequest) (*QueryRequestVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestVerification not implemented")
+}
+func (*UnimplementedQueryServer) RecurringRequest(ctx context.Context, req *QueryRecurringRequestRequest) (*QueryRecurringRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecurringRequest not implemented")
+}
+func (*UnimplementedQueryServer) RecurringRequests(ctx context.Context, req *QueryRecurringRequestsRequest) (*QueryRecurringRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecurringRequests not implemented")
+}
+func (*UnimplementedQueryServer) RecurringRequestSpawns(ctx context.Context, req *QueryRecurringRequestSpawnsRequest) (*QueryRecurringRequestSpawnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecurringRequestSpawns not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorReliability(ctx context.Context, req *QueryValidatorReliabilityRequest) (*QueryValidatorReliabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorReliability not implemented")
+}
+func (*UnimplementedQueryServer) FeeEscrow(ctx context.Context, req *QueryFeeEscrowRequest) (*QueryFeeEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeEscrow not implemented")
+}
+func (*UnimplementedQueryServer) PrunedResults(ctx context.Context, req *QueryPrunedResultsRequest) (*QueryPrunedResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrunedResults not implemented")
+}
+func (*UnimplementedQueryServer) ChannelPrepaidBalance(ctx context.Context, req *QueryChannelPrepaidBalanceRequest) (*QueryChannelPrepaidBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelPrepaidBalance not implemented")
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryValidatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Counts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCountsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Counts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/Counts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Counts(ctx, req.(*QueryCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Data_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Data(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/Data",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Data(ctx, req.(*QueryDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func _Query_DataSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).DataSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/DataSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataSource(ctx, req.(*QueryDataSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OracleScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleScriptRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OracleScript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/OracleScript",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleScript(ctx, req.(*QueryOracleScriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_Request_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Request(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/Request",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Request(ctx, req.(*QueryRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIsReporterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PendingRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRequestsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryIsReporterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryIsReporterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReporterAddress) > 0 {
-		i -= len(m.ReporterAddress)
-		copy(dAtA[i:], m.ReporterAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReporterAddress)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRequests(ctx, in)
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/PendingRequests",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRequests(ctx, req.(*QueryPendingRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIsReporterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Validator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Validator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/Validator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Validator(ctx, req.(*QueryValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIsReporterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_IsReporter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsReporterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsReporter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/IsReporter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsReporter(ctx, req.(*QueryIsReporterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIsReporterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsReporter {
-		i--
-		if m.IsReporter {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func _Query_Reporters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReportersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Reporters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/Reporters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Reporters(ctx, req.(*QueryReportersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReportersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ActiveValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveValidatorsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/ActiveValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveValidators(ctx, req.(*QueryActiveValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReportersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReportersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func _Query_RequestSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequestSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).RequestSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/RequestSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RequestSearch(ctx, req.(*QueryRequestSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReportersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RequestPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequestPriceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).RequestPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/RequestPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RequestPrice(ctx, req.(*QueryRequestPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReportersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_RequestVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequestVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RequestVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/RequestVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RequestVerification(ctx, req.(*QueryRequestVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryReportersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reporter) > 0 {
-		for iNdEx := len(m.Reporter) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Reporter[iNdEx])
-			copy(dAtA[i:], m.Reporter[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Reporter[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_RecurringRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecurringRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).RecurringRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/RecurringRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecurringRequest(ctx, req.(*QueryRecurringRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActiveValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RecurringRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecurringRequestsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).RecurringRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/RecurringRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecurringRequests(ctx, req.(*QueryRecurringRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActiveValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_RecurringRequestSpawns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecurringRequestSpawnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecurringRequestSpawns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/RecurringRequestSpawns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecurringRequestSpawns(ctx, req.(*QueryRecurringRequestSpawnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActiveValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_ValidatorReliability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorReliabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorReliability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/ValidatorReliability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorReliability(ctx, req.(*QueryValidatorReliabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActiveValidatorsResponse) Marshal() (dAtA []byte, err error) {
+func _Query_FeeEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/FeeEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeEscrow(ctx, req.(*QueryFeeEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PrunedResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPrunedResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PrunedResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/PrunedResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PrunedResults(ctx, req.(*QueryPrunedResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelPrepaidBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelPrepaidBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelPrepaidBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Query/ChannelPrepaidBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelPrepaidBalance(ctx, req.(*QueryChannelPrepaidBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "band.oracle.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Counts",
+			Handler:    _Query_Counts_Handler,
+		},
+		{
+			MethodName: "Data",
+			Handler:    _Query_Data_Handler,
+		},
+		{
+			MethodName: "DataSource",
+			Handler:    _Query_DataSource_Handler,
+		},
+		{
+			MethodName: "OracleScript",
+			Handler:    _Query_OracleScript_Handler,
+		},
+		{
+			MethodName: "Request",
+			Handler:    _Query_Request_Handler,
+		},
+		{
+			MethodName: "PendingRequests",
+			Handler:    _Query_PendingRequests_Handler,
+		},
+		{
+			MethodName: "Validator",
+			Handler:    _Query_Validator_Handler,
+		},
+		{
+			MethodName: "IsReporter",
+			Handler:    _Query_IsReporter_Handler,
+		},
+		{
+			MethodName: "Reporters",
+			Handler:    _Query_Reporters_Handler,
+		},
+		{
+			MethodName: "ActiveValidators",
+			Handler:    _Query_ActiveValidators_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "RequestSearch",
+			Handler:    _Query_RequestSearch_Handler,
+		},
+		{
+			MethodName: "RequestPrice",
+			Handler:    _Query_RequestPrice_Handler,
+		},
+		{
+			MethodName: "RequestVerification",
+			Handler:    _Query_RequestVerification_Handler,
+		},
+		{
+			MethodName: "RecurringRequest",
+			Handler:    _Query_RecurringRequest_Handler,
+		},
+		{
+			MethodName: "RecurringRequests",
+			Handler:    _Query_RecurringRequests_Handler,
+		},
+		{
+			MethodName: "RecurringRequestSpawns",
+			Handler:    _Query_RecurringRequestSpawns_Handler,
+		},
+		{
+			MethodName: "ValidatorReliability",
+			Handler:    _Query_ValidatorReliability_Handler,
+		},
+		{
+			MethodName: "FeeEscrow",
+			Handler:    _Query_FeeEscrow_Handler,
+		},
+		{
+			MethodName: "PrunedResults",
+			Handler:    _Query_PrunedResults_Handler,
+		},
+		{
+			MethodName: "ChannelPrepaidBalance",
+			Handler:    _Query_ChannelPrepaidBalance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "band/oracle/v1/query.proto",
+}
+
+func (m *QueryCountsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2944,34 +3349,20 @@ func (m *QueryActiveValidatorsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryActiveValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCountsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryActiveValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestSearchRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCountsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2981,42 +3372,35 @@ func (m *QueryRequestSearchRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRequestSearchRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCountsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRequestSearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MinCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MinCount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.AskCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AskCount))
+	if m.RequestCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequestCount))
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.Calldata) > 0 {
-		i -= len(m.Calldata)
-		copy(dAtA[i:], m.Calldata)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Calldata)))
+	if m.OracleScriptCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OracleScriptCount))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.OracleScriptId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OracleScriptId))
+	if m.DataSourceCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DataSourceCount))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestSearchResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDataRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3026,32 +3410,27 @@ func (m *QueryRequestSearchResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRequestSearchResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDataRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRequestSearchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.DataHash) > 0 {
+		i -= len(m.DataHash)
+		copy(dAtA[i:], m.DataHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataHash)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestPriceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDataResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3061,39 +3440,27 @@ func (m *QueryRequestPriceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRequestPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDataResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRequestPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MinCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MinCount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.AskCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AskCount))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
 		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Symbols) > 0 {
-		for iNdEx := len(m.Symbols) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Symbols[iNdEx])
-			copy(dAtA[i:], m.Symbols[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbols[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestPriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDataSourceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3103,34 +3470,25 @@ func (m *QueryRequestPriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRequestPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDataSourceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRequestPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDataSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PriceResults) > 0 {
-		for iNdEx := len(m.PriceResults) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PriceResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.DataSourceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DataSourceId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestVerificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDataSourceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3140,68 +3498,32 @@ func (m *QueryRequestVerificationRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRequestVerificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDataSourceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRequestVerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDataSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxDelay != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MaxDelay))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Reporter) > 0 {
-		i -= len(m.Reporter)
-		copy(dAtA[i:], m.Reporter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reporter)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.DataSourceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.DataSourceId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.ExternalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ExternalId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.RequestId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+	if m.DataSource != nil {
+		{
+			size, err := m.DataSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestVerificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryOracleScriptRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3211,539 +3533,3590 @@ func (m *QueryRequestVerificationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRequestVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOracleScriptRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRequestVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOracleScriptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsDelay {
-		i--
-		if m.IsDelay {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.DataSourceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.DataSourceId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.ExternalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ExternalId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.RequestId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+	if m.OracleScriptId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OracleScriptId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryOracleScriptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryCountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryOracleScriptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOracleScriptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DataSourceCount != 0 {
-		n += 1 + sovQuery(uint64(m.DataSourceCount))
-	}
-	if m.OracleScriptCount != 0 {
-		n += 1 + sovQuery(uint64(m.OracleScriptCount))
-	}
-	if m.RequestCount != 0 {
-		n += 1 + sovQuery(uint64(m.RequestCount))
+	if m.OracleScript != nil {
+		{
+			size, err := m.OracleScript.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DataHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDataSourceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DataSourceId != 0 {
-		n += 1 + sovQuery(uint64(m.DataSourceId))
+	if m.RequestId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDataSourceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DataSource != nil {
-		l = m.DataSource.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryOracleScriptRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OracleScriptId != 0 {
-		n += 1 + sovQuery(uint64(m.OracleScriptId))
+func (m *QueryRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOracleScriptResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OracleScript != nil {
-		l = m.OracleScript.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRequestRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RequestId != 0 {
-		n += 1 + sovQuery(uint64(m.RequestId))
-	}
-	return n
-}
-
-func (m *QueryRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Signing != nil {
+		{
+			size, err := m.Signing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Reports) > 0 {
-		for _, e := range m.Reports {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Result != nil {
-		l = m.Result.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Signing != nil {
-		l = m.Signing.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.RequestIDs) > 0 {
-		l = 0
-		for _, e := range m.RequestIDs {
-			l += sovQuery(uint64(e))
+		dAtA7 := make([]byte, len(m.RequestIDs)*10)
+		var j6 int
+		for _, num := range m.RequestIDs {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
 		}
-		n += 1 + sovQuery(uint64(l)) + l
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintQuery(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsReporterRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIsReporterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsReporterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsReporterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ReporterAddress) > 0 {
+		i -= len(m.ReporterAddress)
+		copy(dAtA[i:], m.ReporterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReporterAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ReporterAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsReporterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIsReporterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsReporterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsReporterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.IsReporter {
-		n += 2
+		i--
+		if m.IsReporter {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryReportersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryReportersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReportersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReportersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryReportersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryReportersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReportersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReportersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Reporter) > 0 {
-		for _, s := range m.Reporter {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Reporter) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reporter[iNdEx])
+			copy(dAtA[i:], m.Reporter[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Reporter[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryActiveValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryActiveValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryActiveValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryActiveValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestSearchRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRequestSearchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestSearchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestSearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OracleScriptId != 0 {
-		n += 1 + sovQuery(uint64(m.OracleScriptId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Calldata)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.MinCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.AskCount != 0 {
-		n += 1 + sovQuery(uint64(m.AskCount))
+		i = encodeVarintQuery(dAtA, i, uint64(m.AskCount))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.MinCount != 0 {
-		n += 1 + sovQuery(uint64(m.MinCount))
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.OracleScriptId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OracleScriptId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestSearchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRequestSearchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestSearchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestSearchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRequestPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Symbols) > 0 {
-		for _, s := range m.Symbols {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MinCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.AskCount != 0 {
-		n += 1 + sovQuery(uint64(m.AskCount))
+		i = encodeVarintQuery(dAtA, i, uint64(m.AskCount))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.MinCount != 0 {
-		n += 1 + sovQuery(uint64(m.MinCount))
+	if len(m.Symbols) > 0 {
+		for iNdEx := len(m.Symbols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Symbols[iNdEx])
+			copy(dAtA[i:], m.Symbols[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbols[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRequestPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.PriceResults) > 0 {
-		for _, e := range m.PriceResults {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.PriceResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestVerificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRequestVerificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestVerificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestVerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.MaxDelay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxDelay))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.RequestId != 0 {
-		n += 1 + sovQuery(uint64(m.RequestId))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.ExternalId != 0 {
-		n += 1 + sovQuery(uint64(m.ExternalId))
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x32
 	}
 	if m.DataSourceId != 0 {
-		n += 1 + sovQuery(uint64(m.DataSourceId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.DataSourceId))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Reporter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ExternalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExternalId))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.RequestId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.MaxDelay != 0 {
-		n += 1 + sovQuery(uint64(m.MaxDelay))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequestVerificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRequestVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.IsDelay {
+		i--
+		if m.IsDelay {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.DataSourceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DataSourceId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExternalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExternalId))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.RequestId != 0 {
-		n += 1 + sovQuery(uint64(m.RequestId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.ExternalId != 0 {
-		n += 1 + sovQuery(uint64(m.ExternalId))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.DataSourceId != 0 {
-		n += 1 + sovQuery(uint64(m.DataSourceId))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.IsDelay {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecurringRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryRecurringRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryRecurringRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecurringRequestId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecurringRequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (m *QueryCountsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (m *QueryRecurringRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecurringRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecurringRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.RecurringRequest != nil {
+		{
+			size, err := m.RecurringRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecurringRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecurringRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecurringRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecurringRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecurringRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecurringRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecurringRequests) > 0 {
+		for iNdEx := len(m.RecurringRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecurringRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecurringRequestSpawnsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecurringRequestSpawnsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecurringRequestSpawnsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RecurringRequestId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecurringRequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecurringRequestSpawnsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecurringRequestSpawnsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecurringRequestSpawnsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RequestIDs) > 0 {
+		dAtA21 := make([]byte, len(m.RequestIDs)*10)
+		var j20 int
+		for _, num := range m.RequestIDs {
+			for num >= 1<<7 {
+				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j20++
+			}
+			dAtA21[j20] = uint8(num)
+			j20++
+		}
+		i -= j20
+		copy(dAtA[i:], dAtA21[:j20])
+		i = encodeVarintQuery(dAtA, i, uint64(j20))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeEscrow != nil {
+		{
+			size, err := m.FeeEscrow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorReliabilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorReliabilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorReliabilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorReliabilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorReliabilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorReliabilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SamplingWeightPercentage != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SamplingWeightPercentage))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Reliability.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrunedResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrunedResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrunedResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrunedResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrunedResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrunedResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToRequestID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToRequestID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromRequestID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromRequestID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelPrepaidBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelPrepaidBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelPrepaidBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelPrepaidBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelPrepaidBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelPrepaidBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataSourceCount != 0 {
+		n += 1 + sovQuery(uint64(m.DataSourceCount))
+	}
+	if m.OracleScriptCount != 0 {
+		n += 1 + sovQuery(uint64(m.OracleScriptCount))
+	}
+	if m.RequestCount != 0 {
+		n += 1 + sovQuery(uint64(m.RequestCount))
+	}
+	return n
+}
+
+func (m *QueryDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DataHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataSourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataSourceId != 0 {
+		n += 1 + sovQuery(uint64(m.DataSourceId))
+	}
+	return n
+}
+
+func (m *QueryDataSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataSource != nil {
+		l = m.DataSource.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleScriptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScriptId != 0 {
+		n += 1 + sovQuery(uint64(m.OracleScriptId))
+	}
+	return n
+}
+
+func (m *QueryOracleScriptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScript != nil {
+		l = m.OracleScript.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovQuery(uint64(m.RequestId))
+	}
+	return n
+}
+
+func (m *QueryRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Reports) > 0 {
+		for _, e := range m.Reports {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Signing != nil {
+		l = m.Signing.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RequestIDs) > 0 {
+		l = 0
+		for _, e := range m.RequestIDs {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsReporterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ReporterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsReporterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsReporter {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryReportersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReportersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reporter) > 0 {
+		for _, s := range m.Reporter {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryActiveValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActiveValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRequestSearchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScriptId != 0 {
+		n += 1 + sovQuery(uint64(m.OracleScriptId))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AskCount != 0 {
+		n += 1 + sovQuery(uint64(m.AskCount))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovQuery(uint64(m.MinCount))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRequestSearchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRequestPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Symbols) > 0 {
+		for _, s := range m.Symbols {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.AskCount != 0 {
+		n += 1 + sovQuery(uint64(m.AskCount))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovQuery(uint64(m.MinCount))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRequestPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceResults) > 0 {
+		for _, e := range m.PriceResults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRequestVerificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RequestId != 0 {
+		n += 1 + sovQuery(uint64(m.RequestId))
+	}
+	if m.ExternalId != 0 {
+		n += 1 + sovQuery(uint64(m.ExternalId))
+	}
+	if m.DataSourceId != 0 {
+		n += 1 + sovQuery(uint64(m.DataSourceId))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxDelay != 0 {
+		n += 1 + sovQuery(uint64(m.MaxDelay))
+	}
+	return n
+}
+
+func (m *QueryRequestVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RequestId != 0 {
+		n += 1 + sovQuery(uint64(m.RequestId))
+	}
+	if m.ExternalId != 0 {
+		n += 1 + sovQuery(uint64(m.ExternalId))
+	}
+	if m.DataSourceId != 0 {
+		n += 1 + sovQuery(uint64(m.DataSourceId))
+	}
+	if m.IsDelay {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRecurringRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecurringRequestId != 0 {
+		n += 1 + sovQuery(uint64(m.RecurringRequestId))
+	}
+	return n
+}
+
+func (m *QueryRecurringRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecurringRequest != nil {
+		l = m.RecurringRequest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRecurringRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecurringRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecurringRequests) > 0 {
+		for _, e := range m.RecurringRequests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecurringRequestSpawnsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecurringRequestId != 0 {
+		n += 1 + sovQuery(uint64(m.RecurringRequestId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecurringRequestSpawnsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RequestIDs) > 0 {
+		l = 0
+		for _, e := range m.RequestIDs {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovQuery(uint64(m.RequestId))
+	}
+	return n
+}
+
+func (m *QueryFeeEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeeEscrow != nil {
+		l = m.FeeEscrow.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorReliabilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorReliabilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reliability.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SamplingWeightPercentage != 0 {
+		n += 1 + sovQuery(uint64(m.SamplingWeightPercentage))
+	}
+	return n
+}
+
+func (m *QueryPrunedResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPrunedResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromRequestID != 0 {
+		n += 1 + sovQuery(uint64(m.FromRequestID))
+	}
+	if m.ToRequestID != 0 {
+		n += 1 + sovQuery(uint64(m.ToRequestID))
+	}
+	return n
+}
+
+func (m *QueryChannelPrepaidBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelPrepaidBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryCountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceCount", wireType)
+			}
+			m.DataSourceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSourceCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptCount", wireType)
+			}
+			m.OracleScriptCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestCount", wireType)
+			}
+			m.RequestCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataSourceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataSourceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataSourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+			}
+			m.DataSourceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSourceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataSourceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataSourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataSource == nil {
+				m.DataSource = &DataSource{}
+			}
+			if err := m.DataSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleScriptRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleScriptRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleScriptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+			}
+			m.OracleScriptId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleScriptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleScriptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScript", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OracleScript == nil {
+				m.OracleScript = &OracleScript{}
+			}
+			if err := m.OracleScript.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &Request{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reports = append(m.Reports, Report{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &Result{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signing == nil {
+				m.Signing = &SigningResult{}
+			}
+			if err := m.Signing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RequestIDs = append(m.RequestIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RequestIDs) == 0 {
+					m.RequestIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RequestIDs = append(m.RequestIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestIDs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &ValidatorStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsReporterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsReporterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsReporterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterAddress", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCountsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReporterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3765,7 +7138,7 @@ func (m *QueryCountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIsReporterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3788,55 +7161,17 @@ func (m *QueryCountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsReporterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsReporterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceCount", wireType)
-			}
-			m.DataSourceCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataSourceCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptCount", wireType)
-			}
-			m.OracleScriptCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OracleScriptCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReporter", wireType)
 			}
-			m.RequestCount = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3846,11 +7181,12 @@ func (m *QueryCountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestCount |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsReporter = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3872,7 +7208,7 @@ func (m *QueryCountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReportersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3895,15 +7231,15 @@ func (m *QueryDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReportersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReportersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3931,7 +7267,7 @@ func (m *QueryDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataHash = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3954,7 +7290,7 @@ func (m *QueryDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReportersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3977,17 +7313,17 @@ func (m *QueryDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReportersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReportersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3997,25 +7333,23 @@ func (m *QueryDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Reporter = append(m.Reporter, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4038,7 +7372,7 @@ func (m *QueryDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDataSourceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveValidatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4061,31 +7395,12 @@ func (m *QueryDataSourceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDataSourceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveValidatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDataSourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
-			}
-			m.DataSourceId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataSourceId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4107,7 +7422,7 @@ func (m *QueryDataSourceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDataSourceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveValidatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4130,15 +7445,15 @@ func (m *QueryDataSourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDataSourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveValidatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDataSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4165,10 +7480,8 @@ func (m *QueryDataSourceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DataSource == nil {
-				m.DataSource = &DataSource{}
-			}
-			if err := m.DataSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, &ActiveValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4193,7 +7506,7 @@ func (m *QueryDataSourceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOracleScriptRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRequestSearchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4216,10 +7529,10 @@ func (m *QueryOracleScriptRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleScriptRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequestSearchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleScriptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequestSearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4241,59 +7554,79 @@ func (m *QueryOracleScriptRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryOracleScriptResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Calldata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleScriptResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScript", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4320,10 +7653,10 @@ func (m *QueryOracleScriptResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OracleScript == nil {
-				m.OracleScript = &OracleScript{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.OracleScript.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4348,76 +7681,7 @@ func (m *QueryOracleScriptResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRequestRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-			}
-			m.RequestId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRequestSearchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4440,10 +7704,10 @@ func (m *QueryRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequestSearchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequestSearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4476,7 +7740,7 @@ func (m *QueryRequestResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &Request{}
+				m.Request = &QueryRequestResponse{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4484,7 +7748,7 @@ func (m *QueryRequestResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4511,50 +7775,14 @@ func (m *QueryRequestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reports = append(m.Reports, Report{})
-			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Requests = append(m.Requests, &QueryRequestResponse{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Result == nil {
-				m.Result = &Result{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4581,10 +7809,10 @@ func (m *QueryRequestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Signing == nil {
-				m.Signing = &SigningResult{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Signing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4609,7 +7837,7 @@ func (m *QueryRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRequestPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4632,15 +7860,15 @@ func (m *QueryPendingRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequestPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequestPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbols", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4668,134 +7896,82 @@ func (m *QueryPendingRequestsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Symbols = append(m.Symbols, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPendingRequestsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingRequestsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				m.RequestIDs = append(m.RequestIDs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.RequestIDs) == 0 {
-					m.RequestIDs = make([]uint64, 0, elementCount)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RequestIDs = append(m.RequestIDs, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestIDs", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4817,7 +7993,7 @@ func (m *QueryPendingRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRequestPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4840,12 +8016,82 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequestPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequestPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceResults = append(m.PriceResults, &PriceResult{})
+			if err := m.PriceResults[len(m.PriceResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4867,7 +8113,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRequestVerificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4890,17 +8136,119 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequestVerificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequestVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			m.ExternalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+			}
+			m.DataSourceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4910,78 +8258,14 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataSourceId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5009,63 +8293,13 @@ func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Reporter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5075,28 +8309,45 @@ func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &ValidatorStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelay", wireType)
+			}
+			m.MaxDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5118,7 +8369,7 @@ func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsReporterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRequestVerificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5141,15 +8392,15 @@ func (m *QueryIsReporterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsReporterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequestVerificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsReporterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequestVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5177,11 +8428,11 @@ func (m *QueryIsReporterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReporterAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5209,8 +8460,85 @@ func (m *QueryIsReporterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReporterAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			m.ExternalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+			}
+			m.DataSourceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSourceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDelay", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDelay = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5232,7 +8560,7 @@ func (m *QueryIsReporterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsReporterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRecurringRequestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5255,17 +8583,17 @@ func (m *QueryIsReporterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsReporterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecurringRequestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsReporterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecurringRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsReporter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringRequestId", wireType)
 			}
-			var v int
+			m.RecurringRequestId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5275,12 +8603,11 @@ func (m *QueryIsReporterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.RecurringRequestId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsReporter = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5302,7 +8629,7 @@ func (m *QueryIsReporterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReportersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRecurringRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5325,17 +8652,17 @@ func (m *QueryReportersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReportersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecurringRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReportersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecurringRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringRequest", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5345,23 +8672,61 @@ func (m *QueryReportersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if m.RecurringRequest == nil {
+				m.RecurringRequest = &RecurringRequest{}
+			}
+			if err := m.RecurringRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5384,7 +8749,7 @@ func (m *QueryReportersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReportersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRecurringRequestsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5407,17 +8772,17 @@ func (m *QueryReportersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReportersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecurringRequestsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReportersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecurringRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5427,23 +8792,27 @@ func (m *QueryReportersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reporter = append(m.Reporter, string(dAtA[iNdEx:postIndex]))
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5466,7 +8835,7 @@ func (m *QueryReportersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActiveValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRecurringRequestsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5489,12 +8858,82 @@ func (m *QueryActiveValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecurringRequestsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecurringRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecurringRequests = append(m.RecurringRequests, &RecurringRequest{})
+			if err := m.RecurringRequests[len(m.RecurringRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5516,7 +8955,7 @@ func (m *QueryActiveValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActiveValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRecurringRequestSpawnsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5539,15 +8978,34 @@ func (m *QueryActiveValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecurringRequestSpawnsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecurringRequestSpawnsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringRequestId", wireType)
+			}
+			m.RecurringRequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecurringRequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5574,8 +9032,10 @@ func (m *QueryActiveValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &ActiveValidator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5600,7 +9060,7 @@ func (m *QueryActiveValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRequestSearchRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRecurringRequestSpawnsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5623,36 +9083,93 @@ func (m *QueryRequestSearchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestSearchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecurringRequestSpawnsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestSearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecurringRequestSpawnsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
-			}
-			m.OracleScriptId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.RequestIDs = append(m.RequestIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OracleScriptId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RequestIDs) == 0 {
+					m.RequestIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RequestIDs = append(m.RequestIDs, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestIDs", wireType)
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5662,62 +9179,28 @@ func (m *QueryRequestSearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Calldata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
-			}
-			m.AskCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AskCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			m.MinCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5739,7 +9222,7 @@ func (m *QueryRequestSearchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRequestSearchResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeEscrowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5762,17 +9245,17 @@ func (m *QueryRequestSearchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestSearchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeEscrowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestSearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			var msglen int
+			m.RequestId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5782,28 +9265,11 @@ func (m *QueryRequestSearchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RequestId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Request == nil {
-				m.Request = &QueryRequestResponse{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5825,7 +9291,7 @@ func (m *QueryRequestSearchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRequestPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeEscrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5848,17 +9314,17 @@ func (m *QueryRequestPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeEscrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeEscrow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5868,62 +9334,28 @@ func (m *QueryRequestPriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbols = append(m.Symbols, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
-			}
-			m.AskCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AskCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			if m.FeeEscrow == nil {
+				m.FeeEscrow = &FeeEscrow{}
 			}
-			m.MinCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.FeeEscrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5945,7 +9377,7 @@ func (m *QueryRequestPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRequestPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorReliabilityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5968,17 +9400,17 @@ func (m *QueryRequestPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorReliabilityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorReliabilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceResults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5988,25 +9420,23 @@ func (m *QueryRequestPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceResults = append(m.PriceResults, &PriceResult{})
-			if err := m.PriceResults[len(m.PriceResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6029,7 +9459,7 @@ func (m *QueryRequestPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRequestVerificationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorReliabilityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6052,49 +9482,17 @@ func (m *QueryRequestVerificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestVerificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorReliabilityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorReliabilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reliability", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6104,86 +9502,30 @@ func (m *QueryRequestVerificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-			}
-			m.RequestId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
-			}
-			m.ExternalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExternalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
-			}
-			m.DataSourceId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataSourceId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			if err := m.Reliability.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SamplingWeightPercentage", wireType)
+			}
+			m.SamplingWeightPercentage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6193,29 +9535,116 @@ func (m *QueryRequestVerificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SamplingWeightPercentage |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPrunedResultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrunedResultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrunedResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reporter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPrunedResultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			var byteLen int
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrunedResultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrunedResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromRequestID", wireType)
+			}
+			m.FromRequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6225,31 +9654,16 @@ func (m *QueryRequestVerificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.FromRequestID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
-			}
-			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRequestID", wireType)
 			}
-			m.MaxDelay = 0
+			m.ToRequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6259,7 +9673,7 @@ func (m *QueryRequestVerificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxDelay |= uint64(b&0x7F) << shift
+				m.ToRequestID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6285,7 +9699,7 @@ func (m *QueryRequestVerificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRequestVerificationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelPrepaidBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6308,15 +9722,15 @@ func (m *QueryRequestVerificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRequestVerificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelPrepaidBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRequestVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelPrepaidBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6344,11 +9758,61 @@ func (m *QueryRequestVerificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelPrepaidBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelPrepaidBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelPrepaidBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6376,32 +9840,13 @@ func (m *QueryRequestVerificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-			}
-			m.RequestId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			m.ExternalId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6411,50 +9856,26 @@ func (m *QueryRequestVerificationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExternalId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.DataSourceId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataSourceId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsDelay", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IsDelay = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
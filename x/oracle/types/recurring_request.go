@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRecurringRequest creates a new RecurringRequest instance.
+func NewRecurringRequest(
+	id RecurringRequestID,
+	oracleScriptID OracleScriptID,
+	calldata []byte,
+	askCount, minCount uint64,
+	clientID string,
+	feeLimit sdk.Coins,
+	prepareGas, executeGas uint64,
+	tssEncoder Encoder,
+	callback string,
+	interval uint64,
+	nextHeight int64,
+	feePayer, owner sdk.AccAddress,
+) RecurringRequest {
+	return RecurringRequest{
+		ID:             id,
+		OracleScriptID: oracleScriptID,
+		Calldata:       calldata,
+		AskCount:       askCount,
+		MinCount:       minCount,
+		ClientID:       clientID,
+		FeeLimit:       feeLimit,
+		PrepareGas:     prepareGas,
+		ExecuteGas:     executeGas,
+		TSSEncoder:     tssEncoder,
+		Callback:       callback,
+		Interval:       interval,
+		NextHeight:     nextHeight,
+		FeePayer:       feePayer.String(),
+		Owner:          owner.String(),
+		IsActive:       true,
+	}
+}
@@ -9,6 +9,8 @@ import (
 var (
 	_ RequestSpec = &MsgRequestData{}
 	_ RequestSpec = &OracleRequestPacketData{}
+	_ RequestSpec = &MsgCreateRecurringRequest{}
+	_ RequestSpec = &RecurringRequest{}
 )
 
 // RequestSpec captures the essence of what it means to be a request-making object.
@@ -22,6 +24,7 @@ type RequestSpec interface {
 	GetExecuteGas() uint64
 	GetFeeLimit() sdk.Coins
 	GetTSSEncoder() Encoder
+	GetCallback() string
 }
 
 func NewRawRequest(
@@ -75,3 +78,17 @@ func NewRequest(
 		FeeLimit:            feeLimit,
 	}
 }
+
+// ValidateCallback checks that the callback is either empty or a valid module name.
+func ValidateCallback(callback string) error {
+	if callback == "" {
+		return nil
+	}
+	if len(callback) > MaxCallbackLength {
+		return WrapMaxError(ErrInvalidCallback, len(callback), MaxCallbackLength)
+	}
+	if !sdk.IsAlphaNumeric(callback) {
+		return ErrInvalidCallback.Wrapf("callback must be alphanumeric: %s", callback)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// RequestIndex defines the expected node-local index of resolved requests that serves the
+// RequestSearch and RequestPrice queries. The index is not a part of the consensus state.
+type RequestIndex interface {
+	// SearchRequests returns the IDs of the successfully resolved requests that match the given
+	// input, from the latest one.
+	SearchRequests(
+		oracleScriptID OracleScriptID,
+		calldata []byte,
+		askCount uint64,
+		minCount uint64,
+		pagination *query.PageRequest,
+	) ([]RequestID, *query.PageResponse, error)
+
+	// GetPrice returns the latest price of the symbol resolved with the given ask and min count.
+	GetPrice(symbol string, askCount uint64, minCount uint64) (PriceResult, error)
+
+	// GetPrices returns the latest prices of all symbols resolved with the given ask and min count.
+	GetPrices(askCount uint64, minCount uint64, pagination *query.PageRequest) ([]*PriceResult, *query.PageResponse, error)
+}
@@ -58,6 +58,9 @@ type MsgRequestData struct {
 	Sender string `protobuf:"bytes,9,opt,name=sender,proto3" json:"sender,omitempty"`
 	// TSSEncoder is the mode of encoding oracle result signature order.
 	TSSEncoder Encoder `protobuf:"varint,10,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// Callback is the name of the module to be notified through the oracle hooks
+	// when the request is resolved (optional).
+	Callback string `protobuf:"bytes,11,opt,name=callback,proto3" json:"callback,omitempty"`
 }
 
 func (m *MsgRequestData) Reset()         { *m = MsgRequestData{} }
@@ -163,6 +166,13 @@ func (m *MsgRequestData) GetTSSEncoder() Encoder {
 	return ENCODER_UNSPECIFIED
 }
 
+func (m *MsgRequestData) GetCallback() string {
+	if m != nil {
+		return m.Callback
+	}
+	return ""
+}
+
 // MsgRequestDataResponse is response data for MsgRequestData message
 type MsgRequestDataResponse struct {
 }
@@ -969,30 +979,54 @@ func (m *MsgActivateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgActivateResponse proto.InternalMessageInfo
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParams struct {
-	// authority is the address of the governance account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the x/oracle parameters to update.
-	//
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
-}
-
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+// MsgCreateRecurringRequest is a message for creating a recurring request
+// that spawns an oracle request every interval blocks, paid from a prepaid
+// deposit.
+type MsgCreateRecurringRequest struct {
+	// OracleScriptID is the identifier of the oracle script to be called.
+	OracleScriptID OracleScriptID `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3,casttype=OracleScriptID" json:"oracle_script_id,omitempty"`
+	// Calldata is the OBI-encoded call parameters for the oracle script.
+	Calldata []byte `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is the number of validators to perform the oracle task.
+	AskCount uint64 `protobuf:"varint,3,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is the minimum number of validators sufficient to resolve the
+	// oracle tasks.
+	MinCount uint64 `protobuf:"varint,4,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// ClientID is the client-provided unique identifier to track the spawned
+	// requests.
+	ClientID string `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// FeeLimit is the maximum tokens that will be paid for each spawned request.
+	FeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fee_limit,json=feeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_limit"`
+	// PrepareGas is amount of gas to pay to prepare raw requests
+	PrepareGas uint64 `protobuf:"varint,7,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
+	// ExecuteGas is amount of gas to reserve for executing
+	ExecuteGas uint64 `protobuf:"varint,8,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	// TSSEncoder is the mode of encoding oracle result signature order.
+	TSSEncoder Encoder `protobuf:"varint,9,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// Callback is the name of the module to be notified through the oracle hooks
+	// when a spawned request is resolved (optional).
+	Callback string `protobuf:"bytes,10,opt,name=callback,proto3" json:"callback,omitempty"`
+	// Interval is the number of blocks between two spawned requests.
+	Interval uint64 `protobuf:"varint,11,opt,name=interval,proto3" json:"interval,omitempty"`
+	// InitialDeposit is the amount of tokens transferred to the fee payer of the
+	// recurring request.
+	InitialDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=initial_deposit,json=initialDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_deposit"`
+	// Owner is the address of the account who creates the recurring request.
+	Owner string `protobuf:"bytes,13,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgCreateRecurringRequest) Reset()         { *m = MsgCreateRecurringRequest{} }
+func (m *MsgCreateRecurringRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateRecurringRequest) ProtoMessage()    {}
+func (*MsgCreateRecurringRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3ffde65d794f19e2, []int{14}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateRecurringRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateRecurringRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateRecurringRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1002,51 +1036,128 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgCreateRecurringRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateRecurringRequest.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgCreateRecurringRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgCreateRecurringRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateRecurringRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateRecurringRequest proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgCreateRecurringRequest) GetOracleScriptID() OracleScriptID {
 	if m != nil {
-		return m.Authority
+		return m.OracleScriptID
+	}
+	return 0
+}
+
+func (m *MsgCreateRecurringRequest) GetCalldata() []byte {
+	if m != nil {
+		return m.Calldata
+	}
+	return nil
+}
+
+func (m *MsgCreateRecurringRequest) GetAskCount() uint64 {
+	if m != nil {
+		return m.AskCount
+	}
+	return 0
+}
+
+func (m *MsgCreateRecurringRequest) GetMinCount() uint64 {
+	if m != nil {
+		return m.MinCount
+	}
+	return 0
+}
+
+func (m *MsgCreateRecurringRequest) GetClientID() string {
+	if m != nil {
+		return m.ClientID
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgCreateRecurringRequest) GetFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Params
+		return m.FeeLimit
 	}
-	return Params{}
+	return nil
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParamsResponse struct {
+func (m *MsgCreateRecurringRequest) GetPrepareGas() uint64 {
+	if m != nil {
+		return m.PrepareGas
+	}
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreateRecurringRequest) GetExecuteGas() uint64 {
+	if m != nil {
+		return m.ExecuteGas
+	}
+	return 0
+}
+
+func (m *MsgCreateRecurringRequest) GetTSSEncoder() Encoder {
+	if m != nil {
+		return m.TSSEncoder
+	}
+	return ENCODER_UNSPECIFIED
+}
+
+func (m *MsgCreateRecurringRequest) GetCallback() string {
+	if m != nil {
+		return m.Callback
+	}
+	return ""
+}
+
+func (m *MsgCreateRecurringRequest) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *MsgCreateRecurringRequest) GetInitialDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.InitialDeposit
+	}
+	return nil
+}
+
+func (m *MsgCreateRecurringRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// MsgCreateRecurringRequestResponse is response data for
+// MsgCreateRecurringRequest message
+type MsgCreateRecurringRequestResponse struct {
+	// RecurringRequestID is the ID of the newly created recurring request.
+	RecurringRequestID RecurringRequestID `protobuf:"varint,1,opt,name=recurring_request_id,json=recurringRequestId,proto3,casttype=RecurringRequestID" json:"recurring_request_id,omitempty"`
+}
+
+func (m *MsgCreateRecurringRequestResponse) Reset()         { *m = MsgCreateRecurringRequestResponse{} }
+func (m *MsgCreateRecurringRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateRecurringRequestResponse) ProtoMessage()    {}
+func (*MsgCreateRecurringRequestResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3ffde65d794f19e2, []int{15}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateRecurringRequestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateRecurringRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateRecurringRequestResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1056,289 +1167,758 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgCreateRecurringRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateRecurringRequestResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgCreateRecurringRequestResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgCreateRecurringRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateRecurringRequestResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateRecurringRequestResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgRequestData)(nil), "band.oracle.v1.MsgRequestData")
-	proto.RegisterType((*MsgRequestDataResponse)(nil), "band.oracle.v1.MsgRequestDataResponse")
-	proto.RegisterType((*MsgReportData)(nil), "band.oracle.v1.MsgReportData")
-	proto.RegisterType((*MsgReportDataResponse)(nil), "band.oracle.v1.MsgReportDataResponse")
-	proto.RegisterType((*MsgCreateDataSource)(nil), "band.oracle.v1.MsgCreateDataSource")
-	proto.RegisterType((*MsgCreateDataSourceResponse)(nil), "band.oracle.v1.MsgCreateDataSourceResponse")
-	proto.RegisterType((*MsgEditDataSource)(nil), "band.oracle.v1.MsgEditDataSource")
-	proto.RegisterType((*MsgEditDataSourceResponse)(nil), "band.oracle.v1.MsgEditDataSourceResponse")
-	proto.RegisterType((*MsgCreateOracleScript)(nil), "band.oracle.v1.MsgCreateOracleScript")
-	proto.RegisterType((*MsgCreateOracleScriptResponse)(nil), "band.oracle.v1.MsgCreateOracleScriptResponse")
-	proto.RegisterType((*MsgEditOracleScript)(nil), "band.oracle.v1.MsgEditOracleScript")
-	proto.RegisterType((*MsgEditOracleScriptResponse)(nil), "band.oracle.v1.MsgEditOracleScriptResponse")
-	proto.RegisterType((*MsgActivate)(nil), "band.oracle.v1.MsgActivate")
-	proto.RegisterType((*MsgActivateResponse)(nil), "band.oracle.v1.MsgActivateResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "band.oracle.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "band.oracle.v1.MsgUpdateParamsResponse")
+func (m *MsgCreateRecurringRequestResponse) GetRecurringRequestID() RecurringRequestID {
+	if m != nil {
+		return m.RecurringRequestID
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("band/oracle/v1/tx.proto", fileDescriptor_3ffde65d794f19e2) }
-
-var fileDescriptor_3ffde65d794f19e2 = []byte{
-	// 1284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0x36, 0xad, 0x8f, 0x88, 0x23, 0x59, 0x49, 0x98, 0x0f, 0xc9, 0x34, 0x2c, 0x2a, 0xca, 0xc7,
-	0xab, 0x24, 0xb0, 0x98, 0x38, 0xc1, 0x0b, 0xc4, 0x97, 0x36, 0x72, 0xd2, 0xd6, 0x40, 0xd4, 0x16,
-	0x74, 0x53, 0x14, 0x05, 0x5a, 0x61, 0x45, 0x6e, 0x68, 0x36, 0x12, 0xa9, 0x72, 0x57, 0x4a, 0x72,
-	0x2b, 0x7a, 0xec, 0xa5, 0xed, 0x3f, 0xe8, 0xad, 0x40, 0x81, 0x02, 0x39, 0xe4, 0x2f, 0x14, 0xc8,
-	0x31, 0x48, 0x2f, 0x3d, 0xa9, 0x85, 0x72, 0x48, 0xef, 0x3d, 0x14, 0xe8, 0xa9, 0xd8, 0xe5, 0x8a,
-	0x22, 0x65, 0xca, 0x76, 0x8c, 0x34, 0x17, 0x9b, 0xb3, 0xcf, 0x33, 0xb3, 0xc3, 0x79, 0x66, 0x96,
-	0x2b, 0x28, 0x75, 0x90, 0x6b, 0xe9, 0x9e, 0x8f, 0xcc, 0x2e, 0xd6, 0x87, 0x57, 0x75, 0xfa, 0xb0,
-	0xd1, 0xf7, 0x3d, 0xea, 0x29, 0x45, 0x06, 0x34, 0x02, 0xa0, 0x31, 0xbc, 0xaa, 0x9e, 0xb4, 0x3d,
-	0xdb, 0xe3, 0x90, 0xce, 0x9e, 0x02, 0x96, 0xba, 0x32, 0xe3, 0x2e, 0xf8, 0x01, 0x58, 0x31, 0x3d,
-	0xd2, 0xf3, 0x88, 0xde, 0x41, 0x84, 0x81, 0x1d, 0x4c, 0xd1, 0x55, 0xdd, 0xf4, 0x1c, 0x57, 0xe0,
-	0xcb, 0x01, 0xde, 0x0e, 0xa2, 0x06, 0x86, 0x80, 0x4a, 0xc2, 0xb5, 0x47, 0x6c, 0x16, 0xb6, 0x47,
-	0x6c, 0x01, 0x1c, 0x47, 0x3d, 0xc7, 0xf5, 0x74, 0xfe, 0x37, 0x58, 0xaa, 0xfd, 0x9c, 0x86, 0x62,
-	0x8b, 0xd8, 0x06, 0xfe, 0x72, 0x80, 0x09, 0xbd, 0x85, 0x28, 0x52, 0xde, 0x87, 0x63, 0x41, 0x26,
-	0x6d, 0x62, 0xfa, 0x4e, 0x9f, 0xb6, 0x1d, 0xab, 0x2c, 0x55, 0xa5, 0x7a, 0xba, 0x79, 0x6e, 0x3c,
-	0xd2, 0x8a, 0x1f, 0x70, 0x6c, 0x9b, 0x43, 0x5b, 0xb7, 0xfe, 0xd9, 0xb5, 0x62, 0x14, 0xbd, 0xa8,
-	0x6d, 0x29, 0x2a, 0xe4, 0x4c, 0xd4, 0xed, 0x5a, 0x88, 0xa2, 0xf2, 0x62, 0x55, 0xaa, 0x17, 0x8c,
-	0xd0, 0x56, 0x56, 0x40, 0x46, 0xe4, 0x7e, 0xdb, 0xf4, 0x06, 0x2e, 0x2d, 0xa7, 0xd8, 0x26, 0x46,
-	0x0e, 0x91, 0xfb, 0x9b, 0xcc, 0x66, 0x60, 0xcf, 0x71, 0x05, 0x98, 0x0e, 0xc0, 0x9e, 0xe3, 0x06,
-	0xe0, 0x45, 0x90, 0xcd, 0xae, 0x83, 0x5d, 0x9e, 0x5e, 0xa6, 0x2a, 0xd5, 0xe5, 0x66, 0x61, 0x3c,
-	0xd2, 0x72, 0x9b, 0x7c, 0x71, 0xeb, 0x96, 0x91, 0x0b, 0xe0, 0x2d, 0x4b, 0xd9, 0x01, 0xf9, 0x1e,
-	0xc6, 0xed, 0xae, 0xd3, 0x73, 0x68, 0x39, 0x5b, 0x4d, 0xd5, 0xf3, 0xeb, 0xcb, 0x0d, 0x51, 0x31,
-	0x56, 0xde, 0x86, 0x28, 0x6f, 0x63, 0xd3, 0x73, 0xdc, 0xe6, 0x95, 0xa7, 0x23, 0x6d, 0xe1, 0xa7,
-	0xdf, 0xb5, 0xba, 0xed, 0xd0, 0x9d, 0x41, 0xa7, 0x61, 0x7a, 0x3d, 0x51, 0x5e, 0xf1, 0x6f, 0x8d,
-	0x58, 0xf7, 0x75, 0xfa, 0xa8, 0x8f, 0x09, 0x77, 0x20, 0x46, 0xee, 0x1e, 0xc6, 0x77, 0x58, 0x70,
-	0x45, 0x83, 0x7c, 0xdf, 0xc7, 0x7d, 0xe4, 0xe3, 0xb6, 0x8d, 0x48, 0xf9, 0x08, 0xcf, 0x19, 0xc4,
-	0xd2, 0xbb, 0x88, 0x30, 0x02, 0x7e, 0x88, 0xcd, 0x01, 0x0d, 0x08, 0xb9, 0x80, 0x20, 0x96, 0x18,
-	0xe1, 0x0a, 0x64, 0x09, 0x76, 0x2d, 0xec, 0x97, 0x65, 0xfe, 0x4e, 0xe5, 0xe7, 0x4f, 0xd6, 0x4e,
-	0x8a, 0x5c, 0x6f, 0x5a, 0x96, 0x8f, 0x09, 0xd9, 0xa6, 0xbe, 0xe3, 0xda, 0x86, 0xe0, 0x29, 0xef,
-	0x41, 0x9e, 0x12, 0xd2, 0xc6, 0xae, 0xe9, 0x31, 0x37, 0xa8, 0x4a, 0xf5, 0xe2, 0x7a, 0xa9, 0x11,
-	0xef, 0xc0, 0xc6, 0xed, 0x00, 0x6e, 0x16, 0xc7, 0x23, 0x0d, 0x3e, 0xda, 0xde, 0x16, 0xb6, 0x01,
-	0x94, 0x10, 0xf1, 0xbc, 0x51, 0xfb, 0xf3, 0x07, 0x4d, 0xfa, 0xfa, 0xe5, 0xe3, 0x4b, 0x22, 0xf4,
-	0x37, 0x2f, 0x1f, 0x5f, 0x12, 0x62, 0xea, 0xa2, 0x41, 0x6a, 0x65, 0x38, 0x1d, 0x6f, 0x17, 0x03,
-	0x93, 0xbe, 0xe7, 0x12, 0x5c, 0xfb, 0x4b, 0x82, 0x25, 0x0e, 0xf5, 0x3d, 0x3f, 0x68, 0xa4, 0x1b,
-	0x00, 0x7e, 0x40, 0x9c, 0xb6, 0x90, 0x3a, 0x1e, 0x69, 0xb2, 0x70, 0xe7, 0xdd, 0x33, 0x35, 0x0c,
-	0x59, 0xb0, 0xb7, 0x2c, 0xe5, 0x6d, 0xc8, 0xfb, 0xe8, 0x41, 0xdb, 0xe7, 0xc1, 0x48, 0x79, 0x51,
-	0x88, 0x36, 0xf3, 0x52, 0x06, 0x7a, 0x10, 0x6c, 0xd7, 0x4c, 0x33, 0xd1, 0x0c, 0xf0, 0x27, 0x0b,
-	0x44, 0x79, 0x0b, 0xe4, 0x21, 0xea, 0x3a, 0x16, 0xa2, 0x9e, 0xcf, 0x3b, 0x4b, 0x6e, 0x9e, 0x79,
-	0xfe, 0x64, 0x6d, 0x55, 0xd4, 0xf2, 0xe3, 0x09, 0x16, 0x2f, 0xea, 0xd4, 0x67, 0xe3, 0xdc, 0xa4,
-	0x1a, 0xd3, 0x35, 0x56, 0x90, 0xa5, 0xb0, 0x20, 0x6c, 0x9f, 0x5a, 0x09, 0x4e, 0xc5, 0x5e, 0x3a,
-	0x2c, 0xc7, 0xb7, 0x29, 0x38, 0xd1, 0x22, 0xf6, 0xa6, 0x8f, 0x11, 0xc5, 0x0c, 0xd9, 0xf6, 0x06,
-	0xbe, 0x89, 0x15, 0x05, 0xd2, 0x2e, 0xea, 0x61, 0x5e, 0x0e, 0xd9, 0xe0, 0xcf, 0x4a, 0x15, 0xf2,
-	0x16, 0x0e, 0x86, 0xcd, 0xf1, 0x5c, 0x3e, 0x24, 0xb2, 0x11, 0x5d, 0x52, 0x2a, 0x20, 0x9a, 0x04,
-	0x75, 0xba, 0x98, 0xbf, 0x4e, 0xc1, 0x88, 0xac, 0x28, 0x9f, 0x41, 0xea, 0x1e, 0xc6, 0xe5, 0xf4,
-	0xeb, 0x6f, 0x6e, 0x16, 0x57, 0xb9, 0x0e, 0x39, 0xea, 0x63, 0x44, 0x06, 0xfe, 0x23, 0x31, 0x6b,
-	0xf3, 0xfb, 0x32, 0x64, 0x2a, 0x0d, 0xc8, 0x78, 0x0f, 0x5c, 0xec, 0x97, 0xb3, 0xfb, 0xb8, 0x04,
-	0xb4, 0x48, 0xef, 0x1f, 0x39, 0x58, 0xef, 0x6f, 0x5c, 0x4e, 0xe8, 0xd8, 0x92, 0x10, 0x68, 0xb6,
-	0xf2, 0xb5, 0x55, 0x58, 0x49, 0x10, 0x24, 0x14, 0xec, 0xd7, 0x14, 0x1c, 0x6f, 0x11, 0xfb, 0xb6,
-	0xe5, 0xd0, 0x88, 0x5c, 0xef, 0x40, 0x91, 0x1d, 0x54, 0x6d, 0xc2, 0xcd, 0x69, 0x1f, 0x57, 0xc7,
-	0x23, 0xad, 0x30, 0xe5, 0xf1, 0x56, 0x8e, 0xd9, 0x46, 0xc1, 0x9a, 0x5a, 0x56, 0x28, 0xfb, 0xe2,
-	0x7c, 0xd9, 0x53, 0xfb, 0xc9, 0x9e, 0x9e, 0x27, 0x7b, 0xe6, 0x0d, 0xc8, 0x9e, 0x7d, 0x75, 0xd9,
-	0x8f, 0xbc, 0xaa, 0xec, 0xb9, 0x03, 0xca, 0x7e, 0x31, 0x41, 0xf6, 0x53, 0x42, 0xf6, 0xb8, 0x7e,
-	0xb5, 0x15, 0x58, 0xde, 0x25, 0x6a, 0x28, 0xf9, 0x2f, 0x8b, 0x7c, 0x7a, 0x83, 0x96, 0x88, 0x7e,
-	0xc5, 0x0e, 0x39, 0xa5, 0xa7, 0x21, 0x4b, 0xcc, 0x1d, 0xdc, 0x43, 0x42, 0x4b, 0x61, 0x29, 0x37,
-	0xe0, 0xa8, 0xe8, 0x1f, 0x76, 0xd0, 0xb6, 0x07, 0x7e, 0x97, 0x6b, 0x29, 0x37, 0x8f, 0x8f, 0x47,
-	0xda, 0x52, 0x90, 0xd4, 0xa6, 0x67, 0xe1, 0xbb, 0xc6, 0x1d, 0x63, 0x89, 0x4c, 0x4d, 0xbf, 0xcb,
-	0x12, 0x61, 0x3e, 0x7c, 0xea, 0x0a, 0x06, 0x7f, 0x7e, 0x03, 0x73, 0xb5, 0x96, 0x50, 0xe0, 0xe5,
-	0xd8, 0x5c, 0x45, 0xab, 0x55, 0xd3, 0x60, 0x35, 0xb1, 0x8c, 0x61, 0xa1, 0xbf, 0x0f, 0x0e, 0x43,
-	0x26, 0x43, 0xac, 0xcc, 0xaf, 0xfb, 0xaa, 0x71, 0xb8, 0x29, 0x9b, 0xca, 0x96, 0xde, 0x4f, 0xb6,
-	0xcc, 0x2b, 0xca, 0x96, 0x4d, 0x92, 0xed, 0x3f, 0x9b, 0x8b, 0xbd, 0x8e, 0xc3, 0xd9, 0xda, 0x8b,
-	0xe3, 0x70, 0x76, 0x39, 0x94, 0x6c, 0x08, 0xf9, 0x16, 0xb1, 0x6f, 0x9a, 0xd4, 0x19, 0x22, 0x8a,
-	0xe3, 0x9f, 0x53, 0xe9, 0x10, 0x9f, 0xd3, 0x0b, 0xc9, 0x9f, 0xd3, 0xa3, 0x22, 0xbd, 0xc9, 0x46,
-	0xb5, 0x53, 0xbc, 0x53, 0x26, 0x66, 0x98, 0xce, 0x8f, 0x12, 0x1c, 0x6d, 0x11, 0xfb, 0x6e, 0xdf,
-	0x42, 0x14, 0x7f, 0x88, 0x7c, 0xd4, 0x23, 0xca, 0xff, 0x41, 0x46, 0x03, 0xba, 0xe3, 0xf9, 0x0e,
-	0x7d, 0x24, 0x72, 0x9a, 0x5f, 0xa3, 0x29, 0x55, 0xb9, 0x0e, 0xd9, 0x3e, 0x8f, 0xc0, 0xfb, 0x24,
-	0xbf, 0x7e, 0x7a, 0xf6, 0x5e, 0x11, 0xc4, 0x17, 0x97, 0x0a, 0xc1, 0xdd, 0xb8, 0xc0, 0x93, 0x0f,
-	0xa3, 0xb0, 0xe4, 0x4f, 0x88, 0xe4, 0xa3, 0x59, 0xd5, 0x96, 0xa1, 0x34, 0x93, 0xe8, 0xe4, 0x25,
-	0xd6, 0xff, 0xce, 0x40, 0xaa, 0x45, 0x6c, 0xe5, 0x2e, 0xe4, 0xa3, 0x17, 0xee, 0xca, 0xec, 0xfe,
-	0xf1, 0x1b, 0x96, 0x7a, 0x61, 0x6f, 0x7c, 0x12, 0x5e, 0x31, 0x00, 0x22, 0xb7, 0xaf, 0xd5, 0x44,
-	0xaf, 0x09, 0xac, 0x9e, 0xdf, 0x13, 0x0e, 0x63, 0x5a, 0x70, 0x6c, 0xd7, 0x15, 0xe6, 0x6c, 0x82,
-	0xeb, 0x2c, 0x49, 0xbd, 0x7c, 0x00, 0x52, 0xb8, 0xcb, 0xe7, 0x50, 0x9c, 0xf9, 0xee, 0x9e, 0x49,
-	0x70, 0x8f, 0x53, 0xd4, 0x8b, 0xfb, 0x52, 0xc2, 0xf8, 0x5f, 0x80, 0x92, 0x70, 0xc8, 0x9f, 0x9f,
-	0x9b, 0x62, 0x94, 0xa6, 0xae, 0x1d, 0x88, 0x16, 0xad, 0xd8, 0xae, 0x73, 0xee, 0xec, 0x9c, 0x54,
-	0x63, 0xfb, 0x5c, 0x3e, 0x00, 0x29, 0xdc, 0xe5, 0x0e, 0xe4, 0xc2, 0xd9, 0x5c, 0x49, 0x70, 0x9c,
-	0x80, 0xea, 0xd9, 0x3d, 0xc0, 0x30, 0xda, 0x27, 0x50, 0x88, 0x4d, 0x96, 0x96, 0xe0, 0x14, 0x25,
-	0xa8, 0xff, 0xdb, 0x87, 0x30, 0x89, 0xac, 0x66, 0xbe, 0x7a, 0xf9, 0xf8, 0x92, 0xd4, 0xdc, 0x7a,
-	0x3a, 0xae, 0x48, 0xcf, 0xc6, 0x15, 0xe9, 0x8f, 0x71, 0x45, 0xfa, 0xee, 0x45, 0x65, 0xe1, 0xd9,
-	0x8b, 0xca, 0xc2, 0x6f, 0x2f, 0x2a, 0x0b, 0x9f, 0xea, 0x91, 0x2b, 0x09, 0x8b, 0xc9, 0x7f, 0x96,
-	0x9a, 0x5e, 0x57, 0x37, 0x77, 0x90, 0xe3, 0xea, 0xc3, 0x6b, 0xfa, 0xc3, 0xc9, 0x4f, 0x64, 0x7e,
-	0x3f, 0xe9, 0x64, 0x39, 0xe3, 0xda, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xab, 0x06, 0x21,
-	0x7d, 0x0f, 0x00, 0x00,
+// MsgUpdateRecurringRequest is a message for updating the interval and fee
+// limit of a recurring request and topping up its deposit. An inactive
+// recurring request is reactivated by this message.
+type MsgUpdateRecurringRequest struct {
+	// RecurringRequestID is the ID of the recurring request to be updated.
+	RecurringRequestID RecurringRequestID `protobuf:"varint,1,opt,name=recurring_request_id,json=recurringRequestId,proto3,casttype=RecurringRequestID" json:"recurring_request_id,omitempty"`
+	// Interval is the new number of blocks between two spawned requests.
+	Interval uint64 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// FeeLimit is the new maximum tokens that will be paid for each spawned
+	// request.
+	FeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fee_limit,json=feeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_limit"`
+	// Deposit is the additional amount of tokens transferred to the fee payer of
+	// the recurring request.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// Owner is the address of the owner of the recurring request.
+	Owner string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgUpdateRecurringRequest) Reset()         { *m = MsgUpdateRecurringRequest{} }
+func (m *MsgUpdateRecurringRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRecurringRequest) ProtoMessage()    {}
+func (*MsgUpdateRecurringRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{16}
+}
+func (m *MsgUpdateRecurringRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (this *MsgRequestData) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*MsgRequestData)
-	if !ok {
-		that2, ok := that.(MsgRequestData)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *MsgUpdateRecurringRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRecurringRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.OracleScriptID != that1.OracleScriptID {
-		return false
-	}
-	if !bytes.Equal(this.Calldata, that1.Calldata) {
-		return false
+}
+func (m *MsgUpdateRecurringRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRecurringRequest.Merge(m, src)
+}
+func (m *MsgUpdateRecurringRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRecurringRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRecurringRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateRecurringRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateRecurringRequest) GetRecurringRequestID() RecurringRequestID {
+	if m != nil {
+		return m.RecurringRequestID
 	}
-	if this.AskCount != that1.AskCount {
-		return false
+	return 0
+}
+
+func (m *MsgUpdateRecurringRequest) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
 	}
-	if this.MinCount != that1.MinCount {
-		return false
+	return 0
+}
+
+func (m *MsgUpdateRecurringRequest) GetFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeLimit
 	}
-	if this.ClientID != that1.ClientID {
-		return false
+	return nil
+}
+
+func (m *MsgUpdateRecurringRequest) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
 	}
-	if len(this.FeeLimit) != len(that1.FeeLimit) {
-		return false
+	return nil
+}
+
+func (m *MsgUpdateRecurringRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	for i := range this.FeeLimit {
-		if !this.FeeLimit[i].Equal(&that1.FeeLimit[i]) {
-			return false
+	return ""
+}
+
+// MsgUpdateRecurringRequestResponse is response data for
+// MsgUpdateRecurringRequest message
+type MsgUpdateRecurringRequestResponse struct {
+}
+
+func (m *MsgUpdateRecurringRequestResponse) Reset()         { *m = MsgUpdateRecurringRequestResponse{} }
+func (m *MsgUpdateRecurringRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRecurringRequestResponse) ProtoMessage()    {}
+func (*MsgUpdateRecurringRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{17}
+}
+func (m *MsgUpdateRecurringRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRecurringRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRecurringRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.PrepareGas != that1.PrepareGas {
-		return false
-	}
-	if this.ExecuteGas != that1.ExecuteGas {
-		return false
-	}
-	if this.Sender != that1.Sender {
-		return false
-	}
-	if this.TSSEncoder != that1.TSSEncoder {
-		return false
-	}
-	return true
 }
-func (this *MsgReportData) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MsgUpdateRecurringRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRecurringRequestResponse.Merge(m, src)
+}
+func (m *MsgUpdateRecurringRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRecurringRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRecurringRequestResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgReportData)
-	if !ok {
-		that2, ok := that.(MsgReportData)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_MsgUpdateRecurringRequestResponse proto.InternalMessageInfo
+
+// MsgCancelRecurringRequest is a message for cancelling a recurring request.
+// The remaining deposit is refunded to the owner.
+type MsgCancelRecurringRequest struct {
+	// RecurringRequestID is the ID of the recurring request to be cancelled.
+	RecurringRequestID RecurringRequestID `protobuf:"varint,1,opt,name=recurring_request_id,json=recurringRequestId,proto3,casttype=RecurringRequestID" json:"recurring_request_id,omitempty"`
+	// Owner is the address of the owner of the recurring request.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgCancelRecurringRequest) Reset()         { *m = MsgCancelRecurringRequest{} }
+func (m *MsgCancelRecurringRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRecurringRequest) ProtoMessage()    {}
+func (*MsgCancelRecurringRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{18}
+}
+func (m *MsgCancelRecurringRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRecurringRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRecurringRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.RequestID != that1.RequestID {
-		return false
+}
+func (m *MsgCancelRecurringRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRecurringRequest.Merge(m, src)
+}
+func (m *MsgCancelRecurringRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRecurringRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRecurringRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRecurringRequest proto.InternalMessageInfo
+
+func (m *MsgCancelRecurringRequest) GetRecurringRequestID() RecurringRequestID {
+	if m != nil {
+		return m.RecurringRequestID
 	}
-	if len(this.RawReports) != len(that1.RawReports) {
-		return false
+	return 0
+}
+
+func (m *MsgCancelRecurringRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	for i := range this.RawReports {
-		if !this.RawReports[i].Equal(&that1.RawReports[i]) {
-			return false
+	return ""
+}
+
+// MsgCancelRecurringRequestResponse is response data for
+// MsgCancelRecurringRequest message
+type MsgCancelRecurringRequestResponse struct {
+}
+
+func (m *MsgCancelRecurringRequestResponse) Reset()         { *m = MsgCancelRecurringRequestResponse{} }
+func (m *MsgCancelRecurringRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRecurringRequestResponse) ProtoMessage()    {}
+func (*MsgCancelRecurringRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{19}
+}
+func (m *MsgCancelRecurringRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRecurringRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRecurringRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.Validator != that1.Validator {
-		return false
-	}
-	return true
 }
-func (this *MsgCreateDataSource) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MsgCancelRecurringRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRecurringRequestResponse.Merge(m, src)
+}
+func (m *MsgCancelRecurringRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRecurringRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRecurringRequestResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgCreateDataSource)
-	if !ok {
-		that2, ok := that.(MsgCreateDataSource)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_MsgCancelRecurringRequestResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/oracle parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{20}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
-	}
-	if !bytes.Equal(this.Executable, that1.Executable) {
-		return false
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(this.Fee) != len(that1.Fee) {
-		return false
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	for i := range this.Fee {
-		if !this.Fee[i].Equal(&that1.Fee[i]) {
-			return false
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{21}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.Treasury != that1.Treasury {
-		return false
-	}
-	if this.Owner != that1.Owner {
-		return false
-	}
-	if this.Sender != that1.Sender {
-		return false
-	}
-	return true
 }
-func (this *MsgEditDataSource) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgEditDataSource)
-	if !ok {
-		that2, ok := that.(MsgEditDataSource)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgWithdrawChannelPrepaidFee is a message for withdrawing from the prepaid
+// fee account of an oracle IBC channel.
+type MsgWithdrawChannelPrepaidFee struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// ChannelID is the ID of the oracle IBC channel to withdraw from.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Receiver is the address receiving the withdrawn tokens.
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// Amount is the amount of tokens to be withdrawn.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawChannelPrepaidFee) Reset()         { *m = MsgWithdrawChannelPrepaidFee{} }
+func (m *MsgWithdrawChannelPrepaidFee) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawChannelPrepaidFee) ProtoMessage()    {}
+func (*MsgWithdrawChannelPrepaidFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{22}
+}
+func (m *MsgWithdrawChannelPrepaidFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawChannelPrepaidFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawChannelPrepaidFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.DataSourceID != that1.DataSourceID {
-		return false
+}
+func (m *MsgWithdrawChannelPrepaidFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawChannelPrepaidFee.Merge(m, src)
+}
+func (m *MsgWithdrawChannelPrepaidFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawChannelPrepaidFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawChannelPrepaidFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawChannelPrepaidFee proto.InternalMessageInfo
+
+func (m *MsgWithdrawChannelPrepaidFee) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if this.Name != that1.Name {
-		return false
+	return ""
+}
+
+func (m *MsgWithdrawChannelPrepaidFee) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	if this.Description != that1.Description {
-		return false
+	return ""
+}
+
+func (m *MsgWithdrawChannelPrepaidFee) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *MsgWithdrawChannelPrepaidFee) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgWithdrawChannelPrepaidFeeResponse is response data for
+// MsgWithdrawChannelPrepaidFee message
+type MsgWithdrawChannelPrepaidFeeResponse struct {
+}
+
+func (m *MsgWithdrawChannelPrepaidFeeResponse) Reset()         { *m = MsgWithdrawChannelPrepaidFeeResponse{} }
+func (m *MsgWithdrawChannelPrepaidFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawChannelPrepaidFeeResponse) ProtoMessage()    {}
+func (*MsgWithdrawChannelPrepaidFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{23}
+}
+func (m *MsgWithdrawChannelPrepaidFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawChannelPrepaidFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawChannelPrepaidFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawChannelPrepaidFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawChannelPrepaidFeeResponse.Merge(m, src)
+}
+func (m *MsgWithdrawChannelPrepaidFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawChannelPrepaidFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawChannelPrepaidFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawChannelPrepaidFeeResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRequestData)(nil), "band.oracle.v1.MsgRequestData")
+	proto.RegisterType((*MsgRequestDataResponse)(nil), "band.oracle.v1.MsgRequestDataResponse")
+	proto.RegisterType((*MsgReportData)(nil), "band.oracle.v1.MsgReportData")
+	proto.RegisterType((*MsgReportDataResponse)(nil), "band.oracle.v1.MsgReportDataResponse")
+	proto.RegisterType((*MsgCreateDataSource)(nil), "band.oracle.v1.MsgCreateDataSource")
+	proto.RegisterType((*MsgCreateDataSourceResponse)(nil), "band.oracle.v1.MsgCreateDataSourceResponse")
+	proto.RegisterType((*MsgEditDataSource)(nil), "band.oracle.v1.MsgEditDataSource")
+	proto.RegisterType((*MsgEditDataSourceResponse)(nil), "band.oracle.v1.MsgEditDataSourceResponse")
+	proto.RegisterType((*MsgCreateOracleScript)(nil), "band.oracle.v1.MsgCreateOracleScript")
+	proto.RegisterType((*MsgCreateOracleScriptResponse)(nil), "band.oracle.v1.MsgCreateOracleScriptResponse")
+	proto.RegisterType((*MsgEditOracleScript)(nil), "band.oracle.v1.MsgEditOracleScript")
+	proto.RegisterType((*MsgEditOracleScriptResponse)(nil), "band.oracle.v1.MsgEditOracleScriptResponse")
+	proto.RegisterType((*MsgActivate)(nil), "band.oracle.v1.MsgActivate")
+	proto.RegisterType((*MsgActivateResponse)(nil), "band.oracle.v1.MsgActivateResponse")
+	proto.RegisterType((*MsgCreateRecurringRequest)(nil), "band.oracle.v1.MsgCreateRecurringRequest")
+	proto.RegisterType((*MsgCreateRecurringRequestResponse)(nil), "band.oracle.v1.MsgCreateRecurringRequestResponse")
+	proto.RegisterType((*MsgUpdateRecurringRequest)(nil), "band.oracle.v1.MsgUpdateRecurringRequest")
+	proto.RegisterType((*MsgUpdateRecurringRequestResponse)(nil), "band.oracle.v1.MsgUpdateRecurringRequestResponse")
+	proto.RegisterType((*MsgCancelRecurringRequest)(nil), "band.oracle.v1.MsgCancelRecurringRequest")
+	proto.RegisterType((*MsgCancelRecurringRequestResponse)(nil), "band.oracle.v1.MsgCancelRecurringRequestResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "band.oracle.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "band.oracle.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgWithdrawChannelPrepaidFee)(nil), "band.oracle.v1.MsgWithdrawChannelPrepaidFee")
+	proto.RegisterType((*MsgWithdrawChannelPrepaidFeeResponse)(nil), "band.oracle.v1.MsgWithdrawChannelPrepaidFeeResponse")
+}
+
+func init() { proto.RegisterFile("band/oracle/v1/tx.proto", fileDescriptor_3ffde65d794f19e2) }
+
+var fileDescriptor_3ffde65d794f19e2 = []byte{
+	// 1648 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x73, 0xdb, 0xd4,
+	0x16, 0x8f, 0x63, 0xc7, 0xb1, 0x8e, 0x1d, 0xa7, 0x55, 0xdb, 0xc4, 0x71, 0x5e, 0x6c, 0xd7, 0x69,
+	0xf3, 0x92, 0xb6, 0xb1, 0x9b, 0xb4, 0xf3, 0x66, 0x9a, 0xcd, 0x7b, 0x75, 0xd2, 0x3e, 0x32, 0xd3,
+	0x40, 0x47, 0xa1, 0xc0, 0x30, 0x03, 0x9e, 0x1b, 0xe9, 0xd6, 0x11, 0xb1, 0x25, 0xa3, 0x2b, 0x3b,
+	0xed, 0x0a, 0x06, 0x76, 0x6c, 0x80, 0x6f, 0xc0, 0x8e, 0x19, 0x56, 0x5d, 0xf4, 0x2b, 0x30, 0x53,
+	0x58, 0x75, 0xca, 0x86, 0x0d, 0xa6, 0xe3, 0x2e, 0xca, 0x1a, 0x76, 0xac, 0x98, 0xfb, 0x47, 0xb2,
+	0xa4, 0xc8, 0xce, 0x9f, 0x26, 0x65, 0xc3, 0x26, 0xd1, 0xb9, 0xe7, 0x77, 0xcf, 0xb9, 0xf7, 0xfc,
+	0xce, 0xb9, 0xf7, 0x48, 0x86, 0xc9, 0x2d, 0x64, 0x68, 0x65, 0xd3, 0x42, 0x6a, 0x1d, 0x97, 0xdb,
+	0x4b, 0x65, 0xfb, 0x41, 0xa9, 0x69, 0x99, 0xb6, 0x29, 0xa7, 0xa9, 0xa2, 0xc4, 0x15, 0xa5, 0xf6,
+	0x52, 0xf6, 0x6c, 0xcd, 0xac, 0x99, 0x4c, 0x55, 0xa6, 0x4f, 0x1c, 0x95, 0x9d, 0x0e, 0x4c, 0x17,
+	0x78, 0xae, 0xcc, 0xa9, 0x26, 0x69, 0x98, 0xa4, 0xbc, 0x85, 0x08, 0x55, 0x6e, 0x61, 0x1b, 0x2d,
+	0x95, 0x55, 0x53, 0x37, 0x84, 0x7e, 0x8a, 0xeb, 0xab, 0xdc, 0x2a, 0x17, 0x84, 0x6a, 0x52, 0x4c,
+	0x6d, 0x90, 0x1a, 0x35, 0xdb, 0x20, 0x35, 0xa1, 0x38, 0x8d, 0x1a, 0xba, 0x61, 0x96, 0xd9, 0x5f,
+	0x3e, 0x54, 0xfc, 0x21, 0x06, 0xe9, 0x0d, 0x52, 0x53, 0xf0, 0xc7, 0x2d, 0x4c, 0xec, 0x35, 0x64,
+	0x23, 0xf9, 0x4d, 0x38, 0xc5, 0x57, 0x52, 0x25, 0xaa, 0xa5, 0x37, 0xed, 0xaa, 0xae, 0x65, 0x22,
+	0x85, 0xc8, 0x7c, 0xac, 0x72, 0xa1, 0xdb, 0xc9, 0xa7, 0xdf, 0x62, 0xba, 0x4d, 0xa6, 0x5a, 0x5f,
+	0xfb, 0x73, 0xcf, 0x88, 0x92, 0x36, 0xbd, 0xb2, 0x26, 0x67, 0x21, 0xa1, 0xa2, 0x7a, 0x5d, 0x43,
+	0x36, 0xca, 0x0c, 0x17, 0x22, 0xf3, 0x29, 0xc5, 0x95, 0xe5, 0x69, 0x90, 0x10, 0xd9, 0xa9, 0xaa,
+	0x66, 0xcb, 0xb0, 0x33, 0x51, 0xea, 0x44, 0x49, 0x20, 0xb2, 0xb3, 0x4a, 0x65, 0xaa, 0x6c, 0xe8,
+	0x86, 0x50, 0xc6, 0xb8, 0xb2, 0xa1, 0x1b, 0x5c, 0xb9, 0x00, 0x92, 0x5a, 0xd7, 0xb1, 0xc1, 0x96,
+	0x37, 0x52, 0x88, 0xcc, 0x4b, 0x95, 0x54, 0xb7, 0x93, 0x4f, 0xac, 0xb2, 0xc1, 0xf5, 0x35, 0x25,
+	0xc1, 0xd5, 0xeb, 0x9a, 0xbc, 0x0d, 0xd2, 0x7d, 0x8c, 0xab, 0x75, 0xbd, 0xa1, 0xdb, 0x99, 0x78,
+	0x21, 0x3a, 0x9f, 0x5c, 0x9e, 0x2a, 0x89, 0x88, 0xd1, 0xf0, 0x96, 0x44, 0x78, 0x4b, 0xab, 0xa6,
+	0x6e, 0x54, 0xae, 0x3e, 0xe9, 0xe4, 0x87, 0xbe, 0xfb, 0x35, 0x3f, 0x5f, 0xd3, 0xed, 0xed, 0xd6,
+	0x56, 0x49, 0x35, 0x1b, 0x22, 0xbc, 0xe2, 0xdf, 0x22, 0xd1, 0x76, 0xca, 0xf6, 0xc3, 0x26, 0x26,
+	0x6c, 0x02, 0x51, 0x12, 0xf7, 0x31, 0xbe, 0x43, 0x8d, 0xcb, 0x79, 0x48, 0x36, 0x2d, 0xdc, 0x44,
+	0x16, 0xae, 0xd6, 0x10, 0xc9, 0x8c, 0xb2, 0x35, 0x83, 0x18, 0xfa, 0x3f, 0x22, 0x14, 0x80, 0x1f,
+	0x60, 0xb5, 0x65, 0x73, 0x40, 0x82, 0x03, 0xc4, 0x10, 0x05, 0x5c, 0x85, 0x38, 0xc1, 0x86, 0x86,
+	0xad, 0x8c, 0xc4, 0xf6, 0x94, 0x79, 0xf6, 0x78, 0xf1, 0xac, 0x58, 0xeb, 0x4d, 0x4d, 0xb3, 0x30,
+	0x21, 0x9b, 0xb6, 0xa5, 0x1b, 0x35, 0x45, 0xe0, 0xe4, 0x37, 0x20, 0x69, 0x13, 0x52, 0xc5, 0x86,
+	0x6a, 0xd2, 0x69, 0x50, 0x88, 0xcc, 0xa7, 0x97, 0x27, 0x4b, 0xfe, 0x0c, 0x2c, 0xdd, 0xe2, 0xea,
+	0x4a, 0xba, 0xdb, 0xc9, 0xc3, 0xdb, 0x9b, 0x9b, 0x42, 0x56, 0xc0, 0x26, 0x44, 0x3c, 0x3b, 0x44,
+	0x6d, 0x21, 0x75, 0x27, 0x93, 0xa4, 0xde, 0x15, 0x57, 0x5e, 0x29, 0xfe, 0xf6, 0x4d, 0x3e, 0xf2,
+	0xd9, 0xcb, 0x47, 0x97, 0x84, 0xdb, 0x2f, 0x5e, 0x3e, 0xba, 0x24, 0x88, 0x2e, 0x8b, 0xe4, 0x29,
+	0x66, 0x60, 0xc2, 0x9f, 0x4a, 0x0a, 0x26, 0x4d, 0xd3, 0x20, 0xb8, 0xf8, 0x47, 0x04, 0xc6, 0x98,
+	0xaa, 0x69, 0x5a, 0x3c, 0xc9, 0x6e, 0x00, 0x58, 0x1c, 0xd8, 0x4b, 0xaf, 0x6c, 0xb7, 0x93, 0x97,
+	0xc4, 0x74, 0x96, 0x59, 0x3d, 0x41, 0x91, 0x04, 0x7a, 0x5d, 0x93, 0xff, 0x07, 0x49, 0x0b, 0xed,
+	0x56, 0x2d, 0x66, 0x8c, 0x64, 0x86, 0x05, 0xa1, 0x81, 0x0d, 0x2b, 0x68, 0x97, 0xbb, 0xab, 0xc4,
+	0x28, 0xa1, 0x0a, 0x58, 0xce, 0x00, 0x91, 0xff, 0x0b, 0x52, 0x1b, 0xd5, 0x75, 0x0d, 0xd9, 0xa6,
+	0xc5, 0xb2, 0x4e, 0xaa, 0x9c, 0x7f, 0xf6, 0x78, 0x71, 0x46, 0xc4, 0xf9, 0x1d, 0x47, 0xe7, 0x0f,
+	0x78, 0x6f, 0xce, 0xca, 0x05, 0x27, 0x1a, 0xbd, 0x31, 0x1a, 0x90, 0x31, 0x37, 0x20, 0xd4, 0x4f,
+	0x71, 0x12, 0xce, 0xf9, 0x36, 0xed, 0x86, 0xe3, 0xcb, 0x28, 0x9c, 0xd9, 0x20, 0xb5, 0x55, 0x0b,
+	0x23, 0x1b, 0x53, 0xcd, 0xa6, 0xd9, 0xb2, 0x54, 0x2c, 0xcb, 0x10, 0x33, 0x50, 0x03, 0xb3, 0x70,
+	0x48, 0x0a, 0x7b, 0x96, 0x0b, 0x90, 0xd4, 0x30, 0x2f, 0x44, 0xdd, 0x34, 0x58, 0x01, 0x49, 0x8a,
+	0x77, 0x48, 0xce, 0x81, 0x48, 0x20, 0xb4, 0x55, 0xc7, 0x6c, 0x3b, 0x29, 0xc5, 0x33, 0x22, 0x7f,
+	0x00, 0xd1, 0xfb, 0x18, 0x67, 0x62, 0xc7, 0x9f, 0xf8, 0xd4, 0xae, 0x7c, 0x1d, 0x12, 0xb6, 0x85,
+	0x11, 0x69, 0x59, 0x0f, 0x45, 0x1d, 0xf6, 0xcf, 0x59, 0x17, 0x29, 0x97, 0x60, 0xc4, 0xdc, 0x35,
+	0xb0, 0x95, 0x89, 0xef, 0x33, 0x85, 0xc3, 0x3c, 0x75, 0x31, 0x7a, 0xb0, 0xba, 0x58, 0xb9, 0x1c,
+	0x92, 0xb1, 0x93, 0x82, 0xa0, 0x60, 0xe4, 0x8b, 0x33, 0x30, 0x1d, 0x42, 0x88, 0x4b, 0xd8, 0x4f,
+	0x51, 0x38, 0xbd, 0x41, 0x6a, 0xb7, 0x34, 0xdd, 0xf6, 0xd0, 0x75, 0x1b, 0xd2, 0xf4, 0x10, 0xab,
+	0x12, 0x26, 0xf6, 0xf2, 0xb8, 0xd0, 0xed, 0xe4, 0x53, 0x3d, 0x1c, 0x4b, 0x65, 0x9f, 0xac, 0xa4,
+	0xb4, 0x9e, 0xa4, 0xb9, 0xb4, 0x0f, 0xf7, 0xa7, 0x3d, 0xba, 0x1f, 0xed, 0xb1, 0x7e, 0xb4, 0x8f,
+	0xbc, 0x06, 0xda, 0xe3, 0x87, 0xa7, 0x7d, 0xf4, 0xb0, 0xb4, 0x27, 0x0e, 0x48, 0xfb, 0x42, 0x08,
+	0xed, 0xe7, 0x04, 0xed, 0x7e, 0xfe, 0x8a, 0xd3, 0x30, 0xb5, 0x87, 0x54, 0x97, 0xf2, 0xef, 0x87,
+	0x59, 0xf5, 0xf2, 0x94, 0xf0, 0xde, 0x70, 0x47, 0xac, 0xd2, 0x09, 0x88, 0x13, 0x75, 0x1b, 0x37,
+	0x90, 0xe0, 0x52, 0x48, 0xf2, 0x0d, 0x18, 0x17, 0xf9, 0x43, 0x0f, 0xe1, 0x6a, 0xcb, 0xaa, 0x33,
+	0x2e, 0xa5, 0xca, 0xe9, 0x6e, 0x27, 0x3f, 0xc6, 0x17, 0xb5, 0x6a, 0x6a, 0xf8, 0x9e, 0x72, 0x47,
+	0x19, 0x23, 0x3d, 0xd1, 0xaa, 0xd3, 0x85, 0xd0, 0x39, 0xac, 0xea, 0x52, 0x0a, 0x7b, 0x7e, 0x0d,
+	0x75, 0xb5, 0x18, 0x12, 0xe0, 0x29, 0x5f, 0x5d, 0x79, 0xa3, 0x55, 0xcc, 0xc3, 0x4c, 0x68, 0x18,
+	0xdd, 0x40, 0x7f, 0xcd, 0x0f, 0x43, 0x4a, 0x83, 0x2f, 0xcc, 0xc7, 0xdd, 0x86, 0x1c, 0xad, 0xca,
+	0x7a, 0xb4, 0xc5, 0xf6, 0xa3, 0x6d, 0xe4, 0x90, 0xb4, 0xc5, 0xc3, 0x68, 0x3b, 0xb1, 0xba, 0x18,
+	0x74, 0x1c, 0x06, 0x63, 0x2f, 0x8e, 0xc3, 0xe0, 0xb0, 0x4b, 0x59, 0x1b, 0x92, 0x1b, 0xa4, 0x76,
+	0x53, 0xb5, 0xf5, 0x36, 0xb2, 0xb1, 0xff, 0x3a, 0x8d, 0x1c, 0xe1, 0x3a, 0x9d, 0x0b, 0xbf, 0x4e,
+	0xc7, 0xc5, 0xf2, 0x1c, 0x47, 0xc5, 0x73, 0x2c, 0x53, 0x1c, 0xd1, 0x5d, 0xce, 0xef, 0x23, 0xac,
+	0x90, 0x79, 0x8e, 0x29, 0x58, 0x6d, 0x59, 0xcc, 0x01, 0x6f, 0x18, 0xfe, 0x69, 0x67, 0xff, 0xce,
+	0x76, 0x36, 0xd0, 0x9c, 0x4a, 0xc7, 0xd3, 0x9c, 0x82, 0xbf, 0x39, 0xa5, 0x3a, 0xdd, 0xb0, 0xb1,
+	0xd5, 0x46, 0x75, 0xd6, 0xb8, 0xc6, 0x14, 0x57, 0x96, 0x6d, 0x18, 0xd7, 0x0d, 0xdd, 0xd6, 0x51,
+	0xbd, 0xaa, 0xe1, 0xa6, 0x49, 0x74, 0x3b, 0x93, 0x3a, 0xfe, 0x98, 0xa5, 0x85, 0x8f, 0x35, 0xee,
+	0xa2, 0x57, 0xcf, 0x63, 0x07, 0xaa, 0xe7, 0x95, 0xab, 0x4e, 0x05, 0x70, 0x99, 0x66, 0xff, 0x8c,
+	0xef, 0x4c, 0x0d, 0xa6, 0x75, 0xf1, 0xf3, 0x08, 0x9c, 0xef, 0x9b, 0xf4, 0x4e, 0x69, 0xc8, 0x1f,
+	0xc2, 0x59, 0xcb, 0xd1, 0x55, 0xf7, 0x34, 0xdc, 0x57, 0xba, 0x9d, 0xbc, 0x1c, 0x9c, 0xcb, 0x8a,
+	0x20, 0x64, 0x54, 0x91, 0xad, 0xe0, 0x98, 0x56, 0xfc, 0x31, 0xca, 0x4a, 0xef, 0x5e, 0x53, 0x0b,
+	0x2b, 0xbd, 0x13, 0xf6, 0xee, 0xe3, 0x7d, 0x38, 0xc0, 0xbb, 0xaf, 0x4a, 0xa2, 0x27, 0x59, 0x25,
+	0x18, 0x46, 0x9d, 0xcc, 0x3a, 0x81, 0x1e, 0xdb, 0xb1, 0xdd, 0x4b, 0xa9, 0x91, 0x57, 0x4e, 0xa9,
+	0x70, 0xba, 0x8a, 0xb3, 0x2c, 0xa3, 0xc2, 0x95, 0xee, 0x61, 0xfb, 0x4b, 0x84, 0x1f, 0xb6, 0xc8,
+	0x50, 0x71, 0xfd, 0xb5, 0x33, 0xee, 0x06, 0x61, 0xf8, 0xd5, 0xeb, 0x2a, 0x74, 0x07, 0x22, 0x08,
+	0xe1, 0x4a, 0x37, 0x08, 0xdf, 0x46, 0x60, 0xdc, 0x0d, 0xd5, 0x5d, 0x64, 0xa1, 0x06, 0x91, 0xff,
+	0x03, 0x12, 0x6a, 0xd9, 0xdb, 0xa6, 0xa5, 0xdb, 0x0f, 0xc5, 0x2d, 0xd8, 0x7f, 0x79, 0x3d, 0xa8,
+	0x7c, 0x1d, 0xe2, 0x4d, 0x66, 0x81, 0xed, 0x29, 0xb9, 0x3c, 0x11, 0x3c, 0x1d, 0xb9, 0x7d, 0xf1,
+	0x1a, 0x2b, 0xb0, 0x2b, 0x73, 0xec, 0xba, 0x74, 0xad, 0xd0, 0x8d, 0x9d, 0xf1, 0xb1, 0xcb, 0x67,
+	0x15, 0xa7, 0x60, 0x32, 0xb0, 0x50, 0x6f, 0x87, 0xfb, 0xaf, 0x0d, 0x52, 0x7b, 0x57, 0xb7, 0xb7,
+	0x35, 0x0b, 0xed, 0xae, 0x6e, 0x23, 0xc3, 0xc0, 0xf5, 0xbb, 0xf4, 0x74, 0xd7, 0xb5, 0xdb, 0x18,
+	0x1f, 0x79, 0x47, 0x33, 0x00, 0x2a, 0x37, 0x46, 0xa9, 0xe7, 0xfd, 0x96, 0x24, 0x46, 0xd6, 0x35,
+	0xfa, 0xe6, 0x60, 0x61, 0x15, 0xeb, 0x6d, 0xec, 0xbc, 0x7c, 0x0f, 0x78, 0x73, 0x70, 0x90, 0xb2,
+	0x0a, 0x71, 0xd4, 0x10, 0x57, 0xe7, 0xb1, 0x17, 0x99, 0x30, 0xbd, 0x72, 0x6d, 0x6f, 0x54, 0x0b,
+	0x22, 0xaa, 0x7d, 0xc3, 0x54, 0x9c, 0x83, 0x0b, 0x83, 0xc2, 0xe8, 0xc4, 0x7b, 0xf9, 0xb9, 0x04,
+	0xd1, 0x0d, 0x52, 0x93, 0xef, 0x41, 0xd2, 0xfb, 0xb9, 0x2d, 0x17, 0xe4, 0xdb, 0xff, 0x0d, 0x25,
+	0x3b, 0x37, 0x58, 0xef, 0x1e, 0xf5, 0x0a, 0x80, 0xe7, 0xfb, 0xca, 0x4c, 0xe8, 0x2c, 0x47, 0x9d,
+	0xbd, 0x38, 0x50, 0xed, 0xda, 0xd4, 0xe0, 0xd4, 0x9e, 0x8f, 0x14, 0xb3, 0x21, 0x53, 0x83, 0xa0,
+	0xec, 0xe5, 0x03, 0x80, 0x3c, 0x97, 0x54, 0x3a, 0xf0, 0x66, 0x7d, 0x3e, 0x64, 0xba, 0x1f, 0x92,
+	0x5d, 0xd8, 0x17, 0xe2, 0xda, 0xff, 0x08, 0xe4, 0x90, 0xd7, 0xb8, 0x8b, 0x7d, 0x97, 0xe8, 0x85,
+	0x65, 0x17, 0x0f, 0x04, 0xf3, 0x46, 0x6c, 0xcf, 0x9b, 0xcc, 0x6c, 0x9f, 0xa5, 0xfa, 0xfc, 0x5c,
+	0x3e, 0x00, 0xc8, 0xf5, 0x72, 0x07, 0x12, 0x6e, 0xf7, 0x3d, 0x1d, 0x32, 0xd1, 0x51, 0x66, 0x67,
+	0x07, 0x28, 0x5d, 0x6b, 0x6d, 0x98, 0xe8, 0xd3, 0x3b, 0x2f, 0xf4, 0xdd, 0x7c, 0x10, 0x9a, 0x5d,
+	0x3a, 0x30, 0xd4, 0xeb, 0xb7, 0x4f, 0xe3, 0x10, 0xe6, 0x37, 0x1c, 0x1a, 0xea, 0x77, 0xf0, 0x15,
+	0xc6, 0xf6, 0x1b, 0x7e, 0x7d, 0x85, 0xee, 0x37, 0x14, 0x1a, 0xbe, 0xdf, 0x81, 0xb7, 0x86, 0xfc,
+	0x1e, 0xa4, 0x7c, 0x37, 0x46, 0xbe, 0xef, 0xd2, 0x39, 0x20, 0xfb, 0xef, 0x7d, 0x00, 0xae, 0xe5,
+	0x4f, 0x60, 0xaa, 0xff, 0x31, 0x7e, 0x25, 0xc4, 0x4a, 0x5f, 0x74, 0xf6, 0xfa, 0x61, 0xd0, 0xce,
+	0x02, 0xb2, 0x23, 0x9f, 0xbe, 0x7c, 0x74, 0x29, 0x52, 0x59, 0x7f, 0xd2, 0xcd, 0x45, 0x9e, 0x76,
+	0x73, 0x91, 0xe7, 0xdd, 0x5c, 0xe4, 0xab, 0x17, 0xb9, 0xa1, 0xa7, 0x2f, 0x72, 0x43, 0x3f, 0xbf,
+	0xc8, 0x0d, 0xbd, 0x5f, 0xf6, 0x9c, 0xc5, 0xd4, 0x01, 0xfb, 0xf5, 0x41, 0x35, 0xeb, 0x65, 0x75,
+	0x1b, 0xe9, 0x46, 0xb9, 0x7d, 0xad, 0xfc, 0xc0, 0xf9, 0x25, 0x84, 0x1d, 0xcc, 0x5b, 0x71, 0x86,
+	0xb8, 0xf6, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xab, 0xa8, 0xc1, 0x3d, 0x64, 0x19, 0x00, 0x00,
+}
+
+func (this *MsgRequestData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRequestData)
+	if !ok {
+		that2, ok := that.(MsgRequestData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
+	}
+	if !bytes.Equal(this.Calldata, that1.Calldata) {
+		return false
+	}
+	if this.AskCount != that1.AskCount {
+		return false
+	}
+	if this.MinCount != that1.MinCount {
+		return false
+	}
+	if this.ClientID != that1.ClientID {
+		return false
+	}
+	if len(this.FeeLimit) != len(that1.FeeLimit) {
+		return false
+	}
+	for i := range this.FeeLimit {
+		if !this.FeeLimit[i].Equal(&that1.FeeLimit[i]) {
+			return false
+		}
+	}
+	if this.PrepareGas != that1.PrepareGas {
+		return false
+	}
+	if this.ExecuteGas != that1.ExecuteGas {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.TSSEncoder != that1.TSSEncoder {
+		return false
+	}
+	if this.Callback != that1.Callback {
+		return false
+	}
+	return true
+}
+func (this *MsgReportData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgReportData)
+	if !ok {
+		that2, ok := that.(MsgReportData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RequestID != that1.RequestID {
+		return false
+	}
+	if len(this.RawReports) != len(that1.RawReports) {
+		return false
+	}
+	for i := range this.RawReports {
+		if !this.RawReports[i].Equal(&that1.RawReports[i]) {
+			return false
+		}
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	return true
+}
+func (this *MsgCreateDataSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCreateDataSource)
+	if !ok {
+		that2, ok := that.(MsgCreateDataSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if !bytes.Equal(this.Executable, that1.Executable) {
+		return false
+	}
+	if len(this.Fee) != len(that1.Fee) {
+		return false
+	}
+	for i := range this.Fee {
+		if !this.Fee[i].Equal(&that1.Fee[i]) {
+			return false
+		}
+	}
+	if this.Treasury != that1.Treasury {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	return true
+}
+func (this *MsgEditDataSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgEditDataSource)
+	if !ok {
+		that2, ok := that.(MsgEditDataSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DataSourceID != that1.DataSourceID {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
 	}
 	if !bytes.Equal(this.Executable, that1.Executable) {
 		return false
@@ -1473,39 +2053,194 @@ func (this *MsgActivate) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgCreateRecurringRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// RequestData defines a method for submitting a new request.
-	RequestData(ctx context.Context, in *MsgRequestData, opts ...grpc.CallOption) (*MsgRequestDataResponse, error)
-	// ReportData defines a method for reporting a data to resolve the request.
-	ReportData(ctx context.Context, in *MsgReportData, opts ...grpc.CallOption) (*MsgReportDataResponse, error)
-	// CreateDataSource defines a method for creating a new data source.
-	CreateDataSource(ctx context.Context, in *MsgCreateDataSource, opts ...grpc.CallOption) (*MsgCreateDataSourceResponse, error)
-	// EditDataSource defines a method for editing an existing data source.
-	EditDataSource(ctx context.Context, in *MsgEditDataSource, opts ...grpc.CallOption) (*MsgEditDataSourceResponse, error)
-	// CreateOracleScript defines a method for creating a new oracle script.
-	CreateOracleScript(ctx context.Context, in *MsgCreateOracleScript, opts ...grpc.CallOption) (*MsgCreateOracleScriptResponse, error)
-	// EditOracleScript defines a method for editing an existing oracle script.
-	EditOracleScript(ctx context.Context, in *MsgEditOracleScript, opts ...grpc.CallOption) (*MsgEditOracleScriptResponse, error)
-	// Activate defines a method for applying to be an oracle validator.
-	Activate(ctx context.Context, in *MsgActivate, opts ...grpc.CallOption) (*MsgActivateResponse, error)
-	// UpdateParams defines a governance operation for updating the x/oracle module
-	// parameters.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-}
+	that1, ok := that.(*MsgCreateRecurringRequest)
+	if !ok {
+		that2, ok := that.(MsgCreateRecurringRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
+	}
+	if !bytes.Equal(this.Calldata, that1.Calldata) {
+		return false
+	}
+	if this.AskCount != that1.AskCount {
+		return false
+	}
+	if this.MinCount != that1.MinCount {
+		return false
+	}
+	if this.ClientID != that1.ClientID {
+		return false
+	}
+	if len(this.FeeLimit) != len(that1.FeeLimit) {
+		return false
+	}
+	for i := range this.FeeLimit {
+		if !this.FeeLimit[i].Equal(&that1.FeeLimit[i]) {
+			return false
+		}
+	}
+	if this.PrepareGas != that1.PrepareGas {
+		return false
+	}
+	if this.ExecuteGas != that1.ExecuteGas {
+		return false
+	}
+	if this.TSSEncoder != that1.TSSEncoder {
+		return false
+	}
+	if this.Callback != that1.Callback {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if len(this.InitialDeposit) != len(that1.InitialDeposit) {
+		return false
+	}
+	for i := range this.InitialDeposit {
+		if !this.InitialDeposit[i].Equal(&that1.InitialDeposit[i]) {
+			return false
+		}
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateRecurringRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateRecurringRequest)
+	if !ok {
+		that2, ok := that.(MsgUpdateRecurringRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RecurringRequestID != that1.RecurringRequestID {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if len(this.FeeLimit) != len(that1.FeeLimit) {
+		return false
+	}
+	for i := range this.FeeLimit {
+		if !this.FeeLimit[i].Equal(&that1.FeeLimit[i]) {
+			return false
+		}
+	}
+	if len(this.Deposit) != len(that1.Deposit) {
+		return false
+	}
+	for i := range this.Deposit {
+		if !this.Deposit[i].Equal(&that1.Deposit[i]) {
+			return false
+		}
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	return true
+}
+func (this *MsgCancelRecurringRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCancelRecurringRequest)
+	if !ok {
+		that2, ok := that.(MsgCancelRecurringRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RecurringRequestID != that1.RecurringRequestID {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// RequestData defines a method for submitting a new request.
+	RequestData(ctx context.Context, in *MsgRequestData, opts ...grpc.CallOption) (*MsgRequestDataResponse, error)
+	// ReportData defines a method for reporting a data to resolve the request.
+	ReportData(ctx context.Context, in *MsgReportData, opts ...grpc.CallOption) (*MsgReportDataResponse, error)
+	// CreateDataSource defines a method for creating a new data source.
+	CreateDataSource(ctx context.Context, in *MsgCreateDataSource, opts ...grpc.CallOption) (*MsgCreateDataSourceResponse, error)
+	// EditDataSource defines a method for editing an existing data source.
+	EditDataSource(ctx context.Context, in *MsgEditDataSource, opts ...grpc.CallOption) (*MsgEditDataSourceResponse, error)
+	// CreateOracleScript defines a method for creating a new oracle script.
+	CreateOracleScript(ctx context.Context, in *MsgCreateOracleScript, opts ...grpc.CallOption) (*MsgCreateOracleScriptResponse, error)
+	// EditOracleScript defines a method for editing an existing oracle script.
+	EditOracleScript(ctx context.Context, in *MsgEditOracleScript, opts ...grpc.CallOption) (*MsgEditOracleScriptResponse, error)
+	// Activate defines a method for applying to be an oracle validator.
+	Activate(ctx context.Context, in *MsgActivate, opts ...grpc.CallOption) (*MsgActivateResponse, error)
+	// CreateRecurringRequest defines a method for creating a request schedule
+	// that spawns an oracle request every given number of blocks.
+	CreateRecurringRequest(ctx context.Context, in *MsgCreateRecurringRequest, opts ...grpc.CallOption) (*MsgCreateRecurringRequestResponse, error)
+	// UpdateRecurringRequest defines a method for updating and topping up an
+	// existing recurring request.
+	UpdateRecurringRequest(ctx context.Context, in *MsgUpdateRecurringRequest, opts ...grpc.CallOption) (*MsgUpdateRecurringRequestResponse, error)
+	// CancelRecurringRequest defines a method for cancelling a recurring request
+	// and refunding its remaining deposit.
+	CancelRecurringRequest(ctx context.Context, in *MsgCancelRecurringRequest, opts ...grpc.CallOption) (*MsgCancelRecurringRequestResponse, error)
+	// UpdateParams defines a governance operation for updating the x/oracle module
+	// parameters.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// WithdrawChannelPrepaidFee defines a governance operation for withdrawing
+	// from the prepaid fee account of an oracle IBC channel.
+	WithdrawChannelPrepaidFee(ctx context.Context, in *MsgWithdrawChannelPrepaidFee, opts ...grpc.CallOption) (*MsgWithdrawChannelPrepaidFeeResponse, error)
+}
 
 type msgClient struct {
 	cc grpc1.ClientConn
@@ -1578,6 +2313,33 @@ func (c *msgClient) Activate(ctx context.Context, in *MsgActivate, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) CreateRecurringRequest(ctx context.Context, in *MsgCreateRecurringRequest, opts ...grpc.CallOption) (*MsgCreateRecurringRequestResponse, error) {
+	out := new(MsgCreateRecurringRequestResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Msg/CreateRecurringRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateRecurringRequest(ctx context.Context, in *MsgUpdateRecurringRequest, opts ...grpc.CallOption) (*MsgUpdateRecurringRequestResponse, error) {
+	out := new(MsgUpdateRecurringRequestResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Msg/UpdateRecurringRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRecurringRequest(ctx context.Context, in *MsgCancelRecurringRequest, opts ...grpc.CallOption) (*MsgCancelRecurringRequestResponse, error) {
+	out := new(MsgCancelRecurringRequestResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Msg/CancelRecurringRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/band.oracle.v1.Msg/UpdateParams", in, out, opts...)
@@ -1587,6 +2349,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) WithdrawChannelPrepaidFee(ctx context.Context, in *MsgWithdrawChannelPrepaidFee, opts ...grpc.CallOption) (*MsgWithdrawChannelPrepaidFeeResponse, error) {
+	out := new(MsgWithdrawChannelPrepaidFeeResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Msg/WithdrawChannelPrepaidFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RequestData defines a method for submitting a new request.
@@ -1603,11 +2374,23 @@ type MsgServer interface {
 	EditOracleScript(context.Context, *MsgEditOracleScript) (*MsgEditOracleScriptResponse, error)
 	// Activate defines a method for applying to be an oracle validator.
 	Activate(context.Context, *MsgActivate) (*MsgActivateResponse, error)
+	// CreateRecurringRequest defines a method for creating a request schedule
+	// that spawns an oracle request every given number of blocks.
+	CreateRecurringRequest(context.Context, *MsgCreateRecurringRequest) (*MsgCreateRecurringRequestResponse, error)
+	// UpdateRecurringRequest defines a method for updating and topping up an
+	// existing recurring request.
+	UpdateRecurringRequest(context.Context, *MsgUpdateRecurringRequest) (*MsgUpdateRecurringRequestResponse, error)
+	// CancelRecurringRequest defines a method for cancelling a recurring request
+	// and refunding its remaining deposit.
+	CancelRecurringRequest(context.Context, *MsgCancelRecurringRequest) (*MsgCancelRecurringRequestResponse, error)
 	// UpdateParams defines a governance operation for updating the x/oracle module
 	// parameters.
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// WithdrawChannelPrepaidFee defines a governance operation for withdrawing
+	// from the prepaid fee account of an oracle IBC channel.
+	WithdrawChannelPrepaidFee(context.Context, *MsgWithdrawChannelPrepaidFee) (*MsgWithdrawChannelPrepaidFeeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1635,9 +2418,21 @@ func (*UnimplementedMsgServer) EditOracleScript(ctx context.Context, req *MsgEdi
 func (*UnimplementedMsgServer) Activate(ctx context.Context, req *MsgActivate) (*MsgActivateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Activate not implemented")
 }
+func (*UnimplementedMsgServer) CreateRecurringRequest(ctx context.Context, req *MsgCreateRecurringRequest) (*MsgCreateRecurringRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringRequest not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRecurringRequest(ctx context.Context, req *MsgUpdateRecurringRequest) (*MsgUpdateRecurringRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecurringRequest not implemented")
+}
+func (*UnimplementedMsgServer) CancelRecurringRequest(ctx context.Context, req *MsgCancelRecurringRequest) (*MsgCancelRecurringRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRecurringRequest not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawChannelPrepaidFee(ctx context.Context, req *MsgWithdrawChannelPrepaidFee) (*MsgWithdrawChannelPrepaidFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawChannelPrepaidFee not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1769,6 +2564,60 @@ func _Msg_Activate_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateRecurringRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRecurringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRecurringRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Msg/CreateRecurringRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRecurringRequest(ctx, req.(*MsgCreateRecurringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateRecurringRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRecurringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRecurringRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Msg/UpdateRecurringRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRecurringRequest(ctx, req.(*MsgUpdateRecurringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRecurringRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRecurringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRecurringRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Msg/CancelRecurringRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRecurringRequest(ctx, req.(*MsgCancelRecurringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -1787,6 +2636,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawChannelPrepaidFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawChannelPrepaidFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawChannelPrepaidFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Msg/WithdrawChannelPrepaidFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawChannelPrepaidFee(ctx, req.(*MsgWithdrawChannelPrepaidFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "band.oracle.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1819,10 +2686,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Activate",
 			Handler:    _Msg_Activate_Handler,
 		},
+		{
+			MethodName: "CreateRecurringRequest",
+			Handler:    _Msg_CreateRecurringRequest_Handler,
+		},
+		{
+			MethodName: "UpdateRecurringRequest",
+			Handler:    _Msg_UpdateRecurringRequest_Handler,
+		},
+		{
+			MethodName: "CancelRecurringRequest",
+			Handler:    _Msg_CancelRecurringRequest_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "WithdrawChannelPrepaidFee",
+			Handler:    _Msg_WithdrawChannelPrepaidFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/oracle/v1/tx.proto",
@@ -1848,6 +2731,13 @@ func (m *MsgRequestData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Callback) > 0 {
+		i -= len(m.Callback)
+		copy(dAtA[i:], m.Callback)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Callback)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.TSSEncoder != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TSSEncoder))
 		i--
@@ -2468,7 +3358,7 @@ func (m *MsgActivateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateRecurringRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2478,37 +3368,111 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateRecurringRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateRecurringRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x6a
+	}
+	if len(m.InitialDeposit) > 0 {
+		for iNdEx := len(m.InitialDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitialDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Callback) > 0 {
+		i -= len(m.Callback)
+		copy(dAtA[i:], m.Callback)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Callback)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.TSSEncoder != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TSSEncoder))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExecuteGas != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecuteGas))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PrepareGas != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PrepareGas))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.FeeLimit) > 0 {
+		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MinCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AskCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AskCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OracleScriptID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OracleScriptID))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateRecurringRequestResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2518,118 +3482,420 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateRecurringRequestResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateRecurringRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.RecurringRequestID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecurringRequestID))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateRecurringRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRequestData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateRecurringRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRecurringRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OracleScriptID != 0 {
-		n += 1 + sovTx(uint64(m.OracleScriptID))
-	}
-	l = len(m.Calldata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.AskCount != 0 {
-		n += 1 + sovTx(uint64(m.AskCount))
-	}
-	if m.MinCount != 0 {
-		n += 1 + sovTx(uint64(m.MinCount))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.ClientID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
 	if len(m.FeeLimit) > 0 {
-		for _, e := range m.FeeLimit {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.PrepareGas != 0 {
-		n += 1 + sovTx(uint64(m.PrepareGas))
-	}
-	if m.ExecuteGas != 0 {
-		n += 1 + sovTx(uint64(m.ExecuteGas))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.TSSEncoder != 0 {
-		n += 1 + sovTx(uint64(m.TSSEncoder))
+	if m.RecurringRequestID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecurringRequestID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateRecurringRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRecurringRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRecurringRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgReportData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelRecurringRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRecurringRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRecurringRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RequestID != 0 {
-		n += 1 + sovTx(uint64(m.RequestID))
-	}
-	if len(m.RawReports) > 0 {
-		for _, e := range m.RawReports {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.RecurringRequestID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecurringRequestID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgReportDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelRecurringRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRecurringRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRecurringRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateDataSource) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawChannelPrepaidFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawChannelPrepaidFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawChannelPrepaidFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawChannelPrepaidFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawChannelPrepaidFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawChannelPrepaidFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRequestData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScriptID != 0 {
+		n += 1 + sovTx(uint64(m.OracleScriptID))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AskCount != 0 {
+		n += 1 + sovTx(uint64(m.AskCount))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovTx(uint64(m.MinCount))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FeeLimit) > 0 {
+		for _, e := range m.FeeLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.PrepareGas != 0 {
+		n += 1 + sovTx(uint64(m.PrepareGas))
+	}
+	if m.ExecuteGas != 0 {
+		n += 1 + sovTx(uint64(m.ExecuteGas))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TSSEncoder != 0 {
+		n += 1 + sovTx(uint64(m.TSSEncoder))
+	}
+	l = len(m.Callback)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReportData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestID != 0 {
+		n += 1 + sovTx(uint64(m.RequestID))
+	}
+	if len(m.RawReports) > 0 {
+		for _, e := range m.RawReports {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReportDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateDataSource) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -2831,49 +4097,598 @@ func (m *MsgActivate) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgActivateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateRecurringRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScriptID != 0 {
+		n += 1 + sovTx(uint64(m.OracleScriptID))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AskCount != 0 {
+		n += 1 + sovTx(uint64(m.AskCount))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovTx(uint64(m.MinCount))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FeeLimit) > 0 {
+		for _, e := range m.FeeLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.PrepareGas != 0 {
+		n += 1 + sovTx(uint64(m.PrepareGas))
+	}
+	if m.ExecuteGas != 0 {
+		n += 1 + sovTx(uint64(m.ExecuteGas))
+	}
+	if m.TSSEncoder != 0 {
+		n += 1 + sovTx(uint64(m.TSSEncoder))
+	}
+	l = len(m.Callback)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if len(m.InitialDeposit) > 0 {
+		for _, e := range m.InitialDeposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateRecurringRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecurringRequestID != 0 {
+		n += 1 + sovTx(uint64(m.RecurringRequestID))
+	}
+	return n
+}
+
+func (m *MsgUpdateRecurringRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecurringRequestID != 0 {
+		n += 1 + sovTx(uint64(m.RecurringRequestID))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if len(m.FeeLimit) > 0 {
+		for _, e := range m.FeeLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateRecurringRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelRecurringRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecurringRequestID != 0 {
+		n += 1 + sovTx(uint64(m.RecurringRequestID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelRecurringRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawChannelPrepaidFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawChannelPrepaidFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			}
+			m.OracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+			}
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeLimit = append(m.FeeLimit, types.Coin{})
+			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+			}
+			m.PrepareGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrepareGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+			}
+			m.ExecuteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
+			}
+			m.TSSEncoder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TSSEncoder |= Encoder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgActivateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRequestDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
+func (m *MsgReportData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2896,17 +4711,17 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestData: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			m.OracleScriptID = 0
+			m.RequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2916,16 +4731,16 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				m.RequestID |= RequestID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2935,31 +4750,163 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Calldata == nil {
-				m.Calldata = []byte{}
+			m.RawReports = append(m.RawReports, RawReport{})
+			if err := m.RawReports[len(m.RawReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReportDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDataSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.AskCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2969,33 +4916,27 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AskCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.MinCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3023,13 +4964,13 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3039,31 +4980,31 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeLimit = append(m.FeeLimit, types.Coin{})
-			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Executable = append(m.Executable[:0], dAtA[iNdEx:postIndex]...)
+			if m.Executable == nil {
+				m.Executable = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			m.PrepareGas = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3073,16 +5014,31 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrepareGas |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.ExecuteGas = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3092,14 +5048,27 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExecuteGas |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3127,13 +5096,13 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			m.TSSEncoder = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3143,11 +5112,24 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TSSEncoder |= Encoder(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3169,7 +5151,7 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestDataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateDataSourceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3192,10 +5174,10 @@ func (m *MsgRequestDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateDataSourceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateDataSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3207,52 +5189,135 @@ func (m *MsgRequestDataResponse) Unmarshal(dAtA []byte) error {
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgReportData) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditDataSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
+			}
+			m.DataSourceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSourceID |= DataSourceID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReportData: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReportData: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
 			}
-			m.RequestID = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3262,14 +5327,29 @@ func (m *MsgReportData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestID |= RequestID(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executable = append(m.Executable[:0], dAtA[iNdEx:postIndex]...)
+			if m.Executable == nil {
+				m.Executable = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3296,14 +5376,14 @@ func (m *MsgReportData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawReports = append(m.RawReports, RawReport{})
-			if err := m.RawReports[len(m.RawReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3331,7 +5411,71 @@ func (m *MsgReportData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3354,7 +5498,7 @@ func (m *MsgReportData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReportDataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEditDataSourceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3377,10 +5521,10 @@ func (m *MsgReportDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReportDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEditDataSourceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReportDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEditDataSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3404,7 +5548,7 @@ func (m *MsgReportDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3427,10 +5571,10 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDataSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateOracleScript: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateOracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3499,9 +5643,9 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3511,31 +5655,29 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Executable = append(m.Executable[:0], dAtA[iNdEx:postIndex]...)
-			if m.Executable == nil {
-				m.Executable = []byte{}
-			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3545,31 +5687,29 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = append(m.Fee, types.Coin{})
-			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3579,23 +5719,25 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Treasury = string(dAtA[iNdEx:postIndex])
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
@@ -3682,7 +5824,7 @@ func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDataSourceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateOracleScriptResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3705,10 +5847,10 @@ func (m *MsgCreateDataSourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDataSourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateOracleScriptResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDataSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3732,7 +5874,7 @@ func (m *MsgCreateDataSourceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
+func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3755,17 +5897,17 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditDataSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEditOracleScript: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEditOracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
 			}
-			m.DataSourceID = 0
+			m.OracleScriptID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3775,7 +5917,7 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSourceID |= DataSourceID(b&0x7F) << shift
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3844,11 +5986,43 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3858,31 +6032,29 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Executable = append(m.Executable[:0], dAtA[iNdEx:postIndex]...)
-			if m.Executable == nil {
-				m.Executable = []byte{}
-			}
+			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3892,29 +6064,29 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = append(m.Fee, types.Coin{})
-			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3942,11 +6114,11 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Treasury = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3974,11 +6146,111 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditOracleScriptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditOracleScriptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4006,7 +6278,7 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4029,7 +6301,7 @@ func (m *MsgEditDataSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditDataSourceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4052,10 +6324,10 @@ func (m *MsgEditDataSourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditDataSourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgActivateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditDataSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgActivateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4079,7 +6351,7 @@ func (m *MsgEditDataSourceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateRecurringRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4098,19 +6370,110 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateOracleScript: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateOracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRecurringRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRecurringRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			}
+			m.OracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+			}
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4138,13 +6501,13 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ClientID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4154,29 +6517,31 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.FeeLimit = append(m.FeeLimit, types.Coin{})
+			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
 			}
-			var stringLen uint64
+			m.PrepareGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4186,27 +6551,52 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PrepareGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			m.ExecuteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TSSEncoder", wireType)
 			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.TSSEncoder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TSSEncoder |= Encoder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4234,13 +6624,13 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
+			m.Callback = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var byteLen int
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4250,31 +6640,16 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Interval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
-			}
-			iNdEx = postIndex
-		case 6:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4284,27 +6659,29 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.InitialDeposit = append(m.InitialDeposit, types.Coin{})
+			if err := m.InitialDeposit[len(m.InitialDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4332,7 +6709,7 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4355,7 +6732,7 @@ func (m *MsgCreateOracleScript) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateOracleScriptResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateRecurringRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4378,12 +6755,31 @@ func (m *MsgCreateOracleScriptResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateOracleScriptResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateRecurringRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateRecurringRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringRequestID", wireType)
+			}
+			m.RecurringRequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecurringRequestID |= RecurringRequestID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4405,7 +6801,7 @@ func (m *MsgCreateOracleScriptResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRecurringRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4428,17 +6824,17 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditOracleScript: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRecurringRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditOracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRecurringRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringRequestID", wireType)
 			}
-			m.OracleScriptID = 0
+			m.RecurringRequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4448,16 +6844,16 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				m.RecurringRequestID |= RecurringRequestID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var stringLen uint64
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4467,61 +6863,16 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Interval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4531,61 +6882,31 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCodeURL", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.FeeLimit = append(m.FeeLimit, types.Coin{})
+			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4595,27 +6916,27 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -4647,9 +6968,128 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRecurringRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRecurringRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRecurringRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRecurringRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRecurringRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRecurringRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringRequestID", wireType)
+			}
+			m.RecurringRequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecurringRequestID |= RecurringRequestID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4677,7 +7117,7 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4700,7 +7140,7 @@ func (m *MsgEditOracleScript) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditOracleScriptResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRecurringRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4723,10 +7163,10 @@ func (m *MsgEditOracleScriptResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditOracleScriptResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRecurringRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRecurringRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4750,7 +7190,7 @@ func (m *MsgEditOracleScriptResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivate) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4773,15 +7213,15 @@ func (m *MsgActivate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4809,7 +7249,40 @@ func (m *MsgActivate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4832,7 +7305,7 @@ func (m *MsgActivate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4855,10 +7328,10 @@ func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4882,7 +7355,7 @@ func (m *MsgActivateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawChannelPrepaidFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4905,10 +7378,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawChannelPrepaidFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawChannelPrepaidFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4945,7 +7418,71 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4972,7 +7509,8 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4997,7 +7535,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawChannelPrepaidFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5020,10 +7558,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawChannelPrepaidFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawChannelPrepaidFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewValidatorReliability(
+	validator sdk.ValAddress,
+	assignedCount, reportedCount, totalReportLatency uint64,
+) ValidatorReliability {
+	return ValidatorReliability{
+		Validator:          validator.String(),
+		AssignedCount:      assignedCount,
+		ReportedCount:      reportedCount,
+		TotalReportLatency: totalReportLatency,
+	}
+}
+
+// SamplingWeightPercentage returns the percentage of the validator power used as its sampling
+// weight, given the percentage of the weight that depends on reliability. Validators without any
+// assigned request are treated as fully reliable.
+func (r ValidatorReliability) SamplingWeightPercentage(reliabilityWeightPercentage uint64) uint64 {
+	if r.AssignedCount == 0 {
+		return 100
+	}
+	reported := min(r.ReportedCount, r.AssignedCount)
+	return 100 - reliabilityWeightPercentage + reliabilityWeightPercentage*reported/r.AssignedCount
+}
+
+// Decay returns the reliability with all counts halved, which keeps the report rate of the validator
+// while giving the following assignments and reports twice the weight of the earlier ones.
+func (r ValidatorReliability) Decay() ValidatorReliability {
+	r.AssignedCount /= 2
+	r.ReportedCount /= 2
+	r.TotalReportLatency /= 2
+	return r
+}
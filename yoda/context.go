@@ -50,11 +50,15 @@ type Context struct {
 	pendingRequests map[types.RequestID]bool
 
 	metricsEnabled bool
+	metrics        *yodaMetrics
+	executorName   string
 	handlingGauge  int64
 	pendingGauge   int64
 	errorCount     int64
 	submittedCount int64
 	home           string
+
+	inFlight *inFlightRequests
 }
 
 func (c *Context) nextKeyIndex() int64 {
@@ -8,6 +8,8 @@ import (
 
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -81,6 +83,24 @@ func signAndBroadcast(
 	return res.TxHash, nil
 }
 
+// calculateFee returns the fee paid by a transaction with the given gas prices and gas limit,
+// following the same rounding as the transaction factory.
+func calculateFee(gasPrices string, gasLimit uint64) sdk.Coins {
+	prices, err := sdk.ParseDecCoins(gasPrices)
+	if err != nil {
+		return sdk.NewCoins()
+	}
+
+	glDec := math.LegacyNewDec(int64(gasLimit))
+	fees := make(sdk.Coins, len(prices))
+	for i, gp := range prices {
+		fee := gp.Amount.Mul(glDec)
+		fees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+	}
+
+	return fees
+}
+
 func queryAccount(clientCtx client.Context, key *keyring.Record) (client.Account, error) {
 	accountRetriever := authtypes.AccountRetriever{}
 
@@ -110,13 +130,18 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 	ids := make([]types.RequestID, len(reports))
 	feeEstimations := make([]FeeEstimationData, len(reports))
 
+	// Remove the requests from the in-flight list however the submission ends, including validation failures.
+	for i, report := range reports {
+		ids[i] = report.msg.RequestID
+	}
+	defer c.inFlight.finish(ids...)
+
 	for i, report := range reports {
 		if err := report.msg.ValidateBasic(); err != nil {
 			l.Error(":exploding_head: Failed to validate basic with error: %s", c, err.Error())
 			return
 		}
 		msgs[i] = report.msg
-		ids[i] = report.msg.RequestID
 		feeEstimations[i] = report.feeEstimationData
 		for _, exec := range report.execVersion {
 			versionMap[exec] = true
@@ -124,6 +149,8 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 	}
 	l = l.With("rids", ids)
 
+	c.inFlight.setStage(stageSubmitting, ids...)
+
 	versions := make([]string, 0, len(versionMap))
 	for exec := range versionMap {
 		versions = append(versions, exec)
@@ -138,6 +165,7 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 	}
 
 	gasLimit := estimateGas(c, l, msgs, feeEstimations)
+	submitStart := time.Now()
 	// We want to resend transaction only if tx returns Out of gas error.
 	for sendAttempt := uint64(1); sendAttempt <= c.maxTry; sendAttempt++ {
 		var txHash string
@@ -145,6 +173,7 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 		for broadcastTry := uint64(1); broadcastTry <= c.maxTry; broadcastTry++ {
 			l.Info(":writing_hand: Try to sign and broadcast report transaction(%d/%d)", broadcastTry, c.maxTry)
 			hash, err := signAndBroadcast(c, key, msgs, gasLimit, memo)
+			c.observeBroadcastAttempt(err == nil)
 			if err != nil {
 				// Use info level because this error can happen and retry process can solve this error.
 				l.Info(":warning: %s", err.Error())
@@ -169,7 +198,9 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 				continue
 			}
 
+			c.observeReportTx(key.Name, txRes.Codespace, txRes.Code, calculateFee(c.gasPrices, gasLimit))
 			if txRes.Code == 0 {
+				c.observeReportCommit("success", time.Since(submitStart))
 				l.Info(":smiling_face_with_sunglasses: Successfully broadcast tx with hash: %s", txHash)
 				c.updateSubmittedCount(int64(len(reports)))
 				return
@@ -178,10 +209,12 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 				txRes.Code == sdkerrors.ErrOutOfGas.ABCICode() {
 				// Increase gas limit and try to broadcast again
 				gasLimit = gasLimit * 110 / 100
+				c.observeOutOfGasRetry()
 				l.Info(":fuel_pump: Tx(%s) is out of gas and will be rebroadcasted with %d gas", txHash, gasLimit)
 				txFound = true
 				break FindTx
 			} else {
+				c.observeReportCommit("failure", time.Since(submitStart))
 				l.Error(":exploding_head: Tx returned nonzero code %d with log %s, tx hash: %s", c, txRes.Code, txRes.RawLog, txRes.TxHash)
 				return
 			}
@@ -3,9 +3,11 @@ package yoda
 import (
 	"encoding/hex"
 	"strconv"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -34,7 +36,18 @@ func handleTransaction(c *Context, l *Logger, tx abci.TxResult) {
 		return
 	}
 
-	events := tx.Result.Events
+	handleRequestEvents(c, l, tx.Result.Events)
+}
+
+// handleBlockEvents handles requests spawned outside of transactions, e.g. by recurring requests
+// at the beginning of a block.
+func handleBlockEvents(c *Context, l *Logger, block cmttypes.EventDataNewBlockEvents) {
+	l.Debug(":eyes: Inspecting events of block: %d", block.Height)
+	handleRequestEvents(c, l, block.Events)
+}
+
+// handleRequestEvents starts handling every request found in the given events.
+func handleRequestEvents(c *Context, l *Logger, events []abci.Event) {
 	idStrs := GetEventValues(events, types.EventTypeRequest, types.AttributeKeyID)
 	for _, idStr := range idStrs {
 		id, err := strconv.Atoi(idStr)
@@ -79,6 +92,12 @@ func handleRequest(c *Context, l *Logger, id types.RequestID) {
 	keyIndex := c.nextKeyIndex()
 	key := c.keys[keyIndex]
 
+	dataSourceIDs := make([]types.DataSourceID, 0, len(req.RawRequests))
+	for _, raw := range req.RawRequests {
+		dataSourceIDs = append(dataSourceIDs, raw.DataSourceID)
+	}
+	c.inFlight.start(id, keyIndex, dataSourceIDs)
+
 	var rawRequests []rawRequest
 
 	// prepare raw requests
@@ -86,6 +105,7 @@ func handleRequest(c *Context, l *Logger, id types.RequestID) {
 		hash, err := GetDataSourceHash(c, l, raw.DataSourceID)
 		if err != nil {
 			l.Error(":skull: Failed to get data source hash with error: %s", c, err.Error())
+			c.inFlight.finish(id)
 			return
 		}
 
@@ -99,6 +119,7 @@ func handleRequest(c *Context, l *Logger, id types.RequestID) {
 
 	// process raw requests
 	reports, execVersions := handleRawRequests(c, l, id, rawRequests, key)
+	c.inFlight.setStage(stagePending, id)
 
 	c.pendingMsgs <- ReportMsgWithKey{
 		msg:         types.NewMsgReportData(id, reports, c.validator),
@@ -184,6 +205,7 @@ func handleRawRequest(
 		return
 	}
 
+	execStart := time.Now()
 	result, err := c.executor.Exec(exec, req.calldata, map[string]interface{}{
 		"BAND_CHAIN_ID":       vmsg.ChainID,
 		"BAND_DATA_SOURCE_ID": strconv.Itoa(int(vmsg.DataSourceID)),
@@ -195,6 +217,7 @@ func handleRawRequest(
 	})
 
 	if err != nil {
+		c.observeDataSourceExecution(req.dataSourceID, 255, time.Since(execStart))
 		l.Error(":skull: Failed to execute data source script: %s", c, err.Error())
 		processingResultCh <- processingResult{
 			rawReport: types.NewRawReport(req.externalID, 255, nil),
@@ -202,6 +225,7 @@ func handleRawRequest(
 		}
 		return
 	} else {
+		c.observeDataSourceExecution(req.dataSourceID, result.Code, time.Since(execStart))
 		l.Debug(
 			":sparkles: Query data done with calldata: %q, result: %q, exitCode: %d",
 			req.calldata, result.Output, result.Code,
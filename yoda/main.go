@@ -45,6 +45,7 @@ type Config struct {
 	MaxTry            uint64 `mapstructure:"max-try"`             // The maximum number of tries to submit a report transaction
 	MaxReport         uint64 `mapstructure:"max-report"`          // The maximum number of reports in one transaction
 	MetricsListenAddr string `mapstructure:"metrics-listen-addr"` // Address to listen on for prometheus metrics
+	StatusListenAddr  string `mapstructure:"status-listen-addr"`  // Address to listen on for the in-flight requests status endpoint
 }
 
 // Global instances.
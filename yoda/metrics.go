@@ -2,11 +2,16 @@ package yoda
 
 import (
 	"net/http"
+	"strconv"
 	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
 )
 
 type yodaCollector struct {
@@ -57,13 +62,125 @@ func (collector yodaCollector) Collect(ch chan<- prometheus.Metric) {
 		float64(atomic.LoadInt64(&collector.context.submittedCount)))
 }
 
+// yodaMetrics holds the labelled metrics that cannot be expressed as a single value on the Context.
+type yodaMetrics struct {
+	dataSourceExecutions       *prometheus.CounterVec
+	dataSourceExecutionSeconds *prometheus.HistogramVec
+	reportBroadcastAttempts    *prometheus.CounterVec
+	reportOutOfGasRetries      prometheus.Counter
+	reportCommitSeconds        *prometheus.HistogramVec
+	reportTxResults            *prometheus.CounterVec
+	reportFeeSpent             *prometheus.CounterVec
+}
+
+func newYodaMetrics() *yodaMetrics {
+	return &yodaMetrics{
+		dataSourceExecutions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "yoda_data_source_executions_total",
+			Help: "Number of data source executions by data source ID, executor and exit code",
+		}, []string{"data_source_id", "executor", "exit_code"}),
+		dataSourceExecutionSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "yoda_data_source_execution_duration_seconds",
+			Help:    "Time taken to execute a data source script",
+			Buckets: []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 20, 30, 60},
+		}, []string{"data_source_id", "executor", "exit_code"}),
+		reportBroadcastAttempts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "yoda_report_broadcast_attempts_total",
+			Help: "Number of attempts to sign and broadcast a report transaction by result",
+		}, []string{"result"}),
+		reportOutOfGasRetries: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "yoda_report_out_of_gas_retries_total",
+			Help: "Number of report transactions rebroadcasted with more gas after running out of gas",
+		}),
+		reportCommitSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "yoda_report_tx_commit_duration_seconds",
+			Help:    "Time from the first broadcast of a report transaction until it is found in a block",
+			Buckets: []float64{1, 2, 3, 5, 8, 13, 21, 34, 55, 89, 144, 300},
+		}, []string{"result"}),
+		reportTxResults: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "yoda_report_tx_results_total",
+			Help: "Number of committed report transactions by codespace and code",
+		}, []string{"codespace", "code"}),
+		reportFeeSpent: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "yoda_report_fee_spent_total",
+			Help: "Amount of fee spent on committed report transactions by reporter key and denom",
+		}, []string{"key", "denom"}),
+	}
+}
+
+func (m *yodaMetrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		m.dataSourceExecutions,
+		m.dataSourceExecutionSeconds,
+		m.reportBroadcastAttempts,
+		m.reportOutOfGasRetries,
+		m.reportCommitSeconds,
+		m.reportTxResults,
+		m.reportFeeSpent,
+	}
+}
+
+func (c *Context) observeDataSourceExecution(id types.DataSourceID, exitCode uint32, duration time.Duration) {
+	if c.metrics == nil {
+		return
+	}
+	labels := prometheus.Labels{
+		"data_source_id": strconv.FormatUint(uint64(id), 10),
+		"executor":       c.executorName,
+		"exit_code":      strconv.FormatUint(uint64(exitCode), 10),
+	}
+	c.metrics.dataSourceExecutions.With(labels).Inc()
+	c.metrics.dataSourceExecutionSeconds.With(labels).Observe(duration.Seconds())
+}
+
+func (c *Context) observeBroadcastAttempt(success bool) {
+	if c.metrics == nil {
+		return
+	}
+	result := "success"
+	if !success {
+		result = "failure"
+	}
+	c.metrics.reportBroadcastAttempts.WithLabelValues(result).Inc()
+}
+
+func (c *Context) observeOutOfGasRetry() {
+	if c.metrics == nil {
+		return
+	}
+	c.metrics.reportOutOfGasRetries.Inc()
+}
+
+func (c *Context) observeReportCommit(result string, duration time.Duration) {
+	if c.metrics == nil {
+		return
+	}
+	c.metrics.reportCommitSeconds.WithLabelValues(result).Observe(duration.Seconds())
+}
+
+func (c *Context) observeReportTx(keyName string, codespace string, code uint32, fee sdk.Coins) {
+	if c.metrics == nil {
+		return
+	}
+	c.metrics.reportTxResults.WithLabelValues(codespace, strconv.FormatUint(uint64(code), 10)).Inc()
+	for _, coin := range fee {
+		c.metrics.reportFeeSpent.WithLabelValues(keyName, coin.Denom).Add(float64(coin.Amount.Int64()))
+	}
+}
+
 func metricsListen(listenAddr string, c *Context) {
 	collector := NewYodaCollector(c)
 	prometheus.MustRegister(collector)
-	http.Handle("/metrics", promhttp.Handler())
+	if c.metrics != nil {
+		prometheus.MustRegister(c.metrics.collectors()...)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
 
 	server := &http.Server{
 		Addr:              listenAddr,
+		Handler:           mux,
 		ReadHeaderTimeout: 10 * time.Second,
 	}
 
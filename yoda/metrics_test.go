@@ -0,0 +1,55 @@
+package yoda
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestObserveMetrics(t *testing.T) {
+	c := &Context{metrics: newYodaMetrics(), executorName: "rest"}
+
+	c.observeDataSourceExecution(1, 0, time.Second)
+	c.observeDataSourceExecution(1, 0, time.Second)
+	c.observeDataSourceExecution(2, 126, time.Second)
+	require.Equal(t, 2.0, testutil.ToFloat64(c.metrics.dataSourceExecutions.WithLabelValues("1", "rest", "0")))
+	require.Equal(t, 1.0, testutil.ToFloat64(c.metrics.dataSourceExecutions.WithLabelValues("2", "rest", "126")))
+	require.Equal(t, 2, testutil.CollectAndCount(c.metrics.dataSourceExecutionSeconds))
+
+	c.observeBroadcastAttempt(true)
+	c.observeBroadcastAttempt(false)
+	c.observeBroadcastAttempt(false)
+	require.Equal(t, 1.0, testutil.ToFloat64(c.metrics.reportBroadcastAttempts.WithLabelValues("success")))
+	require.Equal(t, 2.0, testutil.ToFloat64(c.metrics.reportBroadcastAttempts.WithLabelValues("failure")))
+
+	c.observeOutOfGasRetry()
+	require.Equal(t, 1.0, testutil.ToFloat64(c.metrics.reportOutOfGasRetries))
+
+	c.observeReportCommit("success", time.Second)
+	require.Equal(t, 1, testutil.CollectAndCount(c.metrics.reportCommitSeconds))
+
+	fee := sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(1000)))
+	c.observeReportTx("key1", "", 0, fee)
+	c.observeReportTx("key1", "oracle", 13, fee)
+	require.Equal(t, 1.0, testutil.ToFloat64(c.metrics.reportTxResults.WithLabelValues("", "0")))
+	require.Equal(t, 1.0, testutil.ToFloat64(c.metrics.reportTxResults.WithLabelValues("oracle", "13")))
+	require.Equal(t, 2000.0, testutil.ToFloat64(c.metrics.reportFeeSpent.WithLabelValues("key1", "uband")))
+}
+
+func TestObserveMetricsDisabled(t *testing.T) {
+	c := &Context{}
+
+	require.NotPanics(t, func() {
+		c.observeDataSourceExecution(1, 0, time.Second)
+		c.observeBroadcastAttempt(true)
+		c.observeOutOfGasRetry()
+		c.observeReportCommit("success", time.Second)
+		c.observeReportTx("key1", "", 0, sdk.NewCoins(sdk.NewCoin("uband", math.NewInt(1000))))
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -24,6 +25,8 @@ import (
 
 const (
 	TxQuery = "tm.event = 'Tx' AND request.id EXISTS"
+	// BlockQuery matches requests spawned by the chain itself, such as recurring requests.
+	BlockQuery = "tm.event = 'NewBlockEvents' AND request.id EXISTS"
 	// EventChannelCapacity is a buffer size of channel between node and this program
 	EventChannelCapacity = 2000
 )
@@ -45,11 +48,22 @@ func runImpl(c *Context, l *Logger) error {
 		return err
 	}
 
+	l.Info(":ear: Subscribing to events with query: %s...", BlockQuery)
+	blockEventChan, err := c.client.Subscribe(ctx, "", BlockQuery, EventChannelCapacity)
+	if err != nil {
+		return err
+	}
+
 	if c.metricsEnabled {
 		l.Info(":eyes: Starting Prometheus listener")
 		go metricsListen(cfg.MetricsListenAddr, c)
 	}
 
+	if cfg.StatusListenAddr != "" {
+		l.Info(":clipboard: Starting status listener")
+		go statusListen(cfg.StatusListenAddr, c)
+	}
+
 	availiableKeys := make([]bool, len(c.keys))
 	waitingMsgs := make([][]ReportMsgWithKey, len(c.keys))
 	for i := range availiableKeys {
@@ -77,6 +91,8 @@ func runImpl(c *Context, l *Logger) error {
 		select {
 		case ev := <-eventChan:
 			go handleTransaction(c, l, ev.Data.(cmttypes.EventDataTx).TxResult)
+		case ev := <-blockEventChan:
+			go handleBlockEvents(c, l, ev.Data.(cmttypes.EventDataNewBlockEvents))
 		case keyIndex := <-c.freeKeys:
 			if len(waitingMsgs[keyIndex]) != 0 {
 				if uint64(len(waitingMsgs[keyIndex])) > c.maxReport {
@@ -160,6 +176,11 @@ func runCmd(c *Context) *cobra.Command {
 			c.keyRoundRobinIndex = -1
 			c.pendingRequests = make(map[types.RequestID]bool)
 			c.metricsEnabled = cfg.MetricsListenAddr != ""
+			if c.metricsEnabled {
+				c.metrics = newYodaMetrics()
+			}
+			c.executorName = strings.SplitN(cfg.Executor, ":", 2)[0]
+			c.inFlight = newInFlightRequests()
 			return runImpl(c, l)
 		},
 	}
@@ -0,0 +1,120 @@
+package yoda
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// Stages of a request while yoda is working on it.
+const (
+	stageExecuting  = "executing"
+	stagePending    = "pending_submission"
+	stageSubmitting = "submitting"
+)
+
+// inFlightRequest describes a request that yoda has picked up but not yet finished reporting.
+type inFlightRequest struct {
+	RequestID   types.RequestID      `json:"request_id"`
+	Stage       string               `json:"stage"`
+	DataSources []types.DataSourceID `json:"data_source_ids"`
+	KeyIndex    int64                `json:"key_index"`
+	StartedAt   time.Time            `json:"started_at"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+}
+
+// inFlightRequests is a concurrency-safe registry of requests currently handled by yoda.
+type inFlightRequests struct {
+	mu       sync.RWMutex
+	requests map[types.RequestID]*inFlightRequest
+}
+
+func newInFlightRequests() *inFlightRequests {
+	return &inFlightRequests{requests: make(map[types.RequestID]*inFlightRequest)}
+}
+
+func (r *inFlightRequests) start(id types.RequestID, keyIndex int64, dataSources []types.DataSourceID) {
+	now := time.Now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests[id] = &inFlightRequest{
+		RequestID:   id,
+		Stage:       stageExecuting,
+		DataSources: dataSources,
+		KeyIndex:    keyIndex,
+		StartedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+func (r *inFlightRequests) setStage(stage string, ids ...types.RequestID) {
+	now := time.Now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range ids {
+		if req, ok := r.requests[id]; ok {
+			req.Stage = stage
+			req.UpdatedAt = now
+		}
+	}
+}
+
+func (r *inFlightRequests) finish(ids ...types.RequestID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range ids {
+		delete(r.requests, id)
+	}
+}
+
+// list returns a snapshot of in-flight requests sorted by request ID.
+func (r *inFlightRequests) list() []inFlightRequest {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	reqs := make([]inFlightRequest, 0, len(r.requests))
+	for _, req := range r.requests {
+		reqs = append(reqs, *req)
+	}
+	sort.Slice(reqs, func(i, j int) bool { return reqs[i].RequestID < reqs[j].RequestID })
+
+	return reqs
+}
+
+// statusResponse is the JSON body served by the status endpoint.
+type statusResponse struct {
+	Validator string            `json:"validator"`
+	InFlight  []inFlightRequest `json:"in_flight"`
+}
+
+// newStatusHandler returns the handler serving the in-flight requests of yoda at /status.
+func newStatusHandler(c *Context) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(statusResponse{
+			Validator: c.validator.String(),
+			InFlight:  c.inFlight.list(),
+		})
+	})
+
+	return mux
+}
+
+func statusListen(listenAddr string, c *Context) {
+	server := &http.Server{
+		Addr:              listenAddr,
+		Handler:           newStatusHandler(c),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	if err := server.ListenAndServe(); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,76 @@
+package yoda
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func TestInFlightRequests(t *testing.T) {
+	r := newInFlightRequests()
+	r.start(2, 0, []types.DataSourceID{1, 2})
+	r.start(1, 1, []types.DataSourceID{3})
+
+	r.setStage(stagePending, 1)
+	reqs := r.list()
+	require.Len(t, reqs, 2)
+	require.Equal(t, types.RequestID(1), reqs[0].RequestID)
+	require.Equal(t, stagePending, reqs[0].Stage)
+	require.Equal(t, types.RequestID(2), reqs[1].RequestID)
+	require.Equal(t, stageExecuting, reqs[1].Stage)
+	require.Equal(t, []types.DataSourceID{1, 2}, reqs[1].DataSources)
+
+	// unknown requests are ignored
+	r.setStage(stageSubmitting, 3)
+	r.finish(1, 3)
+	reqs = r.list()
+	require.Len(t, reqs, 1)
+	require.Equal(t, types.RequestID(2), reqs[0].RequestID)
+}
+
+func TestStatusHandler(t *testing.T) {
+	c := &Context{
+		validator: sdk.ValAddress("validator"),
+		inFlight:  newInFlightRequests(),
+	}
+	c.inFlight.start(1, 0, []types.DataSourceID{1})
+	c.inFlight.setStage(stageSubmitting, 1)
+
+	rec := httptest.NewRecorder()
+	newStatusHandler(c).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/status", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var res statusResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&res))
+	require.Equal(t, c.validator.String(), res.Validator)
+	require.Len(t, res.InFlight, 1)
+	require.Equal(t, types.RequestID(1), res.InFlight[0].RequestID)
+	require.Equal(t, stageSubmitting, res.InFlight[0].Stage)
+}
+
+func TestSubmitReportFinishesInvalidReports(t *testing.T) {
+	c := &Context{
+		freeKeys: make(chan int64, 1),
+		inFlight: newInFlightRequests(),
+	}
+	c.inFlight.start(1, 0, nil)
+	c.inFlight.setStage(stagePending, 1)
+
+	// the report fails validation as it has no validator and raw reports
+	SubmitReport(c, &Logger{log.NewNopLogger()}, 0, []ReportMsgWithKey{
+		{msg: &types.MsgReportData{RequestID: 1}},
+	})
+
+	require.Empty(t, c.inFlight.list())
+	require.Equal(t, int64(0), <-c.freeKeys)
+}